@@ -57,3 +57,48 @@ func (c *Client) DeleteCredential(ctx context.Context, name string, opts DeleteC
 
 	return
 }
+
+// BackupCredentials exports the credentials of multi-user MCP servers. Only admins can back up credentials.
+func (c *Client) BackupCredentials(ctx context.Context, request types.CredentialBackupRequest) (*types.CredentialBackup, error) {
+	_, resp, err := c.postJSON(ctx, "/credentials/backup", request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.CredentialBackup{})
+}
+
+// RestoreCredentials imports the selected credentials of a backup. Only admins can restore credentials.
+func (c *Client) RestoreCredentials(ctx context.Context, request types.CredentialRestoreRequest) (*types.CredentialRestoreResultList, error) {
+	_, resp, err := c.postJSON(ctx, "/credentials/restore", request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.CredentialRestoreResultList{})
+}
+
+// RotateEncryptionKey starts re-encrypting the stored credentials and OAuth tokens with the current write key of the
+// encryption provider. Only admins can rotate the key.
+func (c *Client) RotateEncryptionKey(ctx context.Context) (*types.KeyRotation, error) {
+	_, resp, err := c.postJSON(ctx, "/encryption/key-rotation", "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.KeyRotation{})
+}
+
+// GetEncryptionKeyRotation returns the status of the current or last key rotation.
+func (c *Client) GetEncryptionKeyRotation(ctx context.Context) (*types.KeyRotation, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/encryption/key-rotation", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.KeyRotation{})
+}
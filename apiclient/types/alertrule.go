@@ -0,0 +1,207 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AlertRuleCondition is the metric of MCP servers, or of the users calling them, that an alert rule watches.
+type AlertRuleCondition string
+
+const (
+	// AlertRuleConditionServerHealth compares the number of liveness probes of a server that failed in a row.
+	AlertRuleConditionServerHealth AlertRuleCondition = "server-health"
+	// AlertRuleConditionErrorRate compares the fraction, from 0 to 1, of a server's tool calls in the window that failed.
+	AlertRuleConditionErrorRate AlertRuleCondition = "error-rate"
+	// AlertRuleConditionLatency compares the average processing time, in milliseconds, of a server's tool calls in the window.
+	AlertRuleConditionLatency AlertRuleCondition = "latency"
+	// AlertRuleConditionRestartCount compares the number of times the containers of a server's deployment restarted.
+	AlertRuleConditionRestartCount AlertRuleCondition = "restart-count"
+
+	// AlertRuleConditionUserCallSpike compares how many times more tool calls a user made in the window than they
+	// usually make in a window of the same length, over the baseline days before it.
+	AlertRuleConditionUserCallSpike AlertRuleCondition = "user-call-spike"
+	// AlertRuleConditionUserOffHoursCalls compares the number of tool calls a user made in the window outside the
+	// rule's active hours.
+	AlertRuleConditionUserOffHoursCalls AlertRuleCondition = "user-off-hours-calls"
+	// AlertRuleConditionUserServerCount compares the number of MCP servers a user called tools of in the window.
+	AlertRuleConditionUserServerCount AlertRuleCondition = "user-server-count"
+)
+
+// IsUserCondition returns whether the condition fires for the users calling MCP servers, rather than for the servers.
+func (c AlertRuleCondition) IsUserCondition() bool {
+	switch c {
+	case AlertRuleConditionUserCallSpike, AlertRuleConditionUserOffHoursCalls, AlertRuleConditionUserServerCount:
+		return true
+	default:
+		return false
+	}
+}
+
+// AlertRuleState is whether an alert rule is firing for any MCP server.
+type AlertRuleState string
+
+const (
+	AlertRuleStateOK       AlertRuleState = "ok"
+	AlertRuleStateFiring   AlertRuleState = "firing"
+	AlertRuleStateDisabled AlertRuleState = "disabled"
+)
+
+type AlertRule struct {
+	Metadata          `json:",inline"`
+	AlertRuleManifest `json:",inline"`
+	// HasSecret is true if notifications for this rule are signed.
+	HasSecret       bool              `json:"hasSecret"`
+	State           AlertRuleState    `json:"state,omitempty"`
+	Firing          []AlertRuleFiring `json:"firing,omitempty"`
+	LastEvaluatedAt *Time             `json:"lastEvaluatedAt,omitempty"`
+	// Error is the error of the last evaluation or notification, if any.
+	Error string `json:"error,omitempty"`
+}
+
+type AlertRuleManifest struct {
+	DisplayName string             `json:"displayName"`
+	Description string             `json:"description,omitempty"`
+	Condition   AlertRuleCondition `json:"condition"`
+	// Threshold is the value that the metric of a server must exceed for the rule to fire for that server.
+	Threshold float64 `json:"threshold"`
+	// WindowMinutes is how far back the conditions that look at tool calls look. It defaults to 15 minutes.
+	WindowMinutes int `json:"windowMinutes,omitempty"`
+	// MinCalls is the number of tool calls that a server must have received, or a user must have made, in the window
+	// for the conditions that look at tool calls to apply to it. It defaults to 1.
+	MinCalls int `json:"minCalls,omitempty"`
+	// BaselineDays is how many days before the window the user-call-spike condition compares the window to. It
+	// defaults to 7 days.
+	BaselineDays int `json:"baselineDays,omitempty"`
+	// ActiveHours are the hours when the user-off-hours-calls condition expects users to call tools.
+	ActiveHours *AlertRuleActiveHours `json:"activeHours,omitempty"`
+	// MCPServerIDs limits the rule to these MCP servers. The rule applies to all MCP servers if it is empty.
+	MCPServerIDs []string              `json:"mcpServerIDs,omitempty"`
+	Notification AlertRuleNotification `json:"notification"`
+	Disabled     bool                  `json:"disabled,omitempty"`
+}
+
+// AlertRuleNotification is where notifications are sent when a rule starts or stops firing for a server.
+type AlertRuleNotification struct {
+	WebhookURL string `json:"webhookURL"`
+	// Secret signs the notifications, in the same way as requests to webhook filters. It is never returned by the API.
+	Secret string `json:"secret,omitempty"`
+}
+
+// AlertRuleActiveHours is the range of hours of the day, in a time zone, when users are expected to call tools.
+type AlertRuleActiveHours struct {
+	// Start is the first active hour, from 0 to 23.
+	Start int `json:"start"`
+	// End is the hour, from 0 to 23, when the active hours end. It is before Start if the active hours span midnight.
+	End int `json:"end"`
+	// TimeZone is the IANA time zone of the hours, such as America/New_York. It defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// Location returns the time zone of the active hours.
+func (h AlertRuleActiveHours) Location() (*time.Location, error) {
+	return time.LoadLocation(h.TimeZone)
+}
+
+// Contains returns whether an hour of the day is in the active hours.
+func (h AlertRuleActiveHours) Contains(hour int) bool {
+	if h.Start <= h.End {
+		return hour >= h.Start && hour < h.End
+	}
+	return hour >= h.Start || hour < h.End
+}
+
+// AlertRuleFiring is an MCP server or a user that an alert rule is firing for.
+type AlertRuleFiring struct {
+	MCPID                string `json:"mcpID,omitempty"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName,omitempty"`
+	// UserID is set instead of MCPID for the conditions that fire for users.
+	UserID          string  `json:"userID,omitempty"`
+	UserDisplayName string  `json:"userDisplayName,omitempty"`
+	Value           float64 `json:"value"`
+	Since           Time    `json:"since"`
+}
+
+type AlertRuleList List[AlertRule]
+
+// AlertNotification is the body of the webhook requests sent for alert rules.
+type AlertNotification struct {
+	AlertRuleID   string             `json:"alertRuleID"`
+	AlertRuleName string             `json:"alertRuleName"`
+	Condition     AlertRuleCondition `json:"condition"`
+	Threshold     float64            `json:"threshold"`
+	Events        []AlertEvent       `json:"events"`
+}
+
+// AlertEventType is whether a rule started or stopped firing for a server or a user.
+type AlertEventType string
+
+const (
+	AlertEventTypeFiring   AlertEventType = "firing"
+	AlertEventTypeResolved AlertEventType = "resolved"
+)
+
+type AlertEvent struct {
+	Type                 AlertEventType `json:"type"`
+	MCPID                string         `json:"mcpID,omitempty"`
+	MCPServerDisplayName string         `json:"mcpServerDisplayName,omitempty"`
+	UserID               string         `json:"userID,omitempty"`
+	UserDisplayName      string         `json:"userDisplayName,omitempty"`
+	Value                float64        `json:"value"`
+	Time                 Time           `json:"time"`
+}
+
+func (m AlertRuleManifest) Validate() error {
+	if m.DisplayName == "" {
+		return fmt.Errorf("displayName is required")
+	}
+
+	switch m.Condition {
+	case AlertRuleConditionServerHealth, AlertRuleConditionLatency, AlertRuleConditionRestartCount:
+	case AlertRuleConditionErrorRate:
+		if m.Threshold > 1 {
+			return fmt.Errorf("the threshold of an error-rate condition must be a fraction between 0 and 1")
+		}
+	case AlertRuleConditionUserCallSpike, AlertRuleConditionUserServerCount:
+	case AlertRuleConditionUserOffHoursCalls:
+		if m.ActiveHours == nil {
+			return fmt.Errorf("activeHours is required for a user-off-hours-calls condition")
+		}
+		if m.ActiveHours.Start < 0 || m.ActiveHours.Start > 23 || m.ActiveHours.End < 0 || m.ActiveHours.End > 23 {
+			return fmt.Errorf("activeHours.start and activeHours.end must be between 0 and 23")
+		}
+		if m.ActiveHours.Start == m.ActiveHours.End {
+			return fmt.Errorf("activeHours.start and activeHours.end must be different")
+		}
+		if _, err := m.ActiveHours.Location(); err != nil {
+			return fmt.Errorf("invalid activeHours.timeZone %q: %v", m.ActiveHours.TimeZone, err)
+		}
+	default:
+		return fmt.Errorf("invalid condition %q: must be one of %q, %q, %q, %q, %q, %q, %q", m.Condition,
+			AlertRuleConditionServerHealth, AlertRuleConditionErrorRate, AlertRuleConditionLatency, AlertRuleConditionRestartCount,
+			AlertRuleConditionUserCallSpike, AlertRuleConditionUserOffHoursCalls, AlertRuleConditionUserServerCount)
+	}
+
+	if m.Threshold < 0 {
+		return fmt.Errorf("threshold must not be negative")
+	}
+	if m.WindowMinutes < 0 {
+		return fmt.Errorf("windowMinutes must not be negative")
+	}
+	if m.MinCalls < 0 {
+		return fmt.Errorf("minCalls must not be negative")
+	}
+	if m.BaselineDays < 0 {
+		return fmt.Errorf("baselineDays must not be negative")
+	}
+
+	if m.Notification.WebhookURL == "" {
+		return fmt.Errorf("notification.webhookURL is required")
+	}
+	if u, err := url.Parse(m.Notification.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("notification.webhookURL must be an http or https URL")
+	}
+
+	return nil
+}
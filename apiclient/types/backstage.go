@@ -0,0 +1,39 @@
+package types
+
+// BackstageEntity is an entity of the Backstage software catalog, in the format of Backstage catalog-info files.
+// The servers of an MCP catalog are described by a System for the catalog, an API for each catalog entry, and a
+// Component for each deployed multi-user server.
+type BackstageEntity struct {
+	APIVersion string                  `json:"apiVersion"`
+	Kind       string                  `json:"kind"`
+	Metadata   BackstageEntityMetadata `json:"metadata"`
+	Spec       BackstageEntitySpec     `json:"spec"`
+}
+
+type BackstageEntityMetadata struct {
+	Name        string            `json:"name"`
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Links       []BackstageLink   `json:"links,omitempty"`
+}
+
+type BackstageLink struct {
+	URL   string `json:"url"`
+	Title string `json:"title,omitempty"`
+}
+
+// BackstageEntitySpec contains the spec fields of the System, API, and Component kinds. Only the fields of the entity's
+// kind are set.
+type BackstageEntitySpec struct {
+	Type      string `json:"type,omitempty"`
+	Lifecycle string `json:"lifecycle,omitempty"`
+	Owner     string `json:"owner"`
+	System    string `json:"system,omitempty"`
+	// Definition is the definition of an API. For MCP servers, it lists the tools of the server.
+	Definition   string   `json:"definition,omitempty"`
+	ProvidesAPIs []string `json:"providesApis,omitempty"`
+}
+
+type BackstageEntityList List[BackstageEntity]
@@ -0,0 +1,17 @@
+package types
+
+// Condition describes one aspect of an object's current state, following the Kubernetes condition conventions.
+type Condition struct {
+	// Type of condition, e.g. Ready, Synced, CredentialConfigured, DriftDetected, or K8sSettingsApplied.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status string `json:"status"`
+	// ObservedGeneration is the generation of the object that the condition was set based on.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Reason is a CamelCase reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human-readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime Time `json:"lastTransitionTime"`
+}
@@ -0,0 +1,65 @@
+package types
+
+// CredentialBackupEncryption is how the credential values in a backup are encrypted.
+type CredentialBackupEncryption string
+
+const (
+	// CredentialBackupEncryptionKey means the values are encrypted with a key provided when the backup was created.
+	CredentialBackupEncryptionKey CredentialBackupEncryption = "key"
+	// CredentialBackupEncryptionPlatform means the values are encrypted with the encryption provider of the Obot
+	// installation that created the backup. They can only be restored by installations that use the same provider key.
+	CredentialBackupEncryptionPlatform CredentialBackupEncryption = "platform"
+)
+
+type CredentialBackupRequest struct {
+	// Key encrypts the backup. When empty, the backup is encrypted with the platform's encryption provider.
+	Key string `json:"key,omitempty"`
+	// MCPServerIDs limits the backup to the credentials of these multi-user MCP servers. All are included when empty.
+	MCPServerIDs []string `json:"mcpServerIDs,omitempty"`
+}
+
+// CredentialBackup is an export of the credentials of multi-user MCP servers. Only the credential values are encrypted,
+// the entries describing them are not, so that the credentials to restore can be selected before decrypting.
+type CredentialBackup struct {
+	Version    int                        `json:"version"`
+	CreatedAt  Time                       `json:"createdAt"`
+	Encryption CredentialBackupEncryption `json:"encryption"`
+	Entries    []CredentialBackupEntry    `json:"entries"`
+	// Data is the encrypted credential values of the entries, base64 encoded.
+	Data string `json:"data"`
+}
+
+type CredentialBackupEntry struct {
+	// ID identifies the entry in the backup. It is the ID of the MCP server the credential was backed up from.
+	ID                   string   `json:"id"`
+	MCPServerName        string   `json:"mcpServerName,omitempty"`
+	CatalogEntryID       string   `json:"catalogEntryID,omitempty"`
+	MCPCatalogID         string   `json:"mcpCatalogID,omitempty"`
+	PowerUserWorkspaceID string   `json:"powerUserWorkspaceID,omitempty"`
+	EnvVars              []string `json:"envVars,omitempty"`
+}
+
+type CredentialRestoreRequest struct {
+	Backup CredentialBackup `json:"backup"`
+	// Key decrypts a backup that was encrypted with a key.
+	Key string `json:"key,omitempty"`
+	// Entries selects the entries to restore. All entries are restored when empty.
+	Entries []CredentialRestoreEntry `json:"entries,omitempty"`
+}
+
+type CredentialRestoreEntry struct {
+	ID string `json:"id"`
+	// TargetMCPServerID is the MCP server to restore the credential to. When empty, the credential is restored to the
+	// server with the same ID, or else to the only server in the same catalog or workspace with the same catalog entry
+	// and name.
+	TargetMCPServerID string `json:"targetMCPServerID,omitempty"`
+}
+
+type CredentialRestoreResult struct {
+	ID                string `json:"id"`
+	TargetMCPServerID string `json:"targetMCPServerID,omitempty"`
+	Restored          bool   `json:"restored"`
+	Error             string `json:"error,omitempty"`
+}
+
+type CredentialRestoreResultList List[CredentialRestoreResult]
@@ -0,0 +1,67 @@
+package types
+
+// DatabaseChangeType is the kind of change that Obot makes to its database when it starts.
+type DatabaseChangeType string
+
+const (
+	DatabaseChangeTypeCreateTable   DatabaseChangeType = "createTable"
+	DatabaseChangeTypeAddColumn     DatabaseChangeType = "addColumn"
+	DatabaseChangeTypeCreateIndex   DatabaseChangeType = "createIndex"
+	DatabaseChangeTypeDataMigration DatabaseChangeType = "dataMigration"
+)
+
+// DatabaseChange is a change to the schema or data of Obot's database.
+type DatabaseChange struct {
+	Type DatabaseChangeType `json:"type"`
+	// Table is the table that the change is made to. It is empty for data migrations.
+	Table string `json:"table,omitempty"`
+	// Name is the name of the column, index, or data migration.
+	Name string `json:"name,omitempty"`
+}
+
+// DatabaseMigration is a startup of Obot that changed its database.
+type DatabaseMigration struct {
+	ID        uint             `json:"id"`
+	AppliedAt Time             `json:"appliedAt"`
+	Version   string           `json:"version"`
+	Changes   []DatabaseChange `json:"changes"`
+}
+
+// SchemaTransitionPhase is the phase of an expand/contract migration of Obot's database.
+type SchemaTransitionPhase string
+
+const (
+	// SchemaTransitionPhaseExpanded means that the new schema was added next to the old one. Both are written, the old
+	// one is read, and the existing data is being copied to the new one.
+	SchemaTransitionPhaseExpanded SchemaTransitionPhase = "expanded"
+	// SchemaTransitionPhaseBackfilled means that all existing data was copied to the new schema once. Both schemas are
+	// still written, so that instances of the previous release can keep running, until the transition is contracted.
+	SchemaTransitionPhaseBackfilled SchemaTransitionPhase = "backfilled"
+	// SchemaTransitionPhaseContracted means that the old schema was removed, and only the new one is used.
+	SchemaTransitionPhaseContracted SchemaTransitionPhase = "contracted"
+)
+
+// SchemaTransition is an expand/contract migration, which changes the schema of Obot's database without downtime
+// while instances of the previous and new release run side by side.
+type SchemaTransition struct {
+	Name           string                `json:"name"`
+	Phase          SchemaTransitionPhase `json:"phase"`
+	BackfilledRows int64                 `json:"backfilledRows"`
+	StartedAt      Time                  `json:"startedAt"`
+	BackfilledAt   *Time                 `json:"backfilledAt,omitempty"`
+	ContractedAt   *Time                 `json:"contractedAt,omitempty"`
+}
+
+// DatabaseMigrationStatus describes the changes that are waiting to be made to Obot's database, and the changes that
+// were made.
+type DatabaseMigrationStatus struct {
+	// DryRun is true when Obot was started without applying pending changes, so that they can be reviewed.
+	DryRun bool `json:"dryRun"`
+	// Pending are the changes that the next startup without dry run makes. Changes of the types of existing columns
+	// aren't included.
+	Pending []DatabaseChange `json:"pending"`
+	// History are the startups that changed the database, the most recent first.
+	History []DatabaseMigration `json:"history"`
+	// Transitions are the expand/contract migrations of the database.
+	Transitions []SchemaTransition `json:"transitions"`
+}
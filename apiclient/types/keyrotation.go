@@ -0,0 +1,33 @@
+package types
+
+// KeyRotationState is the state of a key rotation job.
+type KeyRotationState string
+
+const (
+	KeyRotationStatePending   KeyRotationState = "pending"
+	KeyRotationStateRunning   KeyRotationState = "running"
+	KeyRotationStateSucceeded KeyRotationState = "succeeded"
+	KeyRotationStateFailed    KeyRotationState = "failed"
+)
+
+// KeyRotation is the status of the job that re-encrypts stored secrets with the current write key of the encryption
+// provider. An empty state means that no job has run yet.
+type KeyRotation struct {
+	State       KeyRotationState      `json:"state,omitempty"`
+	StartedBy   string                `json:"startedBy,omitempty"`
+	StartedAt   *Time                 `json:"startedAt,omitempty"`
+	CompletedAt *Time                 `json:"completedAt,omitempty"`
+	Resources   []KeyRotationResource `json:"resources,omitempty"`
+	Error       string                `json:"error,omitempty"`
+}
+
+// KeyRotationResource is the progress of re-encrypting one kind of stored secret.
+type KeyRotationResource struct {
+	Resource string `json:"resource"`
+	// Total is the number of records to re-encrypt. It is zero for resources that are re-encrypted as a whole.
+	Total       int    `json:"total,omitempty"`
+	Reencrypted int    `json:"reencrypted,omitempty"`
+	Failed      int    `json:"failed,omitempty"`
+	Done        bool   `json:"done"`
+	Error       string `json:"error,omitempty"`
+}
@@ -29,6 +29,10 @@ type MCPAuditLog struct {
 	Error                     string          `json:"error,omitempty"`
 	ProcessingTimeMs          int64           `json:"processingTimeMs"`
 	SessionID                 string          `json:"sessionID,omitempty"`
+	RunID                     string          `json:"runID,omitempty"`
+	ProjectID                 string          `json:"projectID,omitempty"`
+	EstimatedCostUSD          float64         `json:"estimatedCostUSD,omitempty"`
+	EstimatedCredits          float64         `json:"estimatedCredits,omitempty"`
 	RequestID                 string          `json:"requestID,omitempty"`
 	UserAgent                 string          `json:"userAgent,omitempty"`
 	RequestHeaders            json.RawMessage `json:"requestHeaders,omitempty"`
@@ -108,3 +112,46 @@ type MCPPromptReadStats struct {
 
 // MCPUsageStatsList represents a list of MCP usage statistics
 type MCPUsageStatsList List[MCPUsageStatItem]
+
+// MCPToolCallDailyStat is the number of calls of a tool of an MCP server on a day
+type MCPToolCallDailyStat struct {
+	// Date is the UTC day of the calls, formatted as YYYY-MM-DD
+	Date                 string `json:"date"`
+	MCPID                string `json:"mcpID"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName"`
+	ToolName             string `json:"toolName"`
+	CallCount            int64  `json:"callCount"`
+	ErrorCount           int64  `json:"errorCount"`
+}
+
+type MCPToolCallDailyStats struct {
+	TimeStart Time                   `json:"timeStart"`
+	TimeEnd   Time                   `json:"timeEnd"`
+	Items     []MCPToolCallDailyStat `json:"items"`
+}
+
+// MCPErrorRateStat is the number of calls and errors of an MCP server
+type MCPErrorRateStat struct {
+	MCPID                string  `json:"mcpID"`
+	MCPServerDisplayName string  `json:"mcpServerDisplayName"`
+	CallCount            int64   `json:"callCount"`
+	ErrorCount           int64   `json:"errorCount"`
+	ErrorRate            float64 `json:"errorRate"`
+}
+
+type MCPErrorRateStats struct {
+	TimeStart  Time               `json:"timeStart"`
+	TimeEnd    Time               `json:"timeEnd"`
+	CallCount  int64              `json:"callCount"`
+	ErrorCount int64              `json:"errorCount"`
+	ErrorRate  float64            `json:"errorRate"`
+	Items      []MCPErrorRateStat `json:"items"`
+}
+
+// ErrorRate returns the fraction of calls that are errors, or zero if there are no calls
+func ErrorRate(errors, calls int64) float64 {
+	if calls == 0 {
+		return 0
+	}
+	return float64(errors) / float64(calls)
+}
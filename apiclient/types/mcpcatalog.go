@@ -6,12 +6,51 @@ type MCPCatalog struct {
 	LastSynced Time              `json:"lastSynced,omitzero"`
 	SyncErrors map[string]string `json:"syncErrors,omitempty"`
 	IsSyncing  bool              `json:"isSyncing,omitempty"`
+	// SyncConflicts contains the IDs of the entries imported from an MCP registry that were edited in Obot and changed
+	// in the registry since, so they weren't updated by the last sync.
+	SyncConflicts []string `json:"syncConflicts,omitempty"`
 }
 
 type MCPCatalogManifest struct {
 	DisplayName          string            `json:"displayName"`
 	SourceURLs           []string          `json:"sourceURLs"`
 	SourceURLCredentials map[string]string `json:"sourceURLCredentials,omitempty"`
+	// ExternalURL, if set, is the base URL used in place of Obot's server URL when building the connect URLs of servers in this catalog.
+	ExternalURL string `json:"externalURL,omitempty"`
+	// DefaultToolSelection, if set, overrides the server-wide policy for which tools of a server from this catalog are
+	// enabled when the server is added to a project.
+	DefaultToolSelection ToolSelectionPolicy `json:"defaultToolSelection,omitempty"`
+}
+
+// ToolSelectionPolicy determines which tools of an MCP server are enabled when it is added to a project,
+// until tools are explicitly selected for it.
+type ToolSelectionPolicy string
+
+const (
+	// ToolSelectionPolicyAllowAll enables all tools of the server.
+	ToolSelectionPolicyAllowAll ToolSelectionPolicy = "allow-all"
+	// ToolSelectionPolicyDenyAll enables no tools until they are selected.
+	ToolSelectionPolicyDenyAll ToolSelectionPolicy = "deny-all"
+	// ToolSelectionPolicyCatalogDefault enables the default tools of the server's catalog entry,
+	// or all tools if the catalog entry has none.
+	ToolSelectionPolicyCatalogDefault ToolSelectionPolicy = "catalog-default"
+)
+
+func (p ToolSelectionPolicy) Valid() bool {
+	switch p {
+	case ToolSelectionPolicyAllowAll, ToolSelectionPolicyDenyAll, ToolSelectionPolicyCatalogDefault:
+		return true
+	default:
+		return false
+	}
 }
 
 type MCPCatalogList List[MCPCatalog]
+
+// MCPCatalogWebhook is the webhook that Git hosting platforms call to sync a catalog when its Git sources are pushed to.
+type MCPCatalogWebhook struct {
+	URL        string `json:"url"`
+	Configured bool   `json:"configured"`
+	// Secret is only returned when it is generated.
+	Secret string `json:"secret,omitempty"`
+}
@@ -0,0 +1,97 @@
+package types
+
+// MCPCatalogBundleVersion is the version of the bundle format that is exported, and the only one that can be imported.
+const MCPCatalogBundleVersion = 1
+
+// MCPCatalogBundle is the configuration of an MCP catalog, exported from one Obot instance so that it can be imported
+// into another, such as when promoting configuration from staging to production. Credentials are not included.
+//
+// Entries, servers, and access control rules are identified by their names, which must be unique in a bundle. When a
+// bundle is imported, they update the entries, servers, and rules of the same names in the catalog, and the rest are
+// created. Nothing is deleted.
+type MCPCatalogBundle struct {
+	Version            int                                 `json:"version"`
+	Catalog            MCPCatalogBundleCatalog             `json:"catalog"`
+	Entries            []MCPCatalogBundleEntry             `json:"entries,omitempty"`
+	Servers            []MCPCatalogBundleServer            `json:"servers,omitempty"`
+	AccessControlRules []MCPCatalogBundleAccessControlRule `json:"accessControlRules,omitempty"`
+}
+
+// MCPCatalogBundleCatalog contains the settings of the catalog. The external URL is not included, because it is
+// specific to each instance.
+type MCPCatalogBundleCatalog struct {
+	SourceURLs           []string            `json:"sourceURLs,omitempty"`
+	DefaultToolSelection ToolSelectionPolicy `json:"defaultToolSelection,omitempty"`
+}
+
+// MCPCatalogBundleEntry is a catalog entry that was created in Obot. Entries from the catalog's source URLs are not
+// included, because they are synced from the source URLs. Its name is the name in its manifest.
+type MCPCatalogBundleEntry struct {
+	Manifest MCPServerCatalogEntryManifest `json:"manifest"`
+}
+
+// MCPCatalogBundleServer is a multi-user server of the catalog. Its name is the name in its manifest.
+type MCPCatalogBundleServer struct {
+	// CatalogEntry is the name of the entry of the bundle that the server was created from, if it was.
+	CatalogEntry string `json:"catalogEntry,omitempty"`
+	// CatalogEntryID is the ID of the entry that the server was created from, if it was created from an entry that is
+	// not in the bundle, such as one from the catalog's source URLs.
+	CatalogEntryID string            `json:"catalogEntryID,omitempty"`
+	Manifest       MCPServerManifest `json:"manifest"`
+}
+
+// MCPCatalogBundleAccessControlRule is an access control rule of the catalog. Its name is its display name. Its subjects
+// are copied as they are, so the users and groups must have the same IDs in the instance that it is imported into.
+type MCPCatalogBundleAccessControlRule struct {
+	DisplayName string                     `json:"displayName"`
+	Subjects    []Subject                  `json:"subjects,omitempty"`
+	Resources   []MCPCatalogBundleResource `json:"resources,omitempty"`
+}
+
+// MCPCatalogBundleResource is a resource of an access control rule. Entries and servers of the bundle are referred to
+// by Name, and other entries by ID. A resource of the mcpCatalog type refers to the catalog that the bundle is
+// imported into, so it has neither.
+type MCPCatalogBundleResource struct {
+	Type ResourceType `json:"type"`
+	Name string       `json:"name,omitempty"`
+	ID   string       `json:"id,omitempty"`
+}
+
+type MCPCatalogBundleChangeKind string
+
+const (
+	MCPCatalogBundleChangeKindCatalog           MCPCatalogBundleChangeKind = "catalog"
+	MCPCatalogBundleChangeKindEntry             MCPCatalogBundleChangeKind = "entry"
+	MCPCatalogBundleChangeKindServer            MCPCatalogBundleChangeKind = "server"
+	MCPCatalogBundleChangeKindAccessControlRule MCPCatalogBundleChangeKind = "accessControlRule"
+)
+
+type MCPCatalogBundleChangeAction string
+
+const (
+	MCPCatalogBundleChangeActionCreate    MCPCatalogBundleChangeAction = "create"
+	MCPCatalogBundleChangeActionUpdate    MCPCatalogBundleChangeAction = "update"
+	MCPCatalogBundleChangeActionUnchanged MCPCatalogBundleChangeAction = "unchanged"
+)
+
+// MCPCatalogBundleImportResult is the response to importing a bundle. For a dry run, it contains the changes that
+// importing the bundle would make, without making them.
+type MCPCatalogBundleImportResult struct {
+	DryRun  bool                     `json:"dryRun,omitempty"`
+	Changes []MCPCatalogBundleChange `json:"changes"`
+	// Failed is the number of changes that failed.
+	Failed int `json:"failed,omitempty"`
+}
+
+type MCPCatalogBundleChange struct {
+	Kind   MCPCatalogBundleChangeKind   `json:"kind"`
+	Name   string                       `json:"name"`
+	Action MCPCatalogBundleChangeAction `json:"action"`
+	// ID is the ID of the object in the catalog, if it exists or was created.
+	ID string `json:"id,omitempty"`
+	// Diff is a unified diff from the object's configuration in the catalog to its configuration in the bundle, for
+	// updates.
+	Diff string `json:"diff,omitempty"`
+	// Error is why the change can't be made, or why making it failed.
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,49 @@
+package types
+
+// MCPServerCatalogEntryValidationRequest is a catalog entry manifest to validate before it is published.
+type MCPServerCatalogEntryValidationRequest struct {
+	// CatalogID or WorkspaceID is where the entry would be published. The components of a composite entry must be
+	// in the same catalog or workspace.
+	CatalogID   string                        `json:"catalogID,omitempty"`
+	WorkspaceID string                        `json:"workspaceID,omitempty"`
+	Manifest    MCPServerCatalogEntryManifest `json:"manifest"`
+
+	// DryRun deploys a temporary server from the manifest to list its tools, then tears it down. It is only done if the
+	// manifest is valid.
+	DryRun bool `json:"dryRun,omitempty"`
+	// Config and URL configure the temporary server of a dry run.
+	Config map[string]string `json:"config,omitempty"`
+	URL    string            `json:"url,omitempty"`
+	// ComponentConfigs configure the temporary servers of the components of a composite entry, by component ID.
+	// Components without a configuration are skipped.
+	ComponentConfigs map[string]MCPComponentServerConfig `json:"componentConfigs,omitempty"`
+}
+
+// MCPComponentServerConfig is the configuration of a temporary server for a component of a composite catalog entry.
+type MCPComponentServerConfig struct {
+	Config   map[string]string `json:"config,omitempty"`
+	URL      string            `json:"url,omitempty"`
+	Disabled bool              `json:"disabled,omitempty"`
+}
+
+// MCPServerCatalogEntryValidationIssue is a problem with a field of a catalog entry manifest.
+type MCPServerCatalogEntryValidationIssue struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// MCPServerCatalogEntryValidationResult is the result of validating a catalog entry manifest. The manifest is valid if
+// there are no errors and the dry run, if one was requested, succeeded.
+type MCPServerCatalogEntryValidationResult struct {
+	Valid    bool                                   `json:"valid"`
+	Errors   []MCPServerCatalogEntryValidationIssue `json:"errors,omitempty"`
+	Warnings []MCPServerCatalogEntryValidationIssue `json:"warnings,omitempty"`
+	DryRun   *MCPServerCatalogEntryDryRunResult     `json:"dryRun,omitempty"`
+}
+
+// MCPServerCatalogEntryDryRunResult is the result of deploying a temporary server from a catalog entry manifest.
+type MCPServerCatalogEntryDryRunResult struct {
+	Succeeded bool            `json:"succeeded"`
+	Error     string          `json:"error,omitempty"`
+	Tools     []MCPServerTool `json:"tools,omitempty"`
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// MCPCatalogEventType is a lifecycle event of the entries and servers of an MCP catalog.
+type MCPCatalogEventType string
+
+const (
+	// MCPCatalogEventEntryPublished is sent when a catalog entry is added to the catalog, and when a new revision of
+	// its configuration is published.
+	MCPCatalogEventEntryPublished MCPCatalogEventType = "entry-published"
+	// MCPCatalogEventServerCreated is sent when a multi-user server is created in the catalog, or a single-user server
+	// is created from one of the catalog's entries.
+	MCPCatalogEventServerCreated MCPCatalogEventType = "server-created"
+	// MCPCatalogEventServerDeleted is sent when one of those servers is deleted.
+	MCPCatalogEventServerDeleted MCPCatalogEventType = "server-deleted"
+	// MCPCatalogEventServerUpdateApplied is sent when the configuration of one of those servers changes, such as when
+	// an update from its catalog entry is applied.
+	MCPCatalogEventServerUpdateApplied MCPCatalogEventType = "server-update-applied"
+	// MCPCatalogEventServerHealthChanged is sent when the deployment status of one of those servers changes.
+	MCPCatalogEventServerHealthChanged MCPCatalogEventType = "server-health-changed"
+)
+
+var mcpCatalogEventTypes = []MCPCatalogEventType{
+	MCPCatalogEventEntryPublished,
+	MCPCatalogEventServerCreated,
+	MCPCatalogEventServerDeleted,
+	MCPCatalogEventServerUpdateApplied,
+	MCPCatalogEventServerHealthChanged,
+}
+
+// MCPCatalogEventWebhook is a subscription of an external system to the lifecycle events of an MCP catalog.
+type MCPCatalogEventWebhook struct {
+	Metadata                       `json:",inline"`
+	MCPCatalogEventWebhookManifest `json:",inline"`
+	MCPCatalogID                   string `json:"mcpCatalogID"`
+	// HasSecret is true if the events sent to this webhook are signed.
+	HasSecret bool `json:"hasSecret"`
+	// LastDeliveredAt is the last time an event was delivered to this webhook.
+	LastDeliveredAt *Time `json:"lastDeliveredAt,omitempty"`
+	// Error is the error of the last delivery to this webhook, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+type MCPCatalogEventWebhookManifest struct {
+	DisplayName string `json:"displayName"`
+	URL         string `json:"url"`
+	// Secret signs the events, in the same way as requests to webhook filters. It is never returned by the API.
+	Secret string `json:"secret,omitempty"`
+	// Events are the types of events that are sent to this webhook. All events are sent if it is empty.
+	Events   []MCPCatalogEventType `json:"events,omitempty"`
+	Disabled bool                  `json:"disabled,omitempty"`
+}
+
+type MCPCatalogEventWebhookList List[MCPCatalogEventWebhook]
+
+// Subscribes returns whether events of a type are sent to the webhook.
+func (m MCPCatalogEventWebhookManifest) Subscribes(eventType MCPCatalogEventType) bool {
+	return !m.Disabled && (len(m.Events) == 0 || slices.Contains(m.Events, eventType))
+}
+
+func (m MCPCatalogEventWebhookManifest) Validate() error {
+	if m.DisplayName == "" {
+		return fmt.Errorf("displayName is required")
+	}
+	if m.URL == "" {
+		return fmt.Errorf("url is required")
+	}
+	if u, err := url.Parse(m.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an http or https URL")
+	}
+	for _, eventType := range m.Events {
+		if !slices.Contains(mcpCatalogEventTypes, eventType) {
+			return fmt.Errorf("invalid event %q: must be one of %q", eventType, mcpCatalogEventTypes)
+		}
+	}
+	return nil
+}
+
+// MCPCatalogEvent is the body of the webhook requests sent for the lifecycle events of an MCP catalog.
+type MCPCatalogEvent struct {
+	// ID identifies the event, so that receivers can ignore an event that is delivered again.
+	ID           string              `json:"id"`
+	Type         MCPCatalogEventType `json:"type"`
+	MCPCatalogID string              `json:"mcpCatalogID"`
+	Time         Time                `json:"time"`
+
+	CatalogEntryID   string `json:"catalogEntryID,omitempty"`
+	CatalogEntryName string `json:"catalogEntryName,omitempty"`
+	// CatalogEntryRevision is the revision of the catalog entry that was published.
+	CatalogEntryRevision int `json:"catalogEntryRevision,omitempty"`
+
+	MCPServerID   string `json:"mcpServerID,omitempty"`
+	MCPServerName string `json:"mcpServerName,omitempty"`
+	// UserID is the owner of a single-user server. It is empty for multi-user servers.
+	UserID string `json:"userID,omitempty"`
+	// DeploymentStatus is the deployment status of the server, and PreviousDeploymentStatus is the one before it
+	// changed.
+	DeploymentStatus         string `json:"deploymentStatus,omitempty"`
+	PreviousDeploymentStatus string `json:"previousDeploymentStatus,omitempty"`
+}
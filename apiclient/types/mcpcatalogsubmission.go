@@ -0,0 +1,48 @@
+package types
+
+// MCPCatalogSubmissionPortalManifest configures the submission portal of a catalog, through which vendors without an
+// Obot account submit catalog entries for admins to review.
+type MCPCatalogSubmissionPortalManifest struct {
+	// RequireToken requires vendors to send the token of the portal in the X-Obot-Submission-Token header. Otherwise,
+	// anyone can submit entries.
+	RequireToken bool `json:"requireToken,omitempty"`
+	// SandboxTest deploys a temporary server from each submitted entry to list its tools, once an admin triages it.
+	SandboxTest bool `json:"sandboxTest,omitempty"`
+}
+
+// MCPCatalogSubmissionPortal is the submission portal of a catalog.
+type MCPCatalogSubmissionPortal struct {
+	MCPCatalogSubmissionPortalManifest
+	// URL is where vendors submit entries.
+	URL     string `json:"url"`
+	Enabled bool   `json:"enabled"`
+	// Token is the token that vendors submit entries with, if the portal requires one.
+	Token string `json:"token,omitempty"`
+}
+
+// MCPCatalogSubmissionRequest is a catalog entry that a vendor submits through the submission portal of a catalog.
+type MCPCatalogSubmissionRequest struct {
+	Manifest MCPServerCatalogEntryManifest `json:"manifest"`
+	// Message explains the submission to the reviewers.
+	Message string                    `json:"message,omitempty"`
+	Vendor  PendingCatalogEntryVendor `json:"vendor"`
+	// SandboxConfig and SandboxURL configure the temporary server of the sandbox test. They are stored as a credential
+	// until the sandbox test runs, and aren't shown to the reviewers.
+	SandboxConfig map[string]string `json:"sandboxConfig,omitempty"`
+	SandboxURL    string            `json:"sandboxURL,omitempty"`
+}
+
+// MCPCatalogSubmission is the status of an entry submitted through the submission portal of a catalog, as seen by its
+// vendor.
+type MCPCatalogSubmission struct {
+	ID        string                    `json:"id"`
+	CatalogID string                    `json:"catalogID"`
+	Created   Time                      `json:"created"`
+	State     PendingCatalogEntryState  `json:"state"`
+	Checks    PendingCatalogEntryChecks `json:"checks"`
+	// Comments are the messages of the reviewers of the entry.
+	Comments []PendingCatalogEntryComment `json:"comments,omitempty"`
+	// StatusToken is only returned when the entry is submitted. The vendor sends it in the
+	// X-Obot-Submission-Status-Token header to get the status of the submission.
+	StatusToken string `json:"statusToken,omitempty"`
+}
@@ -0,0 +1,35 @@
+package types
+
+import "encoding/json"
+
+// MCPElicitation is a request from an MCP server for input from the user while one of its tool calls is in progress.
+// The tool call is paused until the user responds or the elicitation expires.
+type MCPElicitation struct {
+	ID                   string `json:"id"`
+	MCPServerID          string `json:"mcpServerID"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName"`
+	Message              string `json:"message"`
+	// Mode is either "form", in which case the user should respond with content matching RequestedSchema,
+	// or "url", in which case the user should visit URL to provide the input out-of-band.
+	Mode string `json:"mode,omitempty"`
+	URL  string `json:"url,omitempty"`
+	// RequestedSchema is the JSON schema of the content requested from the user.
+	RequestedSchema json.RawMessage `json:"requestedSchema,omitempty"`
+	Created         Time            `json:"created"`
+	ExpiresAt       Time            `json:"expiresAt"`
+}
+
+type MCPElicitationAction string
+
+const (
+	MCPElicitationActionAccept  MCPElicitationAction = "accept"
+	MCPElicitationActionDecline MCPElicitationAction = "decline"
+	MCPElicitationActionCancel  MCPElicitationAction = "cancel"
+)
+
+// MCPElicitationResponse is the user's response to an MCPElicitation.
+type MCPElicitationResponse struct {
+	Action MCPElicitationAction `json:"action"`
+	// Content is the user's input, as a JSON object. It is only used when Action is "accept".
+	Content json.RawMessage `json:"content,omitempty"`
+}
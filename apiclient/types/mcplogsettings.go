@@ -0,0 +1,8 @@
+package types
+
+// MCPLogSettings configures the logs of MCP servers that are streamed to users.
+type MCPLogSettings struct {
+	// MaskSecrets redacts the configured credentials of a server, and strings that look like common kinds of
+	// credentials, from its logs. It is on by default.
+	MaskSecrets bool `json:"maskSecrets"`
+}
@@ -0,0 +1,48 @@
+package types
+
+// MCPOAuthTokenStatus is whether a user's stored OAuth token for an MCP server is expected to keep working.
+type MCPOAuthTokenStatus string
+
+const (
+	// MCPOAuthTokenStatusHealthy means the token is expected to keep working.
+	MCPOAuthTokenStatusHealthy MCPOAuthTokenStatus = "healthy"
+	// MCPOAuthTokenStatusExpiringSoon means the token can't be refreshed and expires soon, after which the user has to
+	// authenticate again.
+	MCPOAuthTokenStatusExpiringSoon MCPOAuthTokenStatus = "expiringSoon"
+	// MCPOAuthTokenStatusReauthenticationRequired means the token expired and can't be refreshed, or the authorization
+	// server reported that it is no longer valid.
+	MCPOAuthTokenStatusReauthenticationRequired MCPOAuthTokenStatus = "reauthenticationRequired"
+)
+
+// MCPOAuthTokenHealth is the health of a user's stored OAuth token for an MCP server. It doesn't include the token.
+type MCPOAuthTokenHealth struct {
+	MCPID  string              `json:"mcpID"`
+	URL    string              `json:"url,omitempty"`
+	Status MCPOAuthTokenStatus `json:"status"`
+	// Warning explains why the status isn't healthy.
+	Warning     string `json:"warning,omitempty"`
+	Expiry      *Time  `json:"expiry,omitempty"`
+	Refreshable bool   `json:"refreshable"`
+	// CheckedAt is when the authorization server last confirmed whether the token is valid. It is unset if the
+	// authorization server doesn't support checking tokens.
+	CheckedAt *Time `json:"checkedAt,omitempty"`
+}
+
+// MCPOAuthOverview is the health of the OAuth tokens that the current user has stored for MCP servers.
+type MCPOAuthOverview struct {
+	// NeedsAttention is the number of tokens that aren't healthy.
+	NeedsAttention int                   `json:"needsAttention"`
+	Items          []MCPOAuthTokenHealth `json:"items"`
+}
+
+// MCPServerReauthentication is an MCP server that the current user has to authenticate to again, or will have to soon.
+type MCPServerReauthentication struct {
+	MCPServerID string `json:"mcpServerID"`
+	// MCPServerInstanceID is set if the user connects to the multi-user server through an instance.
+	MCPServerInstanceID string              `json:"mcpServerInstanceID,omitempty"`
+	DisplayName         string              `json:"displayName,omitempty"`
+	ConnectURL          string              `json:"connectURL,omitempty"`
+	Token               MCPOAuthTokenHealth `json:"token"`
+}
+
+type MCPServerReauthenticationList List[MCPServerReauthentication]
@@ -0,0 +1,53 @@
+package types
+
+// MCPOAuthFailureCategory is the reason that an OAuth flow or token refresh with an MCP server failed.
+type MCPOAuthFailureCategory string
+
+const (
+	// MCPOAuthFailureCategoryProvider means the authorization server returned an error to the callback, such as
+	// access_denied or invalid_scope. The code is the error returned.
+	MCPOAuthFailureCategoryProvider MCPOAuthFailureCategory = "provider"
+	// MCPOAuthFailureCategoryTokenExchange means the authorization server rejected the authorization code, such as
+	// with invalid_client or invalid_grant. The code is the error returned, if any.
+	MCPOAuthFailureCategoryTokenExchange MCPOAuthFailureCategory = "tokenExchange"
+	// MCPOAuthFailureCategoryUnreachable means the token endpoint of the authorization server couldn't be reached.
+	MCPOAuthFailureCategoryUnreachable MCPOAuthFailureCategory = "unreachable"
+	// MCPOAuthFailureCategoryStorage means the token couldn't be saved.
+	MCPOAuthFailureCategoryStorage MCPOAuthFailureCategory = "storage"
+	// MCPOAuthFailureCategoryRefresh means an expired token couldn't be refreshed, so the user had to authorize again.
+	MCPOAuthFailureCategoryRefresh MCPOAuthFailureCategory = "refresh"
+)
+
+// MCPOAuthFailure is the number of OAuth failures of a category and code, with the last error message.
+type MCPOAuthFailure struct {
+	Category    MCPOAuthFailureCategory `json:"category"`
+	Code        string                  `json:"code,omitempty"`
+	Count       int64                   `json:"count"`
+	LastError   string                  `json:"lastError,omitempty"`
+	LastErrorAt *Time                   `json:"lastErrorAt,omitempty"`
+}
+
+// MCPOAuthTelemetry is the number of each outcome of the OAuth flows with an MCP server. Flows that were initiated
+// but neither completed nor failed were abandoned by the user or are still in progress.
+type MCPOAuthTelemetry struct {
+	MCPID string `json:"mcpID"`
+	// UserID is only set when the report is filtered by user.
+	UserID        string `json:"userID,omitempty"`
+	Initiated     int64  `json:"initiated"`
+	Completed     int64  `json:"completed"`
+	Failed        int64  `json:"failed"`
+	Refreshed     int64  `json:"refreshed"`
+	RefreshFailed int64  `json:"refreshFailed"`
+	Rejected      int64  `json:"rejected"`
+	// AverageExchangeMs is the average time that the authorization server took to exchange authorization codes.
+	AverageExchangeMs float64           `json:"averageExchangeMs"`
+	Failures          []MCPOAuthFailure `json:"failures"`
+}
+
+// MCPOAuthTelemetryReport is the OAuth telemetry of each MCP server in the days overlapping a time range, ordered by
+// the number of failures.
+type MCPOAuthTelemetryReport struct {
+	Start Time                `json:"start"`
+	End   Time                `json:"end"`
+	Items []MCPOAuthTelemetry `json:"items"`
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // Runtime represents the execution runtime type for MCP servers
@@ -16,6 +17,8 @@ const (
 	RuntimeContainerized Runtime = "containerized"
 	RuntimeRemote        Runtime = "remote"
 	RuntimeComposite     Runtime = "composite"
+	RuntimeStdio         Runtime = "stdio"
+	RuntimeSource        Runtime = "source"
 )
 
 // UVXRuntimeConfig represents configuration for UVX runtime (Python packages via uvx)
@@ -46,6 +49,27 @@ type ContainerizedRuntimeConfig struct {
 	DenyAllEgress *bool    `json:"denyAllEgress,omitempty"` // Optional: Deny all egress when network policy enforcement is enabled
 }
 
+// SourceRuntimeConfig represents configuration for source runtime (MCP servers that Obot builds into an image from their
+// source code, and then runs like containerized servers)
+type SourceRuntimeConfig struct {
+	Repository string   `json:"repository,omitempty"` // Git repository to build, required unless bundleURL is set
+	Ref        string   `json:"ref,omitempty"`        // Optional: Branch, tag, or commit of the repository to build, defaults to the default branch
+	BundleURL  string   `json:"bundleURL,omitempty"`  // URL of a .tar.gz bundle of the source code to build, required unless repository is set
+	ContextDir string   `json:"contextDir,omitempty"` // Optional: Directory of the repository or bundle to build, defaults to its root
+	Dockerfile string   `json:"dockerfile,omitempty"` // Optional: Dockerfile in the context directory, defaults to Dockerfile. Only used by Dockerfile builders.
+	Command    string   `json:"command,omitempty"`    // Optional: Override container command
+	Args       []string `json:"args,omitempty"`       // Optional: Container arguments
+	Port       int      `json:"port"`                 // Required: Container port
+	Path       string   `json:"path"`                 // Required: HTTP path for MCP endpoint
+}
+
+// StdioRuntimeConfig represents configuration for stdio runtime (local subprocesses spawned by Obot)
+type StdioRuntimeConfig struct {
+	Command string   `json:"command"`        // Required: Command to run
+	Args    []string `json:"args,omitempty"` // Optional: Additional arguments
+	Cwd     string   `json:"cwd,omitempty"`  // Optional: Working directory for the command
+}
+
 // RemoteRuntimeConfig represents configuration for remote runtime (External MCP servers)
 type RemoteRuntimeConfig struct {
 	URL                 string      `json:"url"`                           // Required: Full URL to remote MCP server
@@ -101,8 +125,73 @@ func (c CatalogComponentServer) ComponentID() string {
 
 type CompositeRuntimeConfig struct {
 	ComponentServers []ComponentServer `json:"componentServers"`
+	// ToolNamespace controls how the tools of components without a ToolPrefix are namespaced, so that tools with the
+	// same name in different components don't conflict.
+	ToolNamespace ToolNamespace `json:"toolNamespace,omitempty"`
+}
+
+// CompositeComponentsPatch adds and removes components of a composite server. Components that are added replace the
+// components with the same component IDs, and the others are appended. Remove contains the component IDs of the
+// components to remove.
+type CompositeComponentsPatch struct {
+	Add    []ComponentServer `json:"add,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
+}
+
+// CompositeToolConflicts is the result of checking the tools of a composite server's components for names that more
+// than one component exposes.
+type CompositeToolConflicts struct {
+	Conflicts []CompositeToolConflict `json:"conflicts"`
+	// Unchecked are the enabled components whose tools are unknown, because they don't have tool previews.
+	Unchecked []string `json:"unchecked,omitempty"`
+}
+
+// CompositeToolConflict is a tool name that more than one component of a composite server exposes.
+type CompositeToolConflict struct {
+	Name  string                      `json:"name"`
+	Tools []CompositeToolConflictTool `json:"tools"`
+}
+
+// CompositeToolConflictTool is a component's tool that is exposed under a conflicting name.
+type CompositeToolConflictTool struct {
+	ComponentID string `json:"componentID"`
+	// Tool is the name of the tool in the component, before its overrides and prefix are applied.
+	Tool string `json:"tool"`
 }
 
+// MCPServerConnectBlocker is a reason that a client can't connect to an MCP server yet.
+type MCPServerConnectBlocker string
+
+const (
+	// MCPServerConnectBlockerMissingConfiguration means that required environment variables or headers have not been
+	// set, on the server or on one of the enabled components of a composite server.
+	MCPServerConnectBlockerMissingConfiguration MCPServerConnectBlocker = "MissingConfiguration"
+	// MCPServerConnectBlockerNeedsURL means that the server's URL doesn't match the hostname of its catalog entry.
+	MCPServerConnectBlockerNeedsURL MCPServerConnectBlocker = "NeedsURL"
+	// MCPServerConnectBlockerMissingOAuthCredentials means that an admin has not configured the static OAuth
+	// credentials that the server requires.
+	MCPServerConnectBlockerMissingOAuthCredentials MCPServerConnectBlocker = "MissingOAuthCredentials"
+	// MCPServerConnectBlockerDeploymentFailed means that the server's deployment is unavailable or failing
+	// permanently.
+	MCPServerConnectBlockerDeploymentFailed MCPServerConnectBlocker = "DeploymentFailed"
+	// MCPServerConnectBlockerInMaintenance means that the window of the server's maintenance notice is in progress.
+	MCPServerConnectBlockerInMaintenance MCPServerConnectBlocker = "InMaintenance"
+)
+
+// maxComponentToolPrefixLength is the length limit of the tool prefixes derived from component names. It matches the
+// limit of the ToolPrefix of components.
+const maxComponentToolPrefixLength = 64
+
+// ToolNamespace is how a composite server namespaces the tools of its components.
+type ToolNamespace string
+
+const (
+	// ToolNamespaceNone exposes the tools of components without a ToolPrefix under their own names.
+	ToolNamespaceNone ToolNamespace = ""
+	// ToolNamespaceComponent prefixes the tools of components without a ToolPrefix with the component's name.
+	ToolNamespaceComponent ToolNamespace = "component"
+)
+
 type ComponentServer struct {
 	// CatalogEntryID if set, reference the catalog entry the component server is sourced from
 	CatalogEntryID string `json:"catalogEntryID,omitempty"`
@@ -128,6 +217,37 @@ func (c ComponentServer) ComponentID() string {
 	return c.MCPServerID
 }
 
+// EffectiveToolPrefix returns the prefix that the composite server applies to the names of the component's tools.
+// It is the component's ToolPrefix if it has one. Otherwise, if the namespace is ToolNamespaceComponent, it is the
+// component's name, lowercased with other characters than letters, digits, dots, and dashes replaced, followed by an
+// underscore.
+func (c ComponentServer) EffectiveToolPrefix(namespace ToolNamespace) string {
+	if c.ToolPrefix != "" || namespace != ToolNamespaceComponent {
+		return c.ToolPrefix
+	}
+
+	name := c.Manifest.Name
+	if name == "" {
+		name = c.ComponentID()
+	}
+
+	prefix := strings.Trim(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, strings.ToLower(name)), "_")
+	if len(prefix) > maxComponentToolPrefixLength-1 {
+		prefix = prefix[:maxComponentToolPrefixLength-1]
+	}
+	if prefix == "" {
+		return ""
+	}
+	return prefix + "_"
+}
+
 type MCPServerCatalogEntry struct {
 	Metadata
 	Manifest                  MCPServerCatalogEntryManifest `json:"manifest"`
@@ -137,10 +257,19 @@ type MCPServerCatalogEntry struct {
 	UserCount                 int                           `json:"userCount,omitempty"`
 	LastUpdated               *Time                         `json:"lastUpdated,omitempty"`
 	ToolPreviewsLastGenerated *Time                         `json:"toolPreviewsLastGenerated,omitempty"`
-	PowerUserWorkspaceID      string                        `json:"powerUserWorkspaceID,omitempty"`
-	PowerUserID               string                        `json:"powerUserID,omitempty"`
-	NeedsUpdate               bool                          `json:"needsUpdate,omitempty"`
-	OAuthCredentialConfigured bool                          `json:"oauthCredentialConfigured,omitempty"`
+	// ToolPreviewsError is why the tool previews couldn't be generated automatically the last time the entry changed.
+	ToolPreviewsError         string                `json:"toolPreviewsError,omitempty"`
+	PowerUserWorkspaceID      string                `json:"powerUserWorkspaceID,omitempty"`
+	PowerUserID               string                `json:"powerUserID,omitempty"`
+	NeedsUpdate               bool                  `json:"needsUpdate,omitempty"`
+	OAuthCredentialConfigured bool                  `json:"oauthCredentialConfigured,omitempty"`
+	Conditions                []Condition           `json:"conditions,omitempty"`
+	MaintenanceNotice         *MCPMaintenanceNotice `json:"maintenanceNotice,omitempty"`
+	InMaintenance             bool                  `json:"inMaintenance,omitempty"`
+	Revision                  int                   `json:"revision,omitempty"`
+	// OAuthScopeChanges are the OAuth apps that an update to the entry requests new scopes for. It is only set in the
+	// response to the update.
+	OAuthScopeChanges []MCPOAuthScopeChange `json:"oauthScopeChanges,omitempty"`
 }
 
 type MCPServerCatalogEntryManifest struct {
@@ -151,6 +280,8 @@ type MCPServerCatalogEntryManifest struct {
 	Icon             string            `json:"icon"`
 	RepoURL          string            `json:"repoURL,omitempty"`
 	ToolPreview      []MCPServerTool   `json:"toolPreview,omitempty"`
+	// Tags are free-form labels for finding the server. Categories are set in the categories metadata instead.
+	Tags []string `json:"tags,omitempty"`
 
 	// Runtime configuration
 	Runtime Runtime `json:"runtime"`
@@ -161,6 +292,8 @@ type MCPServerCatalogEntryManifest struct {
 	ContainerizedConfig *ContainerizedRuntimeConfig `json:"containerizedConfig,omitempty"`
 	RemoteConfig        *RemoteCatalogConfig        `json:"remoteConfig,omitempty"`
 	CompositeConfig     *CompositeCatalogConfig     `json:"compositeConfig,omitempty"`
+	StdioConfig         *StdioRuntimeConfig         `json:"stdioConfig,omitempty"`
+	SourceConfig        *SourceRuntimeConfig        `json:"sourceConfig,omitempty"`
 
 	// MultiUserConfig is the multi-user specific configuration for this component server, if applicable.
 	MultiUserConfig *MultiUserConfig `json:"multiUserConfig,omitempty"`
@@ -170,6 +303,155 @@ type MCPServerCatalogEntryManifest struct {
 	// StartupTimeout configures the timeout to start and connect to an MCP Server. When unset, it defaults to 60s.
 	// The maximum allowed value is 600s (10 minutes). Attempting to set a higher value will cause an error.
 	StartupTimeoutSeconds int `json:"startupTimeoutSeconds,omitempty"`
+
+	// ToolPolicy restricts which tools can be listed and called on every server created from this catalog entry.
+	// It is enforced by the MCP gateway, regardless of any per-project tool selections.
+	ToolPolicy *MCPToolPolicy `json:"toolPolicy,omitempty"`
+
+	// Sampling controls whether servers created from this catalog entry may request LLM completions from Obot.
+	Sampling *MCPSamplingConfig `json:"sampling,omitempty"`
+
+	// ConnectSettings overrides the server-wide stream settings of the connect URL for servers created from this catalog entry.
+	ConnectSettings *MCPConnectSettings `json:"connectSettings,omitempty"`
+
+	// RequestTimeouts overrides the server-wide timeouts of the requests Obot makes to servers created from this catalog entry.
+	RequestTimeouts *MCPRequestTimeouts `json:"requestTimeouts,omitempty"`
+
+	// Presets are named configurations that users can pick from when creating a server from this catalog entry.
+	Presets []MCPConfigurationPreset `json:"presets,omitempty"`
+
+	// DefaultTools are the tools that are enabled when a server created from this catalog entry is added to a project
+	// and the catalog-default tool selection policy applies. When empty, all tools are enabled.
+	DefaultTools []string `json:"defaultTools,omitempty"`
+
+	// OAuthScopeGroups are the OAuth scopes that the tools of remote servers created from this catalog entry need.
+	// When set, users are only asked to grant the scopes of the groups that have a tool allowed for their server,
+	// instead of the scopes that the server requests.
+	OAuthScopeGroups []MCPOAuthScopeGroup `json:"oauthScopeGroups,omitempty"`
+
+	// ToolCosts are the estimated costs of calling the tools of servers created from this catalog entry. They are added
+	// up in the MCP usage report and the details of the runs that call the tools.
+	ToolCosts []MCPToolCost `json:"toolCosts,omitempty"`
+}
+
+// ToolCost returns the cost annotation of a tool, if there is one.
+func (m MCPServerCatalogEntryManifest) ToolCost(tool string) (MCPToolCost, bool) {
+	for _, cost := range m.ToolCosts {
+		if cost.Tool == tool {
+			return cost, true
+		}
+	}
+	return MCPToolCost{}, false
+}
+
+// Categories returns the categories of the catalog entry, from the comma-separated categories metadata.
+func (m MCPServerCatalogEntryManifest) Categories() []string {
+	var categories []string
+	for category := range strings.SplitSeq(m.Metadata["categories"], ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// RequiresConfiguration returns whether users have to provide configuration, such as required environment variables
+// or headers, before they can use a server created from the catalog entry. Composite entries always require it.
+func (m MCPServerCatalogEntryManifest) RequiresConfiguration() bool {
+	if m.Runtime == RuntimeComposite {
+		return true
+	}
+	for _, env := range m.Env {
+		if env.Required {
+			return true
+		}
+	}
+	if m.Runtime == RuntimeRemote && m.RemoteConfig != nil {
+		for _, header := range m.RemoteConfig.Headers {
+			if header.Required {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MCPConfigurationPreset is a named, pre-filled configuration for servers created from a catalog entry.
+// Presets never contain sensitive values; users still provide those when configuring the server.
+type MCPConfigurationPreset struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Env contains values for the non-sensitive environment variables and headers of the catalog entry, keyed by their keys.
+	Env map[string]string `json:"env,omitempty"`
+	// URL is the default URL for remote catalog entries that require users to provide a URL.
+	URL string `json:"url,omitempty"`
+	// Tools are the tools that are enabled when a server created with this preset is added to a project.
+	// When empty, all tools are enabled.
+	Tools []string `json:"tools,omitempty"`
+}
+
+// MCPOAuthScopeGroup is a group of tools of a remote MCP server and the OAuth scopes that they need.
+type MCPOAuthScopeGroup struct {
+	Name string `json:"name"`
+	// Description is shown to users on the consent page before they continue to the authorization server.
+	Description string `json:"description,omitempty"`
+	// Tools are the names of the tools of the group. The scopes of a group without tools are always requested.
+	Tools  []string `json:"tools,omitempty"`
+	Scopes []string `json:"scopes"`
+}
+
+// MCPToolCost is the estimated cost of one call of a tool.
+type MCPToolCost struct {
+	Tool string `json:"tool"`
+	// Credits are the API credits of the upstream service that a call uses.
+	Credits float64 `json:"credits,omitempty"`
+	// USDPerCall is the price of a call in US dollars.
+	USDPerCall float64 `json:"usdPerCall,omitempty"`
+	// RateLimitWeight is how many requests a call counts as against the upstream service's rate limits.
+	RateLimitWeight int `json:"rateLimitWeight,omitempty"`
+}
+
+// MCPToolPolicy is an allowlist/denylist of tool name patterns. Patterns support the `*` and `?` wildcards, e.g. `delete_*`.
+// A tool is allowed if it does not match any deny pattern and either the allow list is empty or it matches an allow pattern.
+type MCPToolPolicy struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
+// MCPSamplingConfig controls how sampling/createMessage requests from an MCP server are handled.
+// When sampling is enabled, requests are routed through Obot's model providers and the token usage is
+// attributed to the user of the server.
+type MCPSamplingConfig struct {
+	// Enabled allows the server to request LLM completions. Sampling requests are rejected when this is false.
+	Enabled bool `json:"enabled,omitempty"`
+	// AllowedModels restricts the models the server may use, by model ID or default model alias name.
+	// Model hints from the server are honored only if they match an allowed model. The first allowed model is used otherwise.
+	// When empty, the default "llm" model alias is used.
+	AllowedModels []string `json:"allowedModels,omitempty"`
+}
+
+// MCPConnectSettings tunes the event streams that clients open through an MCP server's connect URL, for example
+// when clients connect through proxies that drop idle or long-lived connections.
+// A value of 0 uses the server-wide default and a value of -1 disables the setting.
+type MCPConnectSettings struct {
+	// PingIntervalSeconds is the interval between keep-alive pings sent on open event streams.
+	PingIntervalSeconds int `json:"pingIntervalSeconds,omitempty"`
+	// IdleTimeoutSeconds is how long a session without requests or open streams is kept before it is closed.
+	IdleTimeoutSeconds int `json:"idleTimeoutSeconds,omitempty"`
+	// MaxSessionDurationSeconds is how long an event stream, or a session using the SSE transport, is kept open
+	// before it is closed and the client has to reconnect.
+	MaxSessionDurationSeconds int `json:"maxSessionDurationSeconds,omitempty"`
+}
+
+// MCPRequestTimeouts sets how long Obot waits for an MCP server to respond to a request.
+// A value of 0 uses the server-wide default and a value of -1 disables the timeout.
+type MCPRequestTimeouts struct {
+	// ToolsListSeconds is the timeout of tools/list requests.
+	ToolsListSeconds int `json:"toolsListSeconds,omitempty"`
+	// ToolsCallSeconds is the timeout of tools/call requests.
+	ToolsCallSeconds int `json:"toolsCallSeconds,omitempty"`
+	// ResourcesReadSeconds is the timeout of resources/read requests.
+	ResourcesReadSeconds int `json:"resourcesReadSeconds,omitempty"`
 }
 
 // ToolOverride defines how a single component tool is exposed by the composite server
@@ -206,8 +488,41 @@ type MCPHeader struct {
 	Sensitive bool   `json:"sensitive"`
 	Required  bool   `json:"required"`
 	Prefix    string `json:"prefix,omitempty"` // Optional prefix to prepend to user-supplied values (e.g., "Bearer ")
+
+	// For values sourced from the user's OAuth token.
+	// The current token is injected in place of a user-supplied value and is refreshed automatically.
+	OAuthTokenSource *MCPOAuthTokenSource `json:"oauthTokenSource,omitempty"`
+}
+
+// MCPOAuthTokenSource declares that a header or environment variable value is the user's access token for an OAuth app registered in Obot.
+type MCPOAuthTokenSource struct {
+	// OAuthApp is the alias of the OAuth app.
+	OAuthApp string `json:"oauthApp"`
+	// Scopes are the scopes to request when the user authorizes the OAuth app.
+	Scopes []string `json:"scopes,omitempty"`
 }
 
+// MCPOAuthTokenSourceStatus reports whether the user has authorized an OAuth app that an MCP server sources values from.
+type MCPOAuthTokenSourceStatus struct {
+	OAuthApp      string   `json:"oauthApp"`
+	Keys          []string `json:"keys"`
+	Authenticated bool     `json:"authenticated"`
+	// MissingScopes are the scopes that the server requests and the user's token wasn't granted, such as after the
+	// server started requesting new scopes. The user has to authorize the OAuth app again to consent to them.
+	MissingScopes []string `json:"missingScopes,omitempty"`
+}
+
+// MCPOAuthScopeChange is an OAuth app that an update to a catalog entry requests new scopes for.
+type MCPOAuthScopeChange struct {
+	OAuthApp    string   `json:"oauthApp"`
+	AddedScopes []string `json:"addedScopes"`
+	// AffectedUsers is the number of users who authorized the OAuth app without the added scopes, and who are asked
+	// to consent to them the next time they use the server.
+	AffectedUsers int `json:"affectedUsers"`
+}
+
+type MCPOAuthTokenSourceStatusList List[MCPOAuthTokenSourceStatus]
+
 type MCPEnv struct {
 	MCPHeader `json:",inline"`
 	File      bool `json:"file"`
@@ -215,9 +530,30 @@ type MCPEnv struct {
 	// server does not need to be restarted for changes to this file to be picked up.
 	// Ignored if File is false.
 	DynamicFile bool `json:"dynamicFile,omitempty"`
+	// ValueFrom references a secret in an external secret manager, such as vault:secret/data/app#token, that is
+	// resolved when the server is deployed instead of being supplied by the user. Only catalog entries can set it.
+	ValueFrom string `json:"valueFrom,omitempty"`
+}
+
+type MCPServerCatalogEntryList struct {
+	Items []MCPServerCatalogEntry `json:"items"`
+	// Total is the number of entries that match the filters, before the limit and offset are applied.
+	Total int `json:"total"`
+	// Continue is the token to pass as the continue query parameter to get the next page. It is empty on the last page.
+	Continue string `json:"continue,omitempty"`
+	// Facets counts the entries by each value that they can be filtered by. It is only set when requested.
+	Facets *MCPServerCatalogEntryFacets `json:"facets,omitempty"`
 }
 
-type MCPServerCatalogEntryList List[MCPServerCatalogEntry]
+// MCPServerCatalogEntryFacets counts catalog entries by the values that they can be filtered by. The counts include
+// every entry that the user can see and that matches the search query, regardless of the other filters of the request.
+type MCPServerCatalogEntryFacets struct {
+	Runtimes       map[Runtime]int `json:"runtimes"`
+	Categories     map[string]int  `json:"categories"`
+	Tags           map[string]int  `json:"tags"`
+	RequiresConfig int             `json:"requiresConfig"`
+	MultiUser      int             `json:"multiUser"`
+}
 
 type MCPServerManifest struct {
 	Metadata         map[string]string `json:"metadata,omitempty"`
@@ -236,6 +572,8 @@ type MCPServerManifest struct {
 	ContainerizedConfig *ContainerizedRuntimeConfig `json:"containerizedConfig,omitempty"`
 	RemoteConfig        *RemoteRuntimeConfig        `json:"remoteConfig,omitempty"`
 	CompositeConfig     *CompositeRuntimeConfig     `json:"compositeConfig,omitempty"`
+	StdioConfig         *StdioRuntimeConfig         `json:"stdioConfig,omitempty"`
+	SourceConfig        *SourceRuntimeConfig        `json:"sourceConfig,omitempty"`
 
 	// Multi-user specific configuration
 	MultiUserConfig *MultiUserConfig `json:"multiUserConfig,omitempty"`
@@ -250,6 +588,15 @@ type MCPServerManifest struct {
 
 	IdleShutdownIntervalHours int `json:"idleShutdownIntervalHours,omitempty"`
 	StartupTimeoutSeconds     int `json:"startupTimeoutSeconds,omitempty"`
+
+	// Sampling controls whether this server may request LLM completions from Obot.
+	Sampling *MCPSamplingConfig `json:"sampling,omitempty"`
+
+	// ConnectSettings overrides the server-wide stream settings of this server's connect URL.
+	ConnectSettings *MCPConnectSettings `json:"connectSettings,omitempty"`
+
+	// RequestTimeouts overrides the server-wide timeouts of the requests Obot makes to this server.
+	RequestTimeouts *MCPRequestTimeouts `json:"requestTimeouts,omitempty"`
 }
 
 type MCPServer struct {
@@ -270,10 +617,36 @@ type MCPServer struct {
 	ConnectURL              string   `json:"connectURL,omitempty"`
 	NanobotAgentID          string   `json:"nanobotAgentID,omitempty"`
 
+	// ConnectAlias is a vanity hostname, optionally followed by a path, that routes to this multi-user server.
+	ConnectAlias string `json:"connectAlias,omitempty"`
+	// ExternalExposure exposes this multi-user server directly on a custom hostname, without going through Obot.
+	ExternalExposure *MCPServerExternalExposure `json:"externalExposure,omitempty"`
+	// ExternalURL is the URL that clients connect to this server at directly when it is exposed on a custom hostname.
+	ExternalURL string `json:"externalURL,omitempty"`
+
+	// MaintenanceNotice is the planned outage of this server, or of its catalog entry, if one is scheduled.
+	MaintenanceNotice *MCPMaintenanceNotice `json:"maintenanceNotice,omitempty"`
+	// InMaintenance indicates whether the window of the maintenance notice is in progress.
+	InMaintenance bool `json:"inMaintenance,omitempty"`
+
+	// ReadyToConnect indicates whether a client can connect to the server: it is configured, has the OAuth
+	// credentials it requires, and is not failing or in maintenance. ConnectBlockers are the reasons if it isn't.
+	ReadyToConnect  bool                      `json:"readyToConnect"`
+	ConnectBlockers []MCPServerConnectBlocker `json:"connectBlockers,omitempty"`
+
+	// ConfigurationPreset is the name of the catalog entry preset this server was created with, if any.
+	ConfigurationPreset string `json:"configurationPreset,omitempty"`
+
+	// PinnedCatalogEntryRevision is the revision of the catalog entry that this server is pinned to, if it is pinned.
+	// Pinned servers are only flagged for updates when their manifest differs from the pinned revision.
+	PinnedCatalogEntryRevision int `json:"pinnedCatalogEntryRevision,omitempty"`
+
 	// NeedsUpdate indicates whether the configuration in this server's catalog entry has drift from this server's configuration.
+	// Deprecated: use the DriftDetected condition instead.
 	NeedsUpdate bool `json:"needsUpdate,omitempty"`
 
 	// NeedsK8sUpdate indicates whether this server needs redeployment with new K8s settings
+	// Deprecated: use the K8sSettingsApplied condition instead.
 	NeedsK8sUpdate bool `json:"needsK8sUpdate,omitempty"`
 
 	// NeedsURL indicates whether the server's URL needs to be updated to match the catalog entry.
@@ -304,12 +677,55 @@ type MCPServer struct {
 	// K8sSettingsHash contains the hash of K8s settings this server was deployed with
 	K8sSettingsHash string `json:"k8sSettingsHash,omitempty"`
 
+	// LastHealthyTime is the last time the liveness prober found the server's deployment ready.
+	LastHealthyTime *Time `json:"lastHealthyTime,omitempty"`
+
+	// ConsecutiveProbeFailures is the number of liveness probes that failed in a row since the server was last healthy.
+	ConsecutiveProbeFailures int `json:"consecutiveProbeFailures,omitempty"`
+
+	// RestartCount is the number of times the containers of the server's deployment restarted, as of the last liveness probe.
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// DeploymentFailureReason is the reason that the server's deployment is failing permanently, such as CrashLoopBackOff,
+	// as of the last liveness probe.
+	DeploymentFailureReason string `json:"deploymentFailureReason,omitempty"`
+
+	// CredentialStatus is whether the owner's stored OAuth token for this single-user server is expected to keep
+	// working. It is unset if the owner has no token for the server.
+	CredentialStatus MCPOAuthTokenStatus `json:"credentialStatus,omitempty"`
+	// CredentialWarning explains why the CredentialStatus isn't healthy.
+	CredentialWarning string `json:"credentialWarning,omitempty"`
+	// CredentialExpiry is when the owner's stored OAuth token for this server expires, if it can't be refreshed.
+	CredentialExpiry *Time `json:"credentialExpiry,omitempty"`
+
+	// StaleSince is when this single-user server was flagged for not being used, if it is stale.
+	StaleSince *Time `json:"staleSince,omitempty"`
+	// StaleAction is what happens to this server at StaleActionTime if it is still not used by then.
+	StaleAction     MCPServerStaleAction `json:"staleAction,omitempty"`
+	StaleActionTime *Time                `json:"staleActionTime,omitempty"`
+
+	// MemoryUsageBytes is the size of the memories stored by this server, if it is an Obot-managed memory server.
+	MemoryUsageBytes int64 `json:"memoryUsageBytes,omitempty"`
+	// MemoryQuotaBytes is the maximum size of the memories of this memory server, or 0 if there is no limit.
+	MemoryQuotaBytes int64 `json:"memoryQuotaBytes,omitempty"`
+
+	// WorkspaceProjectID is the project that this server is bound to, if it is an Obot-managed workspace server.
+	WorkspaceProjectID string `json:"workspaceProjectID,omitempty"`
+	// WorkspaceUsageBytes is the size of the files of the project that this workspace server is bound to.
+	WorkspaceUsageBytes int64 `json:"workspaceUsageBytes,omitempty"`
+	// WorkspaceQuotaBytes is the maximum size of the files that this workspace server can write, or 0 if there is no
+	// limit.
+	WorkspaceQuotaBytes int64 `json:"workspaceQuotaBytes,omitempty"`
+
 	// Template indicates whether this MCP server is a template server.
 	// Template servers are hidden from user views and are used for creating project instances.
 	Template bool `json:"template,omitempty"`
 
 	// CompositeName is the name of the composite server that this MCP server is a component of, if there is one.
 	CompositeName string `json:"compositeName,omitempty"`
+
+	// Conditions contains the Ready, CredentialConfigured, DriftDetected, and K8sSettingsApplied conditions for this server.
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
 type DeploymentCondition struct {
@@ -327,7 +743,28 @@ type DeploymentCondition struct {
 	LastUpdateTime Time `json:"lastUpdateTime"`
 }
 
-type MCPServerList List[MCPServer]
+type MCPServerList struct {
+	Items []MCPServer `json:"items"`
+	// Total is the number of servers that match the search, before the limit and offset are applied.
+	Total int `json:"total,omitempty"`
+	// Continue is the token to pass as the continue query parameter to get the next page. It is empty on the last page.
+	Continue string `json:"continue,omitempty"`
+}
+
+type MCPServerWatchEventType string
+
+const (
+	MCPServerWatchEventCreated MCPServerWatchEventType = "created"
+	MCPServerWatchEventUpdated MCPServerWatchEventType = "updated"
+	MCPServerWatchEventDeleted MCPServerWatchEventType = "deleted"
+)
+
+// MCPServerWatchEvent is a change to one of a user's MCP servers, streamed by GET /api/mcp-servers/watch. The Server
+// of a deleted event only has its metadata.
+type MCPServerWatchEvent struct {
+	Type   MCPServerWatchEventType `json:"type"`
+	Server MCPServer               `json:"server"`
+}
 
 type MCPServerTool struct {
 	ID          string            `json:"id"`
@@ -359,6 +796,9 @@ type ProjectMCPServer struct {
 	NeedsUpdate             bool `json:"needsUpdate"`
 	NeedsK8sUpdate          bool `json:"needsK8sUpdate"`
 	MissingOAuthCredentials bool `json:"missingOAuthCredentials,omitempty"`
+
+	ReadyToConnect  bool                      `json:"readyToConnect"`
+	ConnectBlockers []MCPServerConnectBlocker `json:"connectBlockers,omitempty"`
 }
 
 type ProjectMCPServerList List[ProjectMCPServer]
@@ -422,6 +862,9 @@ func MapCatalogEntryToServer(catalogEntry MCPServerCatalogEntryManifest, userURL
 		Runtime:               catalogEntry.Runtime,
 		Env:                   catalogEntry.Env,
 		StartupTimeoutSeconds: catalogEntry.StartupTimeoutSeconds,
+		Sampling:              catalogEntry.Sampling,
+		ConnectSettings:       catalogEntry.ConnectSettings,
+		RequestTimeouts:       catalogEntry.RequestTimeouts,
 	}
 
 	// Handle runtime-specific mapping
@@ -475,6 +918,31 @@ func MapCatalogEntryToServer(catalogEntry MCPServerCatalogEntryManifest, userURL
 			DenyAllEgress: catalogEntry.ContainerizedConfig.DenyAllEgress,
 		}
 
+	case RuntimeStdio:
+		if catalogEntry.StdioConfig == nil {
+			return serverManifest, RuntimeValidationError{
+				Runtime: RuntimeStdio,
+				Field:   "stdioConfig",
+				Message: "stdio configuration is required for stdio runtime",
+			}
+		}
+		serverManifest.StdioConfig = &StdioRuntimeConfig{
+			Command: catalogEntry.StdioConfig.Command,
+			Args:    catalogEntry.StdioConfig.Args,
+			Cwd:     catalogEntry.StdioConfig.Cwd,
+		}
+
+	case RuntimeSource:
+		if catalogEntry.SourceConfig == nil {
+			return serverManifest, RuntimeValidationError{
+				Runtime: RuntimeSource,
+				Field:   "sourceConfig",
+				Message: "source configuration is required for source runtime",
+			}
+		}
+		sourceConfig := *catalogEntry.SourceConfig
+		serverManifest.SourceConfig = &sourceConfig
+
 	case RuntimeRemote:
 		if catalogEntry.RemoteConfig == nil {
 			return serverManifest, RuntimeValidationError{
@@ -519,6 +987,35 @@ func MapCatalogEntryToServer(catalogEntry MCPServerCatalogEntryManifest, userURL
 		remoteConfig.Headers = catalogEntry.RemoteConfig.Headers
 		remoteConfig.StaticOAuthRequired = catalogEntry.RemoteConfig.StaticOAuthRequired
 		serverManifest.RemoteConfig = remoteConfig
+
+	case RuntimeComposite:
+		// Composite catalog entries are nested in other composite catalog entries.
+		if catalogEntry.CompositeConfig == nil {
+			return serverManifest, RuntimeValidationError{
+				Runtime: RuntimeComposite,
+				Field:   "compositeConfig",
+				Message: "composite configuration is required for composite runtime",
+			}
+		}
+
+		compositeConfig := &CompositeRuntimeConfig{
+			ComponentServers: make([]ComponentServer, 0, len(catalogEntry.CompositeConfig.ComponentServers)),
+		}
+		for _, component := range catalogEntry.CompositeConfig.ComponentServers {
+			componentManifest, err := MapCatalogEntryToServer(component.Manifest, "", disableHostnameValidation)
+			if err != nil {
+				return serverManifest, err
+			}
+
+			compositeConfig.ComponentServers = append(compositeConfig.ComponentServers, ComponentServer{
+				CatalogEntryID: component.CatalogEntryID,
+				MCPServerID:    component.MCPServerID,
+				Manifest:       componentManifest,
+				ToolOverrides:  component.ToolOverrides,
+				ToolPrefix:     component.ToolPrefix,
+			})
+		}
+		serverManifest.CompositeConfig = compositeConfig
 	default:
 		return serverManifest, RuntimeValidationError{
 			Runtime: catalogEntry.Runtime,
@@ -587,6 +1084,217 @@ func ValidateURLHostname(u string, hostname string) error {
 	return nil
 }
 
+// MCPServerCloneRequest represents a request to duplicate a single-user MCP server.
+type MCPServerCloneRequest struct {
+	// Alias is the alias for the new server. If not set, the alias of the source server is used.
+	Alias string `json:"alias,omitempty"`
+	// UserID is the user that will own the new server. Only admins may clone a server for another user.
+	// If not set, the new server is owned by the requesting user.
+	UserID string `json:"userID,omitempty"`
+	// ProjectID, if set, is the project that the new server is added to. The project must belong to the new server's owner.
+	ProjectID string `json:"projectID,omitempty"`
+	// CopyConfiguration copies the non-sensitive environment variables and headers of the source server to the new server.
+	CopyConfiguration bool `json:"copyConfiguration,omitempty"`
+	// CopySensitiveConfiguration also copies the sensitive environment variables and headers. It is only allowed when
+	// the new server has the same owner as the source server, so that secrets are never copied to another user.
+	CopySensitiveConfiguration bool `json:"copySensitiveConfiguration,omitempty"`
+	// Configuration sets environment variables and headers of the new server, replacing any copied values. An empty
+	// value removes a copied value.
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+// MCPServerConnectAliasRequest sets the vanity hostname that routes to a multi-user MCP server.
+type MCPServerConnectAliasRequest struct {
+	// ConnectAlias is a hostname, optionally followed by a path, such as jira.mcp.example.com or mcp.example.com/jira.
+	// An empty value removes the alias.
+	ConnectAlias string `json:"connectAlias"`
+}
+
+// MCPServerExternalExposure exposes a multi-user MCP server directly on a custom hostname through an Ingress or a Gateway
+// API HTTPRoute that Obot generates, for clients that can't go through Obot's hostname. Requests are still
+// authenticated by the server's shim with tokens issued by Obot.
+type MCPServerExternalExposure struct {
+	// Hostname is the custom hostname of the server, such as jira.mcp.partner.example.com.
+	Hostname string `json:"hostname"`
+	// TLSSecretName is the secret with the TLS certificate of the hostname in the MCP namespace. When Obot is configured
+	// with a cert-manager issuer, the certificate is issued into this secret. Defaults to the name of the server with a
+	// -tls suffix. It is not used when servers are exposed through a Gateway, which terminates TLS itself.
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// MCPServerExternalExposureRequest sets or removes the custom hostname that a multi-user MCP server is exposed on.
+type MCPServerExternalExposureRequest struct {
+	// ExternalExposure is the exposure of the server. A nil value stops exposing the server.
+	ExternalExposure *MCPServerExternalExposure `json:"externalExposure"`
+}
+
+// MCPMaintenanceNotice is a planned outage of an MCP server or catalog entry. During the window, users are warned in the
+// results of their tool calls.
+type MCPMaintenanceNotice struct {
+	Message   string `json:"message"`
+	StartTime Time   `json:"startTime"`
+	EndTime   Time   `json:"endTime"`
+}
+
+func (n MCPMaintenanceNotice) Validate() error {
+	if strings.TrimSpace(n.Message) == "" {
+		return fmt.Errorf("message is required")
+	}
+	if n.StartTime.IsZero() || n.EndTime.IsZero() {
+		return fmt.Errorf("startTime and endTime are required")
+	}
+	if !n.EndTime.Time.After(n.StartTime.Time) {
+		return fmt.Errorf("endTime must be after startTime")
+	}
+	return nil
+}
+
+// ActiveAt returns true if t is within the window of the notice.
+func (n *MCPMaintenanceNotice) ActiveAt(t time.Time) bool {
+	return n != nil && !t.Before(n.StartTime.Time) && t.Before(n.EndTime.Time)
+}
+
+// Expired returns true if the window of the notice ended before t.
+func (n *MCPMaintenanceNotice) Expired(t time.Time) bool {
+	return n == nil || !t.Before(n.EndTime.Time)
+}
+
+// MCPServerStaleAction is what happens to a stale single-user MCP server at the end of the grace period.
+type MCPServerStaleAction string
+
+const (
+	MCPServerStaleActionNone     MCPServerStaleAction = "none"
+	MCPServerStaleActionShutdown MCPServerStaleAction = "shutdown"
+	MCPServerStaleActionDelete   MCPServerStaleAction = "delete"
+)
+
+func (a MCPServerStaleAction) Valid() bool {
+	switch a {
+	case MCPServerStaleActionNone, MCPServerStaleActionShutdown, MCPServerStaleActionDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// MCPServerStaleEventType is what happened to a stale single-user MCP server.
+type MCPServerStaleEventType string
+
+const (
+	// MCPServerStaleEventTypeFlagged is sent when a server is flagged for not being used.
+	MCPServerStaleEventTypeFlagged MCPServerStaleEventType = "flagged"
+	// MCPServerStaleEventTypeShutdown is sent when a stale server is shut down at the end of the grace period.
+	MCPServerStaleEventTypeShutdown MCPServerStaleEventType = "shutdown"
+	// MCPServerStaleEventTypeDeleted is sent when a stale server is deleted at the end of the grace period.
+	MCPServerStaleEventTypeDeleted MCPServerStaleEventType = "deleted"
+)
+
+// MCPServerStaleNotification is the body of the webhook requests sent about stale single-user MCP servers, so that
+// their owners can be notified.
+type MCPServerStaleNotification struct {
+	Type                 MCPServerStaleEventType `json:"type"`
+	MCPID                string                  `json:"mcpID"`
+	MCPServerDisplayName string                  `json:"mcpServerDisplayName,omitempty"`
+	UserID               string                  `json:"userID"`
+	UserEmail            string                  `json:"userEmail,omitempty"`
+	LastUsed             Time                    `json:"lastUsed"`
+	// Action and ActionTime are what will happen to a flagged server, and when, if it is still not used by then.
+	Action     MCPServerStaleAction `json:"action,omitempty"`
+	ActionTime *Time                `json:"actionTime,omitempty"`
+}
+
+// MCPServerFailureReport is the diagnostics report of an MCP server whose deployment is failing permanently. It is the
+// body of the requests that create tickets for such failures, or the data of the configured ticket template.
+type MCPServerFailureReport struct {
+	MCPID                     string `json:"mcpID"`
+	MCPServerDisplayName      string `json:"mcpServerDisplayName,omitempty"`
+	MCPServerCatalogEntryName string `json:"mcpServerCatalogEntryName,omitempty"`
+	MCPCatalogID              string `json:"mcpCatalogID,omitempty"`
+	PowerUserWorkspaceID      string `json:"powerUserWorkspaceID,omitempty"`
+	// UserID is the owner of the server.
+	UserID string `json:"userID,omitempty"`
+	// FailureReason is the reason that the deployment is failing, such as CrashLoopBackOff or ImagePullBackOff.
+	FailureReason            string `json:"failureReason"`
+	ConsecutiveProbeFailures int    `json:"consecutiveProbeFailures,omitempty"`
+	LastHealthyTime          *Time  `json:"lastHealthyTime,omitempty"`
+	// Summary is a one-line description of the failure, and Description is the whole report as plain text, for ticket
+	// templates.
+	Summary     string           `json:"summary"`
+	Description string           `json:"description"`
+	Details     MCPServerDetails `json:"details"`
+}
+
+// MCPRoot is a filesystem location that an MCP server may operate on, exposed to the server through the roots capability.
+type MCPRoot struct {
+	// URI must be a file:// URI.
+	URI  string `json:"uri"`
+	Name string `json:"name,omitempty"`
+}
+
+type MCPRootList List[MCPRoot]
+
+type MCPCompletionReferenceType string
+
+const (
+	MCPCompletionReferenceTypePrompt   MCPCompletionReferenceType = "ref/prompt"
+	MCPCompletionReferenceTypeResource MCPCompletionReferenceType = "ref/resource"
+)
+
+// MCPCompletionRequest asks an MCP server for completions of a prompt argument or resource template variable.
+type MCPCompletionRequest struct {
+	Ref      MCPCompletionReference `json:"ref"`
+	Argument MCPCompletionArgument  `json:"argument"`
+	// Arguments are the values of previously completed arguments, which the server may use to narrow its completions.
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+type MCPCompletionReference struct {
+	Type MCPCompletionReferenceType `json:"type"`
+	// Name is the name of the prompt, for prompt references.
+	Name string `json:"name,omitempty"`
+	// URI is the URI template of the resource, for resource references.
+	URI string `json:"uri,omitempty"`
+}
+
+type MCPCompletionArgument struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type MCPCompletionResult struct {
+	Values  []string `json:"values"`
+	Total   *int     `json:"total,omitempty"`
+	HasMore bool     `json:"hasMore,omitempty"`
+}
+
+// MCPResourceUpdate is sent to API clients subscribed to a resource when the MCP server reports that the resource changed.
+type MCPResourceUpdate struct {
+	MCPServerID string `json:"mcpServerID"`
+	URI         string `json:"uri"`
+	Time        Time   `json:"time"`
+}
+
+type MCPTransport string
+
+const (
+	MCPTransportStreamableHTTP MCPTransport = "streamable-http"
+	MCPTransportSSE            MCPTransport = "sse"
+)
+
+// MCPConnectSession is a session that a client has open with an MCP server through its connect URL.
+type MCPConnectSession struct {
+	ID         string       `json:"id"`
+	MCPID      string       `json:"mcpID"`
+	UserID     string       `json:"userID"`
+	Transport  MCPTransport `json:"transport"`
+	Created    Time         `json:"created"`
+	LastActive Time         `json:"lastActive"`
+	// Streaming is whether the client currently has an event stream open in the session.
+	Streaming bool `json:"streaming,omitempty"`
+}
+
+type MCPConnectSessionList List[MCPConnectSession]
+
 // MCPServerOAuthCredentialRequest represents a request to set OAuth credentials for an MCP server
 type MCPServerOAuthCredentialRequest struct {
 	ClientID     string `json:"clientID"`
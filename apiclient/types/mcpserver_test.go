@@ -273,6 +273,44 @@ func TestMapCatalogEntryToServer_DisableHostnameValidation(t *testing.T) {
 	}
 }
 
+func TestMapCatalogEntryToServer_Composite(t *testing.T) {
+	catalogEntry := MCPServerCatalogEntryManifest{
+		Name:    "Test Composite Server",
+		Runtime: RuntimeComposite,
+		CompositeConfig: &CompositeCatalogConfig{
+			ComponentServers: []CatalogComponentServer{
+				{
+					CatalogEntryID: "entry-1",
+					ToolPrefix:     "remote_",
+					Manifest: MCPServerCatalogEntryManifest{
+						Runtime: RuntimeRemote,
+						RemoteConfig: &RemoteCatalogConfig{
+							FixedURL: "https://api.example.com/mcp",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := MapCatalogEntryToServer(catalogEntry, "", false)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.CompositeConfig == nil || len(result.CompositeConfig.ComponentServers) != 1 {
+		t.Fatalf("Expected one component server, got %v", result.CompositeConfig)
+	}
+
+	component := result.CompositeConfig.ComponentServers[0]
+	if component.CatalogEntryID != "entry-1" || component.ToolPrefix != "remote_" {
+		t.Errorf("Expected component entry-1 with prefix remote_, got %s with prefix %s", component.CatalogEntryID, component.ToolPrefix)
+	}
+	if component.Manifest.RemoteConfig == nil || component.Manifest.RemoteConfig.URL != "https://api.example.com/mcp" {
+		t.Errorf("Expected the component manifest to be mapped, got %v", component.Manifest.RemoteConfig)
+	}
+}
+
 // Hostname validation tests
 
 func TestValidateURLMatchesHostname(t *testing.T) {
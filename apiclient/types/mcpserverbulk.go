@@ -0,0 +1,38 @@
+package types
+
+import "encoding/json"
+
+type MCPServerBulkAction string
+
+const (
+	MCPServerBulkActionConfigure     MCPServerBulkAction = "configure"
+	MCPServerBulkActionLaunch        MCPServerBulkAction = "launch"
+	MCPServerBulkActionShutdown      MCPServerBulkAction = "shutdown"
+	MCPServerBulkActionDelete        MCPServerBulkAction = "delete"
+	MCPServerBulkActionTriggerUpdate MCPServerBulkAction = "trigger-update"
+)
+
+// MCPServerBulkRequest performs the same action on each of a list of MCP servers.
+type MCPServerBulkRequest struct {
+	Action    MCPServerBulkAction `json:"action"`
+	ServerIDs []string            `json:"serverIDs"`
+	// Configuration is what the configure action sets on every server, in the same form as the body of the configure
+	// endpoint of a single server.
+	Configuration json.RawMessage `json:"configuration,omitempty"`
+}
+
+// MCPServerBulkResponse is the result of a bulk action on MCP servers, with a result for each server in the order of
+// the request.
+type MCPServerBulkResponse struct {
+	Succeeded int                   `json:"succeeded"`
+	Failed    int                   `json:"failed"`
+	Items     []MCPServerBulkResult `json:"items"`
+}
+
+// MCPServerBulkResult is the result of a bulk action on one MCP server.
+type MCPServerBulkResult struct {
+	ServerID string `json:"serverID"`
+	// StatusCode is the HTTP status code that the action would have had if it were performed on this server alone.
+	StatusCode int    `json:"statusCode"`
+	Error      string `json:"error,omitempty"`
+}
@@ -0,0 +1,36 @@
+package types
+
+import "encoding/json"
+
+// MCPServerCatalogEntryRevision is a snapshot of the manifest of a catalog entry, taken each time the manifest changes.
+type MCPServerCatalogEntryRevision struct {
+	Metadata
+	CatalogEntryID string                        `json:"catalogEntryID"`
+	Revision       int                           `json:"revision"`
+	Manifest       MCPServerCatalogEntryManifest `json:"manifest"`
+	// Changes contains the changes to the manifest since the previous revision.
+	Changes []MCPManifestChange `json:"changes,omitempty"`
+}
+
+type MCPServerCatalogEntryRevisionList List[MCPServerCatalogEntryRevision]
+
+// MCPManifestChange is a change to a top-level field of a manifest. Before is empty for added fields, and After is
+// empty for removed fields.
+type MCPManifestChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// MCPServerUpdatePreview contains the changes that updating an MCP server from its catalog entry would make.
+type MCPServerUpdatePreview struct {
+	// Revision is the revision of the catalog entry that the server would be updated to.
+	Revision int                 `json:"revision,omitempty"`
+	Changes  []MCPManifestChange `json:"changes"`
+}
+
+// MCPServerPinRequest pins an MCP server to a revision of its catalog entry. A revision of 0 unpins the server so
+// that it follows the latest revision.
+type MCPServerPinRequest struct {
+	Revision int `json:"revision"`
+}
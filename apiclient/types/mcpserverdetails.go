@@ -12,11 +12,64 @@ type MCPServerEvent struct {
 }
 
 type MCPServerDetails struct {
-	DeploymentName string           `json:"deploymentName"`
-	Namespace      string           `json:"namespace"`
-	LastRestart    Time             `json:"lastRestart"`
-	ReadyReplicas  int32            `json:"readyReplicas"`
-	Replicas       int32            `json:"replicas"`
-	IsAvailable    bool             `json:"isAvailable"`
-	Events         []MCPServerEvent `json:"events"`
+	DeploymentName string `json:"deploymentName"`
+	Namespace      string `json:"namespace"`
+	LastRestart    Time   `json:"lastRestart"`
+	ReadyReplicas  int32  `json:"readyReplicas"`
+	Replicas       int32  `json:"replicas"`
+	IsAvailable    bool   `json:"isAvailable"`
+	RestartCount   int32  `json:"restartCount"`
+	// FailureReason is the reason that a container of the deployment is failing permanently, such as CrashLoopBackOff
+	// or ImagePullBackOff, if it is.
+	FailureReason string           `json:"failureReason,omitempty"`
+	Events        []MCPServerEvent `json:"events"`
+	// Components are the statuses of the components of a composite server.
+	Components []MCPComponentServerDetails `json:"components,omitempty"`
+}
+
+// MCPComponentServerDetails is the deployment status and health of a component of a composite server.
+type MCPComponentServerDetails struct {
+	ComponentID string `json:"componentID"`
+	// MCPServerID is the ID of the server that the component runs as, or the multi-user server that it refers to.
+	MCPServerID string `json:"mcpServerID,omitempty"`
+	Name        string `json:"name,omitempty"`
+	// Disabled is whether the component is disabled in the composite server's configuration, in which case it is not
+	// deployed and its tools are not available.
+	Disabled         bool   `json:"disabled,omitempty"`
+	DeploymentStatus string `json:"deploymentStatus,omitempty"`
+	IsAvailable      bool   `json:"isAvailable"`
+	RestartCount     int32  `json:"restartCount,omitempty"`
+	FailureReason    string `json:"failureReason,omitempty"`
+	LastHealthyTime  *Time  `json:"lastHealthyTime,omitempty"`
+}
+
+// MCPCompositeComponentFailure is a component of a composite server that failed to launch.
+type MCPCompositeComponentFailure struct {
+	ComponentID string `json:"componentID"`
+	MCPServerID string `json:"mcpServerID,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Error       string `json:"error"`
+}
+
+// MCPCompositeLaunchResult is the response to launching a composite server with degraded components allowed. The
+// components that failed to launch are disabled, and the tools of the rest are available.
+type MCPCompositeLaunchResult struct {
+	DisabledComponents []MCPCompositeComponentFailure `json:"disabledComponents"`
+}
+
+type MCPServerLaunchState string
+
+const (
+	MCPServerLaunchStateQueued    MCPServerLaunchState = "queued"
+	MCPServerLaunchStateDeploying MCPServerLaunchState = "deploying"
+	MCPServerLaunchStateReady     MCPServerLaunchState = "ready"
+	MCPServerLaunchStateFailed    MCPServerLaunchState = "failed"
+)
+
+// MCPServerLaunchStatus is the status of the last asynchronous launch of an MCP server.
+type MCPServerLaunchStatus struct {
+	State MCPServerLaunchState `json:"state"`
+	// QueuePosition is the position of the server's deployment in the deployment queue, starting at 1, while it is queued.
+	QueuePosition int    `json:"queuePosition,omitempty"`
+	Error         string `json:"error,omitempty"`
 }
@@ -0,0 +1,15 @@
+package types
+
+// MCPServerHealthStatus is the health of an MCP server as seen by Obot.
+type MCPServerHealthStatus string
+
+const (
+	// MCPServerHealthReady means the server responded to a ping.
+	MCPServerHealthReady MCPServerHealthStatus = "ready"
+	// MCPServerHealthAuthRequired means the server requires the user to authenticate before it can be used.
+	MCPServerHealthAuthRequired MCPServerHealthStatus = "auth_required"
+	// MCPServerHealthUnhealthy means the server is misconfigured or did not respond to a ping.
+	MCPServerHealthUnhealthy MCPServerHealthStatus = "unhealthy"
+	// MCPServerHealthNotDeployed means the server is not running. It is deployed the next time it is used.
+	MCPServerHealthNotDeployed MCPServerHealthStatus = "not_deployed"
+)
@@ -20,6 +20,36 @@ type MCPServerInstance struct {
 	ConnectURL string `json:"connectURL,omitempty"`
 	// MultiUserConfig is the multi-user configuration for this instance, which is copied from the MCP server's manifest. This will be nil if the MCP server does not have multi-user config.
 	MultiUserConfig *MultiUserConfig `json:"multiUserConfig,omitempty"`
+	// ToolAllowlist contains the tool name patterns that can be listed and called through this instance, if it is restricted.
+	ToolAllowlist []string `json:"toolAllowlist,omitempty"`
+	// Conditions contains the Ready and Synced conditions for this instance.
+	Conditions []Condition `json:"conditions,omitempty"`
+	// ReadyToConnect indicates whether the user can connect to the instance: its server is ready to connect, and the
+	// user has provided the headers that the server requires. ConnectBlockers are the reasons if they can't.
+	ReadyToConnect  bool                      `json:"readyToConnect"`
+	ConnectBlockers []MCPServerConnectBlocker `json:"connectBlockers,omitempty"`
 }
 
 type MCPServerInstanceList List[MCPServerInstance]
+
+// MCPServerInstanceToolAllowlist restricts the tools of an MCP server instance to those matching one of the patterns.
+// Patterns support the `*` and `?` wildcards. An empty allowlist removes the restriction.
+type MCPServerInstanceToolAllowlist struct {
+	Allow []string `json:"allow"`
+}
+
+// MCPServerInstanceOAuthState is the OAuth state of the user of an MCP server instance, for troubleshooting. It doesn't
+// include the tokens themselves.
+type MCPServerInstanceOAuthState struct {
+	MCPServerInstanceID string `json:"mcpServerInstanceID"`
+	UserID              string `json:"userID"`
+	// HasToken indicates whether an OAuth token is stored for the user.
+	HasToken        bool   `json:"hasToken"`
+	URL             string `json:"url,omitempty"`
+	Scopes          string `json:"scopes,omitempty"`
+	Expiry          *Time  `json:"expiry,omitempty"`
+	Expired         bool   `json:"expired"`
+	HasRefreshToken bool   `json:"hasRefreshToken"`
+	// PendingAuthorizations is the number of OAuth authorizations that the user started and hasn't finished.
+	PendingAuthorizations int64 `json:"pendingAuthorizations"`
+}
@@ -0,0 +1,43 @@
+package types
+
+// MCPServerScaffoldLanguage is the language of the template that a new MCP server project is scaffolded from.
+type MCPServerScaffoldLanguage string
+
+const (
+	MCPServerScaffoldLanguagePython     MCPServerScaffoldLanguage = "python"
+	MCPServerScaffoldLanguageTypeScript MCPServerScaffoldLanguage = "typescript"
+	MCPServerScaffoldLanguageGo         MCPServerScaffoldLanguage = "go"
+)
+
+// MCPServerScaffoldRequest describes a new MCP server project to scaffold.
+type MCPServerScaffoldRequest struct {
+	// Name is the name of the project, which has to be lowercase letters, numbers, and dashes.
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	Language    MCPServerScaffoldLanguage `json:"language"`
+	// Env is the configuration of the server. The project reads it from environment variables, and it is part of the
+	// catalog entry manifest that is generated with the project.
+	Env []MCPEnv `json:"env,omitempty"`
+	// Repository is the Git repository that the project is pushed to. If it isn't set, the project is returned as a
+	// zip archive.
+	Repository *MCPServerScaffoldRepository `json:"repository,omitempty"`
+}
+
+// MCPServerScaffoldRepository is the Git repository that a scaffolded project is pushed to.
+type MCPServerScaffoldRepository struct {
+	// URL is the HTTPS URL of the repository.
+	URL string `json:"url"`
+	// Branch is the branch that the project is pushed to, which must not exist yet. Defaults to main.
+	Branch string `json:"branch,omitempty"`
+	// Token is used as the password to push to the repository. It isn't stored.
+	Token string `json:"token,omitempty"`
+}
+
+// MCPServerScaffoldResult is the result of scaffolding a project into a Git repository.
+type MCPServerScaffoldResult struct {
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+	Commit     string `json:"commit"`
+	// CatalogEntryManifest is the manifest of a catalog entry that builds and runs the project with the source runtime.
+	CatalogEntryManifest MCPServerCatalogEntryManifest `json:"catalogEntryManifest"`
+}
@@ -0,0 +1,13 @@
+package types
+
+// MCPTeamCredential is a configuration of the servers of a catalog entry that the members of an auth provider group
+// share, so that they don't each have to configure the same API keys. It is used by the single-user servers of the
+// members that aren't configured, as long as an access control rule gives the group access to the catalog entry.
+type MCPTeamCredential struct {
+	// GroupID is the ID of the auth provider group.
+	GroupID string `json:"groupID"`
+	// ConfiguredKeys are the names of the configured environment variables and headers. The values are never returned.
+	ConfiguredKeys []string `json:"configuredKeys,omitempty"`
+}
+
+type MCPTeamCredentialList List[MCPTeamCredential]
@@ -0,0 +1,43 @@
+package types
+
+// MCPUsage is the number and duration of the tool calls that a user made to an MCP server, and the tokens used by the
+// server's sampling requests on the user's behalf.
+type MCPUsage struct {
+	UserID                   string  `json:"userID"`
+	MCPID                    string  `json:"mcpID"`
+	MCPServerDisplayName     string  `json:"mcpServerDisplayName,omitempty"`
+	ToolCalls                int64   `json:"toolCalls"`
+	ErrorCount               int64   `json:"errorCount"`
+	TotalDurationMs          int64   `json:"totalDurationMs"`
+	AverageDurationMs        float64 `json:"averageDurationMs"`
+	SamplingPromptTokens     int     `json:"samplingPromptTokens,omitempty"`
+	SamplingCompletionTokens int     `json:"samplingCompletionTokens,omitempty"`
+	EstimatedCostUSD         float64 `json:"estimatedCostUSD,omitempty"`
+	EstimatedCredits         float64 `json:"estimatedCredits,omitempty"`
+}
+
+// MCPProjectUsage is the estimated cost of the MCP tool calls that the agent runs of a project made.
+type MCPProjectUsage struct {
+	ProjectID        string  `json:"projectID"`
+	ToolCalls        int64   `json:"toolCalls"`
+	EstimatedCostUSD float64 `json:"estimatedCostUSD"`
+	EstimatedCredits float64 `json:"estimatedCredits"`
+}
+
+// MCPUsageReport is the usage of MCP servers in a month, per user and server.
+type MCPUsageReport struct {
+	// Month is the month of the report, in YYYY-MM format. Months start and end at midnight UTC.
+	Month string     `json:"month"`
+	Items []MCPUsage `json:"items"`
+	// Projects are the costs of the tool calls made by agent runs, per project. Other tool calls have no project.
+	Projects []MCPProjectUsage `json:"projects,omitempty"`
+}
+
+// RemainingMCPToolCalls is the number of MCP tool calls that a user can still make this month.
+type RemainingMCPToolCalls struct {
+	UserID    string `json:"userID"`
+	Limit     int64  `json:"limit,omitempty"`
+	ToolCalls int64  `json:"toolCalls"`
+	Remaining int64  `json:"remaining,omitempty"`
+	Unlimited bool   `json:"unlimited"`
+}
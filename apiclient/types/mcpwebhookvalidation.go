@@ -23,10 +23,30 @@ type MCPWebhookValidationManifest struct {
 	Selectors                     MCPSelectors             `json:"selectors,omitempty"`
 	AllowedToMutate               bool                     `json:"allowedToMutate,omitempty"`
 	Disabled                      bool                     `json:"disabled,omitempty"`
+	// Filter indicates that the webhook is a filter that may rewrite or reject tool call arguments and results, rather than only observe them.
+	// Filters are always allowed to mutate messages and are invoked synchronously by the MCP server shim.
+	// If no selectors are provided, a filter applies to all tool calls, in both directions.
+	Filter bool `json:"filter,omitempty"`
 }
 
 type MCPWebhookValidationList List[MCPWebhookValidation]
 
+// MCPWebhookSignatureScheme describes how requests to HTTP webhooks are signed with the webhook secret, so that
+// webhook authors can verify them.
+type MCPWebhookSignatureScheme struct {
+	Version   string `json:"version"`
+	Algorithm string `json:"algorithm"`
+	// SignedPayload is the format of the data that is signed.
+	SignedPayload   string `json:"signedPayload"`
+	TimestampHeader string `json:"timestampHeader"`
+	// SignatureHeader holds one or more comma-separated signatures, each prefixed with the version.
+	SignatureHeader string `json:"signatureHeader"`
+	// LegacySignatureHeader holds a signature of the body alone. It does not protect against replayed requests.
+	LegacySignatureHeader string `json:"legacySignatureHeader"`
+	// ToleranceSeconds is the recommended maximum age of a request's timestamp.
+	ToleranceSeconds int `json:"toleranceSeconds"`
+}
+
 type MCPSelectors []MCPSelector
 
 func (f MCPSelectors) Matches(method, identifier string) bool {
@@ -52,9 +72,24 @@ func (f MCPSelectors) Strings() []string {
 	return result
 }
 
+// MCPWebhookDirection is the direction of the messages a webhook is invoked for.
+type MCPWebhookDirection string
+
+const (
+	// MCPWebhookDirectionRequest invokes the webhook for messages sent to the MCP server, e.g. tool call arguments.
+	MCPWebhookDirectionRequest MCPWebhookDirection = "request"
+	// MCPWebhookDirectionResponse invokes the webhook for messages returned by the MCP server, e.g. tool call results.
+	MCPWebhookDirectionResponse MCPWebhookDirection = "response"
+)
+
+// DefaultFilterSelectors are the selectors used for filter webhooks that don't specify any.
+var DefaultFilterSelectors = MCPSelectors{{Method: "tools/call"}}
+
 type MCPSelector struct {
 	Method      string   `json:"method,omitempty"`
 	Identifiers []string `json:"identifiers,omitempty"`
+	// Direction limits the selector to requests or responses. An empty direction matches both.
+	Direction MCPWebhookDirection `json:"direction,omitempty"`
 }
 
 func (f *MCPSelector) Matches(method, identifier string) bool {
@@ -78,13 +113,25 @@ func (f MCPSelector) Strings() []string {
 		s = f.Method
 	}
 
+	var direction string
+	if f.Direction != "" {
+		direction = fmt.Sprintf("direction=%s", f.Direction)
+	}
+
 	if f.Identifiers == nil {
+		if direction != "" {
+			return []string{s + "?" + direction}
+		}
 		return []string{s}
 	}
 
 	result := make([]string, 0, len(f.Identifiers))
 	for _, id := range f.Identifiers {
-		result = append(result, fmt.Sprintf("%s?name=%s", s, id))
+		if direction != "" {
+			result = append(result, fmt.Sprintf("%s?%s&name=%s", s, direction, id))
+		} else {
+			result = append(result, fmt.Sprintf("%s?name=%s", s, id))
+		}
 	}
 
 	return result
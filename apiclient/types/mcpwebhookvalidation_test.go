@@ -419,3 +419,46 @@ func TestMCPFilter_Matches(t *testing.T) {
 		})
 	}
 }
+
+func TestMCPSelector_Strings(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector MCPSelector
+		expected []string
+	}{
+		{
+			name:     "empty selector",
+			selector: MCPSelector{},
+			expected: []string{"*"},
+		},
+		{
+			name:     "method with identifiers",
+			selector: MCPSelector{Method: "tools/call", Identifiers: []string{"tool1", "tool2"}},
+			expected: []string{"tools/call?name=tool1", "tools/call?name=tool2"},
+		},
+		{
+			name:     "method with direction",
+			selector: MCPSelector{Method: "tools/call", Direction: MCPWebhookDirectionResponse},
+			expected: []string{"tools/call?direction=response"},
+		},
+		{
+			name:     "method with direction and identifiers",
+			selector: MCPSelector{Method: "tools/call", Identifiers: []string{"tool1"}, Direction: MCPWebhookDirectionRequest},
+			expected: []string{"tools/call?direction=request&name=tool1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.selector.Strings()
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Strings() = %v, expected %v", got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("Strings()[%d] = %q, expected %q", i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
@@ -24,6 +24,11 @@ type OAuthClientManifest struct {
 	// Optional.
 	GrantTypes []string `json:"grant_types,omitempty"`
 
+	// AllowedAudiences are the mcp-connect URLs that the client can request tokens for with the client_credentials grant.
+	// Only clients created by admins can use the client_credentials grant.
+	// Optional.
+	AllowedAudiences []string `json:"allowed_audiences,omitempty"`
+
 	// ResponseTypes is an array of the OAuth 2.0 response type strings that the client can use at the authorization endpoint.
 	// If omitted, the default is that the client will use only the "code" response type.
 	// Optional.
@@ -107,4 +112,6 @@ type OAuthToken struct {
 	RefreshToken string `json:"refresh_token"`
 	ExpiresIn    int    `json:"expires_in"`
 	TokenType    string `json:"token_type"`
+	// IDToken is the OpenID Connect ID token, issued when the openid scope is granted.
+	IDToken string `json:"id_token,omitempty"`
 }
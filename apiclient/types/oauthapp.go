@@ -19,6 +19,57 @@ const (
 
 type OAuthAppType string
 
+const (
+	// OAuthAppTokenResponseFormatJSON is the standard OAuth 2.0 JSON token response.
+	OAuthAppTokenResponseFormatJSON OAuthAppTokenResponseFormat = "json"
+	// OAuthAppTokenResponseFormatForm is a token response with the standard fields in a URL-encoded form.
+	OAuthAppTokenResponseFormatForm OAuthAppTokenResponseFormat = "form"
+	// OAuthAppTokenResponseFormatSlack is Slack's token response, with user tokens in authed_user.
+	OAuthAppTokenResponseFormatSlack OAuthAppTokenResponseFormat = "slack"
+	// OAuthAppTokenResponseFormatSalesforce is Salesforce's token response, without expires_in and with the instance URL.
+	OAuthAppTokenResponseFormatSalesforce OAuthAppTokenResponseFormat = "salesforce"
+)
+
+// OAuthAppTokenResponseFormat is the format of the responses of a provider's token endpoint.
+type OAuthAppTokenResponseFormat string
+
+const (
+	// OAuthAppClientAuthMethodDefault sends the client credentials in the body of token requests, and also with basic
+	// auth when exchanging an authorization code.
+	OAuthAppClientAuthMethodDefault OAuthAppClientAuthMethod = ""
+	// OAuthAppClientAuthMethodBody only sends the client credentials in the body of token requests.
+	OAuthAppClientAuthMethodBody OAuthAppClientAuthMethod = "body"
+	// OAuthAppClientAuthMethodBasicAndBody sends the client credentials with basic auth and in the body of all token
+	// requests.
+	OAuthAppClientAuthMethodBasicAndBody OAuthAppClientAuthMethod = "basic_and_body"
+)
+
+// OAuthAppClientAuthMethod is how the client credentials are sent to a provider's token endpoint.
+type OAuthAppClientAuthMethod string
+
+// OAuthAppProvider describes how a provider deviates from standard OAuth 2.0, so that providers with quirks can be
+// used without code changes. Apps of the built-in types that don't set it use the quirks of their provider.
+type OAuthAppProvider struct {
+	// AuthorizeParams are added to the query of the authorization URL.
+	AuthorizeParams map[string]string `json:"authorizeParams,omitempty"`
+	// PassthroughAuthorizeParams are the query parameters that are copied from the authorize request, if they are set,
+	// to the authorization URL.
+	PassthroughAuthorizeParams []string `json:"passthroughAuthorizeParams,omitempty"`
+	// OmitEmptyScope leaves the scope out of the authorization URL when no scope is requested.
+	OmitEmptyScope bool `json:"omitEmptyScope,omitempty"`
+	// TokenParams are added to the body of the requests that exchange authorization codes for tokens.
+	TokenParams map[string]string `json:"tokenParams,omitempty"`
+	// RefreshOmitParams are the standard parameters, such as scope or redirect_uri, that are left out of refresh
+	// requests.
+	RefreshOmitParams []string `json:"refreshOmitParams,omitempty"`
+	// ClientAuthMethod is how the client credentials are sent to the token endpoint.
+	ClientAuthMethod OAuthAppClientAuthMethod `json:"clientAuthMethod,omitempty"`
+	// TokenResponseFormat is the format of the responses of the token endpoint. Defaults to json.
+	TokenResponseFormat OAuthAppTokenResponseFormat `json:"tokenResponseFormat,omitempty"`
+	// Extras are added to the credentials of the tokens, for tools that need them.
+	Extras map[string]string `json:"extras,omitempty"`
+}
+
 type OAuthApp struct {
 	Metadata
 	OAuthAppManifest
@@ -48,6 +99,9 @@ type OAuthAppManifest struct {
 	GitLabBaseURL string `json:"gitlabBaseURL,omitempty"`
 	// AuthorizationServerURL is the URL used in the MCP oauth flow
 	AuthorizationServerURL string `json:"authorizationServerURL,omitempty"`
+	// Provider overrides how Obot deals with the quirks of the app's provider. Apps of the built-in types that don't
+	// set it use the quirks of their provider.
+	Provider *OAuthAppProvider `json:"provider,omitempty"`
 }
 
 type OAuthAppList List[OAuthApp]
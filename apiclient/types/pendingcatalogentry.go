@@ -0,0 +1,100 @@
+package types
+
+// PendingCatalogEntryState is the state of the review of a pending catalog entry.
+type PendingCatalogEntryState string
+
+const (
+	PendingCatalogEntryStatePending  PendingCatalogEntryState = "pending"
+	PendingCatalogEntryStateApproved PendingCatalogEntryState = "approved"
+	PendingCatalogEntryStateRejected PendingCatalogEntryState = "rejected"
+)
+
+// PendingCatalogEntryManifest is a catalog entry, or an edit to one, that a user proposes for a catalog.
+type PendingCatalogEntryManifest struct {
+	// CatalogID is the catalog that the entry is proposed for. It defaults to the default catalog.
+	CatalogID string `json:"catalogID,omitempty"`
+	// CatalogEntryID is the entry that the manifest replaces when the submission is an edit. It is empty for new
+	// entries.
+	CatalogEntryID string                        `json:"catalogEntryID,omitempty"`
+	Manifest       MCPServerCatalogEntryManifest `json:"manifest"`
+	// Message explains the submission to the reviewers.
+	Message string `json:"message,omitempty"`
+}
+
+// PendingCatalogEntry is a catalog entry, or an edit to one, that is proposed by a user and reviewed by an admin.
+// Approving it creates or updates the catalog entry.
+type PendingCatalogEntry struct {
+	Metadata
+	PendingCatalogEntryManifest
+	// UserID is the user who submitted the entry.
+	UserID   string                       `json:"userID"`
+	State    PendingCatalogEntryState     `json:"state"`
+	Comments []PendingCatalogEntryComment `json:"comments,omitempty"`
+	// ReviewerID is the admin who approved or rejected the entry.
+	ReviewerID string `json:"reviewerID,omitempty"`
+	ReviewedAt *Time  `json:"reviewedAt,omitempty"`
+	// ApprovedCatalogEntryID is the catalog entry that was created or updated when the entry was approved.
+	ApprovedCatalogEntryID string `json:"approvedCatalogEntryID,omitempty"`
+	// Vendor is the vendor that submitted the entry through the submission portal of the catalog. UserID is empty for
+	// these entries.
+	Vendor *PendingCatalogEntryVendor `json:"vendor,omitempty"`
+	// Checks are the results of the automatic checks of entries submitted through the submission portal.
+	Checks *PendingCatalogEntryChecks `json:"checks,omitempty"`
+}
+
+type PendingCatalogEntryList List[PendingCatalogEntry]
+
+// PendingCatalogEntryComment is a comment from the submitter or a reviewer of a pending catalog entry.
+type PendingCatalogEntryComment struct {
+	UserID  string `json:"userID"`
+	Message string `json:"message"`
+	Created Time   `json:"created"`
+}
+
+// PendingCatalogEntryReview is the body of requests to comment on, approve, or reject a pending catalog entry. The
+// message is added as a comment.
+type PendingCatalogEntryReview struct {
+	Message string `json:"message,omitempty"`
+}
+
+// PendingCatalogEntryVendor is a vendor that submitted a catalog entry through the submission portal of a catalog.
+type PendingCatalogEntryVendor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	URL   string `json:"url,omitempty"`
+}
+
+// PendingCatalogEntryCheckState is the result of an automatic check of a submitted catalog entry.
+type PendingCatalogEntryCheckState string
+
+const (
+	// PendingCatalogEntryCheckStatePending means that the check runs once an admin triages the submission.
+	PendingCatalogEntryCheckStatePending PendingCatalogEntryCheckState = "pending"
+	PendingCatalogEntryCheckStateRunning PendingCatalogEntryCheckState = "running"
+	PendingCatalogEntryCheckStatePassed  PendingCatalogEntryCheckState = "passed"
+	PendingCatalogEntryCheckStateFailed  PendingCatalogEntryCheckState = "failed"
+	PendingCatalogEntryCheckStateSkipped PendingCatalogEntryCheckState = "skipped"
+)
+
+// PendingCatalogEntryCheck is the result of an automatic check of a submitted catalog entry.
+type PendingCatalogEntryCheck struct {
+	State PendingCatalogEntryCheckState `json:"state"`
+	// Message explains why the check failed or was skipped.
+	Message string `json:"message,omitempty"`
+	// Output is the output of the image scanner.
+	Output string `json:"output,omitempty"`
+}
+
+// PendingCatalogEntryChecks are the results of the automatic checks of a catalog entry submitted through the
+// submission portal of a catalog. Submissions that fail linting are rejected right away, so only the warnings of the
+// linter are recorded. The image scan and sandbox test only run once an admin triages the submission.
+type PendingCatalogEntryChecks struct {
+	LintWarnings []MCPServerCatalogEntryValidationIssue `json:"lintWarnings,omitempty"`
+	// ImageScan scans the image of containerized entries for vulnerabilities.
+	ImageScan PendingCatalogEntryCheck `json:"imageScan"`
+	// SandboxTest deploys a temporary server from the entry and lists its tools.
+	SandboxTest PendingCatalogEntryCheck `json:"sandboxTest"`
+	Tools       []MCPServerTool          `json:"tools,omitempty"`
+	// TriagedAt is when an admin last started the image scan and sandbox test.
+	TriagedAt *Time `json:"triagedAt,omitempty"`
+}
@@ -14,6 +14,7 @@ type Project struct {
 	TemplateLastUpgraded         *Time                        `json:"templateLastUpgraded,omitempty"`
 	TemplatePublicID             string                       `json:"templatePublicID,omitempty"`
 	LastUsedTime                 *Time                        `json:"lastUsedTime,omitempty"`
+	ToolRecertificationRequired  bool                         `json:"toolRecertificationRequired,omitempty"`
 }
 
 type WorkflowNamesFromIntegration struct {
@@ -0,0 +1,37 @@
+package types
+
+// ReconcileStats contains reconcile statistics for the MCP-related controller handlers.
+type ReconcileStats struct {
+	Handlers []ReconcileHandlerStats `json:"handlers"`
+}
+
+// ReconcileHandlerStats contains reconcile statistics for a single controller handler and object kind.
+type ReconcileHandlerStats struct {
+	// Handler is the name of the handler function.
+	Handler string `json:"handler"`
+	// Kind is the kind of object the handler reconciles.
+	Kind string `json:"kind"`
+	// Reconciles is the total number of times the handler has run since the server started.
+	Reconciles int64 `json:"reconciles"`
+	// Errors is the total number of times the handler has returned an error since the server started.
+	Errors int64 `json:"errors"`
+	// Backlog is the number of objects whose most recent reconcile failed and are waiting to be retried.
+	Backlog int `json:"backlog"`
+	// LastReconcileTime is the last time the handler ran.
+	LastReconcileTime *Time `json:"lastReconcileTime,omitempty"`
+	// FailingObjects contains the last error for each object currently in the backlog.
+	FailingObjects []ReconcileObjectError `json:"failingObjects,omitempty"`
+}
+
+// ReconcileObjectError describes the most recent reconcile error for a single object.
+type ReconcileObjectError struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Error     string `json:"error"`
+	// RetryCount is the number of consecutive failed reconciles for this object.
+	RetryCount int `json:"retryCount"`
+	// FirstFailureTime is the time of the first failure in the current run of consecutive failures.
+	FirstFailureTime Time `json:"firstFailureTime"`
+	// LastFailureTime is the time of the most recent failure.
+	LastFailureTime Time `json:"lastFailureTime"`
+}
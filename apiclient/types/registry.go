@@ -75,6 +75,8 @@ type RegistryOfficialMeta struct {
 type RegistryObotMeta struct {
 	ConfigurationRequired bool   `json:"configurationRequired,omitempty"`
 	ConfigurationMessage  string `json:"configurationMessage,omitempty"`
+	// ConnectBlockers are the reasons that the server isn't ready to connect to.
+	ConnectBlockers []MCPServerConnectBlocker `json:"connectBlockers,omitempty"`
 }
 
 type RegistryServerMeta struct {
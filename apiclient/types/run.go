@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/gptscript-ai/go-gptscript"
 )
 
@@ -16,6 +18,28 @@ type Run struct {
 	State          string `json:"state,omitempty"`
 	Output         string `json:"output,omitempty"`
 	Error          string `json:"error,omitempty"`
+	// MCPToolCalls are the MCP tools that the run called, from the audit logs. They are only included in the run's detail.
+	MCPToolCalls []RunMCPToolCall `json:"mcpToolCalls,omitempty"`
+	// EstimatedMCPToolCostUSD is the sum of the estimated costs of the MCP tool calls of the run.
+	EstimatedMCPToolCostUSD float64 `json:"estimatedMCPToolCostUSD,omitempty"`
+	// MCPToolCostWarning is set when the estimated cost of the MCP tool calls of the run exceeds the configured threshold.
+	MCPToolCostWarning string `json:"mcpToolCostWarning,omitempty"`
+}
+
+// RunMCPToolCall is an MCP tool call that a run made.
+type RunMCPToolCall struct {
+	AuditLogID           uint   `json:"auditLogID"`
+	Created              Time   `json:"created"`
+	MCPID                string `json:"mcpID"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName,omitempty"`
+	ToolName             string `json:"toolName"`
+	// Arguments are only included if the user is allowed to see the request bodies of the server's audit logs.
+	Arguments        json.RawMessage `json:"arguments,omitempty"`
+	ResponseStatus   int             `json:"responseStatus,omitempty"`
+	Error            string          `json:"error,omitempty"`
+	ProcessingTimeMs int64           `json:"processingTimeMs"`
+	EstimatedCostUSD float64         `json:"estimatedCostUSD,omitempty"`
+	EstimatedCredits float64         `json:"estimatedCredits,omitempty"`
 }
 
 type RunList List[Run]
@@ -56,4 +56,31 @@ type ThreadManifest struct {
 	Prompt          string              `json:"prompt"`
 	SharedTasks     []string            `json:"sharedTasks,omitempty"`
 	AllowedMCPTools map[string][]string `json:"allowedMCPTools,omitempty"`
+	// MCPToolSchemaModes are how the tools of the project's MCP servers are exposed to models, keyed the same way as
+	// AllowedMCPTools. Servers without a mode expose the full schemas of their tools.
+	MCPToolSchemaModes map[string]MCPToolSchemaMode `json:"mcpToolSchemaModes,omitempty"`
+}
+
+// MCPToolSchemaMode is how the tools of an MCP server are exposed to models, to reduce the context used by servers
+// with many tools.
+type MCPToolSchemaMode string
+
+const (
+	// MCPToolSchemaModeFull exposes every allowed tool with its full description and input schema.
+	MCPToolSchemaModeFull MCPToolSchemaMode = "full"
+	// MCPToolSchemaModeSummarized exposes every allowed tool with the first sentence of its description and of the
+	// descriptions of its arguments, without the documentation of nested arguments.
+	MCPToolSchemaModeSummarized MCPToolSchemaMode = "summarized"
+	// MCPToolSchemaModeLazy exposes two tools per server instead: one that returns the full schemas of the tools that the
+	// model asks for, and one that calls a tool by name. Only the names and summaries of the tools are in the context.
+	MCPToolSchemaModeLazy MCPToolSchemaMode = "lazy"
+)
+
+// Valid returns whether the mode is known. The empty mode is the same as the full mode.
+func (m MCPToolSchemaMode) Valid() bool {
+	switch m {
+	case "", MCPToolSchemaModeFull, MCPToolSchemaModeSummarized, MCPToolSchemaModeLazy:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,34 @@
+package types
+
+// ToolApprovalStatus is the state of the approval of the tools selected for an MCP server in a project.
+type ToolApprovalStatus string
+
+const (
+	// ToolApprovalApproved means the tools are approved and the approval is not about to expire.
+	ToolApprovalApproved ToolApprovalStatus = "approved"
+	// ToolApprovalExpiring means the approval expires soon and should be re-certified.
+	ToolApprovalExpiring ToolApprovalStatus = "expiring"
+	// ToolApprovalExpired means the approval expired and the tools are revoked until they are re-certified.
+	ToolApprovalExpired ToolApprovalStatus = "expired"
+)
+
+type ToolApproval struct {
+	ProjectID          string             `json:"projectID"`
+	ProjectMCPServerID string             `json:"projectMCPServerID"`
+	ApprovedBy         string             `json:"approvedBy,omitempty"`
+	ApprovedAt         *Time              `json:"approvedAt,omitempty"`
+	ExpiresAt          *Time              `json:"expiresAt,omitempty"`
+	Status             ToolApprovalStatus `json:"status"`
+}
+
+type ToolApprovalList List[ToolApproval]
+
+// ToolRecertificationRequest re-approves the tools selected for the given project MCP servers.
+type ToolRecertificationRequest struct {
+	Approvals []ToolApprovalReference `json:"approvals"`
+}
+
+type ToolApprovalReference struct {
+	ProjectID          string `json:"projectID"`
+	ProjectMCPServerID string `json:"projectMCPServerID"`
+}
@@ -30,16 +30,22 @@ const (
 
 	RoleUnknown Role = 0
 
-	GroupOwner                 = "owner"
-	GroupAdmin                 = "admin"
-	GroupAuditor               = "auditor"
-	GroupUserImpersonation     = "user-impersonation"
-	GroupPowerUserPlus         = "power-user-plus"
-	GroupPowerUser             = "power-user"
-	GroupBasic                 = "basic"
-	GroupAuthenticated         = "authenticated"
-	GroupAPIKey                = "api-key"
+	GroupOwner             = "owner"
+	GroupAdmin             = "admin"
+	GroupAuditor           = "auditor"
+	GroupUserImpersonation = "user-impersonation"
+	GroupPowerUserPlus     = "power-user-plus"
+	GroupPowerUser         = "power-user"
+	GroupBasic             = "basic"
+	GroupAuthenticated     = "authenticated"
+	GroupAPIKey            = "api-key"
+	// GroupOAuthClient is the only group of OAuth clients that authenticate with the client_credentials grant.
+	GroupOAuthClient           = "oauth-client"
 	APIKeySkillsAccessExtraKey = "api-key-can-access-skills"
+	// APIKeyMCPServerIDsExtraKey holds the IDs of the MCP servers that an API key can access, or "*" for all of them.
+	APIKeyMCPServerIDsExtraKey = "api-key-mcp-server-ids"
+	// APIKeyToolsExtraKey holds the tools that an API key is restricted to, as "<MCP server ID>/<tool>".
+	APIKeyToolsExtraKey = "api-key-tools"
 )
 
 type Role int
@@ -130,6 +136,7 @@ type User struct {
 	Internal                   bool     `json:"internal,omitempty"`
 	DailyPromptTokensLimit     int      `json:"dailyPromptTokensLimit,omitempty"`
 	DailyCompletionTokensLimit int      `json:"dailyCompletionTokensLimit,omitempty"`
+	MonthlyMCPToolCallLimit    *int     `json:"monthlyMCPToolCallLimit,omitempty"`
 	DisplayName                string   `json:"displayName,omitempty"`
 	DeletedAt                  *Time    `json:"deletedAt,omitempty"`
 	OriginalEmail              string   `json:"originalEmail,omitempty"`
@@ -0,0 +1,63 @@
+package types
+
+// UserActivityExportManifest describes the contents of an export of a user's MCP activity. It is the manifest.json
+// file of the archive.
+type UserActivityExportManifest struct {
+	UserID string `json:"userID"`
+	// StartTime and EndTime are the range of the activity in the export. A zero StartTime includes all activity up to
+	// EndTime. Server configurations and OAuth grants are exported as they are when the export is generated.
+	StartTime   Time   `json:"startTime"`
+	EndTime     Time   `json:"endTime"`
+	GeneratedAt Time   `json:"generatedAt"`
+	GeneratedBy string `json:"generatedBy"`
+	// WithRequestAndResponse is whether the audit logs include the bodies and headers of requests and responses. They
+	// are only included for auditors.
+	WithRequestAndResponse bool `json:"withRequestAndResponse"`
+	// Files counts the records in each file of the archive.
+	Files map[string]int `json:"files"`
+}
+
+// UserActivityExportServer is the configuration of an MCP server in an export of a user's MCP activity. Values of
+// sensitive configuration are masked.
+type UserActivityExportServer struct {
+	Server        MCPServer         `json:"server"`
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+// UserActivityExportServerInstance is a connection of a user to a multi-user MCP server in an export of their MCP
+// activity. The values of its configuration are always masked.
+type UserActivityExportServerInstance struct {
+	Instance      MCPServerInstance `json:"instance"`
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+// UserActivityExportSession is a session that a user had with an MCP server.
+type UserActivityExportSession struct {
+	ID           string `json:"id"`
+	MCPID        string `json:"mcpID"`
+	Created      Time   `json:"created"`
+	LastUsedTime *Time  `json:"lastUsedTime,omitempty"`
+}
+
+// UserActivityExportOAuth is the OAuth consent history of a user in an export of their MCP activity.
+type UserActivityExportOAuth struct {
+	// Grants are the MCP clients that the user authorized to connect to MCP servers through Obot.
+	Grants []UserActivityExportOAuthGrant `json:"grants"`
+	// Authorizations are the authorizations of MCP clients that the user started.
+	Authorizations []UserActivityExportOAuthGrant `json:"authorizations"`
+	// Tokens are the OAuth tokens that the user has stored for MCP servers. The tokens themselves are never exported.
+	Tokens []MCPOAuthTokenHealth `json:"tokens"`
+	// Activity counts the outcomes of the OAuth flows between the user and each MCP server in the range.
+	Activity []MCPOAuthTelemetry `json:"activity"`
+}
+
+// UserActivityExportOAuthGrant is an authorization of an MCP client by a user.
+type UserActivityExportOAuthGrant struct {
+	ClientID string `json:"clientID"`
+	MCPID    string `json:"mcpID,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+	Resource string `json:"resource,omitempty"`
+	Created  Time   `json:"created"`
+	// Completed is whether the authorization was completed. It is only set for authorizations.
+	Completed *bool `json:"completed,omitempty"`
+}
@@ -0,0 +1,25 @@
+package types
+
+// UserPurgeRequest confirms the purge of a user's personal data. A purge can't be undone.
+type UserPurgeRequest struct {
+	// Confirm must be the email address of the user that is purged.
+	Confirm string `json:"confirm"`
+}
+
+// UserPurgeReport is the result of a purge of a user's personal data.
+type UserPurgeReport struct {
+	UserID   string `json:"userID"`
+	PurgedAt Time   `json:"purgedAt"`
+	PurgedBy string `json:"purgedBy"`
+	// Deleted counts the records of the user that were deleted, by the kind of record.
+	Deleted map[string]int `json:"deleted"`
+	// Anonymized counts the records of the user that are kept for auditing without their personal data, by the kind of
+	// record.
+	Anonymized map[string]int `json:"anonymized"`
+	// Pending counts the objects of the user that are still being deleted, by the kind of object. The user's
+	// credentials are only deleted once nothing else is pending, because deleting some objects needs them.
+	Pending map[string]int `json:"pending,omitempty"`
+	// Complete is whether all of the user's personal data was removed. If it wasn't, the purge should be repeated once
+	// the pending objects are deleted.
+	Complete bool `json:"complete"`
+}
@@ -274,6 +274,166 @@ func (in *AgentManifest) DeepCopy() *AgentManifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertEvent) DeepCopyInto(out *AlertEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertEvent.
+func (in *AlertEvent) DeepCopy() *AlertEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertNotification) DeepCopyInto(out *AlertNotification) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]AlertEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertNotification.
+func (in *AlertNotification) DeepCopy() *AlertNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRule) DeepCopyInto(out *AlertRule) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.AlertRuleManifest.DeepCopyInto(&out.AlertRuleManifest)
+	if in.Firing != nil {
+		in, out := &in.Firing, &out.Firing
+		*out = make([]AlertRuleFiring, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastEvaluatedAt != nil {
+		in, out := &in.LastEvaluatedAt, &out.LastEvaluatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRule.
+func (in *AlertRule) DeepCopy() *AlertRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleActiveHours) DeepCopyInto(out *AlertRuleActiveHours) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleActiveHours.
+func (in *AlertRuleActiveHours) DeepCopy() *AlertRuleActiveHours {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleActiveHours)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleFiring) DeepCopyInto(out *AlertRuleFiring) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleFiring.
+func (in *AlertRuleFiring) DeepCopy() *AlertRuleFiring {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleFiring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleList) DeepCopyInto(out *AlertRuleList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleList.
+func (in *AlertRuleList) DeepCopy() *AlertRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleManifest) DeepCopyInto(out *AlertRuleManifest) {
+	*out = *in
+	if in.ActiveHours != nil {
+		in, out := &in.ActiveHours, &out.ActiveHours
+		*out = new(AlertRuleActiveHours)
+		**out = **in
+	}
+	if in.MCPServerIDs != nil {
+		in, out := &in.MCPServerIDs, &out.MCPServerIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Notification = in.Notification
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleManifest.
+func (in *AlertRuleManifest) DeepCopy() *AlertRuleManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleNotification) DeepCopyInto(out *AlertRuleNotification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleNotification.
+func (in *AlertRuleNotification) DeepCopy() *AlertRuleNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppPreferences) DeepCopyInto(out *AppPreferences) {
 	*out = *in
@@ -617,6 +777,112 @@ func (in *AzureConfig) DeepCopy() *AzureConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackstageEntity) DeepCopyInto(out *BackstageEntity) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackstageEntity.
+func (in *BackstageEntity) DeepCopy() *BackstageEntity {
+	if in == nil {
+		return nil
+	}
+	out := new(BackstageEntity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackstageEntityList) DeepCopyInto(out *BackstageEntityList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackstageEntity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackstageEntityList.
+func (in *BackstageEntityList) DeepCopy() *BackstageEntityList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackstageEntityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackstageEntityMetadata) DeepCopyInto(out *BackstageEntityMetadata) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]BackstageLink, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackstageEntityMetadata.
+func (in *BackstageEntityMetadata) DeepCopy() *BackstageEntityMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BackstageEntityMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackstageEntitySpec) DeepCopyInto(out *BackstageEntitySpec) {
+	*out = *in
+	if in.ProvidesAPIs != nil {
+		in, out := &in.ProvidesAPIs, &out.ProvidesAPIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackstageEntitySpec.
+func (in *BackstageEntitySpec) DeepCopy() *BackstageEntitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackstageEntitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackstageLink) DeepCopyInto(out *BackstageLink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackstageLink.
+func (in *BackstageLink) DeepCopy() *BackstageLink {
+	if in == nil {
+		return nil
+	}
+	out := new(BackstageLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatalogComponentServer) DeepCopyInto(out *CatalogComponentServer) {
 	*out = *in
@@ -742,6 +1008,33 @@ func (in *CompositeCatalogConfig) DeepCopy() *CompositeCatalogConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeComponentsPatch) DeepCopyInto(out *CompositeComponentsPatch) {
+	*out = *in
+	if in.Add != nil {
+		in, out := &in.Add, &out.Add
+		*out = make([]ComponentServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeComponentsPatch.
+func (in *CompositeComponentsPatch) DeepCopy() *CompositeComponentsPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeComponentsPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CompositeRuntimeConfig) DeepCopyInto(out *CompositeRuntimeConfig) {
 	*out = *in
@@ -765,88 +1058,300 @@ func (in *CompositeRuntimeConfig) DeepCopy() *CompositeRuntimeConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerizedRuntimeConfig) DeepCopyInto(out *ContainerizedRuntimeConfig) {
+func (in *CompositeToolConflict) DeepCopyInto(out *CompositeToolConflict) {
 	*out = *in
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.EgressDomains != nil {
-		in, out := &in.EgressDomains, &out.EgressDomains
-		*out = make([]string, len(*in))
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]CompositeToolConflictTool, len(*in))
 		copy(*out, *in)
 	}
-	if in.DenyAllEgress != nil {
-		in, out := &in.DenyAllEgress, &out.DenyAllEgress
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerizedRuntimeConfig.
-func (in *ContainerizedRuntimeConfig) DeepCopy() *ContainerizedRuntimeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeToolConflict.
+func (in *CompositeToolConflict) DeepCopy() *CompositeToolConflict {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerizedRuntimeConfig)
+	out := new(CompositeToolConflict)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Credential) DeepCopyInto(out *Credential) {
+func (in *CompositeToolConflictTool) DeepCopyInto(out *CompositeToolConflictTool) {
 	*out = *in
-	if in.EnvVars != nil {
-		in, out := &in.EnvVars, &out.EnvVars
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credential.
-func (in *Credential) DeepCopy() *Credential {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeToolConflictTool.
+func (in *CompositeToolConflictTool) DeepCopy() *CompositeToolConflictTool {
 	if in == nil {
 		return nil
 	}
-	out := new(Credential)
+	out := new(CompositeToolConflictTool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CredentialList) DeepCopyInto(out *CredentialList) {
+func (in *CompositeToolConflicts) DeepCopyInto(out *CompositeToolConflicts) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Credential, len(*in))
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]CompositeToolConflict, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Unchecked != nil {
+		in, out := &in.Unchecked, &out.Unchecked
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialList.
-func (in *CredentialList) DeepCopy() *CredentialList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeToolConflicts.
+func (in *CompositeToolConflicts) DeepCopy() *CompositeToolConflicts {
 	if in == nil {
 		return nil
 	}
-	out := new(CredentialList)
+	out := new(CompositeToolConflicts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJob) DeepCopyInto(out *CronJob) {
+func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.CronJobManifest.DeepCopyInto(&out.CronJobManifest)
-	if in.LastRunStartedAt != nil {
-		in, out := &in.LastRunStartedAt, &out.LastRunStartedAt
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerizedRuntimeConfig) DeepCopyInto(out *ContainerizedRuntimeConfig) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressDomains != nil {
+		in, out := &in.EgressDomains, &out.EgressDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DenyAllEgress != nil {
+		in, out := &in.DenyAllEgress, &out.DenyAllEgress
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerizedRuntimeConfig.
+func (in *ContainerizedRuntimeConfig) DeepCopy() *ContainerizedRuntimeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerizedRuntimeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Credential) DeepCopyInto(out *Credential) {
+	*out = *in
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credential.
+func (in *Credential) DeepCopy() *Credential {
+	if in == nil {
+		return nil
+	}
+	out := new(Credential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialBackup) DeepCopyInto(out *CredentialBackup) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]CredentialBackupEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialBackup.
+func (in *CredentialBackup) DeepCopy() *CredentialBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialBackupEntry) DeepCopyInto(out *CredentialBackupEntry) {
+	*out = *in
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialBackupEntry.
+func (in *CredentialBackupEntry) DeepCopy() *CredentialBackupEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialBackupEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialBackupRequest) DeepCopyInto(out *CredentialBackupRequest) {
+	*out = *in
+	if in.MCPServerIDs != nil {
+		in, out := &in.MCPServerIDs, &out.MCPServerIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialBackupRequest.
+func (in *CredentialBackupRequest) DeepCopy() *CredentialBackupRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialBackupRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialList) DeepCopyInto(out *CredentialList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Credential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialList.
+func (in *CredentialList) DeepCopy() *CredentialList {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRestoreEntry) DeepCopyInto(out *CredentialRestoreEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRestoreEntry.
+func (in *CredentialRestoreEntry) DeepCopy() *CredentialRestoreEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRestoreEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRestoreRequest) DeepCopyInto(out *CredentialRestoreRequest) {
+	*out = *in
+	in.Backup.DeepCopyInto(&out.Backup)
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]CredentialRestoreEntry, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRestoreRequest.
+func (in *CredentialRestoreRequest) DeepCopy() *CredentialRestoreRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRestoreRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRestoreResult) DeepCopyInto(out *CredentialRestoreResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRestoreResult.
+func (in *CredentialRestoreResult) DeepCopy() *CredentialRestoreResult {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRestoreResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRestoreResultList) DeepCopyInto(out *CredentialRestoreResultList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CredentialRestoreResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRestoreResultList.
+func (in *CredentialRestoreResultList) DeepCopy() *CredentialRestoreResultList {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRestoreResultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJob) DeepCopyInto(out *CronJob) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.CronJobManifest.DeepCopyInto(&out.CronJobManifest)
+	if in.LastRunStartedAt != nil {
+		in, out := &in.LastRunStartedAt, &out.LastRunStartedAt
 		*out = (*in).DeepCopy()
 	}
 	if in.LastSuccessfulRunCompleted != nil {
@@ -926,6 +1431,76 @@ func (in *CustomS3Config) DeepCopy() *CustomS3Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseChange) DeepCopyInto(out *DatabaseChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseChange.
+func (in *DatabaseChange) DeepCopy() *DatabaseChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseMigration) DeepCopyInto(out *DatabaseMigration) {
+	*out = *in
+	in.AppliedAt.DeepCopyInto(&out.AppliedAt)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]DatabaseChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseMigration.
+func (in *DatabaseMigration) DeepCopy() *DatabaseMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseMigrationStatus) DeepCopyInto(out *DatabaseMigrationStatus) {
+	*out = *in
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]DatabaseChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]DatabaseMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]SchemaTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseMigrationStatus.
+func (in *DatabaseMigrationStatus) DeepCopy() *DatabaseMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultModelAlias) DeepCopyInto(out *DefaultModelAlias) {
 	*out = *in
@@ -1882,44 +2457,87 @@ func (in *K8sSettingsStatus) DeepCopy() *K8sSettingsStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KnowledgeFile) DeepCopyInto(out *KnowledgeFile) {
+func (in *KeyRotation) DeepCopyInto(out *KeyRotation) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	if in.Approved != nil {
-		in, out := &in.Approved, &out.Approved
-		*out = new(bool)
-		**out = **in
-	}
-	if in.LastIngestionStartTime != nil {
-		in, out := &in.LastIngestionStartTime, &out.LastIngestionStartTime
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
 		*out = (*in).DeepCopy()
 	}
-	if in.LastIngestionEndTime != nil {
-		in, out := &in.LastIngestionEndTime, &out.LastIngestionEndTime
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
 		*out = (*in).DeepCopy()
 	}
-	if in.LastRunIDs != nil {
-		in, out := &in.LastRunIDs, &out.LastRunIDs
-		*out = make([]string, len(*in))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]KeyRotationResource, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnowledgeFile.
-func (in *KnowledgeFile) DeepCopy() *KnowledgeFile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRotation.
+func (in *KeyRotation) DeepCopy() *KeyRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(KnowledgeFile)
+	out := new(KeyRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KnowledgeFileList) DeepCopyInto(out *KnowledgeFileList) {
+func (in *KeyRotationResource) DeepCopyInto(out *KeyRotationResource) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRotationResource.
+func (in *KeyRotationResource) DeepCopy() *KeyRotationResource {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRotationResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnowledgeFile) DeepCopyInto(out *KnowledgeFile) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.Approved != nil {
+		in, out := &in.Approved, &out.Approved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LastIngestionStartTime != nil {
+		in, out := &in.LastIngestionStartTime, &out.LastIngestionStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastIngestionEndTime != nil {
+		in, out := &in.LastIngestionEndTime, &out.LastIngestionEndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRunIDs != nil {
+		in, out := &in.LastRunIDs, &out.LastRunIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnowledgeFile.
+func (in *KnowledgeFile) DeepCopy() *KnowledgeFile {
+	if in == nil {
+		return nil
+	}
+	out := new(KnowledgeFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnowledgeFileList) DeepCopyInto(out *KnowledgeFileList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
 		*out = make([]KnowledgeFile, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
@@ -2183,6 +2801,11 @@ func (in *MCPCatalog) DeepCopyInto(out *MCPCatalog) {
 			(*out)[key] = val
 		}
 	}
+	if in.SyncConflicts != nil {
+		in, out := &in.SyncConflicts, &out.SyncConflicts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalog.
@@ -2196,298 +2819,2040 @@ func (in *MCPCatalog) DeepCopy() *MCPCatalog {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalogList) DeepCopyInto(out *MCPCatalogList) {
+func (in *MCPCatalogBundle) DeepCopyInto(out *MCPCatalogBundle) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MCPCatalog, len(*in))
+	in.Catalog.DeepCopyInto(&out.Catalog)
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]MCPCatalogBundleEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]MCPCatalogBundleServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessControlRules != nil {
+		in, out := &in.AccessControlRules, &out.AccessControlRules
+		*out = make([]MCPCatalogBundleAccessControlRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogList.
-func (in *MCPCatalogList) DeepCopy() *MCPCatalogList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundle.
+func (in *MCPCatalogBundle) DeepCopy() *MCPCatalogBundle {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalogList)
+	out := new(MCPCatalogBundle)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalogManifest) DeepCopyInto(out *MCPCatalogManifest) {
+func (in *MCPCatalogBundleAccessControlRule) DeepCopyInto(out *MCPCatalogBundleAccessControlRule) {
 	*out = *in
-	if in.SourceURLs != nil {
-		in, out := &in.SourceURLs, &out.SourceURLs
-		*out = make([]string, len(*in))
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]Subject, len(*in))
 		copy(*out, *in)
 	}
-	if in.SourceURLCredentials != nil {
-		in, out := &in.SourceURLCredentials, &out.SourceURLCredentials
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]MCPCatalogBundleResource, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogManifest.
-func (in *MCPCatalogManifest) DeepCopy() *MCPCatalogManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundleAccessControlRule.
+func (in *MCPCatalogBundleAccessControlRule) DeepCopy() *MCPCatalogBundleAccessControlRule {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalogManifest)
+	out := new(MCPCatalogBundleAccessControlRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPEnv) DeepCopyInto(out *MCPEnv) {
+func (in *MCPCatalogBundleCatalog) DeepCopyInto(out *MCPCatalogBundleCatalog) {
 	*out = *in
-	out.MCPHeader = in.MCPHeader
+	if in.SourceURLs != nil {
+		in, out := &in.SourceURLs, &out.SourceURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPEnv.
-func (in *MCPEnv) DeepCopy() *MCPEnv {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundleCatalog.
+func (in *MCPCatalogBundleCatalog) DeepCopy() *MCPCatalogBundleCatalog {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPEnv)
+	out := new(MCPCatalogBundleCatalog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPHeader) DeepCopyInto(out *MCPHeader) {
+func (in *MCPCatalogBundleChange) DeepCopyInto(out *MCPCatalogBundleChange) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPHeader.
-func (in *MCPHeader) DeepCopy() *MCPHeader {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundleChange.
+func (in *MCPCatalogBundleChange) DeepCopy() *MCPCatalogBundleChange {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPHeader)
+	out := new(MCPCatalogBundleChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPPromptReadStats) DeepCopyInto(out *MCPPromptReadStats) {
+func (in *MCPCatalogBundleEntry) DeepCopyInto(out *MCPCatalogBundleEntry) {
 	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPromptReadStats.
-func (in *MCPPromptReadStats) DeepCopy() *MCPPromptReadStats {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundleEntry.
+func (in *MCPCatalogBundleEntry) DeepCopy() *MCPCatalogBundleEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPPromptReadStats)
+	out := new(MCPCatalogBundleEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPResourceReadStats) DeepCopyInto(out *MCPResourceReadStats) {
+func (in *MCPCatalogBundleImportResult) DeepCopyInto(out *MCPCatalogBundleImportResult) {
 	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]MCPCatalogBundleChange, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPResourceReadStats.
-func (in *MCPResourceReadStats) DeepCopy() *MCPResourceReadStats {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundleImportResult.
+func (in *MCPCatalogBundleImportResult) DeepCopy() *MCPCatalogBundleImportResult {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPResourceReadStats)
+	out := new(MCPCatalogBundleImportResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPResourceRequests) DeepCopyInto(out *MCPResourceRequests) {
+func (in *MCPCatalogBundleResource) DeepCopyInto(out *MCPCatalogBundleResource) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPResourceRequests.
-func (in *MCPResourceRequests) DeepCopy() *MCPResourceRequests {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundleResource.
+func (in *MCPCatalogBundleResource) DeepCopy() *MCPCatalogBundleResource {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPResourceRequests)
+	out := new(MCPCatalogBundleResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPSelector) DeepCopyInto(out *MCPSelector) {
+func (in *MCPCatalogBundleServer) DeepCopyInto(out *MCPCatalogBundleServer) {
 	*out = *in
-	if in.Identifiers != nil {
-		in, out := &in.Identifiers, &out.Identifiers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.Manifest.DeepCopyInto(&out.Manifest)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelector.
-func (in *MCPSelector) DeepCopy() *MCPSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogBundleServer.
+func (in *MCPCatalogBundleServer) DeepCopy() *MCPCatalogBundleServer {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSelector)
+	out := new(MCPCatalogBundleServer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in MCPSelectors) DeepCopyInto(out *MCPSelectors) {
-	{
-		in := &in
-		*out = make(MCPSelectors, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+func (in *MCPCatalogEvent) DeepCopyInto(out *MCPCatalogEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelectors.
-func (in MCPSelectors) DeepCopy() MCPSelectors {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEvent.
+func (in *MCPCatalogEvent) DeepCopy() *MCPCatalogEvent {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSelectors)
+	out := new(MCPCatalogEvent)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+func (in *MCPCatalogEventWebhook) DeepCopyInto(out *MCPCatalogEventWebhook) {
 	*out = *in
 	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.MCPServerManifest.DeepCopyInto(&out.MCPServerManifest)
-	if in.MissingRequiredEnvVars != nil {
-		in, out := &in.MissingRequiredEnvVars, &out.MissingRequiredEnvVars
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.MissingRequiredHeaders != nil {
-		in, out := &in.MissingRequiredHeaders, &out.MissingRequiredHeaders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.MCPServerInstanceUserCount != nil {
-		in, out := &in.MCPServerInstanceUserCount, &out.MCPServerInstanceUserCount
-		*out = new(int)
-		**out = **in
-	}
-	if in.DeploymentAvailableReplicas != nil {
-		in, out := &in.DeploymentAvailableReplicas, &out.DeploymentAvailableReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DeploymentReadyReplicas != nil {
-		in, out := &in.DeploymentReadyReplicas, &out.DeploymentReadyReplicas
-		*out = new(int32)
-		**out = **in
+	in.MCPCatalogEventWebhookManifest.DeepCopyInto(&out.MCPCatalogEventWebhookManifest)
+	if in.LastDeliveredAt != nil {
+		in, out := &in.LastDeliveredAt, &out.LastDeliveredAt
+		*out = (*in).DeepCopy()
 	}
-	if in.DeploymentReplicas != nil {
-		in, out := &in.DeploymentReplicas, &out.DeploymentReplicas
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventWebhook.
+func (in *MCPCatalogEventWebhook) DeepCopy() *MCPCatalogEventWebhook {
+	if in == nil {
+		return nil
 	}
-	if in.DeploymentConditions != nil {
-		in, out := &in.DeploymentConditions, &out.DeploymentConditions
-		*out = make([]DeploymentCondition, len(*in))
+	out := new(MCPCatalogEventWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventWebhookList) DeepCopyInto(out *MCPCatalogEventWebhookList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPCatalogEventWebhook, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
-func (in *MCPServer) DeepCopy() *MCPServer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventWebhookList.
+func (in *MCPCatalogEventWebhookList) DeepCopy() *MCPCatalogEventWebhookList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServer)
+	out := new(MCPCatalogEventWebhookList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
+func (in *MCPCatalogEventWebhookManifest) DeepCopyInto(out *MCPCatalogEventWebhookManifest) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.Manifest.DeepCopyInto(&out.Manifest)
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
-		*out = (*in).DeepCopy()
-	}
-	if in.ToolPreviewsLastGenerated != nil {
-		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
-		*out = (*in).DeepCopy()
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]MCPCatalogEventType, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntry.
-func (in *MCPServerCatalogEntry) DeepCopy() *MCPServerCatalogEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventWebhookManifest.
+func (in *MCPCatalogEventWebhookManifest) DeepCopy() *MCPCatalogEventWebhookManifest {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntry)
+	out := new(MCPCatalogEventWebhookManifest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntryList) DeepCopyInto(out *MCPServerCatalogEntryList) {
+func (in *MCPCatalogList) DeepCopyInto(out *MCPCatalogList) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerCatalogEntry, len(*in))
+		*out = make([]MCPCatalog, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryList.
-func (in *MCPServerCatalogEntryList) DeepCopy() *MCPServerCatalogEntryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogList.
+func (in *MCPCatalogList) DeepCopy() *MCPCatalogList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntryList)
+	out := new(MCPCatalogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntryManifest) DeepCopyInto(out *MCPServerCatalogEntryManifest) {
+func (in *MCPCatalogManifest) DeepCopyInto(out *MCPCatalogManifest) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
+	if in.SourceURLs != nil {
+		in, out := &in.SourceURLs, &out.SourceURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceURLCredentials != nil {
+		in, out := &in.SourceURLCredentials, &out.SourceURLCredentials
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ToolPreview != nil {
-		in, out := &in.ToolPreview, &out.ToolPreview
-		*out = make([]MCPServerTool, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogManifest.
+func (in *MCPCatalogManifest) DeepCopy() *MCPCatalogManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogSubmission) DeepCopyInto(out *MCPCatalogSubmission) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	in.Checks.DeepCopyInto(&out.Checks)
+	if in.Comments != nil {
+		in, out := &in.Comments, &out.Comments
+		*out = make([]PendingCatalogEntryComment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.UVXConfig != nil {
-		in, out := &in.UVXConfig, &out.UVXConfig
-		*out = new(UVXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSubmission.
+func (in *MCPCatalogSubmission) DeepCopy() *MCPCatalogSubmission {
+	if in == nil {
+		return nil
 	}
-	if in.NPXConfig != nil {
-		in, out := &in.NPXConfig, &out.NPXConfig
-		*out = new(NPXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
+	out := new(MCPCatalogSubmission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogSubmissionPortal) DeepCopyInto(out *MCPCatalogSubmissionPortal) {
+	*out = *in
+	out.MCPCatalogSubmissionPortalManifest = in.MCPCatalogSubmissionPortalManifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSubmissionPortal.
+func (in *MCPCatalogSubmissionPortal) DeepCopy() *MCPCatalogSubmissionPortal {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogSubmissionPortal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogSubmissionPortalManifest) DeepCopyInto(out *MCPCatalogSubmissionPortalManifest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSubmissionPortalManifest.
+func (in *MCPCatalogSubmissionPortalManifest) DeepCopy() *MCPCatalogSubmissionPortalManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogSubmissionPortalManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogSubmissionRequest) DeepCopyInto(out *MCPCatalogSubmissionRequest) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	out.Vendor = in.Vendor
+	if in.SandboxConfig != nil {
+		in, out := &in.SandboxConfig, &out.SandboxConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSubmissionRequest.
+func (in *MCPCatalogSubmissionRequest) DeepCopy() *MCPCatalogSubmissionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogSubmissionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogWebhook) DeepCopyInto(out *MCPCatalogWebhook) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogWebhook.
+func (in *MCPCatalogWebhook) DeepCopy() *MCPCatalogWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCompletionArgument) DeepCopyInto(out *MCPCompletionArgument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCompletionArgument.
+func (in *MCPCompletionArgument) DeepCopy() *MCPCompletionArgument {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCompletionArgument)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCompletionReference) DeepCopyInto(out *MCPCompletionReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCompletionReference.
+func (in *MCPCompletionReference) DeepCopy() *MCPCompletionReference {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCompletionReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCompletionRequest) DeepCopyInto(out *MCPCompletionRequest) {
+	*out = *in
+	out.Ref = in.Ref
+	out.Argument = in.Argument
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCompletionRequest.
+func (in *MCPCompletionRequest) DeepCopy() *MCPCompletionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCompletionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCompletionResult) DeepCopyInto(out *MCPCompletionResult) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCompletionResult.
+func (in *MCPCompletionResult) DeepCopy() *MCPCompletionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCompletionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPComponentServerConfig) DeepCopyInto(out *MCPComponentServerConfig) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPComponentServerConfig.
+func (in *MCPComponentServerConfig) DeepCopy() *MCPComponentServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPComponentServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPComponentServerDetails) DeepCopyInto(out *MCPComponentServerDetails) {
+	*out = *in
+	if in.LastHealthyTime != nil {
+		in, out := &in.LastHealthyTime, &out.LastHealthyTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPComponentServerDetails.
+func (in *MCPComponentServerDetails) DeepCopy() *MCPComponentServerDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPComponentServerDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCompositeComponentFailure) DeepCopyInto(out *MCPCompositeComponentFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCompositeComponentFailure.
+func (in *MCPCompositeComponentFailure) DeepCopy() *MCPCompositeComponentFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCompositeComponentFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCompositeLaunchResult) DeepCopyInto(out *MCPCompositeLaunchResult) {
+	*out = *in
+	if in.DisabledComponents != nil {
+		in, out := &in.DisabledComponents, &out.DisabledComponents
+		*out = make([]MCPCompositeComponentFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCompositeLaunchResult.
+func (in *MCPCompositeLaunchResult) DeepCopy() *MCPCompositeLaunchResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCompositeLaunchResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPConfigurationPreset) DeepCopyInto(out *MCPConfigurationPreset) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPConfigurationPreset.
+func (in *MCPConfigurationPreset) DeepCopy() *MCPConfigurationPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPConfigurationPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPConnectSession) DeepCopyInto(out *MCPConnectSession) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	in.LastActive.DeepCopyInto(&out.LastActive)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPConnectSession.
+func (in *MCPConnectSession) DeepCopy() *MCPConnectSession {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPConnectSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPConnectSessionList) DeepCopyInto(out *MCPConnectSessionList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPConnectSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPConnectSessionList.
+func (in *MCPConnectSessionList) DeepCopy() *MCPConnectSessionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPConnectSessionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPConnectSettings) DeepCopyInto(out *MCPConnectSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPConnectSettings.
+func (in *MCPConnectSettings) DeepCopy() *MCPConnectSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPConnectSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPElicitation) DeepCopyInto(out *MCPElicitation) {
+	*out = *in
+	if in.RequestedSchema != nil {
+		in, out := &in.RequestedSchema, &out.RequestedSchema
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	in.Created.DeepCopyInto(&out.Created)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPElicitation.
+func (in *MCPElicitation) DeepCopy() *MCPElicitation {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPElicitation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPElicitationResponse) DeepCopyInto(out *MCPElicitationResponse) {
+	*out = *in
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPElicitationResponse.
+func (in *MCPElicitationResponse) DeepCopy() *MCPElicitationResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPElicitationResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPEnv) DeepCopyInto(out *MCPEnv) {
+	*out = *in
+	in.MCPHeader.DeepCopyInto(&out.MCPHeader)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPEnv.
+func (in *MCPEnv) DeepCopy() *MCPEnv {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPEnv)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPErrorRateStat) DeepCopyInto(out *MCPErrorRateStat) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPErrorRateStat.
+func (in *MCPErrorRateStat) DeepCopy() *MCPErrorRateStat {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPErrorRateStat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPErrorRateStats) DeepCopyInto(out *MCPErrorRateStats) {
+	*out = *in
+	in.TimeStart.DeepCopyInto(&out.TimeStart)
+	in.TimeEnd.DeepCopyInto(&out.TimeEnd)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPErrorRateStat, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPErrorRateStats.
+func (in *MCPErrorRateStats) DeepCopy() *MCPErrorRateStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPErrorRateStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPHeader) DeepCopyInto(out *MCPHeader) {
+	*out = *in
+	if in.OAuthTokenSource != nil {
+		in, out := &in.OAuthTokenSource, &out.OAuthTokenSource
+		*out = new(MCPOAuthTokenSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPHeader.
+func (in *MCPHeader) DeepCopy() *MCPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPLogSettings) DeepCopyInto(out *MCPLogSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPLogSettings.
+func (in *MCPLogSettings) DeepCopy() *MCPLogSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPLogSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPMaintenanceNotice) DeepCopyInto(out *MCPMaintenanceNotice) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPMaintenanceNotice.
+func (in *MCPMaintenanceNotice) DeepCopy() *MCPMaintenanceNotice {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPMaintenanceNotice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPManifestChange) DeepCopyInto(out *MCPManifestChange) {
+	*out = *in
+	if in.Before != nil {
+		in, out := &in.Before, &out.Before
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPManifestChange.
+func (in *MCPManifestChange) DeepCopy() *MCPManifestChange {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPManifestChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthFailure) DeepCopyInto(out *MCPOAuthFailure) {
+	*out = *in
+	if in.LastErrorAt != nil {
+		in, out := &in.LastErrorAt, &out.LastErrorAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthFailure.
+func (in *MCPOAuthFailure) DeepCopy() *MCPOAuthFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthOverview) DeepCopyInto(out *MCPOAuthOverview) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPOAuthTokenHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthOverview.
+func (in *MCPOAuthOverview) DeepCopy() *MCPOAuthOverview {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthOverview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthScopeChange) DeepCopyInto(out *MCPOAuthScopeChange) {
+	*out = *in
+	if in.AddedScopes != nil {
+		in, out := &in.AddedScopes, &out.AddedScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthScopeChange.
+func (in *MCPOAuthScopeChange) DeepCopy() *MCPOAuthScopeChange {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthScopeChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthScopeGroup) DeepCopyInto(out *MCPOAuthScopeGroup) {
+	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthScopeGroup.
+func (in *MCPOAuthScopeGroup) DeepCopy() *MCPOAuthScopeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthScopeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthTelemetry) DeepCopyInto(out *MCPOAuthTelemetry) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]MCPOAuthFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthTelemetry.
+func (in *MCPOAuthTelemetry) DeepCopy() *MCPOAuthTelemetry {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthTelemetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthTelemetryReport) DeepCopyInto(out *MCPOAuthTelemetryReport) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPOAuthTelemetry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthTelemetryReport.
+func (in *MCPOAuthTelemetryReport) DeepCopy() *MCPOAuthTelemetryReport {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthTelemetryReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthTokenHealth) DeepCopyInto(out *MCPOAuthTokenHealth) {
+	*out = *in
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = (*in).DeepCopy()
+	}
+	if in.CheckedAt != nil {
+		in, out := &in.CheckedAt, &out.CheckedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthTokenHealth.
+func (in *MCPOAuthTokenHealth) DeepCopy() *MCPOAuthTokenHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthTokenHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthTokenSource) DeepCopyInto(out *MCPOAuthTokenSource) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthTokenSource.
+func (in *MCPOAuthTokenSource) DeepCopy() *MCPOAuthTokenSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthTokenSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthTokenSourceStatus) DeepCopyInto(out *MCPOAuthTokenSourceStatus) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingScopes != nil {
+		in, out := &in.MissingScopes, &out.MissingScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthTokenSourceStatus.
+func (in *MCPOAuthTokenSourceStatus) DeepCopy() *MCPOAuthTokenSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthTokenSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPOAuthTokenSourceStatusList) DeepCopyInto(out *MCPOAuthTokenSourceStatusList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPOAuthTokenSourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPOAuthTokenSourceStatusList.
+func (in *MCPOAuthTokenSourceStatusList) DeepCopy() *MCPOAuthTokenSourceStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPOAuthTokenSourceStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPProjectUsage) DeepCopyInto(out *MCPProjectUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPProjectUsage.
+func (in *MCPProjectUsage) DeepCopy() *MCPProjectUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPProjectUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPromptReadStats) DeepCopyInto(out *MCPPromptReadStats) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPromptReadStats.
+func (in *MCPPromptReadStats) DeepCopy() *MCPPromptReadStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPromptReadStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPRequestTimeouts) DeepCopyInto(out *MCPRequestTimeouts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPRequestTimeouts.
+func (in *MCPRequestTimeouts) DeepCopy() *MCPRequestTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPRequestTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPResourceReadStats) DeepCopyInto(out *MCPResourceReadStats) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPResourceReadStats.
+func (in *MCPResourceReadStats) DeepCopy() *MCPResourceReadStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPResourceReadStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPResourceRequests) DeepCopyInto(out *MCPResourceRequests) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPResourceRequests.
+func (in *MCPResourceRequests) DeepCopy() *MCPResourceRequests {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPResourceRequests)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPResourceUpdate) DeepCopyInto(out *MCPResourceUpdate) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPResourceUpdate.
+func (in *MCPResourceUpdate) DeepCopy() *MCPResourceUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPResourceUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPRoot) DeepCopyInto(out *MCPRoot) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPRoot.
+func (in *MCPRoot) DeepCopy() *MCPRoot {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPRoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPRootList) DeepCopyInto(out *MCPRootList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPRoot, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPRootList.
+func (in *MCPRootList) DeepCopy() *MCPRootList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPRootList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPSamplingConfig) DeepCopyInto(out *MCPSamplingConfig) {
+	*out = *in
+	if in.AllowedModels != nil {
+		in, out := &in.AllowedModels, &out.AllowedModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSamplingConfig.
+func (in *MCPSamplingConfig) DeepCopy() *MCPSamplingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSamplingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPSelector) DeepCopyInto(out *MCPSelector) {
+	*out = *in
+	if in.Identifiers != nil {
+		in, out := &in.Identifiers, &out.Identifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelector.
+func (in *MCPSelector) DeepCopy() *MCPSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MCPSelectors) DeepCopyInto(out *MCPSelectors) {
+	{
+		in := &in
+		*out = make(MCPSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelectors.
+func (in MCPSelectors) DeepCopy() MCPSelectors {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSelectors)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.MCPServerManifest.DeepCopyInto(&out.MCPServerManifest)
+	if in.MissingRequiredEnvVars != nil {
+		in, out := &in.MissingRequiredEnvVars, &out.MissingRequiredEnvVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingRequiredHeaders != nil {
+		in, out := &in.MissingRequiredHeaders, &out.MissingRequiredHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalExposure != nil {
+		in, out := &in.ExternalExposure, &out.ExternalExposure
+		*out = new(MCPServerExternalExposure)
+		**out = **in
+	}
+	if in.MaintenanceNotice != nil {
+		in, out := &in.MaintenanceNotice, &out.MaintenanceNotice
+		*out = new(MCPMaintenanceNotice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectBlockers != nil {
+		in, out := &in.ConnectBlockers, &out.ConnectBlockers
+		*out = make([]MCPServerConnectBlocker, len(*in))
+		copy(*out, *in)
+	}
+	if in.MCPServerInstanceUserCount != nil {
+		in, out := &in.MCPServerInstanceUserCount, &out.MCPServerInstanceUserCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeploymentAvailableReplicas != nil {
+		in, out := &in.DeploymentAvailableReplicas, &out.DeploymentAvailableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentReadyReplicas != nil {
+		in, out := &in.DeploymentReadyReplicas, &out.DeploymentReadyReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentReplicas != nil {
+		in, out := &in.DeploymentReplicas, &out.DeploymentReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentConditions != nil {
+		in, out := &in.DeploymentConditions, &out.DeploymentConditions
+		*out = make([]DeploymentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastHealthyTime != nil {
+		in, out := &in.LastHealthyTime, &out.LastHealthyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CredentialExpiry != nil {
+		in, out := &in.CredentialExpiry, &out.CredentialExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleSince != nil {
+		in, out := &in.StaleSince, &out.StaleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleActionTime != nil {
+		in, out := &in.StaleActionTime, &out.StaleActionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerBulkRequest) DeepCopyInto(out *MCPServerBulkRequest) {
+	*out = *in
+	if in.ServerIDs != nil {
+		in, out := &in.ServerIDs, &out.ServerIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerBulkRequest.
+func (in *MCPServerBulkRequest) DeepCopy() *MCPServerBulkRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerBulkRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerBulkResponse) DeepCopyInto(out *MCPServerBulkResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerBulkResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerBulkResponse.
+func (in *MCPServerBulkResponse) DeepCopy() *MCPServerBulkResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerBulkResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerBulkResult) DeepCopyInto(out *MCPServerBulkResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerBulkResult.
+func (in *MCPServerBulkResult) DeepCopy() *MCPServerBulkResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerBulkResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.ToolPreviewsLastGenerated != nil {
+		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaintenanceNotice != nil {
+		in, out := &in.MaintenanceNotice, &out.MaintenanceNotice
+		*out = new(MCPMaintenanceNotice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuthScopeChanges != nil {
+		in, out := &in.OAuthScopeChanges, &out.OAuthScopeChanges
+		*out = make([]MCPOAuthScopeChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntry.
+func (in *MCPServerCatalogEntry) DeepCopy() *MCPServerCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryDryRunResult) DeepCopyInto(out *MCPServerCatalogEntryDryRunResult) {
+	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]MCPServerTool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryDryRunResult.
+func (in *MCPServerCatalogEntryDryRunResult) DeepCopy() *MCPServerCatalogEntryDryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryDryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryFacets) DeepCopyInto(out *MCPServerCatalogEntryFacets) {
+	*out = *in
+	if in.Runtimes != nil {
+		in, out := &in.Runtimes, &out.Runtimes
+		*out = make(map[Runtime]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryFacets.
+func (in *MCPServerCatalogEntryFacets) DeepCopy() *MCPServerCatalogEntryFacets {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryFacets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryList) DeepCopyInto(out *MCPServerCatalogEntryList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Facets != nil {
+		in, out := &in.Facets, &out.Facets
+		*out = new(MCPServerCatalogEntryFacets)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryList.
+func (in *MCPServerCatalogEntryList) DeepCopy() *MCPServerCatalogEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryManifest) DeepCopyInto(out *MCPServerCatalogEntryManifest) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ToolPreview != nil {
+		in, out := &in.ToolPreview, &out.ToolPreview
+		*out = make([]MCPServerTool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UVXConfig != nil {
+		in, out := &in.UVXConfig, &out.UVXConfig
+		*out = new(UVXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NPXConfig != nil {
+		in, out := &in.NPXConfig, &out.NPXConfig
+		*out = new(NPXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerizedConfig != nil {
+		in, out := &in.ContainerizedConfig, &out.ContainerizedConfig
+		*out = new(ContainerizedRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteConfig != nil {
+		in, out := &in.RemoteConfig, &out.RemoteConfig
+		*out = new(RemoteCatalogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompositeConfig != nil {
+		in, out := &in.CompositeConfig, &out.CompositeConfig
+		*out = new(CompositeCatalogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StdioConfig != nil {
+		in, out := &in.StdioConfig, &out.StdioConfig
+		*out = new(StdioRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceConfig != nil {
+		in, out := &in.SourceConfig, &out.SourceConfig
+		*out = new(SourceRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MultiUserConfig != nil {
+		in, out := &in.MultiUserConfig, &out.MultiUserConfig
+		*out = new(MultiUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]MCPEnv, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ToolPolicy != nil {
+		in, out := &in.ToolPolicy, &out.ToolPolicy
+		*out = new(MCPToolPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(MCPSamplingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectSettings != nil {
+		in, out := &in.ConnectSettings, &out.ConnectSettings
+		*out = new(MCPConnectSettings)
+		**out = **in
+	}
+	if in.RequestTimeouts != nil {
+		in, out := &in.RequestTimeouts, &out.RequestTimeouts
+		*out = new(MCPRequestTimeouts)
+		**out = **in
+	}
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = make([]MCPConfigurationPreset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultTools != nil {
+		in, out := &in.DefaultTools, &out.DefaultTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OAuthScopeGroups != nil {
+		in, out := &in.OAuthScopeGroups, &out.OAuthScopeGroups
+		*out = make([]MCPOAuthScopeGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ToolCosts != nil {
+		in, out := &in.ToolCosts, &out.ToolCosts
+		*out = make([]MCPToolCost, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryManifest.
+func (in *MCPServerCatalogEntryManifest) DeepCopy() *MCPServerCatalogEntryManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryRevision) DeepCopyInto(out *MCPServerCatalogEntryRevision) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]MCPManifestChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryRevision.
+func (in *MCPServerCatalogEntryRevision) DeepCopy() *MCPServerCatalogEntryRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryRevisionList) DeepCopyInto(out *MCPServerCatalogEntryRevisionList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerCatalogEntryRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryRevisionList.
+func (in *MCPServerCatalogEntryRevisionList) DeepCopy() *MCPServerCatalogEntryRevisionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryRevisionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryValidationIssue) DeepCopyInto(out *MCPServerCatalogEntryValidationIssue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryValidationIssue.
+func (in *MCPServerCatalogEntryValidationIssue) DeepCopy() *MCPServerCatalogEntryValidationIssue {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryValidationIssue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryValidationRequest) DeepCopyInto(out *MCPServerCatalogEntryValidationRequest) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ComponentConfigs != nil {
+		in, out := &in.ComponentConfigs, &out.ComponentConfigs
+		*out = make(map[string]MCPComponentServerConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryValidationRequest.
+func (in *MCPServerCatalogEntryValidationRequest) DeepCopy() *MCPServerCatalogEntryValidationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryValidationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryValidationResult) DeepCopyInto(out *MCPServerCatalogEntryValidationResult) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]MCPServerCatalogEntryValidationIssue, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]MCPServerCatalogEntryValidationIssue, len(*in))
+		copy(*out, *in)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(MCPServerCatalogEntryDryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryValidationResult.
+func (in *MCPServerCatalogEntryValidationResult) DeepCopy() *MCPServerCatalogEntryValidationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryValidationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCloneRequest) DeepCopyInto(out *MCPServerCloneRequest) {
+	*out = *in
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCloneRequest.
+func (in *MCPServerCloneRequest) DeepCopy() *MCPServerCloneRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCloneRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerConnectAliasRequest) DeepCopyInto(out *MCPServerConnectAliasRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerConnectAliasRequest.
+func (in *MCPServerConnectAliasRequest) DeepCopy() *MCPServerConnectAliasRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerConnectAliasRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerDetails) DeepCopyInto(out *MCPServerDetails) {
+	*out = *in
+	in.LastRestart.DeepCopyInto(&out.LastRestart)
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]MCPServerEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]MCPComponentServerDetails, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerDetails.
+func (in *MCPServerDetails) DeepCopy() *MCPServerDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerEvent) DeepCopyInto(out *MCPServerEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerEvent.
+func (in *MCPServerEvent) DeepCopy() *MCPServerEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerExternalExposure) DeepCopyInto(out *MCPServerExternalExposure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerExternalExposure.
+func (in *MCPServerExternalExposure) DeepCopy() *MCPServerExternalExposure {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerExternalExposure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerExternalExposureRequest) DeepCopyInto(out *MCPServerExternalExposureRequest) {
+	*out = *in
+	if in.ExternalExposure != nil {
+		in, out := &in.ExternalExposure, &out.ExternalExposure
+		*out = new(MCPServerExternalExposure)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerExternalExposureRequest.
+func (in *MCPServerExternalExposureRequest) DeepCopy() *MCPServerExternalExposureRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerExternalExposureRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerFailureReport) DeepCopyInto(out *MCPServerFailureReport) {
+	*out = *in
+	if in.LastHealthyTime != nil {
+		in, out := &in.LastHealthyTime, &out.LastHealthyTime
+		*out = (*in).DeepCopy()
+	}
+	in.Details.DeepCopyInto(&out.Details)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerFailureReport.
+func (in *MCPServerFailureReport) DeepCopy() *MCPServerFailureReport {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerFailureReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.MissingRequiredHeaders != nil {
+		in, out := &in.MissingRequiredHeaders, &out.MissingRequiredHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MultiUserConfig != nil {
+		in, out := &in.MultiUserConfig, &out.MultiUserConfig
+		*out = new(MultiUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ToolAllowlist != nil {
+		in, out := &in.ToolAllowlist, &out.ToolAllowlist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectBlockers != nil {
+		in, out := &in.ConnectBlockers, &out.ConnectBlockers
+		*out = make([]MCPServerConnectBlocker, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstance.
+func (in *MCPServerInstance) DeepCopy() *MCPServerInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstanceList) DeepCopyInto(out *MCPServerInstanceList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceList.
+func (in *MCPServerInstanceList) DeepCopy() *MCPServerInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstanceOAuthState) DeepCopyInto(out *MCPServerInstanceOAuthState) {
+	*out = *in
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceOAuthState.
+func (in *MCPServerInstanceOAuthState) DeepCopy() *MCPServerInstanceOAuthState {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstanceOAuthState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstanceToolAllowlist) DeepCopyInto(out *MCPServerInstanceToolAllowlist) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceToolAllowlist.
+func (in *MCPServerInstanceToolAllowlist) DeepCopy() *MCPServerInstanceToolAllowlist {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstanceToolAllowlist)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerLaunchStatus) DeepCopyInto(out *MCPServerLaunchStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerLaunchStatus.
+func (in *MCPServerLaunchStatus) DeepCopy() *MCPServerLaunchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerLaunchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
+func (in *MCPServerList) DeepCopy() *MCPServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerManifest) DeepCopyInto(out *MCPServerManifest) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ToolPreview != nil {
+		in, out := &in.ToolPreview, &out.ToolPreview
+		*out = make([]MCPServerTool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UVXConfig != nil {
+		in, out := &in.UVXConfig, &out.UVXConfig
+		*out = new(UVXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NPXConfig != nil {
+		in, out := &in.NPXConfig, &out.NPXConfig
+		*out = new(NPXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ContainerizedConfig != nil {
 		in, out := &in.ContainerizedConfig, &out.ContainerizedConfig
@@ -2496,12 +4861,22 @@ func (in *MCPServerCatalogEntryManifest) DeepCopyInto(out *MCPServerCatalogEntry
 	}
 	if in.RemoteConfig != nil {
 		in, out := &in.RemoteConfig, &out.RemoteConfig
-		*out = new(RemoteCatalogConfig)
+		*out = new(RemoteRuntimeConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CompositeConfig != nil {
 		in, out := &in.CompositeConfig, &out.CompositeConfig
-		*out = new(CompositeCatalogConfig)
+		*out = new(CompositeRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StdioConfig != nil {
+		in, out := &in.StdioConfig, &out.StdioConfig
+		*out = new(StdioRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceConfig != nil {
+		in, out := &in.SourceConfig, &out.SourceConfig
+		*out = new(SourceRuntimeConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.MultiUserConfig != nil {
@@ -2509,332 +4884,506 @@ func (in *MCPServerCatalogEntryManifest) DeepCopyInto(out *MCPServerCatalogEntry
 		*out = new(MultiUserConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]MCPEnv, len(*in))
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]MCPEnv, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]MCPHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(MCPSamplingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectSettings != nil {
+		in, out := &in.ConnectSettings, &out.ConnectSettings
+		*out = new(MCPConnectSettings)
+		**out = **in
+	}
+	if in.RequestTimeouts != nil {
+		in, out := &in.RequestTimeouts, &out.RequestTimeouts
+		*out = new(MCPRequestTimeouts)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerManifest.
+func (in *MCPServerManifest) DeepCopy() *MCPServerManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerNeedingK8sUpdate) DeepCopyInto(out *MCPServerNeedingK8sUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerNeedingK8sUpdate.
+func (in *MCPServerNeedingK8sUpdate) DeepCopy() *MCPServerNeedingK8sUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerNeedingK8sUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerOAuthCredentialRequest) DeepCopyInto(out *MCPServerOAuthCredentialRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialRequest.
+func (in *MCPServerOAuthCredentialRequest) DeepCopy() *MCPServerOAuthCredentialRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerOAuthCredentialRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerOAuthCredentialStatus) DeepCopyInto(out *MCPServerOAuthCredentialStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialStatus.
+func (in *MCPServerOAuthCredentialStatus) DeepCopy() *MCPServerOAuthCredentialStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerOAuthCredentialStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerPinRequest) DeepCopyInto(out *MCPServerPinRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerPinRequest.
+func (in *MCPServerPinRequest) DeepCopy() *MCPServerPinRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerPinRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerReauthentication) DeepCopyInto(out *MCPServerReauthentication) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerReauthentication.
+func (in *MCPServerReauthentication) DeepCopy() *MCPServerReauthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerReauthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerReauthenticationList) DeepCopyInto(out *MCPServerReauthenticationList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerReauthentication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerReauthenticationList.
+func (in *MCPServerReauthenticationList) DeepCopy() *MCPServerReauthenticationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerReauthenticationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerScaffoldRepository) DeepCopyInto(out *MCPServerScaffoldRepository) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerScaffoldRepository.
+func (in *MCPServerScaffoldRepository) DeepCopy() *MCPServerScaffoldRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerScaffoldRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerScaffoldRequest) DeepCopyInto(out *MCPServerScaffoldRequest) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]MCPEnv, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(MCPServerScaffoldRepository)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerScaffoldRequest.
+func (in *MCPServerScaffoldRequest) DeepCopy() *MCPServerScaffoldRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerScaffoldRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerScaffoldResult) DeepCopyInto(out *MCPServerScaffoldResult) {
+	*out = *in
+	in.CatalogEntryManifest.DeepCopyInto(&out.CatalogEntryManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerScaffoldResult.
+func (in *MCPServerScaffoldResult) DeepCopy() *MCPServerScaffoldResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerScaffoldResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerStaleNotification) DeepCopyInto(out *MCPServerStaleNotification) {
+	*out = *in
+	in.LastUsed.DeepCopyInto(&out.LastUsed)
+	if in.ActionTime != nil {
+		in, out := &in.ActionTime, &out.ActionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerStaleNotification.
+func (in *MCPServerStaleNotification) DeepCopy() *MCPServerStaleNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerStaleNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerTool) DeepCopyInto(out *MCPServerTool) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryManifest.
-func (in *MCPServerCatalogEntryManifest) DeepCopy() *MCPServerCatalogEntryManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerTool.
+func (in *MCPServerTool) DeepCopy() *MCPServerTool {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntryManifest)
+	out := new(MCPServerTool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerDetails) DeepCopyInto(out *MCPServerDetails) {
+func (in *MCPServerUpdatePreview) DeepCopyInto(out *MCPServerUpdatePreview) {
 	*out = *in
-	in.LastRestart.DeepCopyInto(&out.LastRestart)
-	if in.Events != nil {
-		in, out := &in.Events, &out.Events
-		*out = make([]MCPServerEvent, len(*in))
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]MCPManifestChange, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerDetails.
-func (in *MCPServerDetails) DeepCopy() *MCPServerDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerUpdatePreview.
+func (in *MCPServerUpdatePreview) DeepCopy() *MCPServerUpdatePreview {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerDetails)
+	out := new(MCPServerUpdatePreview)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerEvent) DeepCopyInto(out *MCPServerEvent) {
+func (in *MCPServerWatchEvent) DeepCopyInto(out *MCPServerWatchEvent) {
 	*out = *in
-	in.Time.DeepCopyInto(&out.Time)
+	in.Server.DeepCopyInto(&out.Server)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerEvent.
-func (in *MCPServerEvent) DeepCopy() *MCPServerEvent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerWatchEvent.
+func (in *MCPServerWatchEvent) DeepCopy() *MCPServerWatchEvent {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerEvent)
+	out := new(MCPServerWatchEvent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
+func (in *MCPServersNeedingK8sUpdateList) DeepCopyInto(out *MCPServersNeedingK8sUpdateList) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	if in.MissingRequiredHeaders != nil {
-		in, out := &in.MissingRequiredHeaders, &out.MissingRequiredHeaders
-		*out = make([]string, len(*in))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerNeedingK8sUpdate, len(*in))
 		copy(*out, *in)
 	}
-	if in.MultiUserConfig != nil {
-		in, out := &in.MultiUserConfig, &out.MultiUserConfig
-		*out = new(MultiUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstance.
-func (in *MCPServerInstance) DeepCopy() *MCPServerInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServersNeedingK8sUpdateList.
+func (in *MCPServersNeedingK8sUpdateList) DeepCopy() *MCPServersNeedingK8sUpdateList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerInstance)
+	out := new(MCPServersNeedingK8sUpdateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerInstanceList) DeepCopyInto(out *MCPServerInstanceList) {
+func (in *MCPTeamCredential) DeepCopyInto(out *MCPTeamCredential) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerInstance, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ConfiguredKeys != nil {
+		in, out := &in.ConfiguredKeys, &out.ConfiguredKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceList.
-func (in *MCPServerInstanceList) DeepCopy() *MCPServerInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPTeamCredential.
+func (in *MCPTeamCredential) DeepCopy() *MCPTeamCredential {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerInstanceList)
+	out := new(MCPTeamCredential)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+func (in *MCPTeamCredentialList) DeepCopyInto(out *MCPTeamCredentialList) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPServer, len(*in))
+		*out = make([]MCPTeamCredential, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
-func (in *MCPServerList) DeepCopy() *MCPServerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPTeamCredentialList.
+func (in *MCPTeamCredentialList) DeepCopy() *MCPTeamCredentialList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerList)
+	out := new(MCPTeamCredentialList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerManifest) DeepCopyInto(out *MCPServerManifest) {
+func (in *MCPToolCallDailyStat) DeepCopyInto(out *MCPToolCallDailyStat) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ToolPreview != nil {
-		in, out := &in.ToolPreview, &out.ToolPreview
-		*out = make([]MCPServerTool, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.UVXConfig != nil {
-		in, out := &in.UVXConfig, &out.UVXConfig
-		*out = new(UVXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NPXConfig != nil {
-		in, out := &in.NPXConfig, &out.NPXConfig
-		*out = new(NPXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ContainerizedConfig != nil {
-		in, out := &in.ContainerizedConfig, &out.ContainerizedConfig
-		*out = new(ContainerizedRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RemoteConfig != nil {
-		in, out := &in.RemoteConfig, &out.RemoteConfig
-		*out = new(RemoteRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CompositeConfig != nil {
-		in, out := &in.CompositeConfig, &out.CompositeConfig
-		*out = new(CompositeRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MultiUserConfig != nil {
-		in, out := &in.MultiUserConfig, &out.MultiUserConfig
-		*out = new(MultiUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]MCPEnv, len(*in))
-		copy(*out, *in)
-	}
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]MCPHeader, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerManifest.
-func (in *MCPServerManifest) DeepCopy() *MCPServerManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallDailyStat.
+func (in *MCPToolCallDailyStat) DeepCopy() *MCPToolCallDailyStat {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerManifest)
+	out := new(MCPToolCallDailyStat)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerNeedingK8sUpdate) DeepCopyInto(out *MCPServerNeedingK8sUpdate) {
+func (in *MCPToolCallDailyStats) DeepCopyInto(out *MCPToolCallDailyStats) {
 	*out = *in
+	in.TimeStart.DeepCopyInto(&out.TimeStart)
+	in.TimeEnd.DeepCopyInto(&out.TimeEnd)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolCallDailyStat, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerNeedingK8sUpdate.
-func (in *MCPServerNeedingK8sUpdate) DeepCopy() *MCPServerNeedingK8sUpdate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallDailyStats.
+func (in *MCPToolCallDailyStats) DeepCopy() *MCPToolCallDailyStats {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerNeedingK8sUpdate)
+	out := new(MCPToolCallDailyStats)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerOAuthCredentialRequest) DeepCopyInto(out *MCPServerOAuthCredentialRequest) {
+func (in *MCPToolCallStats) DeepCopyInto(out *MCPToolCallStats) {
 	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolCallStatsItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialRequest.
-func (in *MCPServerOAuthCredentialRequest) DeepCopy() *MCPServerOAuthCredentialRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStats.
+func (in *MCPToolCallStats) DeepCopy() *MCPToolCallStats {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerOAuthCredentialRequest)
+	out := new(MCPToolCallStats)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerOAuthCredentialStatus) DeepCopyInto(out *MCPServerOAuthCredentialStatus) {
+func (in *MCPToolCallStatsItem) DeepCopyInto(out *MCPToolCallStatsItem) {
 	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialStatus.
-func (in *MCPServerOAuthCredentialStatus) DeepCopy() *MCPServerOAuthCredentialStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStatsItem.
+func (in *MCPToolCallStatsItem) DeepCopy() *MCPToolCallStatsItem {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerOAuthCredentialStatus)
+	out := new(MCPToolCallStatsItem)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerTool) DeepCopyInto(out *MCPServerTool) {
+func (in *MCPToolCost) DeepCopyInto(out *MCPToolCost) {
 	*out = *in
-	if in.Params != nil {
-		in, out := &in.Params, &out.Params
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerTool.
-func (in *MCPServerTool) DeepCopy() *MCPServerTool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCost.
+func (in *MCPToolCost) DeepCopy() *MCPToolCost {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerTool)
+	out := new(MCPToolCost)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServersNeedingK8sUpdateList) DeepCopyInto(out *MCPServersNeedingK8sUpdateList) {
+func (in *MCPToolPolicy) DeepCopyInto(out *MCPToolPolicy) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerNeedingK8sUpdate, len(*in))
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServersNeedingK8sUpdateList.
-func (in *MCPServersNeedingK8sUpdateList) DeepCopy() *MCPServersNeedingK8sUpdateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolPolicy.
+func (in *MCPToolPolicy) DeepCopy() *MCPToolPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServersNeedingK8sUpdateList)
+	out := new(MCPToolPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPToolCallStats) DeepCopyInto(out *MCPToolCallStats) {
+func (in *MCPUsage) DeepCopyInto(out *MCPUsage) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MCPToolCallStatsItem, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStats.
-func (in *MCPToolCallStats) DeepCopy() *MCPToolCallStats {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPUsage.
+func (in *MCPUsage) DeepCopy() *MCPUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPToolCallStats)
+	out := new(MCPUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPToolCallStatsItem) DeepCopyInto(out *MCPToolCallStatsItem) {
+func (in *MCPUsageReport) DeepCopyInto(out *MCPUsageReport) {
 	*out = *in
-	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]MCPProjectUsage, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStatsItem.
-func (in *MCPToolCallStatsItem) DeepCopy() *MCPToolCallStatsItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPUsageReport.
+func (in *MCPUsageReport) DeepCopy() *MCPUsageReport {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPToolCallStatsItem)
+	out := new(MCPUsageReport)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2917,6 +5466,21 @@ func (in *MCPUsageStatsList) DeepCopy() *MCPUsageStatsList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPWebhookSignatureScheme) DeepCopyInto(out *MCPWebhookSignatureScheme) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPWebhookSignatureScheme.
+func (in *MCPWebhookSignatureScheme) DeepCopy() *MCPWebhookSignatureScheme {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPWebhookSignatureScheme)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPWebhookValidation) DeepCopyInto(out *MCPWebhookValidation) {
 	*out = *in
@@ -3514,7 +6078,9 @@ func (in *MultiUserConfig) DeepCopyInto(out *MultiUserConfig) {
 	if in.UserDefinedHeaders != nil {
 		in, out := &in.UserDefinedHeaders, &out.UserDefinedHeaders
 		*out = make([]MCPHeader, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -3687,26 +6253,77 @@ func (in *OAuthAppLoginAuthStatus) DeepCopy() *OAuthAppLoginAuthStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OAuthAppManifest) DeepCopyInto(out *OAuthAppManifest) {
+func (in *OAuthAppManifest) DeepCopyInto(out *OAuthAppManifest) {
+	*out = *in
+	if in.TenantID != nil {
+		in, out := &in.TenantID, &out.TenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(OAuthAppProvider)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuthAppManifest.
+func (in *OAuthAppManifest) DeepCopy() *OAuthAppManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuthAppManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuthAppProvider) DeepCopyInto(out *OAuthAppProvider) {
 	*out = *in
-	if in.TenantID != nil {
-		in, out := &in.TenantID, &out.TenantID
-		*out = new(string)
-		**out = **in
+	if in.AuthorizeParams != nil {
+		in, out := &in.AuthorizeParams, &out.AuthorizeParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.Global != nil {
-		in, out := &in.Global, &out.Global
-		*out = new(bool)
-		**out = **in
+	if in.PassthroughAuthorizeParams != nil {
+		in, out := &in.PassthroughAuthorizeParams, &out.PassthroughAuthorizeParams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenParams != nil {
+		in, out := &in.TokenParams, &out.TokenParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RefreshOmitParams != nil {
+		in, out := &in.RefreshOmitParams, &out.RefreshOmitParams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Extras != nil {
+		in, out := &in.Extras, &out.Extras
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuthAppManifest.
-func (in *OAuthAppManifest) DeepCopy() *OAuthAppManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuthAppProvider.
+func (in *OAuthAppProvider) DeepCopy() *OAuthAppProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(OAuthAppManifest)
+	out := new(OAuthAppProvider)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3763,6 +6380,11 @@ func (in *OAuthClientManifest) DeepCopyInto(out *OAuthClientManifest) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedAudiences != nil {
+		in, out := &in.AllowedAudiences, &out.AllowedAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ResponseTypes != nil {
 		in, out := &in.ResponseTypes, &out.ResponseTypes
 		*out = make([]string, len(*in))
@@ -3852,6 +6474,176 @@ func (in *OneDriveConfig) DeepCopy() *OneDriveConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntry) DeepCopyInto(out *PendingCatalogEntry) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.PendingCatalogEntryManifest.DeepCopyInto(&out.PendingCatalogEntryManifest)
+	if in.Comments != nil {
+		in, out := &in.Comments, &out.Comments
+		*out = make([]PendingCatalogEntryComment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReviewedAt != nil {
+		in, out := &in.ReviewedAt, &out.ReviewedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Vendor != nil {
+		in, out := &in.Vendor, &out.Vendor
+		*out = new(PendingCatalogEntryVendor)
+		**out = **in
+	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = new(PendingCatalogEntryChecks)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntry.
+func (in *PendingCatalogEntry) DeepCopy() *PendingCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryCheck) DeepCopyInto(out *PendingCatalogEntryCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryCheck.
+func (in *PendingCatalogEntryCheck) DeepCopy() *PendingCatalogEntryCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryChecks) DeepCopyInto(out *PendingCatalogEntryChecks) {
+	*out = *in
+	if in.LintWarnings != nil {
+		in, out := &in.LintWarnings, &out.LintWarnings
+		*out = make([]MCPServerCatalogEntryValidationIssue, len(*in))
+		copy(*out, *in)
+	}
+	out.ImageScan = in.ImageScan
+	out.SandboxTest = in.SandboxTest
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]MCPServerTool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TriagedAt != nil {
+		in, out := &in.TriagedAt, &out.TriagedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryChecks.
+func (in *PendingCatalogEntryChecks) DeepCopy() *PendingCatalogEntryChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryComment) DeepCopyInto(out *PendingCatalogEntryComment) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryComment.
+func (in *PendingCatalogEntryComment) DeepCopy() *PendingCatalogEntryComment {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryComment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryList) DeepCopyInto(out *PendingCatalogEntryList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PendingCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryList.
+func (in *PendingCatalogEntryList) DeepCopy() *PendingCatalogEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryManifest) DeepCopyInto(out *PendingCatalogEntryManifest) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryManifest.
+func (in *PendingCatalogEntryManifest) DeepCopy() *PendingCatalogEntryManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryReview) DeepCopyInto(out *PendingCatalogEntryReview) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryReview.
+func (in *PendingCatalogEntryReview) DeepCopy() *PendingCatalogEntryReview {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryVendor) DeepCopyInto(out *PendingCatalogEntryVendor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryVendor.
+func (in *PendingCatalogEntryVendor) DeepCopy() *PendingCatalogEntryVendor {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryVendor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSecurityAdmissionSettings) DeepCopyInto(out *PodSecurityAdmissionSettings) {
 	*out = *in
@@ -4087,6 +6879,11 @@ func (in *ProjectMCPServer) DeepCopyInto(out *ProjectMCPServer) {
 	*out = *in
 	in.Metadata.DeepCopyInto(&out.Metadata)
 	out.ProjectMCPServerManifest = in.ProjectMCPServerManifest
+	if in.ConnectBlockers != nil {
+		in, out := &in.ConnectBlockers, &out.ConnectBlockers
+		*out = make([]MCPServerConnectBlocker, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMCPServer.
@@ -4532,6 +7329,71 @@ func (in *PublishedArtifactVersionSummary) DeepCopy() *PublishedArtifactVersionS
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileHandlerStats) DeepCopyInto(out *ReconcileHandlerStats) {
+	*out = *in
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FailingObjects != nil {
+		in, out := &in.FailingObjects, &out.FailingObjects
+		*out = make([]ReconcileObjectError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileHandlerStats.
+func (in *ReconcileHandlerStats) DeepCopy() *ReconcileHandlerStats {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileHandlerStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileObjectError) DeepCopyInto(out *ReconcileObjectError) {
+	*out = *in
+	in.FirstFailureTime.DeepCopyInto(&out.FirstFailureTime)
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileObjectError.
+func (in *ReconcileObjectError) DeepCopy() *ReconcileObjectError {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileObjectError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileStats) DeepCopyInto(out *ReconcileStats) {
+	*out = *in
+	if in.Handlers != nil {
+		in, out := &in.Handlers, &out.Handlers
+		*out = make([]ReconcileHandlerStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileStats.
+func (in *ReconcileStats) DeepCopy() *ReconcileStats {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryGitHubMeta) DeepCopyInto(out *RegistryGitHubMeta) {
 	*out = *in
@@ -4553,7 +7415,7 @@ func (in *RegistryMeta) DeepCopyInto(out *RegistryMeta) {
 	if in.Obot != nil {
 		in, out := &in.Obot, &out.Obot
 		*out = new(RegistryObotMeta)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	out.Official = in.Official
 }
@@ -4571,6 +7433,11 @@ func (in *RegistryMeta) DeepCopy() *RegistryMeta {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryObotMeta) DeepCopyInto(out *RegistryObotMeta) {
 	*out = *in
+	if in.ConnectBlockers != nil {
+		in, out := &in.ConnectBlockers, &out.ConnectBlockers
+		*out = make([]MCPServerConnectBlocker, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryObotMeta.
@@ -4780,6 +7647,21 @@ func (in *RegistryServerResponse) DeepCopy() *RegistryServerResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemainingMCPToolCalls) DeepCopyInto(out *RemainingMCPToolCalls) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemainingMCPToolCalls.
+func (in *RemainingMCPToolCalls) DeepCopy() *RemainingMCPToolCalls {
+	if in == nil {
+		return nil
+	}
+	out := new(RemainingMCPToolCalls)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemainingTokenUsage) DeepCopyInto(out *RemainingTokenUsage) {
 	*out = *in
@@ -4821,7 +7703,9 @@ func (in *RemoteCatalogConfig) DeepCopyInto(out *RemoteCatalogConfig) {
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = make([]MCPHeader, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -4841,7 +7725,9 @@ func (in *RemoteRuntimeConfig) DeepCopyInto(out *RemoteRuntimeConfig) {
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = make([]MCPHeader, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -4874,6 +7760,13 @@ func (in *Resource) DeepCopy() *Resource {
 func (in *Run) DeepCopyInto(out *Run) {
 	*out = *in
 	in.Created.DeepCopyInto(&out.Created)
+	if in.MCPToolCalls != nil {
+		in, out := &in.MCPToolCalls, &out.MCPToolCalls
+		*out = make([]RunMCPToolCall, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Run.
@@ -4908,6 +7801,27 @@ func (in *RunList) DeepCopy() *RunList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunMCPToolCall) DeepCopyInto(out *RunMCPToolCall) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunMCPToolCall.
+func (in *RunMCPToolCall) DeepCopy() *RunMCPToolCall {
+	if in == nil {
+		return nil
+	}
+	out := new(RunMCPToolCall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeValidationError) DeepCopyInto(out *RuntimeValidationError) {
 	*out = *in
@@ -5050,12 +7964,36 @@ func (in *ScheduledAuditLogExportUpdateRequest) DeepCopyInto(out *ScheduledAudit
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledAuditLogExportUpdateRequest.
-func (in *ScheduledAuditLogExportUpdateRequest) DeepCopy() *ScheduledAuditLogExportUpdateRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledAuditLogExportUpdateRequest.
+func (in *ScheduledAuditLogExportUpdateRequest) DeepCopy() *ScheduledAuditLogExportUpdateRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledAuditLogExportUpdateRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaTransition) DeepCopyInto(out *SchemaTransition) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.BackfilledAt != nil {
+		in, out := &in.BackfilledAt, &out.BackfilledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ContractedAt != nil {
+		in, out := &in.ContractedAt, &out.ContractedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaTransition.
+func (in *SchemaTransition) DeepCopy() *SchemaTransition {
 	if in == nil {
 		return nil
 	}
-	out := new(ScheduledAuditLogExportUpdateRequest)
+	out := new(SchemaTransition)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5256,6 +8194,46 @@ func (in *SkillResource) DeepCopy() *SkillResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceRuntimeConfig) DeepCopyInto(out *SourceRuntimeConfig) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceRuntimeConfig.
+func (in *SourceRuntimeConfig) DeepCopy() *SourceRuntimeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceRuntimeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StdioRuntimeConfig) DeepCopyInto(out *StdioRuntimeConfig) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StdioRuntimeConfig.
+func (in *StdioRuntimeConfig) DeepCopy() *StdioRuntimeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StdioRuntimeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Step) DeepCopyInto(out *Step) {
 	*out = *in
@@ -5626,7 +8604,9 @@ func (in *SystemMCPServerCatalogEntryManifest) DeepCopyInto(out *SystemMCPServer
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]MCPEnv, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -5700,7 +8680,9 @@ func (in *SystemMCPServerManifest) DeepCopyInto(out *SystemMCPServerManifest) {
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]MCPEnv, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -6065,6 +9047,13 @@ func (in *ThreadManifest) DeepCopyInto(out *ThreadManifest) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.MCPToolSchemaModes != nil {
+		in, out := &in.MCPToolSchemaModes, &out.MCPToolSchemaModes
+		*out = make(map[string]MCPToolSchemaMode, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadManifest.
@@ -6198,6 +9187,66 @@ func (in TokenUsageSeries) DeepCopy() TokenUsageSeries {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolApproval) DeepCopyInto(out *ToolApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolApproval.
+func (in *ToolApproval) DeepCopy() *ToolApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolApprovalList) DeepCopyInto(out *ToolApprovalList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ToolApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolApprovalList.
+func (in *ToolApprovalList) DeepCopy() *ToolApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolApprovalReference) DeepCopyInto(out *ToolApprovalReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolApprovalReference.
+func (in *ToolApprovalReference) DeepCopy() *ToolApprovalReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolApprovalReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ToolCall) DeepCopyInto(out *ToolCall) {
 	*out = *in
@@ -6329,6 +9378,26 @@ func (in *ToolOverride) DeepCopy() *ToolOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolRecertificationRequest) DeepCopyInto(out *ToolRecertificationRequest) {
+	*out = *in
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]ToolApprovalReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolRecertificationRequest.
+func (in *ToolRecertificationRequest) DeepCopy() *ToolRecertificationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolRecertificationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ToolReference) DeepCopyInto(out *ToolReference) {
 	*out = *in
@@ -6435,6 +9504,11 @@ func (in *User) DeepCopyInto(out *User) {
 		copy(*out, *in)
 	}
 	in.LastActiveDay.DeepCopyInto(&out.LastActiveDay)
+	if in.MonthlyMCPToolCallLimit != nil {
+		in, out := &in.MonthlyMCPToolCallLimit, &out.MonthlyMCPToolCallLimit
+		*out = new(int)
+		**out = **in
+	}
 	if in.DeletedAt != nil {
 		in, out := &in.DeletedAt, &out.DeletedAt
 		*out = (*in).DeepCopy()
@@ -6456,6 +9530,161 @@ func (in *User) DeepCopy() *User {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivityExportManifest) DeepCopyInto(out *UserActivityExportManifest) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	in.GeneratedAt.DeepCopyInto(&out.GeneratedAt)
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivityExportManifest.
+func (in *UserActivityExportManifest) DeepCopy() *UserActivityExportManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivityExportManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivityExportOAuth) DeepCopyInto(out *UserActivityExportOAuth) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]UserActivityExportOAuthGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Authorizations != nil {
+		in, out := &in.Authorizations, &out.Authorizations
+		*out = make([]UserActivityExportOAuthGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tokens != nil {
+		in, out := &in.Tokens, &out.Tokens
+		*out = make([]MCPOAuthTokenHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Activity != nil {
+		in, out := &in.Activity, &out.Activity
+		*out = make([]MCPOAuthTelemetry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivityExportOAuth.
+func (in *UserActivityExportOAuth) DeepCopy() *UserActivityExportOAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivityExportOAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivityExportOAuthGrant) DeepCopyInto(out *UserActivityExportOAuthGrant) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	if in.Completed != nil {
+		in, out := &in.Completed, &out.Completed
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivityExportOAuthGrant.
+func (in *UserActivityExportOAuthGrant) DeepCopy() *UserActivityExportOAuthGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivityExportOAuthGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivityExportServer) DeepCopyInto(out *UserActivityExportServer) {
+	*out = *in
+	in.Server.DeepCopyInto(&out.Server)
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivityExportServer.
+func (in *UserActivityExportServer) DeepCopy() *UserActivityExportServer {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivityExportServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivityExportServerInstance) DeepCopyInto(out *UserActivityExportServerInstance) {
+	*out = *in
+	in.Instance.DeepCopyInto(&out.Instance)
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivityExportServerInstance.
+func (in *UserActivityExportServerInstance) DeepCopy() *UserActivityExportServerInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivityExportServerInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserActivityExportSession) DeepCopyInto(out *UserActivityExportSession) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	if in.LastUsedTime != nil {
+		in, out := &in.LastUsedTime, &out.LastUsedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserActivityExportSession.
+func (in *UserActivityExportSession) DeepCopy() *UserActivityExportSession {
+	if in == nil {
+		return nil
+	}
+	out := new(UserActivityExportSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserDefaultRoleSetting) DeepCopyInto(out *UserDefaultRoleSetting) {
 	*out = *in
@@ -6493,6 +9722,58 @@ func (in *UserList) DeepCopy() *UserList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPurgeReport) DeepCopyInto(out *UserPurgeReport) {
+	*out = *in
+	in.PurgedAt.DeepCopyInto(&out.PurgedAt)
+	if in.Deleted != nil {
+		in, out := &in.Deleted, &out.Deleted
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Anonymized != nil {
+		in, out := &in.Anonymized, &out.Anonymized
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPurgeReport.
+func (in *UserPurgeReport) DeepCopy() *UserPurgeReport {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPurgeReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPurgeRequest) DeepCopyInto(out *UserPurgeRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPurgeRequest.
+func (in *UserPurgeRequest) DeepCopy() *UserPurgeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPurgeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Webhook) DeepCopyInto(out *Webhook) {
 	*out = *in
@@ -0,0 +1,124 @@
+// Package webhooksignature signs and verifies the requests that Obot sends to HTTP webhooks.
+//
+// A signed request carries two headers:
+//
+//	X-Obot-Webhook-Timestamp: <unix seconds>
+//	X-Obot-Webhook-Signature: v1=<hex HMAC-SHA256 of "<timestamp>.<body>" with the webhook secret>
+//
+// The signature header may hold several comma-separated signatures, for example while the secret is being rotated.
+// A request is valid if any of them matches. Requests are also signed with the legacy X-Obot-Signature-256 header,
+// which covers only the body and so does not protect against replays.
+package webhooksignature
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the version of the signing scheme.
+	Version = "v1"
+
+	TimestampHeader       = "X-Obot-Webhook-Timestamp"
+	SignatureHeader       = "X-Obot-Webhook-Signature"
+	LegacySignatureHeader = "X-Obot-Signature-256"
+
+	// DefaultTolerance is how far the timestamp of a request may be from the current time.
+	DefaultTolerance = 5 * time.Minute
+
+	// maxBodySize limits how much of a request body Middleware reads.
+	maxBodySize = 10 << 20
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook request is not signed")
+	ErrInvalidTimestamp = errors.New("webhook request has an invalid timestamp")
+	ErrExpiredTimestamp = errors.New("webhook request timestamp is outside the tolerance")
+	ErrInvalidSignature = errors.New("webhook request signature does not match")
+)
+
+// Sign returns the signature of the body sent at the given time.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return Version + "=" + hex.EncodeToString(mac(secret, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+// SetHeaders signs the body and sets the signature headers, including the legacy one, on the header.
+func SetHeaders(header http.Header, secret string, timestamp time.Time, body []byte) {
+	header.Set(TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	header.Set(SignatureHeader, Sign(secret, timestamp, body))
+
+	legacy := hmac.New(sha256.New, []byte(secret))
+	legacy.Write(body)
+	header.Set(LegacySignatureHeader, "sha256="+hex.EncodeToString(legacy.Sum(nil)))
+}
+
+// Verify checks that the headers carry a valid signature of the body that was created within the tolerance of now.
+// A tolerance of zero uses DefaultTolerance.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+
+	timestamp, signatures := header.Get(TimestampHeader), header.Get(SignatureHeader)
+	if timestamp == "" || signatures == "" {
+		return ErrMissingSignature
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > tolerance || age < -tolerance {
+		return ErrExpiredTimestamp
+	}
+
+	expected := mac(secret, timestamp, body)
+	for signature := range strings.SplitSeq(signatures, ",") {
+		version, value, ok := strings.Cut(strings.TrimSpace(signature), "=")
+		if !ok || version != Version {
+			continue
+		}
+		if decoded, err := hex.DecodeString(value); err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// Middleware rejects requests that are not signed with the secret with http.StatusUnauthorized. The body of accepted
+// requests is passed on to the next handler unchanged.
+func Middleware(secret string, tolerance time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if err = Verify(secret, r.Header, body, tolerance, time.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+func mac(secret, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
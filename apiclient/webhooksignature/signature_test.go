@@ -0,0 +1,86 @@
+package webhooksignature
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	body := []byte(`{"jsonrpc":"2.0","method":"tools/call"}`)
+
+	signed := func(secret string, at time.Time) http.Header {
+		h := http.Header{}
+		SetHeaders(h, secret, at, body)
+		return h
+	}
+
+	rotating := signed("old", now)
+	rotating.Set(SignatureHeader, Sign("new", now, body)+", "+rotating.Get(SignatureHeader))
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  http.Header
+		body    []byte
+		wantErr error
+	}{
+		{name: "valid", header: signed("secret", now), body: body},
+		{name: "within tolerance", header: signed("secret", now.Add(-4*time.Minute)), body: body},
+		{name: "any of several signatures", secret: "new", header: rotating, body: body},
+		{name: "unsigned", header: http.Header{}, body: body, wantErr: ErrMissingSignature},
+		{name: "wrong secret", header: signed("other", now), body: body, wantErr: ErrInvalidSignature},
+		{name: "tampered body", header: signed("secret", now), body: []byte(`{}`), wantErr: ErrInvalidSignature},
+		{name: "replayed", header: signed("secret", now.Add(-10*time.Minute)), body: body, wantErr: ErrExpiredTimestamp},
+		{name: "from the future", header: signed("secret", now.Add(10*time.Minute)), body: body, wantErr: ErrExpiredTimestamp},
+		{
+			name: "invalid timestamp",
+			header: http.Header{
+				TimestampHeader: []string{"yesterday"},
+				SignatureHeader: []string{Sign("secret", now, body)},
+			},
+			body:    body,
+			wantErr: ErrInvalidTimestamp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := tt.secret
+			if secret == "" {
+				secret = "secret"
+			}
+			if err := Verify(secret, tt.header, tt.body, 0, now); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	body := `{"jsonrpc":"2.0"}`
+	handler := Middleware("secret", 0, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(b)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	SetHeaders(req.Header, "secret", time.Now(), []byte(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || rec.Body.String() != body {
+		t.Errorf("signed request: got %d %q, want 200 with the body", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("unsigned request: got %d, want 401", rec.Code)
+	}
+}
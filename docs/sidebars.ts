@@ -24,6 +24,10 @@ const sidebars = {
         "functionality/mcp-servers",
         "functionality/mcp-registries",
         "functionality/audit-logs-and-usage",
+        "functionality/alert-rules",
+        "functionality/catalog-event-webhooks",
+        "functionality/backstage",
+        "functionality/catalog-bundles",
         "functionality/filters",
         "functionality/server-scheduling",
         "functionality/obot-agent-management",
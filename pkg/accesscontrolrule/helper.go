@@ -3,6 +3,7 @@ package accesscontrolrule
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
@@ -276,6 +277,34 @@ func (h *Helper) UserHasAccessToMCPServerCatalogEntry(user kuser.Info, entryName
 	return h.UserHasAccessToMCPServerCatalogEntryInCatalog(user, entryName, system.DefaultCatalog)
 }
 
+// GroupHasAccessToMCPServerCatalogEntry checks if any of the rules, which must be the rules of the entry's catalog, give
+// the members of a group access to a catalog entry.
+func GroupHasAccessToMCPServerCatalogEntry(rules []v1.AccessControlRule, groupID, entryName string) bool {
+	for _, rule := range rules {
+		if !slices.ContainsFunc(rule.Spec.Manifest.Resources, func(resource types.Resource) bool {
+			return resource.Type == types.ResourceTypeSelector && resource.ID == "*" ||
+				resource.Type == types.ResourceTypeMCPServerCatalogEntry && resource.ID == entryName
+		}) {
+			continue
+		}
+
+		for _, subject := range rule.Spec.Manifest.Subjects {
+			switch subject.Type {
+			case types.SubjectTypeGroup:
+				if subject.ID == groupID {
+					return true
+				}
+			case types.SubjectTypeSelector:
+				if subject.ID == "*" {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
 // HasWildcardAccessToMCPServerCatalogEntryInCatalog checks if there are ACRs with wildcard selector for an entry
 func (h *Helper) HasWildcardAccessToMCPServerCatalogEntryInCatalog(entryName, catalogID string) (bool, error) {
 	// Check wildcard selector rules first
@@ -0,0 +1,50 @@
+package accesscontrolrule
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupHasAccessToMCPServerCatalogEntry(t *testing.T) {
+	rule := func(subject types.Subject, resource types.Resource) v1.AccessControlRule {
+		return v1.AccessControlRule{
+			Spec: v1.AccessControlRuleSpec{
+				Manifest: types.AccessControlRuleManifest{
+					Subjects:  []types.Subject{subject},
+					Resources: []types.Resource{resource},
+				},
+			},
+		}
+	}
+	var (
+		engineering = types.Subject{Type: types.SubjectTypeGroup, ID: "engineering"}
+		everyone    = types.Subject{Type: types.SubjectTypeSelector, ID: "*"}
+		alice       = types.Subject{Type: types.SubjectTypeUser, ID: "1"}
+		github      = types.Resource{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "github"}
+		jira        = types.Resource{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "jira"}
+		all         = types.Resource{Type: types.ResourceTypeSelector, ID: "*"}
+	)
+
+	tests := []struct {
+		name     string
+		rules    []v1.AccessControlRule
+		expected bool
+	}{
+		{name: "no rules"},
+		{name: "group and entry", rules: []v1.AccessControlRule{rule(engineering, github)}, expected: true},
+		{name: "group and all entries", rules: []v1.AccessControlRule{rule(engineering, all)}, expected: true},
+		{name: "everyone and entry", rules: []v1.AccessControlRule{rule(everyone, github)}, expected: true},
+		{name: "group and other entry", rules: []v1.AccessControlRule{rule(engineering, jira)}},
+		{name: "user and entry", rules: []v1.AccessControlRule{rule(alice, github)}},
+		{name: "other group and entry", rules: []v1.AccessControlRule{rule(types.Subject{Type: types.SubjectTypeGroup, ID: "sales"}, github)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GroupHasAccessToMCPServerCatalogEntry(tt.rules, "engineering", "github"))
+		})
+	}
+}
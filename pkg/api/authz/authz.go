@@ -55,12 +55,18 @@ var (
 		"/api/cronjobs/",
 		"/api/mcp-catalogs",
 		"/api/mcp-catalogs/",
+		"POST /api/catalog-entries/validate",
+		"POST /api/mcp-server-scaffolds",
+		"/api/pending-catalog-entries",
+		"/api/pending-catalog-entries/",
 		"/api/mcp-servers",
 		"/api/mcp-servers/",
 		"/api/workspaces",
 		"/api/workspaces/",
 		"/api/mcp-webhook-validations",
 		"/api/mcp-webhook-validations/",
+		"/api/alert-rules",
+		"/api/alert-rules/",
 		"/api/system-mcp-servers",
 		"/api/system-mcp-servers/",
 		"/api/system-mcp-catalogs",
@@ -68,6 +74,8 @@ var (
 		"GET /api/mcp-audit-logs",
 		"GET /api/mcp-audit-logs/filter-options/{filter}",
 		"GET /api/mcp-audit-logs/detail/{audit_log_id}",
+		"GET /api/mcp-audit-logs/stats/tool-calls",
+		"GET /api/mcp-audit-logs/stats/error-rate",
 		"GET /api/mcp-audit-logs/{mcp_id}",
 		"GET /api/mcp-stats",
 		"GET /api/mcp-stats/{mcp_id}",
@@ -111,6 +119,8 @@ var (
 		"GET /api/active-users",
 		"GET /api/token-usage",
 		"GET /api/total-token-usage",
+		"GET /api/mcp-usage",
+		"GET /api/mcp-oauth-telemetry",
 		"GET /api/tokens",
 		"DELETE /api/tokens/{id}",
 		"/api/oauth-apps",
@@ -119,7 +129,11 @@ var (
 		"/api/user-default-role-settings",
 		"/api/setup/",
 		"/api/k8s-settings",
+		"/api/mcp-log-settings",
+		"/api/database-migrations",
+		"/api/database-migrations/",
 		"/api/mcp-capacity",
+		"/api/mcp-reconcile-stats",
 		"/api/audit-log-exports",
 		"/api/audit-log-exports/{id}",
 		"/api/scheduled-audit-log-exports",
@@ -154,6 +168,7 @@ var (
 		"GET /api/admin-api-keys",
 		"GET /api/admin-api-keys/{id}",
 		"DELETE /api/admin-api-keys/{id}",
+		"POST /api/admin-api-keys/{id}/revoke",
 
 		"/api/projectsv2",
 		"/api/projectsv2/",
@@ -168,10 +183,13 @@ var (
 			"GET /api/mcp-audit-logs",
 			"GET /api/mcp-audit-logs/filter-options/{filter}",
 			"GET /api/mcp-audit-logs/detail/{audit_log_id}",
+			"GET /api/mcp-audit-logs/stats/tool-calls",
+			"GET /api/mcp-audit-logs/stats/error-rate",
 			"GET /api/mcp-audit-logs/{mcp_id}",
 			"GET /api/mcp-stats",
 			"GET /api/mcp-stats/{mcp_id}",
 			"GET /api/mcp-capacity",
+			"GET /api/mcp-reconcile-stats",
 			"GET /api/threads",
 			"GET /api/threads/",
 			"GET /api/runs",
@@ -187,6 +205,8 @@ var (
 			"GET /api/system-mcp-catalogs/",
 			"GET /api/mcp-webhook-validations",
 			"GET /api/mcp-webhook-validations/",
+			"GET /api/alert-rules",
+			"GET /api/alert-rules/",
 			"GET /api/mcp-servers/",
 			"GET /api/tasks",
 			"GET /api/tasks/",
@@ -197,6 +217,7 @@ var (
 			"GET /api/message-policies/",
 			"GET /api/user-default-role-settings",
 			"GET /api/k8s-settings",
+			"GET /api/mcp-log-settings",
 			"POST /api/auth-providers/",
 			"GET /api/workspaces/",
 			"GET /api/projects/",
@@ -227,6 +248,8 @@ var (
 			"GET /api/devices/skills/",
 			"GET /api/token-usage",
 			"GET /api/total-token-usage",
+			"GET /api/mcp-usage",
+			"GET /api/mcp-oauth-telemetry",
 			"GET /api/nanobot-agents",
 		},
 		anyGroup: {
@@ -234,6 +257,9 @@ var (
 			"/oauth2/",
 
 			"POST /api/webhooks/{namespace}/{id}",
+			"POST /api/mcp-catalog-webhooks/{catalog_id}",
+			"POST /api/mcp-catalog-submissions/{catalog_id}",
+			"GET /api/mcp-catalog-submissions/{catalog_id}/{submission_id}",
 			"GET /api/token-request/{id}",
 			"POST /api/token-request",
 			"GET /api/token-request/{id}/{service}",
@@ -272,8 +298,15 @@ var (
 			"POST /oauth/register",
 			"GET /oauth/authorize/{mcp_id}",
 			"GET /oauth/authorize",
+			"POST /oauth/challenge",
 			"POST /oauth/token/{mcp_id}",
 			"POST /oauth/token",
+			"POST /oauth/revoke",
+			"POST /oauth/introspect",
+			"POST /oauth/device_authorization/{mcp_id}",
+			"POST /oauth/device_authorization",
+			"GET /oauth/device",
+			"POST /oauth/device",
 			"GET /oauth/jwks.json",
 
 			// Allow any user to read stored images.
@@ -283,6 +316,10 @@ var (
 			// The auth for this is handled in the HTTP handler
 			"POST /api/mcp-audit-logs",
 
+			// Workspace MCP servers authenticate with their token, which is checked in the HTTP handlers
+			"/api/workspace-server-files",
+			"/api/workspace-server-files/",
+
 			// API Key authentication webhook (called by nanobot shim)
 			// This endpoint validates the API key passed in the header
 			"POST /api/api-keys/auth",
@@ -312,10 +349,19 @@ var (
 			"GET /api/all-mcps/servers",
 			"GET /api/all-mcps/servers/{mcp_server_id}",
 
+			// Elicitations from MCP servers are scoped to the user in the handler.
+			"GET /api/mcp-elicitations/events",
+			"POST /api/mcp-elicitations/{elicitation_id}",
+
+			// Sessions open through mcp-connect are scoped to the user in the handler, unless the user is an admin.
+			"GET /api/mcp-connect-sessions",
+
 			// Audit log access for own servers (filtered in handler)
 			"GET /api/mcp-audit-logs",
 			"GET /api/mcp-audit-logs/filter-options/{filter}",
 			"GET /api/mcp-audit-logs/detail/{audit_log_id}",
+			"GET /api/mcp-audit-logs/stats/tool-calls",
+			"GET /api/mcp-audit-logs/stats/error-rate",
 			"GET /api/mcp-audit-logs/{mcp_id}",
 			"GET /api/mcp-stats",
 			"GET /api/mcp-stats/{mcp_id}",
@@ -349,8 +395,22 @@ var (
 		types.GroupPowerUser: {
 			"GET /api/users",
 			"GET /api/users/{user_id}",
+			// Power users validate entries for their own workspaces, which is checked in the handler.
+			"POST /api/catalog-entries/validate",
+			// Power users scaffold new MCP servers to build and submit as catalog entries.
+			"POST /api/mcp-server-scaffolds",
+			// Power users submit catalog entries for review and manage their own submissions, which is checked in the
+			// handlers. Only admins can approve or reject them.
+			"GET /api/pending-catalog-entries",
+			"GET /api/pending-catalog-entries/{pending_entry_id}",
+			"POST /api/pending-catalog-entries",
+			"PUT /api/pending-catalog-entries/{pending_entry_id}",
+			"DELETE /api/pending-catalog-entries/{pending_entry_id}",
+			"POST /api/pending-catalog-entries/{pending_entry_id}/comments",
 			"GET /api/mcp-audit-logs",
 			"GET /api/mcp-audit-logs/filter-options/{filter}",
+			"GET /api/mcp-audit-logs/stats/tool-calls",
+			"GET /api/mcp-audit-logs/stats/error-rate",
 			"GET /api/mcp-audit-logs/{mcp_id}",
 			"GET /api/mcp-stats",
 			"GET /api/mcp-stats/{mcp_id}",
@@ -365,6 +425,8 @@ var (
 			"GET /api/me",
 			"DELETE /api/me",
 			"PATCH /api/me",
+			"GET /api/me/mcp-oauth-overview",
+			"GET /api/me/mcp-servers-needing-reauthentication",
 			"POST /api/logout-all",
 			"GET /api/version",
 			"GET /api/setup/oauth-complete",
@@ -374,6 +436,7 @@ var (
 			"GET /api/api-keys",
 			"GET /api/api-keys/{id}",
 			"DELETE /api/api-keys/{id}",
+			"POST /api/api-keys/{id}/revoke",
 		},
 
 		// API key users have restricted access - they can only access MCP-connect routes and /api/me
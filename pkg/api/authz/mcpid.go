@@ -2,9 +2,12 @@ package authz
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -17,6 +20,18 @@ func (a *Authorizer) checkMCPID(req *http.Request, resources *Resources, user us
 		return true, nil
 	}
 
+	if slices.Contains(user.GetGroups(), types.GroupOAuthClient) {
+		// OAuth clients can only connect to the MCP server that their token was issued for.
+		return slices.Contains(user.GetExtra()["mcp_id"], resources.MCPID), nil
+	}
+
+	if slices.Contains(user.GetGroups(), types.GroupAPIKey) {
+		// API keys can only connect to the MCP servers that they were created for, on top of the access of their user.
+		if allowed, err := a.apiKeyAllowsMCPID(req, resources.MCPID, user); err != nil || !allowed {
+			return false, err
+		}
+	}
+
 	switch {
 	case system.IsMCPServerInstanceID(resources.MCPID):
 		var mcpServerInstance v1.MCPServerInstance
@@ -63,3 +78,29 @@ func (a *Authorizer) checkMCPID(req *http.Request, resources *Resources, user us
 		return false, nil
 	}
 }
+
+// apiKeyAllowsMCPID returns whether the MCP servers of the user's API key include the one with mcpID. Server instances
+// are allowed if the key allows their multi-user server, and component servers if it allows their composite server.
+func (a *Authorizer) apiKeyAllowsMCPID(req *http.Request, mcpID string, user user.Info) (bool, error) {
+	apiKey := gatewaytypes.APIKey{MCPServerIDs: user.GetExtra()[types.APIKeyMCPServerIDsExtraKey]}
+	if system.IsWebhookSystemMCPServerID(mcpID) || apiKey.AllowsMCPServer(mcpID) {
+		return true, nil
+	}
+
+	switch {
+	case system.IsMCPServerInstanceID(mcpID):
+		var mcpServerInstance v1.MCPServerInstance
+		if err := a.get(req.Context(), router.Key(system.DefaultNamespace, mcpID), &mcpServerInstance); err != nil {
+			return false, err
+		}
+		return apiKey.AllowsMCPServer(mcpServerInstance.Spec.MCPServerName), nil
+	case system.IsMCPServerID(mcpID):
+		var mcpServer v1.MCPServer
+		if err := a.get(req.Context(), router.Key(system.DefaultNamespace, mcpID), &mcpServer); err != nil {
+			return false, err
+		}
+		return apiKey.AllowsMCPServer(mcpServer.Spec.CompositeName), nil
+	}
+
+	return false, nil
+}
@@ -425,6 +425,7 @@ func TestMCPConnectSubtreeAuthorization(t *testing.T) {
 				Name:   "key-user",
 				UID:    "key-user-uid",
 				Groups: []string{types.GroupAPIKey},
+				Extra:  map[string][]string{types.APIKeyMCPServerIDsExtraKey: {"*"}},
 			},
 			allowed: true,
 		},
@@ -436,6 +437,7 @@ func TestMCPConnectSubtreeAuthorization(t *testing.T) {
 				Name:   "key-user",
 				UID:    "key-user-uid",
 				Groups: []string{types.GroupAPIKey},
+				Extra:  map[string][]string{types.APIKeyMCPServerIDsExtraKey: {"*"}},
 			},
 			allowed: true,
 		},
@@ -553,3 +555,80 @@ func newMCPIDTestAuthorizer(t *testing.T, storage client.Client, acrs ...*v1.Acc
 		acrHelper: accesscontrolrule.NewAccessControlRuleHelper(indexer, storage),
 	}
 }
+
+func TestCheckMCPIDRestrictsOAuthClientsToTokenAudience(t *testing.T) {
+	authorizer := &Authorizer{}
+	client := &user.DefaultInfo{
+		Name:   "default:oc1test",
+		UID:    "default:oc1test",
+		Groups: []string{types.GroupOAuthClient},
+		Extra:  map[string][]string{"mcp_id": {"ms1allowed"}},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1allowed", nil)
+	ok, err := authorizer.checkMCPID(req, &Resources{MCPID: "ms1allowed"}, client)
+	if err != nil {
+		t.Fatalf("checkMCPID() error = %v", err)
+	}
+	if !ok {
+		t.Fatal("checkMCPID() = false, want true for the token's MCP server")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1other", nil)
+	ok, err = authorizer.checkMCPID(req, &Resources{MCPID: "ms1other"}, client)
+	if err != nil {
+		t.Fatalf("checkMCPID() error = %v", err)
+	}
+	if ok {
+		t.Fatal("checkMCPID() = true, want false for another MCP server")
+	}
+}
+
+func TestCheckMCPIDRestrictsAPIKeysToTheirMCPServers(t *testing.T) {
+	storage := clientfake.NewClientBuilder().WithScheme(storagescheme.Scheme).WithObjects(
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1keytest", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerSpec{UserID: "key-user-uid"},
+		},
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1keyother", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerSpec{UserID: "key-user-uid"},
+		},
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1keycomponent", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerSpec{UserID: "key-user-uid", CompositeName: "ms1keytest"},
+		},
+		&v1.MCPServerInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "msi1keytest", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerInstanceSpec{UserID: "key-user-uid", MCPServerName: "ms1keytest"},
+		},
+		&v1.MCPServerInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "msi1keyother", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerInstanceSpec{UserID: "key-user-uid", MCPServerName: "ms1keyother"},
+		},
+	).Build()
+	authorizer := newMCPIDTestAuthorizer(t, storage)
+	apiKeyUser := &user.DefaultInfo{
+		Name:   "key-user",
+		UID:    "key-user-uid",
+		Groups: []string{types.GroupAPIKey},
+		Extra:  map[string][]string{types.APIKeyMCPServerIDsExtraKey: {"ms1keytest"}},
+	}
+
+	for mcpID, want := range map[string]bool{
+		"ms1keytest":      true,
+		"ms1keycomponent": true,
+		"msi1keytest":     true,
+		"ms1keyother":     false,
+		"msi1keyother":    false,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/mcp-connect/"+mcpID, nil)
+		ok, err := authorizer.checkMCPID(req, &Resources{MCPID: mcpID}, apiKeyUser)
+		if err != nil {
+			t.Fatalf("checkMCPID(%s) error = %v", mcpID, err)
+		}
+		if ok != want {
+			t.Fatalf("checkMCPID(%s) = %v, want %v", mcpID, ok, want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+func TestMCPServerWatchAuthorization(t *testing.T) {
+	authorizer := NewAuthorizer(nil, nil, false, nil, false)
+
+	tests := []struct {
+		name    string
+		user    user.Info
+		allowed bool
+	}{
+		{
+			name: "basic user can watch their MCP servers",
+			user: &user.DefaultInfo{
+				Name:   "user",
+				Groups: []string{types.GroupBasic, types.GroupAuthenticated},
+			},
+			allowed: true,
+		},
+		{
+			name: "unauthenticated user cannot watch MCP servers",
+			user: &user.DefaultInfo{
+				Name:   "anonymous",
+				Groups: []string{UnauthenticatedGroup},
+			},
+			allowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/mcp-servers/watch", nil)
+			assert.Equal(t, tt.allowed, authorizer.Authorize(req, tt.user))
+		})
+	}
+}
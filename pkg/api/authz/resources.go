@@ -17,6 +17,7 @@ var apiResources = map[string][]string{
 		"GET    /api/all-mcps/servers/{mcpserver_id}/prompts/{prompt_name}",
 		"GET    /oauth/callback/{oauth_request_id}/{mcp_id}",
 		"GET    /oauth/mcp/callback",
+		"GET    /oauth/mcp/consent",
 		"GET    /auth/mcp/composite/{mcp_id}",
 		"GET    /api/oauth/composite/{mcp_id}",
 		"GET    /mcp-connect/{mcp_id}",
@@ -166,27 +167,44 @@ var apiResources = map[string][]string{
 		"POST   /api/mcp-server-instances/{mcp_server_instance_id}/reveal",
 		"POST   /api/mcp-server-instances/{mcp_server_instance_id}/configure",
 		"POST   /api/mcp-server-instances/{mcp_server_instance_id}/deconfigure",
+		"PUT    /api/mcp-server-instances/{mcp_server_instance_id}/tool-allowlist",
 		"GET    /api/mcp-servers",
+		"GET    /api/mcp-servers/health",
+		"GET    /api/mcp-servers/watch",
 		"GET    /api/mcp-servers/{mcpserver_id}",
 		"POST   /api/mcp-servers/{mcpserver_id}/launch",
+		"GET    /api/mcp-servers/{mcpserver_id}/launch",
 		"POST   /api/mcp-servers/{mcpserver_id}/check-oauth",
 		"GET    /api/mcp-servers/{mcpserver_id}/oauth-url",
+		"GET    /api/mcp-servers/{mcpserver_id}/tool-conflicts",
 		"POST   /api/mcp-servers",
 		"DELETE /api/mcp-servers/{mcpserver_id}",
 		"DELETE /api/mcp-servers/{mcpserver_id}/oauth",
 		"GET    /api/mcp-servers/{mcpserver_id}/logs",
 		"PUT	/api/mcp-servers/{mcpserver_id}/alias",
 		"POST   /api/mcp-servers/{mcpserver_id}/update-url",
+		"POST   /api/mcp-servers/{mcpserver_id}/clone",
 		"POST   /api/mcp-servers/{mcpserver_id}/configure",
+		"POST   /api/mcp-servers/{mcpserver_id}/rotate-credentials",
 		"POST   /api/mcp-servers/{mcpserver_id}/deconfigure",
 		"POST   /api/mcp-servers/{mcpserver_id}/reveal",
 		"POST   /api/mcp-servers/{mcpserver_id}/restart",
 		"POST   /api/mcp-servers/{mcpserver_id}/trigger-update",
+		"GET    /api/mcp-servers/{mcpserver_id}/update-preview",
+		"GET    /api/mcp-servers/{mcpserver_id}/catalog-entry-revisions",
+		"PUT    /api/mcp-servers/{mcpserver_id}/pinned-revision",
 		"GET    /api/mcp-servers/{mcpserver_id}/tools",
+		"GET    /api/mcp-servers/{mcpserver_id}/roots",
+		"PUT    /api/mcp-servers/{mcpserver_id}/roots",
+		"GET    /api/mcp-servers/{mcpserver_id}/oauth-token-sources",
+		"POST   /api/mcp-servers/{mcpserver_id}/oauth-token-sources/{oauth_app}/authorize",
+		"DELETE /api/mcp-servers/{mcpserver_id}/oauth-token-sources/{oauth_app}",
 		"GET    /api/mcp-servers/{mcpserver_id}/resources",
 		"GET    /api/mcp-servers/{mcpserver_id}/resources/{resource_uri}",
+		"GET    /api/mcp-servers/{mcpserver_id}/resource-updates",
 		"GET    /api/mcp-servers/{mcpserver_id}/prompts",
 		"GET    /api/mcp-servers/{mcpserver_id}/prompts/{prompt_name}",
+		"POST   /api/mcp-servers/{mcpserver_id}/complete",
 		"GET    /api/projects",
 		"GET    /api/projects/{project_id}",
 		"POST   /api/prompt",
@@ -196,6 +214,8 @@ var apiResources = map[string][]string{
 		"GET    /api/templates",
 		"GET    /api/templates/{template_public_id}",
 		"POST   /api/templates/{template_public_id}",
+		"GET    /api/tool-approvals",
+		"POST   /api/tool-approvals/recertify",
 		"GET    /api/published-artifacts/{artifact_id}",
 		"GET    /api/published-artifacts/{artifact_id}/download",
 		"GET    /api/published-artifacts/{artifact_id}/{artifact_version}/skill",
@@ -239,6 +259,7 @@ var apiResources = map[string][]string{
 		"GET    /api/users/{user_id}/token-usage",
 		"GET    /api/users/{user_id}/total-token-usage",
 		"GET    /api/users/{user_id}/remaining-token-usage",
+		"GET    /api/users/{user_id}/remaining-mcp-tool-calls",
 		"GET    /api/workspaces",
 		"GET    /api/projectsv2/{projectv2_id}",
 		"PUT    /api/projectsv2/{projectv2_id}",
@@ -275,15 +296,19 @@ var apiResources = map[string][]string{
 		"DELETE /api/workspaces/{workspace_id}/servers/{mcp_server_id}",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}",
 		"PUT    /api/workspaces/{workspace_id}/servers/{mcp_server_id}",
+		"PATCH  /api/workspaces/{workspace_id}/servers/{mcp_server_id}/components",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/launch",
+		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/launch",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/check-oauth",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/oauth-url",
 		"DELETE /api/workspaces/{workspace_id}/servers/{mcp_server_id}/oauth",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/configure",
+		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/rotate-credentials",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/deconfigure",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/reveal",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/instances",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/details",
+		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-conflicts",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/logs",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/restart",
 		"GET    /api/workspaces/{workspace_id}/access-control-rules",
@@ -304,6 +329,14 @@ var apiResources = map[string][]string{
 		"GET    /api/published-artifacts/{artifact_id}/download",
 		"GET    /api/published-artifacts/{artifact_id}/{artifact_version}/skill",
 	},
+	types.GroupOAuthClient: {
+		"GET    /mcp-connect/{mcp_id}",
+		"POST   /mcp-connect/{mcp_id}",
+		"DELETE /mcp-connect/{mcp_id}",
+		"GET    /mcp-connect/{mcp_id}/",
+		"POST   /mcp-connect/{mcp_id}/",
+		"DELETE /mcp-connect/{mcp_id}/",
+	},
 }
 
 type Resources struct {
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type AlertRuleHandler struct{}
+
+func NewAlertRuleHandler() *AlertRuleHandler {
+	return &AlertRuleHandler{}
+}
+
+// List returns all alert rules.
+func (*AlertRuleHandler) List(req api.Context) error {
+	var list v1.AlertRuleList
+	if err := req.List(&list); err != nil {
+		return fmt.Errorf("failed to list alert rules: %w", err)
+	}
+
+	items := make([]types.AlertRule, 0, len(list.Items))
+	for _, item := range list.Items {
+		hasSecret, err := alertRuleHasSecret(req, item.Name)
+		if err != nil {
+			return err
+		}
+		items = append(items, convertAlertRule(item, hasSecret))
+	}
+
+	return req.Write(types.AlertRuleList{
+		Items: items,
+	})
+}
+
+// Get returns a specific alert rule by ID.
+func (*AlertRuleHandler) Get(req api.Context) error {
+	var rule v1.AlertRule
+	if err := req.Get(&rule, req.PathValue("id")); err != nil {
+		return fmt.Errorf("failed to get alert rule: %w", err)
+	}
+
+	hasSecret, err := alertRuleHasSecret(req, rule.Name)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertAlertRule(rule, hasSecret))
+}
+
+// Create creates a new alert rule.
+func (*AlertRuleHandler) Create(req api.Context) error {
+	var manifest types.AlertRuleManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read alert rule manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid alert rule manifest: %v", err)
+	}
+
+	// Don't save the secret in the database.
+	secret := manifest.Notification.Secret
+	manifest.Notification.Secret = ""
+
+	rule := v1.AlertRule{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.AlertRulePrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.AlertRuleSpec{
+			Manifest: manifest,
+		},
+	}
+
+	if err := req.Create(&rule); err != nil {
+		return fmt.Errorf("failed to create alert rule: %w", err)
+	}
+
+	if secret != "" {
+		if err := storeAlertRuleSecret(req, rule.Name, secret); err != nil {
+			_ = req.Delete(&rule)
+			return err
+		}
+	}
+
+	return req.Write(convertAlertRule(rule, secret != ""))
+}
+
+// Update updates an existing alert rule. The secret of the notification is kept if the manifest doesn't set one.
+func (*AlertRuleHandler) Update(req api.Context) error {
+	var manifest types.AlertRuleManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read alert rule manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid alert rule manifest: %v", err)
+	}
+
+	var existing v1.AlertRule
+	if err := req.Get(&existing, req.PathValue("id")); err != nil {
+		return fmt.Errorf("failed to get alert rule: %w", err)
+	}
+
+	secret := manifest.Notification.Secret
+	manifest.Notification.Secret = ""
+
+	if secret != "" {
+		if err := storeAlertRuleSecret(req, existing.Name, secret); err != nil {
+			return err
+		}
+	}
+
+	existing.Spec.Manifest = manifest
+	if err := req.Update(&existing); err != nil {
+		return fmt.Errorf("failed to update alert rule: %w", err)
+	}
+
+	hasSecret, err := alertRuleHasSecret(req, existing.Name)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertAlertRule(existing, hasSecret))
+}
+
+// Delete deletes an alert rule and the secret of its notification.
+func (*AlertRuleHandler) Delete(req api.Context) error {
+	ruleID := req.PathValue("id")
+
+	if err := DeleteCredentialIfExists(req.Context(), req.GPTClient, []string{system.AlertRuleCredentialContext}, ruleID); err != nil {
+		return err
+	}
+
+	return req.Delete(&v1.AlertRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ruleID,
+			Namespace: req.Namespace(),
+		},
+	})
+}
+
+func storeAlertRuleSecret(req api.Context, ruleName, secret string) error {
+	if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+		Context:  system.AlertRuleCredentialContext,
+		ToolName: ruleName,
+		Type:     gptscript.CredentialTypeTool,
+		Env: map[string]string{
+			"secret": secret,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+	return nil
+}
+
+func alertRuleHasSecret(req api.Context, ruleName string) (bool, error) {
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{system.AlertRuleCredentialContext}, ruleName)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to reveal credential: %w", err)
+	}
+	return cred.Env["secret"] != "", nil
+}
+
+func convertAlertRule(rule v1.AlertRule, hasSecret bool) types.AlertRule {
+	result := types.AlertRule{
+		Metadata:          MetadataFrom(&rule),
+		AlertRuleManifest: rule.Spec.Manifest,
+		HasSecret:         hasSecret,
+		State:             types.AlertRuleStateOK,
+		Firing:            rule.Status.Firing,
+		Error:             rule.Status.Error,
+	}
+
+	if !rule.Status.LastEvaluatedAt.IsZero() {
+		result.LastEvaluatedAt = types.NewTime(rule.Status.LastEvaluatedAt.Time)
+	}
+
+	switch {
+	case rule.Spec.Manifest.Disabled:
+		result.State = types.AlertRuleStateDisabled
+	case len(rule.Status.Firing) > 0:
+		result.State = types.AlertRuleStateFiring
+	}
+
+	return result
+}
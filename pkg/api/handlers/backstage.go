@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	backstageAPIVersion = "backstage.io/v1alpha1"
+	// backstageMCPServerType is the type of the APIs and Components of MCP servers.
+	backstageMCPServerType = "mcp-server"
+	// defaultBackstageOwner is the owner of the entities if the request doesn't set one.
+	defaultBackstageOwner = "obot"
+
+	backstageCatalogAnnotation      = "obot.ai/mcp-catalog-id"
+	backstageCatalogEntryAnnotation = "obot.ai/catalog-entry-id"
+	backstageServerAnnotation       = "obot.ai/mcp-server-id"
+	backstageDeploymentAnnotation   = "obot.ai/deployment-status"
+)
+
+var invalidBackstageTagChars = regexp.MustCompile(`[^a-z0-9:+#]+`)
+
+type BackstageHandler struct{}
+
+func NewBackstageHandler() *BackstageHandler {
+	return &BackstageHandler{}
+}
+
+// CatalogEntities handles GET /api/mcp-catalogs/{catalog_id}/backstage-entities. It describes the catalog entries and
+// multi-user servers of a catalog as Backstage entities, so that a Backstage catalog location can point at it. The
+// entities are written as a multi-document catalog-info YAML file, or as a JSON list if the format query parameter is
+// json. The owner query parameter sets the owner of the entities.
+func (*BackstageHandler) CatalogEntities(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	format := req.URL.Query().Get("format")
+	if format != "" && format != "yaml" && format != "json" {
+		return types.NewErrBadRequest("invalid format %q: must be yaml or json", format)
+	}
+
+	owner := req.URL.Query().Get("owner")
+	if owner == "" {
+		owner = defaultBackstageOwner
+	}
+
+	var entries v1.MCPServerCatalogEntryList
+	if err := req.List(&entries, kclient.MatchingFields{
+		"spec.mcpCatalogName": catalog.Name,
+	}); err != nil {
+		return fmt.Errorf("failed to list catalog entries: %w", err)
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers, kclient.MatchingFields{
+		"spec.mcpCatalogID": catalog.Name,
+	}); err != nil {
+		return fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+
+	entities, err := backstageEntities(req.ExternalBaseURL, owner, catalog, entries.Items, servers.Items)
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		return req.Write(types.BackstageEntityList{
+			Items: entities,
+		})
+	}
+
+	var body strings.Builder
+	for _, entity := range entities {
+		data, err := yaml.Marshal(entity)
+		if err != nil {
+			return fmt.Errorf("failed to marshal Backstage entity %s: %w", entity.Metadata.Name, err)
+		}
+		body.WriteString("---\n")
+		body.Write(data)
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "application/yaml")
+	_, err = req.ResponseWriter.Write([]byte(body.String()))
+	return err
+}
+
+// backstageEntities returns the System of the catalog, followed by an API for each catalog entry and a Component for
+// each multi-user server.
+func backstageEntities(baseURL, owner string, catalog v1.MCPCatalog, entries []v1.MCPServerCatalogEntry, servers []v1.MCPServer) ([]types.BackstageEntity, error) {
+	title := catalog.Spec.DisplayName
+	if title == "" {
+		title = catalog.Name
+	}
+
+	entities := []types.BackstageEntity{
+		{
+			APIVersion: backstageAPIVersion,
+			Kind:       "System",
+			Metadata: types.BackstageEntityMetadata{
+				Name:        catalog.Name,
+				Title:       title,
+				Description: "MCP servers of the " + title + " catalog in Obot",
+				Annotations: map[string]string{
+					backstageCatalogAnnotation: catalog.Name,
+				},
+			},
+			Spec: types.BackstageEntitySpec{
+				Owner: owner,
+			},
+		},
+	}
+
+	apis := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		definition, err := backstageAPIDefinition(entry.Spec.Manifest.ToolPreview)
+		if err != nil {
+			return nil, err
+		}
+
+		manifest := entry.Spec.Manifest
+		entities = append(entities, types.BackstageEntity{
+			APIVersion: backstageAPIVersion,
+			Kind:       "API",
+			Metadata: types.BackstageEntityMetadata{
+				Name:        entry.Name,
+				Title:       manifest.Name,
+				Description: backstageDescription(manifest.ShortDescription, manifest.Description),
+				Annotations: map[string]string{
+					backstageCatalogAnnotation:      catalog.Name,
+					backstageCatalogEntryAnnotation: entry.Name,
+				},
+				Tags:  backstageTags(string(manifest.Runtime), manifest.Tags),
+				Links: backstageLinks(baseURL+"/admin/mcp-servers/c/"+entry.Name, manifest.RepoURL),
+			},
+			Spec: types.BackstageEntitySpec{
+				Type:       backstageMCPServerType,
+				Lifecycle:  "production",
+				Owner:      owner,
+				System:     catalog.Name,
+				Definition: definition,
+			},
+		})
+		apis[entry.Name] = true
+	}
+
+	for _, server := range servers {
+		if server.Spec.Template || server.Spec.CompositeName != "" {
+			continue
+		}
+
+		manifest := server.Spec.Manifest
+		entity := types.BackstageEntity{
+			APIVersion: backstageAPIVersion,
+			Kind:       "Component",
+			Metadata: types.BackstageEntityMetadata{
+				Name:        server.Name,
+				Title:       manifest.Name,
+				Description: backstageDescription(manifest.ShortDescription, manifest.Description),
+				Annotations: map[string]string{
+					backstageCatalogAnnotation: catalog.Name,
+					backstageServerAnnotation:  server.Name,
+				},
+				Tags:  backstageTags(string(manifest.Runtime), nil),
+				Links: backstageLinks(baseURL+"/admin/mcp-servers/s/"+server.Name, ""),
+			},
+			Spec: types.BackstageEntitySpec{
+				Type:      backstageMCPServerType,
+				Lifecycle: "production",
+				Owner:     owner,
+				System:    catalog.Name,
+			},
+		}
+		if server.Status.DeploymentStatus != "" {
+			entity.Metadata.Annotations[backstageDeploymentAnnotation] = server.Status.DeploymentStatus
+		}
+		if entryName := server.Spec.MCPServerCatalogEntryName; apis[entryName] {
+			entity.Spec.ProvidesAPIs = []string{"api:" + entryName}
+		}
+		entities = append(entities, entity)
+	}
+
+	return entities, nil
+}
+
+// backstageAPIDefinition returns the definition of the API of an MCP server: a YAML list of its tools.
+func backstageAPIDefinition(tools []types.MCPServerTool) (string, error) {
+	type tool struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}
+
+	definition := struct {
+		Tools []tool `json:"tools"`
+	}{
+		Tools: make([]tool, 0, len(tools)),
+	}
+	for _, t := range tools {
+		definition.Tools = append(definition.Tools, tool{
+			Name:        t.Name,
+			Description: t.Description,
+		})
+	}
+
+	data, err := yaml.Marshal(definition)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal API definition: %w", err)
+	}
+	return string(data), nil
+}
+
+func backstageDescription(shortDescription, description string) string {
+	if shortDescription != "" {
+		return shortDescription
+	}
+	return description
+}
+
+// backstageTags converts tags to Backstage tags, which are lowercase words of letters, digits, and the characters :+#
+// joined by dashes, and are at most 63 characters long.
+func backstageTags(runtime string, tags []string) []string {
+	var (
+		result []string
+		seen   = make(map[string]bool)
+	)
+	for _, tag := range append([]string{backstageMCPServerType, runtime}, tags...) {
+		tag = strings.Trim(invalidBackstageTagChars.ReplaceAllString(strings.ToLower(tag), "-"), "-")
+		if len(tag) > 63 {
+			tag = strings.TrimRight(tag[:63], "-")
+		}
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+func backstageLinks(obotURL, repoURL string) []types.BackstageLink {
+	links := []types.BackstageLink{
+		{
+			URL:   obotURL,
+			Title: "Obot",
+		},
+	}
+	if repoURL != "" {
+		links = append(links, types.BackstageLink{
+			URL:   repoURL,
+			Title: "Repository",
+		})
+	}
+	return links
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackstageTags(t *testing.T) {
+	got := backstageTags("containerized", []string{"Dev Tools", "GitHub", "c++", "  ", "dev-tools"})
+	want := []string{"mcp-server", "containerized", "dev-tools", "github", "c++"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBackstageEntities(t *testing.T) {
+	catalog := v1.MCPCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       v1.MCPCatalogSpec{DisplayName: "Default"},
+	}
+	entries := []v1.MCPServerCatalogEntry{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "github"},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: "default",
+				Manifest: types.MCPServerCatalogEntryManifest{
+					Name:             "GitHub",
+					ShortDescription: "Manage repositories",
+					RepoURL:          "https://github.com/github/github-mcp-server",
+					Runtime:          types.RuntimeRemote,
+					ToolPreview: []types.MCPServerTool{
+						{Name: "create_issue", Description: "Create an issue"},
+					},
+				},
+			},
+		},
+	}
+	servers := []v1.MCPServer{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1github"},
+			Spec: v1.MCPServerSpec{
+				MCPCatalogID:              "default",
+				MCPServerCatalogEntryName: "github",
+				Manifest:                  types.MCPServerManifest{Name: "GitHub", Runtime: types.RuntimeRemote},
+			},
+			Status: v1.MCPServerStatus{DeploymentStatus: "Available"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1template"},
+			Spec:       v1.MCPServerSpec{MCPCatalogID: "default", Template: true},
+		},
+	}
+
+	entities, err := backstageEntities("https://obot.example.com", "group:platform", catalog, entries, servers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entities) != 3 {
+		t.Fatalf("expected 3 entities, got %+v", entities)
+	}
+
+	system, api, component := entities[0], entities[1], entities[2]
+	if system.Kind != "System" || system.Metadata.Name != "default" || system.Metadata.Title != "Default" || system.Spec.Owner != "group:platform" {
+		t.Errorf("unexpected system: %+v", system)
+	}
+
+	if api.Kind != "API" || api.Metadata.Name != "github" || api.Spec.Type != "mcp-server" || api.Spec.System != "default" {
+		t.Errorf("unexpected API: %+v", api)
+	}
+	if api.Metadata.Description != "Manage repositories" {
+		t.Errorf("unexpected API description: %q", api.Metadata.Description)
+	}
+	if want := "tools:\n- description: Create an issue\n  name: create_issue\n"; api.Spec.Definition != want {
+		t.Errorf("expected definition %q, got %q", want, api.Spec.Definition)
+	}
+	if len(api.Metadata.Links) != 2 || api.Metadata.Links[0].URL != "https://obot.example.com/admin/mcp-servers/c/github" {
+		t.Errorf("unexpected API links: %+v", api.Metadata.Links)
+	}
+
+	if component.Kind != "Component" || component.Metadata.Name != "ms1github" || !slices.Equal(component.Spec.ProvidesAPIs, []string{"api:github"}) {
+		t.Errorf("unexpected component: %+v", component)
+	}
+	if status := component.Metadata.Annotations[backstageDeploymentAnnotation]; status != "Available" {
+		t.Errorf("expected deployment status annotation Available, got %q", status)
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// callHandler calls a handler as if it were serving req with other path values and body, and returns the status code
+// and body of its response. This lets one request perform the work of several, such as a bulk action, with each of
+// them checked exactly as it would be on its own.
+func callHandler(req api.Context, pathValues map[string]string, body []byte, handler api.HandlerFunc) (int, []byte) {
+	handlerRequest := req.Request.Clone(req.Context())
+	for key, value := range pathValues {
+		handlerRequest.SetPathValue(key, value)
+	}
+	handlerRequest.Body = io.NopCloser(bytes.NewReader(body))
+	handlerRequest.ContentLength = int64(len(body))
+
+	recorder := &handlerRecorder{header: make(http.Header)}
+	handlerContext := req
+	handlerContext.Request = handlerRequest
+	handlerContext.ResponseWriter = recorder
+
+	if err := handler(handlerContext); err != nil {
+		code, message := handlerErrorStatus(err)
+		return code, []byte(message)
+	}
+
+	if recorder.code == 0 {
+		return http.StatusOK, recorder.body.Bytes()
+	}
+	return recorder.code, recorder.body.Bytes()
+}
+
+// handlerErrorStatus returns the status code and message that the API server would have responded with for an error.
+func handlerErrorStatus(err error) (int, string) {
+	if errHTTP := (*types.ErrHTTP)(nil); errors.As(err, &errHTTP) {
+		return errHTTP.Code, errHTTP.Message
+	}
+	if errStatus := (*apierrors.StatusError)(nil); errors.As(err, &errStatus) {
+		return int(errStatus.ErrStatus.Code), errStatus.Error()
+	}
+	return http.StatusInternalServerError, err.Error()
+}
+
+// handlerRecorder records the response of a handler called by callHandler.
+type handlerRecorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (r *handlerRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *handlerRecorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+func (r *handlerRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	connectAliasHostRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	connectAliasPathRegex = regexp.MustCompile(`^(/[a-z0-9][a-z0-9._-]*)+$`)
+)
+
+// ConnectBaseURL returns the base URL for the connect URLs of servers in the given catalog.
+// The catalog's external URL is used if it has one. Otherwise, the URL that the client used to reach Obot is used.
+func ConnectBaseURL(req api.Context, catalogID string) string {
+	if catalogID != "" {
+		var catalog v1.MCPCatalog
+		if err := req.Get(&catalog, catalogID); err == nil && catalog.Spec.ExternalURL != "" {
+			return catalog.Spec.ExternalURL
+		}
+	}
+
+	return req.ExternalBaseURL
+}
+
+// MCPServerConnectBaseURL returns the base URL for the connect URL of the given MCP server.
+func MCPServerConnectBaseURL(req api.Context, server v1.MCPServer) string {
+	catalogID := server.Spec.MCPCatalogID
+	if catalogID == "" {
+		catalogID = server.Status.MCPCatalogID
+	}
+	return ConnectBaseURL(req, catalogID)
+}
+
+// normalizeAndValidateCatalogExternalURL checks that the external URL of a catalog is an absolute HTTP(S) URL and
+// returns it without a trailing slash.
+func normalizeAndValidateCatalogExternalURL(externalURL string) (string, error) {
+	if externalURL == "" {
+		return "", nil
+	}
+
+	u, err := url.Parse(externalURL)
+	if err != nil {
+		return "", types.NewErrBadRequest("invalid external URL: %v", err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", types.NewErrBadRequest("external URL must use http or https")
+	}
+	if u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", types.NewErrBadRequest("external URL must only have a scheme, host, and optional path")
+	}
+
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
+
+// SetConnectAlias sets or removes the vanity hostname that the gateway routes to a multi-user MCP server.
+func (m *MCPHandler) SetConnectAlias(req api.Context) error {
+	var (
+		server    v1.MCPServer
+		id        = req.PathValue("mcp_server_id")
+		catalogID = req.PathValue("catalog_id")
+	)
+
+	var input types.MCPServerConnectAliasRequest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if err := req.Get(&server, id); err != nil {
+		return err
+	}
+	if server.Spec.MCPCatalogID == "" || server.Spec.MCPCatalogID != catalogID {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	alias, err := normalizeAndValidateConnectAlias(input.ConnectAlias, m.serverURL, req.ExternalBaseURL)
+	if err != nil {
+		return err
+	}
+
+	if alias != "" {
+		host, _ := v1.SplitConnectAlias(alias)
+
+		var servers v1.MCPServerList
+		if err := req.List(&servers, kclient.MatchingFields{"spec.connectAliasHost": host}); err != nil {
+			return fmt.Errorf("failed to list MCP servers with connect aliases: %w", err)
+		}
+		for _, other := range servers.Items {
+			if other.Name != server.Name && other.Spec.ConnectAlias == alias {
+				return types.NewErrAlreadyExists("connect alias %s is already used by MCP server %s", alias, other.Name)
+			}
+		}
+	}
+
+	server.Spec.ConnectAlias = alias
+	if err := req.Update(&server); err != nil {
+		return fmt.Errorf("failed to update MCP server: %w", err)
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), catalogID, "")
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
+	}
+
+	return req.Write(ConvertMCPServer(server, nil, MCPServerConnectBaseURL(req, server), slug))
+}
+
+// SetExternalExposure sets or removes the custom hostname that a multi-user MCP server is exposed on directly, through
+// objects that the Kubernetes backend generates.
+func (m *MCPHandler) SetExternalExposure(req api.Context) error {
+	var (
+		server    v1.MCPServer
+		id        = req.PathValue("mcp_server_id")
+		catalogID = req.PathValue("catalog_id")
+	)
+
+	var input types.MCPServerExternalExposureRequest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if err := req.Get(&server, id); err != nil {
+		return err
+	}
+	if server.Spec.MCPCatalogID == "" || server.Spec.MCPCatalogID != catalogID {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	exposure, err := normalizeAndValidateExternalExposure(input.ExternalExposure, m.serverURL, req.ExternalBaseURL)
+	if err != nil {
+		return err
+	}
+
+	if exposure != nil {
+		if !m.mcpSessionManager.ExternalExposureEnabled() {
+			return types.NewErrBadRequest("exposing MCP servers on custom hostnames is not configured")
+		}
+
+		var servers v1.MCPServerList
+		if err := req.List(&servers, kclient.MatchingFields{"spec.externalExposureHost": exposure.Hostname}); err != nil {
+			return fmt.Errorf("failed to list exposed MCP servers: %w", err)
+		}
+		for _, other := range servers.Items {
+			if other.Name != server.Name {
+				return types.NewErrAlreadyExists("hostname %s is already used by MCP server %s", exposure.Hostname, other.Name)
+			}
+		}
+	}
+
+	server.Spec.ExternalExposure = exposure
+	if err := req.Update(&server); err != nil {
+		return fmt.Errorf("failed to update MCP server: %w", err)
+	}
+
+	// Redeploy the server in the background, so that it is exposed, or stops being exposed, right away. A server that
+	// isn't configured yet is exposed when it is first launched.
+	if server.Spec.Manifest.Runtime != types.RuntimeComposite {
+		if serverConfig, err := serverConfigForAction(req, server); err == nil {
+			m.mcpSessionManager.StartLaunch(server.Name, func(ctx context.Context) error {
+				return m.launchServer(ctx, server, serverConfig)
+			}, launchErrorMessage)
+		}
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), catalogID, "")
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
+	}
+
+	return req.Write(ConvertMCPServer(server, nil, MCPServerConnectBaseURL(req, server), slug))
+}
+
+// normalizeAndValidateExternalExposure checks that the hostname of an exposure is a DNS hostname that is not one of
+// Obot's own hostnames, and that its TLS secret name is valid. It returns nil if the exposure has no hostname.
+func normalizeAndValidateExternalExposure(exposure *types.MCPServerExternalExposure, serverURLs ...string) (*types.MCPServerExternalExposure, error) {
+	if exposure == nil {
+		return nil, nil
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(exposure.Hostname)), ".")
+	if host == "" {
+		return nil, nil
+	}
+	if len(host) > 253 || !connectAliasHostRegex.MatchString(host) {
+		return nil, types.NewErrBadRequest("hostname must be a DNS hostname without a scheme, port, or path, such as jira.mcp.example.com")
+	}
+	for _, serverURL := range serverURLs {
+		if u, err := url.Parse(serverURL); err == nil && strings.EqualFold(u.Hostname(), host) {
+			return nil, types.NewErrBadRequest("hostname must not be Obot's own hostname")
+		}
+	}
+
+	secretName := strings.TrimSpace(exposure.TLSSecretName)
+	if secretName != "" {
+		if errs := validation.IsDNS1123Subdomain(secretName); len(errs) > 0 {
+			return nil, types.NewErrBadRequest("invalid TLS secret name: %s", strings.Join(errs, ", "))
+		}
+	}
+
+	return &types.MCPServerExternalExposure{
+		Hostname:      host,
+		TLSSecretName: secretName,
+	}, nil
+}
+
+// normalizeAndValidateConnectAlias checks that a connect alias is a hostname, optionally followed by a path, that is not
+// one of Obot's own hostnames, and returns it in lowercase without a trailing slash.
+func normalizeAndValidateConnectAlias(alias string, serverURLs ...string) (string, error) {
+	alias = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(alias)), "/")
+	if alias == "" {
+		return "", nil
+	}
+	if strings.Contains(alias, "://") {
+		return "", types.NewErrBadRequest("connect alias must not include a scheme")
+	}
+
+	host, path := v1.SplitConnectAlias(alias)
+	if len(host) > 253 || !connectAliasHostRegex.MatchString(host) {
+		return "", types.NewErrBadRequest("connect alias must start with a DNS hostname without a port, such as jira.mcp.example.com")
+	}
+	if path != "" && !connectAliasPathRegex.MatchString(path) {
+		return "", types.NewErrBadRequest("connect alias path may only contain letters, digits, '-', '_', '.', and '/'")
+	}
+
+	for _, serverURL := range serverURLs {
+		if u, err := url.Parse(serverURL); err == nil && strings.EqualFold(u.Hostname(), host) {
+			return "", types.NewErrBadRequest("connect alias hostname must not be Obot's own hostname")
+		}
+	}
+
+	return alias, nil
+}
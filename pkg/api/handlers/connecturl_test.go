@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAndValidateCatalogExternalURL(t *testing.T) {
+	externalURL, err := normalizeAndValidateCatalogExternalURL("https://mcp.example.com/obot/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://mcp.example.com/obot", externalURL)
+
+	externalURL, err = normalizeAndValidateCatalogExternalURL("")
+	require.NoError(t, err)
+	assert.Empty(t, externalURL)
+
+	for _, invalid := range []string{"mcp.example.com", "ftp://mcp.example.com", "https://user@mcp.example.com", "https://mcp.example.com?a=b"} {
+		_, err = normalizeAndValidateCatalogExternalURL(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestNormalizeAndValidateConnectAlias(t *testing.T) {
+	tests := []struct {
+		alias    string
+		expected string
+		wantErr  bool
+	}{
+		{alias: "", expected: ""},
+		{alias: "Jira.MCP.example.com", expected: "jira.mcp.example.com"},
+		{alias: "mcp.example.com/jira/", expected: "mcp.example.com/jira"},
+		{alias: "mcp.example.com/teams/jira", expected: "mcp.example.com/teams/jira"},
+		{alias: "https://jira.mcp.example.com", wantErr: true},
+		{alias: "jira.mcp.example.com:8443", wantErr: true},
+		{alias: "localhost", wantErr: true},
+		{alias: "mcp.example.com/../jira", wantErr: true},
+		{alias: "mcp.example.com/jira?x=y", wantErr: true},
+		{alias: "obot.example.com/jira", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			alias, err := normalizeAndValidateConnectAlias(tt.alias, "https://obot.example.com")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, alias)
+		})
+	}
+}
+
+func TestNormalizeAndValidateExternalExposure(t *testing.T) {
+	exposure, err := normalizeAndValidateExternalExposure(&types.MCPServerExternalExposure{Hostname: " Jira.MCP.partner.example.com. ", TLSSecretName: "jira-tls"}, "https://obot.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, &types.MCPServerExternalExposure{Hostname: "jira.mcp.partner.example.com", TLSSecretName: "jira-tls"}, exposure)
+
+	for _, empty := range []*types.MCPServerExternalExposure{nil, {}} {
+		exposure, err = normalizeAndValidateExternalExposure(empty, "https://obot.example.com")
+		require.NoError(t, err)
+		assert.Nil(t, exposure)
+	}
+
+	for _, invalid := range []types.MCPServerExternalExposure{
+		{Hostname: "https://jira.mcp.example.com"},
+		{Hostname: "jira.mcp.example.com:8443"},
+		{Hostname: "mcp.example.com/jira"},
+		{Hostname: "obot.example.com"},
+		{Hostname: "jira.mcp.example.com", TLSSecretName: "Jira_TLS"},
+	} {
+		_, err = normalizeAndValidateExternalExposure(&invalid, "https://obot.example.com")
+		assert.Error(t, err, invalid.Hostname)
+	}
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/encryption"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/server/options/encryptionconfig"
+	"k8s.io/apiserver/pkg/storage/value"
+)
+
+const credentialBackupVersion = 1
+
+var (
+	credentialsGroupResource = schema.GroupResource{Resource: "credentials"}
+	credentialBackupDataCtx  = value.DefaultContext("obot/credential-backup")
+)
+
+type CredentialBackupHandler struct {
+	mcpSessionManager *mcp.SessionManager
+	encryptionConfig  *encryptionconfig.EncryptionConfiguration
+}
+
+func NewCredentialBackupHandler(mcpSessionManager *mcp.SessionManager, encryptionConfig *encryptionconfig.EncryptionConfiguration) *CredentialBackupHandler {
+	return &CredentialBackupHandler{
+		mcpSessionManager: mcpSessionManager,
+		encryptionConfig:  encryptionConfig,
+	}
+}
+
+// Backup exports the credentials of multi-user MCP servers, encrypted with the provided key or the platform's
+// encryption provider.
+func (h *CredentialBackupHandler) Backup(req api.Context) error {
+	var input types.CredentialBackupRequest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers); err != nil {
+		return err
+	}
+
+	slices.SortFunc(servers.Items, func(a, b v1.MCPServer) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var (
+		entries []types.CredentialBackupEntry
+		values  = make(map[string]map[string]string)
+	)
+	for _, server := range servers.Items {
+		credCtx := multiUserCredentialContext(server)
+		if credCtx == "" || server.Spec.Template {
+			continue
+		}
+		if len(input.MCPServerIDs) > 0 && !slices.Contains(input.MCPServerIDs, server.Name) {
+			continue
+		}
+
+		cred, err := req.GPTClient.RevealCredential(req.Context(), []string{credCtx}, server.Name)
+		if err != nil {
+			if errors.As(err, &gptscript.ErrNotFound{}) {
+				continue
+			}
+			return fmt.Errorf("failed to find credential for MCP server %s: %w", server.Name, err)
+		}
+
+		entries = append(entries, types.CredentialBackupEntry{
+			ID:                   server.Name,
+			MCPServerName:        server.Spec.Manifest.Name,
+			CatalogEntryID:       server.Spec.MCPServerCatalogEntryName,
+			MCPCatalogID:         server.Spec.MCPCatalogID,
+			PowerUserWorkspaceID: server.Spec.PowerUserWorkspaceID,
+			EnvVars:              slices.Sorted(maps.Keys(cred.Env)),
+		})
+		values[server.Name] = cred.Env
+	}
+
+	for _, id := range input.MCPServerIDs {
+		if _, ok := values[id]; !ok {
+			return types.NewErrBadRequest("MCP server %s is not a multi-user server with credentials to back up", id)
+		}
+	}
+
+	plaintext, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+
+	backup := types.CredentialBackup{
+		Version:   credentialBackupVersion,
+		CreatedAt: *types.NewTime(time.Now()),
+		Entries:   entries,
+	}
+
+	var ciphertext []byte
+	if input.Key != "" {
+		backup.Encryption = types.CredentialBackupEncryptionKey
+		ciphertext, err = encryption.EncryptWithPassphrase(plaintext, input.Key)
+	} else {
+		transformer := h.platformTransformer()
+		if transformer == nil {
+			return types.NewErrBadRequest("a key is required because no encryption provider is configured")
+		}
+		backup.Encryption = types.CredentialBackupEncryptionPlatform
+		ciphertext, err = transformer.TransformToStorage(req.Context(), plaintext, credentialBackupDataCtx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encrypt credentials: %w", err)
+	}
+
+	backup.Data = base64.StdEncoding.EncodeToString(ciphertext)
+	return req.Write(backup)
+}
+
+// Restore imports the selected credentials of a backup into the multi-user MCP servers they map to. Each entry is
+// restored independently, and the result of each is returned.
+func (h *CredentialBackupHandler) Restore(req api.Context) error {
+	var input types.CredentialRestoreRequest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if input.Backup.Version != credentialBackupVersion {
+		return types.NewErrBadRequest("unsupported credential backup version %d", input.Backup.Version)
+	}
+
+	entries := make(map[string]types.CredentialBackupEntry, len(input.Backup.Entries))
+	for _, entry := range input.Backup.Entries {
+		entries[entry.ID] = entry
+	}
+
+	selected := input.Entries
+	if len(selected) == 0 {
+		for _, entry := range input.Backup.Entries {
+			selected = append(selected, types.CredentialRestoreEntry{ID: entry.ID})
+		}
+	}
+	for _, sel := range selected {
+		if _, ok := entries[sel.ID]; !ok {
+			return types.NewErrBadRequest("entry %s is not in the backup", sel.ID)
+		}
+	}
+
+	values, err := h.decryptBackup(req, input.Backup, input.Key)
+	if err != nil {
+		return err
+	}
+
+	var servers v1.MCPServerList
+	if err = req.List(&servers); err != nil {
+		return err
+	}
+
+	results := make([]types.CredentialRestoreResult, 0, len(selected))
+	for _, sel := range selected {
+		result := types.CredentialRestoreResult{ID: sel.ID}
+
+		target, err := credentialRestoreTarget(servers.Items, entries[sel.ID], sel.TargetMCPServerID)
+		if err == nil {
+			result.TargetMCPServerID = target.Name
+			err = h.restoreCredential(req, target, values[sel.ID])
+		}
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Restored = true
+		}
+
+		results = append(results, result)
+	}
+
+	return req.Write(types.CredentialRestoreResultList{Items: results})
+}
+
+func (h *CredentialBackupHandler) decryptBackup(req api.Context, backup types.CredentialBackup, key string) (map[string]map[string]string, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(backup.Data)
+	if err != nil {
+		return nil, types.NewErrBadRequest("invalid credential backup data: %v", err)
+	}
+
+	var plaintext []byte
+	switch backup.Encryption {
+	case types.CredentialBackupEncryptionKey:
+		if key == "" {
+			return nil, types.NewErrBadRequest("a key is required to restore this backup")
+		}
+		if plaintext, err = encryption.DecryptWithPassphrase(ciphertext, key); err != nil {
+			return nil, types.NewErrBadRequest("%v", err)
+		}
+	case types.CredentialBackupEncryptionPlatform:
+		transformer := h.platformTransformer()
+		if transformer == nil {
+			return nil, types.NewErrBadRequest("this backup was encrypted with an encryption provider, but none is configured")
+		}
+		if plaintext, _, err = transformer.TransformFromStorage(req.Context(), ciphertext, credentialBackupDataCtx); err != nil {
+			return nil, types.NewErrBadRequest("failed to decrypt backup, it may have been encrypted with a different encryption provider key: %v", err)
+		}
+	default:
+		return nil, types.NewErrBadRequest("unsupported credential backup encryption %q", backup.Encryption)
+	}
+
+	var values map[string]map[string]string
+	if err = json.Unmarshal(plaintext, &values); err != nil {
+		return nil, types.NewErrBadRequest("invalid credential backup data: %v", err)
+	}
+	return values, nil
+}
+
+func (h *CredentialBackupHandler) restoreCredential(req api.Context, server v1.MCPServer, env map[string]string) error {
+	if len(env) == 0 {
+		return errors.New("the backup has no values for this entry")
+	}
+
+	modified, err := ensureCredential(req.Context(), req.GPTClient, gptscript.Credential{
+		Context:  multiUserCredentialContext(server),
+		ToolName: server.Name,
+		Type:     gptscript.CredentialTypeTool,
+		Env:      env,
+	})
+	if err != nil {
+		return err
+	}
+
+	if modified {
+		// Restart the server so that it picks up the restored credential.
+		if err = h.mcpSessionManager.ShutdownServer(req.Context(), server.Name); err != nil {
+			return fmt.Errorf("failed to shutdown server: %w", err)
+		}
+	}
+	return nil
+}
+
+func (h *CredentialBackupHandler) platformTransformer() value.Transformer {
+	if h.encryptionConfig == nil {
+		return nil
+	}
+	return h.encryptionConfig.Transformers[credentialsGroupResource]
+}
+
+// credentialRestoreTarget finds the multi-user MCP server to restore the entry to.
+func credentialRestoreTarget(servers []v1.MCPServer, entry types.CredentialBackupEntry, targetID string) (v1.MCPServer, error) {
+	isTarget := func(server v1.MCPServer) bool {
+		return multiUserCredentialContext(server) != "" && !server.Spec.Template && server.DeletionTimestamp.IsZero()
+	}
+
+	if targetID == "" {
+		targetID = entry.ID
+	} else if i := slices.IndexFunc(servers, func(s v1.MCPServer) bool { return s.Name == targetID }); i < 0 || !isTarget(servers[i]) {
+		return v1.MCPServer{}, fmt.Errorf("MCP server %s is not a multi-user server", targetID)
+	}
+
+	if i := slices.IndexFunc(servers, func(s v1.MCPServer) bool { return s.Name == targetID }); i >= 0 && isTarget(servers[i]) {
+		return servers[i], nil
+	}
+
+	var matches []v1.MCPServer
+	for _, server := range servers {
+		if isTarget(server) &&
+			server.Spec.MCPCatalogID == entry.MCPCatalogID &&
+			server.Spec.PowerUserWorkspaceID == entry.PowerUserWorkspaceID &&
+			server.Spec.MCPServerCatalogEntryName == entry.CatalogEntryID &&
+			server.Spec.Manifest.Name == entry.MCPServerName {
+			matches = append(matches, server)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return v1.MCPServer{}, errors.New("no matching MCP server, select a target server")
+	case 1:
+		return matches[0], nil
+	default:
+		return v1.MCPServer{}, errors.New("more than one matching MCP server, select a target server")
+	}
+}
+
+// multiUserCredentialContext returns the credential context of a multi-user MCP server, or an empty string if the
+// server is not a multi-user server.
+func multiUserCredentialContext(server v1.MCPServer) string {
+	switch {
+	case server.Spec.MCPCatalogID != "":
+		return fmt.Sprintf("%s-%s", server.Spec.MCPCatalogID, server.Name)
+	case server.Spec.PowerUserWorkspaceID != "":
+		return fmt.Sprintf("%s-%s", server.Spec.PowerUserWorkspaceID, server.Name)
+	default:
+		return ""
+	}
+}
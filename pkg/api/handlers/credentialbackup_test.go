@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCredentialRestoreTarget(t *testing.T) {
+	server := func(name, catalogID, entryID, displayName string) v1.MCPServer {
+		s := v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}
+		s.Spec.MCPCatalogID = catalogID
+		s.Spec.MCPServerCatalogEntryName = entryID
+		s.Spec.Manifest.Name = displayName
+		return s
+	}
+
+	entry := types.CredentialBackupEntry{
+		ID:             "ms1staging",
+		MCPServerName:  "GitHub",
+		CatalogEntryID: "github",
+		MCPCatalogID:   "default",
+	}
+
+	tests := []struct {
+		name     string
+		servers  []v1.MCPServer
+		targetID string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:    "same ID",
+			servers: []v1.MCPServer{server("ms1staging", "default", "other", "Other"), server("ms1prod", "default", "github", "GitHub")},
+			want:    "ms1staging",
+		},
+		{
+			name:    "unique match",
+			servers: []v1.MCPServer{server("ms1prod", "default", "github", "GitHub"), server("ms1other", "default", "github", "GitHub Enterprise")},
+			want:    "ms1prod",
+		},
+		{
+			name:    "ambiguous match",
+			servers: []v1.MCPServer{server("ms1a", "default", "github", "GitHub"), server("ms1b", "default", "github", "GitHub")},
+			wantErr: true,
+		},
+		{
+			name:    "no match",
+			servers: []v1.MCPServer{server("ms1prod", "other-catalog", "github", "GitHub")},
+			wantErr: true,
+		},
+		{
+			name:     "explicit target",
+			servers:  []v1.MCPServer{server("ms1a", "default", "github", "GitHub"), server("ms1b", "default", "github", "GitHub")},
+			targetID: "ms1b",
+			want:     "ms1b",
+		},
+		{
+			name:     "explicit target is not multi-user",
+			servers:  []v1.MCPServer{server("ms1prod", "default", "github", "GitHub"), server("ms1single", "", "github", "GitHub")},
+			targetID: "ms1single",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := credentialRestoreTarget(tt.servers, entry, tt.targetID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got target %s", got.Name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Name != tt.want {
+				t.Errorf("credentialRestoreTarget() = %s, want %s", got.Name, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gatewayclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+)
+
+type DatabaseMigrationsHandler struct{}
+
+func NewDatabaseMigrationsHandler() *DatabaseMigrationsHandler {
+	return &DatabaseMigrationsHandler{}
+}
+
+// Get returns the changes that are waiting to be made to the database, and the startups that changed it, so that
+// operators can review the changes of an upgrade before they are applied.
+func (h *DatabaseMigrationsHandler) Get(req api.Context) error {
+	pending, err := req.GatewayClient.PendingDatabaseChanges(req.Context())
+	if err != nil {
+		return err
+	}
+
+	migrations, err := req.GatewayClient.DatabaseMigrations(req.Context())
+	if err != nil {
+		return err
+	}
+
+	history := make([]types.DatabaseMigration, 0, len(migrations))
+	for _, m := range migrations {
+		history = append(history, gatewaytypes.ConvertDatabaseMigration(m))
+	}
+
+	schemaTransitions, err := req.GatewayClient.SchemaTransitions(req.Context())
+	if err != nil {
+		return err
+	}
+
+	transitions := make([]types.SchemaTransition, 0, len(schemaTransitions))
+	for _, t := range schemaTransitions {
+		transitions = append(transitions, gatewaytypes.ConvertSchemaTransition(t))
+	}
+
+	return req.Write(types.DatabaseMigrationStatus{
+		DryRun:      req.GatewayClient.DatabaseMigrationDryRun(),
+		Pending:     pending,
+		History:     history,
+		Transitions: transitions,
+	})
+}
+
+// ContractTransition removes the old schema of a backfilled expand/contract migration. Admins call it after a
+// blue/green upgrade, once no instance of the previous release is running.
+func (h *DatabaseMigrationsHandler) ContractTransition(req api.Context) error {
+	name := req.PathValue("name")
+	if err := req.GatewayClient.ContractSchemaTransition(req.Context(), name); errors.Is(err, gorm.ErrRecordNotFound) {
+		return types.NewErrNotFound("schema transition %s not found", name)
+	} else if errors.Is(err, gatewayclient.ErrSchemaTransitionNotBackfilled) {
+		return types.NewErrHTTP(http.StatusConflict, fmt.Sprintf("schema transition %s isn't backfilled yet", name))
+	} else if err != nil {
+		return err
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"k8s.io/apiserver/pkg/server/options/encryptionconfig"
+)
+
+type KeyRotationHandler struct {
+	encryptionConfig *encryptionconfig.EncryptionConfiguration
+}
+
+func NewKeyRotationHandler(encryptionConfig *encryptionconfig.EncryptionConfiguration) *KeyRotationHandler {
+	return &KeyRotationHandler{
+		encryptionConfig: encryptionConfig,
+	}
+}
+
+// Get returns the status of the current or last key rotation.
+func (h *KeyRotationHandler) Get(req api.Context) error {
+	rotation, err := req.GatewayClient.LatestKeyRotation(req.Context())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return req.Write(types.KeyRotation{})
+	} else if err != nil {
+		return err
+	}
+
+	return req.Write(gatewaytypes.ConvertKeyRotation(rotation))
+}
+
+// Start requests the re-encryption of every stored secret with the current write key of the encryption provider.
+// The leader runs the job in the background, and its progress is reported by Get.
+func (h *KeyRotationHandler) Start(req api.Context) error {
+	if h.encryptionConfig == nil {
+		return types.NewErrBadRequest("no encryption provider is configured")
+	}
+
+	rotation, err := req.GatewayClient.StartKeyRotation(req.Context(), req.User.GetName())
+	if errors.Is(err, gclient.ErrKeyRotationRunning) {
+		return types.NewErrHTTP(http.StatusConflict, err.Error())
+	} else if err != nil {
+		return err
+	}
+
+	log.Infof("Key rotation requested: user=%s", req.User.GetName())
+	return req.WriteCode(gatewaytypes.ConvertKeyRotation(rotation), http.StatusAccepted)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// SetMaintenanceNotice schedules a planned outage of a multi-user MCP server. The notice is returned with the server,
+// and users are warned in the results of their tool calls during its window.
+func (m *MCPHandler) SetMaintenanceNotice(req api.Context) error {
+	var notice types.MCPMaintenanceNotice
+	if err := req.Read(&notice); err != nil {
+		return types.NewErrBadRequest("failed to read maintenance notice: %v", err)
+	}
+	if err := notice.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid maintenance notice: %v", err)
+	}
+
+	return m.updateMaintenanceNotice(req, &notice)
+}
+
+// DeleteMaintenanceNotice removes the maintenance notice of a multi-user MCP server.
+func (m *MCPHandler) DeleteMaintenanceNotice(req api.Context) error {
+	return m.updateMaintenanceNotice(req, nil)
+}
+
+func (m *MCPHandler) updateMaintenanceNotice(req api.Context, notice *types.MCPMaintenanceNotice) error {
+	var (
+		server    v1.MCPServer
+		id        = req.PathValue("mcp_server_id")
+		catalogID = req.PathValue("catalog_id")
+	)
+
+	if err := req.Get(&server, id); err != nil {
+		return err
+	}
+	if server.Spec.MCPCatalogID == "" || server.Spec.MCPCatalogID != catalogID {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	server.Spec.MaintenanceNotice = notice
+	if err := req.Update(&server); err != nil {
+		return fmt.Errorf("failed to update MCP server: %w", err)
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), catalogID, "")
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
+	}
+
+	return req.Write(ConvertMCPServer(server, nil, MCPServerConnectBaseURL(req, server), slug))
+}
+
+// SetEntryMaintenanceNotice schedules a planned outage of all MCP servers created from a catalog entry, except for
+// the servers that have a notice of their own.
+func (h *MCPCatalogHandler) SetEntryMaintenanceNotice(req api.Context) error {
+	var notice types.MCPMaintenanceNotice
+	if err := req.Read(&notice); err != nil {
+		return types.NewErrBadRequest("failed to read maintenance notice: %v", err)
+	}
+	if err := notice.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid maintenance notice: %v", err)
+	}
+
+	return h.updateEntryMaintenanceNotice(req, &notice)
+}
+
+// DeleteEntryMaintenanceNotice removes the maintenance notice of a catalog entry.
+func (h *MCPCatalogHandler) DeleteEntryMaintenanceNotice(req api.Context) error {
+	return h.updateEntryMaintenanceNotice(req, nil)
+}
+
+func (h *MCPCatalogHandler) updateEntryMaintenanceNotice(req api.Context, notice *types.MCPMaintenanceNotice) error {
+	catalogName := req.PathValue("catalog_id")
+
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, req.PathValue("entry_id")); err != nil {
+		return fmt.Errorf("failed to get entry: %w", err)
+	}
+	if entry.Spec.MCPCatalogName != catalogName {
+		return types.NewErrBadRequest("entry does not belong to catalog")
+	}
+
+	entry.Spec.MaintenanceNotice = notice
+	if err := req.Update(&entry); err != nil {
+		return fmt.Errorf("failed to update entry: %w", err)
+	}
+
+	return req.Write(ConvertMCPServerCatalogEntry(entry))
+}
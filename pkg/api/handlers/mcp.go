@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/validation"
 	"github.com/obot-platform/obot/pkg/wait"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -97,6 +99,11 @@ func (m *MCPHandler) GetEntryFromAllSources(req api.Context) error {
 }
 
 func (m *MCPHandler) ListEntriesFromAllSources(req api.Context) error {
+	filter, err := catalogEntryFilterFromRequest(req)
+	if err != nil {
+		return err
+	}
+
 	var list v1.MCPServerCatalogEntryList
 	if err := req.List(&list); err != nil {
 		return err
@@ -112,7 +119,7 @@ func (m *MCPHandler) ListEntriesFromAllSources(req api.Context) error {
 		for _, entry := range list.Items {
 			entries = append(entries, convertEntry(entry))
 		}
-		return req.Write(types.MCPServerCatalogEntryList{Items: entries})
+		return writeCatalogEntries(req, filter, entries)
 	}
 
 	// Apply ACR filtering for regular users and for admins without ?all=true
@@ -146,7 +153,7 @@ func (m *MCPHandler) ListEntriesFromAllSources(req api.Context) error {
 		}
 	}
 
-	return req.Write(types.MCPServerCatalogEntryList{Items: entries})
+	return writeCatalogEntries(req, filter, entries)
 }
 
 func ConvertMCPServerCatalogEntry(entry v1.MCPServerCatalogEntry) types.MCPServerCatalogEntry {
@@ -157,6 +164,8 @@ func ConvertMCPServerCatalogEntryWithWorkspace(entry v1.MCPServerCatalogEntry, p
 	// Add extracted env vars directly to the entry
 	addExtractedEnvVarsToCatalogEntry(&entry)
 
+	notice, inMaintenance := convertMaintenanceNotice(entry.Spec.MaintenanceNotice)
+
 	return types.MCPServerCatalogEntry{
 		Metadata:                  MetadataFrom(&entry),
 		Manifest:                  entry.Spec.Manifest,
@@ -166,11 +175,55 @@ func ConvertMCPServerCatalogEntryWithWorkspace(entry v1.MCPServerCatalogEntry, p
 		UserCount:                 entry.Status.UserCount,
 		LastUpdated:               v1.NewTime(entry.Status.LastUpdated),
 		ToolPreviewsLastGenerated: v1.NewTime(entry.Status.ToolPreviewsLastGenerated),
+		ToolPreviewsError:         entry.Status.ToolPreviewsError,
 		PowerUserWorkspaceID:      powerUserWorkspaceID,
 		PowerUserID:               powerUserID,
 		NeedsUpdate:               entry.Status.NeedsUpdate,
 		OAuthCredentialConfigured: entry.Status.OAuthCredentialConfigured,
+		Conditions:                convertConditions(entry.Status.Conditions),
+		MaintenanceNotice:         notice,
+		InMaintenance:             inMaintenance,
+		Revision:                  entry.Status.Revision,
+	}
+}
+
+// convertMaintenanceNotice returns the notice, unless its window has ended, and whether its window is in progress.
+func convertMaintenanceNotice(notice *types.MCPMaintenanceNotice) (*types.MCPMaintenanceNotice, bool) {
+	now := time.Now()
+	if notice.Expired(now) {
+		return nil, false
+	}
+	return notice, notice.ActiveAt(now)
+}
+
+// connectBlockers returns the connect blockers of a server or instance: the ones that the controller maintains in its
+// status, preceded by MissingConfiguration if it is missing configuration, which the controller can't check because it
+// depends on credentials.
+func connectBlockers(missingConfiguration bool, statusBlockers []types.MCPServerConnectBlocker) []types.MCPServerConnectBlocker {
+	if !missingConfiguration {
+		return slices.Clone(statusBlockers)
+	}
+	return append([]types.MCPServerConnectBlocker{types.MCPServerConnectBlockerMissingConfiguration}, statusBlockers...)
+}
+
+func convertConditions(conditions []metav1.Condition) []types.Condition {
+	if len(conditions) == 0 {
+		return nil
 	}
+
+	result := make([]types.Condition, 0, len(conditions))
+	for _, cond := range conditions {
+		result = append(result, types.Condition{
+			Type:               cond.Type,
+			Status:             string(cond.Status),
+			ObservedGeneration: cond.ObservedGeneration,
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+			LastTransitionTime: *types.NewTime(cond.LastTransitionTime.Time),
+		})
+	}
+
+	return result
 }
 
 func (m *MCPHandler) ListServer(req api.Context) error {
@@ -194,49 +247,31 @@ func (m *MCPHandler) ListServer(req api.Context) error {
 		}
 	}
 
-	var servers v1.MCPServerList
-	if err := req.List(&servers, fieldSelector); err != nil {
-		return nil
+	queryFieldSelector, err := mcpServerListFieldSelector(req.URL.Query())
+	if err != nil {
+		return err
 	}
-
-	credCtxs := make([]string, 0, len(servers.Items))
-	if catalogID != "" {
-		for _, server := range servers.Items {
-			credCtxs = append(credCtxs, fmt.Sprintf("%s-%s", catalogID, server.Name))
-		}
-	} else if workspaceID != "" {
-		for _, server := range servers.Items {
-			credCtxs = append(credCtxs, fmt.Sprintf("%s-%s", workspaceID, server.Name))
-		}
-	} else {
-		for _, server := range servers.Items {
-			credCtxs = append(credCtxs, fmt.Sprintf("%s-%s", req.User.GetUID(), server.Name))
+	for k, v := range queryFieldSelector {
+		// The path scoping always takes precedence over query parameters.
+		if _, ok := fieldSelector[k]; !ok {
+			fieldSelector[k] = v
 		}
 	}
 
-	creds, err := req.GPTClient.ListCredentials(req.Context(), gptscript.ListCredentialsOptions{
-		CredentialContexts: credCtxs,
-	})
+	page, err := listPageFromRequest(req)
 	if err != nil {
-		return fmt.Errorf("failed to list credentials: %w", err)
+		return err
 	}
 
-	credMap := make(map[string]map[string]string, len(creds))
-	for _, cred := range creds {
-		if _, ok := credMap[cred.ToolName]; !ok {
-			c, err := req.GPTClient.RevealCredential(req.Context(), []string{cred.Context}, cred.ToolName)
-			if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
-				return fmt.Errorf("failed to find credential: %w", err)
-			}
-			credMap[cred.ToolName] = c.Env
-		}
+	var servers v1.MCPServerList
+	if err := req.List(&servers, fieldSelector); err != nil {
+		return nil
 	}
 
-	items := make([]types.MCPServer, 0, len(servers.Items))
-
 	// Allow admins/auditors to bypass ACR filtering with ?all=true
 	bypassACRCheck := (req.UserIsAdmin() || req.UserIsAuditor()) && req.URL.Query().Get("all") == "true"
 
+	allowedServers := make([]v1.MCPServer, 0, len(servers.Items))
 	for _, server := range servers.Items {
 		if server.Spec.Template || server.Spec.CompositeName != "" {
 			continue
@@ -268,10 +303,55 @@ func (m *MCPHandler) ListServer(req api.Context) error {
 			}
 		}
 
-		if !hasAccess {
-			continue
+		if hasAccess {
+			allowedServers = append(allowedServers, server)
 		}
+	}
 
+	// Search, sort and page the servers before looking up their credentials, so that only the servers on the page are
+	// looked up.
+	allowedServers, err = searchAndSortServers(req, allowedServers)
+	if err != nil {
+		return err
+	}
+	total := len(allowedServers)
+	allowedServers, next := pageItems(page, allowedServers, serverName)
+
+	credCtxs := make([]string, 0, len(allowedServers))
+	if catalogID != "" {
+		for _, server := range allowedServers {
+			credCtxs = append(credCtxs, fmt.Sprintf("%s-%s", catalogID, server.Name))
+		}
+	} else if workspaceID != "" {
+		for _, server := range allowedServers {
+			credCtxs = append(credCtxs, fmt.Sprintf("%s-%s", workspaceID, server.Name))
+		}
+	} else {
+		for _, server := range allowedServers {
+			credCtxs = append(credCtxs, fmt.Sprintf("%s-%s", req.User.GetUID(), server.Name))
+		}
+	}
+
+	creds, err := req.GPTClient.ListCredentials(req.Context(), gptscript.ListCredentialsOptions{
+		CredentialContexts: credCtxs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	credMap := make(map[string]map[string]string, len(creds))
+	for _, cred := range creds {
+		if _, ok := credMap[cred.ToolName]; !ok {
+			c, err := req.GPTClient.RevealCredential(req.Context(), []string{cred.Context}, cred.ToolName)
+			if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+				return fmt.Errorf("failed to find credential: %w", err)
+			}
+			credMap[cred.ToolName] = c.Env
+		}
+	}
+
+	items := make([]types.MCPServer, 0, len(allowedServers))
+	for _, server := range allowedServers {
 		// Add extracted env vars to the server definition
 		addExtractedEnvVars(&server)
 
@@ -288,11 +368,18 @@ func (m *MCPHandler) ListServer(req api.Context) error {
 				return err
 			}
 		}
-		converted := ConvertMCPServer(server, credMap[server.Name], m.serverURL, slug, components...)
+		credEnv, ok := credMap[server.Name]
+		if !ok {
+			if credEnv, err = teamCredentialEnv(req, server); err != nil {
+				return fmt.Errorf("failed to find team credential: %w", err)
+			}
+		}
+
+		converted := ConvertMCPServer(server, credEnv, MCPServerConnectBaseURL(req, server), slug, components...)
 		items = append(items, converted)
 	}
 
-	return req.Write(types.MCPServerList{Items: items})
+	return writeList(req, types.MCPServerList{Items: items, Total: total, Continue: next}, page)
 }
 
 func (m *MCPHandler) GetServer(req api.Context) error {
@@ -340,7 +427,11 @@ func (m *MCPHandler) GetServer(req api.Context) error {
 	}
 
 	cred, err := req.GPTClient.RevealCredential(req.Context(), credCtxs, server.Name)
-	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		if cred.Env, err = teamCredentialEnv(req, server); err != nil {
+			return fmt.Errorf("failed to find team credential: %w", err)
+		}
+	} else if err != nil {
 		return fmt.Errorf("failed to find credential: %w", err)
 	}
 
@@ -357,7 +448,7 @@ func (m *MCPHandler) GetServer(req api.Context) error {
 			return err
 		}
 	}
-	converted := ConvertMCPServer(server, cred.Env, m.serverURL, slug, components...)
+	converted := ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug, components...)
 	return req.Write(converted)
 }
 
@@ -412,7 +503,7 @@ func (m *MCPHandler) DeleteServer(req api.Context) error {
 		return err
 	}
 
-	return req.Write(ConvertMCPServer(server, nil, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(server, nil, MCPServerConnectBaseURL(req, server), slug))
 }
 
 // compositeDeletionDependency represents a composite MCP server or catalog entry that depends
@@ -503,6 +594,102 @@ func listCompositeDeletionDependencies(req api.Context, server v1.MCPServer) ([]
 	return dependencies, nil
 }
 
+// launchCompositeComponent launches a component server of a composite server and waits for it to be healthy.
+// Components that are composite servers themselves are launched like composite servers, by launching their components.
+func (m *MCPHandler) launchCompositeComponent(req api.Context, component v1.MCPServer) error {
+	if component.Spec.Manifest.Runtime == types.RuntimeComposite {
+		var nestedComponents v1.MCPServerList
+		if err := req.List(&nestedComponents,
+			kclient.InNamespace(component.Namespace),
+			kclient.MatchingFields{
+				"spec.compositeName": component.Name,
+			},
+		); err != nil {
+			return fmt.Errorf("failed to list child servers of component server %s: %w", component.Name, err)
+		}
+
+		disabledComponents := make(map[string]bool)
+		if component.Spec.Manifest.CompositeConfig != nil {
+			for _, comp := range component.Spec.Manifest.CompositeConfig.ComponentServers {
+				disabledComponents[comp.CatalogEntryID] = comp.Disabled
+			}
+		}
+
+		for _, nested := range nestedComponents.Items {
+			if disabledComponents[nested.Spec.MCPServerCatalogEntryName] {
+				continue
+			}
+			if err := m.launchCompositeComponent(req, nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	config, err := serverConfigForAction(req, component)
+	if err != nil {
+		return fmt.Errorf("failed to get config for component server %s: %w", component.Name, err)
+	}
+
+	if config.Runtime != types.RuntimeRemote {
+		_, err = m.mcpSessionManager.ListTools(req.Context(), config)
+	} else {
+		// Don't use ListTools for remote MCP servers in case they need OAuth.
+		_, err = m.mcpSessionManager.LaunchServer(req.Context(), config)
+	}
+	if err != nil {
+		if errors.Is(err, mcp.ErrHealthCheckFailed) || errors.Is(err, mcp.ErrHealthCheckTimeout) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, fmt.Sprintf("Component MCP server %s is not healthy, check configuration for errors", component.Name))
+		}
+		if errors.Is(err, nmcp.ErrNoResult) || strings.HasSuffix(err.Error(), nmcp.ErrNoResult.Error()) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, fmt.Sprintf("No response from component MCP server %s, check configuration for errors", component.Name))
+		}
+		if errors.Is(err, mcp.ErrInsufficientCapacity) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, "Insufficient capacity to deploy MCP server. Please contact your administrator.")
+		}
+		if errors.Is(err, mcp.ErrDeploymentQueueFull) || errors.Is(err, mcp.ErrDeploymentQueueTimeout) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, "Too many MCP servers are being deployed, try again later")
+		}
+		if errors.Is(err, mcp.ErrImageBuildFailed) || errors.Is(err, mcp.ErrSecretResolutionFailed) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, err.Error())
+		}
+		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
+			return types.NewErrHTTP(http.StatusBadRequest, nse.Error())
+		}
+		if errors.Is(err, mcp.ErrStdioRuntimeDisabled) {
+			return types.NewErrHTTP(http.StatusBadRequest, err.Error())
+		}
+
+		return fmt.Errorf("failed to launch component MCP server %s: %w", component.Name, err)
+	}
+
+	return nil
+}
+
+// disableCompositeComponents disables the failed components in the composite server's manifest, so that it is deployed
+// without them. They can be enabled again by configuring the composite server.
+func (m *MCPHandler) disableCompositeComponents(req api.Context, compositeServer v1.MCPServer, failures []types.MCPCompositeComponentFailure) error {
+	failed := make(map[string]bool, len(failures))
+	for _, failure := range failures {
+		failed[failure.ComponentID] = true
+	}
+
+	oldManifestHash := hash.Digest(compositeServer.Spec.Manifest)
+	manifest := *compositeServer.Spec.Manifest.DeepCopy()
+	for i, component := range manifest.CompositeConfig.ComponentServers {
+		if failed[component.ComponentID()] {
+			manifest.CompositeConfig.ComponentServers[i].Disabled = true
+		}
+	}
+
+	if _, err := m.updateCompositeManifest(req, compositeServer.Name, oldManifestHash, manifest); err != nil {
+		return fmt.Errorf("failed to disable components of composite server: %w", err)
+	}
+
+	log.Infof("Disabled components of composite MCP server that failed to launch: server=%s components=%d", compositeServer.Name, len(failures))
+	return nil
+}
+
 func (m *MCPHandler) LaunchServer(req api.Context) error {
 	catalogID := req.PathValue("catalog_id")
 	workspaceID := req.PathValue("workspace_id")
@@ -519,7 +706,12 @@ func (m *MCPHandler) LaunchServer(req api.Context) error {
 		return types.NewErrNotFound("MCP server not found")
 	}
 
+	async := req.URL.Query().Get("async") == "true"
 	if server.Spec.Manifest.Runtime == types.RuntimeComposite {
+		if async {
+			return types.NewErrBadRequest("asynchronous launches are not supported for composite MCP servers")
+		}
+
 		var componentServers v1.MCPServerList
 		if err := req.List(&componentServers,
 			kclient.InNamespace(server.Namespace),
@@ -540,49 +732,74 @@ func (m *MCPHandler) LaunchServer(req api.Context) error {
 			disabledComponents[comp.CatalogEntryID] = comp.Disabled
 		}
 
+		// With allowDegraded, components that fail to launch are disabled instead of failing the launch, so that the
+		// tools of the other components are available.
+		var (
+			allowDegraded = req.URL.Query().Get("allowDegraded") == "true"
+			launched      int
+			result        = types.MCPCompositeLaunchResult{DisabledComponents: []types.MCPCompositeComponentFailure{}}
+		)
 		for _, component := range componentServers.Items {
 			// Skip if disabled in composite config
 			if disabledComponents[component.Spec.MCPServerCatalogEntryName] {
 				continue
 			}
 
-			config, err := serverConfigForAction(req, component)
-			if err != nil {
-				return fmt.Errorf("failed to get config for component server %s: %w", component.Name, err)
+			if err := m.launchCompositeComponent(req, component); err != nil {
+				if !allowDegraded {
+					return err
+				}
+
+				message := launchErrorMessage(err)
+				result.DisabledComponents = append(result.DisabledComponents, types.MCPCompositeComponentFailure{
+					ComponentID: component.Spec.MCPServerCatalogEntryName,
+					MCPServerID: component.Name,
+					Name:        component.Spec.Manifest.Name,
+					Error:       message,
+				})
+				continue
 			}
+			launched++
+		}
 
-			if config.Runtime != types.RuntimeRemote {
-				_, err = m.mcpSessionManager.ListTools(req.Context(), config)
-			} else {
-				// Don't use ListTools for remote MCP servers in case they need OAuth.
-				_, err = m.mcpSessionManager.LaunchServer(req.Context(), config)
+		if !allowDegraded {
+			return nil
+		}
+		if len(result.DisabledComponents) > 0 {
+			if launched == 0 {
+				return types.NewErrHTTP(http.StatusServiceUnavailable, fmt.Sprintf("None of the components of composite MCP server %s could be launched: %s", server.Name, result.DisabledComponents[0].Error))
 			}
-			if err != nil {
-				if errors.Is(err, mcp.ErrHealthCheckFailed) || errors.Is(err, mcp.ErrHealthCheckTimeout) {
-					return types.NewErrHTTP(http.StatusServiceUnavailable, fmt.Sprintf("Component MCP server %s is not healthy, check configuration for errors", component.Name))
-				}
-				if errors.Is(err, nmcp.ErrNoResult) || strings.HasSuffix(err.Error(), nmcp.ErrNoResult.Error()) {
-					return types.NewErrHTTP(http.StatusServiceUnavailable, fmt.Sprintf("No response from component MCP server %s, check configuration for errors", component.Name))
-				}
-				if errors.Is(err, mcp.ErrInsufficientCapacity) {
-					return types.NewErrHTTP(http.StatusServiceUnavailable, "Insufficient capacity to deploy MCP server. Please contact your administrator.")
-				}
-				if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
-					return types.NewErrHTTP(http.StatusBadRequest, nse.Error())
-				}
 
-				return fmt.Errorf("failed to launch component MCP server %s: %w", component.Name, err)
+			if err := m.disableCompositeComponents(req, server, result.DisabledComponents); err != nil {
+				return err
 			}
 		}
 
-		return nil
+		return req.Write(result)
 	}
 
+	// With async, the server is launched in the background, and the status of the launch is returned by
+	// GET .../launch, including the position of the server's deployment in the deployment queue.
+	if async {
+		m.mcpSessionManager.StartLaunch(server.Name, func(ctx context.Context) error {
+			return m.launchServer(ctx, server, serverConfig)
+		}, launchErrorMessage)
+
+		status, _ := m.mcpSessionManager.LaunchStatus(server.Name)
+		return req.WriteCode(status, http.StatusAccepted)
+	}
+
+	return m.launchServer(req.Context(), server, serverConfig)
+}
+
+// launchServer launches a server that isn't a composite server and waits for it to be healthy.
+func (m *MCPHandler) launchServer(ctx context.Context, server v1.MCPServer, serverConfig mcp.ServerConfig) error {
+	var err error
 	if server.Spec.Manifest.Runtime != types.RuntimeRemote {
-		_, err = m.mcpSessionManager.ListTools(req.Context(), serverConfig)
+		_, err = m.mcpSessionManager.ListTools(ctx, serverConfig)
 	} else {
 		// Don't use ListTools for remote MCP servers in case they need OAuth.
-		_, err = m.mcpSessionManager.LaunchServer(req.Context(), serverConfig)
+		_, err = m.mcpSessionManager.LaunchServer(ctx, serverConfig)
 	}
 	if err != nil {
 		if errors.Is(err, mcp.ErrHealthCheckFailed) || errors.Is(err, mcp.ErrHealthCheckTimeout) {
@@ -594,15 +811,54 @@ func (m *MCPHandler) LaunchServer(req api.Context) error {
 		if errors.Is(err, mcp.ErrInsufficientCapacity) {
 			return types.NewErrHTTP(http.StatusServiceUnavailable, "Insufficient capacity to deploy MCP server. Please contact your administrator.")
 		}
+		if errors.Is(err, mcp.ErrDeploymentQueueFull) || errors.Is(err, mcp.ErrDeploymentQueueTimeout) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, "Too many MCP servers are being deployed, try again later")
+		}
+		if errors.Is(err, mcp.ErrImageBuildFailed) || errors.Is(err, mcp.ErrSecretResolutionFailed) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, err.Error())
+		}
 		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
 			return types.NewErrHTTP(http.StatusBadRequest, nse.Error())
 		}
+		if errors.Is(err, mcp.ErrStdioRuntimeDisabled) {
+			return types.NewErrHTTP(http.StatusBadRequest, err.Error())
+		}
 		return fmt.Errorf("failed to launch MCP server: %w", err)
 	}
 
 	return nil
 }
 
+// launchErrorMessage returns the message of an error from launchServer to show to users.
+func launchErrorMessage(err error) string {
+	if httpErr, ok := errors.AsType[*types.ErrHTTP](err); ok {
+		return httpErr.Message
+	}
+	return err.Error()
+}
+
+// LaunchStatus returns the status of the last asynchronous launch of a server.
+func (m *MCPHandler) LaunchStatus(req api.Context) error {
+	var (
+		catalogID   = req.PathValue("catalog_id")
+		workspaceID = req.PathValue("workspace_id")
+		server      v1.MCPServer
+	)
+
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+	if server.Spec.MCPCatalogID != catalogID || server.Spec.PowerUserWorkspaceID != workspaceID {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	status, ok := m.mcpSessionManager.LaunchStatus(server.Name)
+	if !ok {
+		return types.NewErrNotFound("MCP server %s has no asynchronous launch", server.Name)
+	}
+	return req.Write(status)
+}
+
 func (m *MCPHandler) CheckOAuth(req api.Context) error {
 	catalogID := req.PathValue("catalog_id")
 	workspaceID := req.PathValue("workspace_id")
@@ -653,7 +909,16 @@ func (m *MCPHandler) GetOAuthURL(req api.Context) error {
 		return fmt.Errorf("failed to get OAuth URL: %w", err)
 	}
 
-	return req.Write(map[string]string{"oauthURL": u})
+	return req.Write(map[string]string{"oauthURL": oauthConsentURL(m.serverURL, u)})
+}
+
+// oauthConsentURL returns the URL that users are sent to for an OAuth URL of an MCP server. Users are shown the scopes
+// that authorization servers are asked for on Obot's consent page first, and Obot's own pages are returned as they are.
+func oauthConsentURL(serverURL, oauthURL string) string {
+	if oauthURL == "" || strings.HasPrefix(oauthURL, serverURL+"/") {
+		return oauthURL
+	}
+	return system.MCPOAuthConsentURL(serverURL, oauthURL)
 }
 
 func (m *MCPHandler) GetTools(req api.Context) error {
@@ -709,6 +974,43 @@ func (m *MCPHandler) GetTools(req api.Context) error {
 	return req.Write(tools)
 }
 
+// GetToolConflicts reports the tool names that more than one component of a composite server exposes, using the tool
+// previews of the components. A multi-user server component's tool previews are those of the server.
+func (m *MCPHandler) GetToolConflicts(req api.Context) error {
+	var (
+		server      v1.MCPServer
+		catalogID   = req.PathValue("catalog_id")
+		workspaceID = req.PathValue("workspace_id")
+	)
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+	if server.Spec.MCPCatalogID != catalogID || server.Spec.PowerUserWorkspaceID != workspaceID {
+		return types.NewErrNotFound("MCP server not found")
+	}
+	if server.Spec.Manifest.Runtime != types.RuntimeComposite || server.Spec.Manifest.CompositeConfig == nil {
+		return types.NewErrBadRequest("MCP server %s is not a composite server", server.Name)
+	}
+
+	config := *server.Spec.Manifest.CompositeConfig
+	tools := make(map[string][]types.MCPServerTool, len(config.ComponentServers))
+	for _, component := range config.ComponentServers {
+		toolPreview := component.Manifest.ToolPreview
+		if component.MCPServerID != "" {
+			var componentServer v1.MCPServer
+			if err := req.Get(&componentServer, component.MCPServerID); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get component server %s: %w", component.MCPServerID, err)
+			}
+			toolPreview = componentServer.Spec.Manifest.ToolPreview
+		}
+		if len(toolPreview) > 0 {
+			tools[component.ComponentID()] = toolPreview
+		}
+	}
+
+	return req.Write(mcp.CompositeToolConflicts(config, tools))
+}
+
 func (m *MCPHandler) SetTools(req api.Context) error {
 	thread, err := getThreadForScope(req)
 	if err != nil {
@@ -887,6 +1189,36 @@ func (m *MCPHandler) GetResources(req api.Context) error {
 	return req.Write(resources)
 }
 
+// GetRoots returns the roots declared for the user's sessions with the MCP server.
+func (m *MCPHandler) GetRoots(req api.Context) error {
+	_, serverConfig, err := serverForAction(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.MCPRootList{Items: m.mcpSessionManager.Roots(serverConfig)})
+}
+
+// SetRoots replaces the roots declared for the user's sessions with the MCP server.
+// Open sessions are notified that the list of roots changed.
+func (m *MCPHandler) SetRoots(req api.Context) error {
+	var roots []types.MCPRoot
+	if err := req.Read(&roots); err != nil {
+		return types.NewErrBadRequest("failed to read roots: %v", err)
+	}
+
+	_, serverConfig, err := serverForAction(req)
+	if err != nil {
+		return err
+	}
+
+	if err = m.mcpSessionManager.SetRoots(req.Context(), serverConfig, roots); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	return req.Write(types.MCPRootList{Items: m.mcpSessionManager.Roots(serverConfig)})
+}
+
 func (m *MCPHandler) ReadResource(req api.Context) error {
 	_, serverConfig, caps, err := serverForActionWithCapabilities(req, m.mcpSessionManager)
 	if err != nil {
@@ -928,6 +1260,70 @@ func (m *MCPHandler) ReadResource(req api.Context) error {
 	return req.Write(contents)
 }
 
+// ResourceUpdates streams, as server-sent events, the update notifications from the MCP server for the resources given by the uri query parameters.
+func (m *MCPHandler) ResourceUpdates(req api.Context) error {
+	uris := req.URL.Query()["uri"]
+	if len(uris) == 0 {
+		return types.NewErrBadRequest("at least one uri query parameter is required")
+	}
+
+	_, serverConfig, err := serverForAction(req)
+	if err != nil {
+		return err
+	}
+
+	updates, err := m.mcpSessionManager.SubscribeResourceUpdates(req.Context(), serverConfig, uris)
+	if err != nil {
+		if errors.Is(err, mcp.ErrResourceSubscriptionsNotSupported) {
+			return types.NewErrHTTP(http.StatusFailedDependency, err.Error())
+		}
+		if errors.Is(err, mcp.ErrHealthCheckFailed) || errors.Is(err, mcp.ErrHealthCheckTimeout) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, "MCP server is not healthy, check configuration for errors")
+		}
+		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
+			return types.NewErrHTTP(http.StatusBadRequest, nse.Error())
+		}
+
+		var are nmcp.AuthRequiredErr
+		if errors.As(err, &are) {
+			return types.NewErrHTTP(http.StatusPreconditionFailed, "MCP server requires authentication")
+		}
+		return fmt.Errorf("failed to subscribe to resources: %w", err)
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "text/event-stream")
+	req.ResponseWriter.Header().Set("Cache-Control", "no-cache")
+	req.ResponseWriter.Header().Set("Connection", "keep-alive")
+	defer func() {
+		_ = req.WriteDataEvent(api.EventClose{})
+	}()
+
+	if _, err = req.ResponseWriter.Write([]byte("event: start\ndata: {}\n\n")); err != nil {
+		return err
+	}
+	req.Flush()
+
+	keepAlive := time.NewTicker(30 * time.Second)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			if err := req.WriteDataEvent(update); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := req.ResponseWriter.Write([]byte(": keep-alive\n\n")); err != nil {
+				return err
+			}
+			req.Flush()
+		}
+	}
+}
+
 func (m *MCPHandler) GetPrompts(req api.Context) error {
 	_, serverConfig, caps, err := serverForActionWithCapabilities(req, m.mcpSessionManager)
 	if err != nil {
@@ -1023,6 +1419,58 @@ func (m *MCPHandler) GetPrompt(req api.Context) error {
 	})
 }
 
+// Complete returns the MCP server's completions for a prompt argument or resource template variable.
+func (m *MCPHandler) Complete(req api.Context) error {
+	var completionRequest types.MCPCompletionRequest
+	if err := req.Read(&completionRequest); err != nil {
+		return types.NewErrBadRequest("failed to read completion request: %v", err)
+	}
+
+	switch completionRequest.Ref.Type {
+	case types.MCPCompletionReferenceTypePrompt:
+		if completionRequest.Ref.Name == "" {
+			return types.NewErrBadRequest("prompt references require a name")
+		}
+	case types.MCPCompletionReferenceTypeResource:
+		if completionRequest.Ref.URI == "" {
+			return types.NewErrBadRequest("resource references require a uri")
+		}
+	default:
+		return types.NewErrBadRequest("invalid reference type %q", completionRequest.Ref.Type)
+	}
+	if completionRequest.Argument.Name == "" {
+		return types.NewErrBadRequest("argument name is required")
+	}
+
+	_, serverConfig, err := serverForAction(req)
+	if err != nil {
+		return err
+	}
+
+	result, err := m.mcpSessionManager.Complete(req.Context(), serverConfig, completionRequest)
+	if err != nil {
+		if errors.Is(err, mcp.ErrHealthCheckFailed) || errors.Is(err, mcp.ErrHealthCheckTimeout) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, "MCP server is not healthy, check configuration for errors")
+		}
+		if errors.Is(err, nmcp.ErrNoResult) || strings.HasSuffix(err.Error(), nmcp.ErrNoResult.Error()) {
+			return types.NewErrHTTP(http.StatusServiceUnavailable, "No response from MCP server, check configuration for errors")
+		}
+		if strings.HasSuffix(strings.ToLower(err.Error()), "method not found") {
+			return types.NewErrHTTP(http.StatusFailedDependency, "MCP server does not support completions")
+		}
+		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
+			return types.NewErrHTTP(http.StatusBadRequest, nse.Error())
+		}
+		var are nmcp.AuthRequiredErr
+		if errors.As(err, &are) {
+			return types.NewErrHTTP(http.StatusPreconditionFailed, "MCP server requires authentication")
+		}
+		return fmt.Errorf("failed to get completions: %w", err)
+	}
+
+	return req.Write(result)
+}
+
 func mcpServerOrInstanceFromConnectURL(req api.Context, id string) (v1.MCPServer, v1.MCPServerInstance, error) {
 	switch {
 	case system.IsMCPServerInstanceID(id):
@@ -1104,7 +1552,7 @@ func mcpServerOrInstanceFromConnectURL(req api.Context, id string) (v1.MCPServer
 			}
 
 			for _, env := range entry.Spec.Manifest.Env {
-				if env.Required {
+				if env.Required && env.ValueFrom == "" {
 					return v1.MCPServer{}, v1.MCPServerInstance{}, types.NewErrNotFound("user has not configured an MCP server for catalog entry %s", id)
 				}
 			}
@@ -1266,6 +1714,12 @@ func serverFromMCPServerInstance(req api.Context, instance v1.MCPServerInstance)
 		return server, mcp.ServerConfig{}, types.NewErrBadRequest("missing required config: %s", strings.Join(missingConfig, ", "))
 	}
 
+	if err = addOAuthTokenSourceValues(req, server, &serverConfig); err != nil {
+		return server, mcp.ServerConfig{}, err
+	}
+
+	serverConfig.ToolAllowlist = instance.Spec.ToolAllowlist
+
 	// Best effort to update the last request time.
 	// Don't update on every request, only if it's been a while since the last update, to avoid excessive writes to storage.
 	if time.Since(server.Status.LastRequestTime.Time) > requestTimeUpdateInterval {
@@ -1297,6 +1751,27 @@ func serverConfigForAction(req api.Context, server v1.MCPServer) (mcp.ServerConf
 		return mcp.ServerConfig{}, types.NewErrBadRequest("mcp server %s needs to update its URL", server.Name)
 	}
 
+	credCtxs, scope, err := serverCredentialContexts(req, server)
+	if err != nil {
+		return mcp.ServerConfig{}, err
+	}
+
+	cred, err := req.GPTClient.RevealCredential(req.Context(), credCtxs, server.Name)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		// Servers that their owners haven't configured use the team credential of one of the owner's groups.
+		if cred.Env, err = teamCredentialEnv(req, server); err != nil {
+			return mcp.ServerConfig{}, fmt.Errorf("failed to find team credential: %w", err)
+		}
+	} else if err != nil {
+		return mcp.ServerConfig{}, fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	return serverConfigWithCredential(req, server, scope, cred.Env)
+}
+
+// serverCredentialContexts returns the credential contexts that the credential of a server is read from, in order, and
+// the scope of the server.
+func serverCredentialContexts(req api.Context, server v1.MCPServer) ([]string, string, error) {
 	var (
 		credCtxs []string
 		scope    string
@@ -1313,7 +1788,7 @@ func serverConfigForAction(req api.Context, server v1.MCPServer) (mcp.ServerConf
 		if req.PathValue("project_id") != "" {
 			project, err := getProjectThread(req)
 			if err != nil {
-				return mcp.ServerConfig{}, err
+				return nil, "", err
 			}
 
 			if project.IsSharedProject() {
@@ -1327,14 +1802,14 @@ func serverConfigForAction(req api.Context, server v1.MCPServer) (mcp.ServerConf
 		scope = server.Spec.UserID
 	}
 
+	return credCtxs, scope, nil
+}
+
+// serverConfigWithCredential returns the config of a server that is configured with the given credential env.
+func serverConfigWithCredential(req api.Context, server v1.MCPServer, scope string, credEnv map[string]string) (mcp.ServerConfig, error) {
 	// Add extracted env vars to the server definition
 	addExtractedEnvVars(&server)
 
-	cred, err := req.GPTClient.RevealCredential(req.Context(), credCtxs, server.Name)
-	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
-		return mcp.ServerConfig{}, fmt.Errorf("failed to find credential: %w", err)
-	}
-
 	catalogName := server.Spec.MCPCatalogID
 	if catalogName == "" {
 		catalogName = server.Status.MCPCatalogID
@@ -1370,7 +1845,7 @@ func serverConfigForAction(req api.Context, server v1.MCPServer) (mcp.ServerConf
 		tokenExchangeCred gptscript.Credential
 		tokenCredErr      error
 	)
-	if err = retry.OnError(kwait.Backoff{
+	if err := retry.OnError(kwait.Backoff{
 		Steps:    10,
 		Duration: 100 * time.Millisecond,
 		Factor:   2.0,
@@ -1388,6 +1863,7 @@ func serverConfigForAction(req api.Context, server v1.MCPServer) (mcp.ServerConf
 	var (
 		serverConfig  mcp.ServerConfig
 		missingConfig []string
+		err           error
 	)
 	if server.Spec.Manifest.Runtime == types.RuntimeComposite {
 		var componentServers v1.MCPServerList
@@ -1406,9 +1882,9 @@ func serverConfigForAction(req api.Context, server v1.MCPServer) (mcp.ServerConf
 			return mcp.ServerConfig{}, fmt.Errorf("failed to list component servers instances: %w", err)
 		}
 
-		serverConfig, missingConfig, err = mcp.CompositeServerToServerConfig(server, componentServers.Items, componentInstances.Items, server.ValidConnectURLs(baseURL), baseURL, req.User.GetUID(), scope, catalogName, cred.Env, tokenExchangeCred.Env)
+		serverConfig, missingConfig, err = mcp.CompositeServerToServerConfig(server, componentServers.Items, componentInstances.Items, server.ValidConnectURLs(baseURL), baseURL, req.User.GetUID(), scope, catalogName, credEnv, tokenExchangeCred.Env)
 	} else {
-		serverConfig, missingConfig, err = mcp.ServerToServerConfig(server, server.ValidConnectURLs(baseURL), baseURL, req.User.GetUID(), scope, catalogName, cred.Env, tokenExchangeCred.Env)
+		serverConfig, missingConfig, err = mcp.ServerToServerConfig(server, server.ValidConnectURLs(baseURL), baseURL, req.User.GetUID(), scope, catalogName, credEnv, tokenExchangeCred.Env)
 	}
 	if err != nil {
 		return mcp.ServerConfig{}, err
@@ -1418,6 +1894,10 @@ func serverConfigForAction(req api.Context, server v1.MCPServer) (mcp.ServerConf
 		return mcp.ServerConfig{}, types.NewErrBadRequest("missing required config: %s", strings.Join(missingConfig, ", "))
 	}
 
+	if err = addOAuthTokenSourceValues(req, server, &serverConfig); err != nil {
+		return mcp.ServerConfig{}, err
+	}
+
 	// Best effort to update the last request time.
 	// Don't update on every request, only if it's been a while since the last update, to avoid excessive writes to storage.
 	if time.Since(server.Status.LastRequestTime.Time) > requestTimeUpdateInterval {
@@ -1450,6 +1930,70 @@ func serverForActionWithCapabilities(req api.Context, mcpSessionManager *mcp.Ses
 	return server, serverConfig, caps, err
 }
 
+// validateSecretRefsAllowed checks that only admins add or change environment variables that reference external
+// secrets, because the secrets are read with Obot's own credentials. References that are already in the existing
+// environment variables, such as those that a server got from its catalog entry, can be kept.
+func validateSecretRefsAllowed(req api.Context, env, existing []types.MCPEnv) error {
+	if req.UserIsAdmin() {
+		return nil
+	}
+
+	for _, e := range env {
+		if e.ValueFrom == "" {
+			continue
+		}
+		if !slices.ContainsFunc(existing, func(existing types.MCPEnv) bool {
+			return existing.Key == e.Key && existing.ValueFrom == e.ValueFrom
+		}) {
+			return types.NewErrForbidden("only admins can reference external secrets in environment variables")
+		}
+	}
+
+	return nil
+}
+
+// validateStdioServerManifest checks that a server only uses the stdio runtime to run the command of a catalog entry in
+// the default catalog. Stdio servers run as subprocesses of Obot, so their commands are only chosen by admins.
+func validateStdioServerManifest(req api.Context, manifest types.MCPServerManifest, catalogEntryName string) error {
+	if manifest.Runtime == types.RuntimeComposite && manifest.CompositeConfig != nil {
+		for _, component := range manifest.CompositeConfig.ComponentServers {
+			if component.MCPServerID != "" {
+				// Components that proxy to multi-user servers were checked when those servers were created.
+				continue
+			}
+			if err := validateStdioServerManifest(req, component.Manifest, component.CatalogEntryID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if manifest.Runtime != types.RuntimeStdio {
+		return nil
+	}
+
+	if catalogEntryName != "" {
+		var entry v1.MCPServerCatalogEntry
+		if err := req.Get(&entry, catalogEntryName); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		} else if err == nil && entry.Spec.MCPCatalogName == system.DefaultCatalog &&
+			equality.Semantic.DeepEqual(manifest.StdioConfig, entry.Spec.Manifest.StdioConfig) {
+			return nil
+		}
+	}
+
+	return types.NewErrBadRequest("the stdio runtime can only be used by servers of catalog entries in the default catalog")
+}
+
+// validateStdioCatalogEntryManifest checks that only admins add entries that use the stdio runtime, and only to the
+// default catalog.
+func validateStdioCatalogEntryManifest(req api.Context, manifest types.MCPServerCatalogEntryManifest, catalogName string) error {
+	if validation.UsesStdioRuntime(manifest) && (catalogName != system.DefaultCatalog || !req.UserIsAdmin()) {
+		return types.NewErrBadRequest("the stdio runtime can only be used by admins in the default catalog")
+	}
+	return nil
+}
+
 // serverManifestFromCatalogEntryManifest converts a catalog entry manifest to a server manifest.
 // If the user is an admin, they can override anything from the catalog entry.
 func serverManifestFromCatalogEntryManifest(
@@ -1478,6 +2022,7 @@ func serverManifestFromCatalogEntryManifest(
 		if input.CompositeConfig != nil {
 			inputConfig = *input.CompositeConfig
 		}
+		result.CompositeConfig.ToolNamespace = inputConfig.ToolNamespace
 
 		inputComponents := make(map[string]types.ComponentServer, len(inputConfig.ComponentServers))
 		for _, componentServer := range inputConfig.ComponentServers {
@@ -1592,6 +2137,12 @@ func mergeMCPServerManifests(existing, override types.MCPServerManifest) types.M
 	if override.ContainerizedConfig != nil {
 		existing.ContainerizedConfig = override.ContainerizedConfig
 	}
+	if override.StdioConfig != nil {
+		existing.StdioConfig = override.StdioConfig
+	}
+	if override.SourceConfig != nil {
+		existing.SourceConfig = override.SourceConfig
+	}
 	if override.RemoteConfig != nil {
 		if existing.RemoteConfig == nil {
 			existing.RemoteConfig = override.RemoteConfig
@@ -1651,6 +2202,7 @@ func (m *MCPHandler) CreateServer(req api.Context) error {
 		server.Spec.PowerUserWorkspaceID = workspaceID
 	}
 
+	var preset *types.MCPConfigurationPreset
 	if input.CatalogEntryID != "" {
 		var catalogEntry v1.MCPServerCatalogEntry
 		if err := req.Get(&catalogEntry, input.CatalogEntryID); err != nil {
@@ -1680,6 +2232,24 @@ func (m *MCPHandler) CreateServer(req api.Context) error {
 			return types.NewErrBadRequest("catalog entry requires OAuth configuration by an administrator before it can be used")
 		}
 
+		if input.ConfigurationPreset != "" {
+			p, ok := configurationPreset(catalogEntry.Spec.Manifest, input.ConfigurationPreset)
+			if !ok {
+				return types.NewErrBadRequest("catalog entry does not have a preset named %q", input.ConfigurationPreset)
+			}
+
+			// The preset's URL is only a default; a URL provided by the user takes precedence.
+			if p.URL != "" && (input.MCPServerManifest.RemoteConfig == nil || input.MCPServerManifest.RemoteConfig.URL == "") {
+				if input.MCPServerManifest.RemoteConfig == nil {
+					input.MCPServerManifest.RemoteConfig = &types.RemoteRuntimeConfig{}
+				}
+				input.MCPServerManifest.RemoteConfig.URL = p.URL
+			}
+
+			preset = &p
+			server.Spec.ConfigurationPreset = p.Name
+		}
+
 		manifest, err := serverManifestFromCatalogEntryManifest(req.UserIsAdmin(), false, catalogEntry.Spec.Manifest, input.MCPServerManifest)
 		if err != nil {
 			return err
@@ -1687,44 +2257,258 @@ func (m *MCPHandler) CreateServer(req api.Context) error {
 
 		server.Spec.Manifest = manifest
 		server.Spec.UnsupportedTools = catalogEntry.Spec.UnsupportedTools
+	} else if input.ConfigurationPreset != "" {
+		return types.NewErrBadRequest("configurationPreset can only be used with a catalog entry")
 	} else if req.UserIsAdmin() || workspaceID != "" {
 		// If the user is an admin, or if this server is being created in a workspace by a PowerUserPlus,
 		// they can create a server with a manifest that is not in the catalog.
+		if err := validateSecretRefsAllowed(req, input.MCPServerManifest.Env, nil); err != nil {
+			return err
+		}
 		server.Spec.Manifest = input.MCPServerManifest
 	} else {
 		return types.NewErrBadRequest("catalogEntryID is required")
 	}
 
-	if err := validation.ValidateServerManifest(server.Spec.Manifest, server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != ""); err != nil {
-		return types.NewErrBadRequest("validation failed: %v", err)
+	if err := validation.ValidateServerManifest(server.Spec.Manifest, server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != ""); err != nil {
+		return types.NewErrBadRequest("validation failed: %v", err)
+	}
+	if err := validateStdioServerManifest(req, server.Spec.Manifest, server.Spec.MCPServerCatalogEntryName); err != nil {
+		return err
+	}
+	if err := validateCompositeNesting(server.Spec.Manifest, server.Spec.MCPServerCatalogEntryName); err != nil {
+		return err
+	}
+
+	addExtractedEnvVars(&server)
+	if err := req.Create(&server); err != nil {
+		return err
+	}
+
+	var credCtx string
+	if catalogID != "" {
+		credCtx = fmt.Sprintf("%s-%s", catalogID, server.Name)
+	} else if workspaceID != "" {
+		credCtx = fmt.Sprintf("%s-%s", workspaceID, server.Name)
+	} else {
+		credCtx = fmt.Sprintf("%s-%s", req.User.GetUID(), server.Name)
+	}
+
+	if preset != nil && len(preset.Env) > 0 {
+		if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+			Context:  credCtx,
+			ToolName: server.Name,
+			Type:     gptscript.CredentialTypeTool,
+			Env:      preset.Env,
+		}); err != nil {
+			return fmt.Errorf("failed to create credential from preset: %w", err)
+		}
+	}
+
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{credCtx}, server.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), catalogID, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
+	}
+
+	return req.WriteCreated(ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug))
+}
+
+// CloneServer creates a copy of a single-user MCPServer, optionally for another user or in a project.
+// Credentials are not copied unless requested, and sensitive values are only copied to a server with the same owner.
+// Values in the request's configuration replace the copied ones, so that a variant of a configured server can be made
+// in one call.
+func (m *MCPHandler) CloneServer(req api.Context) error {
+	var input types.MCPServerCloneRequest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	var source v1.MCPServer
+	if err := req.Get(&source, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	// Only single-user servers can be cloned through this endpoint.
+	if source.Spec.MCPCatalogID != "" || source.Spec.PowerUserWorkspaceID != "" {
+		return types.NewErrNotFound("MCP server not found")
+	}
+	if source.Spec.Manifest.Runtime == types.RuntimeComposite {
+		return types.NewErrBadRequest("composite MCP servers cannot be cloned")
+	}
+	if source.Spec.NanobotAgentID != "" {
+		return types.NewErrBadRequest("MCP servers for nanobot agents cannot be cloned")
+	}
+
+	userID := req.User.GetUID()
+	if input.UserID != "" && input.UserID != userID {
+		if !req.UserIsAdmin() {
+			return types.NewErrForbidden("only admins can clone MCP servers for other users")
+		}
+		if _, err := req.GatewayClient.UserByID(req.Context(), input.UserID); err != nil {
+			return err
+		}
+		userID = input.UserID
+	}
+	if input.CopySensitiveConfiguration && userID != source.Spec.UserID {
+		return types.NewErrBadRequest("sensitive configuration can only be copied to a server with the same owner")
+	}
+
+	var project *v1.Thread
+	if input.ProjectID != "" {
+		var thread v1.Thread
+		if err := req.Get(&thread, strings.Replace(input.ProjectID, system.ProjectPrefix, system.ThreadPrefix, 1)); err != nil {
+			return err
+		}
+		if !thread.Spec.Project || thread.Spec.UserID != userID {
+			return types.NewErrNotFound("project %s not found", input.ProjectID)
+		}
+		project = &thread
+	}
+
+	alias := input.Alias
+	if alias == "" {
+		alias = source.Spec.Alias
+	}
+
+	server := v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.MCPServerPrefix,
+			Namespace:    req.Namespace(),
+			Finalizers:   []string{v1.MCPServerFinalizer},
+		},
+		Spec: v1.MCPServerSpec{
+			Manifest:                  *source.Spec.Manifest.DeepCopy(),
+			UnsupportedTools:          slices.Clone(source.Spec.UnsupportedTools),
+			Alias:                     alias,
+			MCPServerCatalogEntryName: source.Spec.MCPServerCatalogEntryName,
+			ConfigurationPreset:       source.Spec.ConfigurationPreset,
+			UserID:                    userID,
+		},
+	}
+
+	if err := validation.ValidateServerManifest(server.Spec.Manifest, false); err != nil {
+		return types.NewErrBadRequest("validation failed: %v", err)
+	}
+	if err := validateStdioServerManifest(req, server.Spec.Manifest, server.Spec.MCPServerCatalogEntryName); err != nil {
+		return err
+	}
+
+	addExtractedEnvVars(&server)
+
+	envVars := make(map[string]string, len(input.Configuration))
+	if input.CopyConfiguration || input.CopySensitiveConfiguration {
+		cred, err := req.GPTClient.RevealCredential(req.Context(), []string{fmt.Sprintf("%s-%s", source.Spec.UserID, source.Name)}, source.Name)
+		if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+			return fmt.Errorf("failed to find credential: %w", err)
+		}
+		envVars = copiedConfiguration(server.Spec.Manifest, cred.Env, input.CopySensitiveConfiguration)
+	}
+	if err := applyConfiguration(server.Spec.Manifest, envVars, input.Configuration); err != nil {
+		return types.NewErrBadRequest("invalid configuration: %v", err)
+	}
+
+	if err := req.Create(&server); err != nil {
+		return err
+	}
+
+	if len(envVars) > 0 {
+		if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+			Context:  fmt.Sprintf("%s-%s", userID, server.Name),
+			ToolName: server.Name,
+			Type:     gptscript.CredentialTypeTool,
+			Env:      envVars,
+		}); err != nil {
+			return fmt.Errorf("failed to create credential: %w", err)
+		}
+	}
+
+	if project != nil {
+		if err := req.Create(&v1.ProjectMCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: system.ProjectMCPServerPrefix,
+				Namespace:    req.Namespace(),
+				Finalizers:   []string{v1.ProjectMCPServerFinalizer},
+			},
+			Spec: v1.ProjectMCPServerSpec{
+				Manifest: types.ProjectMCPServerManifest{
+					MCPID: server.Name,
+					Alias: alias,
+				},
+				ThreadName:    project.Name,
+				UserID:        userID,
+				MCPServerName: server.Name,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to add cloned MCP server to project: %w", err)
+		}
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, userID, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	addExtractedEnvVars(&server)
-	if err := req.Create(&server); err != nil {
-		return err
+	return req.WriteCreated(ConvertMCPServer(server, envVars, MCPServerConnectBaseURL(req, server), slug))
+}
+
+// configurationPreset returns the catalog entry's preset with the given name.
+func configurationPreset(manifest types.MCPServerCatalogEntryManifest, name string) (types.MCPConfigurationPreset, bool) {
+	for _, p := range manifest.Presets {
+		if p.Name == name {
+			return p, true
+		}
 	}
+	return types.MCPConfigurationPreset{}, false
+}
 
-	var (
-		cred gptscript.Credential
-		err  error
-	)
-	if catalogID != "" {
-		cred, err = req.GPTClient.RevealCredential(req.Context(), []string{fmt.Sprintf("%s-%s", catalogID, server.Name)}, server.Name)
-	} else if workspaceID != "" {
-		cred, err = req.GPTClient.RevealCredential(req.Context(), []string{fmt.Sprintf("%s-%s", workspaceID, server.Name)}, server.Name)
-	} else {
-		cred, err = req.GPTClient.RevealCredential(req.Context(), []string{fmt.Sprintf("%s-%s", req.User.GetUID(), server.Name)}, server.Name)
+// copiedConfiguration returns the values in env for the environment variables and headers
+// of the manifest, leaving out the ones that are marked as sensitive unless includeSensitive is set.
+func copiedConfiguration(manifest types.MCPServerManifest, env map[string]string, includeSensitive bool) map[string]string {
+	result := make(map[string]string, len(env))
+	for _, e := range manifest.Env {
+		if (includeSensitive || !e.Sensitive) && env[e.Key] != "" {
+			result[e.Key] = env[e.Key]
+		}
 	}
-	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
-		return fmt.Errorf("failed to find credential: %w", err)
+	if manifest.RemoteConfig != nil {
+		for _, h := range manifest.RemoteConfig.Headers {
+			// Headers with static values are part of the manifest and don't need to be copied.
+			if (includeSensitive || !h.Sensitive) && h.Value == "" && env[h.Key] != "" {
+				result[h.Key] = env[h.Key]
+			}
+		}
 	}
+	return result
+}
 
-	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), catalogID, workspaceID)
-	if err != nil {
-		return fmt.Errorf("failed to generate slug: %w", err)
-	}
+// applyConfiguration sets the values of configuration in env, removing the ones that are empty. Every key has to be an
+// environment variable or a header without a static value in the manifest.
+func applyConfiguration(manifest types.MCPServerManifest, env, configuration map[string]string) error {
+	for key, value := range configuration {
+		configurable := slices.ContainsFunc(manifest.Env, func(e types.MCPEnv) bool {
+			return e.Key == key && e.ValueFrom == ""
+		})
+		if !configurable && manifest.RemoteConfig != nil {
+			configurable = slices.ContainsFunc(manifest.RemoteConfig.Headers, func(h types.MCPHeader) bool {
+				return h.Key == key && h.Value == ""
+			})
+		}
+		if !configurable {
+			return fmt.Errorf("%s is not an environment variable or header of the server", key)
+		}
 
-	return req.WriteCreated(ConvertMCPServer(server, cred.Env, m.serverURL, slug))
+		if value == "" {
+			delete(env, key)
+		} else {
+			env[key] = value
+		}
+	}
+	return nil
 }
 
 // UpdateServer updates the manifest of an MCPServer.
@@ -1785,6 +2569,15 @@ func (m *MCPHandler) UpdateServer(req api.Context) error {
 	if err := validation.ValidateServerManifest(updated, existing.Spec.MCPCatalogID != "" || existing.Spec.PowerUserWorkspaceID != ""); err != nil {
 		return types.NewErrBadRequest("validation failed: %v", err)
 	}
+	if err := validateStdioServerManifest(req, updated, existing.Spec.MCPServerCatalogEntryName); err != nil {
+		return err
+	}
+	if err := validateSecretRefsAllowed(req, updated.Env, existing.Spec.Manifest.Env); err != nil {
+		return err
+	}
+	if err := validateCompositeNesting(updated, existing.Name, existing.Spec.MCPServerCatalogEntryName); err != nil {
+		return err
+	}
 
 	// Use retry.RetryOnConflict because controllers (e.g. DetectK8sSettingsDrift,
 	// UpdateMCPServerStatus) can update this MCPServer concurrently, bumping the
@@ -1812,7 +2605,142 @@ func (m *MCPHandler) UpdateServer(req api.Context) error {
 		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	return req.Write(ConvertMCPServer(existing, cred.Env, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(existing, cred.Env, MCPServerConnectBaseURL(req, existing), slug))
+}
+
+// PatchCompositeComponents adds and removes components of a composite server. Unlike UpdateServer, it doesn't shut the
+// composite server down: only the component servers that are added, changed, or removed are deployed or shut down, and
+// the sessions of the other components are kept.
+func (m *MCPHandler) PatchCompositeComponents(req api.Context) error {
+	var (
+		id          = req.PathValue("mcp_server_id")
+		catalogID   = req.PathValue("catalog_id")
+		workspaceID = req.PathValue("workspace_id")
+		server      v1.MCPServer
+		patch       types.CompositeComponentsPatch
+	)
+
+	if err := req.Get(&server, id); err != nil {
+		return err
+	}
+	if server.Spec.MCPCatalogID != catalogID || server.Spec.PowerUserWorkspaceID != workspaceID {
+		return types.NewErrNotFound("MCP server not found")
+	}
+	if server.Spec.Manifest.Runtime != types.RuntimeComposite || server.Spec.Manifest.CompositeConfig == nil {
+		return types.NewErrBadRequest("MCP server %s is not a composite server", server.Name)
+	}
+
+	if err := req.Read(&patch); err != nil {
+		return err
+	}
+	if len(patch.Add) == 0 && len(patch.Remove) == 0 {
+		return types.NewErrBadRequest("no components to add or remove")
+	}
+
+	manifest := *server.Spec.Manifest.DeepCopy()
+	components, err := patchCompositeComponents(manifest.CompositeConfig.ComponentServers, patch, server.Name, server.Spec.MCPServerCatalogEntryName)
+	if err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+	manifest.CompositeConfig.ComponentServers = components
+
+	if err := validation.ValidateServerManifest(manifest, server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != ""); err != nil {
+		return types.NewErrBadRequest("validation failed: %v", err)
+	}
+	if err := validateStdioServerManifest(req, manifest, server.Spec.MCPServerCatalogEntryName); err != nil {
+		return err
+	}
+
+	server, err = m.updateCompositeManifest(req, server.Name, hash.Digest(server.Spec.Manifest), manifest)
+	if err != nil {
+		return err
+	}
+
+	// Wait for the component servers to be created, updated, and deleted, so that they can be configured and launched.
+	if server, err = m.waitForCompositeReady(req, server, 30*time.Second); err != nil {
+		return fmt.Errorf("failed to wait for component servers to sync: %w", err)
+	}
+
+	var credCtx string
+	if catalogID != "" {
+		credCtx = fmt.Sprintf("%s-%s", catalogID, server.Name)
+	} else if workspaceID != "" {
+		credCtx = fmt.Sprintf("%s-%s", workspaceID, server.Name)
+	} else {
+		credCtx = fmt.Sprintf("%s-%s", req.User.GetUID(), server.Name)
+	}
+
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{credCtx}, server.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), catalogID, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
+	}
+
+	return req.Write(ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug))
+}
+
+// validateCompositeNesting validates that the components of a composite server manifest don't contain the composite
+// server, which is identified by compositeIDs, such as its name and the name of its catalog entry.
+func validateCompositeNesting(manifest types.MCPServerManifest, compositeIDs ...string) error {
+	if manifest.Runtime != types.RuntimeComposite || manifest.CompositeConfig == nil {
+		return nil
+	}
+
+	for _, component := range manifest.CompositeConfig.ComponentServers {
+		if err := validation.ValidateComponentNesting(component, compositeIDs...); err != nil {
+			return types.NewErrBadRequest("validation failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// patchCompositeComponents returns the components with the patch applied. Components that are added replace the
+// components with the same IDs in place, and the others are appended. Added components that are composite servers
+// must not contain the composite server, which is identified by compositeIDs.
+func patchCompositeComponents(components []types.ComponentServer, patch types.CompositeComponentsPatch, compositeIDs ...string) ([]types.ComponentServer, error) {
+	indexes := make(map[string]int, len(components))
+	for i, component := range components {
+		indexes[component.ComponentID()] = i
+	}
+
+	removed := make(map[string]bool, len(patch.Remove))
+	for _, id := range patch.Remove {
+		if _, ok := indexes[id]; !ok {
+			return nil, fmt.Errorf("component %s is not a component of the composite server", id)
+		}
+		removed[id] = true
+	}
+
+	result := slices.Clone(components)
+	for _, component := range patch.Add {
+		id := component.ComponentID()
+		switch {
+		case id == "":
+			return nil, fmt.Errorf("components must have a catalogEntryID or an mcpServerID")
+		case component.CatalogEntryID != "" && component.MCPServerID != "":
+			return nil, fmt.Errorf("component %s must have either a catalogEntryID or an mcpServerID, not both", id)
+		case removed[id]:
+			return nil, fmt.Errorf("component %s can't be both added and removed", id)
+		}
+		if err := validation.ValidateComponentNesting(component, compositeIDs...); err != nil {
+			return nil, err
+		}
+
+		if i, ok := indexes[id]; ok {
+			result[i] = component
+			continue
+		}
+		indexes[id] = len(result)
+		result = append(result, component)
+	}
+
+	return slices.DeleteFunc(result, func(component types.ComponentServer) bool {
+		return removed[component.ComponentID()]
+	}), nil
 }
 
 func (m *MCPHandler) UpdateServerAlias(req api.Context) error {
@@ -1950,7 +2878,109 @@ func (m *MCPHandler) ConfigureServer(req api.Context) error {
 		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	return req.Write(ConvertMCPServer(mcpServer, envVars, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(mcpServer, envVars, MCPServerConnectBaseURL(req, mcpServer), slug))
+}
+
+// RotateServerCredentials replaces the configuration of a configured server without shutting it down. The new
+// configuration is kept in a separate credential while the server is redeployed with it, and only replaces the old
+// credential once the new deployment is ready, so that the server keeps running with the old configuration until then.
+func (m *MCPHandler) RotateServerCredentials(req api.Context) error {
+	catalogID := req.PathValue("catalog_id")
+	workspaceID := req.PathValue("workspace_id")
+
+	var mcpServer v1.MCPServer
+	if err := req.Get(&mcpServer, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	// For servers that are in catalogs, this checks to make sure that a catalogID was provided and that it matches.
+	// For servers that are in workspaces, this checks to make sure that a workspaceID was provided and that it matches.
+	// For servers that are not in catalogs or workspaces, this checks to make sure that no catalogID or workspaceID was provided.
+	if mcpServer.Spec.MCPCatalogID != catalogID || mcpServer.Spec.PowerUserWorkspaceID != workspaceID {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	if mcpServer.Spec.Manifest.Runtime == types.RuntimeComposite {
+		return types.NewErrBadRequest("credentials of composite MCP servers can't be rotated, configure their components instead")
+	}
+	if mcpServer.Spec.NeedsURL {
+		return types.NewErrBadRequest("mcp server %s needs to update its URL", mcpServer.Name)
+	}
+
+	var envVars map[string]string
+	if err := req.Read(&envVars); err != nil {
+		return err
+	}
+	for key, val := range envVars {
+		if val == "" {
+			delete(envVars, key)
+		}
+	}
+
+	credCtxs, scope, err := serverCredentialContexts(req, mcpServer)
+	if err != nil {
+		return err
+	}
+	credCtx := credCtxs[0]
+
+	oldCred, err := req.GPTClient.RevealCredential(req.Context(), []string{credCtx}, mcpServer.Name)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return types.NewErrBadRequest("MCP server %s is not configured, configure it instead of rotating its credentials", mcpServer.Name)
+	} else if err != nil {
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	// Keep the new configuration until the rotation is done, so that it isn't lost if the rotation is interrupted.
+	rotatingCredCtx := system.MCPRotatingCredentialContext(credCtx)
+	if _, err := ensureCredential(req.Context(), req.GPTClient, gptscript.Credential{
+		Context:  rotatingCredCtx,
+		ToolName: mcpServer.Name,
+		Type:     gptscript.CredentialTypeTool,
+		Env:      envVars,
+	}); err != nil {
+		return err
+	}
+
+	serverConfig, err := serverConfigWithCredential(req, mcpServer, scope, envVars)
+	if err == nil {
+		// Changing the configuration of the server rolls out a new deployment of it. The old deployment keeps serving
+		// requests until the new one is ready.
+		err = m.launchServer(req.Context(), mcpServer, serverConfig)
+	}
+	if err != nil {
+		log.Warnf("Failed to rotate credentials of MCP server, restoring its previous configuration: server=%s error=%v", mcpServer.Name, err)
+		if oldConfig, oldErr := serverConfigWithCredential(req, mcpServer, scope, oldCred.Env); oldErr != nil {
+			log.Errorf("Failed to get previous configuration of MCP server: server=%s error=%v", mcpServer.Name, oldErr)
+		} else if _, oldErr = m.mcpSessionManager.LaunchServer(context.WithoutCancel(req.Context()), oldConfig); oldErr != nil {
+			log.Errorf("Failed to restore previous configuration of MCP server: server=%s error=%v", mcpServer.Name, oldErr)
+		}
+		if delErr := DeleteCredentialIfExists(context.WithoutCancel(req.Context()), req.GPTClient, []string{rotatingCredCtx}, mcpServer.Name); delErr != nil {
+			log.Errorf("Failed to remove rotated credential of MCP server: server=%s error=%v", mcpServer.Name, delErr)
+		}
+		return err
+	}
+
+	// The server is running with the new configuration, so it can replace the old one.
+	if _, err := ensureCredential(req.Context(), req.GPTClient, gptscript.Credential{
+		Context:  credCtx,
+		ToolName: mcpServer.Name,
+		Type:     gptscript.CredentialTypeTool,
+		Env:      envVars,
+	}); err != nil {
+		return err
+	}
+	if err := DeleteCredentialIfExists(req.Context(), req.GPTClient, []string{rotatingCredCtx}, mcpServer.Name); err != nil {
+		return err
+	}
+
+	log.Infof("Rotated credentials of MCP server: server=%s", mcpServer.Name)
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, mcpServer, req.User.GetUID(), catalogID, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
+	}
+
+	return req.Write(ConvertMCPServer(mcpServer, envVars, MCPServerConnectBaseURL(req, mcpServer), slug))
 }
 
 func (m *MCPHandler) configureCompositeServer(req api.Context, compositeServer v1.MCPServer) error {
@@ -2153,7 +3183,7 @@ func (m *MCPHandler) configureCompositeServer(req api.Context, compositeServer v
 		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	return req.Write(ConvertMCPServer(compositeServer, nil, m.serverURL, slug, components...))
+	return req.Write(ConvertMCPServer(compositeServer, nil, MCPServerConnectBaseURL(req, compositeServer), slug, components...))
 }
 
 // applyURLTemplate applies a URL template with environment variables
@@ -2211,7 +3241,7 @@ func (m *MCPHandler) DeconfigureServer(req api.Context) error {
 		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	return req.Write(ConvertMCPServer(mcpServer, nil, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(mcpServer, nil, MCPServerConnectBaseURL(req, mcpServer), slug))
 }
 
 func (m *MCPHandler) deconfigureCompositeServer(req api.Context, compositeServer v1.MCPServer) error {
@@ -2267,7 +3297,7 @@ func (m *MCPHandler) deconfigureCompositeServer(req api.Context, compositeServer
 		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	return req.Write(ConvertMCPServer(compositeServer, nil, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(compositeServer, nil, MCPServerConnectBaseURL(req, compositeServer), slug))
 }
 
 func (m *MCPHandler) Reveal(req api.Context) error {
@@ -2497,6 +3527,13 @@ func addExtractedEnvVars(server *v1.MCPServer) {
 				toExtract = append(toExtract, server.Spec.Manifest.ContainerizedConfig.Args...)
 			}
 		}
+	case types.RuntimeSource:
+		if server.Spec.Manifest.SourceConfig != nil {
+			toExtract = []string{server.Spec.Manifest.SourceConfig.Command}
+			if len(server.Spec.Manifest.SourceConfig.Args) > 0 {
+				toExtract = append(toExtract, server.Spec.Manifest.SourceConfig.Args...)
+			}
+		}
 	case types.RuntimeRemote:
 		if server.Spec.Manifest.RemoteConfig != nil {
 			toExtract = []string{server.Spec.Manifest.RemoteConfig.URL}
@@ -2550,6 +3587,13 @@ func addExtractedEnvVarsToCatalogEntry(entry *v1.MCPServerCatalogEntry) {
 				toExtract = append(toExtract, entry.Spec.Manifest.ContainerizedConfig.Args...)
 			}
 		}
+	case types.RuntimeSource:
+		if entry.Spec.Manifest.SourceConfig != nil {
+			toExtract = append(toExtract, entry.Spec.Manifest.SourceConfig.Command)
+			if len(entry.Spec.Manifest.SourceConfig.Args) > 0 {
+				toExtract = append(toExtract, entry.Spec.Manifest.SourceConfig.Args...)
+			}
+		}
 	case types.RuntimeRemote:
 		if entry.Spec.Manifest.RemoteConfig != nil {
 			// Add the existing headers to the existing map.
@@ -2593,7 +3637,8 @@ func ConvertMCPServer(server v1.MCPServer, credEnv map[string]string, serverURL,
 
 	// Check for missing required env vars
 	for _, env := range server.Spec.Manifest.Env {
-		if !env.Required {
+		if !env.Required || env.ValueFrom != "" {
+			// Values from external secrets are read when the server is deployed.
 			continue
 		}
 
@@ -2642,6 +3687,8 @@ func ConvertMCPServer(server v1.MCPServer, credEnv map[string]string, serverURL,
 		})
 	}
 
+	notice, inMaintenance := convertMaintenanceNotice(server.EffectiveMaintenanceNotice())
+
 	converted := types.MCPServer{
 		Metadata:                    MetadataFrom(&server),
 		Alias:                       server.Spec.Alias,
@@ -2652,6 +3699,7 @@ func ConvertMCPServer(server v1.MCPServer, credEnv map[string]string, serverURL,
 		Configured:                  len(missingEnvVars) == 0 && len(missingHeaders) == 0 && !server.Spec.NeedsURL && !missingOAuth,
 		MCPServerManifest:           server.Spec.Manifest,
 		CatalogEntryID:              server.Spec.MCPServerCatalogEntryName,
+		ConfigurationPreset:         server.Spec.ConfigurationPreset,
 		PowerUserWorkspaceID:        server.Spec.PowerUserWorkspaceID,
 		MCPCatalogID:                server.Spec.MCPCatalogID,
 		ConnectURL:                  connectURL,
@@ -2666,12 +3714,30 @@ func ConvertMCPServer(server v1.MCPServer, credEnv map[string]string, serverURL,
 		DeploymentReplicas:          server.Status.DeploymentReplicas,
 		DeploymentConditions:        conditions,
 		K8sSettingsHash:             server.Status.K8sSettingsHash,
+		ConsecutiveProbeFailures:    server.Status.ConsecutiveProbeFailures,
+		RestartCount:                server.Status.RestartCount,
+		DeploymentFailureReason:     server.Status.DeploymentFailureReason,
+		CredentialStatus:            server.Status.CredentialStatus,
+		CredentialWarning:           server.Status.CredentialWarning,
+		MemoryUsageBytes:            server.Status.MemoryUsageBytes,
+		MemoryQuotaBytes:            server.Status.MemoryQuotaBytes,
+		WorkspaceProjectID:          strings.Replace(server.Status.WorkspaceProjectName, system.ThreadPrefix, system.ProjectPrefix, 1),
+		WorkspaceUsageBytes:         server.Status.WorkspaceUsageBytes,
+		WorkspaceQuotaBytes:         server.Status.WorkspaceQuotaBytes,
 		Template:                    server.Spec.Template,
 		CompositeName:               server.Spec.CompositeName,
 		NanobotAgentID:              server.Spec.NanobotAgentID,
+		ConnectAlias:                server.Spec.ConnectAlias,
+		ExternalExposure:            server.Spec.ExternalExposure,
+		ExternalURL:                 server.ExternalURL(),
+		PinnedCatalogEntryRevision:  server.Spec.PinnedCatalogEntryRevision,
+		MaintenanceNotice:           notice,
+		InMaintenance:               inMaintenance,
+		Conditions:                  convertConditions(server.Status.Conditions),
 	}
 
 	// For composite servers, also consider component configuration if provided
+	var missingComponentConfiguration bool
 	if server.Spec.Manifest.Runtime == types.RuntimeComposite &&
 		server.Spec.Manifest.CompositeConfig != nil && len(components) > 0 {
 		var (
@@ -2690,10 +3756,28 @@ func ConvertMCPServer(server v1.MCPServer, credEnv map[string]string, serverURL,
 			}
 
 			converted.Configured = false
+			missingComponentConfiguration = true
 			break
 		}
 	}
 
+	converted.ConnectBlockers = connectBlockers(len(missingEnvVars) > 0 || len(missingHeaders) > 0 || missingComponentConfiguration, server.Status.ConnectBlockers)
+	converted.ReadyToConnect = len(converted.ConnectBlockers) == 0
+
+	if !server.Status.LastHealthyTime.IsZero() {
+		converted.LastHealthyTime = types.NewTime(server.Status.LastHealthyTime.Time)
+	}
+	if !server.Status.CredentialExpiry.IsZero() {
+		converted.CredentialExpiry = types.NewTime(server.Status.CredentialExpiry.Time)
+	}
+	if !server.Status.StaleSince.IsZero() {
+		converted.StaleSince = types.NewTime(server.Status.StaleSince.Time)
+		converted.StaleAction = server.Status.StaleAction
+		if !server.Status.StaleActionTime.IsZero() {
+			converted.StaleActionTime = types.NewTime(server.Status.StaleActionTime.Time)
+		}
+	}
+
 	return converted
 }
 
@@ -2779,9 +3863,37 @@ func resolveCompositeComponents(req api.Context, composite v1.MCPServer) ([]type
 	return convertedComponents, nil
 }
 
+// mcpServerListFieldSelector builds a field selector from the optional needsUpdate, deploymentStatus, catalogID,
+// and runtime query parameters so that callers can filter MCP servers using the storage field indexes.
+func mcpServerListFieldSelector(query url.Values) (kclient.MatchingFields, error) {
+	fieldSelector := kclient.MatchingFields{}
+	if v := query.Get("needsUpdate"); v != "" {
+		needsUpdate, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, types.NewErrBadRequest("invalid needsUpdate value %q: %v", v, err)
+		}
+		fieldSelector["status.needsUpdate"] = strconv.FormatBool(needsUpdate)
+	}
+	if v := query.Get("deploymentStatus"); v != "" {
+		fieldSelector["status.deploymentStatus"] = v
+	}
+	if v := query.Get("catalogID"); v != "" {
+		fieldSelector["spec.mcpCatalogID"] = v
+	}
+	if v := query.Get("runtime"); v != "" {
+		fieldSelector["spec.manifest.runtime"] = v
+	}
+	return fieldSelector, nil
+}
+
 func (m *MCPHandler) ListServersFromAllSources(req api.Context) error {
+	fieldSelector, err := mcpServerListFieldSelector(req.URL.Query())
+	if err != nil {
+		return err
+	}
+
 	var list v1.MCPServerList
-	if err := req.List(&list, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+	if err := req.List(&list, kclient.InNamespace(system.DefaultNamespace), fieldSelector); err != nil {
 		return err
 	}
 
@@ -2815,6 +3927,19 @@ func (m *MCPHandler) ListServersFromAllSources(req api.Context) error {
 		}
 	}
 
+	// Search, sort and page the servers before looking up their credentials, so that only the servers on the page are
+	// looked up.
+	page, err := listPageFromRequest(req)
+	if err != nil {
+		return err
+	}
+	allowedServers, err = searchAndSortServers(req, allowedServers)
+	if err != nil {
+		return err
+	}
+	total := len(allowedServers)
+	allowedServers, next := pageItems(page, allowedServers, serverName)
+
 	var credCtxs []string
 	for _, server := range allowedServers {
 		if server.Spec.MCPCatalogID != "" {
@@ -2880,11 +4005,11 @@ func (m *MCPHandler) ListServersFromAllSources(req api.Context) error {
 				return err
 			}
 		}
-		parent := ConvertMCPServer(server, credMap[server.Name], m.serverURL, slug, components...)
+		parent := ConvertMCPServer(server, credMap[server.Name], MCPServerConnectBaseURL(req, server), slug, components...)
 		mcpServers = append(mcpServers, parent)
 	}
 
-	return req.Write(types.MCPServerList{Items: mcpServers})
+	return writeList(req, types.MCPServerList{Items: mcpServers, Total: total, Continue: next}, page)
 }
 
 func (m *MCPHandler) GetServerFromAllSources(req api.Context) error {
@@ -2954,7 +4079,7 @@ func (m *MCPHandler) GetServerFromAllSources(req api.Context) error {
 		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	return req.Write(ConvertMCPServer(server, cred.Env, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug))
 }
 
 func (m *MCPHandler) ClearOAuthCredentials(req api.Context) error {
@@ -3027,9 +4152,84 @@ func (m *MCPHandler) GetServerDetails(req api.Context) error {
 		return err
 	}
 
+	if server.Spec.Manifest.Runtime == types.RuntimeComposite && server.Spec.Manifest.CompositeConfig != nil {
+		details.Components, err = m.compositeComponentServers(req, server)
+		if err != nil {
+			return err
+		}
+	}
+
 	return req.Write(details)
 }
 
+// compositeComponentServers returns the statuses of the components of a composite server. Components that run as their
+// own servers have the statuses of those, and components that refer to multi-user servers have the statuses of the
+// multi-user servers.
+func (m *MCPHandler) compositeComponentServers(req api.Context, compositeServer v1.MCPServer) ([]types.MCPComponentServerDetails, error) {
+	var componentServers v1.MCPServerList
+	if err := req.List(&componentServers,
+		kclient.InNamespace(compositeServer.Namespace),
+		kclient.MatchingFields{"spec.compositeName": compositeServer.Name},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list component servers: %w", err)
+	}
+
+	servers := make(map[string]v1.MCPServer, len(componentServers.Items))
+	for _, server := range componentServers.Items {
+		if id := server.Spec.MCPServerCatalogEntryName; id != "" {
+			servers[id] = server
+		}
+	}
+
+	for _, component := range compositeServer.Spec.Manifest.CompositeConfig.ComponentServers {
+		if component.MCPServerID == "" {
+			continue
+		}
+
+		var server v1.MCPServer
+		if err := req.Get(&server, component.MCPServerID); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get multi-user server %s: %w", component.MCPServerID, err)
+		}
+		servers[component.MCPServerID] = server
+	}
+
+	return compositeComponentDetails(compositeServer.Spec.Manifest.CompositeConfig.ComponentServers, servers), nil
+}
+
+// compositeComponentDetails returns the details of the components from the servers that they run as, keyed by their
+// component IDs.
+func compositeComponentDetails(components []types.ComponentServer, servers map[string]v1.MCPServer) []types.MCPComponentServerDetails {
+	details := make([]types.MCPComponentServerDetails, 0, len(components))
+	for _, component := range components {
+		componentDetails := types.MCPComponentServerDetails{
+			ComponentID: component.ComponentID(),
+			MCPServerID: component.MCPServerID,
+			Name:        component.Manifest.Name,
+			Disabled:    component.Disabled,
+		}
+
+		if server, ok := servers[componentDetails.ComponentID]; ok {
+			componentDetails.MCPServerID = server.Name
+			if componentDetails.Name == "" {
+				componentDetails.Name = server.Spec.Manifest.Name
+			}
+			componentDetails.DeploymentStatus = server.Status.DeploymentStatus
+			componentDetails.IsAvailable = server.Status.DeploymentStatus == "Available"
+			componentDetails.RestartCount = server.Status.RestartCount
+			componentDetails.FailureReason = server.Status.DeploymentFailureReason
+			if !server.Status.LastHealthyTime.IsZero() {
+				componentDetails.LastHealthyTime = types.NewTime(server.Status.LastHealthyTime.Time)
+			}
+		}
+
+		details = append(details, componentDetails)
+	}
+
+	return details
+}
+
 func (m *MCPHandler) RestartServerDeployment(req api.Context) error {
 	server, serverConfig, err := serverForAction(req)
 	if err != nil {
@@ -3312,7 +4512,7 @@ func (m *MCPHandler) RedeployWithK8sSettings(req api.Context) error {
 	}
 
 	// Return updated server
-	return req.Write(ConvertMCPServer(server, cred.Env, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug))
 }
 
 // ListServersNeedingK8sUpdateInCatalog lists all servers in a catalog that need redeployment with new K8s settings
@@ -3471,6 +4671,11 @@ func (m *MCPHandler) StreamServerLogs(req api.Context) error {
 	// Use the user ID from the server rather than from the request.
 	serverConfig.UserID = server.Spec.UserID
 
+	mask, err := mcpLogMask(req, m.mcpSessionManager, serverConfig)
+	if err != nil {
+		return err
+	}
+
 	logs, err := m.mcpSessionManager.StreamServerLogs(req.Context(), serverConfig)
 	if err != nil {
 		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
@@ -3484,6 +4689,7 @@ func (m *MCPHandler) StreamServerLogs(req api.Context) error {
 		SendKeepAlive:  true,
 		SendDisconnect: true,
 		SendEnded:      true,
+		Mask:           mask,
 	})
 }
 
@@ -3563,7 +4769,7 @@ func (m *MCPHandler) UpdateURL(req api.Context) error {
 		return fmt.Errorf("failed to generate slug: %w", err)
 	}
 
-	return req.Write(ConvertMCPServer(mcpServer, nil, m.serverURL, slug))
+	return req.Write(ConvertMCPServer(mcpServer, nil, MCPServerConnectBaseURL(req, mcpServer), slug))
 }
 
 func (m *MCPHandler) TriggerUpdate(req api.Context) error {
@@ -3612,6 +4818,12 @@ func (m *MCPHandler) TriggerUpdate(req api.Context) error {
 		return m.triggerCompositeUpdate(req, server, entry)
 	}
 
+	// Pinned servers are updated to the revision they are pinned to.
+	entry, _, err := catalogEntryForUpdate(req, server, entry)
+	if err != nil {
+		return err
+	}
+
 	// Shutdown the server, even if there is no credential
 	if err := m.removeMCPServer(req.Context(), server); err != nil {
 		return err
@@ -3652,6 +4864,8 @@ func updateServerFromCatalogEntry(server *v1.MCPServer, entry v1.MCPServerCatalo
 	server.Spec.Manifest.UVXConfig = entry.Spec.Manifest.UVXConfig
 	server.Spec.Manifest.NPXConfig = entry.Spec.Manifest.NPXConfig
 	server.Spec.Manifest.ContainerizedConfig = entry.Spec.Manifest.ContainerizedConfig
+	server.Spec.Manifest.StdioConfig = entry.Spec.Manifest.StdioConfig
+	server.Spec.Manifest.SourceConfig = entry.Spec.Manifest.SourceConfig
 
 	// Handle remote runtime URL updates.
 	if entry.Spec.Manifest.Runtime == types.RuntimeRemote && entry.Spec.Manifest.RemoteConfig != nil {
@@ -3844,7 +5058,7 @@ func (m *MCPHandler) ListServerInstances(req api.Context) error {
 			return err
 		}
 
-		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, credEnv, m.serverURL, slug))
+		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, credEnv, ConnectBaseURL(req, instance.Spec.MCPCatalogName), slug))
 	}
 
 	return req.Write(types.MCPServerInstanceList{
@@ -2,8 +2,15 @@ package handlers
 
 import (
 	"fmt"
+	"maps"
+	"net/url"
+	"slices"
 	"testing"
 	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Test functions for applyURLTemplate
@@ -277,3 +284,286 @@ func TestApplyURLTemplateRealWorldExamples(t *testing.T) {
 		})
 	}
 }
+
+func TestMCPServerListFieldSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       url.Values
+		expected    map[string]string
+		expectError bool
+	}{
+		{
+			name:     "no filters",
+			query:    url.Values{},
+			expected: map[string]string{},
+		},
+		{
+			name: "all filters",
+			query: url.Values{
+				"needsUpdate":      []string{"1"},
+				"deploymentStatus": []string{"Needs Attention"},
+				"catalogID":        []string{"default"},
+				"runtime":          []string{"containerized"},
+			},
+			expected: map[string]string{
+				"status.needsUpdate":      "true",
+				"status.deploymentStatus": "Needs Attention",
+				"spec.mcpCatalogID":       "default",
+				"spec.manifest.runtime":   "containerized",
+			},
+		},
+		{
+			name:        "invalid needsUpdate",
+			query:       url.Values{"needsUpdate": []string{"maybe"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mcpServerListFieldSelector(tt.query)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !maps.Equal(map[string]string(result), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCopiedConfiguration(t *testing.T) {
+	manifest := types.MCPServerManifest{
+		Env: []types.MCPEnv{
+			{MCPHeader: types.MCPHeader{Key: "REGION"}},
+			{MCPHeader: types.MCPHeader{Key: "API_KEY", Sensitive: true}},
+			{MCPHeader: types.MCPHeader{Key: "UNSET"}},
+		},
+		RemoteConfig: &types.RemoteRuntimeConfig{
+			Headers: []types.MCPHeader{
+				{Key: "X-Tenant"},
+				{Key: "Authorization", Sensitive: true},
+				{Key: "X-Static", Value: "static"},
+			},
+		},
+	}
+
+	env := map[string]string{
+		"REGION":        "us-east-1",
+		"API_KEY":       "secret",
+		"X-Tenant":      "acme",
+		"Authorization": "Bearer secret",
+		"X-Static":      "static",
+		"UNDECLARED":    "value",
+	}
+
+	result := copiedConfiguration(manifest, env, false)
+	expected := map[string]string{
+		"REGION":   "us-east-1",
+		"X-Tenant": "acme",
+	}
+	if !maps.Equal(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	result = copiedConfiguration(manifest, env, true)
+	expected = map[string]string{
+		"REGION":        "us-east-1",
+		"API_KEY":       "secret",
+		"X-Tenant":      "acme",
+		"Authorization": "Bearer secret",
+	}
+	if !maps.Equal(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestApplyConfiguration(t *testing.T) {
+	manifest := types.MCPServerManifest{
+		Env: []types.MCPEnv{
+			{MCPHeader: types.MCPHeader{Key: "REGION"}},
+			{MCPHeader: types.MCPHeader{Key: "API_KEY", Sensitive: true}},
+		},
+		RemoteConfig: &types.RemoteRuntimeConfig{
+			Headers: []types.MCPHeader{
+				{Key: "X-Tenant"},
+				{Key: "X-Static", Value: "static"},
+			},
+		},
+	}
+
+	env := map[string]string{"REGION": "us-east-1", "API_KEY": "secret"}
+	if err := applyConfiguration(manifest, env, map[string]string{"REGION": "eu-west-1", "API_KEY": "", "X-Tenant": "acme"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"REGION": "eu-west-1", "X-Tenant": "acme"}
+	if !maps.Equal(env, expected) {
+		t.Errorf("expected %v, got %v", expected, env)
+	}
+
+	for _, key := range []string{"UNDECLARED", "X-Static"} {
+		if err := applyConfiguration(manifest, env, map[string]string{key: "value"}); err == nil {
+			t.Errorf("expected an error for %s", key)
+		}
+	}
+}
+
+func TestConvertMCPServerConnectBlockers(t *testing.T) {
+	server := v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms1test"},
+		Spec: v1.MCPServerSpec{
+			Manifest: types.MCPServerManifest{
+				Runtime: types.RuntimeUVX,
+				Env: []types.MCPEnv{
+					{MCPHeader: types.MCPHeader{Key: "API_KEY", Required: true}},
+				},
+			},
+		},
+		Status: v1.MCPServerStatus{
+			ConnectBlockers: []types.MCPServerConnectBlocker{types.MCPServerConnectBlockerDeploymentFailed},
+		},
+	}
+
+	converted := ConvertMCPServer(server, nil, "https://obot.example.com", "test")
+	if converted.ReadyToConnect {
+		t.Error("expected server not to be ready to connect")
+	}
+	if want := []types.MCPServerConnectBlocker{
+		types.MCPServerConnectBlockerMissingConfiguration,
+		types.MCPServerConnectBlockerDeploymentFailed,
+	}; !slices.Equal(converted.ConnectBlockers, want) {
+		t.Errorf("expected connect blockers %v, got %v", want, converted.ConnectBlockers)
+	}
+
+	server.Status.ConnectBlockers = nil
+	converted = ConvertMCPServer(server, map[string]string{"API_KEY": "secret"}, "https://obot.example.com", "test")
+	if !converted.ReadyToConnect || len(converted.ConnectBlockers) != 0 {
+		t.Errorf("expected server to be ready to connect, got blockers %v", converted.ConnectBlockers)
+	}
+}
+
+func TestCompositeComponentDetails(t *testing.T) {
+	healthy := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	components := []types.ComponentServer{
+		{CatalogEntryID: "github", Manifest: types.MCPServerManifest{Name: "GitHub"}},
+		{CatalogEntryID: "linear", Disabled: true},
+		{MCPServerID: "ms1shared"},
+		{CatalogEntryID: "notdeployed", Manifest: types.MCPServerManifest{Name: "Not Deployed"}},
+	}
+	servers := map[string]v1.MCPServer{
+		"github": {
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1github"},
+			Status: v1.MCPServerStatus{
+				DeploymentStatus: "Available",
+				LastHealthyTime:  metav1.NewTime(healthy),
+			},
+		},
+		"linear": {
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1linear"},
+			Spec:       v1.MCPServerSpec{Manifest: types.MCPServerManifest{Name: "Linear"}},
+			Status: v1.MCPServerStatus{
+				DeploymentStatus:        "Unavailable",
+				DeploymentFailureReason: "CrashLoopBackOff",
+				RestartCount:            5,
+			},
+		},
+		"ms1shared": {
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1shared"},
+			Spec:       v1.MCPServerSpec{Manifest: types.MCPServerManifest{Name: "Shared"}},
+			Status:     v1.MCPServerStatus{DeploymentStatus: "Available"},
+		},
+	}
+
+	details := compositeComponentDetails(components, servers)
+	if len(details) != len(components) {
+		t.Fatalf("expected %d components, got %+v", len(components), details)
+	}
+
+	if github := details[0]; github.MCPServerID != "ms1github" || !github.IsAvailable || github.LastHealthyTime == nil || !github.LastHealthyTime.GetTime().Equal(healthy) {
+		t.Errorf("unexpected details for GitHub: %+v", github)
+	}
+	if linear := details[1]; linear.Name != "Linear" || !linear.Disabled || linear.IsAvailable || linear.FailureReason != "CrashLoopBackOff" || linear.RestartCount != 5 {
+		t.Errorf("unexpected details for Linear: %+v", linear)
+	}
+	if shared := details[2]; shared.ComponentID != "ms1shared" || shared.Name != "Shared" || !shared.IsAvailable {
+		t.Errorf("unexpected details for the multi-user server: %+v", shared)
+	}
+	if notDeployed := details[3]; notDeployed.MCPServerID != "" || notDeployed.DeploymentStatus != "" || notDeployed.IsAvailable {
+		t.Errorf("unexpected details for the component that is not deployed: %+v", notDeployed)
+	}
+}
+
+func TestPatchCompositeComponents(t *testing.T) {
+	components := []types.ComponentServer{
+		{CatalogEntryID: "github", Manifest: types.MCPServerManifest{Name: "GitHub"}},
+		{CatalogEntryID: "linear", Manifest: types.MCPServerManifest{Name: "Linear"}},
+		{MCPServerID: "ms1shared"},
+	}
+
+	got, err := patchCompositeComponents(components, types.CompositeComponentsPatch{
+		Add: []types.ComponentServer{
+			{CatalogEntryID: "github", Manifest: types.MCPServerManifest{Name: "GitHub"}, ToolPrefix: "gh_"},
+			{CatalogEntryID: "notion", Manifest: types.MCPServerManifest{Name: "Notion"}},
+		},
+		Remove: []string{"linear"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, component := range got {
+		ids = append(ids, component.ComponentID())
+	}
+	if want := []string{"github", "ms1shared", "notion"}; !slices.Equal(ids, want) {
+		t.Errorf("expected components %v, got %v", want, ids)
+	}
+	if got[0].ToolPrefix != "gh_" {
+		t.Errorf("expected the GitHub component to be replaced, got %+v", got[0])
+	}
+	if components[0].ToolPrefix != "" || len(components) != 3 {
+		t.Error("expected the original components not to change")
+	}
+
+	for name, patch := range map[string]types.CompositeComponentsPatch{
+		"unknown component":         {Remove: []string{"slack"}},
+		"added and removed":         {Add: []types.ComponentServer{{CatalogEntryID: "linear"}}, Remove: []string{"linear"}},
+		"missing component ID":      {Add: []types.ComponentServer{{Manifest: types.MCPServerManifest{Name: "Slack"}}}},
+		"both IDs":                  {Add: []types.ComponentServer{{CatalogEntryID: "slack", MCPServerID: "ms1slack"}}},
+		"nested composite cycle":    {Add: []types.ComponentServer{nestedComposite("nested", types.ComponentServer{MCPServerID: "ms1composite"})}},
+		"nested composite too deep": {Add: []types.ComponentServer{nestedComposite("nested", nestedComposite("deeper", types.ComponentServer{CatalogEntryID: "github"}))}},
+	} {
+		if _, err := patchCompositeComponents(components, patch, "ms1composite"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	// One level of nested composite servers is allowed.
+	got, err = patchCompositeComponents(components, types.CompositeComponentsPatch{
+		Add: []types.ComponentServer{nestedComposite("nested", types.ComponentServer{CatalogEntryID: "github"})},
+	}, "ms1composite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 {
+		t.Errorf("expected the nested composite server to be added, got %d components", len(got))
+	}
+}
+
+func nestedComposite(id string, components ...types.ComponentServer) types.ComponentServer {
+	return types.ComponentServer{
+		CatalogEntryID: id,
+		Manifest: types.MCPServerManifest{
+			Runtime:         types.RuntimeComposite,
+			CompositeConfig: &types.CompositeRuntimeConfig{ComponentServers: components},
+		},
+	}
+}
@@ -0,0 +1,572 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/validation"
+	"github.com/pmezard/go-difflib/difflib"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// maxMCPCatalogBundleSize is the largest bundle that can be imported.
+const maxMCPCatalogBundleSize = 10 << 20
+
+// MCPCatalogBundleHandler exports the configuration of a catalog as a bundle, and imports bundles into catalogs. Imports
+// call the handlers of the catalog, server, and access control rule APIs to create and update objects, so that each
+// of them is validated exactly as it would be on its own.
+type MCPCatalogBundleHandler struct {
+	catalogs *MCPCatalogHandler
+	servers  *MCPHandler
+	rules    *AccessControlRuleHandler
+}
+
+func NewMCPCatalogBundleHandler(catalogs *MCPCatalogHandler, servers *MCPHandler, rules *AccessControlRuleHandler) *MCPCatalogBundleHandler {
+	return &MCPCatalogBundleHandler{
+		catalogs: catalogs,
+		servers:  servers,
+		rules:    rules,
+	}
+}
+
+// mcpCatalogContents is the part of a catalog that is exported in bundles.
+type mcpCatalogContents struct {
+	catalog v1.MCPCatalog
+	// entries are the entries that were created in Obot, rather than synced from the catalog's source URLs.
+	entries []v1.MCPServerCatalogEntry
+	// servers are the multi-user servers, without templates and the components of composite servers.
+	servers []v1.MCPServer
+	// rules are the access control rules that weren't generated by Obot.
+	rules []v1.AccessControlRule
+}
+
+func getMCPCatalogContents(req api.Context, catalogID string) (mcpCatalogContents, error) {
+	var contents mcpCatalogContents
+	if err := req.Get(&contents.catalog, catalogID); err != nil {
+		return contents, err
+	}
+
+	var entries v1.MCPServerCatalogEntryList
+	if err := req.List(&entries, kclient.MatchingFields{
+		"spec.mcpCatalogName": catalogID,
+	}); err != nil {
+		return contents, fmt.Errorf("failed to list catalog entries: %w", err)
+	}
+	for _, entry := range entries.Items {
+		if entry.Spec.Editable {
+			contents.entries = append(contents.entries, entry)
+		}
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers, kclient.MatchingFields{
+		"spec.mcpCatalogID": catalogID,
+	}); err != nil {
+		return contents, fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+	for _, server := range servers.Items {
+		if !server.Spec.Template && server.Spec.CompositeName == "" {
+			contents.servers = append(contents.servers, server)
+		}
+	}
+
+	var rules v1.AccessControlRuleList
+	if err := req.List(&rules); err != nil {
+		return contents, fmt.Errorf("failed to list access control rules: %w", err)
+	}
+	for _, rule := range rules.Items {
+		if rule.Spec.MCPCatalogID == catalogID && !rule.Spec.Generated {
+			contents.rules = append(contents.rules, rule)
+		}
+	}
+
+	return contents, nil
+}
+
+// Export handles GET /api/mcp-catalogs/{catalog_id}/export. It returns the catalog's configuration as a YAML bundle,
+// or as JSON if the format query parameter is json.
+func (*MCPCatalogBundleHandler) Export(req api.Context) error {
+	format := req.URL.Query().Get("format")
+	if format != "" && format != "yaml" && format != "json" {
+		return types.NewErrBadRequest("invalid format %q: must be yaml or json", format)
+	}
+
+	contents, err := getMCPCatalogContents(req, req.PathValue("catalog_id"))
+	if err != nil {
+		return err
+	}
+
+	bundle, err := exportMCPCatalogBundle(contents)
+	if err != nil {
+		return types.NewErrHTTP(http.StatusConflict, fmt.Sprintf("failed to export catalog: %v", err))
+	}
+
+	if format == "json" {
+		return req.Write(bundle)
+	}
+
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bundle: %w", err)
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "application/yaml")
+	_, err = req.ResponseWriter.Write(data)
+	return err
+}
+
+// exportMCPCatalogBundle returns the bundle of a catalog. Entries, servers, and rules are identified by name in
+// bundles, so it fails if two of them have the same name.
+func exportMCPCatalogBundle(contents mcpCatalogContents) (types.MCPCatalogBundle, error) {
+	bundle := types.MCPCatalogBundle{
+		Version: types.MCPCatalogBundleVersion,
+		Catalog: types.MCPCatalogBundleCatalog{
+			SourceURLs:           contents.catalog.Spec.SourceURLs,
+			DefaultToolSelection: contents.catalog.Spec.DefaultToolSelection,
+		},
+	}
+
+	entryNames := make(map[string]string, len(contents.entries))
+	for _, entry := range contents.entries {
+		bundle.Entries = append(bundle.Entries, types.MCPCatalogBundleEntry{
+			Manifest: entry.Spec.Manifest,
+		})
+		entryNames[entry.Name] = entry.Spec.Manifest.Name
+	}
+
+	serverNames := make(map[string]string, len(contents.servers))
+	for _, server := range contents.servers {
+		bundle.Servers = append(bundle.Servers, bundleServer(server, entryNames))
+		serverNames[server.Name] = server.Spec.Manifest.Name
+	}
+
+	for _, rule := range contents.rules {
+		bundle.AccessControlRules = append(bundle.AccessControlRules, bundleRule(rule, contents.catalog.Name, entryNames, serverNames))
+	}
+
+	slices.SortFunc(bundle.Entries, func(a, b types.MCPCatalogBundleEntry) int {
+		return strings.Compare(a.Manifest.Name, b.Manifest.Name)
+	})
+	slices.SortFunc(bundle.Servers, func(a, b types.MCPCatalogBundleServer) int {
+		return strings.Compare(a.Manifest.Name, b.Manifest.Name)
+	})
+	slices.SortFunc(bundle.AccessControlRules, func(a, b types.MCPCatalogBundleAccessControlRule) int {
+		return strings.Compare(a.DisplayName, b.DisplayName)
+	})
+
+	return bundle, validateMCPCatalogBundle(bundle)
+}
+
+// bundleServer returns a server as it is in bundles, given the names of the entries of the bundle by ID.
+func bundleServer(server v1.MCPServer, entryNames map[string]string) types.MCPCatalogBundleServer {
+	result := types.MCPCatalogBundleServer{
+		Manifest: server.Spec.Manifest,
+	}
+	if entryID := server.Spec.MCPServerCatalogEntryName; entryID != "" {
+		if name, ok := entryNames[entryID]; ok {
+			result.CatalogEntry = name
+		} else {
+			result.CatalogEntryID = entryID
+		}
+	}
+	return result
+}
+
+// bundleRule returns an access control rule as it is in bundles, given the names of the entries and servers of the
+// bundle by ID.
+func bundleRule(rule v1.AccessControlRule, catalogID string, entryNames, serverNames map[string]string) types.MCPCatalogBundleAccessControlRule {
+	result := types.MCPCatalogBundleAccessControlRule{
+		DisplayName: rule.Spec.Manifest.DisplayName,
+		Subjects:    rule.Spec.Manifest.Subjects,
+	}
+	for _, resource := range rule.Spec.Manifest.Resources {
+		bundleResource := types.MCPCatalogBundleResource{
+			Type: resource.Type,
+			ID:   resource.ID,
+		}
+		switch resource.Type {
+		case types.ResourceTypeMCPServerCatalogEntry:
+			if name, ok := entryNames[resource.ID]; ok {
+				bundleResource.Name, bundleResource.ID = name, ""
+			}
+		case types.ResourceTypeMCPServer:
+			if name, ok := serverNames[resource.ID]; ok {
+				bundleResource.Name, bundleResource.ID = name, ""
+			}
+		case types.ResourceTypeMcpCatalog:
+			if resource.ID == catalogID {
+				bundleResource.ID = ""
+			}
+		}
+		result.Resources = append(result.Resources, bundleResource)
+	}
+	return result
+}
+
+// validateMCPCatalogBundle checks that the names in a bundle are unique, and that the references to entries and
+// servers by name are to ones in the bundle.
+func validateMCPCatalogBundle(bundle types.MCPCatalogBundle) error {
+	entries := make(map[string]bool, len(bundle.Entries))
+	for _, entry := range bundle.Entries {
+		if entry.Manifest.Name == "" {
+			return fmt.Errorf("entries must have names")
+		}
+		if entries[entry.Manifest.Name] {
+			return fmt.Errorf("there is more than one entry named %q", entry.Manifest.Name)
+		}
+		entries[entry.Manifest.Name] = true
+	}
+
+	servers := make(map[string]bool, len(bundle.Servers))
+	for _, server := range bundle.Servers {
+		if server.Manifest.Name == "" {
+			return fmt.Errorf("servers must have names")
+		}
+		if servers[server.Manifest.Name] {
+			return fmt.Errorf("there is more than one server named %q", server.Manifest.Name)
+		}
+		if server.CatalogEntry != "" && !entries[server.CatalogEntry] {
+			return fmt.Errorf("server %q refers to entry %q, which is not in the bundle", server.Manifest.Name, server.CatalogEntry)
+		}
+		servers[server.Manifest.Name] = true
+	}
+
+	rules := make(map[string]bool, len(bundle.AccessControlRules))
+	for _, rule := range bundle.AccessControlRules {
+		if rule.DisplayName == "" {
+			return fmt.Errorf("access control rules must have display names")
+		}
+		if rules[rule.DisplayName] {
+			return fmt.Errorf("there is more than one access control rule named %q", rule.DisplayName)
+		}
+		for _, resource := range rule.Resources {
+			if resource.Name == "" {
+				continue
+			}
+			if resource.Type == types.ResourceTypeMCPServerCatalogEntry && !entries[resource.Name] ||
+				resource.Type == types.ResourceTypeMCPServer && !servers[resource.Name] {
+				return fmt.Errorf("access control rule %q refers to %s %q, which is not in the bundle", rule.DisplayName, resource.Type, resource.Name)
+			}
+		}
+		rules[rule.DisplayName] = true
+	}
+
+	return nil
+}
+
+// Import handles POST /api/mcp-catalogs/{catalog_id}/import. It imports a YAML or JSON bundle into the catalog, and
+// returns the changes that it made. If the dryRun query parameter is true, it returns the changes that importing the
+// bundle would make, with a diff for each update, without making them. Changes that fail don't stop the others, but
+// the servers and rules that refer to an entry or server that failed to be created fail too.
+func (h *MCPCatalogBundleHandler) Import(req api.Context) error {
+	data, err := io.ReadAll(io.LimitReader(req.Request.Body, maxMCPCatalogBundleSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read bundle: %w", err)
+	}
+	if len(data) > maxMCPCatalogBundleSize {
+		return types.NewErrBadRequest("bundle is larger than %d bytes", maxMCPCatalogBundleSize)
+	}
+
+	var bundle types.MCPCatalogBundle
+	if err := yaml.Unmarshal(data, &bundle); err != nil {
+		return types.NewErrBadRequest("failed to read bundle: %v", err)
+	}
+	if bundle.Version != types.MCPCatalogBundleVersion {
+		return types.NewErrBadRequest("unsupported bundle version %d: must be %d", bundle.Version, types.MCPCatalogBundleVersion)
+	}
+	if err := validateMCPCatalogBundle(bundle); err != nil {
+		return types.NewErrBadRequest("invalid bundle: %v", err)
+	}
+
+	contents, err := getMCPCatalogContents(req, req.PathValue("catalog_id"))
+	if err != nil {
+		return err
+	}
+
+	dryRun := req.URL.Query().Get("dryRun") == "true"
+	result := h.importMCPCatalogBundle(req, contents, bundle, dryRun)
+
+	if !dryRun {
+		log.Infof("Imported MCP catalog bundle: catalog=%s changes=%d failed=%d userID=%s",
+			contents.catalog.Name, len(result.Changes), result.Failed, req.User.GetUID())
+	}
+	return req.Write(result)
+}
+
+func (h *MCPCatalogBundleHandler) importMCPCatalogBundle(req api.Context, contents mcpCatalogContents, bundle types.MCPCatalogBundle, dryRun bool) types.MCPCatalogBundleImportResult {
+	var (
+		catalogID = contents.catalog.Name
+		result    = types.MCPCatalogBundleImportResult{DryRun: dryRun}
+		// entryIDs and serverIDs are the IDs in the catalog of the entries and servers of the bundle, by name.
+		entryIDs  = make(map[string]string, len(bundle.Entries))
+		serverIDs = make(map[string]string, len(bundle.Servers))
+		// entryNames and serverNames are the names of the entries and servers of the catalog, by ID.
+		entryNames  = make(map[string]string, len(contents.entries))
+		serverNames = make(map[string]string, len(contents.servers))
+	)
+
+	add := func(change types.MCPCatalogBundleChange, pathValues map[string]string, body any, handler api.HandlerFunc) string {
+		if !dryRun && change.Error == "" && change.Action != types.MCPCatalogBundleChangeActionUnchanged {
+			applyMCPCatalogBundleChange(req, &change, pathValues, body, handler)
+		}
+		if change.Error != "" {
+			result.Failed++
+		}
+		result.Changes = append(result.Changes, change)
+		if change.Error != "" {
+			return ""
+		}
+		return change.ID
+	}
+
+	// Catalog settings
+	existingCatalog := types.MCPCatalogBundleCatalog{
+		SourceURLs:           contents.catalog.Spec.SourceURLs,
+		DefaultToolSelection: contents.catalog.Spec.DefaultToolSelection,
+	}
+	add(planMCPCatalogBundleChange(types.MCPCatalogBundleChangeKindCatalog, catalogID, catalogID, existingCatalog, bundle.Catalog),
+		map[string]string{"catalog_id": catalogID},
+		types.MCPCatalogManifest{
+			DisplayName:          contents.catalog.Spec.DisplayName,
+			SourceURLs:           bundle.Catalog.SourceURLs,
+			ExternalURL:          contents.catalog.Spec.ExternalURL,
+			DefaultToolSelection: bundle.Catalog.DefaultToolSelection,
+		},
+		h.catalogs.Update)
+
+	// Entries
+	existingEntries := make(map[string][]v1.MCPServerCatalogEntry, len(contents.entries))
+	for _, entry := range contents.entries {
+		existingEntries[entry.Spec.Manifest.Name] = append(existingEntries[entry.Spec.Manifest.Name], entry)
+		entryNames[entry.Name] = entry.Spec.Manifest.Name
+	}
+	for _, entry := range bundle.Entries {
+		name := entry.Manifest.Name
+		change := types.MCPCatalogBundleChange{
+			Kind:   types.MCPCatalogBundleChangeKindEntry,
+			Name:   name,
+			Action: types.MCPCatalogBundleChangeActionCreate,
+		}
+		handler := h.catalogs.CreateEntry
+		pathValues := map[string]string{"catalog_id": catalogID}
+
+		switch matches := existingEntries[name]; len(matches) {
+		case 0:
+		case 1:
+			change = planMCPCatalogBundleChange(change.Kind, name, matches[0].Name, types.MCPCatalogBundleEntry{Manifest: matches[0].Spec.Manifest}, entry)
+			handler = h.catalogs.UpdateEntry
+			pathValues["entry_id"] = matches[0].Name
+		default:
+			change.Error = fmt.Sprintf("there is more than one entry named %q in the catalog", name)
+		}
+		if change.Error == "" && entry.Manifest.Runtime != types.RuntimeComposite {
+			// Composite entries are validated once their components are filled in.
+			if err := validation.ValidateCatalogEntryManifest(entry.Manifest); err != nil {
+				change.Error = fmt.Sprintf("invalid manifest: %v", err)
+			}
+		}
+
+		entryIDs[name] = add(change, pathValues, entry.Manifest, handler)
+	}
+
+	// Servers
+	existingServers := make(map[string][]v1.MCPServer, len(contents.servers))
+	for _, server := range contents.servers {
+		existingServers[server.Spec.Manifest.Name] = append(existingServers[server.Spec.Manifest.Name], server)
+		serverNames[server.Name] = server.Spec.Manifest.Name
+	}
+	for _, server := range bundle.Servers {
+		name := server.Manifest.Name
+		change := types.MCPCatalogBundleChange{
+			Kind:   types.MCPCatalogBundleChangeKindServer,
+			Name:   name,
+			Action: types.MCPCatalogBundleChangeActionCreate,
+		}
+		var (
+			handler    = h.servers.CreateServer
+			pathValues = map[string]string{"catalog_id": catalogID}
+			body       any
+		)
+
+		switch matches := existingServers[name]; len(matches) {
+		case 0:
+			entryID := server.CatalogEntryID
+			if server.CatalogEntry != "" {
+				entryID = entryIDs[server.CatalogEntry]
+				if entryID == "" && !dryRun {
+					change.Error = fmt.Sprintf("entry %q was not imported", server.CatalogEntry)
+				}
+			}
+			body = types.MCPServer{
+				MCPServerManifest: server.Manifest,
+				CatalogEntryID:    entryID,
+			}
+		case 1:
+			existing := bundleServer(matches[0], entryNames)
+			change = planMCPCatalogBundleChange(change.Kind, name, matches[0].Name, existing, server)
+			if existing.CatalogEntry != server.CatalogEntry || existing.CatalogEntryID != server.CatalogEntryID {
+				change.Error = "the entry that an existing server was created from can't be changed"
+			}
+			handler = h.servers.UpdateServer
+			pathValues["mcp_server_id"] = matches[0].Name
+			body = server.Manifest
+		default:
+			change.Error = fmt.Sprintf("there is more than one server named %q in the catalog", name)
+		}
+		if change.Error == "" {
+			if err := validation.ValidateServerManifest(server.Manifest, true); err != nil {
+				change.Error = fmt.Sprintf("invalid manifest: %v", err)
+			}
+		}
+
+		serverIDs[name] = add(change, pathValues, body, handler)
+	}
+
+	// Access control rules
+	existingRules := make(map[string][]v1.AccessControlRule, len(contents.rules))
+	for _, rule := range contents.rules {
+		existingRules[rule.Spec.Manifest.DisplayName] = append(existingRules[rule.Spec.Manifest.DisplayName], rule)
+	}
+	for _, rule := range bundle.AccessControlRules {
+		name := rule.DisplayName
+		change := types.MCPCatalogBundleChange{
+			Kind:   types.MCPCatalogBundleChangeKindAccessControlRule,
+			Name:   name,
+			Action: types.MCPCatalogBundleChangeActionCreate,
+		}
+		handler := h.rules.Create
+		pathValues := map[string]string{"catalog_id": catalogID}
+
+		switch matches := existingRules[name]; len(matches) {
+		case 0:
+		case 1:
+			change = planMCPCatalogBundleChange(change.Kind, name, matches[0].Name, bundleRule(matches[0], catalogID, entryNames, serverNames), rule)
+			handler = h.rules.Update
+			pathValues["access_control_rule_id"] = matches[0].Name
+		default:
+			change.Error = fmt.Sprintf("there is more than one access control rule named %q in the catalog", name)
+		}
+
+		manifest, err := ruleManifest(rule, catalogID, entryIDs, serverIDs)
+		if err != nil && change.Error == "" && !dryRun {
+			change.Error = err.Error()
+		}
+
+		add(change, pathValues, manifest, handler)
+	}
+
+	return result
+}
+
+// ruleManifest returns the manifest of an access control rule of a bundle, with its resources referring to the IDs of
+// the entries and servers in the catalog.
+func ruleManifest(rule types.MCPCatalogBundleAccessControlRule, catalogID string, entryIDs, serverIDs map[string]string) (types.AccessControlRuleManifest, error) {
+	manifest := types.AccessControlRuleManifest{
+		DisplayName: rule.DisplayName,
+		Subjects:    rule.Subjects,
+	}
+	for _, resource := range rule.Resources {
+		id := resource.ID
+		switch {
+		case resource.Type == types.ResourceTypeMcpCatalog && id == "":
+			id = catalogID
+		case resource.Name != "" && resource.Type == types.ResourceTypeMCPServerCatalogEntry:
+			if id = entryIDs[resource.Name]; id == "" {
+				return manifest, fmt.Errorf("entry %q was not imported", resource.Name)
+			}
+		case resource.Name != "" && resource.Type == types.ResourceTypeMCPServer:
+			if id = serverIDs[resource.Name]; id == "" {
+				return manifest, fmt.Errorf("server %q was not imported", resource.Name)
+			}
+		}
+		manifest.Resources = append(manifest.Resources, types.Resource{
+			Type: resource.Type,
+			ID:   id,
+		})
+	}
+	return manifest, nil
+}
+
+// planMCPCatalogBundleChange returns the change that updates an existing object to its configuration in a bundle, with
+// a diff of their configurations, or an unchanged change if they are the same.
+func planMCPCatalogBundleChange(kind types.MCPCatalogBundleChangeKind, name, id string, existing, desired any) types.MCPCatalogBundleChange {
+	change := types.MCPCatalogBundleChange{
+		Kind:   kind,
+		Name:   name,
+		ID:     id,
+		Action: types.MCPCatalogBundleChangeActionUnchanged,
+	}
+
+	diff, err := bundleDiff(existing, desired)
+	if err != nil {
+		change.Action = types.MCPCatalogBundleChangeActionUpdate
+		change.Error = err.Error()
+	} else if diff != "" {
+		change.Action = types.MCPCatalogBundleChangeActionUpdate
+		change.Diff = diff
+	}
+	return change
+}
+
+// bundleDiff returns a unified diff from the YAML of an object in a catalog to the YAML of the object in a bundle, or
+// an empty string if they are the same.
+func bundleDiff(existing, desired any) (string, error) {
+	from, err := yaml.Marshal(existing)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal existing configuration: %w", err)
+	}
+	to, err := yaml.Marshal(desired)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal bundle configuration: %w", err)
+	}
+	if bytes.Equal(from, to) {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: "catalog",
+		ToFile:   "bundle",
+		Context:  3,
+	})
+}
+
+// applyMCPCatalogBundleChange makes a change by calling the handler of the API that makes it, and records the ID of
+// the object that it created, or why it failed.
+func applyMCPCatalogBundleChange(req api.Context, change *types.MCPCatalogBundleChange, pathValues map[string]string, body any, handler api.HandlerFunc) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		change.Error = fmt.Sprintf("failed to marshal request: %v", err)
+		return
+	}
+
+	code, response := callHandler(req, pathValues, data, handler)
+	if code >= http.StatusBadRequest {
+		change.Error = strings.TrimSpace(string(response))
+		if change.Error == "" {
+			change.Error = http.StatusText(code)
+		}
+		return
+	}
+
+	if change.ID == "" {
+		var created struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(response, &created); err != nil || created.ID == "" {
+			change.Error = "failed to read the ID of the created object"
+			return
+		}
+		change.ID = created.ID
+	}
+}
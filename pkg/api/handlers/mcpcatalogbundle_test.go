@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func testMCPCatalogContents() mcpCatalogContents {
+	return mcpCatalogContents{
+		catalog: v1.MCPCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: v1.MCPCatalogSpec{
+				SourceURLs:  []string{"https://github.com/example/catalog"},
+				ExternalURL: "https://staging.example.com",
+			},
+		},
+		entries: []v1.MCPServerCatalogEntry{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "default-github-abc12"},
+				Spec: v1.MCPServerCatalogEntrySpec{
+					Editable: true,
+					Manifest: types.MCPServerCatalogEntryManifest{
+						Name:    "GitHub",
+						Runtime: types.RuntimeRemote,
+						RemoteConfig: &types.RemoteCatalogConfig{
+							FixedURL: "https://api.githubcopilot.com/mcp/",
+						},
+					},
+				},
+			},
+		},
+		servers: []v1.MCPServer{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ms1github"},
+				Spec: v1.MCPServerSpec{
+					MCPCatalogID:              "default",
+					MCPServerCatalogEntryName: "default-github-abc12",
+					Manifest: types.MCPServerManifest{
+						Name:    "Shared GitHub",
+						Runtime: types.RuntimeRemote,
+						RemoteConfig: &types.RemoteRuntimeConfig{
+							URL: "https://api.githubcopilot.com/mcp/",
+						},
+					},
+				},
+			},
+		},
+		rules: []v1.AccessControlRule{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "acr1everyone"},
+				Spec: v1.AccessControlRuleSpec{
+					MCPCatalogID: "default",
+					Manifest: types.AccessControlRuleManifest{
+						DisplayName: "Everyone",
+						Subjects:    []types.Subject{{Type: types.SubjectTypeSelector, ID: "*"}},
+						Resources: []types.Resource{
+							{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "default-github-abc12"},
+							{Type: types.ResourceTypeMCPServer, ID: "ms1github"},
+							{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "default-synced-entry"},
+							{Type: types.ResourceTypeMcpCatalog, ID: "default"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExportMCPCatalogBundle(t *testing.T) {
+	bundle, err := exportMCPCatalogBundle(testMCPCatalogContents())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bundle.Version != types.MCPCatalogBundleVersion {
+		t.Errorf("expected version %d, got %d", types.MCPCatalogBundleVersion, bundle.Version)
+	}
+	if len(bundle.Entries) != 1 || bundle.Entries[0].Manifest.Name != "GitHub" {
+		t.Fatalf("unexpected entries: %+v", bundle.Entries)
+	}
+	if len(bundle.Servers) != 1 || bundle.Servers[0].CatalogEntry != "GitHub" || bundle.Servers[0].CatalogEntryID != "" {
+		t.Fatalf("unexpected servers: %+v", bundle.Servers)
+	}
+	if len(bundle.AccessControlRules) != 1 {
+		t.Fatalf("unexpected access control rules: %+v", bundle.AccessControlRules)
+	}
+
+	want := []types.MCPCatalogBundleResource{
+		{Type: types.ResourceTypeMCPServerCatalogEntry, Name: "GitHub"},
+		{Type: types.ResourceTypeMCPServer, Name: "Shared GitHub"},
+		{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "default-synced-entry"},
+		{Type: types.ResourceTypeMcpCatalog},
+	}
+	got := bundle.AccessControlRules[0].Resources
+	if len(got) != len(want) {
+		t.Fatalf("expected resources %+v, got %+v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("resource %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestExportMCPCatalogBundleDuplicateNames(t *testing.T) {
+	contents := testMCPCatalogContents()
+	duplicate := contents.entries[0]
+	duplicate.Name = "default-github-def34"
+	contents.entries = append(contents.entries, duplicate)
+
+	if _, err := exportMCPCatalogBundle(contents); err == nil || !strings.Contains(err.Error(), `more than one entry named "GitHub"`) {
+		t.Errorf("expected duplicate entry error, got %v", err)
+	}
+}
+
+func TestValidateMCPCatalogBundle(t *testing.T) {
+	tests := []struct {
+		name    string
+		bundle  types.MCPCatalogBundle
+		wantErr string
+	}{
+		{
+			name: "valid",
+			bundle: types.MCPCatalogBundle{
+				Entries: []types.MCPCatalogBundleEntry{{Manifest: types.MCPServerCatalogEntryManifest{Name: "GitHub"}}},
+				Servers: []types.MCPCatalogBundleServer{{CatalogEntry: "GitHub", Manifest: types.MCPServerManifest{Name: "Shared GitHub"}}},
+			},
+		},
+		{
+			name: "server refers to missing entry",
+			bundle: types.MCPCatalogBundle{
+				Servers: []types.MCPCatalogBundleServer{{CatalogEntry: "GitHub", Manifest: types.MCPServerManifest{Name: "Shared GitHub"}}},
+			},
+			wantErr: `refers to entry "GitHub"`,
+		},
+		{
+			name: "rule refers to missing server",
+			bundle: types.MCPCatalogBundle{
+				AccessControlRules: []types.MCPCatalogBundleAccessControlRule{{
+					DisplayName: "Everyone",
+					Resources:   []types.MCPCatalogBundleResource{{Type: types.ResourceTypeMCPServer, Name: "Shared GitHub"}},
+				}},
+			},
+			wantErr: `refers to mcpServer "Shared GitHub"`,
+		},
+		{
+			name: "rule without a display name",
+			bundle: types.MCPCatalogBundle{
+				AccessControlRules: []types.MCPCatalogBundleAccessControlRule{{}},
+			},
+			wantErr: "must have display names",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMCPCatalogBundle(tt.bundle)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			} else if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestImportMCPCatalogBundleDryRun(t *testing.T) {
+	contents := testMCPCatalogContents()
+	bundle, err := exportMCPCatalogBundle(contents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip the bundle through YAML, as it would be when it is exported and imported.
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle = types.MCPCatalogBundle{}
+	if err := yaml.Unmarshal(data, &bundle); err != nil {
+		t.Fatal(err)
+	}
+
+	h := &MCPCatalogBundleHandler{}
+
+	result := h.importMCPCatalogBundle(api.Context{}, contents, bundle, true)
+	for _, change := range result.Changes {
+		if change.Action != types.MCPCatalogBundleChangeActionUnchanged || change.Error != "" {
+			t.Errorf("expected %s %q to be unchanged, got %+v", change.Kind, change.Name, change)
+		}
+	}
+
+	bundle.Catalog.DefaultToolSelection = types.ToolSelectionPolicy("deny-all")
+	bundle.Entries = append(bundle.Entries, types.MCPCatalogBundleEntry{
+		Manifest: types.MCPServerCatalogEntryManifest{
+			Name:         "Linear",
+			Runtime:      types.RuntimeRemote,
+			RemoteConfig: &types.RemoteCatalogConfig{FixedURL: "https://mcp.linear.app/mcp"},
+		},
+	})
+	bundle.AccessControlRules[0].Resources = append(bundle.AccessControlRules[0].Resources, types.MCPCatalogBundleResource{
+		Type: types.ResourceTypeMCPServerCatalogEntry,
+		Name: "Linear",
+	})
+
+	result = h.importMCPCatalogBundle(api.Context{}, contents, bundle, true)
+	if !result.DryRun || result.Failed != 0 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	actions := make(map[string]types.MCPCatalogBundleChange, len(result.Changes))
+	for _, change := range result.Changes {
+		actions[string(change.Kind)+"/"+change.Name] = change
+	}
+
+	if change := actions["catalog/default"]; change.Action != types.MCPCatalogBundleChangeActionUpdate || !strings.Contains(change.Diff, "+defaultToolSelection: deny-all") {
+		t.Errorf("expected catalog update with diff, got %+v", change)
+	}
+	if change := actions["entry/GitHub"]; change.Action != types.MCPCatalogBundleChangeActionUnchanged || change.ID != "default-github-abc12" {
+		t.Errorf("expected GitHub entry to be unchanged, got %+v", change)
+	}
+	if change := actions["entry/Linear"]; change.Action != types.MCPCatalogBundleChangeActionCreate {
+		t.Errorf("expected Linear entry to be created, got %+v", change)
+	}
+	if change := actions["server/Shared GitHub"]; change.Action != types.MCPCatalogBundleChangeActionUnchanged || change.ID != "ms1github" {
+		t.Errorf("expected server to be unchanged, got %+v", change)
+	}
+	if change := actions["accessControlRule/Everyone"]; change.Action != types.MCPCatalogBundleChangeActionUpdate || !strings.Contains(change.Diff, "+- name: Linear") {
+		t.Errorf("expected access control rule update with diff, got %+v", change)
+	}
+}
+
+func TestRuleManifest(t *testing.T) {
+	rule := types.MCPCatalogBundleAccessControlRule{
+		DisplayName: "Everyone",
+		Resources: []types.MCPCatalogBundleResource{
+			{Type: types.ResourceTypeMCPServerCatalogEntry, Name: "GitHub"},
+			{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "default-synced-entry"},
+			{Type: types.ResourceTypeMcpCatalog},
+		},
+	}
+
+	manifest, err := ruleManifest(rule, "default", map[string]string{"GitHub": "default-github-xyz89"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []types.Resource{
+		{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "default-github-xyz89"},
+		{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "default-synced-entry"},
+		{Type: types.ResourceTypeMcpCatalog, ID: "default"},
+	}
+	for i := range want {
+		if manifest.Resources[i] != want[i] {
+			t.Errorf("resource %d: expected %+v, got %+v", i, want[i], manifest.Resources[i])
+		}
+	}
+
+	if _, err := ruleManifest(rule, "default", nil, nil); err == nil {
+		t.Error("expected an error for an entry that was not imported")
+	}
+}
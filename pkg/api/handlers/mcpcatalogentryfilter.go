@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// catalogEntryFilter is the faceted search of a request that lists catalog entries. Values of the same facet match
+// any of them, and different facets must all match.
+type catalogEntryFilter struct {
+	query          string
+	runtimes       []types.Runtime
+	categories     []string
+	tags           []string
+	requiresConfig *bool
+	multiUser      *bool
+	sort           string
+	facets         bool
+	page           listPage
+}
+
+// catalogEntryFilterFromRequest reads the faceted search of a request from the q, runtime, category, tag,
+// requiresConfig, multiUser, sort and facets query parameters, and the page from the limit, continue, offset and fields
+// query parameters.
+func catalogEntryFilterFromRequest(req api.Context) (catalogEntryFilter, error) {
+	q := req.URL.Query()
+	filter := catalogEntryFilter{
+		query:      strings.TrimSpace(q.Get("q")),
+		categories: q["category"],
+		tags:       q["tag"],
+		sort:       q.Get("sort"),
+		facets:     q.Get("facets") == "true",
+	}
+	for _, runtime := range q["runtime"] {
+		filter.runtimes = append(filter.runtimes, types.Runtime(runtime))
+	}
+
+	for name, value := range map[string]**bool{"requiresConfig": &filter.requiresConfig, "multiUser": &filter.multiUser} {
+		if v := q.Get(name); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return filter, types.NewErrBadRequest("invalid %s: %s", name, v)
+			}
+			*value = &b
+		}
+	}
+
+	switch filter.sort {
+	case "", "relevance", "popular", "recent", "name":
+	default:
+		return filter, types.NewErrBadRequest("invalid sort %q, must be relevance, popular, recent or name", filter.sort)
+	}
+
+	var err error
+	filter.page, err = listPageFromRequest(req)
+	return filter, err
+}
+
+// needsMultiUser returns whether the filter needs to know which entries have multi-user servers.
+func (f catalogEntryFilter) needsMultiUser() bool {
+	return f.multiUser != nil || f.facets
+}
+
+// apply filters, sorts and pages the entries that a user can see, and counts them for the facets if requested.
+// multiUser contains the names of the entries that multi-user servers were created from, and scores the relevance of
+// the entries that match the search query, if there is one.
+func (f catalogEntryFilter) apply(entries []types.MCPServerCatalogEntry, multiUser map[string]struct{}, scores map[string]float64) types.MCPServerCatalogEntryList {
+	var result types.MCPServerCatalogEntryList
+	if f.facets {
+		result.Facets = &types.MCPServerCatalogEntryFacets{
+			Runtimes:   make(map[types.Runtime]int),
+			Categories: make(map[string]int),
+			Tags:       make(map[string]int),
+		}
+	}
+
+	result.Items = make([]types.MCPServerCatalogEntry, 0, len(entries))
+	for _, entry := range entries {
+		if f.query != "" {
+			if _, ok := scores[entry.ID]; !ok {
+				continue
+			}
+		}
+
+		_, isMultiUser := multiUser[entry.ID]
+		requiresConfig := entry.Manifest.RequiresConfiguration()
+		categories := entry.Manifest.Categories()
+
+		if facets := result.Facets; facets != nil {
+			facets.Runtimes[entry.Manifest.Runtime]++
+			for _, category := range categories {
+				facets.Categories[category]++
+			}
+			for _, tag := range entry.Manifest.Tags {
+				facets.Tags[tag]++
+			}
+			if requiresConfig {
+				facets.RequiresConfig++
+			}
+			if isMultiUser {
+				facets.MultiUser++
+			}
+		}
+
+		if len(f.runtimes) > 0 && !slices.Contains(f.runtimes, entry.Manifest.Runtime) ||
+			len(f.categories) > 0 && !containsAnyFold(categories, f.categories) ||
+			len(f.tags) > 0 && !containsAnyFold(entry.Manifest.Tags, f.tags) ||
+			f.requiresConfig != nil && *f.requiresConfig != requiresConfig ||
+			f.multiUser != nil && *f.multiUser != isMultiUser {
+			continue
+		}
+		result.Items = append(result.Items, entry)
+	}
+
+	switch f.sort {
+	case "", "relevance":
+		if f.query != "" {
+			slices.SortStableFunc(result.Items, func(a, b types.MCPServerCatalogEntry) int {
+				return cmp.Compare(scores[b.ID], scores[a.ID])
+			})
+		}
+	case "popular":
+		slices.SortStableFunc(result.Items, func(a, b types.MCPServerCatalogEntry) int {
+			return cmp.Compare(b.UserCount, a.UserCount)
+		})
+	case "recent":
+		slices.SortStableFunc(result.Items, func(a, b types.MCPServerCatalogEntry) int {
+			return b.Created.Time.Compare(a.Created.Time)
+		})
+	case "name":
+		slices.SortStableFunc(result.Items, func(a, b types.MCPServerCatalogEntry) int {
+			return cmp.Compare(strings.ToLower(a.Manifest.Name), strings.ToLower(b.Manifest.Name))
+		})
+	}
+
+	result.Total = len(result.Items)
+	result.Items, result.Continue = pageItems(f.page, result.Items, func(entry types.MCPServerCatalogEntry) string {
+		return entry.ID
+	})
+	return result
+}
+
+// multiUserCatalogEntries returns the names of the catalog entries that multi-user servers were created from.
+func multiUserCatalogEntries(req api.Context) (map[string]struct{}, error) {
+	var servers v1.MCPServerList
+	if err := req.List(&servers); err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]struct{})
+	for _, server := range servers.Items {
+		if server.Spec.MCPServerCatalogEntryName != "" && server.Spec.CompositeName == "" && !server.Spec.Template &&
+			(server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "") {
+			entries[server.Spec.MCPServerCatalogEntryName] = struct{}{}
+		}
+	}
+	return entries, nil
+}
+
+// writeCatalogEntries writes the catalog entries that a user can see, with the faceted search of the request applied.
+func writeCatalogEntries(req api.Context, filter catalogEntryFilter, entries []types.MCPServerCatalogEntry) error {
+	var multiUser map[string]struct{}
+	if filter.needsMultiUser() {
+		var err error
+		if multiUser, err = multiUserCatalogEntries(req); err != nil {
+			return err
+		}
+	}
+
+	scores, err := searchScores(req, gatewaytypes.MCPSearchKindCatalogEntry, filter.query)
+	if err != nil {
+		return err
+	}
+
+	return writeList(req, filter.apply(entries, multiUser, scores), filter.page)
+}
+
+func containsAnyFold(values, wanted []string) bool {
+	for _, v := range values {
+		for _, w := range wanted {
+			if strings.EqualFold(v, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+func TestCatalogEntryFilter(t *testing.T) {
+	now := time.Now()
+	entries := []types.MCPServerCatalogEntry{
+		{
+			Metadata: types.Metadata{ID: "github", Created: *types.NewTime(now.Add(-2 * time.Hour))},
+			Manifest: types.MCPServerCatalogEntryManifest{
+				Name:     "GitHub",
+				Runtime:  types.RuntimeRemote,
+				Metadata: map[string]string{"categories": "Developer Tools, Productivity"},
+				Tags:     []string{"git"},
+			},
+			UserCount: 3,
+		},
+		{
+			Metadata: types.Metadata{ID: "slack", Created: *types.NewTime(now)},
+			Manifest: types.MCPServerCatalogEntryManifest{
+				Name:     "Slack",
+				Runtime:  types.RuntimeNPX,
+				Metadata: map[string]string{"categories": "Productivity"},
+				Env:      []types.MCPEnv{{MCPHeader: types.MCPHeader{Key: "TOKEN", Required: true}}},
+			},
+			UserCount: 10,
+		},
+		{
+			Metadata: types.Metadata{ID: "time", Created: *types.NewTime(now.Add(-time.Hour))},
+			Manifest: types.MCPServerCatalogEntryManifest{
+				Name:    "time",
+				Runtime: types.RuntimeUVX,
+			},
+			UserCount: 1,
+		},
+	}
+	multiUser := map[string]struct{}{"github": {}}
+	// The relevance of the entries that match the search query of the q tests.
+	scores := map[string]float64{"time": 2, "slack": 1}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "", want: []string{"github", "slack", "time"}},
+		{query: "category=productivity", want: []string{"github", "slack"}},
+		{query: "category=productivity&runtime=npx&runtime=uvx", want: []string{"slack"}},
+		{query: "tag=GIT", want: []string{"github"}},
+		{query: "requiresConfig=false&sort=name", want: []string{"github", "time"}},
+		{query: "multiUser=true", want: []string{"github"}},
+		{query: "sort=popular", want: []string{"slack", "github", "time"}},
+		{query: "sort=recent", want: []string{"slack", "time", "github"}},
+		{query: "q=chat", want: []string{"time", "slack"}},
+		{query: "q=chat&sort=name", want: []string{"slack", "time"}},
+		{query: "q=chat&runtime=npx", want: []string{"slack"}},
+		{query: "sort=name&limit=2", want: []string{"github", "slack"}},
+		{query: "sort=name&limit=2&offset=2", want: []string{"time"}},
+		{query: "offset=5", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			filter, err := catalogEntryFilterFromRequest(api.Context{Request: httptest.NewRequest("GET", "/api/all-mcps/entries?"+tt.query, nil)})
+			if err != nil {
+				t.Fatalf("catalogEntryFilterFromRequest() error = %v", err)
+			}
+
+			var ids []string
+			for _, entry := range filter.apply(entries, multiUser, scores).Items {
+				ids = append(ids, entry.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("got %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+	filter, err := catalogEntryFilterFromRequest(api.Context{Request: httptest.NewRequest("GET", "/api/all-mcps/entries?facets=true&runtime=npx", nil)})
+	if err != nil {
+		t.Fatalf("catalogEntryFilterFromRequest() error = %v", err)
+	}
+	result := filter.apply(entries, multiUser, scores)
+	if result.Total != 1 {
+		t.Errorf("got total %d, want 1", result.Total)
+	}
+	facets := result.Facets
+	if facets == nil || facets.Categories["Productivity"] != 2 || facets.Runtimes[types.RuntimeRemote] != 1 || facets.RequiresConfig != 1 || facets.MultiUser != 1 || facets.Tags["git"] != 1 {
+		t.Errorf("unexpected facets: %+v", facets)
+	}
+
+	if _, err = catalogEntryFilterFromRequest(api.Context{Request: httptest.NewRequest("GET", "/api/all-mcps/entries?sort=stars", nil)}); err == nil {
+		t.Error("expected an invalid sort to fail")
+	}
+}
@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListEntryRevisions returns the changelog of a catalog entry: its revisions, oldest first, with the changes each
+// revision made to the manifest.
+func (h *MCPCatalogHandler) ListEntryRevisions(req api.Context) error {
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, req.PathValue("entry_id")); err != nil {
+		return fmt.Errorf("failed to get entry: %w", err)
+	}
+	if entry.Spec.MCPCatalogName != req.PathValue("catalog_id") {
+		return types.NewErrBadRequest("entry does not belong to catalog")
+	}
+
+	return listCatalogEntryRevisions(req, entry.Name)
+}
+
+// ListCatalogEntryRevisions returns the changelog of the catalog entry that an MCP server was created from.
+func (m *MCPHandler) ListCatalogEntryRevisions(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+	if server.Spec.MCPServerCatalogEntryName == "" {
+		return types.NewErrBadRequest("MCP server %s was not created from a catalog entry", server.Name)
+	}
+
+	return listCatalogEntryRevisions(req, server.Spec.MCPServerCatalogEntryName)
+}
+
+func listCatalogEntryRevisions(req api.Context, entryName string) error {
+	var list v1.MCPServerCatalogEntryRevisionList
+	if err := req.List(&list, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entryName),
+	}); err != nil {
+		return fmt.Errorf("failed to list catalog entry revisions: %w", err)
+	}
+
+	slices.SortFunc(list.Items, func(a, b v1.MCPServerCatalogEntryRevision) int {
+		return a.Spec.Revision - b.Spec.Revision
+	})
+
+	items := make([]types.MCPServerCatalogEntryRevision, 0, len(list.Items))
+	for i, revision := range list.Items {
+		converted := types.MCPServerCatalogEntryRevision{
+			Metadata:       MetadataFrom(&revision),
+			CatalogEntryID: revision.Spec.MCPServerCatalogEntryName,
+			Revision:       revision.Spec.Revision,
+			Manifest:       revision.Spec.Manifest,
+		}
+		if i > 0 {
+			changes, err := manifestChanges(list.Items[i-1].Spec.Manifest, revision.Spec.Manifest)
+			if err != nil {
+				return err
+			}
+			converted.Changes = changes
+		}
+		items = append(items, converted)
+	}
+
+	return req.Write(types.MCPServerCatalogEntryRevisionList{Items: items})
+}
+
+// PreviewUpdate returns the changes that TriggerUpdate would make to an MCP server, so that users can review them
+// before they update.
+func (m *MCPHandler) PreviewUpdate(req api.Context) error {
+	server, entry, err := serverAndCatalogEntryForRevisions(req)
+	if err != nil {
+		return err
+	}
+
+	target, revision, err := catalogEntryForUpdate(req, server, entry)
+	if err != nil {
+		return err
+	}
+
+	updated := server.DeepCopy()
+	updateServerFromCatalogEntry(updated, target)
+
+	changes, err := manifestChanges(server.Spec.Manifest, updated.Spec.Manifest)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.MCPServerUpdatePreview{
+		Revision: revision,
+		Changes:  changes,
+	})
+}
+
+// PinRevision pins an MCP server to a revision of its catalog entry, or unpins it when the revision is 0.
+// Pinned servers aren't flagged for updates when the catalog entry changes, and TriggerUpdate updates them to the
+// pinned revision.
+func (m *MCPHandler) PinRevision(req api.Context) error {
+	var pin types.MCPServerPinRequest
+	if err := req.Read(&pin); err != nil {
+		return types.NewErrBadRequest("failed to read pin request: %v", err)
+	}
+	if pin.Revision < 0 {
+		return types.NewErrBadRequest("revision must not be negative")
+	}
+
+	server, entry, err := serverAndCatalogEntryForRevisions(req)
+	if err != nil {
+		return err
+	}
+
+	if !req.UserIsAdmin() && (server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "" || server.Spec.UserID != req.User.GetUID()) {
+		return types.NewErrForbidden("only admins can pin multi-user MCP servers")
+	}
+
+	if pin.Revision > 0 {
+		var revision v1.MCPServerCatalogEntryRevision
+		if err := req.Get(&revision, v1.MCPServerCatalogEntryRevisionName(entry.Name, pin.Revision)); err != nil {
+			return types.NewErrBadRequest("revision %d of catalog entry %s not found", pin.Revision, entry.Name)
+		}
+	}
+
+	server.Spec.PinnedCatalogEntryRevision = pin.Revision
+	if err := req.Update(&server); err != nil {
+		return fmt.Errorf("failed to update server: %w", err)
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), "", "")
+	if err != nil {
+		return fmt.Errorf("failed to generate slug: %w", err)
+	}
+
+	return req.Write(ConvertMCPServer(server, nil, MCPServerConnectBaseURL(req, server), slug))
+}
+
+func serverAndCatalogEntryForRevisions(req api.Context) (v1.MCPServer, v1.MCPServerCatalogEntry, error) {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return v1.MCPServer{}, v1.MCPServerCatalogEntry{}, err
+	}
+
+	switch {
+	case server.Spec.MCPServerCatalogEntryName == "":
+		return v1.MCPServer{}, v1.MCPServerCatalogEntry{}, types.NewErrBadRequest("MCP server %s was not created from a catalog entry", server.Name)
+	case server.Spec.CompositeName != "":
+		return v1.MCPServer{}, v1.MCPServerCatalogEntry{}, types.NewErrBadRequest("cannot use revisions of a component server; use the parent composite server instead")
+	case server.Spec.Manifest.Runtime == types.RuntimeComposite:
+		return v1.MCPServer{}, v1.MCPServerCatalogEntry{}, types.NewErrBadRequest("revisions are not supported for composite servers")
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, server.Spec.MCPServerCatalogEntryName); err != nil {
+		return v1.MCPServer{}, v1.MCPServerCatalogEntry{}, err
+	}
+
+	return server, entry, nil
+}
+
+// catalogEntryForUpdate returns the catalog entry to update a server from, with the manifest of the revision that the
+// server is pinned to if it is pinned, and the number of that revision.
+func catalogEntryForUpdate(req api.Context, server v1.MCPServer, entry v1.MCPServerCatalogEntry) (v1.MCPServerCatalogEntry, int, error) {
+	if server.Spec.PinnedCatalogEntryRevision == 0 {
+		return entry, entry.Status.Revision, nil
+	}
+
+	var revision v1.MCPServerCatalogEntryRevision
+	if err := req.Get(&revision, v1.MCPServerCatalogEntryRevisionName(entry.Name, server.Spec.PinnedCatalogEntryRevision)); err != nil {
+		return entry, 0, fmt.Errorf("failed to get pinned revision %d: %w", server.Spec.PinnedCatalogEntryRevision, err)
+	}
+
+	entry.Spec.Manifest = revision.Spec.Manifest
+	return entry, revision.Spec.Revision, nil
+}
+
+// manifestChanges returns the top-level fields of two manifests that differ, sorted by field name.
+func manifestChanges(before, after any) ([]types.MCPManifestChange, error) {
+	beforeFields, err := manifestFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := manifestFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldNames := slices.Collect(maps.Keys(beforeFields))
+	for field := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			fieldNames = append(fieldNames, field)
+		}
+	}
+	slices.Sort(fieldNames)
+
+	changes := []types.MCPManifestChange{}
+	for _, field := range fieldNames {
+		if !bytes.Equal(beforeFields[field], afterFields[field]) {
+			changes = append(changes, types.MCPManifestChange{
+				Field:  field,
+				Before: beforeFields[field],
+				After:  afterFields[field],
+			})
+		}
+	}
+	return changes, nil
+}
+
+func manifestFields(manifest any) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	var result map[string]json.RawMessage
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+	return result, nil
+}
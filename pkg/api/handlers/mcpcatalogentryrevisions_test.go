@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func TestManifestChanges(t *testing.T) {
+	before := types.MCPServerManifest{
+		Name:      "Server",
+		Runtime:   types.RuntimeUVX,
+		UVXConfig: &types.UVXRuntimeConfig{Package: "server@1"},
+	}
+	after := types.MCPServerManifest{
+		Name:      "Server",
+		Runtime:   types.RuntimeNPX,
+		NPXConfig: &types.NPXRuntimeConfig{Package: "server@2"},
+	}
+
+	changes, err := manifestChanges(before, after)
+	if err != nil {
+		t.Fatalf("manifestChanges() error = %v", err)
+	}
+
+	want := []types.MCPManifestChange{
+		{Field: "npxConfig", After: []byte(`{"package":"server@2"}`)},
+		{Field: "runtime", Before: []byte(`"uvx"`), After: []byte(`"npx"`)},
+		{Field: "uvxConfig", Before: []byte(`{"package":"server@1","command":""}`)},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("manifestChanges() = %+v, want %+v", changes, want)
+	}
+	for i := range want {
+		if changes[i].Field != want[i].Field || string(changes[i].Before) != string(want[i].Before) || string(changes[i].After) != string(want[i].After) {
+			t.Errorf("manifestChanges()[%d] = {%s %s %s}, want {%s %s %s}", i,
+				changes[i].Field, changes[i].Before, changes[i].After, want[i].Field, want[i].Before, want[i].After)
+		}
+	}
+
+	changes, err = manifestChanges(before, before)
+	if err != nil {
+		t.Fatalf("manifestChanges() error = %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("manifestChanges() of identical manifests = %+v, want none", changes)
+	}
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/validation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ValidateEntry validates a catalog entry manifest without publishing it. If requested and the manifest is valid, it
+// also deploys a temporary server from the manifest to list its tools, and tears it down afterward.
+func (h *MCPCatalogHandler) ValidateEntry(req api.Context) error {
+	var validationRequest types.MCPServerCatalogEntryValidationRequest
+	if err := req.Read(&validationRequest); err != nil {
+		return types.NewErrBadRequest("failed to read validation request: %v", err)
+	}
+
+	catalogName, workspaceID := validationRequest.CatalogID, validationRequest.WorkspaceID
+	switch {
+	case catalogName != "" && workspaceID != "":
+		return types.NewErrBadRequest("only one of catalogID or workspaceID can be set")
+	case catalogName != "":
+		if !req.UserIsAdmin() {
+			return types.NewErrForbidden("only admins can validate entries for catalogs")
+		}
+		if err := req.Get(&v1.MCPCatalog{}, catalogName); err != nil {
+			return fmt.Errorf("failed to get catalog: %w", err)
+		}
+	case workspaceID != "":
+		var workspace v1.PowerUserWorkspace
+		if err := req.Get(&workspace, workspaceID); err != nil {
+			return fmt.Errorf("failed to get workspace: %w", err)
+		}
+		if !req.UserIsAdmin() && workspace.Spec.UserID != req.User.GetUID() {
+			return types.NewErrNotFound("workspace not found")
+		}
+	default:
+		return types.NewErrBadRequest("either catalogID or workspaceID is required")
+	}
+
+	var (
+		manifest = validationRequest.Manifest
+		result   types.MCPServerCatalogEntryValidationResult
+	)
+
+	if manifest.Runtime == types.RuntimeComposite && manifest.CompositeConfig != nil {
+		issues, err := componentReferenceIssues(req, *manifest.CompositeConfig)
+		if err != nil {
+			return err
+		}
+		result.Errors = append(result.Errors, issues...)
+
+		if len(issues) == 0 {
+			if err = h.populateComponentManifests(req, &manifest, catalogName, workspaceID); err != nil {
+				result.Errors = append(result.Errors, validationIssue("compositeConfig.componentServers", err))
+			}
+		}
+	}
+
+	errs, warnings := lintCatalogEntryManifest(manifest)
+	result.Errors = append(result.Errors, errs...)
+	result.Warnings = warnings
+
+	// The dry run starts stdio servers as subprocesses of Obot, so they are checked like entries that are published.
+	if err := validateStdioCatalogEntryManifest(req, manifest, catalogName); err != nil {
+		result.Errors = append(result.Errors, validationIssue("runtime", err))
+	}
+
+	result.Valid = len(result.Errors) == 0
+	if !validationRequest.DryRun || !result.Valid {
+		return req.Write(result)
+	}
+
+	scope := catalogName
+	if scope == "" {
+		scope = workspaceID
+	}
+
+	var (
+		tools []types.MCPServerTool
+		err   error
+	)
+	if manifest.Runtime == types.RuntimeComposite {
+		tools, err = h.compositeToolPreviews(req, req.Namespace(), scope, *manifest.CompositeConfig, validationRequest.ComponentConfigs)
+	} else {
+		tools, err = h.toolPreviews(req, req.Namespace(), scope, manifest, validationRequest.Config, validationRequest.URL)
+	}
+
+	result.DryRun = &types.MCPServerCatalogEntryDryRunResult{
+		Succeeded: err == nil,
+		Tools:     tools,
+	}
+	if err != nil {
+		result.DryRun.Error = validationIssue("", err).Message
+		result.Valid = false
+	}
+
+	return req.Write(result)
+}
+
+// lintCatalogEntryManifest returns the errors and warnings of a catalog entry manifest. The components of a composite
+// manifest must already be populated.
+func lintCatalogEntryManifest(manifest types.MCPServerCatalogEntryManifest) (errs, warnings []types.MCPServerCatalogEntryValidationIssue) {
+	if err := validation.ValidateCatalogEntryManifest(manifest); err != nil {
+		errs = append(errs, validationIssue("", err))
+	}
+	for _, err := range validation.ValidateCatalogEntryTemplates(manifest) {
+		errs = append(errs, validationIssue("", err))
+	}
+	for _, err := range validation.ValidateCatalogEntryHeaders(manifest) {
+		errs = append(errs, validationIssue("", err))
+	}
+	return errs, undeclaredVariableWarnings(manifest)
+}
+
+// componentReferenceIssues returns the components of a composite catalog entry that reference catalog entries or
+// multi-user servers that don't exist.
+func componentReferenceIssues(req api.Context, compositeConfig types.CompositeCatalogConfig) ([]types.MCPServerCatalogEntryValidationIssue, error) {
+	var issues []types.MCPServerCatalogEntryValidationIssue
+	for i, component := range compositeConfig.ComponentServers {
+		var (
+			field = fmt.Sprintf("compositeConfig.componentServers[%d]", i)
+			err   error
+		)
+		switch {
+		case component.CatalogEntryID != "" && component.MCPServerID != "":
+			// ValidateCatalogEntryManifest reports components that reference both.
+			continue
+		case component.CatalogEntryID != "":
+			err = req.Get(&v1.MCPServerCatalogEntry{}, component.CatalogEntryID)
+			if apierrors.IsNotFound(err) {
+				issues = append(issues, types.MCPServerCatalogEntryValidationIssue{
+					Field:   field + ".catalogEntryID",
+					Message: fmt.Sprintf("catalog entry %q not found", component.CatalogEntryID),
+				})
+				continue
+			}
+		case component.MCPServerID != "":
+			err = req.Get(&v1.MCPServer{}, component.MCPServerID)
+			if apierrors.IsNotFound(err) {
+				issues = append(issues, types.MCPServerCatalogEntryValidationIssue{
+					Field:   field + ".mcpServerID",
+					Message: fmt.Sprintf("multi-user server %q not found", component.MCPServerID),
+				})
+				continue
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get component of composite entry: %w", err)
+		}
+	}
+	return issues, nil
+}
+
+// undeclaredVariableWarnings returns a warning for each ${VAR} that a catalog entry manifest references without
+// declaring it. These variables are added to the entry as required configuration when it is published.
+func undeclaredVariableWarnings(manifest types.MCPServerCatalogEntryManifest) []types.MCPServerCatalogEntryValidationIssue {
+	entry := v1.MCPServerCatalogEntry{
+		Spec: v1.MCPServerCatalogEntrySpec{
+			Manifest: *manifest.DeepCopy(),
+		},
+	}
+	addExtractedEnvVarsToCatalogEntry(&entry)
+
+	var warnings []types.MCPServerCatalogEntryValidationIssue
+	for _, env := range entry.Spec.Manifest.Env[len(manifest.Env):] {
+		warnings = append(warnings, types.MCPServerCatalogEntryValidationIssue{
+			Field:   "env",
+			Message: fmt.Sprintf("${%s} is referenced but not declared, so it will be added as a required, sensitive environment variable", env.Key),
+		})
+	}
+	if remoteConfig := entry.Spec.Manifest.RemoteConfig; remoteConfig != nil {
+		for _, header := range remoteConfig.Headers[len(manifest.RemoteConfig.Headers):] {
+			warnings = append(warnings, types.MCPServerCatalogEntryValidationIssue{
+				Field:   "remoteConfig.headers",
+				Message: fmt.Sprintf("${%s} is referenced but not declared, so it will be added as a required header", header.Key),
+			})
+		}
+	}
+	return warnings
+}
+
+// validationIssue converts a validation error to an issue, using the field of runtime validation errors.
+func validationIssue(field string, err error) types.MCPServerCatalogEntryValidationIssue {
+	if runtimeErr, ok := errors.AsType[types.RuntimeValidationError](err); ok {
+		return types.MCPServerCatalogEntryValidationIssue{
+			Field:   runtimeErr.Field,
+			Message: runtimeErr.Message,
+		}
+	}
+	if httpErr, ok := errors.AsType[*types.ErrHTTP](err); ok {
+		return types.MCPServerCatalogEntryValidationIssue{
+			Field:   field,
+			Message: httpErr.Message,
+		}
+	}
+	return types.MCPServerCatalogEntryValidationIssue{
+		Field:   field,
+		Message: err.Error(),
+	}
+}
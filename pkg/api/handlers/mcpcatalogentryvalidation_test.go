@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidateEntryRejectsStdioForPowerUsers(t *testing.T) {
+	storage := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithObjects(&v1.PowerUserWorkspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "puw1-test", Namespace: system.DefaultNamespace},
+			Spec:       v1.PowerUserWorkspaceSpec{UserID: "1"},
+		}).
+		Build()
+
+	body, err := json.Marshal(types.MCPServerCatalogEntryValidationRequest{
+		WorkspaceID: "puw1-test",
+		Manifest: types.MCPServerCatalogEntryManifest{
+			Name:        "stdio",
+			Runtime:     types.RuntimeStdio,
+			StdioConfig: &types.StdioRuntimeConfig{Command: "my-mcp-server"},
+		},
+		// The dry run must not be reached, since it would start the command as a subprocess of Obot.
+		DryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to encode request: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	if err = (&MCPCatalogHandler{}).ValidateEntry(api.Context{
+		ResponseWriter: rec,
+		Request:        httptest.NewRequest(http.MethodPost, "/api/catalog-entries/validate", strings.NewReader(string(body))),
+		Storage:        storage,
+		User:           &kuser.DefaultInfo{Name: "power-user", UID: "1", Groups: []string{types.GroupPowerUser}},
+	}); err != nil {
+		t.Fatalf("ValidateEntry() error = %v", err)
+	}
+
+	var result types.MCPServerCatalogEntryValidationResult
+	if err = json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	if result.Valid || result.DryRun != nil || len(result.Errors) == 0 {
+		t.Errorf("ValidateEntry() = %+v, want the stdio entry to be rejected without a dry run", result)
+	}
+}
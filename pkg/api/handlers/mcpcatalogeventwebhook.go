@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type MCPCatalogEventWebhookHandler struct{}
+
+func NewMCPCatalogEventWebhookHandler() *MCPCatalogEventWebhookHandler {
+	return &MCPCatalogEventWebhookHandler{}
+}
+
+// List returns the event webhooks of a catalog.
+func (*MCPCatalogEventWebhookHandler) List(req api.Context) error {
+	var list v1.MCPCatalogEventWebhookList
+	if err := req.List(&list, kclient.MatchingFields{
+		"spec.mcpCatalogID": req.PathValue("catalog_id"),
+	}); err != nil {
+		return fmt.Errorf("failed to list event webhooks: %w", err)
+	}
+
+	items := make([]types.MCPCatalogEventWebhook, 0, len(list.Items))
+	for _, item := range list.Items {
+		hasSecret, err := catalogEventWebhookHasSecret(req, item.Name)
+		if err != nil {
+			return err
+		}
+		items = append(items, convertMCPCatalogEventWebhook(item, hasSecret))
+	}
+
+	return req.Write(types.MCPCatalogEventWebhookList{
+		Items: items,
+	})
+}
+
+// Get returns an event webhook of a catalog.
+func (*MCPCatalogEventWebhookHandler) Get(req api.Context) error {
+	webhook, err := getMCPCatalogEventWebhook(req)
+	if err != nil {
+		return err
+	}
+
+	hasSecret, err := catalogEventWebhookHasSecret(req, webhook.Name)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPCatalogEventWebhook(webhook, hasSecret))
+}
+
+// Create subscribes a webhook to the lifecycle events of a catalog.
+func (*MCPCatalogEventWebhookHandler) Create(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	var manifest types.MCPCatalogEventWebhookManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read event webhook manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid event webhook manifest: %v", err)
+	}
+
+	// Don't save the secret in the database.
+	secret := manifest.Secret
+	manifest.Secret = ""
+
+	webhook := v1.MCPCatalogEventWebhook{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.MCPCatalogEventWebhookPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.MCPCatalogEventWebhookSpec{
+			MCPCatalogID: catalog.Name,
+			Manifest:     manifest,
+		},
+	}
+
+	if err := req.Create(&webhook); err != nil {
+		return fmt.Errorf("failed to create event webhook: %w", err)
+	}
+
+	if secret != "" {
+		if err := storeCatalogEventWebhookSecret(req, webhook.Name, secret); err != nil {
+			_ = req.Delete(&webhook)
+			return err
+		}
+	}
+
+	return req.Write(convertMCPCatalogEventWebhook(webhook, secret != ""))
+}
+
+// Update updates an event webhook of a catalog. The secret is kept if the manifest doesn't set one.
+func (*MCPCatalogEventWebhookHandler) Update(req api.Context) error {
+	var manifest types.MCPCatalogEventWebhookManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read event webhook manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid event webhook manifest: %v", err)
+	}
+
+	existing, err := getMCPCatalogEventWebhook(req)
+	if err != nil {
+		return err
+	}
+
+	secret := manifest.Secret
+	manifest.Secret = ""
+
+	if secret != "" {
+		if err := storeCatalogEventWebhookSecret(req, existing.Name, secret); err != nil {
+			return err
+		}
+	}
+
+	existing.Spec.Manifest = manifest
+	if err := req.Update(&existing); err != nil {
+		return fmt.Errorf("failed to update event webhook: %w", err)
+	}
+
+	hasSecret, err := catalogEventWebhookHasSecret(req, existing.Name)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPCatalogEventWebhook(existing, hasSecret))
+}
+
+// Delete deletes an event webhook of a catalog. Its secret is deleted by the controller.
+func (*MCPCatalogEventWebhookHandler) Delete(req api.Context) error {
+	webhook, err := getMCPCatalogEventWebhook(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Delete(&webhook)
+}
+
+// getMCPCatalogEventWebhook returns the webhook in the path, if it belongs to the catalog in the path.
+func getMCPCatalogEventWebhook(req api.Context) (v1.MCPCatalogEventWebhook, error) {
+	var webhook v1.MCPCatalogEventWebhook
+	if err := req.Get(&webhook, req.PathValue("webhook_id")); err != nil {
+		return webhook, err
+	}
+	if webhook.Spec.MCPCatalogID != req.PathValue("catalog_id") {
+		return webhook, types.NewErrNotFound("event webhook %s not found", req.PathValue("webhook_id"))
+	}
+	return webhook, nil
+}
+
+func storeCatalogEventWebhookSecret(req api.Context, webhookName, secret string) error {
+	if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+		Context:  system.MCPCatalogEventWebhookCredentialContext,
+		ToolName: webhookName,
+		Type:     gptscript.CredentialTypeTool,
+		Env: map[string]string{
+			"secret": secret,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+	return nil
+}
+
+func catalogEventWebhookHasSecret(req api.Context, webhookName string) (bool, error) {
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{system.MCPCatalogEventWebhookCredentialContext}, webhookName)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to reveal credential: %w", err)
+	}
+	return cred.Env["secret"] != "", nil
+}
+
+func convertMCPCatalogEventWebhook(webhook v1.MCPCatalogEventWebhook, hasSecret bool) types.MCPCatalogEventWebhook {
+	result := types.MCPCatalogEventWebhook{
+		Metadata:                       MetadataFrom(&webhook),
+		MCPCatalogEventWebhookManifest: webhook.Spec.Manifest,
+		MCPCatalogID:                   webhook.Spec.MCPCatalogID,
+		HasSecret:                      hasSecret,
+		Error:                          webhook.Status.Error,
+	}
+
+	if !webhook.Status.LastDeliveredAt.IsZero() {
+		result.LastDeliveredAt = types.NewTime(webhook.Status.LastDeliveredAt.Time)
+	}
+
+	return result
+}
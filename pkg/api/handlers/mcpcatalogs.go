@@ -5,9 +5,11 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/gptscript-ai/gptscript/pkg/hash"
@@ -21,6 +23,8 @@ import (
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/validation"
+	"github.com/sethvargo/go-limiter"
+	"github.com/sethvargo/go-limiter/memorystore"
 	"golang.org/x/crypto/bcrypt"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,9 +40,17 @@ type MCPCatalogHandler struct {
 	oauthChecker       MCPOAuthChecker
 	gatewayClient      *gclient.Client
 	acrHelper          *accesscontrolrule.Helper
+	submissionLimiter  limiter.Store
+	trustedProxies     []*net.IPNet
 }
 
-func NewMCPCatalogHandler(defaultCatalogPath string, serverURL string, sessionManager *mcp.SessionManager, oauthChecker MCPOAuthChecker, gatewayClient *gclient.Client, acrHelper *accesscontrolrule.Helper) *MCPCatalogHandler {
+func NewMCPCatalogHandler(defaultCatalogPath string, serverURL string, sessionManager *mcp.SessionManager, oauthChecker MCPOAuthChecker, gatewayClient *gclient.Client, acrHelper *accesscontrolrule.Helper, trustedProxies []*net.IPNet) *MCPCatalogHandler {
+	// The store only fails to be created for invalid configurations.
+	submissionLimiter, _ := memorystore.New(&memorystore.Config{
+		Tokens:   submissionsPerHour,
+		Interval: time.Hour,
+	})
+
 	return &MCPCatalogHandler{
 		defaultCatalogPath: defaultCatalogPath,
 		serverURL:          serverURL,
@@ -46,6 +58,8 @@ func NewMCPCatalogHandler(defaultCatalogPath string, serverURL string, sessionMa
 		oauthChecker:       oauthChecker,
 		gatewayClient:      gatewayClient,
 		acrHelper:          acrHelper,
+		submissionLimiter:  submissionLimiter,
+		trustedProxies:     trustedProxies,
 	}
 }
 
@@ -121,6 +135,15 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 		return err
 	}
 
+	externalURL, err := normalizeAndValidateCatalogExternalURL(manifest.ExternalURL)
+	if err != nil {
+		return err
+	}
+
+	if manifest.DefaultToolSelection != "" && !manifest.DefaultToolSelection.Valid() {
+		return types.NewErrBadRequest("invalid default tool selection policy %q", manifest.DefaultToolSelection)
+	}
+
 	// Reveal the existing single credential that holds all source-URL tokens.
 	existingCred, err := req.GPTClient.RevealCredential(req.Context(), []string{catalog.Name}, mcpcataloghandler.CatalogCredentialToolName)
 	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
@@ -130,6 +153,8 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 	newTokens := mergeCatalogTokens(manifest.SourceURLs, manifest.SourceURLCredentials, existingCred.Env)
 
 	catalog.Spec.SourceURLs = manifest.SourceURLs
+	catalog.Spec.ExternalURL = externalURL
+	catalog.Spec.DefaultToolSelection = manifest.DefaultToolSelection
 
 	if err := req.Update(&catalog); err != nil {
 		return fmt.Errorf("failed to update catalog: %w", err)
@@ -144,6 +169,11 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 
 // ListEntries lists all entries for a catalog or workspace.
 func (h *MCPCatalogHandler) ListEntries(req api.Context) error {
+	filter, err := catalogEntryFilterFromRequest(req)
+	if err != nil {
+		return err
+	}
+
 	catalogName := req.PathValue("catalog_id")
 	workspaceID := req.PathValue("workspace_id")
 	var powerUserID string
@@ -183,7 +213,7 @@ func (h *MCPCatalogHandler) ListEntries(req api.Context) error {
 		for _, entry := range list.Items {
 			entries = append(entries, ConvertMCPServerCatalogEntryWithWorkspace(entry, workspaceID, powerUserID))
 		}
-		return req.Write(types.MCPServerCatalogEntryList{Items: entries})
+		return writeCatalogEntries(req, filter, entries)
 	}
 
 	// Apply ACR filtering for regular users and for admins without ?all=true
@@ -214,7 +244,7 @@ func (h *MCPCatalogHandler) ListEntries(req api.Context) error {
 		}
 	}
 
-	return req.Write(types.MCPServerCatalogEntryList{Items: entries})
+	return writeCatalogEntries(req, filter, entries)
 }
 
 // GetEntry returns a specific entry from a catalog or workspace.
@@ -292,7 +322,23 @@ func (h *MCPCatalogHandler) CreateEntry(req api.Context) error {
 	if err := validation.ValidateCatalogEntryManifest(manifest); err != nil {
 		return types.NewErrBadRequest("failed to validate entry manifest: %v", err)
 	}
+	if err := validateSecretRefsAllowed(req, manifest.Env, nil); err != nil {
+		return err
+	}
+	if err := validateStdioCatalogEntryManifest(req, manifest, catalogName); err != nil {
+		return err
+	}
 
+	entry, err := createEditableEntry(req, catalogName, workspaceID, manifest)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(ConvertMCPServerCatalogEntry(entry))
+}
+
+// createEditableEntry creates an editable catalog entry from a validated manifest in a catalog or workspace.
+func createEditableEntry(req api.Context, catalogName, workspaceID string, manifest types.MCPServerCatalogEntryManifest) (v1.MCPServerCatalogEntry, error) {
 	cleanName := normalizeMCPCatalogEntryName(manifest.Name)
 
 	entry := v1.MCPServerCatalogEntry{
@@ -316,10 +362,10 @@ func (h *MCPCatalogHandler) CreateEntry(req api.Context) error {
 	}
 
 	if err := req.Create(&entry); err != nil {
-		return fmt.Errorf("failed to create entry: %w", err)
+		return entry, fmt.Errorf("failed to create entry: %w", err)
 	}
 
-	return req.Write(ConvertMCPServerCatalogEntry(entry))
+	return entry, nil
 }
 
 func (h *MCPCatalogHandler) UpdateEntry(req api.Context) error {
@@ -363,10 +409,30 @@ func (h *MCPCatalogHandler) UpdateEntry(req api.Context) error {
 	if err := validation.ValidateCatalogEntryManifest(manifest); err != nil {
 		return types.NewErrBadRequest("failed to validate entry manifest: %v", err)
 	}
+	if err := validateSecretRefsAllowed(req, manifest.Env, entry.Spec.Manifest.Env); err != nil {
+		return err
+	}
+	if err := validateStdioCatalogEntryManifest(req, manifest, catalogName); err != nil {
+		return err
+	}
+	if manifest.Runtime == types.RuntimeComposite && manifest.CompositeConfig != nil {
+		// Prevent the entry from being nested in itself.
+		for _, component := range manifest.CompositeConfig.ComponentServers {
+			if err := validation.ValidateCatalogComponentNesting(component, entry.Name); err != nil {
+				return types.NewErrBadRequest("failed to validate entry manifest: %v", err)
+			}
+		}
+	}
 
 	// Copy the tool previews over so that they don't get wiped out when updating the manifest
 	manifest.ToolPreview = entry.Spec.Manifest.ToolPreview
 
+	// Users who authorized OAuth apps before the entry requested new scopes from them are asked to consent again.
+	scopeChanges, err := oauthScopeChanges(req, entry.Spec.Manifest, manifest)
+	if err != nil {
+		return err
+	}
+
 	// Update the manifest
 	entry.Spec.Manifest = manifest
 
@@ -374,7 +440,9 @@ func (h *MCPCatalogHandler) UpdateEntry(req api.Context) error {
 		return fmt.Errorf("failed to update entry: %w", err)
 	}
 
-	return req.Write(ConvertMCPServerCatalogEntry(entry))
+	result := ConvertMCPServerCatalogEntry(entry)
+	result.OAuthScopeChanges = scopeChanges
+	return req.Write(result)
 }
 
 func (h *MCPCatalogHandler) DeleteEntry(req api.Context) error {
@@ -474,7 +542,7 @@ func (h *MCPCatalogHandler) AdminListServersForEntryInCatalog(req api.Context) e
 				return err
 			}
 		}
-		items = append(items, ConvertMCPServer(server, cred.Env, h.serverURL, slug, components...))
+		items = append(items, ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug, components...))
 	}
 
 	return req.Write(types.MCPServerList{Items: items})
@@ -553,7 +621,7 @@ func (h *MCPCatalogHandler) AdminListServersForAllEntriesInCatalog(req api.Conte
 				return err
 			}
 		}
-		items = append(items, ConvertMCPServer(server, cred.Env, h.serverURL, slug, components...))
+		items = append(items, ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug, components...))
 	}
 
 	return req.Write(types.MCPServerList{Items: items})
@@ -629,7 +697,7 @@ func (h *MCPCatalogHandler) ListServersForEntry(req api.Context) error {
 				return fmt.Errorf("failed to resolve composite components: %w", err)
 			}
 		}
-		items = append(items, ConvertMCPServer(server, cred.Env, h.serverURL, slug, components...))
+		items = append(items, ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug, components...))
 	}
 
 	return req.Write(types.MCPServerList{Items: items})
@@ -698,7 +766,7 @@ func (h *MCPCatalogHandler) GetServerFromEntry(req api.Context) error {
 			return err
 		}
 	}
-	return req.Write(ConvertMCPServer(server, cred.Env, h.serverURL, slug, components...))
+	return req.Write(ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug, components...))
 }
 
 // GenerateToolPreviews launches a temporary instance of an MCP server from a catalog entry
@@ -761,29 +829,60 @@ func (h *MCPCatalogHandler) GenerateToolPreviews(req api.Context) error {
 	if catalogName == "" {
 		catalogName = entry.Spec.PowerUserWorkspaceID
 	}
-	server, serverConfig, err := tempServerAndConfig(
+	toolPreviews, err := h.toolPreviews(req, entry.Namespace, catalogName, entry.Spec.Manifest, configRequest.Config, configRequest.URL)
+	if err != nil {
+		return err
+	}
+
+	// Set the tool preview on the catalog entry
+	entry.Spec.Manifest.ToolPreview = toolPreviews
+	if dryRun {
+		// Don't update the entry, just return the entry with the new tool set
+		return req.Write(ConvertMCPServerCatalogEntry(entry))
+	}
+
+	if err := req.Update(&entry); err != nil {
+		return fmt.Errorf("failed to update catalog entry: %w", err)
+	}
+
+	now := metav1.Now()
+	entry.Status.ToolPreviewsLastGenerated = &now
+	entry.Status.ToolPreviewsManifestHash = entry.ToolPreviewsHash()
+	entry.Status.ToolPreviewsError = ""
+	if err := req.Storage.Status().Update(req.Context(), &entry); err != nil {
+		return fmt.Errorf("failed to update catalog entry: %w", err)
+	}
+
+	// Return the updated catalog entry
+	return req.Write(ConvertMCPServerCatalogEntry(entry))
+}
+
+// toolPreviews launches a temporary instance of an MCP server from a catalog entry manifest to list its tools, then
+// cleans up the instance.
+func (h *MCPCatalogHandler) toolPreviews(req api.Context, namespace, catalogName string, manifest types.MCPServerCatalogEntryManifest, config map[string]string, url string) ([]types.MCPServerTool, error) {
+	server, serverConfig, err := TempServerAndConfig(
 		req.Context(),
 		req.GPTClient,
 		req.Storage,
-		entry.Namespace,
+		namespace,
 		catalogName,
-		entry.Spec.Manifest,
-		configRequest.Config,
-		configRequest.URL,
+		manifest,
+		config,
+		url,
 		h.serverURL,
 	)
 	if err != nil {
-		return types.NewErrBadRequest("failed to create temporary server and config: %v", err)
+		return nil, types.NewErrBadRequest("failed to create temporary server and config: %v", err)
 	}
 
 	if serverConfig.Runtime == types.RuntimeRemote {
 		oauthURL, err := h.oauthChecker.CheckForMCPAuth(req, server, serverConfig, "system", server.Name, "")
 		if err != nil {
-			return fmt.Errorf("failed to check for MCP auth: %w", err)
+			return nil, fmt.Errorf("failed to check for MCP auth: %w", err)
 		}
 
 		if oauthURL != "" {
-			return types.NewErrBadRequest("MCP server requires OAuth authentication")
+			return nil, types.NewErrBadRequest("MCP server requires OAuth authentication")
 		}
 
 		defer func() {
@@ -794,11 +893,37 @@ func (h *MCPCatalogHandler) GenerateToolPreviews(req api.Context) error {
 	// Launch temporary instance and get tools
 	toolPreviews, err := h.sessionManager.GenerateToolPreviews(req.Context(), server, serverConfig)
 	if err != nil {
-		return fmt.Errorf("failed to launch temporary instance: %w", err)
+		return nil, fmt.Errorf("failed to launch temporary instance: %w", err)
+	}
+	return toolPreviews, nil
+}
+
+func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry v1.MCPServerCatalogEntry, dryRun bool) error {
+	// Read configuration from request body
+	var configRequest struct {
+		ComponentConfigs map[string]types.MCPComponentServerConfig `json:"componentConfigs"`
+	}
+	if err := req.Read(&configRequest); err != nil {
+		return types.NewErrBadRequest("failed to read configuration: %v", err)
+	}
+
+	compositeConfig := entry.Spec.Manifest.CompositeConfig
+	if compositeConfig == nil {
+		return types.NewErrBadRequest("composite configuration is required")
+	}
+
+	catalogName := entry.Spec.MCPCatalogName
+	if catalogName == "" {
+		catalogName = entry.Spec.PowerUserWorkspaceID
+	}
+
+	compositeToolPreviews, err := h.compositeToolPreviews(req, entry.Namespace, catalogName, *compositeConfig, configRequest.ComponentConfigs)
+	if err != nil {
+		return err
 	}
 
 	// Set the tool preview on the catalog entry
-	entry.Spec.Manifest.ToolPreview = toolPreviews
+	entry.Spec.Manifest.ToolPreview = compositeToolPreviews
 	if dryRun {
 		// Don't update the entry, just return the entry with the new tool set
 		return req.Write(ConvertMCPServerCatalogEntry(entry))
@@ -810,6 +935,8 @@ func (h *MCPCatalogHandler) GenerateToolPreviews(req api.Context) error {
 
 	now := metav1.Now()
 	entry.Status.ToolPreviewsLastGenerated = &now
+	entry.Status.ToolPreviewsManifestHash = entry.ToolPreviewsHash()
+	entry.Status.ToolPreviewsError = ""
 	if err := req.Storage.Status().Update(req.Context(), &entry); err != nil {
 		return fmt.Errorf("failed to update catalog entry: %w", err)
 	}
@@ -818,29 +945,9 @@ func (h *MCPCatalogHandler) GenerateToolPreviews(req api.Context) error {
 	return req.Write(ConvertMCPServerCatalogEntry(entry))
 }
 
-func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry v1.MCPServerCatalogEntry, dryRun bool) error {
-	// Read configuration from request body
-	var configRequest struct {
-		ComponentConfigs map[string]struct {
-			Config   map[string]string `json:"config"`
-			URL      string            `json:"url"`
-			Disabled bool              `json:"disabled"`
-		} `json:"componentConfigs"`
-	}
-	if err := req.Read(&configRequest); err != nil {
-		return types.NewErrBadRequest("failed to read configuration: %v", err)
-	}
-
-	compositeConfig := entry.Spec.Manifest.CompositeConfig
-	if compositeConfig == nil {
-		return types.NewErrBadRequest("composite configuration is required")
-	}
-
-	catalogName := entry.Spec.MCPCatalogName
-	if catalogName == "" {
-		catalogName = entry.Spec.PowerUserWorkspaceID
-	}
-
+// compositeToolPreviews lists the tools of the components of a composite server, launching temporary servers for the
+// components that are catalog entries. Components without a configuration are skipped.
+func (h *MCPCatalogHandler) compositeToolPreviews(req api.Context, namespace, catalogName string, compositeConfig types.CompositeCatalogConfig, componentConfigs map[string]types.MCPComponentServerConfig) ([]types.MCPServerTool, error) {
 	compositeToolPreviews := make([]types.MCPServerTool, 0, len(compositeConfig.ComponentServers))
 	for _, componentEntry := range compositeConfig.ComponentServers {
 		// If this component references an existing MCPServer, list its tools directly
@@ -848,17 +955,17 @@ func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry
 		if componentEntry.MCPServerID != "" {
 			var mcpServer v1.MCPServer
 			if err := req.Get(&mcpServer, componentEntry.MCPServerID); err != nil {
-				return fmt.Errorf("failed to get MCP server %q: %w", componentEntry.MCPServerID, err)
+				return nil, fmt.Errorf("failed to get MCP server %q: %w", componentEntry.MCPServerID, err)
 			}
 
 			serverConfig, err := serverConfigForAction(req, mcpServer)
 			if err != nil {
-				return fmt.Errorf("failed to build server configuration for MCP server %q: %w", mcpServer.Name, err)
+				return nil, fmt.Errorf("failed to build server configuration for MCP server %q: %w", mcpServer.Name, err)
 			}
 
 			tools, err := toolsForServer(req.Context(), h.sessionManager, mcpServer, serverConfig, nil)
 			if err != nil {
-				return fmt.Errorf("failed to list tools for MCP server %q: %w", mcpServer.Name, err)
+				return nil, fmt.Errorf("failed to list tools for MCP server %q: %w", mcpServer.Name, err)
 			}
 
 			transformedTools := mcp.ApplyToolOverrides(tools, componentEntry.ToolOverrides, componentEntry.ToolPrefix)
@@ -872,7 +979,7 @@ func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry
 			componentID = componentEntry.MCPServerID
 		}
 
-		config, ok := configRequest.ComponentConfigs[componentID]
+		config, ok := componentConfigs[componentID]
 		if !ok {
 			// No config provided for this component, skip it
 			continue
@@ -883,11 +990,11 @@ func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry
 			continue
 		}
 
-		server, serverConfig, err := tempServerAndConfig(
+		server, serverConfig, err := TempServerAndConfig(
 			req.Context(),
 			req.GPTClient,
 			req.Storage,
-			entry.Namespace,
+			namespace,
 			catalogName,
 			componentEntry.Manifest,
 			config.Config,
@@ -895,7 +1002,7 @@ func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry
 			h.serverURL,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if serverConfig.Runtime == types.RuntimeRemote {
@@ -908,11 +1015,11 @@ func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry
 				"",
 			)
 			if err != nil {
-				return fmt.Errorf("failed to check for MCP auth: %w", err)
+				return nil, fmt.Errorf("failed to check for MCP auth: %w", err)
 			}
 
 			if oauthURL != "" {
-				return types.NewErrBadRequest("MCP server requires OAuth authentication")
+				return nil, types.NewErrBadRequest("MCP server requires OAuth authentication")
 			}
 
 			defer func() {
@@ -922,7 +1029,7 @@ func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry
 
 		toolPreview, err := h.sessionManager.GenerateToolPreviews(req.Context(), server, serverConfig)
 		if err != nil {
-			return fmt.Errorf("failed to generate tool preview: %w", err)
+			return nil, fmt.Errorf("failed to generate tool preview: %w", err)
 		}
 
 		// Apply tool overrides before aggregating
@@ -931,25 +1038,7 @@ func (h *MCPCatalogHandler) generateCompositeToolPreviews(req api.Context, entry
 		compositeToolPreviews = append(compositeToolPreviews, transformedTools...)
 	}
 
-	// Set the tool preview on the catalog entry
-	entry.Spec.Manifest.ToolPreview = compositeToolPreviews
-	if dryRun {
-		// Don't update the entry, just return the entry with the new tool set
-		return req.Write(ConvertMCPServerCatalogEntry(entry))
-	}
-
-	if err := req.Update(&entry); err != nil {
-		return fmt.Errorf("failed to update catalog entry: %w", err)
-	}
-
-	now := metav1.Now()
-	entry.Status.ToolPreviewsLastGenerated = &now
-	if err := req.Storage.Status().Update(req.Context(), &entry); err != nil {
-		return fmt.Errorf("failed to update catalog entry: %w", err)
-	}
-
-	// Return the updated catalog entry
-	return req.Write(ConvertMCPServerCatalogEntry(entry))
+	return compositeToolPreviews, nil
 }
 
 func (h *MCPCatalogHandler) GenerateToolPreviewsOAuthURL(req api.Context) error {
@@ -1015,7 +1104,7 @@ func (h *MCPCatalogHandler) GenerateToolPreviewsOAuthURL(req api.Context) error
 	if catalogName == "" {
 		catalogName = entry.Spec.PowerUserWorkspaceID
 	}
-	server, serverConfig, err := tempServerAndConfig(req.Context(), req.GPTClient, req.Storage, entry.Namespace, catalogName, entry.Spec.Manifest, configRequest.Config, configRequest.URL, h.serverURL)
+	server, serverConfig, err := TempServerAndConfig(req.Context(), req.GPTClient, req.Storage, entry.Namespace, catalogName, entry.Spec.Manifest, configRequest.Config, configRequest.URL, h.serverURL)
 	if err != nil {
 		return types.NewErrBadRequest("failed to create temporary server and config: %v", err)
 	}
@@ -1100,7 +1189,7 @@ func (h *MCPCatalogHandler) GenerateComponentToolPreviews(req api.Context) error
 	}
 
 	// Use the manifest snapshot embedded in the composite entry for this component.
-	server, serverConfig, err := tempServerAndConfig(
+	server, serverConfig, err := TempServerAndConfig(
 		req.Context(),
 		req.GPTClient,
 		req.Storage,
@@ -1222,7 +1311,7 @@ func (h *MCPCatalogHandler) GenerateComponentToolPreviewsOAuthURL(req api.Contex
 		catalogName = composite.Spec.PowerUserWorkspaceID
 	}
 
-	server, serverConfig, err := tempServerAndConfig(
+	server, serverConfig, err := TempServerAndConfig(
 		req.Context(),
 		req.GPTClient,
 		req.Storage,
@@ -1303,7 +1392,7 @@ func (h *MCPCatalogHandler) generateCompositeOAuthURLs(req api.Context, entry v1
 			continue
 		}
 
-		server, serverConfig, err := tempServerAndConfig(
+		server, serverConfig, err := TempServerAndConfig(
 			req.Context(),
 			req.GPTClient,
 			req.Storage,
@@ -1335,7 +1424,9 @@ func (h *MCPCatalogHandler) generateCompositeOAuthURLs(req api.Context, entry v1
 	return req.Write(oauthURLs)
 }
 
-func tempServerAndConfig(ctx context.Context, gptClient *gptscript.GPTScript, client client.Client, namespace, catalogName string, entryManifest types.MCPServerCatalogEntryManifest, config map[string]string, url, baseURL string) (v1.MCPServer, mcp.ServerConfig, error) {
+// TempServerAndConfig returns a temporary MCP server for a catalog entry manifest, and the config to deploy it with,
+// such as to list its tools.
+func TempServerAndConfig(ctx context.Context, gptClient *gptscript.GPTScript, client client.Client, namespace, catalogName string, entryManifest types.MCPServerCatalogEntryManifest, config map[string]string, url, baseURL string) (v1.MCPServer, mcp.ServerConfig, error) {
 	// Convert catalog entry to server manifest
 	serverManifest, err := types.MapCatalogEntryToServer(entryManifest, url, false)
 	if err != nil {
@@ -1419,14 +1510,8 @@ func (h *MCPCatalogHandler) ListCategoriesForCatalog(req api.Context) error {
 	// Collect unique categories
 	categoriesSet := make(map[string]struct{})
 	for _, entry := range list.Items {
-		if categories := entry.Spec.Manifest.Metadata["categories"]; categories != "" {
-			// Handle both comma-separated and single categories
-			for category := range strings.SplitSeq(categories, ",") {
-				trimmed := strings.TrimSpace(category)
-				if trimmed != "" {
-					categoriesSet[trimmed] = struct{}{}
-				}
-			}
+		for _, category := range entry.Spec.Manifest.Categories() {
+			categoriesSet[category] = struct{}{}
 		}
 	}
 
@@ -1460,10 +1545,13 @@ func convertMCPCatalog(catalog v1.MCPCatalog, tokenEnv map[string]string) types.
 			DisplayName:          catalog.Spec.DisplayName,
 			SourceURLs:           catalog.Spec.SourceURLs,
 			SourceURLCredentials: maskCatalogCredentials(catalog.Spec.SourceURLs, tokenEnv),
+			ExternalURL:          catalog.Spec.ExternalURL,
+			DefaultToolSelection: catalog.Spec.DefaultToolSelection,
 		},
-		LastSynced: *types.NewTime(catalog.Status.LastSyncTime.Time),
-		SyncErrors: catalog.Status.SyncErrors,
-		IsSyncing:  catalog.Status.IsSyncing || catalog.Annotations[v1.MCPCatalogSyncAnnotation] == "true",
+		LastSynced:    *types.NewTime(catalog.Status.LastSyncTime.Time),
+		SyncErrors:    catalog.Status.SyncErrors,
+		IsSyncing:     catalog.Status.IsSyncing || catalog.Annotations[v1.MCPCatalogSyncAnnotation] == "true",
+		SyncConflicts: catalog.Status.SyncConflicts,
 	}
 }
 
@@ -1589,6 +1677,10 @@ func convertServerManifestToCatalogManifest(serverManifest types.MCPServerManife
 		catalogManifest.NPXConfig = serverManifest.NPXConfig
 	case types.RuntimeContainerized:
 		catalogManifest.ContainerizedConfig = serverManifest.ContainerizedConfig
+	case types.RuntimeStdio:
+		catalogManifest.StdioConfig = serverManifest.StdioConfig
+	case types.RuntimeSource:
+		catalogManifest.SourceConfig = serverManifest.SourceConfig
 	case types.RuntimeRemote:
 		if serverManifest.RemoteConfig != nil {
 			catalogManifest.RemoteConfig = &types.RemoteCatalogConfig{
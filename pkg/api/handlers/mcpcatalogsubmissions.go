@@ -0,0 +1,568 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/server/requestinfo"
+	mcpcataloghandler "github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	submissionPortalTokenEnvKey       = "token"
+	submissionPortalSandboxTestEnvKey = "sandboxTest"
+
+	submissionTokenHeader       = "X-Obot-Submission-Token"
+	submissionStatusTokenHeader = "X-Obot-Submission-Status-Token"
+
+	submissionSandboxToolName     = "submission-sandbox"
+	submissionSandboxConfigEnvKey = "config"
+	submissionSandboxURLEnvKey    = "url"
+
+	// submissionsPerHour is the number of entries that can be submitted through submission portals from each IP
+	// address per hour.
+	submissionsPerHour = 10
+	// submissionChecksTimeout limits how long the checks of a submitted entry run.
+	submissionChecksTimeout = 15 * time.Minute
+)
+
+var (
+	// internalHostSuffixes are the suffixes of hosts that are only reachable from inside a cluster or network.
+	internalHostSuffixes = []string{".localhost", ".local", ".internal", ".svc", ".cluster.local"}
+	// sharedAddressSpace is the carrier-grade NAT range, which isn't reachable from the internet either.
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+)
+
+// GetSubmissionPortal returns the submission portal of a catalog, through which vendors without an Obot account submit
+// catalog entries for review.
+func (h *MCPCatalogHandler) GetSubmissionPortal(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	portal, err := catalogSubmissionPortal(req, catalog.Name)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(h.convertSubmissionPortal(catalog.Name, portal))
+}
+
+// ConfigureSubmissionPortal enables the submission portal of a catalog, or changes its configuration. A token is
+// generated when the portal starts requiring one, and is kept until the portal stops requiring one or is disabled.
+func (h *MCPCatalogHandler) ConfigureSubmissionPortal(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	var manifest types.MCPCatalogSubmissionPortalManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read submission portal: %v", err)
+	}
+
+	portal, err := catalogSubmissionPortal(req, catalog.Name)
+	if err != nil {
+		return err
+	}
+
+	portal.Enabled = true
+	portal.MCPCatalogSubmissionPortalManifest = manifest
+	if !manifest.RequireToken {
+		portal.Token = ""
+	} else if portal.Token == "" {
+		portal.Token = rand.Text()
+	}
+
+	if err = req.GPTClient.DeleteCredential(req.Context(), catalog.Name, mcpcataloghandler.CatalogSubmissionPortalToolName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to delete previous submission portal: %w", err)
+	}
+	if err = req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+		Context:  catalog.Name,
+		ToolName: mcpcataloghandler.CatalogSubmissionPortalToolName,
+		Type:     gptscript.CredentialTypeTool,
+		Env: map[string]string{
+			submissionPortalTokenEnvKey:       portal.Token,
+			submissionPortalSandboxTestEnvKey: strconv.FormatBool(manifest.SandboxTest),
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to store submission portal: %w", err)
+	}
+
+	return req.Write(h.convertSubmissionPortal(catalog.Name, portal))
+}
+
+// DeleteSubmissionPortal disables the submission portal of a catalog. Entries that were already submitted stay in the
+// review queue.
+func (h *MCPCatalogHandler) DeleteSubmissionPortal(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	if err := req.GPTClient.DeleteCredential(req.Context(), catalog.Name, mcpcataloghandler.CatalogSubmissionPortalToolName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to delete submission portal: %w", err)
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// SubmitThroughPortal submits a catalog entry through the submission portal of a catalog. It is called by vendors
+// without an Obot account. The entry is linted, and rejected if it has errors. Otherwise, the entry lands in the review
+// queue of the admins. Nothing is deployed or fetched for the entry until an admin triages it with
+// RunPendingEntryChecks.
+func (h *MCPCatalogHandler) SubmitThroughPortal(req api.Context) error {
+	catalogName := req.PathValue("catalog_id")
+	portal, err := catalogSubmissionPortal(req, catalogName)
+	if err != nil {
+		return err
+	}
+	if !portal.Enabled {
+		return types.NewErrNotFound("submission portal not found")
+	}
+
+	// Limit submissions before checking the token, so that the token can't be guessed quickly either.
+	_, _, _, ok, err := h.submissionLimiter.Take(req.Context(), requestinfo.ClientIP(req.Request, h.trustedProxies))
+	if err != nil {
+		return fmt.Errorf("failed to take rate limit tokens: %w", err)
+	}
+	if !ok {
+		return types.NewErrHTTP(http.StatusTooManyRequests, "too many submissions, please try again later")
+	}
+
+	if portal.Token != "" && subtle.ConstantTimeCompare([]byte(req.Request.Header.Get(submissionTokenHeader)), []byte(portal.Token)) != 1 {
+		return types.NewErrHTTP(http.StatusUnauthorized, "invalid submission token")
+	}
+
+	var submission types.MCPCatalogSubmissionRequest
+	if err = req.Read(&submission); err != nil {
+		return types.NewErrBadRequest("failed to read submission: %v", err)
+	}
+	if err = validateSubmissionVendor(submission.Vendor); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	manifest := submission.Manifest
+	if err = validateSubmissionRuntime(manifest); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+	// Tool previews are generated by the sandbox test, and from the servers of the entry once it is published.
+	manifest.ToolPreview = nil
+
+	lintErrors, lintWarnings := lintCatalogEntryManifest(manifest)
+	if len(lintErrors) > 0 {
+		return req.WriteCode(types.MCPServerCatalogEntryValidationResult{
+			Errors:   lintErrors,
+			Warnings: lintWarnings,
+		}, http.StatusUnprocessableEntity)
+	}
+	if err = validateSubmissionURLs(req.Context(), manifest, submission.SandboxConfig, submission.SandboxURL); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	checks := types.PendingCatalogEntryChecks{
+		LintWarnings: lintWarnings,
+		ImageScan:    pendingSubmissionImageScan(manifest),
+		SandboxTest: types.PendingCatalogEntryCheck{
+			State:   types.PendingCatalogEntryCheckStateSkipped,
+			Message: "sandbox testing is disabled for this catalog",
+		},
+	}
+	if portal.SandboxTest {
+		checks.SandboxTest = types.PendingCatalogEntryCheck{
+			State:   types.PendingCatalogEntryCheckStatePending,
+			Message: "the sandbox test runs once an admin triages the submission",
+		}
+	}
+
+	statusToken := rand.Text()
+	pending := v1.PendingCatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.PendingCatalogEntryPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.PendingCatalogEntrySpec{
+			Manifest: types.PendingCatalogEntryManifest{
+				CatalogID: catalogName,
+				Manifest:  manifest,
+				Message:   submission.Message,
+			},
+			Vendor:          &submission.Vendor,
+			StatusTokenHash: hashSubmissionStatusToken(statusToken),
+		},
+	}
+	if err = req.Create(&pending); err != nil {
+		return fmt.Errorf("failed to create pending catalog entry: %w", err)
+	}
+
+	if portal.SandboxTest && (len(submission.SandboxConfig) > 0 || submission.SandboxURL != "") {
+		// The configuration may hold the vendor's secrets, so it is kept in a credential until the sandbox test runs.
+		config, err := json.Marshal(submission.SandboxConfig)
+		if err != nil {
+			return fmt.Errorf("failed to encode sandbox configuration: %w", err)
+		}
+		if err = req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+			Context:  pending.Name,
+			ToolName: submissionSandboxToolName,
+			Type:     gptscript.CredentialTypeTool,
+			Env: map[string]string{
+				submissionSandboxConfigEnvKey: string(config),
+				submissionSandboxURLEnvKey:    submission.SandboxURL,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to store sandbox configuration: %w", err)
+		}
+	}
+
+	pending.Status.Checks = &checks
+	if err = req.Storage.Status().Update(req.Context(), &pending); err != nil {
+		return fmt.Errorf("failed to record checks of pending catalog entry: %w", err)
+	}
+
+	log.Infof("Catalog entry submitted through submission portal: catalog=%s pendingEntry=%s vendor=%s", catalogName, pending.Name, submission.Vendor.Name)
+
+	return req.WriteCode(convertCatalogSubmission(pending, statusToken), http.StatusCreated)
+}
+
+// RunPendingEntryChecks triages an entry submitted through the submission portal of a catalog. The image of the entry
+// is scanned and the entry is tested in a sandbox in the background, and the results are recorded with the entry.
+// Checks that seem to be stuck, because the replica that ran them stopped, can be started again.
+func (h *MCPCatalogHandler) RunPendingEntryChecks(req api.Context) error {
+	pending, err := getPendingCatalogEntry(req)
+	if err != nil {
+		return err
+	}
+	if pending.Spec.Vendor == nil || pending.Status.Checks == nil {
+		return types.NewErrBadRequest("only entries submitted through a submission portal have checks")
+	}
+	if pending.Status.State != "" {
+		return types.NewErrBadRequest("pending catalog entry was already %s", pending.Status.State)
+	}
+
+	checks := pending.Status.Checks
+	running := checks.ImageScan.State == types.PendingCatalogEntryCheckStateRunning || checks.SandboxTest.State == types.PendingCatalogEntryCheckStateRunning
+	if running && checks.TriagedAt != nil && time.Since(checks.TriagedAt.Time) < submissionChecksTimeout {
+		return types.NewErrHTTP(http.StatusConflict, "the checks of the entry are already running")
+	}
+
+	portal, err := catalogSubmissionPortal(req, pending.Spec.Manifest.CatalogID)
+	if err != nil {
+		return err
+	}
+
+	checks.ImageScan = pendingSubmissionImageScan(pending.Spec.Manifest.Manifest)
+	if checks.ImageScan.State == types.PendingCatalogEntryCheckStatePending {
+		checks.ImageScan = types.PendingCatalogEntryCheck{State: types.PendingCatalogEntryCheckStateRunning}
+	}
+	if portal.SandboxTest {
+		checks.SandboxTest = types.PendingCatalogEntryCheck{State: types.PendingCatalogEntryCheckStateRunning}
+		checks.Tools = nil
+	}
+	checks.TriagedAt = types.NewTime(time.Now())
+
+	// The update fails if another admin started the checks at the same time.
+	if err = req.Storage.Status().Update(req.Context(), &pending); err != nil {
+		return fmt.Errorf("failed to start checks of pending catalog entry: %w", err)
+	}
+
+	go h.runSubmissionChecks(req, pending)
+
+	return req.WriteCode(convertPendingCatalogEntry(pending), http.StatusAccepted)
+}
+
+// runSubmissionChecks runs the checks of a submitted entry that RunPendingEntryChecks started, and records their
+// results with the entry.
+func (h *MCPCatalogHandler) runSubmissionChecks(req api.Context, pending v1.PendingCatalogEntry) {
+	// The checks outlive the request that started them.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(req.Context()), submissionChecksTimeout)
+	defer cancel()
+	req.Request = req.Request.WithContext(ctx)
+
+	checks := *pending.Status.Checks.DeepCopy()
+	manifest := pending.Spec.Manifest.Manifest
+	if checks.ImageScan.State == types.PendingCatalogEntryCheckStateRunning {
+		checks.ImageScan = h.scanSubmissionImage(ctx, manifest)
+	}
+	if checks.SandboxTest.State == types.PendingCatalogEntryCheckStateRunning {
+		checks.SandboxTest, checks.Tools = h.sandboxTestSubmission(req, pending)
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest v1.PendingCatalogEntry
+		if err := req.Storage.Get(ctx, kclient.ObjectKeyFromObject(&pending), &latest); err != nil {
+			return err
+		}
+		latest.Status.Checks = &checks
+		return req.Storage.Status().Update(ctx, &latest)
+	}); err != nil {
+		log.Errorf("Failed to record checks of pending catalog entry %s: %v", pending.Name, err)
+	}
+}
+
+// sandboxTestSubmission deploys a temporary server from a submitted entry and lists its tools, with the configuration
+// that the vendor submitted the entry with.
+func (h *MCPCatalogHandler) sandboxTestSubmission(req api.Context, pending v1.PendingCatalogEntry) (types.PendingCatalogEntryCheck, []types.MCPServerTool) {
+	failed := func(err error) (types.PendingCatalogEntryCheck, []types.MCPServerTool) {
+		return types.PendingCatalogEntryCheck{
+			State:   types.PendingCatalogEntryCheckStateFailed,
+			Message: validationIssue("", err).Message,
+		}, nil
+	}
+
+	var (
+		config     map[string]string
+		sandboxURL string
+	)
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{pending.Name}, submissionSandboxToolName)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return failed(fmt.Errorf("failed to get sandbox configuration: %w", err))
+	} else if err == nil {
+		if data := cred.Env[submissionSandboxConfigEnvKey]; data != "" {
+			if err = json.Unmarshal([]byte(data), &config); err != nil {
+				return failed(fmt.Errorf("failed to decode sandbox configuration: %w", err))
+			}
+		}
+		sandboxURL = cred.Env[submissionSandboxURLEnvKey]
+	}
+
+	manifest := pending.Spec.Manifest.Manifest
+	// Entries submitted before these runtimes were rejected must not be run either.
+	if err = validateSubmissionRuntime(manifest); err != nil {
+		return failed(err)
+	}
+	// Names can resolve to other addresses since the entry was submitted.
+	if err = validateSubmissionURLs(req.Context(), manifest, config, sandboxURL); err != nil {
+		return failed(err)
+	}
+
+	tools, err := h.toolPreviews(req, pending.Namespace, pending.Spec.Manifest.CatalogID, manifest, config, sandboxURL)
+	if err != nil {
+		return failed(err)
+	}
+	return types.PendingCatalogEntryCheck{State: types.PendingCatalogEntryCheckStatePassed}, tools
+}
+
+// GetPortalSubmission returns the status of an entry submitted through the submission portal of a catalog. Vendors
+// authenticate with the status token that they got when they submitted the entry.
+func (h *MCPCatalogHandler) GetPortalSubmission(req api.Context) error {
+	var pending v1.PendingCatalogEntry
+	if err := req.Get(&pending, req.PathValue("submission_id")); err != nil {
+		return err
+	}
+
+	// Don't reveal whether the submission exists without its token.
+	token := req.Request.Header.Get(submissionStatusTokenHeader)
+	if pending.Spec.Manifest.CatalogID != req.PathValue("catalog_id") || pending.Spec.StatusTokenHash == "" || token == "" ||
+		subtle.ConstantTimeCompare([]byte(hashSubmissionStatusToken(token)), []byte(pending.Spec.StatusTokenHash)) != 1 {
+		return types.NewErrNotFound("submission not found")
+	}
+
+	return req.Write(convertCatalogSubmission(pending, ""))
+}
+
+// pendingSubmissionImageScan returns the image scan of a submitted entry before an admin triages it. Only the images of
+// containerized entries are scanned.
+func pendingSubmissionImageScan(manifest types.MCPServerCatalogEntryManifest) types.PendingCatalogEntryCheck {
+	if manifest.Runtime != types.RuntimeContainerized || manifest.ContainerizedConfig == nil {
+		return types.PendingCatalogEntryCheck{
+			State:   types.PendingCatalogEntryCheckStateSkipped,
+			Message: "only the images of containerized entries are scanned",
+		}
+	}
+	return types.PendingCatalogEntryCheck{
+		State:   types.PendingCatalogEntryCheckStatePending,
+		Message: "the image is scanned once an admin triages the submission",
+	}
+}
+
+// scanSubmissionImage scans the image of a containerized entry for vulnerabilities.
+func (h *MCPCatalogHandler) scanSubmissionImage(ctx context.Context, manifest types.MCPServerCatalogEntryManifest) types.PendingCatalogEntryCheck {
+	output, err := h.sessionManager.ScanImage(ctx, manifest.ContainerizedConfig.Image)
+	switch {
+	case errors.Is(err, mcp.ErrImageScanNotConfigured):
+		return types.PendingCatalogEntryCheck{State: types.PendingCatalogEntryCheckStateSkipped, Message: err.Error()}
+	case err != nil:
+		return types.PendingCatalogEntryCheck{State: types.PendingCatalogEntryCheckStateFailed, Message: err.Error(), Output: output}
+	}
+	return types.PendingCatalogEntryCheck{State: types.PendingCatalogEntryCheckStatePassed, Output: output}
+}
+
+// validateSubmissionRuntime rejects the runtimes that can't be submitted through a submission portal. Stdio and
+// source entries run commands chosen by the vendor on Obot's host or build their images from it, and the components of
+// composite entries are other entries and servers of Obot, which vendors can't see.
+func validateSubmissionRuntime(manifest types.MCPServerCatalogEntryManifest) error {
+	switch manifest.Runtime {
+	case types.RuntimeComposite, types.RuntimeStdio, types.RuntimeSource:
+		return fmt.Errorf("%s entries can't be submitted through the submission portal", manifest.Runtime)
+	}
+	return nil
+}
+
+// validateSubmissionURLs checks that the URLs of a submitted remote entry, and the URL that it is tested in the sandbox
+// with, are public. Otherwise, vendors could make Obot connect to its own network.
+func validateSubmissionURLs(ctx context.Context, manifest types.MCPServerCatalogEntryManifest, sandboxConfig map[string]string, sandboxURL string) error {
+	urls := []string{sandboxURL}
+	if remote := manifest.RemoteConfig; remote != nil {
+		urls = append(urls, remote.FixedURL)
+		if remote.URLTemplate != "" {
+			u, err := applyURLTemplate(remote.URLTemplate, sandboxConfig)
+			if err != nil {
+				return fmt.Errorf("failed to apply URL template: %w", err)
+			}
+			urls = append(urls, u)
+		}
+		if remote.Hostname != "" {
+			if err := validatePublicHost(ctx, strings.TrimPrefix(remote.Hostname, "*.")); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rawURL := range urls {
+		if rawURL == "" {
+			continue
+		}
+		if !strings.HasPrefix(rawURL, "http") {
+			rawURL = "https://" + rawURL
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil || u.Hostname() == "" {
+			return fmt.Errorf("URL %q is invalid", rawURL)
+		}
+		if err = validatePublicHost(ctx, u.Hostname()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePublicHost checks that a host isn't cluster-internal and only resolves to public addresses.
+func validatePublicHost(ctx context.Context, host string) error {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if ip := net.ParseIP(host); ip == nil {
+		if !strings.Contains(host, ".") || slices.ContainsFunc(internalHostSuffixes, func(suffix string) bool {
+			return host == strings.TrimPrefix(suffix, ".") || strings.HasSuffix(host, suffix)
+		}) {
+			return fmt.Errorf("host %q is internal", host)
+		}
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve host %q: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if ip := addr.IP; ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+			ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip) {
+			return fmt.Errorf("host %q resolves to the non-public address %s", host, ip)
+		}
+	}
+	return nil
+}
+
+// deleteSubmissionSandboxConfig deletes the sandbox configuration that a vendor submitted an entry with, if any.
+func deleteSubmissionSandboxConfig(req api.Context, pending v1.PendingCatalogEntry) error {
+	if pending.Spec.Vendor == nil {
+		return nil
+	}
+	if err := req.GPTClient.DeleteCredential(req.Context(), pending.Name, submissionSandboxToolName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to delete sandbox configuration: %w", err)
+	}
+	return nil
+}
+
+func (h *MCPCatalogHandler) convertSubmissionPortal(catalogName string, portal types.MCPCatalogSubmissionPortal) types.MCPCatalogSubmissionPortal {
+	portal.URL = fmt.Sprintf("%s/api/mcp-catalog-submissions/%s", h.serverURL, catalogName)
+	return portal
+}
+
+// catalogSubmissionPortal returns the submission portal of a catalog, which isn't enabled if it has no credential.
+func catalogSubmissionPortal(req api.Context, catalogName string) (types.MCPCatalogSubmissionPortal, error) {
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{catalogName}, mcpcataloghandler.CatalogSubmissionPortalToolName)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return types.MCPCatalogSubmissionPortal{}, nil
+	} else if err != nil {
+		return types.MCPCatalogSubmissionPortal{}, fmt.Errorf("failed to get submission portal: %w", err)
+	}
+
+	token := cred.Env[submissionPortalTokenEnvKey]
+	sandboxTest, _ := strconv.ParseBool(cred.Env[submissionPortalSandboxTestEnvKey])
+	return types.MCPCatalogSubmissionPortal{
+		MCPCatalogSubmissionPortalManifest: types.MCPCatalogSubmissionPortalManifest{
+			RequireToken: token != "",
+			SandboxTest:  sandboxTest,
+		},
+		Enabled: true,
+		Token:   token,
+	}, nil
+}
+
+// validateSubmissionVendor checks that the vendor of a submission can be contacted by the reviewers.
+func validateSubmissionVendor(vendor types.PendingCatalogEntryVendor) error {
+	if strings.TrimSpace(vendor.Name) == "" {
+		return fmt.Errorf("vendor name is required")
+	}
+	if addr, err := mail.ParseAddress(vendor.Email); err != nil || addr.Address != vendor.Email {
+		return fmt.Errorf("vendor email %q is invalid", vendor.Email)
+	}
+	if vendor.URL != "" {
+		if u, err := url.Parse(vendor.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("vendor URL %q must be an HTTP or HTTPS URL", vendor.URL)
+		}
+	}
+	return nil
+}
+
+func hashSubmissionStatusToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// convertCatalogSubmission converts a pending catalog entry to the status that its vendor sees, without the IDs of the
+// reviewers.
+func convertCatalogSubmission(pending v1.PendingCatalogEntry, statusToken string) types.MCPCatalogSubmission {
+	result := types.MCPCatalogSubmission{
+		ID:          pending.Name,
+		CatalogID:   pending.Spec.Manifest.CatalogID,
+		Created:     *types.NewTime(pending.CreationTimestamp.Time),
+		State:       pending.Status.State,
+		StatusToken: statusToken,
+	}
+	if result.State == "" {
+		result.State = types.PendingCatalogEntryStatePending
+	}
+	if pending.Status.Checks != nil {
+		result.Checks = *pending.Status.Checks
+	}
+	for _, comment := range pending.Status.Comments {
+		comment.UserID = ""
+		result.Comments = append(result.Comments, comment)
+	}
+	return result
+}
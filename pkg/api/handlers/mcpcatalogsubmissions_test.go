@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidateSubmissionVendor(t *testing.T) {
+	tests := []struct {
+		name    string
+		vendor  types.PendingCatalogEntryVendor
+		wantErr bool
+	}{
+		{name: "valid", vendor: types.PendingCatalogEntryVendor{Name: "Acme", Email: "mcp@acme.example", URL: "https://acme.example"}},
+		{name: "missing name", vendor: types.PendingCatalogEntryVendor{Email: "mcp@acme.example"}, wantErr: true},
+		{name: "invalid email", vendor: types.PendingCatalogEntryVendor{Name: "Acme", Email: "acme"}, wantErr: true},
+		{name: "email with display name", vendor: types.PendingCatalogEntryVendor{Name: "Acme", Email: "Acme <mcp@acme.example>"}, wantErr: true},
+		{name: "non-HTTP URL", vendor: types.PendingCatalogEntryVendor{Name: "Acme", Email: "mcp@acme.example", URL: "ftp://acme.example"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSubmissionVendor(tt.vendor); (err != nil) != tt.wantErr {
+				t.Errorf("validateSubmissionVendor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSubmissionRuntime(t *testing.T) {
+	for runtime, wantErr := range map[types.Runtime]bool{
+		types.RuntimeRemote:        false,
+		types.RuntimeContainerized: false,
+		types.RuntimeUVX:           false,
+		types.RuntimeStdio:         true,
+		types.RuntimeSource:        true,
+		types.RuntimeComposite:     true,
+	} {
+		t.Run(string(runtime), func(t *testing.T) {
+			if err := validateSubmissionRuntime(types.MCPServerCatalogEntryManifest{Runtime: runtime}); (err != nil) != wantErr {
+				t.Errorf("validateSubmissionRuntime() error = %v, wantErr %v", err, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSubmissionURLs(t *testing.T) {
+	remote := func(config types.RemoteCatalogConfig) types.MCPServerCatalogEntryManifest {
+		return types.MCPServerCatalogEntryManifest{Runtime: types.RuntimeRemote, RemoteConfig: &config}
+	}
+
+	tests := []struct {
+		name          string
+		manifest      types.MCPServerCatalogEntryManifest
+		sandboxConfig map[string]string
+		sandboxURL    string
+		wantErr       bool
+	}{
+		{name: "public fixed URL", manifest: remote(types.RemoteCatalogConfig{FixedURL: "https://1.1.1.1/mcp"})},
+		{name: "loopback", manifest: remote(types.RemoteCatalogConfig{FixedURL: "http://127.0.0.1:8080/mcp"}), wantErr: true},
+		{name: "private", manifest: remote(types.RemoteCatalogConfig{FixedURL: "10.0.0.5/mcp"}), wantErr: true},
+		{name: "link-local", manifest: remote(types.RemoteCatalogConfig{FixedURL: "http://169.254.169.254/latest"}), wantErr: true},
+		{name: "cluster service", manifest: remote(types.RemoteCatalogConfig{FixedURL: "http://obot.obot-system.svc.cluster.local"}), wantErr: true},
+		{name: "short name", manifest: remote(types.RemoteCatalogConfig{FixedURL: "http://kubernetes/mcp"}), wantErr: true},
+		{name: "internal hostname constraint", manifest: remote(types.RemoteCatalogConfig{Hostname: "*.internal"}), wantErr: true},
+		{
+			name:          "URL template",
+			manifest:      remote(types.RemoteCatalogConfig{URLTemplate: "http://${HOST}/mcp"}),
+			sandboxConfig: map[string]string{"HOST": "192.168.1.10"},
+			wantErr:       true,
+		},
+		{name: "sandbox URL", manifest: remote(types.RemoteCatalogConfig{Hostname: "1.1.1.1"}), sandboxURL: "http://[::1]/mcp", wantErr: true},
+		{name: "not remote", manifest: types.MCPServerCatalogEntryManifest{Runtime: types.RuntimeNPX}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSubmissionURLs(t.Context(), tt.manifest, tt.sandboxConfig, tt.sandboxURL); (err != nil) != tt.wantErr {
+				t.Errorf("validateSubmissionURLs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPortalSubmission(t *testing.T) {
+	storage := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithStatusSubresource(&v1.PendingCatalogEntry{}).
+		WithObjects(&v1.PendingCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "pce1-test", Namespace: system.DefaultNamespace},
+			Spec: v1.PendingCatalogEntrySpec{
+				Manifest:        types.PendingCatalogEntryManifest{CatalogID: system.DefaultCatalog},
+				Vendor:          &types.PendingCatalogEntryVendor{Name: "Acme", Email: "mcp@acme.example"},
+				StatusTokenHash: hashSubmissionStatusToken("status-token"),
+			},
+			Status: v1.PendingCatalogEntryStatus{
+				State: types.PendingCatalogEntryStateRejected,
+				Comments: []types.PendingCatalogEntryComment{
+					{UserID: "admin", Message: "please pin the image"},
+				},
+			},
+		}).
+		Build()
+
+	get := func(catalogID, token string) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/mcp-catalog-submissions/"+catalogID+"/pce1-test", nil)
+		req.SetPathValue("catalog_id", catalogID)
+		req.SetPathValue("submission_id", "pce1-test")
+		if token != "" {
+			req.Header.Set(submissionStatusTokenHeader, token)
+		}
+		return rec, (&MCPCatalogHandler{}).GetPortalSubmission(api.Context{
+			ResponseWriter: rec,
+			Request:        req,
+			Storage:        storage,
+			User:           &kuser.DefaultInfo{Name: "anonymous"},
+		})
+	}
+
+	for name, tt := range map[string]struct{ catalogID, token string }{
+		"no token":      {catalogID: system.DefaultCatalog},
+		"wrong token":   {catalogID: system.DefaultCatalog, token: "wrong"},
+		"wrong catalog": {catalogID: "other", token: "status-token"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := get(tt.catalogID, tt.token); !types.IsNotFound(err) {
+				t.Errorf("GetPortalSubmission() error = %v, want not found", err)
+			}
+		})
+	}
+
+	rec, err := get(system.DefaultCatalog, "status-token")
+	if err != nil {
+		t.Fatalf("GetPortalSubmission() error = %v", err)
+	}
+
+	var submission types.MCPCatalogSubmission
+	if err = json.Unmarshal(rec.Body.Bytes(), &submission); err != nil {
+		t.Fatalf("failed to decode submission: %v", err)
+	}
+	if submission.State != types.PendingCatalogEntryStateRejected || submission.StatusToken != "" {
+		t.Errorf("unexpected submission: %+v", submission)
+	}
+	if len(submission.Comments) != 1 || submission.Comments[0].UserID != "" || submission.Comments[0].Message != "please pin the image" {
+		t.Errorf("unexpected comments: %+v", submission.Comments)
+	}
+}
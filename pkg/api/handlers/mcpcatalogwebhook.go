@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	mcpcataloghandler "github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+const catalogWebhookSecretEnvKey = "secret"
+
+// GetWebhook returns the webhook that syncs a catalog when its Git sources are pushed to.
+func (h *MCPCatalogHandler) GetWebhook(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	secret, err := catalogWebhookSecret(req, catalog.Name)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.MCPCatalogWebhook{
+		URL:        h.catalogWebhookURL(catalog.Name),
+		Configured: secret != "",
+	})
+}
+
+// GenerateWebhookSecret generates a new secret for the webhook of a catalog, replacing the previous one. The secret is
+// only returned by this call.
+func (h *MCPCatalogHandler) GenerateWebhookSecret(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	if err := req.GPTClient.DeleteCredential(req.Context(), catalog.Name, mcpcataloghandler.CatalogWebhookSecretToolName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to delete previous webhook secret: %w", err)
+	}
+
+	secret := rand.Text()
+	if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+		Context:  catalog.Name,
+		ToolName: mcpcataloghandler.CatalogWebhookSecretToolName,
+		Type:     gptscript.CredentialTypeTool,
+		Env:      map[string]string{catalogWebhookSecretEnvKey: secret},
+	}); err != nil {
+		return fmt.Errorf("failed to store webhook secret: %w", err)
+	}
+
+	return req.Write(types.MCPCatalogWebhook{
+		URL:        h.catalogWebhookURL(catalog.Name),
+		Configured: true,
+		Secret:     secret,
+	})
+}
+
+// DeleteWebhookSecret deletes the secret of the webhook of a catalog, which disables the webhook.
+func (h *MCPCatalogHandler) DeleteWebhookSecret(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return err
+	}
+
+	if err := req.GPTClient.DeleteCredential(req.Context(), catalog.Name, mcpcataloghandler.CatalogWebhookSecretToolName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to delete webhook secret: %w", err)
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// ReceiveWebhook syncs a catalog when one of its Git sources is pushed to. It is called by Git hosting platforms,
+// which authenticate with the secret of the webhook: GitHub and Gitea sign the payload with it, and GitLab sends it as
+// a token.
+func (h *MCPCatalogHandler) ReceiveWebhook(req api.Context) error {
+	catalogName := req.PathValue("catalog_id")
+	secret, err := catalogWebhookSecret(req, catalogName)
+	if err != nil {
+		return err
+	}
+	if secret == "" {
+		return types.NewErrNotFound("webhook not found")
+	}
+
+	body, err := req.Body()
+	if err != nil {
+		return err
+	}
+	if !validCatalogWebhookRequest(req.Request, body, secret) {
+		return types.NewErrHTTP(http.StatusUnauthorized, "invalid webhook signature")
+	}
+
+	switch event := catalogWebhookEvent(req.Request); event {
+	case "push", "Push Hook", "Tag Push Hook":
+	default:
+		// Pings and other events don't change the catalog.
+		log.Debugf("Ignoring catalog webhook event: catalog=%s event=%s", catalogName, event)
+		req.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+
+	var catalog v1.MCPCatalog
+	if err = req.Get(&catalog, catalogName); err != nil {
+		return err
+	}
+
+	if catalog.Annotations == nil {
+		catalog.Annotations = make(map[string]string)
+	}
+	catalog.Annotations[v1.MCPCatalogSyncAnnotation] = "true"
+	if err = req.Update(&catalog); err != nil {
+		return fmt.Errorf("failed to sync catalog: %w", err)
+	}
+
+	log.Infof("Syncing MCP catalog for webhook push: catalog=%s", catalogName)
+	req.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+func (h *MCPCatalogHandler) catalogWebhookURL(catalogName string) string {
+	return fmt.Sprintf("%s/api/mcp-catalog-webhooks/%s", h.serverURL, catalogName)
+}
+
+func catalogWebhookSecret(req api.Context, catalogName string) (string, error) {
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{catalogName}, mcpcataloghandler.CatalogWebhookSecretToolName)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get webhook secret: %w", err)
+	}
+	return cred.Env[catalogWebhookSecretEnvKey], nil
+}
+
+func catalogWebhookEvent(r *http.Request) string {
+	for _, header := range []string{"X-GitHub-Event", "X-Gitea-Event", "X-Gitlab-Event"} {
+		if event := r.Header.Get(header); event != "" {
+			return event
+		}
+	}
+	return ""
+}
+
+// validCatalogWebhookRequest returns true if the request is signed with the secret, as GitHub and Gitea do, or
+// includes the secret as a token, as GitLab does.
+func validCatalogWebhookRequest(r *http.Request, body []byte, secret string) bool {
+	if token := r.Header.Get("X-Gitlab-Token"); token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+	}
+
+	signature, ok := strings.CutPrefix(r.Header.Get("X-Hub-Signature-256"), "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
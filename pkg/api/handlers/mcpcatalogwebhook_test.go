@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidCatalogWebhookRequest(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{name: "github signature", headers: map[string]string{"X-Hub-Signature-256": signature}, want: true},
+		{name: "wrong signature", headers: map[string]string{"X-Hub-Signature-256": "sha256=" + hex.EncodeToString([]byte("wrong"))}},
+		{name: "malformed signature", headers: map[string]string{"X-Hub-Signature-256": "sha1=abc"}},
+		{name: "gitlab token", headers: map[string]string{"X-Gitlab-Token": "secret"}, want: true},
+		{name: "wrong gitlab token", headers: map[string]string{"X-Gitlab-Token": "wrong", "X-Hub-Signature-256": signature}},
+		{name: "unauthenticated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/api/mcp-catalog-webhooks/default", nil)
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+			if got := validCatalogWebhookRequest(r, body, "secret"); got != tt.want {
+				t.Errorf("validCatalogWebhookRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/mcp"
+)
+
+type MCPElicitationHandler struct {
+	mcpSessionManager *mcp.SessionManager
+}
+
+func NewMCPElicitationHandler(mcpSessionManager *mcp.SessionManager) *MCPElicitationHandler {
+	return &MCPElicitationHandler{
+		mcpSessionManager: mcpSessionManager,
+	}
+}
+
+// Events streams, as server-sent events, the elicitation requests from MCP servers that are waiting on the user.
+// Requests that are already pending are sent as soon as the stream starts.
+func (h *MCPElicitationHandler) Events(req api.Context) error {
+	elicitations := h.mcpSessionManager.SubscribeElicitations(req.Context(), req.User.GetUID())
+
+	req.ResponseWriter.Header().Set("Content-Type", "text/event-stream")
+	req.ResponseWriter.Header().Set("Cache-Control", "no-cache")
+	req.ResponseWriter.Header().Set("Connection", "keep-alive")
+	defer func() {
+		_ = req.WriteDataEvent(api.EventClose{})
+	}()
+
+	if _, err := req.ResponseWriter.Write([]byte("event: start\ndata: {}\n\n")); err != nil {
+		return err
+	}
+	req.Flush()
+
+	keepAlive := time.NewTicker(30 * time.Second)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case elicitation, ok := <-elicitations:
+			if !ok {
+				return nil
+			}
+			if err := req.WriteDataEvent(elicitation); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := req.ResponseWriter.Write([]byte(": keep-alive\n\n")); err != nil {
+				return err
+			}
+			req.Flush()
+		}
+	}
+}
+
+// Respond resumes the tool call waiting on an elicitation with the user's response.
+func (h *MCPElicitationHandler) Respond(req api.Context) error {
+	var response types.MCPElicitationResponse
+	if err := req.Read(&response); err != nil {
+		return types.NewErrBadRequest("failed to read elicitation response: %v", err)
+	}
+
+	switch response.Action {
+	case types.MCPElicitationActionAccept, types.MCPElicitationActionDecline, types.MCPElicitationActionCancel:
+	default:
+		return types.NewErrBadRequest("invalid elicitation action %q", response.Action)
+	}
+
+	if err := h.mcpSessionManager.RespondToElicitation(req.User.GetUID(), req.PathValue("elicitation_id"), response); err != nil {
+		if errors.Is(err, mcp.ErrElicitationNotFound) {
+			return types.NewErrNotFound("elicitation %q not found", req.PathValue("elicitation_id"))
+		}
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -1,6 +1,7 @@
 package mcpgateway
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -13,10 +14,13 @@ import (
 	"github.com/gptscript-ai/gptscript/pkg/hash"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/handlers"
 	gateway "github.com/obot-platform/obot/pkg/gateway/client"
 	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/mcp"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/fields"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -27,28 +31,6 @@ func NewAuditLogHandler() *AuditLogHandler {
 	return &AuditLogHandler{}
 }
 
-// getOwnServerMCPIDs returns the MCP server IDs for servers that the user owns directly
-// (not through a workspace or catalog). These are servers where:
-// - Spec.UserID == user's ID
-// - Spec.MCPCatalogID == ""
-// - Spec.PowerUserWorkspaceID == ""
-func getOwnServerMCPIDs(req api.Context) ([]string, error) {
-	var mcpServers v1.MCPServerList
-	if err := req.List(&mcpServers, &kclient.ListOptions{
-		FieldSelector: fields.OneTermEqualSelector("spec.userID", req.User.GetUID()),
-	}); err != nil {
-		return nil, err
-	}
-
-	var mcpIDs []string
-	for _, server := range mcpServers.Items {
-		if server.Spec.MCPCatalogID == "" && server.Spec.PowerUserWorkspaceID == "" {
-			mcpIDs = append(mcpIDs, server.Name)
-		}
-	}
-	return mcpIDs, nil
-}
-
 // parseMultiValueParam parses query parameters that can have multiple values
 // Supports both comma-separated values in single parameter and repeated parameters
 func parseMultiValueParam(queryValues map[string][]string, key string) []string {
@@ -94,10 +76,13 @@ func parseAuditLogOpts(query url.Values) gateway.MCPAuditLogOptions {
 		CallType:                  parseMultiValueParam(query, "call_type"),
 		CallIdentifier:            parseMultiValueParam(query, "call_identifier"),
 		SessionID:                 parseMultiValueParam(query, "session_id"),
+		RunID:                     parseMultiValueParam(query, "run_id"),
+		ProjectID:                 parseMultiValueParam(query, "project_id"),
 		ClientName:                parseMultiValueParam(query, "client_name"),
 		ClientVersion:             parseMultiValueParam(query, "client_version"),
 		ResponseStatus:            parseMultiValueParam(query, "response_status"),
 		ClientIP:                  parseMultiValueParam(query, "client_ip"),
+		Result:                    query.Get("result"),
 		SortBy:                    query.Get("sort_by"),
 		SortOrder:                 query.Get("sort_order"),
 		Query:                     strings.TrimSpace(query.Get("query")),
@@ -160,12 +145,14 @@ func (h *AuditLogHandler) SubmitAuditLogs(req api.Context) error {
 	}
 
 	var (
-		mcpServerName  string
-		nanobotAgentID string
-		userID         string
+		mcpServerName      string
+		mcpServerNamespace = system.DefaultNamespace
+		nanobotAgentID     string
+		userID             string
 	)
 	if len(mcpServers.Items) == 1 {
 		mcpServerName = mcpServers.Items[0].Name
+		mcpServerNamespace = mcpServers.Items[0].Namespace
 		nanobotAgentID = mcpServers.Items[0].Spec.NanobotAgentID
 		userID = mcpServers.Items[0].Spec.UserID
 	} else {
@@ -187,6 +174,8 @@ func (h *AuditLogHandler) SubmitAuditLogs(req api.Context) error {
 		return types.NewErrBadRequest("failed to read input: %v", err)
 	}
 
+	// The tool costs are looked up once per catalog entry.
+	manifests := make(map[string]types.MCPServerCatalogEntryManifest)
 	for _, auditLog := range auditLogs {
 		if auditLog.MCPID == "" {
 			auditLog.MCPID = auditLog.Metadata["mcpID"]
@@ -211,6 +200,25 @@ func (h *AuditLogHandler) SubmitAuditLogs(req api.Context) error {
 		if auditLog.MCPServerDisplayName == "" {
 			auditLog.MCPServerDisplayName = auditLog.Metadata["mcpServerDisplayName"]
 		}
+		if auditLog.RunID == "" && auditLog.CallType == "tools/call" {
+			// Read the run before the request body is encrypted.
+			auditLog.RunID, auditLog.ProjectID = runFromRequest(auditLog.RequestBody)
+		}
+		if auditLog.CallType == "tools/call" && auditLog.MCPServerCatalogEntryName != "" {
+			manifest, ok := manifests[auditLog.MCPServerCatalogEntryName]
+			if !ok {
+				var entry v1.MCPServerCatalogEntry
+				if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: mcpServerNamespace, Name: auditLog.MCPServerCatalogEntryName}, &entry); err != nil && !apierrors.IsNotFound(err) {
+					return fmt.Errorf("failed to get catalog entry %s: %w", auditLog.MCPServerCatalogEntryName, err)
+				}
+				manifest = entry.Spec.Manifest
+				manifests[auditLog.MCPServerCatalogEntryName] = manifest
+			}
+			if cost, ok := manifest.ToolCost(auditLog.CallIdentifier); ok {
+				auditLog.EstimatedCostUSD = cost.USDPerCall
+				auditLog.EstimatedCredits = cost.Credits
+			}
+		}
 
 		req.GatewayClient.LogMCPAuditEntry(auditLog.MCPAuditLog)
 	}
@@ -218,6 +226,22 @@ func (h *AuditLogHandler) SubmitAuditLogs(req api.Context) error {
 	return nil
 }
 
+// runFromRequest returns the IDs of the agent run that made a tool call and of its project, which Obot sends in the
+// _meta of the call.
+func runFromRequest(body json.RawMessage) (runID, projectID string) {
+	var msg struct {
+		Params struct {
+			Meta map[string]any `json:"_meta"`
+		} `json:"params"`
+	}
+	if len(body) == 0 || json.Unmarshal(body, &msg) != nil {
+		return "", ""
+	}
+	runID, _ = msg.Params.Meta[mcp.RunIDMetaKey].(string)
+	projectID, _ = msg.Params.Meta[mcp.ProjectIDMetaKey].(string)
+	return runID, projectID
+}
+
 // ListAuditLogs handles GET /api/mcp-audit-logs and /api/mcp-audit-logs/{mcp_id}
 func (h *AuditLogHandler) ListAuditLogs(req api.Context) error {
 	query := req.URL.Query()
@@ -234,7 +258,7 @@ func (h *AuditLogHandler) ListAuditLogs(req api.Context) error {
 
 	// Apply scope filtering based on user role
 	if !req.UserIsAdmin() && !req.UserIsAuditor() {
-		ownServerMCPIDs, err := getOwnServerMCPIDs(req)
+		ownServerMCPIDs, err := handlers.OwnServerMCPIDs(req)
 		if err != nil {
 			return fmt.Errorf("failed to get own server MCPIDs: %w", err)
 		}
@@ -306,7 +330,7 @@ func (h *AuditLogHandler) GetAuditLog(req api.Context) error {
 
 	canAccessFullPayload := req.UserIsAuditor()
 	if !req.UserIsAuditor() {
-		ownServerMCPIDs, err := getOwnServerMCPIDs(req)
+		ownServerMCPIDs, err := handlers.OwnServerMCPIDs(req)
 		if err != nil {
 			return fmt.Errorf("failed to get own server MCPIDs: %w", err)
 		}
@@ -354,6 +378,8 @@ var filterOptions = map[string]any{
 	"call_type":                     "",
 	"call_identifier":               "",
 	"session_id":                    "",
+	"run_id":                        "",
+	"project_id":                    "",
 	"client_name":                   "",
 	"client_version":                "",
 	"response_status":               0,
@@ -376,7 +402,7 @@ func (h *AuditLogHandler) ListAuditLogFilterOptions(req api.Context) error {
 
 	// Apply scope filtering based on user role
 	if !req.UserIsAdmin() && !req.UserIsAuditor() {
-		ownServerMCPIDs, err := getOwnServerMCPIDs(req)
+		ownServerMCPIDs, err := handlers.OwnServerMCPIDs(req)
 		if err != nil {
 			return fmt.Errorf("failed to get own server MCPIDs: %w", err)
 		}
@@ -450,7 +476,7 @@ func (h *AuditLogHandler) GetUsageStats(req api.Context) error {
 
 	// Apply scope filtering based on user role (same logic as audit logs)
 	if !req.UserIsAdmin() && !req.UserIsAuditor() {
-		ownServerMCPIDs, err := getOwnServerMCPIDs(req)
+		ownServerMCPIDs, err := handlers.OwnServerMCPIDs(req)
 		if err != nil {
 			return fmt.Errorf("failed to get own server MCPIDs: %w", err)
 		}
@@ -518,3 +544,113 @@ func (h *AuditLogHandler) GetUsageStats(req api.Context) error {
 		Items:       result,
 	})
 }
+
+// defaultAggregationWindow is the time range of aggregations when no start time is provided.
+const defaultAggregationWindow = 7 * 24 * time.Hour
+
+// parseAggregationOpts parses the audit log filters of an aggregation request and scopes them to the logs the user can
+// see. It returns false if the user cannot see any logs.
+func parseAggregationOpts(req api.Context) (gateway.MCPAuditLogOptions, bool, error) {
+	query := req.URL.Query()
+	opts := parseAuditLogOpts(query)
+	// Aggregations are not paginated.
+	opts.Limit, opts.Offset = 0, 0
+
+	if result := opts.Result; result != "" && result != gateway.MCPAuditLogResultSuccess && result != gateway.MCPAuditLogResultError {
+		return opts, false, types.NewErrBadRequest("invalid result %q, expected %q or %q", result, gateway.MCPAuditLogResultSuccess, gateway.MCPAuditLogResultError)
+	}
+	if startTime := query.Get("start_time"); startTime != "" && opts.StartTime.IsZero() {
+		return opts, false, types.NewErrBadRequest("invalid start_time format, expected RFC3339")
+	}
+	if endTime := query.Get("end_time"); endTime != "" && opts.EndTime.IsZero() {
+		return opts, false, types.NewErrBadRequest("invalid end_time format, expected RFC3339")
+	}
+	if opts.EndTime.IsZero() {
+		opts.EndTime = time.Now()
+	}
+	if opts.StartTime.IsZero() {
+		opts.StartTime = opts.EndTime.Add(-defaultAggregationWindow)
+	}
+
+	// Apply scope filtering based on user role (same logic as audit logs)
+	if !req.UserIsAdmin() && !req.UserIsAuditor() {
+		ownServerMCPIDs, err := handlers.OwnServerMCPIDs(req)
+		if err != nil {
+			return opts, false, fmt.Errorf("failed to get own server MCPIDs: %w", err)
+		}
+		opts.OwnServerMCPIDs = ownServerMCPIDs
+
+		// PowerUsers also see workspace servers
+		if req.UserIsPowerUser() {
+			workspaceID := system.GetPowerUserWorkspaceID(req.User.GetUID())
+			opts.PowerUserWorkspaceID = []string{workspaceID}
+		}
+
+		if len(opts.OwnServerMCPIDs) == 0 && len(opts.PowerUserWorkspaceID) == 0 {
+			return opts, false, nil
+		}
+	}
+
+	return opts, true, nil
+}
+
+// GetToolCallDailyStats handles GET /api/mcp-audit-logs/stats/tool-calls.
+// It accepts the same filters as ListAuditLogs and counts the matching tool calls per server, tool, and day.
+func (h *AuditLogHandler) GetToolCallDailyStats(req api.Context) error {
+	opts, ok, err := parseAggregationOpts(req)
+	if err != nil {
+		return err
+	}
+
+	result := types.MCPToolCallDailyStats{
+		TimeStart: *types.NewTime(opts.StartTime),
+		TimeEnd:   *types.NewTime(opts.EndTime),
+		Items:     []types.MCPToolCallDailyStat{},
+	}
+	if !ok {
+		return req.Write(result)
+	}
+
+	stats, err := req.GatewayClient.GetMCPToolCallDailyStats(req.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range stats {
+		result.Items = append(result.Items, gatewaytypes.ConvertMCPToolCallDailyStat(stat))
+	}
+
+	return req.Write(result)
+}
+
+// GetErrorRates handles GET /api/mcp-audit-logs/stats/error-rate.
+// It accepts the same filters as ListAuditLogs and returns the error rate of the matching calls, overall and per server.
+func (h *AuditLogHandler) GetErrorRates(req api.Context) error {
+	opts, ok, err := parseAggregationOpts(req)
+	if err != nil {
+		return err
+	}
+
+	result := types.MCPErrorRateStats{
+		TimeStart: *types.NewTime(opts.StartTime),
+		TimeEnd:   *types.NewTime(opts.EndTime),
+		Items:     []types.MCPErrorRateStat{},
+	}
+	if !ok {
+		return req.Write(result)
+	}
+
+	stats, err := req.GatewayClient.GetMCPErrorRates(req.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range stats {
+		result.CallCount += stat.CallCount
+		result.ErrorCount += stat.ErrorCount
+		result.Items = append(result.Items, gatewaytypes.ConvertMCPErrorRateStat(stat))
+	}
+	result.ErrorRate = types.ErrorRate(result.ErrorCount, result.CallCount)
+
+	return req.Write(result)
+}
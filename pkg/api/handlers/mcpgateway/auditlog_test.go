@@ -0,0 +1,51 @@
+package mcpgateway
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRunFromRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		wantRunID     string
+		wantProjectID string
+	}{
+		{
+			name:      "run ID in meta",
+			body:      `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search","arguments":{"q":"obot"},"_meta":{"obot.ai/run-id":"r1abc"}}}`,
+			wantRunID: "r1abc",
+		},
+		{
+			name:          "run and project IDs in meta",
+			body:          `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search","_meta":{"obot.ai/run-id":"r1abc","obot.ai/project-id":"t1xyz"}}}`,
+			wantRunID:     "r1abc",
+			wantProjectID: "t1xyz",
+		},
+		{
+			name: "no meta",
+			body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search"}}`,
+		},
+		{
+			name: "run ID is not a string",
+			body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search","_meta":{"obot.ai/run-id":1}}}`,
+		},
+		{
+			name: "invalid body",
+			body: `not json`,
+		},
+		{
+			name: "empty body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runID, projectID := runFromRequest(json.RawMessage(tt.body))
+			if runID != tt.wantRunID || projectID != tt.wantProjectID {
+				t.Errorf("runFromRequest() = %q, %q, want %q, %q", runID, projectID, tt.wantRunID, tt.wantProjectID)
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package mcpgateway
 import (
 	"fmt"
 	"maps"
+	"mime"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -24,12 +26,16 @@ import (
 type Handler struct {
 	mcpSessionManager         *mcp.SessionManager
 	webhookHelper             *mcp.WebhookHelper
+	toolPolicyHelper          *mcp.ToolPolicyHelper
 	nanobotIntegrationEnabled bool
+	monthlyToolCallLimit      int
 	scope                     string
 	transport                 http.RoundTripper
+	sessions                  *connectSessions
+	connectOptions            ConnectOptions
 }
 
-func NewHandler(mcpSessionManager *mcp.SessionManager, webhookHelper *mcp.WebhookHelper, scopesSupported []string, nanobotIntegrationEnabled bool) *Handler {
+func NewHandler(mcpSessionManager *mcp.SessionManager, webhookHelper *mcp.WebhookHelper, toolPolicyHelper *mcp.ToolPolicyHelper, scopesSupported []string, nanobotIntegrationEnabled bool, monthlyToolCallLimit int, connectOptions ConnectOptions) *Handler {
 	var scope string
 	if len(scopesSupported) > 0 {
 		scope = fmt.Sprintf(", scope=\"%s\"", strings.Join(scopesSupported, " "))
@@ -37,9 +43,13 @@ func NewHandler(mcpSessionManager *mcp.SessionManager, webhookHelper *mcp.Webhoo
 	return &Handler{
 		mcpSessionManager:         mcpSessionManager,
 		webhookHelper:             webhookHelper,
+		toolPolicyHelper:          toolPolicyHelper,
 		nanobotIntegrationEnabled: nanobotIntegrationEnabled,
+		monthlyToolCallLimit:      monthlyToolCallLimit,
 		scope:                     scope,
-		transport:                 otelhttp.NewTransport(http.DefaultTransport),
+		transport:                 otelhttp.NewTransport(mcpSessionManager.RemoteTransport()),
+		sessions:                  newConnectSessions(),
+		connectOptions:            connectOptions,
 	}
 }
 
@@ -49,9 +59,9 @@ func (h *Handler) Proxy(req api.Context) error {
 		return apierrors.NewUnauthorized("user is not authenticated")
 	}
 
-	serverConfig, mcpURL, allowDifferentPaths, err := h.ensureServerIsDeployed(req)
+	serverConfig, mcpURL, allowDifferentPaths, connectSettings, maintenanceNotice, err := h.ensureServerIsDeployed(req)
 	if err != nil {
-		return fmt.Errorf("failed to ensure server is deployed: %v", err)
+		return fmt.Errorf("failed to ensure server is deployed: %w", err)
 	}
 
 	u, err := url.Parse(mcpURL)
@@ -60,82 +70,172 @@ func (h *Handler) Proxy(req api.Context) error {
 		return nil
 	}
 
-	(&httputil.ReverseProxy{
-		Transport: h.transport,
-		Director: func(r *http.Request) {
-			r.Header.Set("X-Forwarded-Host", r.Host)
-			scheme := "https"
-			if strings.HasPrefix(r.Host, "localhost") || strings.HasPrefix(r.Host, "127.0.0.1") {
-				scheme = "http"
-			}
-			r.Header.Set("X-Forwarded-Proto", scheme)
-
-			r.Host = u.Host
-			r.URL.Scheme = u.Scheme
-			r.URL.Host = u.Host
-			r.URL.Path = u.Path
-			if rest := r.PathValue("rest"); allowDifferentPaths && rest != "" {
-				if strings.HasPrefix(rest, "/") {
-					r.URL.Path = rest
-				} else {
-					r.URL.Path = "/" + rest
+	limitResponse, err := h.checkToolCallLimit(req)
+	if err != nil {
+		return err
+	}
+	if limitResponse != nil {
+		req.ResponseWriter.Header().Set("Content-Type", "application/json")
+		_, err = req.ResponseWriter.Write(limitResponse)
+		return err
+	}
+
+	var modifyResponse func(*http.Response) error
+	policy, err := h.toolPolicyHelper.PolicyForServer(serverConfig)
+	if err != nil {
+		return fmt.Errorf("failed to get tool policy: %v", err)
+	}
+	apiKeyAllowlist := apiKeyToolAllowlist(req.User, req.PathValue("mcp_id"), serverConfig.MCPServerName)
+	if policy != nil || len(serverConfig.ToolAllowlist) > 0 || len(apiKeyAllowlist) > 0 {
+		enforcer := &toolPolicyEnforcer{
+			policy:          policy,
+			allowlist:       serverConfig.ToolAllowlist,
+			apiKeyAllowlist: apiKeyAllowlist,
+			listRequestIDs:  make(map[string]struct{}),
+		}
+
+		deniedResponse, err := enforcer.inspectRequest(req.Request)
+		if err != nil {
+			return err
+		}
+		if deniedResponse != nil {
+			req.ResponseWriter.Header().Set("Content-Type", "application/json")
+			_, err = req.ResponseWriter.Write(deniedResponse)
+			return err
+		}
+
+		modifyResponse = enforcer.modifyResponse
+	}
+
+	if maintenanceNotice.ActiveAt(time.Now()) {
+		warner := newMaintenanceWarner(maintenanceNotice)
+		if err = warner.inspectRequest(req.Request); err != nil {
+			return err
+		}
+
+		if policyModifyResponse := modifyResponse; policyModifyResponse != nil {
+			modifyResponse = func(resp *http.Response) error {
+				if err := policyModifyResponse(resp); err != nil {
+					return err
 				}
+				return warner.modifyResponse(resp)
 			}
+		} else {
+			modifyResponse = warner.modifyResponse
+		}
+	}
 
-			// Merge query parameters from the incoming request and the upstream URL.
-			// Preserve all values; if a key exists in both, both values will be present.
-			upstreamQuery := u.Query()
-			origQuery := r.URL.Query()
-			for k, vs := range origQuery {
-				for _, v := range vs {
-					upstreamQuery.Add(k, v)
+	director := proxyDirector(u, serverConfig, allowDifferentPaths)
+	mcpID := req.PathValue("mcp_id")
+	connectOptions := h.connectOptions.forServer(connectSettings)
+
+	// Clients using the legacy HTTP+SSE transport are bridged to the streamable HTTP transport that the MCP server speaks.
+	// Everything else, including resumption of streamable HTTP streams with Last-Event-ID, is proxied as is.
+	switch {
+	case isLegacySSEStream(req.Request):
+		return h.serveLegacySSEStream(req, mcpID, connectOptions)
+	case isLegacySSEMessage(req.Request):
+		return h.handleLegacySSEMessage(req, mcpID, director, modifyResponse)
+	}
+
+	(&httputil.ReverseProxy{
+		Transport: h.transport,
+		ModifyResponse: func(resp *http.Response) error {
+			sessionID := h.sessions.trackStreamableHTTP(resp, mcpID, req.User.GetUID(), connectOptions.IdleTimeout)
+			if modifyResponse != nil {
+				if err := modifyResponse(resp); err != nil {
+					return err
 				}
 			}
-			r.URL.RawQuery = upstreamQuery.Encode()
 
-			for i := range serverConfig.PassthroughHeaderNames {
-				if i < len(serverConfig.PassthroughHeaderValues) {
-					r.Header.Set(serverConfig.PassthroughHeaderNames[i], serverConfig.PassthroughHeaderValues[i])
-				}
+			if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType == "text/event-stream" {
+				h.sessions.streamOpened(sessionID)
+				resp.Body = newConnectStream(resp.Body, connectOptions, func() {
+					h.sessions.streamClosed(sessionID)
+				})
 			}
+			return nil
 		},
+		Director: director,
 	}).ServeHTTP(req.ResponseWriter, req.Request)
 
 	return nil
 }
 
-func (h *Handler) ensureServerIsDeployed(req api.Context) (mcp.ServerConfig, string, bool, error) {
+// proxyDirector returns the function that rewrites requests to the mcp-connect endpoint into requests to the MCP server.
+func proxyDirector(u *url.URL, serverConfig mcp.ServerConfig, allowDifferentPaths bool) func(*http.Request) {
+	return func(r *http.Request) {
+		r.Header.Set("X-Forwarded-Host", r.Host)
+		scheme := "https"
+		if strings.HasPrefix(r.Host, "localhost") || strings.HasPrefix(r.Host, "127.0.0.1") {
+			scheme = "http"
+		}
+		r.Header.Set("X-Forwarded-Proto", scheme)
+
+		r.Host = u.Host
+		r.URL.Scheme = u.Scheme
+		r.URL.Host = u.Host
+		r.URL.Path = u.Path
+		if rest := r.PathValue("rest"); allowDifferentPaths && rest != "" {
+			if strings.HasPrefix(rest, "/") {
+				r.URL.Path = rest
+			} else {
+				r.URL.Path = "/" + rest
+			}
+		}
+
+		// Merge query parameters from the incoming request and the upstream URL.
+		// Preserve all values; if a key exists in both, both values will be present.
+		upstreamQuery := u.Query()
+		origQuery := r.URL.Query()
+		for k, vs := range origQuery {
+			for _, v := range vs {
+				upstreamQuery.Add(k, v)
+			}
+		}
+		r.URL.RawQuery = upstreamQuery.Encode()
+
+		for i := range serverConfig.PassthroughHeaderNames {
+			if i < len(serverConfig.PassthroughHeaderValues) {
+				r.Header.Set(serverConfig.PassthroughHeaderNames[i], serverConfig.PassthroughHeaderValues[i])
+			}
+		}
+	}
+}
+
+func (h *Handler) ensureServerIsDeployed(req api.Context) (mcp.ServerConfig, string, bool, *types.MCPConnectSettings, *types.MCPMaintenanceNotice, error) {
 	mcpID := req.PathValue("mcp_id")
 
 	if system.IsSystemMCPServerID(mcpID) {
-		return h.ensureSystemServerIsDeployed(req, mcpID)
+		serverConfig, mcpURL, allowDifferentPaths, err := h.ensureSystemServerIsDeployed(req, mcpID)
+		return serverConfig, mcpURL, allowDifferentPaths, nil, nil, err
 	}
 
 	mcpID, mcpServer, mcpServerConfig, err := handlers.ServerForActionWithConnectID(req, mcpID)
 	if err != nil {
-		return mcp.ServerConfig{}, "", false, fmt.Errorf("failed to get mcp server config: %w", err)
+		return mcp.ServerConfig{}, "", false, nil, nil, fmt.Errorf("failed to get mcp server config: %w", err)
 	}
 	if mcpServer.Spec.Template {
-		return mcp.ServerConfig{}, "", false, apierrors.NewNotFound(schema.GroupResource{Group: "obot.obot.ai", Resource: "mcpserver"}, mcpID)
+		return mcp.ServerConfig{}, "", false, nil, nil, apierrors.NewNotFound(schema.GroupResource{Group: "obot.obot.ai", Resource: "mcpserver"}, mcpID)
 	}
 
 	// Add-hoc authorization for nanobot agents
 	if h.nanobotIntegrationEnabled && mcpServerConfig.NanobotAgentName != "" {
 		var agent v1.NanobotAgent
 		if err = req.Get(&agent, mcpServerConfig.NanobotAgentName); err != nil {
-			return mcp.ServerConfig{}, "", false, fmt.Errorf("failed to get nanobot agent %q: %w", mcpServerConfig.NanobotAgentName, err)
+			return mcp.ServerConfig{}, "", false, nil, nil, fmt.Errorf("failed to get nanobot agent %q: %w", mcpServerConfig.NanobotAgentName, err)
 		}
 		if agent.Spec.UserID != req.User.GetUID() && (!req.UserCanImpersonate() || !req.UserIsAdmin()) {
-			return mcp.ServerConfig{}, "", false, types.NewErrForbidden("user is not authorized to access nanobot agent %q", mcpServerConfig.NanobotAgentName)
+			return mcp.ServerConfig{}, "", false, nil, nil, types.NewErrForbidden("user is not authorized to access nanobot agent %q", mcpServerConfig.NanobotAgentName)
 		}
 	}
 
 	url, err := h.mcpSessionManager.LaunchServer(req.Context(), mcpServerConfig)
 	if err != nil {
-		return mcp.ServerConfig{}, "", false, fmt.Errorf("failed to launch mcp server: %w", err)
+		return mcp.ServerConfig{}, "", false, nil, nil, fmt.Errorf("failed to launch mcp server: %w", err)
 	}
 
-	return mcpServerConfig, url, h.nanobotIntegrationEnabled && mcpServerConfig.NanobotAgentName != "", nil
+	return mcpServerConfig, url, h.nanobotIntegrationEnabled && mcpServerConfig.NanobotAgentName != "", mcpServer.Spec.Manifest.ConnectSettings, mcpServer.EffectiveMaintenanceNotice(), nil
 }
 
 func (h *Handler) ensureSystemServerIsDeployed(req api.Context, mcpID string) (mcp.ServerConfig, string, bool, error) {
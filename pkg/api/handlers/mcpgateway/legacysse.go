@@ -0,0 +1,414 @@
+package mcpgateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+var log = logger.Package()
+
+// legacySSEBufferSize is the number of messages kept for a legacy SSE session so that a client can resume its stream with Last-Event-ID.
+const legacySSEBufferSize = 256
+
+// isLegacySSEStream reports whether the request opens the event stream of the legacy HTTP+SSE transport.
+// Streamable HTTP clients only open a stream with GET once they have a session, so a GET without one is a legacy client.
+func isLegacySSEStream(r *http.Request) bool {
+	return r.Method == http.MethodGet &&
+		r.PathValue("rest") == "" &&
+		r.Header.Get("Mcp-Session-Id") == "" &&
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// isLegacySSEMessage reports whether the request posts a message to the endpoint advertised to a legacy HTTP+SSE client.
+func isLegacySSEMessage(r *http.Request) bool {
+	return r.Method == http.MethodPost &&
+		r.PathValue("rest") == "" &&
+		r.Header.Get("Mcp-Session-Id") == "" &&
+		r.URL.Query().Get("sessionId") != ""
+}
+
+type legacySSEEvent struct {
+	seq  uint64
+	data []byte
+}
+
+// legacySSESession bridges a client using the legacy HTTP+SSE transport to a streamable HTTP session with the MCP server.
+// Messages from the server, both responses to the client's posts and server-initiated messages, are buffered and sent on the client's event stream.
+type legacySSESession struct {
+	id     string
+	mcpID  string
+	userID string
+	opts   ConnectOptions
+
+	// ctx is done when the session is closed or reaches the maximum session duration. Requests to the MCP server on behalf of the session use it,
+	// so that responses keep streaming after the client's request completes.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock              sync.Mutex
+	upstreamSessionID string
+	streaming         bool
+	events            []legacySSEEvent
+	seq               uint64
+	changed           chan struct{}
+	attached          int
+	detachedAt        time.Time
+}
+
+func newLegacySSESession(ctx context.Context, mcpID, userID string, opts ConnectOptions) *legacySSESession {
+	var cancel context.CancelFunc
+	if opts.MaxSessionDuration > 0 {
+		ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), opts.MaxSessionDuration)
+	} else {
+		ctx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+	}
+	return &legacySSESession{
+		id:         strings.ToLower(rand.Text()),
+		mcpID:      mcpID,
+		userID:     userID,
+		opts:       opts,
+		ctx:        ctx,
+		cancel:     cancel,
+		changed:    make(chan struct{}),
+		detachedAt: time.Now(),
+	}
+}
+
+func (s *legacySSESession) close() {
+	s.cancel()
+}
+
+// push buffers a message for the client, dropping the oldest message if the buffer is full.
+func (s *legacySSESession) push(data []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.seq++
+	s.events = append(s.events, legacySSEEvent{seq: s.seq, data: data})
+	if len(s.events) > legacySSEBufferSize {
+		s.events = s.events[len(s.events)-legacySSEBufferSize:]
+	}
+
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// eventsAfter returns the buffered messages after seq and a channel that is closed when another message is buffered.
+func (s *legacySSESession) eventsAfter(seq uint64) ([]legacySSEEvent, <-chan struct{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i, e := range s.events {
+		if e.seq > seq {
+			return slices.Clone(s.events[i:]), s.changed
+		}
+	}
+	return nil, s.changed
+}
+
+func (s *legacySSESession) attach() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.attached++
+}
+
+func (s *legacySSESession) detach() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.attached--
+	if s.attached == 0 {
+		s.detachedAt = time.Now()
+	}
+}
+
+func (s *legacySSESession) isAttached() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.attached > 0
+}
+
+// idleSince returns how long the session has been without a client stream.
+func (s *legacySSESession) idleSince(now time.Time) time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.attached > 0 {
+		return 0
+	}
+	return now.Sub(s.detachedAt)
+}
+
+func (s *legacySSESession) getUpstreamSessionID() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.upstreamSessionID
+}
+
+func (s *legacySSESession) setUpstreamSessionID(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.upstreamSessionID = id
+}
+
+// startStreaming reports whether the caller should open the stream of server-initiated messages for the session.
+func (s *legacySSESession) startStreaming() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.streaming || s.upstreamSessionID == "" {
+		return false
+	}
+	s.streaming = true
+	return true
+}
+
+func (s *legacySSESession) stopStreaming() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.streaming = false
+}
+
+// formatLegacySSEEventID returns the ID of an event sent on a legacy SSE stream. The ID identifies both the session and
+// the message so that a client reconnecting with Last-Event-ID resumes the session where it left off.
+func formatLegacySSEEventID(sessionID string, seq uint64) string {
+	return fmt.Sprintf("%s-%d", sessionID, seq)
+}
+
+func parseLegacySSEEventID(id string) (string, uint64, bool) {
+	i := strings.LastIndex(id, "-")
+	if i <= 0 {
+		return "", 0, false
+	}
+
+	seq, err := strconv.ParseUint(id[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return id[:i], seq, true
+}
+
+// serveLegacySSEStream serves the event stream of a legacy HTTP+SSE client. A new session is started unless the client
+// is resuming one of its sessions with Last-Event-ID, in which case the messages it missed are sent first.
+func (h *Handler) serveLegacySSEStream(req api.Context, mcpID string, opts ConnectOptions) error {
+	h.sessions.prune()
+
+	var (
+		session *legacySSESession
+		seq     uint64
+	)
+	if sessionID, lastSeq, ok := parseLegacySSEEventID(req.Request.Header.Get("Last-Event-ID")); ok {
+		if session = h.sessions.legacySSE(sessionID, mcpID, req.User.GetUID()); session != nil {
+			seq = lastSeq
+		}
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "text/event-stream")
+	req.ResponseWriter.Header().Set("Cache-Control", "no-cache")
+	req.ResponseWriter.Header().Set("Connection", "keep-alive")
+
+	if session == nil {
+		session = newLegacySSESession(req.Context(), mcpID, req.User.GetUID(), opts)
+		h.sessions.addLegacySSE(session)
+
+		if _, err := fmt.Fprintf(req.ResponseWriter, "event: endpoint\ndata: %s?sessionId=%s\n\n", req.URL.Path, session.id); err != nil {
+			return err
+		}
+	}
+	req.Flush()
+
+	session.attach()
+	defer session.detach()
+
+	var keepAlive <-chan time.Time
+	if session.opts.PingInterval > 0 {
+		ticker := time.NewTicker(session.opts.PingInterval)
+		defer ticker.Stop()
+		keepAlive = ticker.C
+	}
+
+	for {
+		events, changed := session.eventsAfter(seq)
+		for _, e := range events {
+			if _, err := fmt.Fprintf(req.ResponseWriter, "id: %s\nevent: message\ndata: %s\n\n", formatLegacySSEEventID(session.id, e.seq), e.data); err != nil {
+				return err
+			}
+			seq = e.seq
+		}
+		if len(events) > 0 {
+			req.Flush()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil
+		case <-session.ctx.Done():
+			return nil
+		case <-changed:
+		case <-keepAlive:
+			if _, err := req.ResponseWriter.Write([]byte(": keep-alive\n\n")); err != nil {
+				return err
+			}
+			req.Flush()
+		}
+	}
+}
+
+// handleLegacySSEMessage forwards a message posted by a legacy HTTP+SSE client to the MCP server's streamable HTTP endpoint.
+// The message is accepted once the MCP server has accepted it, and the server's response is sent on the client's event stream.
+func (h *Handler) handleLegacySSEMessage(req api.Context, mcpID string, director func(*http.Request), modifyResponse func(*http.Response) error) error {
+	session := h.sessions.legacySSE(req.URL.Query().Get("sessionId"), mcpID, req.User.GetUID())
+	if session == nil {
+		return types.NewErrNotFound("MCP session %s not found", req.URL.Query().Get("sessionId"))
+	}
+
+	body, err := io.ReadAll(req.Request.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	upstreamReq := h.newLegacySSEUpstreamRequest(req.Request, session, director, http.MethodPost, body)
+	resp, err := h.transport.RoundTrip(upstreamReq)
+	if err != nil {
+		return types.NewErrHTTP(http.StatusBadGateway, fmt.Sprintf("failed to send message to MCP server: %v", err))
+	}
+	if modifyResponse != nil {
+		if err = modifyResponse(resp); err != nil {
+			resp.Body.Close()
+			return err
+		}
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		req.ResponseWriter.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+		req.ResponseWriter.WriteHeader(resp.StatusCode)
+		_, err = io.Copy(req.ResponseWriter, resp.Body)
+		return err
+	}
+
+	if id := resp.Header.Get("Mcp-Session-Id"); id != "" {
+		session.setUpstreamSessionID(id)
+	}
+
+	req.ResponseWriter.WriteHeader(http.StatusAccepted)
+	req.Flush()
+
+	go relayLegacySSEResponse(session, resp)
+
+	if session.startStreaming() {
+		go h.streamLegacySSEServerMessages(req.Request, session, director)
+	}
+
+	return nil
+}
+
+// newLegacySSEUpstreamRequest returns a streamable HTTP request to the MCP server on behalf of the legacy SSE session.
+func (h *Handler) newLegacySSEUpstreamRequest(r *http.Request, session *legacySSESession, director func(*http.Request), method string, body []byte) *http.Request {
+	upstreamReq := r.Clone(session.ctx)
+	upstreamReq.Method = method
+	upstreamReq.RequestURI = ""
+	upstreamReq.Body = http.NoBody
+	upstreamReq.ContentLength = 0
+	if body != nil {
+		upstreamReq.Body = io.NopCloser(bytes.NewReader(body))
+		upstreamReq.ContentLength = int64(len(body))
+		upstreamReq.Header.Set("Content-Type", "application/json")
+	} else {
+		upstreamReq.Header.Del("Content-Type")
+	}
+
+	query := upstreamReq.URL.Query()
+	query.Del("sessionId")
+	upstreamReq.URL.RawQuery = query.Encode()
+
+	director(upstreamReq)
+
+	upstreamReq.Header.Del("Last-Event-ID")
+	if method == http.MethodGet {
+		upstreamReq.Header.Set("Accept", "text/event-stream")
+	} else {
+		upstreamReq.Header.Set("Accept", "application/json, text/event-stream")
+	}
+	if id := session.getUpstreamSessionID(); id != "" {
+		upstreamReq.Header.Set("Mcp-Session-Id", id)
+	}
+
+	return upstreamReq
+}
+
+// streamLegacySSEServerMessages relays server-initiated messages to the legacy SSE session until the session is closed.
+// The stream is reopened if the MCP server ends it, unless the MCP server doesn't offer one.
+func (h *Handler) streamLegacySSEServerMessages(r *http.Request, session *legacySSESession, director func(*http.Request)) {
+	defer session.stopStreaming()
+
+	for {
+		resp, err := h.transport.RoundTrip(h.newLegacySSEUpstreamRequest(r, session, director, http.MethodGet, nil))
+		if err != nil {
+			if session.ctx.Err() == nil {
+				log.Debugf("failed to open event stream for legacy SSE session %s: %v", session.id, err)
+			}
+			return
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return
+		}
+
+		relayLegacySSEResponse(session, resp)
+
+		select {
+		case <-session.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// relayLegacySSEResponse buffers the messages in a streamable HTTP response for the legacy SSE session.
+func relayLegacySSEResponse(session *legacySSESession, resp *http.Response) {
+	defer resp.Body.Close()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			log.Debugf("failed to read response for legacy SSE session %s: %v", session.id, err)
+			return
+		}
+		if body = bytes.TrimSpace(body); len(body) > 0 {
+			session.push(body)
+		}
+	case "text/event-stream":
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			if data, ok := bytes.CutPrefix(scanner.Bytes(), []byte("data:")); ok {
+				if data = bytes.TrimSpace(data); len(data) > 0 {
+					session.push(bytes.Clone(data))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package mcpgateway
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLegacySSEEventID(t *testing.T) {
+	sessionID, seq, ok := parseLegacySSEEventID(formatLegacySSEEventID("abc123", 42))
+	if !ok || sessionID != "abc123" || seq != 42 {
+		t.Fatalf("expected abc123/42, got %q/%d (ok=%v)", sessionID, seq, ok)
+	}
+
+	for _, id := range []string{"", "42", "-42", "abc123-", "abc123-x"} {
+		if _, _, ok := parseLegacySSEEventID(id); ok {
+			t.Errorf("expected %q to be rejected", id)
+		}
+	}
+}
+
+func TestLegacySSESessionEventsAfter(t *testing.T) {
+	s := newLegacySSESession(t.Context(), "ms1test", "user", ConnectOptions{})
+	defer s.close()
+
+	for i := range legacySSEBufferSize + 10 {
+		s.push(fmt.Appendf(nil, `{"n":%d}`, i))
+	}
+
+	events, _ := s.eventsAfter(0)
+	if len(events) != legacySSEBufferSize {
+		t.Fatalf("expected %d buffered events, got %d", legacySSEBufferSize, len(events))
+	}
+	if events[0].seq != 11 {
+		t.Errorf("expected the oldest events to be dropped, first seq is %d", events[0].seq)
+	}
+
+	events, changed := s.eventsAfter(legacySSEBufferSize + 10)
+	if len(events) != 0 {
+		t.Fatalf("expected no events after the last one, got %d", len(events))
+	}
+
+	s.push([]byte(`{}`))
+	select {
+	case <-changed:
+	default:
+		t.Fatal("expected the changed channel to be closed after a push")
+	}
+}
+
+func TestLegacySSETransportDetection(t *testing.T) {
+	stream := httptest.NewRequest("GET", "/mcp-connect/ms1test", nil)
+	stream.Header.Set("Accept", "text/event-stream")
+	if !isLegacySSEStream(stream) {
+		t.Error("expected GET without a session to be a legacy SSE stream")
+	}
+
+	stream.Header.Set("Mcp-Session-Id", "session")
+	if isLegacySSEStream(stream) {
+		t.Error("expected GET with a streamable HTTP session not to be a legacy SSE stream")
+	}
+
+	message := httptest.NewRequest("POST", "/mcp-connect/ms1test?sessionId=abc", nil)
+	if !isLegacySSEMessage(message) {
+		t.Error("expected POST with a sessionId query parameter to be a legacy SSE message")
+	}
+
+	if isLegacySSEMessage(httptest.NewRequest("POST", "/mcp-connect/ms1test", nil)) {
+		t.Error("expected POST without a sessionId query parameter not to be a legacy SSE message")
+	}
+}
@@ -0,0 +1,96 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// maintenanceWarner adds a warning about the maintenance window of an MCP server to the results of tool calls sent
+// through the gateway, so that users aren't surprised by failures during planned outages.
+type maintenanceWarner struct {
+	warning string
+	// callRequestIDs contains the IDs of the tools/call requests whose results should include the warning.
+	callRequestIDs map[string]struct{}
+}
+
+func newMaintenanceWarner(notice *types.MCPMaintenanceNotice) *maintenanceWarner {
+	return &maintenanceWarner{
+		warning:        fmt.Sprintf("Warning: this MCP server is under maintenance until %s: %s", notice.EndTime.Time.UTC().Format(time.RFC3339), notice.Message),
+		callRequestIDs: make(map[string]struct{}),
+	}
+}
+
+// inspectRequest reads the request body and records the IDs of any tool calls.
+func (w *maintenanceWarner) inspectRequest(r *http.Request) error {
+	if r.Method != http.MethodPost || r.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	messages, _ := decodeMessages(body)
+	for _, msg := range messages {
+		if msg.Method == "tools/call" {
+			w.callRequestIDs[messageID(msg.ID)] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// modifyResponse adds the warning to tools/call results, both for plain JSON and event stream responses.
+func (w *maintenanceWarner) modifyResponse(resp *http.Response) error {
+	if len(w.callRequestIDs) == 0 {
+		return nil
+	}
+
+	return rewriteResponse(resp, func(data []byte) []byte {
+		return rewriteResults(data, w.callRequestIDs, w.addWarning)
+	})
+}
+
+func (w *maintenanceWarner) addWarning(data json.RawMessage) (json.RawMessage, bool) {
+	// Decode generically so that any fields we don't know about are passed through untouched.
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, false
+	}
+
+	var content []json.RawMessage
+	if c, ok := result["content"]; ok {
+		if err := json.Unmarshal(c, &content); err != nil {
+			return nil, false
+		}
+	}
+
+	warning, err := json.Marshal(map[string]string{
+		"type": "text",
+		"text": w.warning,
+	})
+	if err != nil {
+		return nil, false
+	}
+
+	withWarning, err := json.Marshal(append([]json.RawMessage{warning}, content...))
+	if err != nil {
+		return nil, false
+	}
+	result["content"] = withWarning
+
+	data, err = json.Marshal(result)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
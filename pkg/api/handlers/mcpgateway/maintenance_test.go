@@ -0,0 +1,54 @@
+package mcpgateway
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func TestMaintenanceWarner(t *testing.T) {
+	warner := newMaintenanceWarner(&types.MCPMaintenanceNotice{
+		Message: "Upgrading the database",
+		EndTime: types.Time{Time: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1", strings.NewReader(
+		`[{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"query"}},{"jsonrpc":"2.0","id":2,"method":"tools/list"}]`))
+	if err := warner.inspectRequest(req); err != nil {
+		t.Fatalf("inspectRequest() error = %v", err)
+	}
+	if body, _ := io.ReadAll(req.Body); len(body) == 0 {
+		t.Error("expected the request body to be restored")
+	}
+
+	resp := &http.Response{
+		Header: http.Header{"Content-Type": []string{"text/event-stream"}},
+		Body: io.NopCloser(strings.NewReader("event: message\n" +
+			`data: {"jsonrpc":"2.0","id":1,"result":{"content":[{"type":"text","text":"ok"}],"isError":false}}` + "\n\n" +
+			`data: {"jsonrpc":"2.0","id":2,"result":{"tools":[]}}` + "\n\n")),
+	}
+	if err := warner.modifyResponse(resp); err != nil {
+		t.Fatalf("modifyResponse() error = %v", err)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response: %v", err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	if !strings.Contains(lines[1], `"content":[{"text":"Warning: this MCP server is under maintenance until 2026-03-01T12:00:00Z: Upgrading the database","type":"text"},{"type":"text","text":"ok"}]`) {
+		t.Errorf("expected the warning to be prepended to the tool call result, got %s", lines[1])
+	}
+	if !strings.Contains(lines[1], `"isError":false`) {
+		t.Errorf("expected the other fields of the result to be kept, got %s", lines[1])
+	}
+	if lines[3] != `data: {"jsonrpc":"2.0","id":2,"result":{"tools":[]}}` {
+		t.Errorf("expected other responses to be unchanged, got %s", lines[3])
+	}
+}
@@ -1,6 +1,7 @@
 package oauth
 
 import (
+	"cmp"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/json"
@@ -33,6 +34,8 @@ const (
 	ErrServerError             ErrorCode = "server_error"
 	ErrTemporarilyUnavailable  ErrorCode = "temporarily_unavailable"
 	ErrInvalidClientMetadata   ErrorCode = "invalid_client_metadata"
+	ErrInvalidTarget           ErrorCode = "invalid_target"
+	ErrInvalidGrant            ErrorCode = "invalid_grant"
 )
 
 // Error represents an OAuth 2.0 error response.
@@ -71,7 +74,7 @@ func (h *handler) authorize(req api.Context) error {
 	codeChallenge := req.FormValue("code_challenge")
 	codeChallengeMethod := req.FormValue("code_challenge_method")
 	if codeChallenge != "" && (codeChallengeMethod == "" || !slices.Contains(h.oauthConfig.CodeChallengeMethodsSupported, codeChallengeMethod)) {
-		return types.NewErrBadRequest("%v", Error{
+		return authorizeError(req, Error{
 			Code:        ErrInvalidRequest,
 			Description: "code_challenge_method is invalid",
 			State:       state,
@@ -80,7 +83,7 @@ func (h *handler) authorize(req api.Context) error {
 
 	clientID := req.FormValue("client_id")
 	if clientID == "" {
-		return types.NewErrBadRequest("%v", Error{
+		return authorizeError(req, Error{
 			Code:        ErrInvalidRequest,
 			Description: "client_id is required",
 			State:       state,
@@ -89,7 +92,7 @@ func (h *handler) authorize(req api.Context) error {
 
 	clientNamespace, clientName, ok := strings.Cut(clientID, ":")
 	if !ok {
-		return types.NewErrBadRequest("%v", Error{
+		return authorizeError(req, Error{
 			Code:        ErrInvalidRequest,
 			Description: "client_id is invalid",
 			State:       state,
@@ -98,7 +101,7 @@ func (h *handler) authorize(req api.Context) error {
 
 	redirectURI := req.FormValue("redirect_uri")
 	if redirectURI == "" {
-		return types.NewErrBadRequest("%v", Error{
+		return authorizeError(req, Error{
 			Code:        ErrInvalidRequest,
 			Description: "redirect_uri is required",
 			State:       state,
@@ -107,14 +110,14 @@ func (h *handler) authorize(req api.Context) error {
 
 	responseType := req.FormValue("response_type")
 	if responseType == "" {
-		return types.NewErrBadRequest("%v", Error{
+		return authorizeError(req, Error{
 			Code:        ErrInvalidRequest,
 			Description: "response_type is required",
 			State:       state,
 		})
 	}
 	if !slices.Contains(h.oauthConfig.ResponseTypesSupported, responseType) {
-		return types.NewErrBadRequest("%v", Error{
+		return authorizeError(req, Error{
 			Code:        ErrInvalidRequest,
 			Description: "response_type is invalid",
 			State:       state,
@@ -127,13 +130,17 @@ func (h *handler) authorize(req api.Context) error {
 	}
 
 	if !slices.Contains(oauthClient.Spec.Manifest.RedirectURIs, redirectURI) {
-		return types.NewErrBadRequest("%v", Error{
+		return authorizeError(req, Error{
 			Code:        ErrInvalidRequest,
 			Description: "redirect_uri is invalid for this client",
 			State:       state,
 		})
 	}
 
+	if ok, err := h.challenger.checkAuthorization(req, clientID, redirectURI, state); !ok || err != nil {
+		return err
+	}
+
 	if len(oauthClient.Spec.Manifest.ResponseTypes) > 0 && !slices.Contains(oauthClient.Spec.Manifest.ResponseTypes, responseType) || len(oauthClient.Spec.Manifest.ResponseTypes) == 0 && responseType != "code" {
 		redirectWithAuthorizeError(req, redirectURI, Error{
 			Code:        ErrUnsupportedResponseType,
@@ -150,24 +157,7 @@ func (h *handler) authorize(req api.Context) error {
 		})
 	}
 
-	scope := req.FormValue("scope")
-	if scope != "" {
-		var (
-			supported []string
-			scopes    = make(map[string]struct{})
-		)
-		for s := range strings.SplitSeq(oauthClient.Spec.Manifest.Scope, " ") {
-			scopes[s] = struct{}{}
-		}
-
-		for s := range strings.SplitSeq(scope, " ") {
-			if _, ok := scopes[s]; s != "" && ok {
-				supported = append(supported, s)
-			}
-		}
-
-		scope = strings.Join(supported, " ")
-	}
+	scope := clientScope(oauthClient, req.FormValue("scope"))
 
 	mcpID := req.PathValue("mcp_id")
 	resource := req.FormValue("resource")
@@ -212,6 +202,7 @@ func (h *handler) authorize(req api.Context) error {
 			CodeChallengeMethod: codeChallengeMethod,
 			GrantType:           "authorization_code",
 			MCPID:               strings.TrimPrefix(mcpID, "/"),
+			Nonce:               req.FormValue("nonce"),
 		},
 	}
 
@@ -251,42 +242,12 @@ func (h *handler) callback(req api.Context) error {
 		return nil
 	}
 
-	mcpID := oauthAppAuthRequest.Spec.MCPID
-	if mcpID != "" {
-		serverOrInstanceID, audience, err := handlers.MCPIDAndAudienceFromConnectURL(req, mcpID)
-		if err != nil {
-			if errHTTP := (*types.ErrHTTP)(nil); errors.As(err, &errHTTP) {
-				redirectWithAuthorizeError(req, oauthAppAuthRequest.Spec.RedirectURI, Error{
-					Code:        ErrInvalidRequest,
-					Description: errHTTP.Message,
-					State:       oauthAppAuthRequest.Spec.State,
-				})
-			} else {
-				redirectWithAuthorizeError(req, oauthAppAuthRequest.Spec.RedirectURI, Error{
-					Code:        ErrServerError,
-					Description: fmt.Sprintf("failed to get MCP ID from connect URL: %v", err),
-					State:       oauthAppAuthRequest.Spec.State,
-				})
-			}
-			return nil
-		}
-
-		mcpID = serverOrInstanceID
-		audience = "/" + audience
-		if !strings.HasSuffix(oauthAppAuthRequest.Spec.Resource, audience) || oauthAppAuthRequest.Spec.MCPID != mcpID {
-			// Ensure the audience is what the server expects.
-			oauthAppAuthRequest.Spec.Resource = fmt.Sprintf("%s/mcp-connect%s", h.baseURL, audience)
-			oauthAppAuthRequest.Spec.MCPID = mcpID
-			if err = req.Update(&oauthAppAuthRequest); err != nil {
-				redirectWithAuthorizeError(req, oauthAppAuthRequest.Spec.RedirectURI, Error{
-					Code:        ErrServerError,
-					Description: fmt.Sprintf("failed to update OAuth app auth request: %v", err),
-					State:       oauthAppAuthRequest.Spec.State,
-				})
-				return nil
-			}
-		}
+	if oauthErr := h.resolveConnectMCPID(req, &oauthAppAuthRequest); oauthErr != nil {
+		oauthErr.State = oauthAppAuthRequest.Spec.State
+		redirectWithAuthorizeError(req, oauthAppAuthRequest.Spec.RedirectURI, *oauthErr)
+		return nil
 	}
+	mcpID := oauthAppAuthRequest.Spec.MCPID
 
 	code := strings.ToLower(rand.Text() + rand.Text())
 	oauthAppAuthRequest.Spec.HashedAuthCode = fmt.Sprintf("%x", sha256.Sum256([]byte(code)))
@@ -323,8 +284,12 @@ func (h *handler) callback(req api.Context) error {
 
 		if u != "" {
 			log.Infof("OAuth callback requires second-level MCP authentication: authRequest=%s mcpID=%s", oauthAppAuthRequest.Name, mcpID)
-			http.Redirect(req.ResponseWriter, req.Request, u, http.StatusFound)
-			return nil
+			scopeGroups, _, err := h.oauthChecker.scopeGroups(req.Context(), mcpServer)
+			if err != nil {
+				// The scope groups only describe the requested scopes, so the page can be shown without them.
+				log.Warnf("Failed to get OAuth scope groups of MCP server %s: %v", mcpServer.Name, err)
+			}
+			return renderConsentPage(req, mcpServer, u, scopeGroups)
 		}
 	}
 
@@ -338,15 +303,21 @@ func (h *handler) callback(req api.Context) error {
 func (h *handler) oauthCallback(req api.Context) error {
 	oauthAuthRequestID, mcpServerID, err := h.oauthChecker.stateMgr.createToken(req.Context(), req.URL.Query().Get("state"), req.URL.Query().Get("code"), req.URL.Query().Get("error"), req.URL.Query().Get("error_description"))
 	if err != nil {
-		return types.NewErrHTTP(http.StatusBadRequest, err.Error())
+		code := ErrorCode(req.URL.Query().Get("error"))
+		if code == "" {
+			code = ErrInvalidRequest
+		}
+		return renderErrorPage(req, statusForError(code), Error{
+			Code:        code,
+			Description: err.Error(),
+		})
 	}
 
 	if oauthAuthRequestID == "" {
 		// If there is no OAuth request object, then MCP OAuth wasn't started by OAuth; likely the UI kicked it off.
-		// Redirect to the login complete page.
+		// Show the success page.
 		log.Infof("Completed MCP OAuth callback without first-level OAuth auth request context")
-		http.Redirect(req.ResponseWriter, req.Request, "/login_complete", http.StatusFound)
-		return nil
+		return renderPage(req, http.StatusOK, page{Kind: pageSuccess})
 	}
 
 	var oauthAppAuthRequest v1.OAuthAuthRequest
@@ -378,12 +349,22 @@ func (h *handler) oauthCallback(req api.Context) error {
 
 	if server.Spec.CompositeName != "" {
 		// MCP server is a component of a composite.
-		// Redirect to login complete page; the checkCompositeAuth handler will redirect back
+		// Show the success page; the checkCompositeAuth handler will redirect back
 		// to the 1st level OAuth redirect URL when all pending 2nd level OAuth for the composite server's
 		// component servers are completed.
 		log.Infof("MCP OAuth callback completed for composite component server, awaiting composite finalization: authRequest=%s mcpServer=%s composite=%s", oauthAppAuthRequest.Name, server.Name, server.Spec.CompositeName)
-		http.Redirect(req.ResponseWriter, req.Request, "/login_complete", http.StatusFound)
-		return nil
+		return renderPage(req, http.StatusOK, page{Kind: pageSuccess})
+	}
+
+	if oauthAppAuthRequest.Spec.GrantType == deviceCodeGrantType {
+		// The device polls for its token, so there is nothing to redirect to.
+		if err := approveDevice(req, &oauthAppAuthRequest); err != nil {
+			return renderErrorPage(req, http.StatusInternalServerError, Error{
+				Code:        ErrServerError,
+				Description: err.Error(),
+			})
+		}
+		return renderPage(req, http.StatusOK, page{Kind: pageSuccess})
 	}
 
 	// Not a component of a composite MCP server, redirect to complete 1st level OAuth
@@ -404,6 +385,97 @@ func (h *handler) oauthCallback(req api.Context) error {
 	return nil
 }
 
+// clientScope returns the scopes of a requested scope that the client is allowed to request.
+func clientScope(oauthClient v1.OAuthClient, scope string) string {
+	if scope == "" {
+		return ""
+	}
+
+	var (
+		supported []string
+		scopes    = make(map[string]struct{})
+	)
+	for s := range strings.SplitSeq(oauthClient.Spec.Manifest.Scope, " ") {
+		scopes[s] = struct{}{}
+	}
+
+	for s := range strings.SplitSeq(scope, " ") {
+		if _, ok := scopes[s]; s != "" && ok {
+			supported = append(supported, s)
+		}
+	}
+
+	return strings.Join(supported, " ")
+}
+
+// resolveConnectMCPID updates the MCP ID and resource of an authorization request to the server or instance that the
+// user connects to, and the audience that it expects.
+func (h *handler) resolveConnectMCPID(req api.Context, oauthAuthRequest *v1.OAuthAuthRequest) *Error {
+	if oauthAuthRequest.Spec.MCPID == "" {
+		return nil
+	}
+
+	mcpID, audience, err := handlers.MCPIDAndAudienceFromConnectURL(req, oauthAuthRequest.Spec.MCPID)
+	if err != nil {
+		if errHTTP := (*types.ErrHTTP)(nil); errors.As(err, &errHTTP) {
+			return &Error{
+				Code:        ErrInvalidRequest,
+				Description: errHTTP.Message,
+			}
+		}
+		return &Error{
+			Code:        ErrServerError,
+			Description: fmt.Sprintf("failed to get MCP ID from connect URL: %v", err),
+		}
+	}
+
+	audience = "/" + audience
+	if !strings.HasSuffix(oauthAuthRequest.Spec.Resource, audience) || oauthAuthRequest.Spec.MCPID != mcpID {
+		// Ensure the audience is what the server expects.
+		oauthAuthRequest.Spec.Resource = fmt.Sprintf("%s/mcp-connect%s", h.baseURL, audience)
+		oauthAuthRequest.Spec.MCPID = mcpID
+		if err = req.Update(oauthAuthRequest); err != nil {
+			return &Error{
+				Code:        ErrServerError,
+				Description: fmt.Sprintf("failed to update OAuth app auth request: %v", err),
+			}
+		}
+	}
+
+	return nil
+}
+
+// authorizeError returns an error for an authorization request that can't be redirected back to the client. Browsers
+// are shown an error page, and other clients get the JSON error.
+func authorizeError(req api.Context, err Error) error {
+	status := statusForError(err.Code)
+	if wantsHTML(req) {
+		return renderErrorPage(req, status, err)
+	}
+	return types.NewErrHTTP(status, err.Error())
+}
+
+// renderConsentPage asks the user to continue to the authorization server of an MCP server, listing the scopes that
+// the authorization URL requests.
+func renderConsentPage(req api.Context, mcpServer v1.MCPServer, authURL string, scopeGroups []types.MCPOAuthScopeGroup) error {
+	provider := authURL
+	var permissions []string
+	if u, err := url.Parse(authURL); err == nil && u.Host != "" {
+		provider = u.Hostname()
+		permissions = consentPermissions(strings.Fields(u.Query().Get("scope")), scopeGroups)
+	}
+
+	return renderPage(req, http.StatusOK, page{
+		Kind:        pageConsent,
+		ActionURL:   authURL,
+		Permissions: permissions,
+		Args: map[string]string{
+			"server":   cmp.Or(mcpServer.Spec.Manifest.Name, mcpServer.Name),
+			"provider": provider,
+		},
+	})
+}
+
 func redirectWithAuthorizeError(req api.Context, redirectURI string, err Error) {
 	http.Redirect(req.ResponseWriter, req.Request, redirectURI+"?"+err.toQuery().Encode(), http.StatusFound)
 }
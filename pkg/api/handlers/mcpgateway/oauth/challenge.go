@@ -0,0 +1,321 @@
+package oauth
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/server/requestinfo"
+)
+
+// ChallengeProvider is the service that decides whether a request to a public OAuth endpoint came from a person.
+type ChallengeProvider string
+
+const (
+	ChallengeProviderNone      ChallengeProvider = ""
+	ChallengeProviderTurnstile ChallengeProvider = "turnstile"
+	ChallengeProviderHCaptcha  ChallengeProvider = "hcaptcha"
+	ChallengeProviderWebhook   ChallengeProvider = "webhook"
+
+	challengeCookie         = "obot_oauth_challenge"
+	challengePassedDuration = time.Hour
+	challengeTimeout        = 10 * time.Second
+)
+
+var challengeVerifyURLs = map[ChallengeProvider]string{
+	ChallengeProviderTurnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+	ChallengeProviderHCaptcha:  "https://api.hcaptcha.com/siteverify",
+}
+
+var challengeScripts = map[ChallengeProvider]struct{ src, class string }{
+	ChallengeProviderTurnstile: {src: "https://challenges.cloudflare.com/turnstile/v0/api.js", class: "cf-turnstile"},
+	ChallengeProviderHCaptcha:  {src: "https://js.hcaptcha.com/1/api.js", class: "h-captcha"},
+}
+
+// ChallengeConfig configures the bot protection of the authorization and dynamic client registration endpoints.
+type ChallengeConfig struct {
+	Provider ChallengeProvider
+	// SiteKey is the public key of the Turnstile or hCaptcha widget.
+	SiteKey string
+	// SecretKey verifies Turnstile and hCaptcha responses, and signs the requests to the webhook if set.
+	SecretKey string
+	// WebhookURL is where requests are sent for the webhook to allow or deny.
+	WebhookURL string
+}
+
+// Validate returns an error if the provider is unknown or is missing its keys.
+func (c ChallengeConfig) Validate() error {
+	switch c.Provider {
+	case ChallengeProviderNone:
+	case ChallengeProviderTurnstile, ChallengeProviderHCaptcha:
+		if c.SiteKey == "" || c.SecretKey == "" {
+			return fmt.Errorf("the %s OAuth challenge requires a site key and a secret key", c.Provider)
+		}
+	case ChallengeProviderWebhook:
+		if _, err := url.ParseRequestURI(c.WebhookURL); err != nil {
+			return fmt.Errorf("the webhook OAuth challenge requires a valid webhook URL: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid OAuth challenge provider %q: must be one of turnstile, hcaptcha, or webhook", c.Provider)
+	}
+	return nil
+}
+
+// ChallengeWebhookRequest is sent to the challenge webhook for each authorization and dynamic client registration
+// request.
+type ChallengeWebhookRequest struct {
+	// Endpoint is "authorize" or "register".
+	Endpoint     string   `json:"endpoint"`
+	SourceIP     string   `json:"sourceIP"`
+	UserAgent    string   `json:"userAgent,omitempty"`
+	ClientID     string   `json:"clientID,omitempty"`
+	ClientName   string   `json:"clientName,omitempty"`
+	RedirectURI  string   `json:"redirectURI,omitempty"`
+	RedirectURIs []string `json:"redirectURIs,omitempty"`
+}
+
+// ChallengeWebhookResponse is the challenge webhook's decision.
+type ChallengeWebhookResponse struct {
+	Allow  bool   `json:"allow"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type challenger struct {
+	config     ChallengeConfig
+	httpClient *http.Client
+}
+
+func newChallenger(config ChallengeConfig) *challenger {
+	if config.Provider != ChallengeProviderNone {
+		log.Infof("OAuth endpoint bot protection: %s", config.Provider)
+	}
+	return &challenger{
+		config:     config,
+		httpClient: &http.Client{Timeout: challengeTimeout},
+	}
+}
+
+// checkRegistration returns an error if the webhook denies a dynamic client registration. Turnstile and hCaptcha
+// don't protect registration, because MCP clients register without a person in the loop.
+func (c *challenger) checkRegistration(req api.Context, manifest types.OAuthClientManifest) error {
+	if c.config.Provider != ChallengeProviderWebhook {
+		return nil
+	}
+
+	if err := c.callWebhook(req, ChallengeWebhookRequest{
+		Endpoint:     "register",
+		ClientName:   manifest.ClientName,
+		RedirectURIs: manifest.RedirectURIs,
+	}); err != nil {
+		return types.NewErrHTTP(statusForError(err.Code), err.Error())
+	}
+	return nil
+}
+
+// checkAuthorization returns whether an authorization request may continue. If it may not, the response was already
+// written: either the page with the challenge, or the webhook's denial.
+func (c *challenger) checkAuthorization(req api.Context, clientID, redirectURI, state string) (bool, error) {
+	switch c.config.Provider {
+	case ChallengeProviderNone:
+		return true, nil
+	case ChallengeProviderWebhook:
+		err := c.callWebhook(req, ChallengeWebhookRequest{
+			Endpoint:    "authorize",
+			ClientID:    clientID,
+			RedirectURI: redirectURI,
+		})
+		if err == nil {
+			return true, nil
+		}
+		err.State = state
+		return false, authorizeError(req, *err)
+	}
+
+	if cookie, err := req.Request.Cookie(challengeCookie); err == nil && c.validPass(cookie.Value, time.Now()) {
+		return true, nil
+	}
+
+	script := challengeScripts[c.config.Provider]
+	return false, renderPage(req, http.StatusOK, page{
+		Kind:      pageChallenge,
+		ActionURL: "/oauth/challenge?" + url.Values{"rd": {req.URL.RequestURI()}}.Encode(),
+		Challenge: &pageChallengeWidget{
+			Script:  script.src,
+			Class:   script.class,
+			SiteKey: c.config.SiteKey,
+		},
+	})
+}
+
+// verify handles the form of the challenge page: it checks the response with the provider, remembers that the user
+// passed in a cookie, and sends them back to the authorization request.
+func (c *challenger) verify(req api.Context) error {
+	if _, ok := challengeVerifyURLs[c.config.Provider]; !ok {
+		return types.NewErrNotFound("no OAuth challenge is configured")
+	}
+
+	rd := req.URL.Query().Get("rd")
+	if !strings.HasPrefix(rd, "/oauth/authorize") {
+		return types.NewErrBadRequest("invalid redirect")
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return types.NewErrBadRequest("invalid form: %v", err)
+	}
+
+	field := "cf-turnstile-response"
+	if c.config.Provider == ChallengeProviderHCaptcha {
+		field = "h-captcha-response"
+	}
+	if err := c.verifyResponse(req.Context(), req.PostFormValue(field), requestinfo.GetSourceIP(req.Request)); err != nil {
+		log.Infof("Denied OAuth challenge response: error=%v", err)
+		return authorizeError(req, Error{Code: ErrAccessDenied, Description: err.Error()})
+	}
+
+	http.SetCookie(req.ResponseWriter, &http.Cookie{
+		Name:     challengeCookie,
+		Value:    c.pass(time.Now().Add(challengePassedDuration)),
+		Path:     "/oauth/",
+		MaxAge:   int(challengePassedDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   req.Request.TLS != nil || strings.EqualFold(req.Request.Header.Get("X-Forwarded-Proto"), "https"),
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(req.ResponseWriter, req.Request, rd, http.StatusSeeOther)
+	return nil
+}
+
+// verifyResponse asks Turnstile or hCaptcha whether the response to a challenge is valid.
+func (c *challenger) verifyResponse(ctx context.Context, response, sourceIP string) error {
+	if response == "" {
+		return fmt.Errorf("the challenge was not completed")
+	}
+
+	form := url.Values{
+		"secret":   {c.config.SecretKey},
+		"response": {response},
+		"remoteip": {sourceIP},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, challengeVerifyURLs[c.config.Provider], strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to verify the challenge: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode challenge verification: %w", err)
+	}
+	if !result.Success {
+		return fmt.Errorf("the challenge was not passed: %s", strings.Join(result.ErrorCodes, ", "))
+	}
+	return nil
+}
+
+// callWebhook asks the webhook whether a request is allowed. Requests are denied if the webhook can't be reached, so
+// that an outage of the webhook doesn't leave the endpoints unprotected.
+func (c *challenger) callWebhook(req api.Context, body ChallengeWebhookRequest) *Error {
+	body.SourceIP = requestinfo.GetSourceIP(req.Request)
+	body.UserAgent = req.Request.UserAgent()
+
+	unavailable := &Error{
+		Code:        ErrTemporarilyUnavailable,
+		Description: "the request could not be checked, try again later",
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		log.Errorf("Failed to marshal OAuth challenge webhook request: endpoint=%s error=%v", body.Endpoint, err)
+		return unavailable
+	}
+
+	webhookReq, err := http.NewRequestWithContext(req.Context(), http.MethodPost, c.config.WebhookURL, bytes.NewReader(b))
+	if err != nil {
+		log.Errorf("Failed to create OAuth challenge webhook request: endpoint=%s error=%v", body.Endpoint, err)
+		return unavailable
+	}
+	webhookReq.Header.Set("Content-Type", "application/json")
+	if c.config.SecretKey != "" {
+		webhooksignature.SetHeaders(webhookReq.Header, c.config.SecretKey, time.Now(), b)
+	}
+
+	resp, err := c.httpClient.Do(webhookReq)
+	if err != nil {
+		log.Errorf("Failed to call OAuth challenge webhook: endpoint=%s error=%v", body.Endpoint, err)
+		return unavailable
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Errorf("OAuth challenge webhook returned an error: endpoint=%s status=%d", body.Endpoint, resp.StatusCode)
+		return unavailable
+	}
+
+	var result ChallengeWebhookResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result); err != nil {
+		log.Errorf("Failed to decode OAuth challenge webhook response: endpoint=%s error=%v", body.Endpoint, err)
+		return unavailable
+	}
+
+	if !result.Allow {
+		log.Infof("OAuth challenge webhook denied request: endpoint=%s sourceIP=%s reason=%s", body.Endpoint, body.SourceIP, result.Reason)
+		return &Error{
+			Code:        ErrAccessDenied,
+			Description: cmp.Or(result.Reason, "the request was denied"),
+		}
+	}
+	return nil
+}
+
+// pass returns the value of a cookie that shows that the user passed the challenge, until the expiry.
+func (c *challenger) pass(expiry time.Time) string {
+	exp := strconv.FormatInt(expiry.Unix(), 10)
+	return exp + "." + base64.RawURLEncoding.EncodeToString(c.mac(exp))
+}
+
+// validPass returns whether a cookie shows that the user passed the challenge and hasn't expired.
+func (c *challenger) validPass(value string, now time.Time) bool {
+	exp, sig, ok := strings.Cut(value, ".")
+	if !ok {
+		return false
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(decoded, c.mac(exp)) {
+		return false
+	}
+
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	return err == nil && now.Before(time.Unix(unix, 0))
+}
+
+// mac signs a value with a key derived from the secret key, so that every replica accepts the same cookies.
+func (c *challenger) mac(value string) []byte {
+	key := sha256.Sum256([]byte("obot-oauth-challenge:" + c.config.SecretKey))
+	h := hmac.New(sha256.New, key[:])
+	h.Write([]byte(value))
+	return h.Sum(nil)
+}
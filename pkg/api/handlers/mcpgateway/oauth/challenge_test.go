@@ -0,0 +1,135 @@
+package oauth
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
+	"github.com/obot-platform/obot/pkg/api"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestChallengeConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		config  ChallengeConfig
+		wantErr bool
+	}{
+		"disabled":         {config: ChallengeConfig{}},
+		"turnstile":        {config: ChallengeConfig{Provider: ChallengeProviderTurnstile, SiteKey: "site", SecretKey: "secret"}},
+		"hcaptcha no keys": {config: ChallengeConfig{Provider: ChallengeProviderHCaptcha}, wantErr: true},
+		"webhook":          {config: ChallengeConfig{Provider: ChallengeProviderWebhook, WebhookURL: "https://example.com/check"}},
+		"webhook no URL":   {config: ChallengeConfig{Provider: ChallengeProviderWebhook}, wantErr: true},
+		"unknown provider": {config: ChallengeConfig{Provider: "recaptcha"}, wantErr: true},
+	}
+	for name, tt := range tests {
+		if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestChallengePass(t *testing.T) {
+	c := newChallenger(ChallengeConfig{Provider: ChallengeProviderTurnstile, SiteKey: "site", SecretKey: "secret"})
+	now := time.Now()
+	pass := c.pass(now.Add(time.Hour))
+
+	if !c.validPass(pass, now) {
+		t.Error("expected pass to be valid")
+	}
+	if c.validPass(pass, now.Add(2*time.Hour)) {
+		t.Error("expected expired pass to be invalid")
+	}
+
+	exp, sig, _ := strings.Cut(pass, ".")
+	if c.validPass(exp+"0."+sig, now) {
+		t.Error("expected pass with a changed expiry to be invalid")
+	}
+
+	other := newChallenger(ChallengeConfig{Provider: ChallengeProviderTurnstile, SiteKey: "site", SecretKey: "other"})
+	if other.validPass(pass, now) {
+		t.Error("expected pass signed with another secret to be invalid")
+	}
+}
+
+func TestChallengeAuthorizationPage(t *testing.T) {
+	c := newChallenger(ChallengeConfig{Provider: ChallengeProviderHCaptcha, SiteKey: "site-key", SecretKey: "secret"})
+	storage := fake.NewClientBuilder().WithScheme(storagescheme.Scheme).Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?client_id=a:b&state=s", nil)
+	rec := httptest.NewRecorder()
+	ok, err := c.checkAuthorization(api.Context{ResponseWriter: rec, Request: req, Storage: storage}, "a:b", "", "s")
+	if ok || err != nil {
+		t.Fatalf("checkAuthorization() = %v, %v, want the challenge page", ok, err)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		`src="https://js.hcaptcha.com/1/api.js"`,
+		`class="h-captcha" data-sitekey="site-key"`,
+		`action="/oauth/challenge?rd=%2Foauth%2Fauthorize%3Fclient_id%3Da%3Ab%26state%3Ds"`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("challenge page is missing %s", want)
+		}
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/oauth/authorize?client_id=a:b&state=s", nil)
+	req.AddCookie(&http.Cookie{Name: challengeCookie, Value: c.pass(time.Now().Add(time.Minute))})
+	if ok, err = c.checkAuthorization(api.Context{ResponseWriter: httptest.NewRecorder(), Request: req, Storage: storage}, "a:b", "", "s"); !ok || err != nil {
+		t.Fatalf("checkAuthorization() with a pass = %v, %v, want allowed", ok, err)
+	}
+}
+
+func TestChallengeWebhook(t *testing.T) {
+	var received ChallengeWebhookRequest
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := webhooksignature.Verify("secret", r.Header, body, time.Minute, time.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err := json.Unmarshal(body, &received); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(ChallengeWebhookResponse{
+			Allow:  received.ClientName != "spam",
+			Reason: "looks automated",
+		})
+	}))
+	defer webhook.Close()
+
+	c := newChallenger(ChallengeConfig{Provider: ChallengeProviderWebhook, WebhookURL: webhook.URL, SecretKey: "secret"})
+	newContext := func() api.Context {
+		req := httptest.NewRequest(http.MethodPost, "/oauth/register", nil)
+		req.Header.Set("User-Agent", "test-agent")
+		return api.Context{ResponseWriter: httptest.NewRecorder(), Request: req}
+	}
+
+	if err := c.checkRegistration(newContext(), types.OAuthClientManifest{ClientName: "editor", RedirectURIs: []string{"http://localhost/callback"}}); err != nil {
+		t.Fatalf("checkRegistration() error = %v", err)
+	}
+	if received.Endpoint != "register" || received.UserAgent != "test-agent" || received.SourceIP == "" || len(received.RedirectURIs) != 1 {
+		t.Errorf("unexpected webhook request: %+v", received)
+	}
+
+	err := c.checkRegistration(newContext(), types.OAuthClientManifest{ClientName: "spam"})
+	var httpErr *types.ErrHTTP
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusForbidden || !strings.Contains(httpErr.Message, "looks automated") {
+		t.Errorf("checkRegistration() error = %v, want a forbidden access_denied error", err)
+	}
+
+	webhook.Close()
+	err = c.checkRegistration(newContext(), types.OAuthClientManifest{ClientName: "editor"})
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusServiceUnavailable {
+		t.Errorf("checkRegistration() error = %v, want the request denied while the webhook is unavailable", err)
+	}
+}
@@ -23,6 +23,10 @@ func (h *handler) register(req api.Context) error {
 		return types.NewErrBadRequest("invalid request body: %v", err)
 	}
 
+	if err := h.challenger.checkRegistration(req, oauthClientManifest); err != nil {
+		return err
+	}
+
 	clientID := system.OAuthClientPrefix + strings.ToLower(rand.Text())
 
 	oauthClient := v1.OAuthClient{
@@ -91,6 +91,17 @@ func (h *handler) checkCompositeAuth(req api.Context) error {
 		return req.Write(pending)
 	}
 
+	if oauthAuthRequestID != "" && authRequest.Spec.GrantType == deviceCodeGrantType {
+		// All pending second level OAuth requests are complete, so the device can poll for its token.
+		if err := approveDevice(req, &authRequest); err != nil {
+			return err
+		}
+		log.Infof("Composite OAuth completed for device authorization: compositeMCPID=%s authRequest=%s", compositeMCPID, authRequest.Name)
+		return req.Write(map[string]string{
+			"redirect_uri": h.baseURL + "/oauth/device?complete=true",
+		})
+	}
+
 	if oauthAuthRequestID != "" {
 		// All pending second level OAuth requests are complete, so produce a new authorization code and return redirect URL as JSON for client-side redirect.
 		code := strings.ToLower(rand.Text() + rand.Text())
@@ -0,0 +1,108 @@
+package oauth
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"gorm.io/gorm"
+)
+
+// consent shows the user which scopes an MCP server requests before continuing to its authorization server. The
+// authorization URL must belong to an OAuth flow that the user started, so the page can't be used to redirect anywhere
+// else.
+func (h *handler) consent(req api.Context) error {
+	authURL := req.URL.Query().Get("auth_url")
+	u, err := url.Parse(authURL)
+	if err != nil || u.Query().Get("state") == "" {
+		return authorizeError(req, Error{
+			Code:        ErrInvalidRequest,
+			Description: "invalid authorization URL",
+		})
+	}
+
+	ps, err := h.oauthChecker.stateMgr.gatewayClient.GetMCPOAuthPendingState(req.Context(), u.Query().Get("state"))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return authorizeError(req, Error{
+			Code:        ErrInvalidRequest,
+			Description: "the authorization expired, start connecting to the server again",
+		})
+	} else if err != nil {
+		return fmt.Errorf("failed to get OAuth state: %w", err)
+	}
+
+	if ps.UserID != req.User.GetUID() || !sameEndpoint(u, ps.AuthURL) {
+		return authorizeError(req, Error{
+			Code:        ErrInvalidRequest,
+			Description: "invalid authorization URL",
+		})
+	}
+
+	mcpServerName := ps.MCPID
+	if system.IsMCPServerInstanceID(ps.MCPID) {
+		var instance v1.MCPServerInstance
+		if err := req.Get(&instance, ps.MCPID); err != nil {
+			return err
+		}
+		mcpServerName = instance.Spec.MCPServerName
+	}
+
+	var mcpServer v1.MCPServer
+	if err := req.Get(&mcpServer, mcpServerName); err != nil {
+		return err
+	}
+
+	scopeGroups, _, err := h.oauthChecker.scopeGroups(req.Context(), mcpServer)
+	if err != nil {
+		return err
+	}
+
+	return renderConsentPage(req, mcpServer, authURL, scopeGroups)
+}
+
+// sameEndpoint returns true if the URL is for the authorization endpoint, ignoring its query.
+func sameEndpoint(u *url.URL, endpoint string) bool {
+	e, err := url.Parse(endpoint)
+	return err == nil && u.Scheme == e.Scheme && u.Host == e.Host && u.Path == e.Path
+}
+
+// consentPermissions describes the requested scopes to the user. Scopes of the scope groups of the server's catalog
+// entry are described by their groups, and the other scopes are listed as they are.
+func consentPermissions(scopes []string, scopeGroups []types.MCPOAuthScopeGroup) []string {
+	var (
+		permissions []string
+		described   = make(map[string]bool, len(scopes))
+	)
+	for _, group := range scopeGroups {
+		var requested []string
+		for _, scope := range group.Scopes {
+			if slices.Contains(scopes, scope) {
+				requested = append(requested, scope)
+				described[scope] = true
+			}
+		}
+		if len(requested) == 0 {
+			continue
+		}
+
+		name := group.Name
+		if group.Description != "" {
+			name = group.Description
+		}
+		permissions = append(permissions, fmt.Sprintf("%s (%s)", name, strings.Join(requested, ", ")))
+	}
+
+	for _, scope := range scopes {
+		if !described[scope] {
+			permissions = append(permissions, scope)
+		}
+	}
+
+	return permissions
+}
@@ -0,0 +1,418 @@
+package oauth
+
+import (
+	"cmp"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/handlers"
+	"github.com/obot-platform/obot/pkg/auth"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/selectors"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	deviceCodeGrantType  = handlers.DeviceCodeGrantType
+	deviceCodeExpiration = 10 * time.Minute
+	devicePollInterval   = 5 * time.Second
+
+	// deviceCSRFCookie holds the CSRF token of the user's browser, which the confirm page posts back with its form, so
+	// that other sites can't approve a device authorization request for the user.
+	deviceCSRFCookie = "obot_device_csrf"
+
+	// userCodeAlphabet has no vowels, so that user codes don't spell words, and no characters that are easily confused.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+
+	ErrAuthorizationPending ErrorCode = "authorization_pending"
+	ErrSlowDown             ErrorCode = "slow_down"
+	ErrExpiredToken         ErrorCode = "expired_token"
+)
+
+// DeviceAuthorizationResponse represents an RFC 8628 device authorization response
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// deviceAuthorization starts a device authorization grant for clients that can't open a browser, such as CLIs. The
+// user enters the returned user code at the verification URI while the client polls the token endpoint.
+func (h *handler) deviceAuthorization(req api.Context) error {
+	if err := req.ParseForm(); err != nil {
+		return types.NewErrBadRequest("failed to parse request body: %v", err)
+	}
+
+	oauthClient, err := authenticateClient(req)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(oauthClient.Spec.Manifest.GrantTypes, deviceCodeGrantType) {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrUnauthorizedClient,
+			Description: "client is not allowed to use the device_code grant type",
+		})
+	}
+
+	mcpID := req.PathValue("mcp_id")
+	resource := req.FormValue("resource")
+	if resource != "" {
+		u, err := url.Parse(resource)
+		if err != nil {
+			return types.NewErrBadRequest("%v", Error{
+				Code:        ErrInvalidRequest,
+				Description: fmt.Sprintf("invalid resource URL: %s", resource),
+			})
+		}
+
+		if mcpID == "" {
+			mcpID = strings.TrimPrefix(u.Path, "/mcp-connect/")
+		} else if !strings.HasSuffix(u.Path, "/"+mcpID) {
+			return types.NewErrBadRequest("%v", Error{
+				Code:        ErrInvalidRequest,
+				Description: fmt.Sprintf("resource doesn't match mcp_id: %s", mcpID),
+			})
+		}
+	}
+
+	deviceCode := strings.ToLower(rand.Text() + rand.Text())
+	userCode := newUserCode()
+	oauthAuthRequest := v1.OAuthAuthRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.OAuthAppPrefix,
+			Namespace:    oauthClient.Namespace,
+		},
+		Spec: v1.OAuthAuthRequestSpec{
+			Scope:            clientScope(oauthClient, req.FormValue("scope")),
+			Resource:         resource,
+			ClientID:         oauthClient.Name,
+			GrantType:        deviceCodeGrantType,
+			MCPID:            mcpID,
+			HashedDeviceCode: hashCode(deviceCode),
+			HashedUserCode:   hashCode(userCode),
+		},
+	}
+	if err = req.Create(&oauthAuthRequest); err != nil {
+		return fmt.Errorf("failed to create device authorization request: %w", err)
+	}
+	log.Infof("Created OAuth device authorization request: authRequest=%s client=%s requestedMCPID=%s", oauthAuthRequest.Name, oauthClient.Name, mcpID)
+
+	verificationURI := h.baseURL + "/oauth/device"
+	return req.Write(DeviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:],
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?" + url.Values{"user_code": {userCode}}.Encode(),
+		ExpiresIn:               int(deviceCodeExpiration.Seconds()),
+		Interval:                int(devicePollInterval.Seconds()),
+	})
+}
+
+// device is the verification page of the device authorization grant. The user enters the code shown by the client,
+// and then approves or denies its request.
+func (h *handler) device(req api.Context) error {
+	if err := req.ParseForm(); err != nil {
+		return types.NewErrBadRequest("failed to parse request body: %v", err)
+	}
+
+	userCode := normalizeUserCode(req.FormValue("user_code"))
+	if !req.UserIsAuthenticated() || req.User.GetName() == "bootstrap" {
+		rd := "/oauth/device"
+		if userCode != "" {
+			rd += "?" + url.Values{"user_code": {userCode}}.Encode()
+		}
+		http.Redirect(req.ResponseWriter, req.Request, "/?"+url.Values{"rd": {rd}}.Encode(), http.StatusFound)
+		return nil
+	}
+
+	if req.FormValue("complete") != "" {
+		return renderPage(req, http.StatusOK, page{Kind: pageSuccess})
+	}
+
+	if userCode == "" {
+		return renderPage(req, http.StatusOK, page{Kind: pageDevice, ActionURL: "/oauth/device"})
+	}
+
+	oauthAuthRequest, err := pendingDeviceAuthRequest(req, userCode)
+	if err != nil {
+		return err
+	} else if oauthAuthRequest == nil {
+		return renderPage(req, http.StatusBadRequest, page{
+			Kind:      pageDevice,
+			ActionURL: "/oauth/device",
+			Detail:    "The code is invalid or expired. Check the code shown by the application, or start connecting again.",
+		})
+	}
+
+	if req.Method != http.MethodPost {
+		var oauthClient v1.OAuthClient
+		if err = req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: oauthAuthRequest.Namespace, Name: oauthAuthRequest.Spec.ClientID}, &oauthClient); err != nil {
+			return err
+		}
+
+		server := "Obot"
+		if oauthAuthRequest.Spec.MCPID != "" {
+			_, mcpServer, _, err := handlers.ServerForActionWithConnectID(req, oauthAuthRequest.Spec.MCPID)
+			if err != nil {
+				return err
+			}
+			server = cmp.Or(mcpServer.Spec.Manifest.Name, mcpServer.Name)
+		}
+
+		return renderPage(req, http.StatusOK, page{
+			Kind:      pageDeviceConfirm,
+			ActionURL: "/oauth/device",
+			UserCode:  userCode,
+			CSRFToken: setDeviceCSRFCookie(req),
+			Args: map[string]string{
+				"client": cmp.Or(oauthClient.Spec.Manifest.ClientName, oauthClient.Name),
+				"server": server,
+				"code":   userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:],
+			},
+		})
+	}
+
+	if !validDeviceCSRFToken(req) {
+		log.Infof("Rejected OAuth device authorization form without a valid CSRF token: authRequest=%s", oauthAuthRequest.Name)
+		return renderErrorPage(req, http.StatusForbidden, Error{
+			Code:        ErrAccessDenied,
+			Description: "the form expired or was not submitted from this page, enter the code again",
+		})
+	}
+
+	if req.FormValue("action") != "approve" {
+		oauthAuthRequest.Spec.DeviceDenied = true
+		if err = req.Update(oauthAuthRequest); err != nil {
+			return err
+		}
+		log.Infof("User denied OAuth device authorization request: authRequest=%s", oauthAuthRequest.Name)
+		return renderErrorPage(req, http.StatusForbidden, Error{Code: ErrAccessDenied})
+	}
+
+	authProviderName, authProviderNamespace := req.AuthProviderNameAndNamespace()
+	if authProviderName == "bootstrap" || authProviderNamespace == "bootstrap" {
+		return renderErrorPage(req, http.StatusForbidden, Error{
+			Code:        ErrAccessDenied,
+			Description: "user is not authenticated",
+		})
+	}
+
+	if oauthErr := h.resolveConnectMCPID(req, oauthAuthRequest); oauthErr != nil {
+		return renderErrorPage(req, statusForError(oauthErr.Code), *oauthErr)
+	}
+
+	oauthAuthRequest.Spec.UserID = req.UserID()
+	oauthAuthRequest.Spec.AuthProviderUserID = auth.FirstExtraValue(req.User.GetExtra(), "auth_provider_user_id")
+	oauthAuthRequest.Spec.AuthProviderNamespace = authProviderNamespace
+	oauthAuthRequest.Spec.AuthProviderName = authProviderName
+
+	if mcpID := oauthAuthRequest.Spec.MCPID; mcpID != "" {
+		// Check whether the MCP server needs authentication, which completes the device authorization when it's done.
+		mcpID, mcpServer, mcpServerConfig, err := handlers.ServerForActionWithConnectID(req, mcpID)
+		if err != nil {
+			return err
+		}
+
+		u, err := h.oauthChecker.CheckForMCPAuth(req, mcpServer, mcpServerConfig, req.User.GetUID(), mcpID, oauthAuthRequest.Name)
+		if err != nil {
+			return renderErrorPage(req, http.StatusInternalServerError, Error{
+				Code:        ErrServerError,
+				Description: err.Error(),
+			})
+		}
+
+		if u != "" {
+			if err = req.Update(oauthAuthRequest); err != nil {
+				return err
+			}
+
+			log.Infof("OAuth device authorization requires second-level MCP authentication: authRequest=%s mcpID=%s", oauthAuthRequest.Name, mcpID)
+			scopeGroups, _, err := h.oauthChecker.scopeGroups(req.Context(), mcpServer)
+			if err != nil {
+				// The scope groups only describe the requested scopes, so the page can be shown without them.
+				log.Warnf("Failed to get OAuth scope groups of MCP server %s: %v", mcpServer.Name, err)
+			}
+			return renderConsentPage(req, mcpServer, u, scopeGroups)
+		}
+	}
+
+	if err = approveDevice(req, oauthAuthRequest); err != nil {
+		return err
+	}
+	return renderPage(req, http.StatusOK, page{Kind: pageSuccess})
+}
+
+// setDeviceCSRFCookie returns the CSRF token of the user's browser, and sets a new one if the browser doesn't have one.
+func setDeviceCSRFCookie(req api.Context) string {
+	if cookie, err := req.Request.Cookie(deviceCSRFCookie); err == nil && cookie.Value != "" {
+		return cookie.Value
+	}
+
+	token := rand.Text()
+	http.SetCookie(req.ResponseWriter, &http.Cookie{
+		Name:     deviceCSRFCookie,
+		Value:    token,
+		Path:     "/oauth/device",
+		HttpOnly: true,
+		Secure:   req.Request.TLS != nil || strings.EqualFold(req.Request.Header.Get("X-Forwarded-Proto"), "https"),
+		SameSite: http.SameSiteStrictMode,
+	})
+	return token
+}
+
+// validDeviceCSRFToken returns whether the form of a confirm page was posted with the CSRF token of the user's browser.
+// Other sites can post the form, but they can neither read nor set the cookie.
+func validDeviceCSRFToken(req api.Context) bool {
+	cookie, err := req.Request.Cookie(deviceCSRFCookie)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(req.Request.PostFormValue("csrf_token"))) == 1
+}
+
+// approveDevice records that the user approved a device authorization request, so that the client's next poll of the
+// token endpoint returns a token.
+func approveDevice(req api.Context, oauthAuthRequest *v1.OAuthAuthRequest) error {
+	oauthAuthRequest.Spec.DeviceApproved = true
+	if err := req.Update(oauthAuthRequest); err != nil {
+		return fmt.Errorf("failed to approve device authorization request: %w", err)
+	}
+
+	log.Infof("User approved OAuth device authorization request: authRequest=%s client=%s", oauthAuthRequest.Name, oauthAuthRequest.Spec.ClientID)
+	return nil
+}
+
+// pendingDeviceAuthRequest returns the device authorization request of a user code that the user hasn't approved or
+// denied yet, or nil if there is none.
+func pendingDeviceAuthRequest(req api.Context, userCode string) (*v1.OAuthAuthRequest, error) {
+	var oauthAuthRequestList v1.OAuthAuthRequestList
+	if err := req.Storage.List(req.Context(), &oauthAuthRequestList, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(selectors.RemoveEmpty(map[string]string{
+			"spec.hashedUserCode": hashCode(userCode),
+		})),
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, oauthAuthRequest := range oauthAuthRequestList.Items {
+		if oauthAuthRequest.Spec.DeviceApproved || oauthAuthRequest.Spec.DeviceDenied || oauthAuthRequest.Spec.UserID != 0 ||
+			time.Since(oauthAuthRequest.CreationTimestamp.Time) > deviceCodeExpiration {
+			continue
+		}
+		return &oauthAuthRequest, nil
+	}
+
+	return nil, nil
+}
+
+// doDeviceCode answers the client's polls for the token of a device authorization request.
+func (h *handler) doDeviceCode(req api.Context, oauthClient v1.OAuthClient, deviceCode string) error {
+	if deviceCode == "" {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidRequest,
+			Description: "device_code is required",
+		})
+	}
+
+	var oauthAuthRequestList v1.OAuthAuthRequestList
+	if err := req.Storage.List(req.Context(), &oauthAuthRequestList, &kclient.ListOptions{
+		Namespace: oauthClient.Namespace,
+		FieldSelector: fields.SelectorFromSet(selectors.RemoveEmpty(map[string]string{
+			"spec.hashedDeviceCode": hashCode(deviceCode),
+		})),
+	}); err != nil {
+		return err
+	}
+	if len(oauthAuthRequestList.Items) != 1 || oauthAuthRequestList.Items[0].Spec.ClientID != oauthClient.Name {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidRequest,
+			Description: "device_code is invalid",
+		})
+	}
+
+	oauthAuthRequest := oauthAuthRequestList.Items[0]
+	now := time.Now()
+	switch {
+	case oauthAuthRequest.Spec.DeviceDenied:
+		if err := req.Storage.Delete(req.Context(), &oauthAuthRequest); err != nil {
+			log.Warnf("failed to delete auth request: %v", err)
+		}
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrAccessDenied,
+			Description: "the user denied the authorization request",
+		})
+	case now.Sub(oauthAuthRequest.CreationTimestamp.Time) > deviceCodeExpiration:
+		if err := req.Storage.Delete(req.Context(), &oauthAuthRequest); err != nil {
+			log.Warnf("failed to delete auth request: %v", err)
+		}
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrExpiredToken,
+			Description: "device_code is expired",
+		})
+	case !oauthAuthRequest.Spec.DeviceApproved:
+		code := ErrAuthorizationPending
+		if now.Sub(oauthAuthRequest.Spec.LastPollTime.Time) < devicePollInterval {
+			code = ErrSlowDown
+		}
+
+		oauthAuthRequest.Spec.LastPollTime = metav1.NewTime(now)
+		if err := req.Update(&oauthAuthRequest); err != nil {
+			return err
+		}
+		return types.NewErrBadRequest("%v", Error{Code: code})
+	}
+
+	// Device codes are one-time use
+	if err := req.Storage.Delete(req.Context(), &oauthAuthRequest); err != nil {
+		// Don't return an error if we can't delete the auth request
+		log.Warnf("failed to delete auth request: %v", err)
+	}
+
+	return h.issueTokens(req, oauthClient, oauthAuthRequest, deviceCodeGrantType)
+}
+
+// newUserCode returns a random user code that users can type easily.
+func newUserCode() string {
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(userCodeAlphabet))))
+		if err != nil {
+			// crypto/rand doesn't fail on supported platforms
+			panic(err)
+		}
+		code[i] = userCodeAlphabet[n.Int64()]
+	}
+	return string(code)
+}
+
+// normalizeUserCode returns a user code as it was issued, ignoring case, dashes, and spaces that the user typed.
+func normalizeUserCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
+
+func hashCode(code string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(code)))
+}
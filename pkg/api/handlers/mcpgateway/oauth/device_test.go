@@ -0,0 +1,69 @@
+package oauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserCode(t *testing.T) {
+	for range 100 {
+		code := newUserCode()
+		assert.Len(t, code, userCodeLength)
+		assert.Empty(t, strings.Trim(code, userCodeAlphabet))
+		assert.Equal(t, code, normalizeUserCode(code))
+	}
+}
+
+func TestNormalizeUserCode(t *testing.T) {
+	assert.Equal(t, "BCDFGHJK", normalizeUserCode("BCDF-GHJK"))
+	assert.Equal(t, "BCDFGHJK", normalizeUserCode("bcdf ghjk"))
+	assert.Equal(t, hashCode("BCDFGHJK"), hashCode(normalizeUserCode(" bcdf-GHJK ")))
+	assert.Empty(t, normalizeUserCode(""))
+}
+
+func TestDeviceCSRFToken(t *testing.T) {
+	// The confirm page sets a CSRF cookie, and embeds its token in the form.
+	rec := httptest.NewRecorder()
+	token := setDeviceCSRFCookie(api.Context{ResponseWriter: rec, Request: httptest.NewRequest(http.MethodGet, "/oauth/device?user_code=BCDFGHJK", nil)})
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, deviceCSRFCookie, cookies[0].Name)
+	assert.Equal(t, token, cookies[0].Value)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.Equal(t, http.SameSiteStrictMode, cookies[0].SameSite)
+
+	// The existing token of the browser is reused.
+	req := httptest.NewRequest(http.MethodGet, "/oauth/device?user_code=BCDFGHJK", nil)
+	req.AddCookie(cookies[0])
+	rec = httptest.NewRecorder()
+	assert.Equal(t, token, setDeviceCSRFCookie(api.Context{ResponseWriter: rec, Request: req}))
+	assert.Empty(t, rec.Result().Cookies())
+
+	post := func(cookie *http.Cookie, formToken string) api.Context {
+		form := url.Values{"user_code": {"BCDFGHJK"}, "action": {"approve"}}
+		if formToken != "" {
+			form.Set("csrf_token", formToken)
+		}
+		req := httptest.NewRequest(http.MethodPost, "/oauth/device", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		require.NoError(t, req.ParseForm())
+		return api.Context{ResponseWriter: httptest.NewRecorder(), Request: req}
+	}
+
+	assert.True(t, validDeviceCSRFToken(post(cookies[0], token)))
+	// Forms posted by other sites have neither the cookie nor its token.
+	assert.False(t, validDeviceCSRFToken(post(nil, "")))
+	assert.False(t, validDeviceCSRFToken(post(nil, token)))
+	assert.False(t, validDeviceCSRFToken(post(cookies[0], "")))
+	assert.False(t, validDeviceCSRFToken(post(cookies[0], "guessed")))
+}
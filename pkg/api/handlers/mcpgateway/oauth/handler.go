@@ -12,14 +12,16 @@ type handler struct {
 	tokenService *persistent.TokenService
 	oauthConfig  handlers.OAuthAuthorizationServerConfig
 	tokenStore   mcp.GlobalTokenStore
+	challenger   *challenger
 	baseURL      string
 }
 
-func SetupHandlers(oauthChecker *MCPOAuthHandlerFactory, tokenStore mcp.GlobalTokenStore, tokenService *persistent.TokenService, oauthConfig handlers.OAuthAuthorizationServerConfig, baseURL string, mux *server.Server) {
+func SetupHandlers(oauthChecker *MCPOAuthHandlerFactory, tokenStore mcp.GlobalTokenStore, tokenService *persistent.TokenService, oauthConfig handlers.OAuthAuthorizationServerConfig, challengeConfig ChallengeConfig, baseURL string, mux *server.Server) {
 	h := &handler{
 		tokenStore:   tokenStore,
 		tokenService: tokenService,
 		oauthConfig:  oauthConfig,
+		challenger:   newChallenger(challengeConfig),
 		baseURL:      baseURL,
 		oauthChecker: oauthChecker,
 	}
@@ -32,6 +34,14 @@ func SetupHandlers(oauthChecker *MCPOAuthHandlerFactory, tokenStore mcp.GlobalTo
 	mux.HandleFunc("GET /oauth/callback/{oauth_auth_request}/{mcp_id}", h.callback)
 	mux.HandleFunc("POST /oauth/token/{mcp_id}", h.token)
 	mux.HandleFunc("GET /oauth/mcp/callback", h.oauthCallback)
+	mux.HandleFunc("GET /oauth/mcp/consent", h.consent)
+	mux.HandleFunc("POST /oauth/challenge", h.challenger.verify)
+	mux.HandleFunc("POST /oauth/device_authorization/{mcp_id}", h.deviceAuthorization)
+	mux.HandleFunc("POST /oauth/device_authorization", h.deviceAuthorization)
+	mux.HandleFunc("GET /oauth/device", h.device)
+	mux.HandleFunc("POST /oauth/device", h.device)
+	mux.HandleFunc("POST /oauth/revoke", h.revoke)
+	mux.HandleFunc("POST /oauth/introspect", h.introspect)
 
 	// These endpoints allow clients that don't follow the spec to connect to Obot MCP servers.
 	// Such clients will not be able to do second-level OAuth because we aren't able to determine
@@ -44,6 +54,7 @@ func SetupHandlers(oauthChecker *MCPOAuthHandlerFactory, tokenStore mcp.GlobalTo
 
 	mux.HandleFunc("GET /oauth/jwks.json", h.tokenService.ServeJWKS)
 	mux.HandleFunc("POST /oauth/replace-jwks", h.tokenService.ReplaceJWK)
+	mux.HandleFunc("POST /oauth/rotate-jwks", h.tokenService.RotateJWK)
 
 	mux.HandleFunc("GET /api/oauth/composite/{mcp_id}", h.checkCompositeAuth)
 
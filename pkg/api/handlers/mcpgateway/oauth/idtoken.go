@@ -0,0 +1,63 @@
+package oauth
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/obot-platform/obot/pkg/api/handlers"
+	gwtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/jwt/persistent"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// newIDToken returns an OpenID Connect ID token for the user of a grant, or an empty string if the server isn't an
+// OpenID Connect provider or the grant doesn't include the openid scope.
+func (h *handler) newIDToken(ctx context.Context, oauthClient v1.OAuthClient, userID string, user *gwtypes.User, scope, nonce string, now time.Time) (string, error) {
+	scopes := strings.Fields(scope)
+	if !h.oauthConfig.OpenIDProvider() || !slices.Contains(scopes, handlers.OpenIDScope) {
+		return "", nil
+	}
+
+	_, tkn, err := h.tokenService.NewTokenWithClaims(ctx, idTokenClaims(oauthClient, newUserInfo(userID, user, scopes), nonce, now))
+	return tkn, err
+}
+
+// idTokenClaims returns the claims of an ID token. The audience is the client, rather than a resource, and the token
+// type keeps the ID token from being used as an access token.
+func idTokenClaims(oauthClient v1.OAuthClient, userInfo *UserInfoResponse, nonce string, now time.Time) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"aud":       oauthClient.Namespace + ":" + oauthClient.Name,
+		"sub":       userInfo.Sub,
+		"iat":       float64(now.Unix()),
+		"exp":       float64(now.Add(tokenExpiration).Unix()),
+		"TokenType": string(persistent.TokenTypeID),
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	picture := userInfo.Picture
+	if strings.HasPrefix(picture, "data:") {
+		// Don't put base64 encoded images in the token
+		picture = ""
+	}
+	for k, v := range map[string]string{
+		"name":               userInfo.Name,
+		"preferred_username": userInfo.PreferredUsername,
+		"picture":            picture,
+		"zoneinfo":           userInfo.Zoneinfo,
+		"email":              userInfo.Email,
+	} {
+		if v != "" {
+			claims[k] = v
+		}
+	}
+	if userInfo.EmailVerified != nil {
+		claims["email_verified"] = *userInfo.EmailVerified
+	}
+
+	return claims
+}
@@ -0,0 +1,73 @@
+package oauth
+
+import (
+	"testing"
+	"time"
+
+	gwtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/jwt/persistent"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewUserInfo(t *testing.T) {
+	verified := true
+	user := &gwtypes.User{
+		Username:      "jdoe",
+		DisplayName:   "J. Doe",
+		Email:         "jdoe@example.com",
+		VerifiedEmail: &verified,
+		IconURL:       "https://example.com/jdoe.png",
+		Timezone:      "America/New_York",
+	}
+
+	assert.Equal(t, &UserInfoResponse{Sub: "1"}, newUserInfo("1", user, []string{"openid"}))
+	assert.Equal(t, &UserInfoResponse{
+		Sub:           "1",
+		Email:         "jdoe@example.com",
+		EmailVerified: &verified,
+	}, newUserInfo("1", user, []string{"openid", "email"}))
+	assert.Equal(t, &UserInfoResponse{
+		Sub:               "1",
+		Name:              "J. Doe",
+		PreferredUsername: "jdoe",
+		Picture:           "https://example.com/jdoe.png",
+		Zoneinfo:          "America/New_York",
+		Email:             "jdoe@example.com",
+		EmailVerified:     &verified,
+	}, newUserInfo("1", user, []string{"profile"}))
+
+	user.DisplayName = ""
+	assert.Equal(t, "jdoe", newUserInfo("1", user, []string{"profile"}).Name)
+}
+
+func TestIDTokenClaims(t *testing.T) {
+	oauthClient := v1.OAuthClient{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "oc1abc"}}
+	now := time.Unix(1700000000, 0)
+	verified := false
+
+	claims := idTokenClaims(oauthClient, &UserInfoResponse{
+		Sub:           "1",
+		Name:          "jdoe",
+		Picture:       "data:image/png;base64,AAAA",
+		Email:         "jdoe@example.com",
+		EmailVerified: &verified,
+	}, "n-0S6_WzA2Mj", now)
+
+	assert.Equal(t, "default:oc1abc", claims["aud"])
+	assert.Equal(t, "1", claims["sub"])
+	assert.Equal(t, float64(now.Unix()), claims["iat"])
+	assert.Equal(t, float64(now.Add(tokenExpiration).Unix()), claims["exp"])
+	assert.Equal(t, "n-0S6_WzA2Mj", claims["nonce"])
+	assert.Equal(t, string(persistent.TokenTypeID), claims["TokenType"])
+	assert.Equal(t, "jdoe", claims["name"])
+	assert.Equal(t, "jdoe@example.com", claims["email"])
+	assert.Equal(t, false, claims["email_verified"])
+	assert.NotContains(t, claims, "picture")
+	assert.NotContains(t, claims, "preferred_username")
+
+	claims = idTokenClaims(oauthClient, &UserInfoResponse{Sub: "1"}, "", now)
+	assert.NotContains(t, claims, "nonce")
+	assert.NotContains(t, claims, "email_verified")
+}
@@ -17,6 +17,7 @@ import (
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"golang.org/x/oauth2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/fields"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -100,8 +101,13 @@ func (f *MCPOAuthHandlerFactory) CheckForMCPAuth(req api.Context, mcpServer v1.M
 		return "", nil
 	}
 
+	scopeGroups, toolPolicy, err := f.scopeGroups(req.Context(), mcpServer)
+	if err != nil {
+		return "", err
+	}
+
 	// Remote server, check for OAuth directly
-	oauthHandler := f.newMCPOAuthHandler(userID, mcpID, mcpServerConfig.URL, oauthAppAuthRequestID)
+	oauthHandler := f.newMCPOAuthHandler(userID, mcpID, mcpServerConfig.URL, oauthAppAuthRequestID, mcp.OAuthScopesForServer(scopeGroups, toolPolicy, mcpServerConfig))
 	errChan := make(chan error, 1)
 
 	go func() {
@@ -134,6 +140,23 @@ func (f *MCPOAuthHandlerFactory) CheckForMCPAuth(req api.Context, mcpServer v1.M
 	}
 }
 
+// scopeGroups returns the OAuth scope groups and the tool policy of the catalog entry of a server, if it has one.
+func (f *MCPOAuthHandlerFactory) scopeGroups(ctx context.Context, mcpServer v1.MCPServer) ([]types.MCPOAuthScopeGroup, *types.MCPToolPolicy, error) {
+	if mcpServer.Spec.MCPServerCatalogEntryName == "" {
+		return nil, nil, nil
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := f.client.Get(ctx, kclient.ObjectKey{Namespace: mcpServer.Namespace, Name: mcpServer.Spec.MCPServerCatalogEntryName}, &entry); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get catalog entry %s: %w", mcpServer.Spec.MCPServerCatalogEntryName, err)
+	}
+
+	return entry.Spec.Manifest.OAuthScopeGroups, entry.Spec.Manifest.ToolPolicy, nil
+}
+
 type mcpOAuthHandler struct {
 	client             kclient.Client
 	gptscript          *gptscript.GPTScript
@@ -142,10 +165,12 @@ type mcpOAuthHandler struct {
 	mcpURL             string
 	userID             string
 	oauthAuthRequestID string
-	urlChan            chan string
+	// scopes replace the scopes that the server requests, if there are any.
+	scopes  []string
+	urlChan chan string
 }
 
-func (f *MCPOAuthHandlerFactory) newMCPOAuthHandler(userID, mcpID, mcpURL, oauthAuthRequestID string) *mcpOAuthHandler {
+func (f *MCPOAuthHandlerFactory) newMCPOAuthHandler(userID, mcpID, mcpURL, oauthAuthRequestID string, scopes []string) *mcpOAuthHandler {
 	return &mcpOAuthHandler{
 		client:             f.client,
 		gptscript:          f.gptscript,
@@ -154,6 +179,7 @@ func (f *MCPOAuthHandlerFactory) newMCPOAuthHandler(userID, mcpID, mcpURL, oauth
 		mcpID:              mcpID,
 		mcpURL:             mcpURL,
 		oauthAuthRequestID: oauthAuthRequestID,
+		scopes:             scopes,
 		urlChan:            make(chan string, 1),
 	}
 }
@@ -181,6 +207,13 @@ func (m *mcpOAuthHandler) NewState(ctx context.Context, conf *oauth2.Config, ver
 	// callback arrives via a separate HTTP endpoint (oauthCallback) which looks up
 	// the pending state from the DB directly.
 	ch := make(chan nmcp.CallbackPayload)
+
+	// The authorization URL is built from the config after the state is created, so the scopes that the catalog entry
+	// declares for the server's tools are both requested and recorded with the token.
+	if len(m.scopes) > 0 {
+		conf.Scopes = m.scopes
+	}
+
 	return state, ch, m.stateMgr.store(ctx, m.userID, m.mcpID, m.mcpURL, m.oauthAuthRequestID, state, verifier, conf)
 }
 
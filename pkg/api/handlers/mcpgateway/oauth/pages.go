@@ -0,0 +1,278 @@
+package oauth
+
+import (
+	"bytes"
+	"cmp"
+	"embed"
+	"encoding/json"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+)
+
+const defaultPageLocale = "en"
+
+var (
+	//go:embed pages
+	pagesFS embed.FS
+
+	pageTemplate = template.Must(template.ParseFS(pagesFS, "pages/page.html"))
+	pageMessages = loadPageMessages()
+
+	// cssColor matches the colors that are safe to put in the style sheet of a page, such as #4f7ef3 or hsl(0 0 100).
+	cssColor = regexp.MustCompile(`^[#a-zA-Z0-9 .,%()+\-]+$`)
+)
+
+// pageKind is the state of an OAuth flow that a page shows to the user.
+type pageKind string
+
+const (
+	pageConsent pageKind = "consent"
+	pageSuccess pageKind = "success"
+	pageError   pageKind = "error"
+	// pageChallenge asks the user to pass a CAPTCHA before an authorization request continues.
+	pageChallenge pageKind = "challenge"
+	// pageDevice asks the user for the code shown by a device, and pageDeviceConfirm asks the user to approve it.
+	pageDevice        pageKind = "device"
+	pageDeviceConfirm pageKind = "device_confirm"
+)
+
+// page describes a page to show to a user completing an OAuth flow.
+type page struct {
+	Kind pageKind
+	// ErrorCode selects a more specific message for error pages, if the locale has one.
+	ErrorCode ErrorCode
+	// Detail is shown below the message as is, such as the description of an error. It isn't translated.
+	Detail string
+	// ActionURL is the link of the page's button, such as to continue to the authorization server.
+	ActionURL string
+	// Args are substituted into the messages of the page, such as {server}.
+	Args map[string]string
+	// Challenge is the CAPTCHA widget of a challenge page. Its response is posted to ActionURL.
+	Challenge *pageChallengeWidget
+	// Permissions are listed on consent pages, such as the OAuth scopes requested from the authorization server.
+	Permissions []string
+	// UserCode is the device authorization user code that a confirm page approves or denies.
+	UserCode string
+	// CSRFToken is posted with the form of a confirm page, and must match the CSRF cookie of the user's browser.
+	CSRFToken string
+}
+
+// pageDeviceForm is the form of the device authorization pages.
+type pageDeviceForm struct {
+	Confirm                                    bool
+	UserCode, CSRFToken, InputLabel, DenyLabel string
+}
+
+// pageChallengeWidget is a Turnstile or hCaptcha widget.
+type pageChallengeWidget struct {
+	Script, Class, SiteKey string
+}
+
+type pageTheme struct {
+	Background, OnBackground, Surface, Primary                 template.CSS
+	DarkBackground, DarkOnBackground, DarkSurface, DarkPrimary template.CSS
+}
+
+type pageData struct {
+	Lang, Title, Heading, Message, Detail, ActionURL, ActionLabel string
+	Logo                                                          string
+	Theme                                                         pageTheme
+	Challenge                                                     *pageChallengeWidget
+	PermissionsLabel                                              string
+	Permissions                                                   []string
+	DeviceForm                                                    *pageDeviceForm
+}
+
+// wantsHTML returns whether the request came from a browser, rather than an OAuth client expecting a JSON error.
+func wantsHTML(req api.Context) bool {
+	return strings.Contains(req.Request.Header.Get("Accept"), "text/html")
+}
+
+// renderPage writes an HTML page in the user's preferred language, branded with the app preferences.
+func renderPage(req api.Context, status int, p page) error {
+	lang := pageLocale(req.Request.Header.Get("Accept-Language"))
+	messages := pageMessages[lang]
+	message := func(key string) string {
+		m, ok := messages[key]
+		if !ok {
+			m = pageMessages[defaultPageLocale][key]
+		}
+		for name, value := range p.Args {
+			m = strings.ReplaceAll(m, "{"+name+"}", value)
+		}
+		return m
+	}
+
+	data := pageData{
+		Lang:        lang,
+		Title:       message(string(p.Kind) + ".title"),
+		Heading:     message(string(p.Kind) + ".heading"),
+		Message:     message(string(p.Kind) + ".message"),
+		Detail:      p.Detail,
+		ActionURL:   p.ActionURL,
+		ActionLabel: message(string(p.Kind) + ".action"),
+		Challenge:   p.Challenge,
+	}
+	if len(p.Permissions) > 0 {
+		data.PermissionsLabel = message(string(p.Kind) + ".permissions")
+		data.Permissions = p.Permissions
+	}
+	switch p.Kind {
+	case pageDevice:
+		data.DeviceForm = &pageDeviceForm{InputLabel: message("device.input")}
+	case pageDeviceConfirm:
+		data.DeviceForm = &pageDeviceForm{Confirm: true, UserCode: p.UserCode, CSRFToken: p.CSRFToken, DenyLabel: message("device_confirm.deny")}
+	}
+	if p.Kind == pageError && p.ErrorCode != "" {
+		if _, ok := pageMessages[defaultPageLocale]["error."+string(p.ErrorCode)+".message"]; ok {
+			data.Message = message("error." + string(p.ErrorCode) + ".message")
+		}
+	}
+	data.Logo, data.Theme = pageBranding(req, p.Kind)
+
+	var buf bytes.Buffer
+	if err := pageTemplate.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "text/html; charset=utf-8")
+	req.ResponseWriter.Header().Set("Content-Language", lang)
+	req.ResponseWriter.Header().Set("Cache-Control", "no-store")
+	req.ResponseWriter.Header().Set("X-Frame-Options", "DENY")
+	req.ResponseWriter.WriteHeader(status)
+	_, err := req.ResponseWriter.Write(buf.Bytes())
+	return err
+}
+
+// renderErrorPage writes an error page for an OAuth error.
+func renderErrorPage(req api.Context, status int, err Error) error {
+	return renderPage(req, status, page{
+		Kind:      pageError,
+		ErrorCode: err.Code,
+		Detail:    err.Description,
+	})
+}
+
+// pageBranding returns the logo and colors of a page from the app preferences, falling back to Obot's.
+func pageBranding(req api.Context, kind pageKind) (string, pageTheme) {
+	var prefs v1.AppPreferences
+	if err := req.Get(&prefs, system.AppPreferencesName); err != nil {
+		// Branding is cosmetic, so show the defaults if the preferences can't be read.
+		prefs = v1.AppPreferences{}
+	}
+
+	logo := cmp.Or(prefs.Spec.Logos.LogoIcon, "/user/images/obot-icon-blue.svg")
+	if kind == pageError {
+		logo = cmp.Or(prefs.Spec.Logos.LogoIconError, "/user/images/obot-icon-grumpy-blue.svg")
+	}
+
+	theme := prefs.Spec.Theme
+	return logo, pageTheme{
+		Background:       pageColor(theme.BackgroundColor, "hsl(0 0 100)"),
+		OnBackground:     pageColor(theme.OnBackgroundColor, "hsl(0 0 0)"),
+		Surface:          pageColor(theme.Surface1Color, "hsl(0 0 95.5)"),
+		Primary:          pageColor(theme.PrimaryColor, "#4f7ef3"),
+		DarkBackground:   pageColor(theme.DarkBackgroundColor, "hsl(0 0 0)"),
+		DarkOnBackground: pageColor(theme.DarkOnBackgroundColor, "hsl(0 0 97.5)"),
+		DarkSurface:      pageColor(theme.DarkSurface1Color, "hsl(0 0 7.5)"),
+		DarkPrimary:      pageColor(theme.DarkPrimaryColor, "#4f7ef3"),
+	}
+}
+
+// pageColor returns a color from the app preferences if it is safe to use in a style sheet, or the default.
+func pageColor(color, def string) template.CSS {
+	lower := strings.ToLower(color)
+	if !cssColor.MatchString(color) || strings.Contains(lower, "url") || strings.Contains(lower, "expression") {
+		return template.CSS(def)
+	}
+	return template.CSS(color)
+}
+
+// pageLocale returns the locale with messages that best matches an Accept-Language header.
+func pageLocale(acceptLanguage string) string {
+	type preference struct {
+		tag string
+		q   float64
+	}
+
+	var prefs []preference
+	for part := range strings.SplitSeq(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		if tag != "" && q > 0 {
+			prefs = append(prefs, preference{tag: strings.ToLower(tag), q: q})
+		}
+	}
+	slices.SortStableFunc(prefs, func(a, b preference) int {
+		switch {
+		case a.q > b.q:
+			return -1
+		case a.q < b.q:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	for _, p := range prefs {
+		if _, ok := pageMessages[p.tag]; ok {
+			return p.tag
+		}
+		if base, _, _ := strings.Cut(p.tag, "-"); pageMessages[base] != nil {
+			return base
+		}
+	}
+	return defaultPageLocale
+}
+
+// loadPageMessages loads the embedded message catalogs, keyed by lowercase locale.
+func loadPageMessages() map[string]map[string]string {
+	files, err := fs.Glob(pagesFS, "pages/locales/*.json")
+	if err != nil {
+		panic(err)
+	}
+
+	catalogs := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		b, err := pagesFS.ReadFile(file)
+		if err != nil {
+			panic(err)
+		}
+
+		var messages map[string]string
+		if err = json.Unmarshal(b, &messages); err != nil {
+			panic("invalid OAuth page messages in " + file + ": " + err.Error())
+		}
+		catalogs[strings.ToLower(strings.TrimSuffix(path.Base(file), ".json"))] = messages
+	}
+	return catalogs
+}
+
+// statusForError returns the HTTP status of an error page for an OAuth error.
+func statusForError(code ErrorCode) int {
+	switch code {
+	case ErrAccessDenied:
+		return http.StatusForbidden
+	case ErrServerError:
+		return http.StatusInternalServerError
+	case ErrTemporarilyUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadRequest
+	}
+}
@@ -0,0 +1,102 @@
+package oauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPageLocale(t *testing.T) {
+	tests := map[string]string{
+		"":                            "en",
+		"fr-CA,fr;q=0.9,en;q=0.8":     "fr",
+		"ja,de;q=0.5":                 "de",
+		"en;q=0.5,es;q=0.9":           "es",
+		"es;q=0,de":                   "de",
+		"zh-Hant-TW, zh;q=0.9, *;q=1": "en",
+	}
+	for header, want := range tests {
+		if got := pageLocale(header); got != want {
+			t.Errorf("pageLocale(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestLocalesHaveEveryMessage(t *testing.T) {
+	for lang, messages := range pageMessages {
+		for key := range pageMessages[defaultPageLocale] {
+			if messages[key] == "" {
+				t.Errorf("locale %s is missing message %s", lang, key)
+			}
+		}
+	}
+}
+
+func TestRenderPage(t *testing.T) {
+	storage := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithObjects(&v1.AppPreferences{
+			ObjectMeta: metav1.ObjectMeta{Name: system.AppPreferencesName, Namespace: system.DefaultNamespace},
+			Spec: v1.AppPreferencesSpec{
+				Logos: types.LogoPreferences{LogoIcon: "/custom/logo.svg"},
+				Theme: types.ThemePreferences{
+					PrimaryColor:    "hsl(10 20% 30%)",
+					BackgroundColor: "red; background: url(https://example.com)",
+				},
+			},
+		}).
+		Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth/callback/test", nil)
+	req.Header.Set("Accept-Language", "es-MX,es;q=0.9")
+	rec := httptest.NewRecorder()
+
+	err := renderPage(api.Context{ResponseWriter: rec, Request: req, Storage: storage}, http.StatusOK, page{
+		Kind:      pageConsent,
+		ActionURL: "https://auth.example.com/authorize?a=1&b=2",
+		Args:      map[string]string{"server": "<Docs>", "provider": "auth.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("renderPage() error = %v", err)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		`<html lang="es">`,
+		"Conectar &lt;Docs&gt;",
+		`href="https://auth.example.com/authorize?a=1&amp;b=2"`,
+		`src="/custom/logo.svg"`,
+		"--primary: hsl(10 20% 30%);",
+		"--background: hsl(0 0 100);",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected page to contain %q, got:\n%s", want, body)
+		}
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+}
+
+func TestConsentPermissions(t *testing.T) {
+	groups := []types.MCPOAuthScopeGroup{
+		{Name: "read", Description: "Read issues", Scopes: []string{"issues:read"}},
+		{Name: "write", Scopes: []string{"issues:write", "issues:read"}},
+		{Name: "admin", Scopes: []string{"admin"}},
+	}
+
+	got := consentPermissions([]string{"issues:read", "issues:write", "offline_access"}, groups)
+	want := []string{"Read issues (issues:read)", "write (issues:write, issues:read)", "offline_access"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("consentPermissions() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,218 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/jwt/persistent"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/selectors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IntrospectionResponse represents an RFC 7662 token introspection response
+type IntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	Subject   string `json:"sub,omitempty"`
+	Audience  string `json:"aud,omitempty"`
+	MCPID     string `json:"mcp_id,omitempty"`
+}
+
+// revoke implements RFC 7009 token revocation. Revoking an access or refresh token revokes every access and refresh
+// token issued for the same authorization. Unknown tokens are ignored, as the spec requires.
+func (h *handler) revoke(req api.Context) error {
+	if err := req.ParseForm(); err != nil {
+		return types.NewErrBadRequest("failed to parse request body: %v", err)
+	}
+
+	oauthClient, err := authenticateClient(req)
+	if err != nil {
+		return err
+	}
+
+	token := req.FormValue("token")
+	if token == "" {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidRequest,
+			Description: "token is required",
+		})
+	}
+
+	// The hint only decides which kind of token is tried first.
+	revokers := []func(api.Context, v1.OAuthClient, string) (bool, error){h.revokeAccessToken, h.revokeRefreshToken}
+	if req.FormValue("token_type_hint") == "refresh_token" {
+		revokers[0], revokers[1] = revokers[1], revokers[0]
+	}
+	for _, revoker := range revokers {
+		if found, err := revoker(req, oauthClient, token); err != nil || found {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revokeAccessToken revokes the token if it is an access token issued to the client.
+func (h *handler) revokeAccessToken(req api.Context, oauthClient v1.OAuthClient, token string) (bool, error) {
+	tknCtx, err := h.tokenService.DecodeToken(req.Context(), token)
+	if err != nil || tknCtx.TokenID == "" {
+		// Invalid, expired, and already revoked tokens are all treated as unknown.
+		return false, nil
+	}
+	if tknCtx.ClientID != oauthClient.Namespace+":"+oauthClient.Name {
+		return false, types.NewErrBadRequest("%v", Error{
+			Code:        ErrUnauthorizedClient,
+			Description: "token was not issued to this client",
+		})
+	}
+
+	if err = req.GatewayClient.RevokeOAuthTokens(req.Context(), tknCtx.ExpiresAt, tknCtx.TokenID); err != nil {
+		return false, fmt.Errorf("failed to revoke access token: %w", err)
+	}
+	if tknCtx.GrantID != "" {
+		if err = h.revokeGrant(req, oauthClient.Namespace, tknCtx.GrantID); err != nil {
+			return false, err
+		}
+	}
+
+	log.Infof("Revoked OAuth access token: client=%s userID=%s mcpID=%s", oauthClient.Name, tknCtx.UserID, tknCtx.MCPID)
+	return true, nil
+}
+
+// revokeRefreshToken revokes the token if it is a refresh token issued to the client.
+func (h *handler) revokeRefreshToken(req api.Context, oauthClient v1.OAuthClient, token string) (bool, error) {
+	var oauthToken v1.OAuthToken
+	if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: oauthClient.Namespace, Name: fmt.Sprintf("%x", sha256.Sum256([]byte(token)))}, &oauthToken); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if oauthToken.Spec.ClientID != oauthClient.Name {
+		return false, types.NewErrBadRequest("%v", Error{
+			Code:        ErrUnauthorizedClient,
+			Description: "token was not issued to this client",
+		})
+	}
+
+	if err := kclient.IgnoreNotFound(req.Delete(&oauthToken)); err != nil {
+		return false, fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+
+	// Tokens issued before grants had IDs use the name of their first refresh token instead.
+	grantID := oauthToken.Spec.GrantID
+	if grantID == "" {
+		grantID = oauthToken.Name
+	}
+	if err := h.revokeGrant(req, oauthClient.Namespace, grantID); err != nil {
+		return false, err
+	}
+
+	log.Infof("Revoked OAuth refresh token: client=%s userID=%d mcpID=%s", oauthClient.Name, oauthToken.Spec.UserID, oauthToken.Spec.MCPID)
+	return true, nil
+}
+
+// revokeGrant revokes the access tokens issued for the grant and deletes its refresh tokens.
+func (h *handler) revokeGrant(req api.Context, namespace, grantID string) error {
+	// Access tokens of the grant expire at most tokenExpiration from now, after which the revocation isn't needed.
+	if err := req.GatewayClient.RevokeOAuthTokens(req.Context(), time.Now().Add(tokenExpiration), grantID); err != nil {
+		return fmt.Errorf("failed to revoke grant: %w", err)
+	}
+
+	var oauthTokens v1.OAuthTokenList
+	if err := req.Storage.List(req.Context(), &oauthTokens, &kclient.ListOptions{
+		Namespace: namespace,
+		FieldSelector: fields.SelectorFromSet(selectors.RemoveEmpty(map[string]string{
+			"spec.grantID": grantID,
+		})),
+	}); err != nil {
+		return fmt.Errorf("failed to list refresh tokens of grant: %w", err)
+	}
+	for _, oauthToken := range oauthTokens.Items {
+		if err := kclient.IgnoreNotFound(req.Delete(&oauthToken)); err != nil {
+			return fmt.Errorf("failed to delete refresh token %s: %w", oauthToken.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// introspect implements RFC 7662 token introspection for resource servers, such as MCP servers, that need to validate
+// tokens issued by Obot. Only confidential clients may introspect tokens. Clients created by an admin may introspect
+// any token, while dynamically registered clients may only introspect their own.
+func (h *handler) introspect(req api.Context) error {
+	if err := req.ParseForm(); err != nil {
+		return types.NewErrBadRequest("failed to parse request body: %v", err)
+	}
+
+	oauthClient, err := authenticateClient(req)
+	if err != nil {
+		return err
+	}
+	if oauthClient.Spec.Manifest.TokenEndpointAuthMethod == "none" {
+		log.Infof("Denied OAuth token introspection request from public client: client=%s/%s", oauthClient.Namespace, oauthClient.Name)
+		return types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
+	}
+
+	token := req.FormValue("token")
+	if token == "" {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidRequest,
+			Description: "token is required",
+		})
+	}
+
+	clientID := oauthClient.Namespace + ":" + oauthClient.Name
+	allowed := func(tokenClientID string) bool {
+		return oauthClient.Spec.Static || tokenClientID == clientID
+	}
+
+	if tknCtx, err := h.tokenService.DecodeToken(req.Context(), token); err == nil && tknCtx.TokenID != "" && allowed(tknCtx.ClientID) {
+		resp := IntrospectionResponse{
+			Active:    true,
+			Scope:     tknCtx.OAuthScope,
+			ClientID:  tknCtx.ClientID,
+			TokenType: "Bearer",
+			ExpiresAt: tknCtx.ExpiresAt.Unix(),
+			IssuedAt:  tknCtx.IssuedAt.Unix(),
+			Subject:   tknCtx.UserID,
+			Audience:  tknCtx.Audience,
+			MCPID:     tknCtx.MCPID,
+		}
+		if tknCtx.TokenType == persistent.TokenTypeClient {
+			resp.Subject = tknCtx.ClientID
+		} else {
+			resp.Username = tknCtx.UserName
+		}
+		return req.Write(resp)
+	}
+
+	var oauthToken v1.OAuthToken
+	if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: oauthClient.Namespace, Name: fmt.Sprintf("%x", sha256.Sum256([]byte(token)))}, &oauthToken); err == nil && allowed(oauthClient.Namespace+":"+oauthToken.Spec.ClientID) {
+		return req.Write(IntrospectionResponse{
+			Active:   true,
+			Scope:    oauthToken.Spec.Scope,
+			ClientID: oauthClient.Namespace + ":" + oauthToken.Spec.ClientID,
+			IssuedAt: oauthToken.CreationTimestamp.Unix(),
+			Subject:  strconv.FormatUint(uint64(oauthToken.Spec.UserID), 10),
+			Audience: oauthToken.Spec.Resource,
+			MCPID:    oauthToken.Spec.MCPID,
+		})
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return req.Write(IntrospectionResponse{})
+}
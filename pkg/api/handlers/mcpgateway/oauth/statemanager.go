@@ -2,10 +2,14 @@ package oauth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/gateway/client"
+	gwtypes "github.com/obot-platform/obot/pkg/gateway/types"
 	"golang.org/x/oauth2"
 )
 
@@ -20,7 +24,45 @@ func newStateManager(gatewayClient *client.Client) *stateManager {
 }
 
 func (sm *stateManager) store(ctx context.Context, userID, mcpID, mcpURL, oauthAuthRequestID, state, verifier string, conf *oauth2.Config) error {
-	return sm.gatewayClient.CreateMCPOAuthPendingState(ctx, userID, mcpID, mcpURL, oauthAuthRequestID, state, verifier, conf)
+	if err := sm.gatewayClient.CreateMCPOAuthPendingState(ctx, userID, mcpID, mcpURL, oauthAuthRequestID, state, verifier, conf); err != nil {
+		return err
+	}
+
+	sm.record(ctx, gwtypes.MCPOAuthEvent{
+		Type:   gwtypes.MCPOAuthEventInitiated,
+		UserID: userID,
+		MCPID:  mcpID,
+	})
+	return nil
+}
+
+// record records the outcome of an OAuth flow. Failing to record it doesn't fail the flow.
+func (sm *stateManager) record(ctx context.Context, event gwtypes.MCPOAuthEvent) {
+	if err := sm.gatewayClient.RecordMCPOAuthEvent(ctx, event); err != nil {
+		log.Warnf("Failed to record MCP OAuth event: type=%s mcpID=%s userID=%s error=%v", event.Type, event.MCPID, event.UserID, err)
+	}
+}
+
+// recordFailure records a failed OAuth flow, categorizing the error.
+func (sm *stateManager) recordFailure(ctx context.Context, ps *gwtypes.MCPOAuthPendingState, category types.MCPOAuthFailureCategory, code string, exchangeDuration time.Duration, err error) {
+	sm.record(ctx, gwtypes.MCPOAuthEvent{
+		Type:             gwtypes.MCPOAuthEventFailed,
+		UserID:           ps.UserID,
+		MCPID:            ps.MCPID,
+		FailureCategory:  category,
+		FailureCode:      code,
+		Error:            err.Error(),
+		ExchangeDuration: exchangeDuration,
+	})
+}
+
+// exchangeFailure returns the category and code of an error from exchanging an authorization code.
+func exchangeFailure(err error) (types.MCPOAuthFailureCategory, string) {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return types.MCPOAuthFailureCategoryTokenExchange, retrieveErr.ErrorCode
+	}
+	return types.MCPOAuthFailureCategoryUnreachable, ""
 }
 
 func (sm *stateManager) createToken(ctx context.Context, state, code, errorStr, errorDescription string) (string, string, error) {
@@ -32,7 +74,9 @@ func (sm *stateManager) createToken(ctx context.Context, state, code, errorStr,
 	if errorStr != "" {
 		// Clean up the pending state before returning the error
 		_ = sm.gatewayClient.DeleteMCPOAuthPendingState(ctx, ps.HashedState)
-		return "", "", fmt.Errorf("error returned from oauth server: %s, %s", errorStr, errorDescription)
+		err = fmt.Errorf("error returned from oauth server: %s, %s", errorStr, errorDescription)
+		sm.recordFailure(ctx, ps, types.MCPOAuthFailureCategoryProvider, errorStr, 0, err)
+		return "", "", err
 	}
 
 	conf := &oauth2.Config{
@@ -49,17 +93,29 @@ func (sm *stateManager) createToken(ctx context.Context, state, code, errorStr,
 		conf.Scopes = strings.Split(ps.Scopes, " ")
 	}
 
+	exchangeStart := time.Now()
 	token, err := conf.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", ps.Verifier))
+	exchangeDuration := time.Since(exchangeStart)
 	if err != nil {
 		_ = sm.gatewayClient.DeleteMCPOAuthPendingState(ctx, ps.HashedState)
+		category, failureCode := exchangeFailure(err)
+		sm.recordFailure(ctx, ps, category, failureCode, exchangeDuration, err)
 		return "", "", fmt.Errorf("failed to exchange code: %w", err)
 	}
 
 	// Save the completed token
 	if err := sm.gatewayClient.ReplaceMCPOAuthToken(ctx, ps.UserID, ps.MCPID, ps.URL, ps.OAuthAuthRequestID, conf, token); err != nil {
+		sm.recordFailure(ctx, ps, types.MCPOAuthFailureCategoryStorage, "", exchangeDuration, err)
 		return "", "", err
 	}
 
+	sm.record(ctx, gwtypes.MCPOAuthEvent{
+		Type:             gwtypes.MCPOAuthEventCompleted,
+		UserID:           ps.UserID,
+		MCPID:            ps.MCPID,
+		ExchangeDuration: exchangeDuration,
+	})
+
 	// Delete the pending state
 	_ = sm.gatewayClient.DeleteMCPOAuthPendingState(ctx, ps.HashedState)
 
@@ -16,6 +16,8 @@ import (
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/handlers"
+	"github.com/obot-platform/obot/pkg/api/server/requestinfo"
 	gwtypes "github.com/obot-platform/obot/pkg/gateway/types"
 	"github.com/obot-platform/obot/pkg/jwt/persistent"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
@@ -36,6 +38,10 @@ const (
 	tokenTypeAccessToken    = "urn:ietf:params:oauth:token-type:access_token"
 	tokenTypeAPIKey         = "urn:obot:token-type:api-key"
 	ErrUnsupportedGrantType = ErrorCode("unsupported_grant_type")
+
+	// rotatedRefreshTokenRetention is how long a refresh token is checked for reuse after it is rotated.
+	rotatedRefreshTokenRetention = 30 * 24 * time.Hour
+	refreshTokenReuseCallType    = "obot/oauth-refresh-token-reuse"
 )
 
 // TokenExchangeResponse represents an RFC 8693 token exchange response
@@ -51,30 +57,76 @@ func (h *handler) token(req api.Context) error {
 		return types.NewErrBadRequest("failed to parse request body: %v", err)
 	}
 
-	var clientSecret string
+	client, err := authenticateClient(req)
+	if err != nil {
+		return err
+	}
+
+	grantType := req.FormValue("grant_type")
+	if !slices.Contains(h.oauthConfig.GrantTypesSupported, grantType) {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidRequest,
+			Description: fmt.Sprintf("grant_type must be one of %s, not %s", strings.Join(h.oauthConfig.GrantTypesSupported, ", "), grantType),
+		})
+	}
+
+	if len(client.Spec.Manifest.GrantTypes) > 0 && !slices.Contains(client.Spec.Manifest.GrantTypes, grantType) || len(client.Spec.Manifest.GrantTypes) == 0 && grantType != "authorization_code" {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidRequest,
+			Description: "client is not allowed to use authorization_code grant type",
+		})
+	}
+	log.Debugf("Processing OAuth token request: client=%s/%s grantType=%s", client.Namespace, client.Name, grantType)
+
+	switch grantType {
+	case "authorization_code":
+		return h.doAuthorizationCode(req, client, req.FormValue("code"), req.FormValue("code_verifier"))
+	case "refresh_token":
+		return h.doRefreshToken(req, client, req.FormValue("refresh_token"))
+	case deviceCodeGrantType:
+		return h.doDeviceCode(req, client, req.FormValue("device_code"))
+	case handlers.ClientCredentialsGrantType:
+		return h.doClientCredentials(req, client, req.FormValue("resource"), req.FormValue("scope"))
+	case "urn:ietf:params:oauth:grant-type:token-exchange":
+		return h.doTokenExchange(req, client, req.FormValue("resource"), req.FormValue("subject_token"), req.FormValue("subject_token_type"), req.FormValue("requested_token_type"))
+	default:
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidRequest,
+			Description: fmt.Sprintf("grant_type must be one of %s, not %s", strings.Join(h.oauthConfig.GrantTypesSupported, ", "), grantType),
+		})
+	}
+}
+
+// authenticateClient returns the OAuth client of a request to the token or device authorization endpoint, checking its
+// credentials from the Authorization header or the form.
+func authenticateClient(req api.Context) (v1.OAuthClient, error) {
+	var (
+		client       v1.OAuthClient
+		clientSecret string
+	)
 	clientID := req.FormValue("client_id")
 	if clientID == "" {
 		creds := strings.TrimPrefix(req.Request.Header.Get("Authorization"), "Basic ")
 		if creds == "" {
 			log.Infof("Denied OAuth token request due to missing client credentials")
-			return types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
+			return client, types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
 		}
 
 		c, err := base64.StdEncoding.DecodeString(creds)
 		if err != nil {
 			log.Infof("Denied OAuth token request due to invalid basic auth encoding")
-			return types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
+			return client, types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
 		}
 
 		idx := bytes.LastIndex(c, []byte{':'})
 		if idx == -1 {
 			log.Infof("Denied OAuth token request due to malformed basic auth credentials")
-			return types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
+			return client, types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
 		}
 
 		clientID, clientSecret = string(c[:idx]), string(c[idx+1:])
 		if clientID == "" {
-			return types.NewErrBadRequest("%v", Error{
+			return client, types.NewErrBadRequest("%v", Error{
 				Code:        ErrInvalidRequest,
 				Description: "client_id is required",
 			})
@@ -82,7 +134,7 @@ func (h *handler) token(req api.Context) error {
 
 		clientID, err = url.QueryUnescape(clientID)
 		if err != nil {
-			return types.NewErrBadRequest("%v", Error{
+			return client, types.NewErrBadRequest("%v", Error{
 				Code:        ErrInvalidRequest,
 				Description: "client_id is invalid",
 			})
@@ -93,54 +145,25 @@ func (h *handler) token(req api.Context) error {
 
 	clientNamespace, clientName, ok := strings.Cut(clientID, ":")
 	if !ok {
-		return types.NewErrBadRequest("%v", Error{
+		return client, types.NewErrBadRequest("%v", Error{
 			Code:        ErrInvalidRequest,
 			Description: "client_id is invalid",
 		})
 	}
 
-	var client v1.OAuthClient
 	if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: clientNamespace, Name: clientName}, &client); err != nil {
-		return err
+		return client, err
 	}
 
 	switch client.Spec.Manifest.TokenEndpointAuthMethod {
 	case "client_secret_basic", "client_secret_post":
 		if bcrypt.CompareHashAndPassword(client.Spec.ClientSecretHash, []byte(clientSecret)) != nil {
 			log.Infof("Denied OAuth token request due to invalid client secret: client=%s/%s", client.Namespace, client.Name)
-			return types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
+			return client, types.NewErrHTTP(http.StatusUnauthorized, "Invalid client credentials")
 		}
 	}
 
-	grantType := req.FormValue("grant_type")
-	if !slices.Contains(h.oauthConfig.GrantTypesSupported, grantType) {
-		return types.NewErrBadRequest("%v", Error{
-			Code:        ErrInvalidRequest,
-			Description: fmt.Sprintf("grant_type must be one of %s, not %s", strings.Join(h.oauthConfig.GrantTypesSupported, ", "), grantType),
-		})
-	}
-
-	if len(client.Spec.Manifest.GrantTypes) > 0 && !slices.Contains(client.Spec.Manifest.GrantTypes, grantType) || len(client.Spec.Manifest.GrantTypes) == 0 && grantType != "authorization_code" {
-		return types.NewErrBadRequest("%v", Error{
-			Code:        ErrInvalidRequest,
-			Description: "client is not allowed to use authorization_code grant type",
-		})
-	}
-	log.Debugf("Processing OAuth token request: client=%s/%s grantType=%s", client.Namespace, client.Name, grantType)
-
-	switch grantType {
-	case "authorization_code":
-		return h.doAuthorizationCode(req, client, req.FormValue("code"), req.FormValue("code_verifier"))
-	case "refresh_token":
-		return h.doRefreshToken(req, client, req.FormValue("refresh_token"))
-	case "urn:ietf:params:oauth:grant-type:token-exchange":
-		return h.doTokenExchange(req, client, req.FormValue("resource"), req.FormValue("subject_token"), req.FormValue("subject_token_type"), req.FormValue("requested_token_type"))
-	default:
-		return types.NewErrBadRequest("%v", Error{
-			Code:        ErrInvalidRequest,
-			Description: fmt.Sprintf("grant_type must be one of %s, not %s", strings.Join(h.oauthConfig.GrantTypesSupported, ", "), grantType),
-		})
-	}
+	return client, nil
 }
 
 func (h *handler) doAuthorizationCode(req api.Context, oauthClient v1.OAuthClient, code, codeVerifier string) error {
@@ -199,6 +222,11 @@ func (h *handler) doAuthorizationCode(req api.Context, oauthClient v1.OAuthClien
 		}
 	}
 
+	return h.issueTokens(req, oauthClient, oauthAuthRequest, "authorization_code")
+}
+
+// issueTokens issues an access and refresh token for an authorization request that the user approved.
+func (h *handler) issueTokens(req api.Context, oauthClient v1.OAuthClient, oauthAuthRequest v1.OAuthAuthRequest, grantType string) error {
 	userID := fmt.Sprintf("%d", oauthAuthRequest.Spec.UserID)
 	user, err := req.GatewayClient.UserByID(req.Context(), userID)
 	if err != nil {
@@ -208,6 +236,8 @@ func (h *handler) doAuthorizationCode(req api.Context, oauthClient v1.OAuthClien
 		})
 	}
 
+	grantID := strings.ToLower(rand.Text())
+
 	now := time.Now()
 	tknCtx := persistent.TokenContext{
 		Audience:              oauthAuthRequest.Spec.Resource,
@@ -223,12 +253,20 @@ func (h *handler) doAuthorizationCode(req api.Context, oauthClient v1.OAuthClien
 		AuthProviderNamespace: oauthAuthRequest.Spec.AuthProviderNamespace,
 		AuthProviderUserID:    oauthAuthRequest.Spec.AuthProviderUserID,
 		MCPID:                 oauthAuthRequest.Spec.MCPID,
+		ClientID:              oauthClient.Namespace + ":" + oauthClient.Name,
+		TokenID:               strings.ToLower(rand.Text()),
+		GrantID:               grantID,
 	}
 	tkn, err := h.tokenService.NewToken(req.Context(), tknCtx)
 	if err != nil {
 		return fmt.Errorf("failed to create auth token: %w", err)
 	}
 
+	idToken, err := h.newIDToken(req.Context(), oauthClient, userID, user, oauthAuthRequest.Spec.Scope, oauthAuthRequest.Spec.Nonce, now)
+	if err != nil {
+		return fmt.Errorf("failed to create ID token: %w", err)
+	}
+
 	refreshToken := strings.ToLower(rand.Text() + rand.Text())
 
 	oauthToken := v1.OAuthToken{
@@ -245,19 +283,104 @@ func (h *handler) doAuthorizationCode(req api.Context, oauthClient v1.OAuthClien
 			AuthProviderName:      oauthAuthRequest.Spec.AuthProviderName,
 			AuthProviderUserID:    oauthAuthRequest.Spec.AuthProviderUserID,
 			MCPID:                 oauthAuthRequest.Spec.MCPID,
+			GrantID:               grantID,
 		},
 	}
 
 	if err = req.Create(&oauthToken); err != nil {
 		return fmt.Errorf("failed to create oauth token: %w", err)
 	}
-	log.Infof("Issued OAuth access and refresh token via authorization_code: client=%s userID=%d mcpID=%s", oauthClient.Name, oauthAuthRequest.Spec.UserID, oauthAuthRequest.Spec.MCPID)
+	log.Infof("Issued OAuth access and refresh token via %s: client=%s userID=%d mcpID=%s", grantType, oauthClient.Name, oauthAuthRequest.Spec.UserID, oauthAuthRequest.Spec.MCPID)
 
 	return req.Write(types.OAuthToken{
 		AccessToken:  tkn,
 		TokenType:    "bearer",
 		ExpiresIn:    int(time.Until(tknCtx.ExpiresAt).Milliseconds() / 1000),
 		RefreshToken: refreshToken,
+		IDToken:      idToken,
+	})
+}
+
+// doClientCredentials issues an access token that identifies the client itself rather than a user. Only clients created
+// by an admin and registered for this grant may use it, and the token is restricted to one of the MCP servers in the
+// client's allowed audiences.
+func (h *handler) doClientCredentials(req api.Context, oauthClient v1.OAuthClient, resource, scope string) error {
+	if !oauthClient.Spec.Static || oauthClient.Spec.Manifest.TokenEndpointAuthMethod == "none" ||
+		!slices.Contains(oauthClient.Spec.Manifest.GrantTypes, handlers.ClientCredentialsGrantType) {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrUnauthorizedClient,
+			Description: "client is not allowed to use the client_credentials grant type",
+		})
+	}
+
+	allowedAudiences := oauthClient.Spec.Manifest.AllowedAudiences
+	if resource == "" && len(allowedAudiences) == 1 {
+		resource = allowedAudiences[0]
+	}
+	if resource == "" || !slices.ContainsFunc(allowedAudiences, func(audience string) bool {
+		return strings.TrimSuffix(audience, "/") == strings.TrimSuffix(resource, "/")
+	}) {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidTarget,
+			Description: "resource must be one of the client's allowed audiences",
+		})
+	}
+
+	_, mcpID, _ := strings.Cut(strings.TrimSuffix(resource, "/"), "/mcp-connect/")
+	switch {
+	case system.IsSystemMCPServerID(mcpID):
+	case system.IsMCPServerID(mcpID):
+		// Single-user servers belong to a user and can only be reached with that user's token.
+		var mcpServer v1.MCPServer
+		if err := req.Get(&mcpServer, mcpID); err != nil {
+			return types.NewErrBadRequest("%v", Error{
+				Code:        ErrInvalidTarget,
+				Description: "failed to retrieve MCP server " + mcpID,
+			})
+		}
+		if mcpServer.Spec.MCPCatalogID == "" && mcpServer.Spec.PowerUserWorkspaceID == "" {
+			return types.NewErrBadRequest("%v", Error{
+				Code:        ErrInvalidTarget,
+				Description: "the client_credentials grant type is only supported for multi-user MCP servers",
+			})
+		}
+	default:
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidTarget,
+			Description: "the client_credentials grant type is only supported for multi-user MCP servers",
+		})
+	}
+
+	if scope == "" {
+		scope = oauthClient.Spec.Manifest.Scope
+	} else if scope = clientScope(oauthClient, scope); scope == "" {
+		return types.NewErrBadRequest("%v", Error{
+			Code:        ErrInvalidScope,
+			Description: "none of the requested scopes are allowed for this client",
+		})
+	}
+
+	now := time.Now()
+	tknCtx := persistent.TokenContext{
+		Audience:   resource,
+		OAuthScope: scope,
+		IssuedAt:   now,
+		ExpiresAt:  now.Add(tokenExpiration),
+		MCPID:      mcpID,
+		ClientID:   oauthClient.Namespace + ":" + oauthClient.Name,
+		TokenID:    strings.ToLower(rand.Text()),
+		TokenType:  persistent.TokenTypeClient,
+	}
+	tkn, err := h.tokenService.NewToken(req.Context(), tknCtx)
+	if err != nil {
+		return fmt.Errorf("failed to create auth token: %w", err)
+	}
+	log.Infof("Issued OAuth access token via client_credentials: client=%s mcpID=%s", oauthClient.Name, mcpID)
+
+	return req.Write(types.OAuthToken{
+		AccessToken: tkn,
+		TokenType:   "bearer",
+		ExpiresIn:   int(time.Until(tknCtx.ExpiresAt).Milliseconds() / 1000),
 	})
 }
 
@@ -270,17 +393,26 @@ func (h *handler) doRefreshToken(req api.Context, oauthClient v1.OAuthClient, re
 	}
 
 	var oauthToken v1.OAuthToken
-	if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: oauthClient.Namespace, Name: fmt.Sprintf("%x", sha256.Sum256([]byte(refreshToken)))}, &oauthToken); err != nil {
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(refreshToken)))
+	if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: oauthClient.Namespace, Name: hash}, &oauthToken); apierrors.IsNotFound(err) {
+		return h.checkRefreshTokenReuse(req, oauthClient, hash)
+	} else if err != nil {
+		return fmt.Errorf("failed to get oauth token: %w", err)
+	}
+	if oauthToken.Spec.ClientID != oauthClient.Name {
 		return types.NewErrBadRequest("%v", Error{
-			Code:        ErrInvalidRequest,
-			Description: "refresh_token is invalid",
+			Code:        ErrInvalidGrant,
+			Description: "refresh_token was not issued to this client",
 		})
 	}
 
-	if err := req.Delete(&oauthToken); err != nil {
-		return fmt.Errorf("failed to refresh oauth token: %w", err)
+	// Tokens issued before grants had IDs use the name of their first refresh token instead.
+	grantID := oauthToken.Spec.GrantID
+	if grantID == "" {
+		grantID = oauthToken.Name
 	}
 
+	now := time.Now()
 	userID := fmt.Sprintf("%d", oauthToken.Spec.UserID)
 	user, err := req.GatewayClient.UserByID(req.Context(), userID)
 	if err != nil {
@@ -304,9 +436,8 @@ func (h *handler) doRefreshToken(req api.Context, oauthClient v1.OAuthClient, re
 		oauthToken.Spec.Resource = fmt.Sprintf("%s/mcp-connect/%s", h.baseURL, mcpServerInstance.Spec.MCPServerName)
 	}
 
-	now := time.Now()
 	tknCtx := persistent.TokenContext{
-		Scope:                 oauthToken.Spec.Scope,
+		OAuthScope:            oauthToken.Spec.Scope,
 		Audience:              oauthToken.Spec.Resource,
 		IssuedAt:              now,
 		ExpiresAt:             now.Add(tokenExpiration),
@@ -319,20 +450,31 @@ func (h *handler) doRefreshToken(req api.Context, oauthClient v1.OAuthClient, re
 		AuthProviderNamespace: oauthToken.Spec.AuthProviderNamespace,
 		AuthProviderUserID:    oauthToken.Spec.AuthProviderUserID,
 		MCPID:                 oauthToken.Spec.MCPID,
+		ClientID:              oauthClient.Namespace + ":" + oauthClient.Name,
+		TokenID:               strings.ToLower(rand.Text()),
+		GrantID:               grantID,
 	}
 	tkn, err := h.tokenService.NewToken(req.Context(), tknCtx)
 	if err != nil {
 		return fmt.Errorf("failed to create auth token: %w", err)
 	}
 
+	// ID tokens of refresh requests don't have a nonce, as in OpenID Connect Core section 12.2.
+	idToken, err := h.newIDToken(req.Context(), oauthClient, userID, user, oauthToken.Spec.Scope, "", now)
+	if err != nil {
+		return fmt.Errorf("failed to create ID token: %w", err)
+	}
+
 	refreshToken = strings.ToLower(rand.Text() + rand.Text())
 
+	oldOAuthToken := oauthToken
 	oauthToken = v1.OAuthToken{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: oauthClient.Namespace,
 			Name:      fmt.Sprintf("%x", sha256.Sum256([]byte(refreshToken))),
 		},
 		Spec: v1.OAuthTokenSpec{
+			Scope:                 oauthToken.Spec.Scope,
 			Resource:              oauthToken.Spec.Resource,
 			ClientID:              oauthClient.Name,
 			UserID:                oauthToken.Spec.UserID,
@@ -340,12 +482,37 @@ func (h *handler) doRefreshToken(req api.Context, oauthClient v1.OAuthClient, re
 			AuthProviderName:      oauthToken.Spec.AuthProviderName,
 			AuthProviderUserID:    oauthToken.Spec.AuthProviderUserID,
 			MCPID:                 oauthToken.Spec.MCPID,
+			GrantID:               grantID,
 		},
 	}
 
+	// The new refresh token is persisted before the old one is rotated, so that a failure in between leaves the client
+	// with a refresh token that still works.
 	if err = req.Create(&oauthToken); err != nil {
 		return fmt.Errorf("failed to create new oauth token: %w", err)
 	}
+
+	if err = req.GatewayClient.RecordRotatedOAuthRefreshToken(req.Context(), gwtypes.RotatedOAuthRefreshToken{
+		Hash:      hash,
+		GrantID:   grantID,
+		ClientID:  oauthClient.Name,
+		UserID:    oldOAuthToken.Spec.UserID,
+		MCPID:     oldOAuthToken.Spec.MCPID,
+		RotatedAt: now,
+		ExpiresAt: now.Add(rotatedRefreshTokenRetention),
+	}); err != nil {
+		deleteUnusedOAuthToken(req, &oauthToken)
+		return fmt.Errorf("failed to record rotated refresh token: %w", err)
+	}
+
+	if err = req.Delete(&oldOAuthToken); apierrors.IsNotFound(err) {
+		// Another request refreshed the token first, so this is a reuse of it.
+		deleteUnusedOAuthToken(req, &oauthToken)
+		return h.checkRefreshTokenReuse(req, oauthClient, hash)
+	} else if err != nil {
+		deleteUnusedOAuthToken(req, &oauthToken)
+		return fmt.Errorf("failed to refresh oauth token: %w", err)
+	}
 	log.Infof("Issued OAuth access and refresh token via refresh_token: client=%s userID=%d mcpID=%s", oauthClient.Name, oauthToken.Spec.UserID, oauthToken.Spec.MCPID)
 
 	return req.Write(types.OAuthToken{
@@ -353,9 +520,55 @@ func (h *handler) doRefreshToken(req api.Context, oauthClient v1.OAuthClient, re
 		TokenType:    "bearer",
 		ExpiresIn:    int(time.Until(tknCtx.ExpiresAt).Milliseconds() / 1000),
 		RefreshToken: refreshToken,
+		IDToken:      idToken,
 	})
 }
 
+// deleteUnusedOAuthToken deletes a new refresh token that is never returned to the client, because the refresh failed.
+func deleteUnusedOAuthToken(req api.Context, oauthToken *v1.OAuthToken) {
+	if err := req.Delete(oauthToken); err != nil && !apierrors.IsNotFound(err) {
+		log.Warnf("failed to delete unused oauth token: %v", err)
+	}
+}
+
+// checkRefreshTokenReuse handles a refresh token that doesn't exist. If the token was already rotated, then it was
+// used more than once, which means that it may have been stolen. Every token issued for the same grant is revoked, so
+// that neither the client nor an attacker can continue to use it.
+func (h *handler) checkRefreshTokenReuse(req api.Context, oauthClient v1.OAuthClient, hash string) error {
+	invalid := types.NewErrBadRequest("%v", Error{
+		Code:        ErrInvalidGrant,
+		Description: "refresh_token is invalid",
+	})
+
+	rotated, err := req.GatewayClient.RotatedOAuthRefreshToken(req.Context(), hash)
+	if err != nil {
+		return fmt.Errorf("failed to check for refresh token reuse: %w", err)
+	}
+	if rotated == nil || rotated.ClientID != oauthClient.Name {
+		return invalid
+	}
+
+	if err = h.revokeGrant(req, oauthClient.Namespace, rotated.GrantID); err != nil {
+		return err
+	}
+
+	log.Warnf("Revoked OAuth grant after reuse of rotated refresh token: client=%s userID=%d mcpID=%s rotatedAt=%s", oauthClient.Name, rotated.UserID, rotated.MCPID, rotated.RotatedAt.Format(time.RFC3339))
+	req.GatewayClient.LogMCPAuditEntry(gwtypes.MCPAuditLog{
+		CreatedAt:      time.Now(),
+		UserID:         fmt.Sprintf("%d", rotated.UserID),
+		MCPID:          rotated.MCPID,
+		ClientName:     oauthClient.Spec.Manifest.ClientName,
+		ClientIP:       requestinfo.GetSourceIP(req.Request),
+		CallType:       refreshTokenReuseCallType,
+		CallIdentifier: oauthClient.Namespace + ":" + oauthClient.Name,
+		UserAgent:      req.Request.UserAgent(),
+		ResponseStatus: http.StatusBadRequest,
+		Error:          "reuse of rotated refresh token, revoked all tokens of the grant",
+	})
+
+	return invalid
+}
+
 func (h *handler) doTokenExchange(req api.Context, oauthClient v1.OAuthClient, resource, subjectToken, subjectTokenType, requestedTokenType string) error {
 	if subjectToken == "" {
 		return types.NewErrBadRequest("%v", Error{
@@ -677,18 +890,20 @@ func (h *handler) getTokenForConnectResource(ctx context.Context, subjectTokenTy
 // For component servers (servers that belong to a composite), it instead checks whether
 // the corresponding composite server is in the allowed list.
 func validateAPIKeyAccess(ctx api.Context, apiKey *gwtypes.APIKey, mcpID string) error {
-	if system.IsWebhookSystemMCPServerID(mcpID) || slices.Contains(apiKey.MCPServerIDs, "*") || slices.Contains(apiKey.MCPServerIDs, mcpID) {
-		return nil
+	var compositeName string
+	if !system.IsWebhookSystemMCPServerID(mcpID) && !apiKey.AllowsMCPServer(mcpID) {
+		// Check if this is a component server - if so, check the composite server ID
+		var mcpServer v1.MCPServer
+		if err := ctx.Get(&mcpServer, mcpID); err != nil || !apiKey.AllowsMCPServer(mcpServer.Spec.CompositeName) {
+			return fmt.Errorf("API key does not have access to MCP server %s", mcpID)
+		}
+		compositeName = mcpServer.Spec.CompositeName
 	}
 
-	// Check if this is a component server - if so, check the composite server ID
-	var mcpServer v1.MCPServer
-	if err := ctx.Get(&mcpServer, mcpID); err == nil && mcpServer.Spec.CompositeName != "" {
-		// This is a component server, check if the composite server is allowed
-		if slices.Contains(apiKey.MCPServerIDs, mcpServer.Spec.CompositeName) {
-			return nil
-		}
+	// Tokens don't carry the tool restrictions of the key, so keys that are restricted to some tools can't be exchanged.
+	if len(apiKey.ToolAllowlist(mcpID, compositeName)) > 0 {
+		return fmt.Errorf("API key is restricted to some tools of MCP server %s and can't be exchanged for a token", mcpID)
 	}
 
-	return fmt.Errorf("API key does not have access to MCP server %s", mcpID)
+	return nil
 }
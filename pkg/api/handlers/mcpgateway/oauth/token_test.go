@@ -0,0 +1,46 @@
+package oauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/handlers"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCredentialsRequiresRegisteredGrantType(t *testing.T) {
+	client := func(grantTypes ...string) v1.OAuthClient {
+		return v1.OAuthClient{
+			Spec: v1.OAuthClientSpec{
+				Static: true,
+				Manifest: types.OAuthClientManifest{
+					GrantTypes:              grantTypes,
+					TokenEndpointAuthMethod: "client_secret_basic",
+					AllowedAudiences:        []string{"https://obot.example.com/mcp-connect/ms1test"},
+				},
+			},
+		}
+	}
+
+	for name, oauthClient := range map[string]v1.OAuthClient{
+		"no grant types":          client(),
+		"authorization code only": client("authorization_code", "refresh_token"),
+		"device code only":        client(handlers.DeviceCodeGrantType),
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := api.Context{
+				ResponseWriter: httptest.NewRecorder(),
+				Request:        httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader("grant_type=client_credentials")),
+			}
+			err := (&handler{}).doClientCredentials(req, oauthClient, "", "")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), string(ErrUnauthorizedClient))
+		})
+	}
+}
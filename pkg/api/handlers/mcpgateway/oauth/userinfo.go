@@ -8,6 +8,8 @@ import (
 
 	gtypes "github.com/gptscript-ai/gptscript/pkg/types"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/handlers"
+	gwtypes "github.com/obot-platform/obot/pkg/gateway/types"
 )
 
 // UserInfoResponse represents the OpenID Connect UserInfo response
@@ -28,8 +30,8 @@ type UserInfoResponse struct {
 }
 
 func (h *handler) userInfo(req api.Context) error {
-	scope := gtypes.FirstSet(req.User.GetExtra()["oauthScope"]...)
-	if !slices.Contains(strings.Fields(scope), "profile") {
+	scopes := strings.Fields(gtypes.FirstSet(req.User.GetExtra()["oauthScope"]...))
+	if !slices.Contains(scopes, "profile") && (!h.oauthConfig.OpenIDProvider() || !slices.Contains(scopes, handlers.OpenIDScope)) {
 		log.Infof("Denied OAuth userinfo request due to insufficient scope: userID=%s", req.User.GetUID())
 		return h.writeUserInfoError(req, http.StatusUnauthorized,
 			"invalid_scope", "Insufficient scope")
@@ -43,24 +45,37 @@ func (h *handler) userInfo(req api.Context) error {
 		return h.writeUserInfoError(req, http.StatusForbidden, "invalid_token", "Invalid token")
 	}
 
+	log.Infof("Returned OAuth userinfo response: userID=%s", userID)
+
+	return req.Write(newUserInfo(userID, user, scopes))
+}
+
+// newUserInfo returns the claims about a user that the granted scopes allow.
+func newUserInfo(userID string, user *gwtypes.User, scopes []string) *UserInfoResponse {
 	response := &UserInfoResponse{
-		Sub:               userID,
-		PreferredUsername: user.Username,
-		Picture:           user.IconURL,
-		Zoneinfo:          user.Timezone,
-		Email:             user.Email,
-		EmailVerified:     user.VerifiedEmail,
+		Sub: userID,
 	}
 
-	// Use DisplayName as name, fallback to Username if DisplayName is empty
-	if user.DisplayName != "" {
-		response.Name = user.DisplayName
-	} else {
-		response.Name = user.Username
+	if slices.Contains(scopes, "profile") {
+		response.PreferredUsername = user.Username
+		response.Picture = user.IconURL
+		response.Zoneinfo = user.Timezone
+
+		// Use DisplayName as name, fallback to Username if DisplayName is empty
+		if user.DisplayName != "" {
+			response.Name = user.DisplayName
+		} else {
+			response.Name = user.Username
+		}
+	}
+
+	// The profile scope has always included the email claims, so it still does.
+	if slices.Contains(scopes, "profile") || slices.Contains(scopes, "email") {
+		response.Email = user.Email
+		response.EmailVerified = user.VerifiedEmail
 	}
-	log.Infof("Returned OAuth userinfo response: userID=%s", userID)
 
-	return req.Write(response)
+	return response
 }
 
 // writeUserInfoError writes an OAuth 2.0 Bearer token error response per RFC 6750
@@ -0,0 +1,191 @@
+package mcpgateway
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+type connectSession struct {
+	id          string
+	mcpID       string
+	userID      string
+	transport   types.MCPTransport
+	created     time.Time
+	lastActive  time.Time
+	idleTimeout time.Duration
+	streams     int
+	legacy      *legacySSESession
+}
+
+// connectSessions tracks the sessions that clients have open with MCP servers through mcp-connect.
+type connectSessions struct {
+	lock     sync.Mutex
+	sessions map[string]*connectSession
+}
+
+func newConnectSessions() *connectSessions {
+	return &connectSessions{
+		sessions: make(map[string]*connectSession),
+	}
+}
+
+// trackStreamableHTTP records the streamable HTTP session of a response from the MCP server and returns its ID.
+// The client doesn't always end the session explicitly, so sessions are dropped once they have been idle for the idle timeout.
+func (c *connectSessions) trackStreamableHTTP(resp *http.Response, mcpID, userID string, idleTimeout time.Duration) string {
+	id := resp.Header.Get("Mcp-Session-Id")
+	if id == "" && resp.Request != nil {
+		id = resp.Request.Header.Get("Mcp-Session-Id")
+	}
+	if id == "" {
+		return ""
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if resp.StatusCode == http.StatusNotFound || (resp.Request != nil && resp.Request.Method == http.MethodDelete) {
+		delete(c.sessions, id)
+		return ""
+	}
+
+	now := time.Now()
+	if s, ok := c.sessions[id]; ok {
+		s.lastActive = now
+		s.idleTimeout = idleTimeout
+		return id
+	}
+
+	c.sessions[id] = &connectSession{
+		id:          id,
+		mcpID:       mcpID,
+		userID:      userID,
+		transport:   types.MCPTransportStreamableHTTP,
+		created:     now,
+		lastActive:  now,
+		idleTimeout: idleTimeout,
+	}
+	return id
+}
+
+// streamOpened records that the client has an event stream open in the streamable HTTP session, which keeps the session active.
+func (c *connectSessions) streamOpened(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if s, ok := c.sessions[id]; ok {
+		s.streams++
+	}
+}
+
+func (c *connectSessions) streamClosed(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if s, ok := c.sessions[id]; ok && s.streams > 0 {
+		s.streams--
+		s.lastActive = time.Now()
+	}
+}
+
+func (c *connectSessions) addLegacySSE(s *legacySSESession) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	c.sessions[s.id] = &connectSession{
+		id:         s.id,
+		mcpID:      s.mcpID,
+		userID:     s.userID,
+		transport:   types.MCPTransportSSE,
+		created:     now,
+		lastActive:  now,
+		idleTimeout: s.opts.IdleTimeout,
+		legacy:      s,
+	}
+}
+
+// legacySSE returns the legacy SSE session with the given ID, if it belongs to the user and MCP server.
+func (c *connectSessions) legacySSE(id, mcpID, userID string) *legacySSESession {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	s, ok := c.sessions[id]
+	if !ok || s.legacy == nil || s.mcpID != mcpID || s.userID != userID {
+		return nil
+	}
+
+	s.lastActive = time.Now()
+	return s.legacy
+}
+
+// prune drops idle sessions and closes legacy SSE sessions that haven't been resumed.
+func (c *connectSessions) prune() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	for id, s := range c.sessions {
+		if s.legacy != nil {
+			if s.legacy.ctx.Err() != nil || s.idleTimeout > 0 && s.legacy.idleSince(now) > s.idleTimeout {
+				s.legacy.close()
+				delete(c.sessions, id)
+			}
+			continue
+		}
+
+		if s.streams == 0 && s.idleTimeout > 0 && now.Sub(s.lastActive) > s.idleTimeout {
+			delete(c.sessions, id)
+		}
+	}
+}
+
+func (c *connectSessions) list(userID, mcpID string) []types.MCPConnectSession {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	result := make([]types.MCPConnectSession, 0, len(c.sessions))
+	for _, s := range c.sessions {
+		if (userID != "" && s.userID != userID) || (mcpID != "" && s.mcpID != mcpID) {
+			continue
+		}
+
+		session := types.MCPConnectSession{
+			ID:         s.id,
+			MCPID:      s.mcpID,
+			UserID:     s.userID,
+			Transport:  s.transport,
+			Created:    *types.NewTime(s.created),
+			LastActive: *types.NewTime(s.lastActive),
+		}
+		if s.legacy != nil {
+			session.Streaming = s.legacy.isAttached()
+		} else {
+			session.Streaming = s.streams > 0
+		}
+		result = append(result, session)
+	}
+
+	slices.SortFunc(result, func(a, b types.MCPConnectSession) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return result
+}
+
+// ListSessions lists the sessions that clients have open through mcp-connect, along with the transport each uses.
+// Admins see every user's sessions, other users only see their own.
+func (h *Handler) ListSessions(req api.Context) error {
+	h.sessions.prune()
+
+	userID := req.User.GetUID()
+	if req.UserIsAdmin() {
+		userID = req.URL.Query().Get("userID")
+	}
+
+	return req.Write(types.MCPConnectSessionList{Items: h.sessions.list(userID, req.URL.Query().Get("mcpID"))})
+}
@@ -0,0 +1,56 @@
+package mcpgateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+)
+
+func NewSessionStore(gatewayClient *gateway.Client) mcp.SessionStore {
+	return &sessionStore{
+		gatewayClient: gatewayClient,
+	}
+}
+
+type sessionStore struct {
+	gatewayClient *gateway.Client
+}
+
+func (s *sessionStore) GetSessionState(ctx context.Context, clientID string) (*nmcp.SessionState, error) {
+	stored, err := s.gatewayClient.GetMCPSessionState(ctx, clientID)
+	if err != nil || stored == nil {
+		return nil, err
+	}
+
+	var state nmcp.SessionState
+	if err = json.Unmarshal(stored.State, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode MCP session state: %w", err)
+	}
+	return &state, nil
+}
+
+func (s *sessionStore) StoreSessionState(ctx context.Context, mcpServerName, clientID string, state *nmcp.SessionState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode MCP session state: %w", err)
+	}
+
+	return s.gatewayClient.SaveMCPSessionState(ctx, &types.MCPSessionState{
+		ClientID:      clientID,
+		MCPServerName: mcpServerName,
+		State:         data,
+	})
+}
+
+func (s *sessionStore) DeleteSessionState(ctx context.Context, clientID string) error {
+	return s.gatewayClient.DeleteMCPSessionState(ctx, clientID)
+}
+
+func (s *sessionStore) DeleteSessionStates(ctx context.Context, mcpServerName string) error {
+	return s.gatewayClient.DeleteMCPSessionStatesForServer(ctx, mcpServerName)
+}
@@ -0,0 +1,159 @@
+package mcpgateway
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// ConnectOptions tunes the sessions and event streams that clients open through mcp-connect.
+// A zero value disables the corresponding behavior.
+type ConnectOptions struct {
+	// PingInterval is the interval between keep-alive pings sent on open event streams.
+	PingInterval time.Duration
+	// IdleTimeout is how long a session without requests or open streams is kept before it is closed.
+	IdleTimeout time.Duration
+	// MaxSessionDuration is how long an event stream, or a legacy SSE session, is kept open before it is closed.
+	MaxSessionDuration time.Duration
+}
+
+// forServer returns the options with the server's connect settings applied.
+func (o ConnectOptions) forServer(settings *types.MCPConnectSettings) ConnectOptions {
+	if settings == nil {
+		return o
+	}
+
+	return ConnectOptions{
+		PingInterval:       connectSetting(o.PingInterval, settings.PingIntervalSeconds),
+		IdleTimeout:        connectSetting(o.IdleTimeout, settings.IdleTimeoutSeconds),
+		MaxSessionDuration: connectSetting(o.MaxSessionDuration, settings.MaxSessionDurationSeconds),
+	}
+}
+
+func connectSetting(def time.Duration, seconds int) time.Duration {
+	switch {
+	case seconds > 0:
+		return time.Duration(seconds) * time.Second
+	case seconds < 0:
+		return 0
+	default:
+		return def
+	}
+}
+
+// connectStream wraps an event stream from the MCP server to send keep-alive pings between events and to end the stream
+// once it reaches the maximum duration. Clients resume an ended stream with Last-Event-ID.
+type connectStream struct {
+	body    io.ReadCloser
+	pr      *io.PipeReader
+	pw      *io.PipeWriter
+	onClose func()
+
+	lock       sync.Mutex
+	atBoundary bool
+	expired    bool
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newConnectStream(body io.ReadCloser, opts ConnectOptions, onClose func()) *connectStream {
+	pr, pw := io.Pipe()
+	s := &connectStream{
+		body:       body,
+		pr:         pr,
+		pw:         pw,
+		onClose:    onClose,
+		atBoundary: true,
+		done:       make(chan struct{}),
+	}
+
+	go s.relay()
+	if opts.PingInterval > 0 || opts.MaxSessionDuration > 0 {
+		go s.keepAlive(opts)
+	}
+
+	return s
+}
+
+func (s *connectStream) Read(p []byte) (int, error) {
+	return s.pr.Read(p)
+}
+
+func (s *connectStream) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		_ = s.pr.Close()
+		if s.onClose != nil {
+			s.onClose()
+		}
+	})
+	return s.body.Close()
+}
+
+// relay copies the stream from the MCP server line by line, so that pings are only written between events.
+func (s *connectStream) relay() {
+	reader := bufio.NewReader(s.body)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			s.lock.Lock()
+			_, werr := s.pw.Write(line)
+			s.atBoundary = len(bytes.TrimRight(line, "\r\n")) == 0
+			if s.atBoundary && s.expired {
+				_ = s.pw.Close()
+			}
+			s.lock.Unlock()
+			if werr != nil {
+				return
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			_ = s.pw.CloseWithError(err)
+			return
+		}
+	}
+}
+
+func (s *connectStream) keepAlive(opts ConnectOptions) {
+	var ping, maxDuration <-chan time.Time
+	if opts.PingInterval > 0 {
+		ticker := time.NewTicker(opts.PingInterval)
+		defer ticker.Stop()
+		ping = ticker.C
+	}
+	if opts.MaxSessionDuration > 0 {
+		timer := time.NewTimer(opts.MaxSessionDuration)
+		defer timer.Stop()
+		maxDuration = timer.C
+	}
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ping:
+			s.lock.Lock()
+			if s.atBoundary && !s.expired {
+				_, _ = s.pw.Write([]byte(": keep-alive\n\n"))
+			}
+			s.lock.Unlock()
+		case <-maxDuration:
+			s.lock.Lock()
+			// End the stream now if no event is in progress, otherwise once the current event has been relayed.
+			s.expired = true
+			if s.atBoundary {
+				_ = s.pw.Close()
+			}
+			s.lock.Unlock()
+			return
+		}
+	}
+}
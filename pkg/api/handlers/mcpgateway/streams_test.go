@@ -0,0 +1,61 @@
+package mcpgateway
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func TestConnectOptionsForServer(t *testing.T) {
+	defaults := ConnectOptions{
+		PingInterval:       30 * time.Second,
+		IdleTimeout:        5 * time.Minute,
+		MaxSessionDuration: time.Hour,
+	}
+
+	if got := defaults.forServer(nil); got != defaults {
+		t.Errorf("expected defaults without server settings, got %+v", got)
+	}
+
+	got := defaults.forServer(&types.MCPConnectSettings{
+		PingIntervalSeconds:       10,
+		MaxSessionDurationSeconds: -1,
+	})
+	expected := ConnectOptions{
+		PingInterval: 10 * time.Second,
+		IdleTimeout:  5 * time.Minute,
+	}
+	if got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestConnectStreamMaxSessionDuration(t *testing.T) {
+	upstream, w := io.Pipe()
+	defer w.Close()
+
+	var closed bool
+	stream := newConnectStream(upstream, ConnectOptions{MaxSessionDuration: 50 * time.Millisecond}, func() {
+		closed = true
+	})
+
+	go func() {
+		_, _ = w.Write([]byte("id: 1\ndata: {}\n\n"))
+	}()
+
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("expected the stream to end cleanly, got %v", err)
+	}
+	if !strings.Contains(string(data), "data: {}") {
+		t.Errorf("expected the event to be relayed, got %q", data)
+	}
+
+	_ = stream.Close()
+	if !closed {
+		t.Error("expected the close callback to be called")
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
 	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
 	"github.com/obot-platform/obot/pkg/mcp"
 	"golang.org/x/oauth2"
 	"gorm.io/gorm"
@@ -67,10 +70,41 @@ func (t *tokenStore) GetTokenConfig(ctx context.Context, mcpURL string) (*oauth2
 	}, nil
 }
 
+// SetTokenConfig stores a token. Tokens from completed OAuth flows are stored by the OAuth callback, so this is only
+// called when a token is refreshed.
 func (t *tokenStore) SetTokenConfig(ctx context.Context, mcpURL string, config *oauth2.Config, token *oauth2.Token) error {
-	return t.gatewayClient.ReplaceMCPOAuthToken(ctx, t.userID, t.mcpID, mcpURL, "", config, token)
+	if err := t.gatewayClient.ReplaceMCPOAuthToken(ctx, t.userID, t.mcpID, mcpURL, "", config, token); err != nil {
+		return err
+	}
+
+	t.record(ctx, gatewaytypes.MCPOAuthEvent{Type: gatewaytypes.MCPOAuthEventRefreshed})
+	return nil
 }
 
+// DeleteTokenConfig deletes the token when the MCP server requires the user to authorize again. A token that expired
+// with a refresh token couldn't be refreshed, and any other token was rejected by the server.
 func (t *tokenStore) DeleteTokenConfig(ctx context.Context, mcpURL string) error {
-	return t.gatewayClient.DeleteMCPOAuthTokenForURL(ctx, t.userID, t.mcpID, mcpURL)
+	// The token is only read to record why it was deleted, so it is deleted even if it can't be read.
+	existing, _ := t.gatewayClient.GetMCPOAuthToken(ctx, t.userID, t.mcpID, mcpURL)
+	if err := t.gatewayClient.DeleteMCPOAuthTokenForURL(ctx, t.userID, t.mcpID, mcpURL); err != nil || existing == nil {
+		return err
+	}
+
+	if existing.RefreshToken != "" && !existing.Expiry.IsZero() && existing.Expiry.Before(time.Now()) {
+		t.record(ctx, gatewaytypes.MCPOAuthEvent{
+			Type:            gatewaytypes.MCPOAuthEventRefreshFailed,
+			FailureCategory: types.MCPOAuthFailureCategoryRefresh,
+			Error:           "the token expired and the MCP server required the user to authorize again",
+		})
+	} else {
+		t.record(ctx, gatewaytypes.MCPOAuthEvent{Type: gatewaytypes.MCPOAuthEventRejected})
+	}
+	return nil
+}
+
+func (t *tokenStore) record(ctx context.Context, event gatewaytypes.MCPOAuthEvent) {
+	event.UserID, event.MCPID = t.userID, t.mcpID
+	if err := t.gatewayClient.RecordMCPOAuthEvent(ctx, event); err != nil {
+		log.Warnf("Failed to record MCP OAuth event: type=%s mcpID=%s userID=%s error=%v", event.Type, t.mcpID, t.userID, err)
+	}
 }
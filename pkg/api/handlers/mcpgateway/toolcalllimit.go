@@ -0,0 +1,66 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+// checkToolCallLimit rejects tool calls from users that reached their monthly tool call limit.
+// If the limit is reached, the JSON-RPC error response to send back is returned and the request should not be proxied.
+// Usage is recorded when the audit logs are persisted, so a user can exceed the limit by the calls made since the last flush.
+func (h *Handler) checkToolCallLimit(req api.Context) ([]byte, error) {
+	if req.Request.Method != http.MethodPost || req.Request.Body == nil || req.GatewayClient == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	messages, batch := decodeMessages(body)
+
+	var calls []nmcp.Message
+	for _, msg := range messages {
+		if msg.Method == "tools/call" {
+			calls = append(calls, msg)
+		}
+	}
+	if len(calls) == 0 {
+		return nil, nil
+	}
+
+	remaining, err := req.GatewayClient.RemainingMCPToolCallsForUser(req.Context(), req.User.GetUID(), h.monthlyToolCallLimit, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remaining MCP tool calls: %w", err)
+	}
+	if remaining.Unlimited || remaining.ToolCalls < remaining.Limit {
+		return nil, nil
+	}
+
+	rejected := make([]nmcp.Message, 0, len(calls))
+	for _, msg := range calls {
+		rejected = append(rejected, nmcp.Message{
+			JSONRPC: "2.0",
+			ID:      msg.ID,
+			Error: &nmcp.RPCError{
+				Code:    -32000,
+				Message: fmt.Sprintf("monthly MCP tool call limit of %d reached", remaining.Limit),
+			},
+		})
+	}
+
+	// A batch is rejected as a whole, like batches with calls denied by the tool policy.
+	if batch {
+		return json.Marshal(rejected)
+	}
+	return json.Marshal(rejected[0])
+}
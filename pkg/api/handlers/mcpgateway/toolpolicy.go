@@ -0,0 +1,265 @@
+package mcpgateway
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+// toolPolicyEnforcer inspects the JSON-RPC messages sent through the gateway and enforces the catalog tool policy, the
+// tool allowlist of the user's server instance, and the tools that the user's API key is restricted to.
+// Calls to denied tools are rejected before they reach the MCP server, and denied tools are removed from tools/list results.
+type toolPolicyEnforcer struct {
+	policy    *types.MCPToolPolicy
+	allowlist []string
+	// apiKeyAllowlist contains the tools that the API key of the request is restricted to, if any.
+	apiKeyAllowlist []string
+	// listRequestIDs contains the IDs of the tools/list requests whose responses should be filtered.
+	listRequestIDs map[string]struct{}
+}
+
+// inspectRequest reads the request body and checks any tool calls against the policy.
+// If a call is denied, the JSON-RPC error response to send back is returned and the request should not be proxied.
+func (e *toolPolicyEnforcer) inspectRequest(r *http.Request) ([]byte, error) {
+	if r.Method != http.MethodPost || r.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	messages, batch := decodeMessages(body)
+
+	var denied []nmcp.Message
+	for _, msg := range messages {
+		switch msg.Method {
+		case "tools/list":
+			e.listRequestIDs[messageID(msg.ID)] = struct{}{}
+		case "tools/call":
+			var params nmcp.CallToolRequest
+			if err := json.Unmarshal(msg.Params, &params); err != nil {
+				continue
+			}
+			var reason error
+			if !mcp.ToolAllowed(e.policy, params.Name) {
+				reason = mcp.ErrToolDenied
+			} else if !mcp.ToolAllowlisted(e.allowlist, params.Name) {
+				reason = mcp.ErrToolNotAllowlisted
+			} else if !mcp.ToolAllowlisted(e.apiKeyAllowlist, params.Name) {
+				reason = mcp.ErrToolNotAllowedForAPIKey
+			}
+			if reason != nil {
+				denied = append(denied, nmcp.Message{
+					JSONRPC: "2.0",
+					ID:      msg.ID,
+					Error: &nmcp.RPCError{
+						Code:    -32602,
+						Message: fmt.Sprintf("%s: %s", reason.Error(), params.Name),
+					},
+				})
+			}
+		}
+	}
+
+	if len(denied) == 0 {
+		return nil, nil
+	}
+
+	// A batch is rejected as a whole if any of its calls are denied.
+	if batch {
+		return json.Marshal(denied)
+	}
+	return json.Marshal(denied[0])
+}
+
+// modifyResponse filters denied tools out of tools/list responses, both for plain JSON and event stream responses.
+func (e *toolPolicyEnforcer) modifyResponse(resp *http.Response) error {
+	if len(e.listRequestIDs) == 0 {
+		return nil
+	}
+
+	return rewriteResponse(resp, func(data []byte) []byte {
+		return rewriteResults(data, e.listRequestIDs, e.filterToolsListResult)
+	})
+}
+
+// rewriteResponse applies rewrite to the JSON-RPC messages of a response, both for plain JSON and event stream responses.
+func rewriteResponse(resp *http.Response, rewrite func([]byte) []byte) error {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		body = rewrite(body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	case "text/event-stream":
+		pr, pw := io.Pipe()
+		go rewriteEventStream(resp.Body, pw, rewrite)
+		resp.Body = pr
+		resp.ContentLength = -1
+		resp.Header.Del("Content-Length")
+	}
+
+	return nil
+}
+
+func rewriteEventStream(body io.ReadCloser, w *io.PipeWriter, rewrite func([]byte) []byte) {
+	defer body.Close()
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if data, ok := bytes.CutPrefix(line, []byte("data:")); ok {
+			line = append([]byte("data: "), rewrite(bytes.TrimSpace(data))...)
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return
+		}
+	}
+
+	_ = w.CloseWithError(scanner.Err())
+}
+
+// rewriteResults applies rewrite to the results of the messages in the data that respond to one of the request IDs.
+// Data that can't be parsed is returned unchanged.
+func rewriteResults(data []byte, requestIDs map[string]struct{}, rewrite func(json.RawMessage) (json.RawMessage, bool)) []byte {
+	messages, batch := decodeMessages(data)
+	if len(messages) == 0 {
+		return data
+	}
+
+	var changed bool
+	for i, msg := range messages {
+		if _, ok := requestIDs[messageID(msg.ID)]; !ok || len(msg.Result) == 0 {
+			continue
+		}
+
+		if result, ok := rewrite(msg.Result); ok {
+			messages[i].Result = result
+			changed = true
+		}
+	}
+
+	if !changed {
+		return data
+	}
+
+	var (
+		result []byte
+		err    error
+	)
+	if batch {
+		result, err = json.Marshal(messages)
+	} else {
+		result, err = json.Marshal(messages[0])
+	}
+	if err != nil {
+		return data
+	}
+
+	return result
+}
+
+func (e *toolPolicyEnforcer) filterToolsListResult(data json.RawMessage) (json.RawMessage, bool) {
+	// Decode generically so that any fields we don't know about are passed through untouched.
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, false
+	}
+
+	var tools []json.RawMessage
+	if err := json.Unmarshal(result["tools"], &tools); err != nil {
+		return nil, false
+	}
+
+	allowed := make([]json.RawMessage, 0, len(tools))
+	for _, tool := range tools {
+		var t struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(tool, &t); err != nil || e.toolAllowed(t.Name) {
+			allowed = append(allowed, tool)
+		}
+	}
+
+	if len(allowed) == len(tools) {
+		return nil, false
+	}
+
+	filtered, err := json.Marshal(allowed)
+	if err != nil {
+		return nil, false
+	}
+	result["tools"] = filtered
+
+	data, err = json.Marshal(result)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+func (e *toolPolicyEnforcer) toolAllowed(name string) bool {
+	return mcp.ToolAllowed(e.policy, name) && mcp.ToolAllowlisted(e.allowlist, name) && mcp.ToolAllowlisted(e.apiKeyAllowlist, name)
+}
+
+// apiKeyToolAllowlist returns the tools of the MCP server with any of the IDs that the user's API key is restricted
+// to. It is empty if the user didn't authenticate with an API key, or if the key can call all tools of the server.
+func apiKeyToolAllowlist(user user.Info, mcpServerIDs ...string) []string {
+	var tools []string
+	for _, scope := range user.GetExtra()[types.APIKeyToolsExtraKey] {
+		if serverID, tool, ok := strings.Cut(scope, "/"); ok && serverID != "" && slices.Contains(mcpServerIDs, serverID) {
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
+// decodeMessages decodes a single JSON-RPC message or a batch of messages.
+func decodeMessages(data []byte) ([]nmcp.Message, bool) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, false
+	}
+
+	if data[0] == '[' {
+		var messages []nmcp.Message
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return nil, true
+		}
+		return messages, true
+	}
+
+	var msg nmcp.Message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, false
+	}
+	return []nmcp.Message{msg}, false
+}
+
+func messageID(id any) string {
+	return fmt.Sprint(id)
+}
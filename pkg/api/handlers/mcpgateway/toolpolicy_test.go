@@ -0,0 +1,62 @@
+package mcpgateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+func TestAPIKeyToolAllowlist(t *testing.T) {
+	apiKeyUser := &user.DefaultInfo{
+		Groups: []string{types.GroupAPIKey},
+		Extra: map[string][]string{
+			types.APIKeyToolsExtraKey: {"ms1test/read_*", "ms1test/list_files", "ms1other/write_file"},
+		},
+	}
+
+	if tools := apiKeyToolAllowlist(apiKeyUser, "msi1test", "ms1test"); !slices.Equal(tools, []string{"read_*", "list_files"}) {
+		t.Fatalf("unexpected tools: %v", tools)
+	}
+	if tools := apiKeyToolAllowlist(apiKeyUser, "ms1unrestricted"); len(tools) != 0 {
+		t.Fatalf("expected no restrictions for another server, got %v", tools)
+	}
+}
+
+func TestToolPolicyEnforcerRestrictsAPIKeyTools(t *testing.T) {
+	enforcer := &toolPolicyEnforcer{
+		apiKeyAllowlist: []string{"read_*"},
+		listRequestIDs:  make(map[string]struct{}),
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1test", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"read_file"}}`))
+	denied, err := enforcer.inspectRequest(req)
+	if err != nil {
+		t.Fatalf("failed to inspect request: %v", err)
+	}
+	if denied != nil {
+		t.Fatalf("expected an allowed tool call to be proxied, got %s", denied)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1test", strings.NewReader(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"write_file"}}`))
+	denied, err = enforcer.inspectRequest(req)
+	if err != nil {
+		t.Fatalf("failed to inspect request: %v", err)
+	}
+	if !strings.Contains(string(denied), "tool is not allowed for the API key") {
+		t.Fatalf("expected the tool call to be denied, got %s", denied)
+	}
+
+	filtered, ok := enforcer.filterToolsListResult(json.RawMessage(`{"tools":[{"name":"read_file"},{"name":"write_file"}]}`))
+	if !ok {
+		t.Fatal("expected the tools list to be filtered")
+	}
+	if string(filtered) != `{"tools":[{"name":"read_file"}]}` {
+		t.Fatalf("unexpected tools list: %s", filtered)
+	}
+}
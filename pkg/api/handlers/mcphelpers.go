@@ -10,6 +10,10 @@ import (
 	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // StreamLogsOptions configures SSE log streaming behavior.
@@ -22,12 +26,15 @@ type StreamLogsOptions struct {
 	SendDisconnect bool
 	// SendEnded enables sending an ended event when the log stream ends.
 	SendEnded bool
+	// Mask, if set, redacts secrets from each log line before it is sent.
+	Mask func(string) string
 }
 
 // StreamLogs streams logs from an io.ReadCloser to an HTTP response as Server-Sent Events.
 // It handles:
 // - SSE header setup (Content-Type, Cache-Control, Connection)
 // - Docker log header stripping (8-byte prefix for stdout/stderr)
+// - Optional masking of secrets
 // - Context cancellation
 // - Optional keep-alive pings
 // - Proper SSE event formatting
@@ -57,6 +64,9 @@ func StreamLogs(ctx context.Context, w http.ResponseWriter, logs io.ReadCloser,
 		scanner := bufio.NewScanner(logs)
 		for scanner.Scan() {
 			line := stripDockerLogHeader(scanner.Text())
+			if opts.Mask != nil {
+				line = opts.Mask(line)
+			}
 			select {
 			case logChan <- line:
 			case <-ctx.Done():
@@ -190,3 +200,25 @@ func ensureCredential(ctx context.Context, gptClient *gptscript.GPTScript, cred
 
 	return true, nil
 }
+
+// OwnServerMCPIDs returns the MCP server IDs for servers that the user owns directly
+// (not through a workspace or catalog). These are servers where:
+// - Spec.UserID == user's ID
+// - Spec.MCPCatalogID == ""
+// - Spec.PowerUserWorkspaceID == ""
+func OwnServerMCPIDs(req api.Context) ([]string, error) {
+	var mcpServers v1.MCPServerList
+	if err := req.List(&mcpServers, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.userID", req.User.GetUID()),
+	}); err != nil {
+		return nil, err
+	}
+
+	var mcpIDs []string
+	for _, server := range mcpServers.Items {
+		if server.Spec.MCPCatalogID == "" && server.Spec.PowerUserWorkspaceID == "" {
+			mcpIDs = append(mcpIDs, server.Name)
+		}
+	}
+	return mcpIDs, nil
+}
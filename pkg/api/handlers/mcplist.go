@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// listPage is the page of an MCP list that a request asks for with the limit, continue and offset query parameters,
+// and the fields of the items to return from the fields query parameter. A zero limit returns every item after the
+// start of the page.
+type listPage struct {
+	limit, offset int
+	after         *listContinue
+	fields        []string
+}
+
+// listContinue is the position in a list that a continue token resumes from. The list resumes after the item with
+// the ID, or at the offset if that item is no longer in the list.
+type listContinue struct {
+	ID     string `json:"id"`
+	Offset int    `json:"offset"`
+}
+
+func listPageFromRequest(req api.Context) (listPage, error) {
+	var page listPage
+	query := req.URL.Query()
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			page.limit = l
+		}
+	}
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			page.offset = o
+		}
+	}
+	if token := query.Get("continue"); token != "" {
+		after, err := decodeListContinue(token)
+		if err != nil {
+			return page, types.NewErrBadRequest("invalid continue token: %v", err)
+		}
+		page.after = &after
+	}
+	for _, field := range strings.Split(query.Get("fields"), ",") {
+		if field = strings.TrimSpace(field); field != "" && !slices.Contains(page.fields, field) {
+			page.fields = append(page.fields, field)
+		}
+	}
+	return page, nil
+}
+
+func decodeListContinue(token string) (listContinue, error) {
+	var after listContinue
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return after, err
+	}
+	if err := json.Unmarshal(data, &after); err != nil {
+		return after, err
+	}
+	if after.ID == "" || after.Offset < 0 {
+		return after, strconv.ErrSyntax
+	}
+	return after, nil
+}
+
+func encodeListContinue(after listContinue) string {
+	data, _ := json.Marshal(after)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// pageItems returns the items on a page, and the continue token of the next page if there are more items.
+func pageItems[T any](page listPage, items []T, id func(T) string) ([]T, string) {
+	start := page.offset
+	if page.after != nil {
+		start = page.after.Offset
+		if i := slices.IndexFunc(items, func(item T) bool { return id(item) == page.after.ID }); i >= 0 {
+			start = i + 1
+		}
+	}
+	if start >= len(items) {
+		return items[:0], ""
+	}
+
+	end := len(items)
+	if page.limit > 0 && start+page.limit < end {
+		end = start + page.limit
+	}
+
+	var next string
+	if end < len(items) {
+		next = encodeListContinue(listContinue{ID: id(items[end-1]), Offset: end})
+	}
+	return items[start:end], next
+}
+
+// writeList writes a list response. If the request selected fields, each item only has those fields and its ID.
+func writeList(req api.Context, list any, page listPage) error {
+	if len(page.fields) == 0 {
+		return req.Write(list)
+	}
+
+	selected, err := selectListFields(list, page.fields)
+	if err != nil {
+		return err
+	}
+	return req.Write(selected)
+}
+
+// selectListFields converts a list response to a map in which each item only has the top-level JSON fields that were
+// selected and its ID.
+func selectListFields(list any, fields []string) (map[string]any, error) {
+	data, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	items, _ := result["items"].([]any)
+	for i, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		selected := make(map[string]any, len(fields)+1)
+		if id, ok := object["id"]; ok {
+			selected["id"] = id
+		}
+		for _, field := range fields {
+			if v, ok := object[field]; ok {
+				selected[field] = v
+			}
+		}
+		items[i] = selected
+	}
+	return result, nil
+}
+
+// searchAndSortServers applies the q and sort query parameters of a request to a list of MCP servers. The servers are
+// sorted by relevance when there is a query and no other sort was requested.
+func searchAndSortServers(req api.Context, servers []v1.MCPServer) ([]v1.MCPServer, error) {
+	query := req.URL.Query()
+	sort := query.Get("sort")
+	switch sort {
+	case "", "relevance", "recent", "name":
+	default:
+		return nil, types.NewErrBadRequest("invalid sort %q, must be relevance, recent or name", sort)
+	}
+
+	q := strings.TrimSpace(query.Get("q"))
+	scores, err := searchScores(req, gatewaytypes.MCPSearchKindServer, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortServers(servers, q, sort, scores), nil
+}
+
+func sortServers(servers []v1.MCPServer, q, sort string, scores map[string]float64) []v1.MCPServer {
+	if q != "" {
+		servers = slices.DeleteFunc(servers, func(server v1.MCPServer) bool {
+			_, ok := scores[server.Name]
+			return !ok
+		})
+	}
+
+	switch sort {
+	case "", "relevance":
+		if q != "" {
+			slices.SortStableFunc(servers, func(a, b v1.MCPServer) int {
+				return cmp.Compare(scores[b.Name], scores[a.Name])
+			})
+		}
+	case "recent":
+		slices.SortStableFunc(servers, func(a, b v1.MCPServer) int {
+			return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+		})
+	case "name":
+		slices.SortStableFunc(servers, func(a, b v1.MCPServer) int {
+			return cmp.Compare(strings.ToLower(serverSortName(a)), strings.ToLower(serverSortName(b)))
+		})
+	}
+	return servers
+}
+
+func serverSortName(server v1.MCPServer) string {
+	if server.Spec.Alias != "" {
+		return server.Spec.Alias
+	}
+	return server.Spec.Manifest.Name
+}
+
+func serverName(server v1.MCPServer) string {
+	return server.Name
+}
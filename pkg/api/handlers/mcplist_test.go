@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPageItemsContinue(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	id := func(s string) string { return s }
+
+	listPage := func(query string) listPage {
+		t.Helper()
+		page, err := listPageFromRequest(api.Context{Request: httptest.NewRequest("GET", "/api/mcp-servers?"+query, nil)})
+		if err != nil {
+			t.Fatalf("listPageFromRequest(%q) error = %v", query, err)
+		}
+		return page
+	}
+
+	var (
+		got   []string
+		token string
+	)
+	for i := 0; i < 3; i++ {
+		query := "limit=2"
+		if token != "" {
+			query += "&continue=" + token
+		}
+		var page []string
+		page, token = pageItems(listPage(query), items, id)
+		got = append(got, page...)
+		if token == "" {
+			break
+		}
+	}
+	if !slices.Equal(got, items) || token != "" {
+		t.Errorf("got %v with continue %q, want every item", got, token)
+	}
+
+	// A page resumes after the last item that was returned, even if items before it were removed.
+	page, token := pageItems(listPage("limit=2"), items, id)
+	if !slices.Equal(page, []string{"a", "b"}) {
+		t.Fatalf("got first page %v", page)
+	}
+	if page, _ = pageItems(listPage("limit=2&continue="+token), items[1:], id); !slices.Equal(page, []string{"c", "d"}) {
+		t.Errorf("got %v after removing an item, want [c d]", page)
+	}
+	// If the last item was removed, the page resumes at its offset.
+	if page, _ = pageItems(listPage("limit=2&continue="+token), []string{"a", "c", "d", "e"}, id); !slices.Equal(page, []string{"d", "e"}) {
+		t.Errorf("got %v after removing the last item, want [d e]", page)
+	}
+
+	if _, err := listPageFromRequest(api.Context{Request: httptest.NewRequest("GET", "/api/mcp-servers?continue=bogus", nil)}); err == nil {
+		t.Error("expected an invalid continue token to fail")
+	}
+}
+
+func TestSelectListFields(t *testing.T) {
+	list := types.MCPServerList{
+		Items: []types.MCPServer{{
+			Metadata:       types.Metadata{ID: "ms1abc"},
+			Alias:          "docs",
+			CatalogEntryID: "github",
+			Configured:     true,
+		}},
+		Total: 1,
+	}
+
+	got, err := selectListFields(list, []string{"alias", "configured", "missing"})
+	if err != nil {
+		t.Fatalf("selectListFields() error = %v", err)
+	}
+	if got["total"] != float64(1) {
+		t.Errorf("expected the list fields to be kept, got %v", got)
+	}
+	items, _ := got["items"].([]any)
+	if len(items) != 1 {
+		t.Fatalf("got items %v", got["items"])
+	}
+	item := items[0].(map[string]any)
+	if len(item) != 3 || item["id"] != "ms1abc" || item["alias"] != "docs" || item["configured"] != true {
+		t.Errorf("got item %v, want id, alias and configured", item)
+	}
+}
+
+func TestSortServers(t *testing.T) {
+	now := time.Now()
+	server := func(name, alias, manifestName string, created time.Time) v1.MCPServer {
+		return v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Spec: v1.MCPServerSpec{
+				Alias:    alias,
+				Manifest: types.MCPServerManifest{Name: manifestName},
+			},
+		}
+	}
+	servers := []v1.MCPServer{
+		server("ms1", "", "Slack", now.Add(-time.Hour)),
+		server("ms2", "docs", "GitHub", now),
+		server("ms3", "", "time", now.Add(-2*time.Hour)),
+	}
+	scores := map[string]float64{"ms3": 2, "ms1": 1}
+
+	tests := []struct {
+		q, sort string
+		want    []string
+	}{
+		{want: []string{"ms1", "ms2", "ms3"}},
+		{sort: "name", want: []string{"ms2", "ms1", "ms3"}},
+		{sort: "recent", want: []string{"ms2", "ms1", "ms3"}},
+		{q: "chat", want: []string{"ms3", "ms1"}},
+		{q: "chat", sort: "name", want: []string{"ms1", "ms3"}},
+	}
+	for _, tt := range tests {
+		var names []string
+		for _, s := range sortServers(slices.Clone(servers), tt.q, tt.sort, scores) {
+			names = append(names, s.Name)
+		}
+		if !slices.Equal(names, tt.want) {
+			t.Errorf("sortServers(q=%q, sort=%q) = %v, want %v", tt.q, tt.sort, names, tt.want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/mcp"
+	"gorm.io/gorm"
+)
+
+// mcpLogMaskingDisabledKey is set to "true" when admins turn off the masking of secrets in the logs of MCP servers.
+const mcpLogMaskingDisabledKey = "mcp_log_masking_disabled"
+
+type MCPLogSettingsHandler struct{}
+
+func NewMCPLogSettingsHandler() *MCPLogSettingsHandler {
+	return &MCPLogSettingsHandler{}
+}
+
+// Get returns the settings of the logs of MCP servers.
+func (h *MCPLogSettingsHandler) Get(req api.Context) error {
+	maskSecrets, err := mcpLogMaskingEnabled(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.MCPLogSettings{MaskSecrets: maskSecrets})
+}
+
+// Update changes the settings of the logs of MCP servers. They apply to log streams that are opened afterward.
+func (h *MCPLogSettingsHandler) Update(req api.Context) error {
+	var input types.MCPLogSettings
+	if err := req.Read(&input); err != nil {
+		return types.NewErrBadRequest("failed to read MCP log settings: %v", err)
+	}
+
+	value := "true"
+	if input.MaskSecrets {
+		value = "false"
+	}
+	if _, err := req.GatewayClient.SetProperty(req.Context(), mcpLogMaskingDisabledKey, value); err != nil {
+		return err
+	}
+
+	return req.Write(input)
+}
+
+func mcpLogMaskingEnabled(req api.Context) (bool, error) {
+	property, err := req.GatewayClient.GetProperty(req.Context(), mcpLogMaskingDisabledKey)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return property.Value != "true", nil
+}
+
+// mcpLogMask returns the function that masks the secrets in the logs of the server, or nil if admins turned off
+// masking.
+func mcpLogMask(req api.Context, sessionManager *mcp.SessionManager, serverConfig mcp.ServerConfig) (func(string) string, error) {
+	enabled, err := mcpLogMaskingEnabled(req)
+	if err != nil || !enabled {
+		return nil, err
+	}
+	return sessionManager.LogMasker(req.Context(), serverConfig).Mask, nil
+}
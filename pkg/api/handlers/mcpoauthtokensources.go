@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/alias"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+)
+
+// oauthTokenSourceMCPID is the ID under which a user's token for an OAuth app is stored.
+// The token is shared by every MCP server that sources values from the OAuth app.
+func oauthTokenSourceMCPID(oauthApp string) string {
+	return "oauth-app-" + oauthApp
+}
+
+// oauthTokenSourceToken returns the user's current access token for the OAuth app, refreshing it if it has expired, and
+// the scopes that the token wasn't granted. An empty token is returned if the user has not authorized the OAuth app or
+// the token can no longer be refreshed.
+func oauthTokenSourceToken(req api.Context, oauthApp string, scopes []string) (string, []string, error) {
+	mcpID := oauthTokenSourceMCPID(oauthApp)
+	mcpToken, err := req.GatewayClient.GetMCPOAuthToken(req.Context(), req.User.GetUID(), mcpID, "")
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil, nil
+		}
+		return "", nil, fmt.Errorf("failed to get OAuth token for OAuth app %s: %w", oauthApp, err)
+	}
+
+	conf := &oauth2.Config{
+		ClientID:     mcpToken.ClientID,
+		ClientSecret: mcpToken.ClientSecret,
+		Endpoint:     mcpToken.Endpoint,
+		RedirectURL:  mcpToken.RedirectURL,
+	}
+	if mcpToken.Scopes != "" {
+		conf.Scopes = strings.Split(mcpToken.Scopes, " ")
+	}
+
+	token, err := conf.TokenSource(req.Context(), &oauth2.Token{
+		AccessToken:  mcpToken.AccessToken,
+		RefreshToken: mcpToken.RefreshToken,
+		TokenType:    mcpToken.TokenType,
+		ExpiresIn:    mcpToken.ExpiresIn,
+		Expiry:       mcpToken.Expiry,
+	}).Token()
+	if err != nil {
+		log.Warnf("failed to refresh OAuth token for OAuth app %s: %v", oauthApp, err)
+		return "", nil, nil
+	}
+
+	if token.AccessToken != mcpToken.AccessToken {
+		if token.Extra("scope") == nil && mcpToken.GrantedScopes != "" {
+			// Keep the granted scopes if the authorization server doesn't report them when refreshing.
+			token = token.WithExtra(map[string]any{"scope": mcpToken.GrantedScopes})
+		}
+		if err = req.GatewayClient.ReplaceMCPOAuthToken(req.Context(), req.User.GetUID(), mcpID, "", "", conf, token); err != nil {
+			return "", nil, fmt.Errorf("failed to save refreshed OAuth token for OAuth app %s: %w", oauthApp, err)
+		}
+	}
+
+	return token.AccessToken, mcpToken.MissingScopes(scopes), nil
+}
+
+// addOAuthTokenSourceValues adds the headers and environment variables that are sourced from the user's OAuth tokens to the server config.
+func addOAuthTokenSourceValues(req api.Context, server v1.MCPServer, serverConfig *mcp.ServerConfig) error {
+	apps := mcp.OAuthTokenSourceApps(server.Spec.Manifest)
+	if len(apps) == 0 {
+		return nil
+	}
+
+	tokens := make(map[string]string, len(apps))
+	var unauthorized, needsConsent []string
+	for _, app := range apps {
+		token, missingScopes, err := oauthTokenSourceToken(req, app, mcp.OAuthTokenSourceScopes(server.Spec.Manifest, app))
+		if err != nil {
+			return err
+		}
+		if token == "" {
+			unauthorized = append(unauthorized, app)
+			continue
+		}
+		if len(missingScopes) > 0 {
+			needsConsent = append(needsConsent, fmt.Sprintf("%s (%s)", app, strings.Join(missingScopes, ", ")))
+			continue
+		}
+		tokens[app] = token
+	}
+
+	if len(unauthorized) > 0 {
+		return types.NewErrHTTP(http.StatusPreconditionFailed, fmt.Sprintf("MCP server %s requires authorization with OAuth apps: %s", server.Name, strings.Join(unauthorized, ", ")))
+	}
+	if len(needsConsent) > 0 {
+		return types.NewErrHTTP(http.StatusPreconditionFailed, fmt.Sprintf("MCP server %s requires consent to new scopes of OAuth apps: %s", server.Name, strings.Join(needsConsent, ", ")))
+	}
+
+	mcp.AddOAuthTokenValues(serverConfig, server.Spec.Manifest, tokens)
+	return nil
+}
+
+// ListOAuthTokenSources reports, for each OAuth app that the server sources values from, whether the user has authorized it.
+func (m *MCPHandler) ListOAuthTokenSources(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	apps := mcp.OAuthTokenSourceApps(server.Spec.Manifest)
+	result := make([]types.MCPOAuthTokenSourceStatus, 0, len(apps))
+	for _, app := range apps {
+		token, missingScopes, err := oauthTokenSourceToken(req, app, mcp.OAuthTokenSourceScopes(server.Spec.Manifest, app))
+		if err != nil {
+			return err
+		}
+
+		status := types.MCPOAuthTokenSourceStatus{
+			OAuthApp:      app,
+			Authenticated: token != "",
+			MissingScopes: missingScopes,
+		}
+		for _, env := range server.Spec.Manifest.Env {
+			if env.OAuthTokenSource != nil && env.OAuthTokenSource.OAuthApp == app {
+				status.Keys = append(status.Keys, env.Key)
+			}
+		}
+		if server.Spec.Manifest.RemoteConfig != nil {
+			for _, header := range server.Spec.Manifest.RemoteConfig.Headers {
+				if header.OAuthTokenSource != nil && header.OAuthTokenSource.OAuthApp == app {
+					status.Keys = append(status.Keys, header.Key)
+				}
+			}
+		}
+		result = append(result, status)
+	}
+
+	return req.Write(types.MCPOAuthTokenSourceStatusList{Items: result})
+}
+
+// AuthorizeOAuthTokenSource starts the flow for the user to authorize an OAuth app that the server sources values from,
+// and returns the URL that the user should visit. The OAuth app must allow <Obot URL>/oauth/mcp/callback as a redirect URI.
+func (m *MCPHandler) AuthorizeOAuthTokenSource(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	appAlias := req.PathValue("oauth_app")
+	if !slices.Contains(mcp.OAuthTokenSourceApps(server.Spec.Manifest), appAlias) {
+		return types.NewErrNotFound("MCP server %s does not source any values from OAuth app %s", server.Name, appAlias)
+	}
+
+	var app v1.OAuthApp
+	if err := alias.Get(req.Context(), req.Storage, &app, req.Namespace(), appAlias); err != nil {
+		return err
+	}
+
+	clientSecret := app.Spec.Manifest.ClientSecret
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{app.Name}, app.Spec.Manifest.Alias)
+	if err == nil {
+		clientSecret = cred.Env["CLIENT_SECRET"]
+	} else if !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to reveal OAuth app credential: %w", err)
+	}
+
+	conf := &oauth2.Config{
+		ClientID:     app.Spec.Manifest.ClientID,
+		ClientSecret: clientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  app.Spec.Manifest.AuthURL,
+			TokenURL: app.Spec.Manifest.TokenURL,
+		},
+		RedirectURL: fmt.Sprintf("%s/oauth/mcp/callback", m.serverURL),
+		Scopes:      mcp.OAuthTokenSourceScopes(server.Spec.Manifest, appAlias),
+	}
+
+	// The token is shared by every MCP server that sources values from the OAuth app, so keep the scopes that it was
+	// already granted when the user authorizes again to consent to new ones.
+	var reconsent bool
+	existing, err := req.GatewayClient.GetMCPOAuthToken(req.Context(), req.User.GetUID(), oauthTokenSourceMCPID(appAlias), "")
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get OAuth token for OAuth app %s: %w", appAlias, err)
+	} else if err == nil {
+		reconsent = len(existing.MissingScopes(conf.Scopes)) > 0
+		for _, scope := range existing.GrantedScopeList() {
+			if !slices.Contains(conf.Scopes, scope) {
+				conf.Scopes = append(conf.Scopes, scope)
+			}
+		}
+	}
+
+	state := strings.ToLower(rand.Text())
+	verifier := oauth2.GenerateVerifier()
+	if err = req.GatewayClient.CreateMCPOAuthPendingState(req.Context(), req.User.GetUID(), oauthTokenSourceMCPID(appAlias), "", "", state, verifier, conf); err != nil {
+		return fmt.Errorf("failed to store OAuth state: %w", err)
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.S256ChallengeOption(verifier)}
+	if app.Spec.Manifest.Type == types.OAuthAppTypeGoogle {
+		// Google only returns a refresh token when offline access is requested.
+		opts = append(opts, oauth2.AccessTypeOffline, oauth2.ApprovalForce)
+	} else if reconsent {
+		// Ask the user to consent to the new scopes instead of silently reusing the previous grant.
+		opts = append(opts, oauth2.SetAuthURLParam("prompt", "consent"))
+	}
+
+	return req.Write(map[string]string{"authURL": conf.AuthCodeURL(state, opts...)})
+}
+
+// DeleteOAuthTokenSource removes the user's token for an OAuth app that the server sources values from.
+// The token is shared by all MCP servers that source values from the OAuth app.
+func (m *MCPHandler) DeleteOAuthTokenSource(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	appAlias := req.PathValue("oauth_app")
+	if !slices.Contains(mcp.OAuthTokenSourceApps(server.Spec.Manifest), appAlias) {
+		return types.NewErrNotFound("MCP server %s does not source any values from OAuth app %s", server.Name, appAlias)
+	}
+
+	if err := req.GatewayClient.DeleteMCPOAuthTokens(req.Context(), req.User.GetUID(), oauthTokenSourceMCPID(appAlias)); err != nil {
+		return fmt.Errorf("failed to delete OAuth token: %w", err)
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// oauthScopeChanges returns the OAuth apps that an updated catalog entry requests new scopes for, with the number of
+// users who authorized them without those scopes.
+func oauthScopeChanges(req api.Context, before, after types.MCPServerCatalogEntryManifest) ([]types.MCPOAuthScopeChange, error) {
+	added := mcp.AddedOAuthTokenSourceScopes(oauthTokenSourceManifest(before), oauthTokenSourceManifest(after))
+
+	changes := make([]types.MCPOAuthScopeChange, 0, len(added))
+	for _, app := range slices.Sorted(maps.Keys(added)) {
+		affected, err := req.GatewayClient.CountMCPOAuthTokensMissingScopes(req.Context(), oauthTokenSourceMCPID(app), added[app])
+		if err != nil {
+			return nil, fmt.Errorf("failed to count OAuth tokens missing scopes for OAuth app %s: %w", app, err)
+		}
+		changes = append(changes, types.MCPOAuthScopeChange{
+			OAuthApp:      app,
+			AddedScopes:   added[app],
+			AffectedUsers: affected,
+		})
+	}
+	return changes, nil
+}
+
+// oauthTokenSourceManifest returns a server manifest with the values of a catalog entry manifest that can be sourced
+// from OAuth tokens.
+func oauthTokenSourceManifest(manifest types.MCPServerCatalogEntryManifest) types.MCPServerManifest {
+	serverManifest := types.MCPServerManifest{
+		Env: manifest.Env,
+	}
+	if manifest.RemoteConfig != nil {
+		serverManifest.RemoteConfig = &types.RemoteRuntimeConfig{
+			Headers: manifest.RemoteConfig.Headers,
+		}
+	}
+	return serverManifest
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+// searchScores returns the relevance of the MCP catalog entries or servers that match a search query, by name. It
+// returns nil if there is no query.
+func searchScores(req api.Context, kind, query string) (map[string]float64, error) {
+	if query == "" {
+		return nil, nil
+	}
+
+	results, err := req.GatewayClient.SearchMCP(req.Context(), kind, query)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[string]float64, len(results))
+	for _, r := range results {
+		scores[r.Name] = r.Score
+	}
+	return scores, nil
+}
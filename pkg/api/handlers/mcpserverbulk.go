@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxMCPServerBulkItems is the most servers that one bulk request can act on.
+	maxMCPServerBulkItems = 100
+	// mcpServerBulkConcurrency is the number of servers that a bulk request acts on at a time.
+	mcpServerBulkConcurrency = 4
+)
+
+// BulkServerAction handles POST /api/mcp-servers/bulk, /api/mcp-catalogs/{catalog_id}/servers/bulk and
+// /api/workspaces/{workspace_id}/servers/bulk. It performs the same action on each of a list of servers by calling the
+// handler of that action for a single server, so each server is checked exactly as it would be on its own, and
+// reports the result for each server. A server that fails doesn't stop the others.
+func (m *MCPHandler) BulkServerAction(req api.Context) error {
+	if !req.UserIsAdmin() {
+		return types.NewErrForbidden("only admins can perform bulk actions on MCP servers")
+	}
+
+	var bulkRequest types.MCPServerBulkRequest
+	if err := req.Read(&bulkRequest); err != nil {
+		return types.NewErrBadRequest("failed to read bulk request: %v", err)
+	}
+
+	action, err := m.mcpServerBulkAction(bulkRequest.Action)
+	if err != nil {
+		return err
+	}
+
+	serverIDs := uniqueMCPServerIDs(bulkRequest.ServerIDs)
+	if len(serverIDs) == 0 {
+		return types.NewErrBadRequest("at least one server ID is required")
+	}
+	if len(serverIDs) > maxMCPServerBulkItems {
+		return types.NewErrBadRequest("a bulk request can act on at most %d servers", maxMCPServerBulkItems)
+	}
+
+	var body []byte
+	if bulkRequest.Action == types.MCPServerBulkActionConfigure {
+		if len(bulkRequest.Configuration) == 0 || string(bulkRequest.Configuration) == "null" {
+			return types.NewErrBadRequest("configuration is required to configure servers")
+		}
+		body = bulkRequest.Configuration
+	}
+
+	var (
+		results = make([]types.MCPServerBulkResult, len(serverIDs))
+		sem     = make(chan struct{}, mcpServerBulkConcurrency)
+		wg      sync.WaitGroup
+	)
+	for i, id := range serverIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = runMCPServerBulkItem(req, id, body, action)
+		}()
+	}
+	wg.Wait()
+
+	response := types.MCPServerBulkResponse{Items: results}
+	for _, result := range results {
+		if result.Error == "" {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	log.Infof("Performed bulk MCP server action: action=%s servers=%d succeeded=%d failed=%d userID=%s",
+		bulkRequest.Action, len(serverIDs), response.Succeeded, response.Failed, req.User.GetUID())
+	return req.Write(response)
+}
+
+func (m *MCPHandler) mcpServerBulkAction(action types.MCPServerBulkAction) (api.HandlerFunc, error) {
+	switch action {
+	case types.MCPServerBulkActionConfigure:
+		return m.ConfigureServer, nil
+	case types.MCPServerBulkActionLaunch:
+		return m.LaunchServer, nil
+	case types.MCPServerBulkActionShutdown:
+		return m.shutdownServer, nil
+	case types.MCPServerBulkActionDelete:
+		return m.DeleteServer, nil
+	case types.MCPServerBulkActionTriggerUpdate:
+		return m.TriggerUpdate, nil
+	default:
+		return nil, types.NewErrBadRequest("invalid action %q, must be configure, launch, shutdown, delete or trigger-update", action)
+	}
+}
+
+// uniqueMCPServerIDs returns the server IDs of a bulk request without blanks and duplicates, in the order they were
+// given.
+func uniqueMCPServerIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// runMCPServerBulkItem calls the handler of an action for one server of a bulk request, as if the server were in the
+// path of the request.
+func runMCPServerBulkItem(req api.Context, serverID string, body []byte, action api.HandlerFunc) types.MCPServerBulkResult {
+	result := types.MCPServerBulkResult{ServerID: serverID}
+	var response []byte
+	result.StatusCode, response = callHandler(req, map[string]string{"mcp_server_id": serverID}, body, action)
+	if result.StatusCode >= http.StatusBadRequest {
+		result.Error = strings.TrimSpace(string(response))
+		if result.Error == "" {
+			result.Error = http.StatusText(result.StatusCode)
+		}
+	}
+	return result
+}
+
+// shutdownServer shuts down a server and its components, if it is a composite server. It will be started again the
+// next time that it is used.
+func (m *MCPHandler) shutdownServer(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	// For servers that are in catalogs, this checks to make sure that a catalogID was provided and that it matches.
+	// For servers that are in workspaces, this checks to make sure that a workspaceID was provided and that it matches.
+	if server.Spec.MCPCatalogID != req.PathValue("catalog_id") || server.Spec.PowerUserWorkspaceID != req.PathValue("workspace_id") {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	if server.Spec.Manifest.Runtime == types.RuntimeComposite {
+		var components v1.MCPServerList
+		if err := req.List(&components, kclient.InNamespace(server.Namespace), kclient.MatchingFields{
+			"spec.compositeName": server.Name,
+		}); err != nil {
+			return fmt.Errorf("failed to list component servers: %w", err)
+		}
+		for _, component := range components.Items {
+			if err := m.removeMCPServer(req.Context(), component); err != nil {
+				return err
+			}
+		}
+	}
+
+	return m.removeMCPServer(req.Context(), server)
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestUniqueMCPServerIDs(t *testing.T) {
+	got := uniqueMCPServerIDs([]string{"ms1", "", "ms2", "ms1", "ms3", "ms2"})
+	if want := []string{"ms1", "ms2", "ms3"}; !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestRunMCPServerBulkItem(t *testing.T) {
+	req := api.Context{
+		ResponseWriter: httptest.NewRecorder(),
+		Request:        httptest.NewRequest(http.MethodPost, "/api/mcp-catalogs/default/servers/bulk", nil),
+	}
+	req.SetPathValue("catalog_id", "default")
+
+	tests := []struct {
+		name       string
+		action     api.HandlerFunc
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name: "no response written",
+			action: func(api.Context) error {
+				return nil
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "response written",
+			action: func(req api.Context) error {
+				req.WriteHeader(http.StatusNoContent)
+				return nil
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "error response written",
+			action: func(req api.Context) error {
+				http.Error(req.ResponseWriter, "server is in use", http.StatusConflict)
+				return nil
+			},
+			wantStatus: http.StatusConflict,
+			wantError:  "server is in use",
+		},
+		{
+			name: "HTTP error",
+			action: func(api.Context) error {
+				return types.NewErrNotFound("MCP server not found")
+			},
+			wantStatus: http.StatusNotFound,
+			wantError:  "MCP server not found",
+		},
+		{
+			name: "storage error",
+			action: func(api.Context) error {
+				return apierrors.NewNotFound(schema.GroupResource{Resource: "mcpservers"}, "ms1")
+			},
+			wantStatus: http.StatusNotFound,
+			wantError:  `mcpservers "ms1" not found`,
+		},
+		{
+			name: "other error",
+			action: func(api.Context) error {
+				return errors.New("boom")
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  "boom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var serverID, catalogID string
+			result := runMCPServerBulkItem(req, "ms1", nil, func(req api.Context) error {
+				serverID, catalogID = req.PathValue("mcp_server_id"), req.PathValue("catalog_id")
+				return tt.action(req)
+			})
+			if serverID != "ms1" || catalogID != "default" {
+				t.Errorf("expected the action to see server ms1 in catalog default, got %q in %q", serverID, catalogID)
+			}
+			if result.ServerID != "ms1" || result.StatusCode != tt.wantStatus || result.Error != tt.wantError {
+				t.Errorf("expected status %d and error %q, got %+v", tt.wantStatus, tt.wantError, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxConcurrentHealthChecks bounds the number of MCP servers that are checked at the same time.
+	maxConcurrentHealthChecks = 10
+	// healthCheckTimeout is how long to wait for an MCP server before reporting it as unhealthy.
+	healthCheckTimeout = 10 * time.Second
+)
+
+// ServersHealth returns the health of each of the user's MCP servers, keyed by server ID.
+// Servers that aren't running are reported as not deployed rather than being deployed to check them.
+func (m *MCPHandler) ServersHealth(req api.Context) error {
+	var servers v1.MCPServerList
+	if err := req.List(&servers, kclient.MatchingFields{
+		"spec.userID":     req.User.GetUID(),
+		"spec.threadName": "",
+	}); err != nil {
+		return err
+	}
+
+	var (
+		lock   sync.Mutex
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, maxConcurrentHealthChecks)
+		health = make(map[string]types.MCPServerHealthStatus, len(servers.Items))
+	)
+	for _, server := range servers.Items {
+		if server.Spec.Template || server.Spec.CompositeName != "" {
+			continue
+		}
+
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			status := types.MCPServerHealthUnhealthy
+			if serverConfig, err := serverConfigForAction(req, server); err == nil {
+				ctx, cancel := context.WithTimeout(req.Context(), healthCheckTimeout)
+				status = m.mcpSessionManager.ServerHealth(ctx, serverConfig)
+				cancel()
+			} else {
+				log.Debugf("failed to get config of MCP server %s for health check: %v", server.Name, err)
+			}
+
+			lock.Lock()
+			health[server.Name] = status
+			lock.Unlock()
+		})
+	}
+	wg.Wait()
+
+	return req.Write(health)
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/auth"
+	"github.com/obot-platform/obot/pkg/mcpscaffold"
+)
+
+type MCPServerScaffoldHandler struct{}
+
+func NewMCPServerScaffoldHandler() *MCPServerScaffoldHandler {
+	return &MCPServerScaffoldHandler{}
+}
+
+// Create scaffolds a new MCP server project from the template of a language. The project is pushed to a new branch of
+// the Git repository in the request, or returned as a zip archive if there isn't one.
+func (*MCPServerScaffoldHandler) Create(req api.Context) error {
+	var input types.MCPServerScaffoldRequest
+	if err := req.Read(&input); err != nil {
+		return types.NewErrBadRequest("failed to read scaffold request: %v", err)
+	}
+	if err := mcpscaffold.Validate(input); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	if input.Repository == nil {
+		files, err := mcpscaffold.Generate(input, "", "")
+		if err != nil {
+			return err
+		}
+
+		req.ResponseWriter.Header().Set("Content-Type", "application/zip")
+		req.ResponseWriter.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", input.Name+".zip"))
+		req.WriteHeader(http.StatusOK)
+
+		return mcpscaffold.WriteZip(req.ResponseWriter, input.Name, files)
+	}
+
+	branch := mcpscaffold.Branch(*input.Repository)
+	files, err := mcpscaffold.Generate(input, input.Repository.URL, branch)
+	if err != nil {
+		return err
+	}
+
+	author := object.Signature{
+		Name:  req.User.GetName(),
+		Email: auth.FirstExtraValue(req.User.GetExtra(), "email"),
+	}
+	commit, err := mcpscaffold.Push(req.Context(), *input.Repository, files, author)
+	if errors.Is(err, mcpscaffold.ErrBranchExists) {
+		return types.NewErrHTTP(http.StatusConflict, err.Error())
+	} else if err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	return req.WriteCode(types.MCPServerScaffoldResult{
+		Repository:           input.Repository.URL,
+		Branch:               branch,
+		Commit:               commit,
+		CatalogEntryManifest: mcpscaffold.CatalogEntryManifest(input, input.Repository.URL, branch),
+	}, http.StatusCreated)
+}
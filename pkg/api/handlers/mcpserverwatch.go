@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WatchServers handles GET /api/mcp-servers/watch. It streams the changes to the requesting user's MCP servers as
+// server-sent events, starting with a created event for each server that already exists. An updated event is only
+// sent when something that the list endpoint returns changed, like the deployment status or whether the server
+// drifted from its catalog entry.
+func (m *MCPHandler) WatchServers(req api.Context) error {
+	userID := req.User.GetUID()
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers, kclient.MatchingFields{
+		"spec.userID":     userID,
+		"spec.threadName": "",
+	}); err != nil {
+		return err
+	}
+
+	// Field selectors don't work for watches, so the servers are filtered as they change.
+	w, err := req.Storage.Watch(req.Context(), &v1.MCPServerList{}, &kclient.ListOptions{
+		Namespace: req.Namespace(),
+		Raw: &metav1.ListOptions{
+			ResourceVersion: servers.ResourceVersion,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch MCP servers: %w", err)
+	}
+	defer w.Stop()
+
+	req.ResponseWriter.Header().Set("Content-Type", "text/event-stream")
+	req.ResponseWriter.Header().Set("Cache-Control", "no-cache")
+	req.ResponseWriter.Header().Set("Connection", "keep-alive")
+	defer func() {
+		_ = req.WriteDataEvent(api.EventClose{})
+	}()
+
+	if _, err = req.ResponseWriter.Write([]byte("event: start\ndata: {}\n\n")); err != nil {
+		return err
+	}
+	req.Flush()
+
+	state := newMCPServerWatchState(userID)
+	for _, server := range servers.Items {
+		if err := writeMCPServerWatchEvent(req, state, server, false); err != nil {
+			return err
+		}
+	}
+
+	keepAlive := time.NewTicker(30 * time.Second)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				// The client reconnects when the watch ends, and gets the servers again.
+				return nil
+			}
+			server, ok := event.Object.(*v1.MCPServer)
+			if !ok {
+				continue
+			}
+
+			var deleted bool
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				deleted = !server.DeletionTimestamp.IsZero()
+			case watch.Deleted:
+				deleted = true
+			default:
+				continue
+			}
+			if err := writeMCPServerWatchEvent(req, state, *server, deleted); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := req.ResponseWriter.Write([]byte(": keep-alive\n\n")); err != nil {
+				return err
+			}
+			req.Flush()
+		case <-req.Context().Done():
+			return nil
+		}
+	}
+}
+
+func writeMCPServerWatchEvent(req api.Context, state *mcpServerWatchState, server v1.MCPServer, deleted bool) error {
+	var converted types.MCPServer
+	if !deleted && state.matches(server) {
+		var err error
+		if converted, err = convertWatchedMCPServer(req, server); err != nil {
+			return err
+		}
+	}
+
+	event, ok := state.event(server, converted, deleted)
+	if !ok {
+		return nil
+	}
+	return req.WriteDataEvent(event)
+}
+
+// convertWatchedMCPServer converts one of the requesting user's MCP servers the same way the list endpoint does.
+func convertWatchedMCPServer(req api.Context, server v1.MCPServer) (types.MCPServer, error) {
+	addExtractedEnvVars(&server)
+
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{fmt.Sprintf("%s-%s", req.User.GetUID(), server.Name)}, server.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return types.MCPServer{}, fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	slug, err := SlugForMCPServer(req.Context(), req.Storage, server, req.User.GetUID(), "", "")
+	if err != nil {
+		return types.MCPServer{}, fmt.Errorf("failed to determine slug: %w", err)
+	}
+
+	var components []types.MCPServer
+	if server.Spec.Manifest.Runtime == types.RuntimeComposite {
+		if components, err = resolveCompositeComponents(req, server); err != nil {
+			return types.MCPServer{}, err
+		}
+	}
+
+	return ConvertMCPServer(server, cred.Env, MCPServerConnectBaseURL(req, server), slug, components...), nil
+}
+
+// mcpServerWatchState is the last version of each server that a watch sent, so that it only sends changes.
+type mcpServerWatchState struct {
+	userID string
+	sent   map[string][]byte
+}
+
+func newMCPServerWatchState(userID string) *mcpServerWatchState {
+	return &mcpServerWatchState{
+		userID: userID,
+		sent:   make(map[string][]byte),
+	}
+}
+
+// matches returns whether a server is one that the list endpoint returns to the user.
+func (s *mcpServerWatchState) matches(server v1.MCPServer) bool {
+	return server.Spec.UserID == s.userID && server.Spec.ThreadName == "" && !server.Spec.Template && server.Spec.CompositeName == ""
+}
+
+// event returns the event to send for a change to a server, if the change is one that the user can see. A server that
+// stops matching is sent as deleted.
+func (s *mcpServerWatchState) event(server v1.MCPServer, converted types.MCPServer, deleted bool) (types.MCPServerWatchEvent, bool) {
+	if deleted || !s.matches(server) {
+		if _, ok := s.sent[server.Name]; !ok {
+			return types.MCPServerWatchEvent{}, false
+		}
+		delete(s.sent, server.Name)
+
+		metadata := MetadataFrom(&server)
+		if metadata.Deleted == nil {
+			metadata.Deleted = types.NewTime(time.Now())
+		}
+		return types.MCPServerWatchEvent{
+			Type:   types.MCPServerWatchEventDeleted,
+			Server: types.MCPServer{Metadata: metadata},
+		}, true
+	}
+
+	data, err := json.Marshal(converted)
+	if err != nil {
+		return types.MCPServerWatchEvent{}, false
+	}
+
+	eventType := types.MCPServerWatchEventUpdated
+	if previous, ok := s.sent[server.Name]; !ok {
+		eventType = types.MCPServerWatchEventCreated
+	} else if bytes.Equal(previous, data) {
+		return types.MCPServerWatchEvent{}, false
+	}
+	s.sent[server.Name] = data
+
+	return types.MCPServerWatchEvent{
+		Type:   eventType,
+		Server: converted,
+	}, true
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMCPServerWatchState(t *testing.T) {
+	state := newMCPServerWatchState("1")
+	server := v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms1"},
+		Spec:       v1.MCPServerSpec{UserID: "1"},
+	}
+	converted := types.MCPServer{Metadata: types.Metadata{ID: "ms1"}, DeploymentStatus: "Progressing"}
+
+	expect := func(event types.MCPServerWatchEvent, ok bool, want types.MCPServerWatchEventType) {
+		t.Helper()
+		if want == "" {
+			if ok {
+				t.Errorf("expected no event, got %s", event.Type)
+			}
+			return
+		}
+		if !ok || event.Type != want || event.Server.ID != "ms1" {
+			t.Errorf("expected a %s event for ms1, got %+v (ok=%v)", want, event, ok)
+		}
+	}
+
+	event, ok := state.event(server, converted, false)
+	expect(event, ok, types.MCPServerWatchEventCreated)
+	// Nothing that the user can see changed.
+	event, ok = state.event(server, converted, false)
+	expect(event, ok, "")
+
+	converted.DeploymentStatus = "Available"
+	event, ok = state.event(server, converted, false)
+	expect(event, ok, types.MCPServerWatchEventUpdated)
+
+	converted.NeedsUpdate = true
+	event, ok = state.event(server, converted, false)
+	expect(event, ok, types.MCPServerWatchEventUpdated)
+
+	event, ok = state.event(server, converted, true)
+	expect(event, ok, types.MCPServerWatchEventDeleted)
+	if event.Server.Deleted == nil {
+		t.Error("expected the deleted server to have a deletion time")
+	}
+	event, ok = state.event(server, converted, true)
+	expect(event, ok, "")
+
+	// Servers of other users are never sent.
+	other := server
+	other.Spec.UserID = "2"
+	event, ok = state.event(other, converted, false)
+	expect(event, ok, "")
+}
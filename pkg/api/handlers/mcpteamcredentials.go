@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/accesscontrolrule"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListTeamCredentials lists the groups that have a team credential for a catalog entry, with the keys they configured.
+func (h *MCPCatalogHandler) ListTeamCredentials(req api.Context) error {
+	entry, err := teamCredentialEntry(req)
+	if err != nil {
+		return err
+	}
+
+	items := make([]types.MCPTeamCredential, 0, len(entry.Spec.TeamCredentialGroupIDs))
+	for _, groupID := range entry.Spec.TeamCredentialGroupIDs {
+		cred, err := req.GPTClient.RevealCredential(req.Context(), []string{system.MCPTeamCredentialContext(entry.Spec.MCPCatalogName, entry.Name, groupID)}, entry.Name)
+		if errors.As(err, &gptscript.ErrNotFound{}) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to find team credential: %w", err)
+		}
+
+		keys := make([]string, 0, len(cred.Env))
+		for key := range cred.Env {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		items = append(items, types.MCPTeamCredential{
+			GroupID:        groupID,
+			ConfiguredKeys: keys,
+		})
+	}
+
+	return req.Write(types.MCPTeamCredentialList{Items: items})
+}
+
+// SetTeamCredential configures the team credential of a group for a catalog entry. The body has the same form as the
+// configuration of a server: the values of its environment variables and headers by name.
+func (h *MCPCatalogHandler) SetTeamCredential(req api.Context) error {
+	groupID := req.PathValue("group_id")
+
+	entry, err := teamCredentialEntry(req)
+	if err != nil {
+		return err
+	}
+
+	var envVars map[string]string
+	if err := req.Read(&envVars); err != nil {
+		return err
+	}
+	if len(envVars) == 0 {
+		return types.NewErrBadRequest("team credential must configure at least one value")
+	}
+
+	keys := teamCredentialKeys(entry.Spec.Manifest)
+	for key := range envVars {
+		if !slices.Contains(keys, key) {
+			return types.NewErrBadRequest("%s is not an environment variable or header of catalog entry %s", key, entry.Name)
+		}
+	}
+
+	hasAccess, err := groupHasAccessToCatalogEntry(req, entry, groupID)
+	if err != nil {
+		return err
+	}
+	if !hasAccess {
+		return types.NewErrBadRequest("group %s has no access to catalog entry %s; add it to an access control rule first", groupID, entry.Name)
+	}
+
+	credCtx := system.MCPTeamCredentialContext(entry.Spec.MCPCatalogName, entry.Name, groupID)
+	if err := DeleteCredentialIfExists(req.Context(), req.GPTClient, []string{credCtx}, entry.Name); err != nil {
+		return err
+	}
+	if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+		Context:  credCtx,
+		ToolName: entry.Name,
+		Type:     gptscript.CredentialTypeTool,
+		Env:      envVars,
+	}); err != nil {
+		return fmt.Errorf("failed to create team credential: %w", err)
+	}
+
+	if !slices.Contains(entry.Spec.TeamCredentialGroupIDs, groupID) {
+		entry.Spec.TeamCredentialGroupIDs = append(entry.Spec.TeamCredentialGroupIDs, groupID)
+		slices.Sort(entry.Spec.TeamCredentialGroupIDs)
+		if err := req.Update(&entry); err != nil {
+			return fmt.Errorf("failed to update catalog entry: %w", err)
+		}
+	}
+
+	keys = make([]string, 0, len(envVars))
+	for key := range envVars {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return req.Write(types.MCPTeamCredential{
+		GroupID:        groupID,
+		ConfiguredKeys: keys,
+	})
+}
+
+// DeleteTeamCredential removes the team credential of a group for a catalog entry. The servers of the members of the
+// group that used it need to be configured again.
+func (h *MCPCatalogHandler) DeleteTeamCredential(req api.Context) error {
+	groupID := req.PathValue("group_id")
+
+	entry, err := teamCredentialEntry(req)
+	if err != nil {
+		return err
+	}
+
+	if err := DeleteCredentialIfExists(req.Context(), req.GPTClient, []string{system.MCPTeamCredentialContext(entry.Spec.MCPCatalogName, entry.Name, groupID)}, entry.Name); err != nil {
+		return err
+	}
+
+	if i := slices.Index(entry.Spec.TeamCredentialGroupIDs, groupID); i >= 0 {
+		entry.Spec.TeamCredentialGroupIDs = slices.Delete(entry.Spec.TeamCredentialGroupIDs, i, i+1)
+		if err := req.Update(&entry); err != nil {
+			return fmt.Errorf("failed to update catalog entry: %w", err)
+		}
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// teamCredentialEntry returns the catalog entry of a team credential request. Team credentials are only supported for
+// entries whose configuration is made of environment variables and headers.
+func teamCredentialEntry(req api.Context) (v1.MCPServerCatalogEntry, error) {
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, req.PathValue("entry_id")); err != nil {
+		return entry, err
+	}
+	if entry.Spec.MCPCatalogName != req.PathValue("catalog_id") {
+		return entry, types.NewErrNotFound("catalog entry not found")
+	}
+
+	switch {
+	case entry.Spec.Manifest.Runtime == types.RuntimeComposite:
+		return entry, types.NewErrBadRequest("team credentials are not supported for composite catalog entries")
+	case entry.Spec.Manifest.RemoteConfig != nil && entry.Spec.Manifest.RemoteConfig.URLTemplate != "":
+		return entry, types.NewErrBadRequest("team credentials are not supported for catalog entries with URL templates")
+	}
+
+	return entry, nil
+}
+
+// teamCredentialKeys returns the names of the environment variables and headers that a team credential can configure.
+func teamCredentialKeys(manifest types.MCPServerCatalogEntryManifest) []string {
+	keys := make([]string, 0, len(manifest.Env))
+	for _, env := range manifest.Env {
+		keys = append(keys, env.Key)
+	}
+	if manifest.RemoteConfig != nil {
+		for _, header := range manifest.RemoteConfig.Headers {
+			keys = append(keys, header.Key)
+		}
+	}
+	return keys
+}
+
+// groupHasAccessToCatalogEntry checks if the access control rules of the entry's catalog give the members of a group
+// access to the entry.
+func groupHasAccessToCatalogEntry(req api.Context, entry v1.MCPServerCatalogEntry, groupID string) (bool, error) {
+	var rules v1.AccessControlRuleList
+	if err := req.List(&rules, kclient.MatchingFields{"spec.mcpCatalogID": entry.Spec.MCPCatalogName}); err != nil {
+		return false, fmt.Errorf("failed to list access control rules: %w", err)
+	}
+
+	return accesscontrolrule.GroupHasAccessToMCPServerCatalogEntry(rules.Items, groupID, entry.Name), nil
+}
+
+// teamCredentialEnv returns the configuration of a single-user server from the team credential of one of its owner's
+// groups, or nil if there is none. Only groups that an access control rule gives access to the server's catalog entry
+// are considered, in the order of their IDs.
+func teamCredentialEnv(req api.Context, server v1.MCPServer) (map[string]string, error) {
+	if server.Spec.UserID == "" || server.Spec.MCPServerCatalogEntryName == "" || server.Spec.MCPCatalogID != "" ||
+		server.Spec.PowerUserWorkspaceID != "" || server.Spec.ThreadName != "" || server.Spec.CompositeName != "" {
+		return nil, nil
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, server.Spec.MCPServerCatalogEntryName); err != nil {
+		return nil, kclient.IgnoreNotFound(err)
+	}
+	if len(entry.Spec.TeamCredentialGroupIDs) == 0 || entry.Spec.MCPCatalogName == "" {
+		return nil, nil
+	}
+
+	ownerID, err := strconv.ParseUint(server.Spec.UserID, 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+	ownerGroupIDs, err := req.GatewayClient.ListGroupIDsForUser(req.Context(), uint(ownerID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, groupID := range entry.Spec.TeamCredentialGroupIDs {
+		if !slices.Contains(ownerGroupIDs, groupID) {
+			continue
+		}
+
+		hasAccess, err := groupHasAccessToCatalogEntry(req, entry, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if !hasAccess {
+			continue
+		}
+
+		cred, err := req.GPTClient.RevealCredential(req.Context(), []string{system.MCPTeamCredentialContext(entry.Spec.MCPCatalogName, entry.Name, groupID)}, entry.Name)
+		if errors.As(err, &gptscript.ErrNotFound{}) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to find team credential: %w", err)
+		}
+		return cred.Env, nil
+	}
+
+	return nil, nil
+}
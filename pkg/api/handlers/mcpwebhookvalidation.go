@@ -13,6 +13,7 @@ import (
 	"github.com/gptscript-ai/go-gptscript"
 	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
 	"github.com/obot-platform/obot/pkg/api"
 	"github.com/obot-platform/obot/pkg/mcp"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
@@ -62,6 +63,19 @@ func (m *MCPWebhookValidationHandler) Get(req api.Context) error {
 	return req.Write(convertMCPWebhookValidation(webhookValidation, credEnv))
 }
 
+// SignatureScheme describes how requests to HTTP webhooks are signed.
+func (m *MCPWebhookValidationHandler) SignatureScheme(req api.Context) error {
+	return req.Write(types.MCPWebhookSignatureScheme{
+		Version:               webhooksignature.Version,
+		Algorithm:             "HMAC-SHA256",
+		SignedPayload:         "<timestamp>.<body>",
+		TimestampHeader:       webhooksignature.TimestampHeader,
+		SignatureHeader:       webhooksignature.SignatureHeader,
+		LegacySignatureHeader: webhooksignature.LegacySignatureHeader,
+		ToleranceSeconds:      int(webhooksignature.DefaultTolerance.Seconds()),
+	})
+}
+
 func (m *MCPWebhookValidationHandler) Create(req api.Context) error {
 	var manifest types.MCPWebhookValidationManifest
 	if err := req.Read(&manifest); err != nil {
@@ -401,6 +415,11 @@ func (m *MCPWebhookValidationHandler) Logs(req api.Context) error {
 		return types.NewErrBadRequest("failed to transform system server to config: %v", err)
 	}
 
+	mask, err := mcpLogMask(req, m.mcpSessionManager, serverConfig)
+	if err != nil {
+		return err
+	}
+
 	logs, err := m.mcpSessionManager.StreamServerLogs(req.Context(), serverConfig)
 	if err != nil {
 		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
@@ -413,6 +432,7 @@ func (m *MCPWebhookValidationHandler) Logs(req api.Context) error {
 		SendKeepAlive:  true,
 		SendDisconnect: true,
 		SendEnded:      true,
+		Mask:           mask,
 	})
 }
 
@@ -559,9 +579,17 @@ func validateManifest(m *types.MCPWebhookValidationManifest) error {
 		}
 	}
 
+	for _, filter := range m.Selectors {
+		switch filter.Direction {
+		case "", types.MCPWebhookDirectionRequest, types.MCPWebhookDirectionResponse:
+		default:
+			return fmt.Errorf("invalid selector direction %q, must be %q or %q", filter.Direction, types.MCPWebhookDirectionRequest, types.MCPWebhookDirectionResponse)
+		}
+	}
+
 	for i, filter := range m.Selectors {
 		if filter.Method == "*" {
-			m.Selectors = []types.MCPSelector{{Method: filter.Method}}
+			m.Selectors = []types.MCPSelector{{Method: filter.Method, Direction: filter.Direction}}
 			break
 		}
 		if slices.Contains(filter.Identifiers, "*") {
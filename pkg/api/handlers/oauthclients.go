@@ -3,6 +3,7 @@ package handlers
 import (
 	"crypto/rand"
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 
@@ -168,14 +169,43 @@ func (h *OAuthClientsHandler) RollClientSecret(req api.Context) error {
 	return req.Write(ConvertClient(client, h.serverURL, clientSecret))
 }
 
+// DeviceCodeGrantType is the grant type of the OAuth 2.0 device authorization grant (RFC 8628).
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// ClientCredentialsGrantType is the grant type of the OAuth 2.0 client credentials grant (RFC 6749, section 4.4).
+const ClientCredentialsGrantType = "client_credentials"
+
 func ValidateClientConfig(oauthClient *v1.OAuthClient, oauthConfig OAuthAuthorizationServerConfig) error {
 	//nolint: staticcheck
 	if oauthClient.Spec.Manifest.RedirectURI != "" {
 		oauthClient.Spec.Manifest.RedirectURIs = append(oauthClient.Spec.Manifest.RedirectURIs, oauthClient.Spec.Manifest.RedirectURI)
 	}
-	if len(oauthClient.Spec.Manifest.RedirectURIs) == 0 {
+	// Only clients using the authorization code grant are ever redirected.
+	grantTypes := oauthClient.Spec.Manifest.GrantTypes
+	redirected := len(grantTypes) == 0 || slices.Contains(grantTypes, "authorization_code")
+	if len(oauthClient.Spec.Manifest.RedirectURIs) == 0 && redirected {
 		return fmt.Errorf("redirect_uris is required")
 	}
+	if slices.Contains(grantTypes, ClientCredentialsGrantType) {
+		if !oauthClient.Spec.Static {
+			return fmt.Errorf("only clients created by an admin can use %s", ClientCredentialsGrantType)
+		}
+		if oauthClient.Spec.Manifest.TokenEndpointAuthMethod == "none" {
+			return fmt.Errorf("token_endpoint_auth_method cannot be none when using %s", ClientCredentialsGrantType)
+		}
+		if len(oauthClient.Spec.Manifest.AllowedAudiences) == 0 {
+			return fmt.Errorf("allowed_audiences is required when using %s", ClientCredentialsGrantType)
+		}
+		for _, audience := range oauthClient.Spec.Manifest.AllowedAudiences {
+			u, err := url.Parse(audience)
+			if err != nil {
+				return fmt.Errorf("allowed audience %s is not a valid URL: %v", audience, err)
+			}
+			if mcpID, ok := strings.CutPrefix(u.Path, "/mcp-connect/"); !ok || strings.Trim(mcpID, "/") == "" {
+				return fmt.Errorf("allowed audience %s is not an MCP server URL", audience)
+			}
+		}
+	}
 	if oauthClient.Spec.Manifest.TokenEndpointAuthMethod != "" && !slices.Contains(oauthConfig.TokenEndpointAuthMethodsSupported, oauthClient.Spec.Manifest.TokenEndpointAuthMethod) {
 		return fmt.Errorf("token_endpoint_auth_method must be %s, not %s", strings.Join(oauthConfig.TokenEndpointAuthMethodsSupported, ", "), oauthClient.Spec.Manifest.TokenEndpointAuthMethod)
 	}
@@ -228,6 +258,9 @@ type OAuthAuthorizationServerConfig struct {
 	// TokenEndpoint is the URL of the authorization server's token endpoint.
 	// REQUIRED unless only the implicit grant type is supported.
 	TokenEndpoint string `json:"token_endpoint"`
+	// DeviceAuthorizationEndpoint is the URL of the authorization server's OAuth 2.0 device authorization endpoint.
+	// OPTIONAL.
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
 	// JWKSURI is the URL of the authorization server's JWK Set document.
 	// OPTIONAL.
 	JWKSURI string `json:"jwks_uri,omitempty"`
@@ -285,4 +318,21 @@ type OAuthAuthorizationServerConfig struct {
 
 	// Additional fields can be added here
 	UserInfoEndpoint string `json:"userinfo_endpoint,omitempty"`
+
+	// The following fields are from OpenID Connect Discovery, and are only set when the server is an OpenID Connect provider.
+
+	// SubjectTypesSupported is a JSON array containing a list of the Subject Identifier types that this provider supports.
+	SubjectTypesSupported []string `json:"subject_types_supported,omitempty"`
+	// IDTokenSigningAlgValuesSupported is a JSON array containing a list of the JWS signing algorithms supported for ID tokens.
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported,omitempty"`
+	// ClaimsSupported is a JSON array containing a list of the claims that this provider may be able to supply values for.
+	ClaimsSupported []string `json:"claims_supported,omitempty"`
+}
+
+// OpenIDScope is the scope that clients request to get ID tokens when the OAuth server is an OpenID Connect provider.
+const OpenIDScope = "openid"
+
+// OpenIDProvider returns whether the OAuth server is an OpenID Connect provider.
+func (c OAuthAuthorizationServerConfig) OpenIDProvider() bool {
+	return slices.Contains(c.ScopesSupported, OpenIDScope)
 }
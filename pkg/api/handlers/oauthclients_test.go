@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateClientConfigClientCredentials(t *testing.T) {
+	config := OAuthAuthorizationServerConfig{
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+	}
+	newClient := func(static bool, authMethod string, audiences ...string) *v1.OAuthClient {
+		return &v1.OAuthClient{
+			Spec: v1.OAuthClientSpec{
+				Static: static,
+				Manifest: types.OAuthClientManifest{
+					GrantTypes:              []string{ClientCredentialsGrantType},
+					TokenEndpointAuthMethod: authMethod,
+					AllowedAudiences:        audiences,
+				},
+			},
+		}
+	}
+
+	// Redirect URIs are not required when the client is never redirected.
+	assert.NoError(t, ValidateClientConfig(newClient(true, "client_secret_basic", "https://obot.example.com/mcp-connect/ms1abc"), config))
+
+	assert.Error(t, ValidateClientConfig(newClient(false, "client_secret_basic", "https://obot.example.com/mcp-connect/ms1abc"), config))
+	assert.Error(t, ValidateClientConfig(newClient(true, "none", "https://obot.example.com/mcp-connect/ms1abc"), config))
+	assert.Error(t, ValidateClientConfig(newClient(true, "client_secret_basic"), config))
+	assert.Error(t, ValidateClientConfig(newClient(true, "client_secret_basic", "https://obot.example.com/api/mcp-servers/ms1abc"), config))
+	assert.Error(t, ValidateClientConfig(newClient(true, "client_secret_basic", "https://obot.example.com/mcp-connect/"), config))
+}
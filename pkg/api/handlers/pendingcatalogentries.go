@@ -0,0 +1,323 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListPendingEntries returns the catalog entries that users have submitted for review. Admins see every submission,
+// and other users see their own. The state query parameter filters them by state.
+func (h *MCPCatalogHandler) ListPendingEntries(req api.Context) error {
+	var opts []kclient.ListOption
+	if !req.UserIsAdmin() {
+		opts = append(opts, kclient.MatchingFields{"spec.userID": req.User.GetUID()})
+	}
+
+	var list v1.PendingCatalogEntryList
+	if err := req.List(&list, opts...); err != nil {
+		return fmt.Errorf("failed to list pending catalog entries: %w", err)
+	}
+
+	state := types.PendingCatalogEntryState(req.URL.Query().Get("state"))
+	items := make([]types.PendingCatalogEntry, 0, len(list.Items))
+	for _, item := range list.Items {
+		converted := convertPendingCatalogEntry(item)
+		if state == "" || converted.State == state {
+			items = append(items, converted)
+		}
+	}
+
+	return req.Write(types.PendingCatalogEntryList{Items: items})
+}
+
+// GetPendingEntry returns a submitted catalog entry.
+func (h *MCPCatalogHandler) GetPendingEntry(req api.Context) error {
+	pending, err := getPendingCatalogEntry(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertPendingCatalogEntry(pending))
+}
+
+// SubmitEntry submits a new catalog entry, or an edit to an existing one, for admins to review.
+func (h *MCPCatalogHandler) SubmitEntry(req api.Context) error {
+	var manifest types.PendingCatalogEntryManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read pending catalog entry: %v", err)
+	}
+
+	if err := h.validatePendingCatalogEntry(req, &manifest); err != nil {
+		return err
+	}
+
+	pending := v1.PendingCatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.PendingCatalogEntryPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.PendingCatalogEntrySpec{
+			Manifest: manifest,
+			UserID:   req.User.GetUID(),
+		},
+	}
+	if err := req.Create(&pending); err != nil {
+		return fmt.Errorf("failed to create pending catalog entry: %w", err)
+	}
+
+	return req.Write(convertPendingCatalogEntry(pending))
+}
+
+// UpdatePendingEntry replaces the manifest of a submission that hasn't been reviewed, such as to address comments.
+// Only the submitter can update it.
+func (h *MCPCatalogHandler) UpdatePendingEntry(req api.Context) error {
+	pending, err := getPendingCatalogEntry(req)
+	if err != nil {
+		return err
+	}
+	if pending.Spec.UserID != req.User.GetUID() {
+		return types.NewErrForbidden("only the submitter can update a pending catalog entry")
+	}
+	if pending.Status.State != "" {
+		return types.NewErrBadRequest("pending catalog entry was already %s", pending.Status.State)
+	}
+
+	var manifest types.PendingCatalogEntryManifest
+	if err = req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read pending catalog entry: %v", err)
+	}
+
+	if err = h.validatePendingCatalogEntry(req, &manifest); err != nil {
+		return err
+	}
+
+	pending.Spec.Manifest = manifest
+	if err = req.Update(&pending); err != nil {
+		return fmt.Errorf("failed to update pending catalog entry: %w", err)
+	}
+
+	return req.Write(convertPendingCatalogEntry(pending))
+}
+
+// DeletePendingEntry deletes a submission. The submitter can withdraw it until it is reviewed, and admins can delete
+// it at any time.
+func (h *MCPCatalogHandler) DeletePendingEntry(req api.Context) error {
+	pending, err := getPendingCatalogEntry(req)
+	if err != nil {
+		return err
+	}
+	if !req.UserIsAdmin() && pending.Status.State != "" {
+		return types.NewErrBadRequest("pending catalog entry was already %s", pending.Status.State)
+	}
+	if err = deleteSubmissionSandboxConfig(req, pending); err != nil {
+		return err
+	}
+
+	return req.Delete(&pending)
+}
+
+// CommentOnPendingEntry adds a comment from the submitter or an admin to a submission.
+func (h *MCPCatalogHandler) CommentOnPendingEntry(req api.Context) error {
+	pending, err := getPendingCatalogEntry(req)
+	if err != nil {
+		return err
+	}
+
+	var review types.PendingCatalogEntryReview
+	if err = req.Read(&review); err != nil {
+		return types.NewErrBadRequest("failed to read comment: %v", err)
+	}
+	if strings.TrimSpace(review.Message) == "" {
+		return types.NewErrBadRequest("message is required")
+	}
+
+	addPendingCatalogEntryComment(&pending, req.User.GetUID(), review.Message)
+	if err = req.Storage.Status().Update(req.Context(), &pending); err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	return req.Write(convertPendingCatalogEntry(pending))
+}
+
+// ApprovePendingEntry approves a submission, creating the catalog entry or applying the edit to it.
+func (h *MCPCatalogHandler) ApprovePendingEntry(req api.Context) error {
+	pending, review, err := pendingCatalogEntryForReview(req)
+	if err != nil {
+		return err
+	}
+
+	// The catalog or entry may have changed since the submission, so validate it again.
+	manifest := pending.Spec.Manifest
+	if err = h.validatePendingCatalogEntry(req, &manifest); err != nil {
+		return err
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if manifest.CatalogEntryID == "" {
+		if manifest.Manifest.Runtime == types.RuntimeComposite && manifest.Manifest.CompositeConfig != nil {
+			if err = h.populateComponentManifests(req, &manifest.Manifest, manifest.CatalogID, ""); err != nil {
+				return err
+			}
+		}
+		if entry, err = createEditableEntry(req, manifest.CatalogID, "", manifest.Manifest); err != nil {
+			return err
+		}
+	} else {
+		if err = req.Get(&entry, manifest.CatalogEntryID); err != nil {
+			return fmt.Errorf("failed to get entry: %w", err)
+		}
+
+		// Copy the tool previews over so that they don't get wiped out when updating the manifest
+		manifest.Manifest.ToolPreview = entry.Spec.Manifest.ToolPreview
+		entry.Spec.Manifest = manifest.Manifest
+		if err = req.Update(&entry); err != nil {
+			return fmt.Errorf("failed to update entry: %w", err)
+		}
+	}
+
+	pending.Status.ApprovedCatalogEntryName = entry.Name
+	if err = reviewPendingCatalogEntry(req, &pending, types.PendingCatalogEntryStateApproved, review); err != nil {
+		return err
+	}
+
+	return req.Write(convertPendingCatalogEntry(pending))
+}
+
+// RejectPendingEntry rejects a submission. The message of the review tells the submitter why.
+func (h *MCPCatalogHandler) RejectPendingEntry(req api.Context) error {
+	pending, review, err := pendingCatalogEntryForReview(req)
+	if err != nil {
+		return err
+	}
+
+	if err = reviewPendingCatalogEntry(req, &pending, types.PendingCatalogEntryStateRejected, review); err != nil {
+		return err
+	}
+
+	return req.Write(convertPendingCatalogEntry(pending))
+}
+
+// validatePendingCatalogEntry defaults the catalog of a submission and checks that the catalog exists, that the entry
+// it edits belongs to the catalog and is editable, and that the manifest is valid.
+func (h *MCPCatalogHandler) validatePendingCatalogEntry(req api.Context, manifest *types.PendingCatalogEntryManifest) error {
+	if manifest.CatalogID == "" {
+		manifest.CatalogID = system.DefaultCatalog
+	}
+	if err := req.Get(&v1.MCPCatalog{}, manifest.CatalogID); err != nil {
+		return fmt.Errorf("failed to get catalog: %w", err)
+	}
+
+	if manifest.CatalogEntryID != "" {
+		var entry v1.MCPServerCatalogEntry
+		if err := req.Get(&entry, manifest.CatalogEntryID); err != nil {
+			return fmt.Errorf("failed to get entry: %w", err)
+		}
+		if entry.Spec.MCPCatalogName != manifest.CatalogID {
+			return types.NewErrBadRequest("entry does not belong to catalog")
+		}
+		if !entry.Spec.Editable {
+			return types.NewErrBadRequest("entry is not editable")
+		}
+	}
+
+	// Validate the manifest as it would be created, without storing the manifests of composite components.
+	populated := *manifest.Manifest.DeepCopy()
+	if populated.Runtime == types.RuntimeComposite && populated.CompositeConfig != nil && manifest.CatalogEntryID == "" {
+		if err := h.populateComponentManifests(req, &populated, manifest.CatalogID, ""); err != nil {
+			return err
+		}
+	}
+	if err := validation.ValidateCatalogEntryManifest(populated); err != nil {
+		return types.NewErrBadRequest("failed to validate entry manifest: %v", err)
+	}
+
+	return validateStdioCatalogEntryManifest(req, populated, manifest.CatalogID)
+}
+
+// getPendingCatalogEntry returns the pending catalog entry of the request, if the user is its submitter or an admin.
+func getPendingCatalogEntry(req api.Context) (v1.PendingCatalogEntry, error) {
+	var pending v1.PendingCatalogEntry
+	if err := req.Get(&pending, req.PathValue("pending_entry_id")); err != nil {
+		return pending, fmt.Errorf("failed to get pending catalog entry: %w", err)
+	}
+	if !req.UserIsAdmin() && pending.Spec.UserID != req.User.GetUID() {
+		return pending, types.NewErrNotFound("pending catalog entry not found")
+	}
+	return pending, nil
+}
+
+// pendingCatalogEntryForReview returns the pending catalog entry of the request and the review in its body, if the
+// entry hasn't been reviewed yet.
+func pendingCatalogEntryForReview(req api.Context) (v1.PendingCatalogEntry, types.PendingCatalogEntryReview, error) {
+	var review types.PendingCatalogEntryReview
+	if req.ContentLength != 0 {
+		if err := req.Read(&review); err != nil {
+			return v1.PendingCatalogEntry{}, review, types.NewErrBadRequest("failed to read review: %v", err)
+		}
+	}
+
+	pending, err := getPendingCatalogEntry(req)
+	if err != nil {
+		return pending, review, err
+	}
+	if pending.Status.State != "" {
+		return pending, review, types.NewErrBadRequest("pending catalog entry was already %s", pending.Status.State)
+	}
+
+	return pending, review, nil
+}
+
+// reviewPendingCatalogEntry records that the user approved or rejected a pending catalog entry, with the message of
+// the review as a comment.
+func reviewPendingCatalogEntry(req api.Context, pending *v1.PendingCatalogEntry, state types.PendingCatalogEntryState, review types.PendingCatalogEntryReview) error {
+	pending.Status.State = state
+	pending.Status.ReviewerID = req.User.GetUID()
+	pending.Status.ReviewedAt = metav1.Now()
+	if strings.TrimSpace(review.Message) != "" {
+		addPendingCatalogEntryComment(pending, req.User.GetUID(), review.Message)
+	}
+
+	if err := req.Storage.Status().Update(req.Context(), pending); err != nil {
+		return fmt.Errorf("failed to record review of pending catalog entry: %w", err)
+	}
+	// Reviewed entries aren't tested in the sandbox anymore.
+	return deleteSubmissionSandboxConfig(req, *pending)
+}
+
+func addPendingCatalogEntryComment(pending *v1.PendingCatalogEntry, userID, message string) {
+	pending.Status.Comments = append(pending.Status.Comments, types.PendingCatalogEntryComment{
+		UserID:  userID,
+		Message: message,
+		Created: *types.NewTime(time.Now()),
+	})
+}
+
+func convertPendingCatalogEntry(pending v1.PendingCatalogEntry) types.PendingCatalogEntry {
+	result := types.PendingCatalogEntry{
+		Metadata:                    MetadataFrom(&pending),
+		PendingCatalogEntryManifest: pending.Spec.Manifest,
+		UserID:                      pending.Spec.UserID,
+		State:                       pending.Status.State,
+		Comments:                    pending.Status.Comments,
+		ReviewerID:                  pending.Status.ReviewerID,
+		ApprovedCatalogEntryID:      pending.Status.ApprovedCatalogEntryName,
+		Vendor:                      pending.Spec.Vendor,
+		Checks:                      pending.Status.Checks,
+	}
+	if result.State == "" {
+		result.State = types.PendingCatalogEntryStatePending
+	}
+	if !pending.Status.ReviewedAt.IsZero() {
+		result.ReviewedAt = types.NewTime(pending.Status.ReviewedAt.Time)
+	}
+	return result
+}
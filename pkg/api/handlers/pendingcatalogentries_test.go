@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApprovePendingEntry_AppliesEdit(t *testing.T) {
+	manifest := func(name string) types.MCPServerCatalogEntryManifest {
+		return types.MCPServerCatalogEntryManifest{
+			Name:    name,
+			Runtime: types.RuntimeNPX,
+			NPXConfig: &types.NPXRuntimeConfig{
+				Package: "test-server",
+			},
+		}
+	}
+
+	storage := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithStatusSubresource(&v1.PendingCatalogEntry{}).
+		WithObjects(
+			&v1.MCPCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: system.DefaultCatalog, Namespace: system.DefaultNamespace},
+			},
+			&v1.MCPServerCatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "entry", Namespace: system.DefaultNamespace},
+				Spec: v1.MCPServerCatalogEntrySpec{
+					MCPCatalogName: system.DefaultCatalog,
+					Editable:       true,
+					Manifest:       manifest("before"),
+				},
+			},
+			&v1.PendingCatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "pce1-test", Namespace: system.DefaultNamespace},
+				Spec: v1.PendingCatalogEntrySpec{
+					Manifest: types.PendingCatalogEntryManifest{
+						CatalogEntryID: "entry",
+						Manifest:       manifest("after"),
+					},
+					UserID: "submitter",
+				},
+			},
+		).
+		Build()
+
+	newContext := func(userID string, groups []string, body string) api.Context {
+		req := httptest.NewRequest(http.MethodPost, "/api/pending-catalog-entries/pce1-test/approve", strings.NewReader(body))
+		req.SetPathValue("pending_entry_id", "pce1-test")
+		return api.Context{
+			ResponseWriter: httptest.NewRecorder(),
+			Request:        req,
+			Storage:        storage,
+			User:           &kuser.DefaultInfo{UID: userID, Groups: groups},
+		}
+	}
+
+	handler := &MCPCatalogHandler{}
+
+	// Other users can't see the submission.
+	if err := handler.GetPendingEntry(newContext("other", []string{types.GroupPowerUser}, "")); err == nil {
+		t.Fatal("expected another user to be unable to get the submission")
+	}
+
+	if err := handler.ApprovePendingEntry(newContext("admin", []string{types.GroupAdmin}, `{"message":"looks good"}`)); err != nil {
+		t.Fatalf("ApprovePendingEntry() error = %v", err)
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := storage.Get(context.Background(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: "entry"}, &entry); err != nil {
+		t.Fatalf("failed to get entry: %v", err)
+	}
+	if entry.Spec.Manifest.Name != "after" {
+		t.Errorf("entry name = %q, want %q", entry.Spec.Manifest.Name, "after")
+	}
+
+	var pending v1.PendingCatalogEntry
+	if err := storage.Get(context.Background(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: "pce1-test"}, &pending); err != nil {
+		t.Fatalf("failed to get pending entry: %v", err)
+	}
+	if pending.Status.State != types.PendingCatalogEntryStateApproved || pending.Status.ReviewerID != "admin" || pending.Status.ApprovedCatalogEntryName != "entry" {
+		t.Errorf("unexpected status: %+v", pending.Status)
+	}
+	if len(pending.Status.Comments) != 1 || pending.Status.Comments[0].Message != "looks good" {
+		t.Errorf("unexpected comments: %+v", pending.Status.Comments)
+	}
+
+	// A reviewed submission can't be reviewed again.
+	if err := handler.RejectPendingEntry(newContext("admin", []string{types.GroupAdmin}, "")); err == nil {
+		t.Fatal("expected rejecting an approved submission to fail")
+	}
+}
@@ -14,13 +14,11 @@ import (
 )
 
 type PowerUserWorkspaceHandler struct {
-	serverURL string
 	acrHelper *accesscontrolrule.Helper
 }
 
-func NewPowerUserWorkspaceHandler(serverURL string, acrHelper *accesscontrolrule.Helper) *PowerUserWorkspaceHandler {
+func NewPowerUserWorkspaceHandler(acrHelper *accesscontrolrule.Helper) *PowerUserWorkspaceHandler {
 	return &PowerUserWorkspaceHandler{
-		serverURL: serverURL,
 		acrHelper: acrHelper,
 	}
 }
@@ -140,7 +138,7 @@ func (p *PowerUserWorkspaceHandler) ListAllServers(req api.Context) error {
 			return fmt.Errorf("failed to determine slug: %w", err)
 		}
 
-		servers = append(servers, ConvertMCPServer(server, credMap[server.Name], p.serverURL, slug))
+		servers = append(servers, ConvertMCPServer(server, credMap[server.Name], MCPServerConnectBaseURL(req, server), slug))
 	}
 
 	return req.Write(types.MCPServerList{
@@ -240,7 +238,7 @@ func (p *PowerUserWorkspaceHandler) ListAllServersForAllEntries(req api.Context)
 			return fmt.Errorf("failed to determine slug: %w", err)
 		}
 
-		servers = append(servers, ConvertMCPServer(server, credMap[server.Name], p.serverURL, slug))
+		servers = append(servers, ConvertMCPServer(server, credMap[server.Name], MCPServerConnectBaseURL(req, server), slug))
 	}
 
 	return req.Write(types.MCPServerList{
@@ -334,7 +332,7 @@ func (p *PowerUserWorkspaceHandler) ListAllServerInstances(req api.Context) erro
 			return err
 		}
 
-		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, credEnv, p.serverURL, slug))
+		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, credEnv, ConnectBaseURL(req, instance.Spec.MCPCatalogName), slug))
 	}
 
 	return req.Write(types.MCPServerInstanceList{
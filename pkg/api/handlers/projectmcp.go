@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
@@ -18,25 +19,28 @@ import (
 	"github.com/obot-platform/obot/pkg/projects"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type ProjectMCPHandler struct {
-	mcpSessionManager *mcp.SessionManager
-	mcpOAuthChecker   MCPOAuthChecker
-	acrHelper         *accesscontrolrule.Helper
-	serverURL         string
-	internalServerURL string
+	mcpSessionManager    *mcp.SessionManager
+	mcpOAuthChecker      MCPOAuthChecker
+	acrHelper            *accesscontrolrule.Helper
+	serverURL            string
+	internalServerURL    string
+	defaultToolSelection types.ToolSelectionPolicy
 }
 
-func NewProjectMCPHandler(mcpLoader *mcp.SessionManager, acrHelper *accesscontrolrule.Helper, mcpOAuthChecker MCPOAuthChecker, serverURL, internalServerURL string) *ProjectMCPHandler {
+func NewProjectMCPHandler(mcpLoader *mcp.SessionManager, acrHelper *accesscontrolrule.Helper, mcpOAuthChecker MCPOAuthChecker, serverURL, internalServerURL string, defaultToolSelection types.ToolSelectionPolicy) *ProjectMCPHandler {
 	return &ProjectMCPHandler{
-		mcpSessionManager: mcpLoader,
-		mcpOAuthChecker:   mcpOAuthChecker,
-		acrHelper:         acrHelper,
-		serverURL:         serverURL,
-		internalServerURL: internalServerURL,
+		mcpSessionManager:    mcpLoader,
+		mcpOAuthChecker:      mcpOAuthChecker,
+		acrHelper:            acrHelper,
+		serverURL:            serverURL,
+		internalServerURL:    internalServerURL,
+		defaultToolSelection: defaultToolSelection,
 	}
 }
 
@@ -55,6 +59,9 @@ func convertProjectMCPServer(projectServer *v1.ProjectMCPServer, mcpServer *v1.M
 		NeedsURL:       false,
 		NeedsUpdate:    false,
 		NeedsK8sUpdate: false,
+
+		// Shared servers are configured by the admin, so only the blockers that the controller checks apply.
+		ConnectBlockers: slices.Clone(mcpServer.Status.ConnectBlockers),
 	}
 	pmcp.Alias = mcpServer.Spec.Alias
 
@@ -69,7 +76,9 @@ func convertProjectMCPServer(projectServer *v1.ProjectMCPServer, mcpServer *v1.M
 		pmcp.NeedsUpdate = convertedServer.NeedsUpdate
 		pmcp.NeedsK8sUpdate = convertedServer.NeedsK8sUpdate
 		pmcp.MissingOAuthCredentials = convertedServer.MissingOAuthCredentials
+		pmcp.ConnectBlockers = convertedServer.ConnectBlockers
 	}
+	pmcp.ReadyToConnect = len(pmcp.ConnectBlockers) == 0
 
 	return pmcp
 }
@@ -219,6 +228,11 @@ func (p *ProjectMCPHandler) CreateServer(req api.Context) error {
 		}
 	}
 
+	// A workspace server can only reach the files of the project that it is bound to.
+	if mcp.IsWorkspaceServer(mcpServer.Spec.MCPServerCatalogEntryName) && mcpServer.Status.WorkspaceProjectName != "" && mcpServer.Status.WorkspaceProjectName != t.Name {
+		return types.NewErrBadRequest("workspace MCP server %s is bound to another project, create a new server for this project", mcpServer.Name)
+	}
+
 	var cred map[string]string
 	if mcpServer.Spec.MCPCatalogID == "" {
 		gptscriptCred, err := req.GPTClient.RevealCredential(req.Context(), []string{fmt.Sprintf("%s-%s", mcpServer.Spec.UserID, mcpServer.Name)}, mcpServer.Name)
@@ -233,6 +247,10 @@ func (p *ProjectMCPHandler) CreateServer(req api.Context) error {
 		return err
 	}
 
+	if err = p.applyDefaultTools(req, t, projectServer.Name, *mcpServer); err != nil {
+		return err
+	}
+
 	// Gather components for single-user composite servers
 	var components []types.MCPServer
 	if mcpServer.Spec.MCPCatalogID == "" && mcpServer.Spec.Manifest.Runtime == types.RuntimeComposite {
@@ -245,6 +263,79 @@ func (p *ProjectMCPHandler) CreateServer(req api.Context) error {
 	return req.WriteCreated(convertProjectMCPServer(&projectServer, mcpServer, cred, components...))
 }
 
+// applyDefaultTools enables the default tools of the MCP server in the project, unless tools have already been selected
+// for the project server. The tools of the configuration preset the MCP server was created with take precedence, and
+// otherwise the tool selection policy of the server's catalog, or the server-wide policy if the catalog has none, applies.
+func (p *ProjectMCPHandler) applyDefaultTools(req api.Context, thread *v1.Thread, projectServerName string, mcpServer v1.MCPServer) error {
+	if _, ok := thread.Spec.Manifest.AllowedMCPTools[projectServerName]; ok {
+		return nil
+	}
+
+	var entry *v1.MCPServerCatalogEntry
+	if mcpServer.Spec.MCPServerCatalogEntryName != "" {
+		var e v1.MCPServerCatalogEntry
+		if err := req.Get(&e, mcpServer.Spec.MCPServerCatalogEntryName); err == nil {
+			entry = &e
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	catalogName := mcpServer.Spec.MCPCatalogID
+	if catalogName == "" && entry != nil {
+		catalogName = entry.Spec.MCPCatalogName
+	}
+
+	policy := p.defaultToolSelection
+	if catalogName != "" {
+		var catalog v1.MCPCatalog
+		if err := req.Get(&catalog, catalogName); err == nil {
+			if catalog.Spec.DefaultToolSelection != "" {
+				policy = catalog.Spec.DefaultToolSelection
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	tools := defaultTools(policy, entry, mcpServer.Spec.ConfigurationPreset)
+	if tools == nil {
+		return nil
+	}
+
+	if thread.Spec.Manifest.AllowedMCPTools == nil {
+		thread.Spec.Manifest.AllowedMCPTools = make(map[string][]string)
+	}
+	thread.Spec.Manifest.AllowedMCPTools[projectServerName] = tools
+
+	if err := req.Update(thread); err != nil {
+		return fmt.Errorf("failed to update thread with default tools: %w", err)
+	}
+
+	return nil
+}
+
+// defaultTools returns the tools to enable when a server created from the catalog entry is added to a project,
+// or nil if all tools should be enabled. An empty, non-nil slice enables no tools.
+func defaultTools(policy types.ToolSelectionPolicy, entry *v1.MCPServerCatalogEntry, presetName string) []string {
+	if entry != nil && presetName != "" {
+		if preset, ok := configurationPreset(entry.Spec.Manifest, presetName); ok && len(preset.Tools) > 0 {
+			return slices.Clone(preset.Tools)
+		}
+	}
+
+	switch policy {
+	case types.ToolSelectionPolicyDenyAll:
+		return []string{}
+	case types.ToolSelectionPolicyCatalogDefault:
+		if entry != nil && len(entry.Spec.Manifest.DefaultTools) > 0 {
+			return slices.Clone(entry.Spec.Manifest.DefaultTools)
+		}
+	}
+
+	return nil
+}
+
 func (p *ProjectMCPHandler) GetServer(req api.Context) error {
 	var projectServer v1.ProjectMCPServer
 	if err := req.Get(&projectServer, req.PathValue("project_mcp_server_id")); err != nil {
@@ -392,7 +483,7 @@ func (p *ProjectMCPHandler) GetOAuthURL(req api.Context) error {
 		return fmt.Errorf("failed to get OAuth URL: %w", err)
 	}
 
-	return req.Write(map[string]string{"oauthURL": u})
+	return req.Write(map[string]string{"oauthURL": oauthConsentURL(p.serverURL, u)})
 }
 
 func (p *ProjectMCPHandler) GetTools(req api.Context) error {
@@ -566,6 +657,8 @@ func (p *ProjectMCPHandler) SetTools(req api.Context) error {
 		thread.Spec.Manifest.AllowedMCPTools[projectServer.Name] = tools
 	}
 
+	projects.RecordToolApproval(thread, projectServer.Name, req.User.GetUID(), time.Now())
+
 	if err = req.Update(thread); err != nil {
 		return fmt.Errorf("failed to update thread: %w", err)
 	}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+func TestDefaultTools(t *testing.T) {
+	entry := &v1.MCPServerCatalogEntry{
+		Spec: v1.MCPServerCatalogEntrySpec{
+			Manifest: types.MCPServerCatalogEntryManifest{
+				DefaultTools: []string{"search"},
+				Presets: []types.MCPConfigurationPreset{
+					{Name: "readonly", Tools: []string{"read"}},
+					{Name: "empty"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		policy   types.ToolSelectionPolicy
+		entry    *v1.MCPServerCatalogEntry
+		preset   string
+		expected []string
+	}{
+		{
+			name:   "allow all",
+			policy: types.ToolSelectionPolicyAllowAll,
+			entry:  entry,
+		},
+		{
+			name:     "deny all",
+			policy:   types.ToolSelectionPolicyDenyAll,
+			entry:    entry,
+			expected: []string{},
+		},
+		{
+			name:     "deny all without catalog entry",
+			policy:   types.ToolSelectionPolicyDenyAll,
+			expected: []string{},
+		},
+		{
+			name:     "catalog default",
+			policy:   types.ToolSelectionPolicyCatalogDefault,
+			entry:    entry,
+			expected: []string{"search"},
+		},
+		{
+			name:   "catalog default without default tools",
+			policy: types.ToolSelectionPolicyCatalogDefault,
+			entry:  &v1.MCPServerCatalogEntry{},
+		},
+		{
+			name:     "preset takes precedence",
+			policy:   types.ToolSelectionPolicyDenyAll,
+			entry:    entry,
+			preset:   "readonly",
+			expected: []string{"read"},
+		},
+		{
+			name:     "preset without tools falls back to policy",
+			policy:   types.ToolSelectionPolicyCatalogDefault,
+			entry:    entry,
+			preset:   "empty",
+			expected: []string{"search"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := defaultTools(tt.policy, tt.entry, tt.preset)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("defaultTools() = %#v, want %#v", got, tt.expected)
+			}
+		})
+	}
+}
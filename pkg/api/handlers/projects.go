@@ -150,6 +150,11 @@ func (h *ProjectsHandler) UpdateProject(req api.Context) error {
 
 	project.Tools = thread.Spec.Manifest.Tools
 	project.AllowedMCPTools = thread.Spec.Manifest.AllowedMCPTools
+	for server, mode := range project.MCPToolSchemaModes {
+		if !mode.Valid() {
+			return types.NewErrBadRequest("invalid tool schema mode %q for MCP server %s, must be one of %s, %s, or %s", mode, server, types.MCPToolSchemaModeFull, types.MCPToolSchemaModeSummarized, types.MCPToolSchemaModeLazy)
+		}
+	}
 
 	if !equality.Semantic.DeepEqual(thread.Spec.Manifest, project) {
 		// Make sure that the default model provider and model are also on the models map.
@@ -539,6 +544,7 @@ func convertProject(thread *v1.Thread, parentThread *v1.Thread) types.Project {
 		TemplateUpgradeInProgress:    thread.Status.UpgradeInProgress,
 		TemplatePublicID:             thread.Status.UpgradePublicID,
 		LastUsedTime:                 types.NewTime(thread.Status.LastUsedTime.Time),
+		ToolRecertificationRequired:  thread.Status.ToolRecertificationRequired,
 	}
 
 	if !thread.Status.LastUpgraded.IsZero() {
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/controller/reconcilestats"
+)
+
+type ReconcileStatsHandler struct {
+	tracker *reconcilestats.Tracker
+}
+
+func NewReconcileStatsHandler(tracker *reconcilestats.Tracker) *ReconcileStatsHandler {
+	return &ReconcileStatsHandler{
+		tracker: tracker,
+	}
+}
+
+// Get returns the reconcile statistics for the MCP-related controller handlers.
+// This endpoint is admin/owner-only.
+func (h *ReconcileStatsHandler) Get(req api.Context) error {
+	return req.Write(h.tracker.Stats())
+}
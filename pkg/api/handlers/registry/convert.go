@@ -114,6 +114,13 @@ func ConvertMCPServerToRegistry(
 		serverDetail.Meta.PublisherProvided.GitHub.Readme = fmt.Sprintf("> Note: This server requires configuration and cannot be installed directly from your client. Please visit [Obot](%s) to to configure this server and obtain a connection URL.\n\n%s", serverURL, serverDetail.Meta.PublisherProvided.GitHub.Readme)
 	}
 
+	if len(convertedServer.ConnectBlockers) > 0 {
+		if meta.Obot == nil {
+			meta.Obot = &obottypes.RegistryObotMeta{}
+		}
+		meta.Obot.ConnectBlockers = convertedServer.ConnectBlockers
+	}
+
 	return obottypes.RegistryServerResponse{
 		Server:        serverDetail,
 		Meta:          meta,
@@ -185,27 +192,7 @@ func ConvertMCPServerCatalogEntryToRegistry(
 
 	// Check if the catalog entry requires configuration.
 	// Composite servers always require configuration in the UI before they can be used.
-	requiresConfiguration := manifest.Runtime == obottypes.RuntimeComposite
-
-	// Check for required environment variables
-	if !requiresConfiguration {
-		for _, env := range manifest.Env {
-			if env.Required {
-				requiresConfiguration = true
-				break
-			}
-		}
-	}
-
-	// Check for required headers (for remote runtime)
-	if !requiresConfiguration && manifest.Runtime == obottypes.RuntimeRemote && manifest.RemoteConfig != nil {
-		for _, header := range manifest.RemoteConfig.Headers {
-			if header.Required {
-				requiresConfiguration = true
-				break
-			}
-		}
-	}
+	requiresConfiguration := manifest.RequiresConfiguration()
 
 	// Create metadata
 	meta := obottypes.RegistryMeta{
@@ -88,7 +88,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			continue
 		}
 
-		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credMap[server.Name], h.serverURL, slug, reverseDNS, userID, h.mimeFetcher)
+		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credMap[server.Name], handlers.MCPServerConnectBaseURL(req, server), slug, reverseDNS, userID, h.mimeFetcher)
 		if err != nil {
 			// Skip servers that can't be converted
 			continue
@@ -108,7 +108,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 	}
 
 	for _, entry := range catalogEntries {
-		converted, err := ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, h.serverURL, reverseDNS, h.mimeFetcher)
+		converted, err := ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, handlers.ConnectBaseURL(req, entry.Spec.MCPCatalogName), reverseDNS, h.mimeFetcher)
 		if err != nil {
 			// If conversion fails, just skip the entry
 			continue
@@ -130,7 +130,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			continue
 		}
 
-		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credMap[server.Name], h.serverURL, slug, reverseDNS, userID, h.mimeFetcher)
+		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credMap[server.Name], handlers.MCPServerConnectBaseURL(req, server), slug, reverseDNS, userID, h.mimeFetcher)
 		if err != nil {
 			// If conversion fails, just skip the server
 			continue
@@ -145,7 +145,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 	}
 
 	for _, entry := range workspaceEntries {
-		converted, err := ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, h.serverURL, reverseDNS, h.mimeFetcher)
+		converted, err := ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, handlers.ConnectBaseURL(req, entry.Spec.MCPCatalogName), reverseDNS, h.mimeFetcher)
 		if err != nil {
 			// If conversion fails, just skip the entry
 			continue
@@ -167,7 +167,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			continue
 		}
 
-		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credMap[server.Name], h.serverURL, slug, reverseDNS, userID, h.mimeFetcher)
+		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credMap[server.Name], handlers.MCPServerConnectBaseURL(req, server), slug, reverseDNS, userID, h.mimeFetcher)
 		if err != nil {
 			// If conversion fails, just skip the server
 			continue
@@ -203,7 +203,7 @@ func (h *Handler) collectAccessibleServersNoAuth(req api.Context, reverseDNS str
 			continue
 		}
 
-		converted, err := ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, h.serverURL, reverseDNS, h.mimeFetcher)
+		converted, err := ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, handlers.ConnectBaseURL(req, entry.Spec.MCPCatalogName), reverseDNS, h.mimeFetcher)
 		if err != nil {
 			// If conversion fails, just skip the entry
 			continue
@@ -247,7 +247,7 @@ func (h *Handler) collectAccessibleServersNoAuth(req api.Context, reverseDNS str
 		// Get credentials
 		credEnv, _ := h.getCredentialsForServer(req, server, "", system.DefaultCatalog, "")
 
-		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credEnv, h.serverURL, slug, reverseDNS, "", h.mimeFetcher)
+		converted, err := ConvertMCPServerToRegistry(req.Context(), server, credEnv, handlers.MCPServerConnectBaseURL(req, server), slug, reverseDNS, "", h.mimeFetcher)
 		if err != nil {
 			// If conversion fails, just skip the server
 			continue
@@ -786,7 +786,7 @@ func (h *Handler) findMCPServer(req api.Context, serverName, reverseDNS string)
 		return types.RegistryServerResponse{}, fmt.Errorf("server not found")
 	}
 
-	return ConvertMCPServerToRegistry(req.Context(), server, credEnv, h.serverURL, slug, reverseDNS, req.User.GetUID(), h.mimeFetcher)
+	return ConvertMCPServerToRegistry(req.Context(), server, credEnv, handlers.MCPServerConnectBaseURL(req, server), slug, reverseDNS, req.User.GetUID(), h.mimeFetcher)
 }
 
 // findMCPServerCatalogEntry looks up an MCPServerCatalogEntry and checks ACR permissions
@@ -823,7 +823,7 @@ func (h *Handler) findMCPServerCatalogEntry(req api.Context, entryName string, r
 		return types.RegistryServerResponse{}, fmt.Errorf("catalog entry not found")
 	}
 
-	return ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, h.serverURL, reverseDNS, h.mimeFetcher)
+	return ConvertMCPServerCatalogEntryToRegistry(req.Context(), entry, handlers.ConnectBaseURL(req, entry.Spec.MCPCatalogName), reverseDNS, h.mimeFetcher)
 }
 
 // notFoundError returns a standard 404 error response in the format:
@@ -1,21 +1,30 @@
 package handlers
 
 import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
 	"github.com/obot-platform/obot/pkg/events"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
 	"github.com/obot-platform/obot/pkg/gz"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type RunHandler struct {
 	events *events.Emitter
+	// costWarningUSD is the estimated cost of the MCP tool calls of a run above which its details show a warning.
+	costWarningUSD float64
 }
 
-func NewRunHandler(events *events.Emitter) *RunHandler {
+func NewRunHandler(events *events.Emitter, costWarningUSD float64) *RunHandler {
 	return &RunHandler{
-		events: events,
+		events:         events,
+		costWarningUSD: costWarningUSD,
 	}
 }
 
@@ -132,7 +141,83 @@ func (a *RunHandler) ByID(req api.Context) error {
 		return err
 	}
 
-	return req.Write(convertRun(run))
+	result := convertRun(run)
+	toolCalls, err := runMCPToolCalls(req, run)
+	if err != nil {
+		return err
+	}
+	result.MCPToolCalls = toolCalls
+	for _, toolCall := range toolCalls {
+		result.EstimatedMCPToolCostUSD += toolCall.EstimatedCostUSD
+	}
+	if a.costWarningUSD > 0 && result.EstimatedMCPToolCostUSD > a.costWarningUSD {
+		result.MCPToolCostWarning = fmt.Sprintf("The estimated cost of the MCP tool calls of this run, $%.2f, exceeds the threshold of $%.2f", result.EstimatedMCPToolCostUSD, a.costWarningUSD)
+	}
+
+	return req.Write(result)
+}
+
+// runMCPToolCalls returns the MCP tool calls of a run from the audit logs. The arguments of the calls are only
+// included when the user could read the request bodies of the audit logs themselves.
+func runMCPToolCalls(req api.Context, run v1.Run) ([]types.RunMCPToolCall, error) {
+	var thread v1.Thread
+	if err := req.Get(&thread, run.Spec.ThreadName); apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	logs, _, err := req.GatewayClient.GetMCPAuditLogs(req.Context(), gateway.MCPAuditLogOptions{
+		// Any MCP client can claim a run ID, so only calls made as the run's user belong to the run.
+		UserID:                 []string{thread.Spec.UserID},
+		RunID:                  []string{run.Name},
+		CallType:               []string{"tools/call"},
+		WithRequestAndResponse: true,
+		SortBy:                 "created_at",
+		SortOrder:              "asc",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MCP tool calls of run %s: %w", run.Name, err)
+	}
+	if len(logs) == 0 {
+		return nil, nil
+	}
+
+	var ownServerMCPIDs []string
+	if !req.UserIsAuditor() {
+		if ownServerMCPIDs, err = OwnServerMCPIDs(req); err != nil {
+			return nil, fmt.Errorf("failed to get own server MCPIDs: %w", err)
+		}
+	}
+
+	toolCalls := make([]types.RunMCPToolCall, 0, len(logs))
+	for _, log := range logs {
+		toolCall := types.RunMCPToolCall{
+			AuditLogID:           log.ID,
+			Created:              *types.NewTime(log.CreatedAt),
+			MCPID:                log.MCPID,
+			MCPServerDisplayName: log.MCPServerDisplayName,
+			ToolName:             log.CallIdentifier,
+			ResponseStatus:       log.ResponseStatus,
+			Error:                log.Error,
+			ProcessingTimeMs:     log.ProcessingTimeMs,
+			EstimatedCostUSD:     log.EstimatedCostUSD,
+			EstimatedCredits:     log.EstimatedCredits,
+		}
+		if req.UserIsAuditor() || slices.Contains(ownServerMCPIDs, log.MCPID) {
+			var body struct {
+				Params struct {
+					Arguments json.RawMessage `json:"arguments"`
+				} `json:"params"`
+			}
+			if json.Unmarshal(log.RequestBody, &body) == nil {
+				toolCall.Arguments = body.Params.Arguments
+			}
+		}
+		toolCalls = append(toolCalls, toolCall)
+	}
+
+	return toolCalls, nil
 }
 
 func (a *RunHandler) Delete(req api.Context) error {
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"gorm.io/gorm"
+)
+
+// oauthResetCallType is the call type of the audit log entries recorded when an admin resets a user's OAuth state.
+const oauthResetCallType = "obot/oauth-reset"
+
+// GetOAuthState returns the OAuth state of the user of a server instance so that admins can troubleshoot
+// authentication problems without seeing the tokens.
+func (h *ServerInstancesHandler) GetOAuthState(req api.Context) error {
+	_, instance, err := serverInstanceForServer(req)
+	if err != nil {
+		return err
+	}
+
+	state := types.MCPServerInstanceOAuthState{
+		MCPServerInstanceID: instance.Name,
+		UserID:              instance.Spec.UserID,
+	}
+
+	token, err := req.GatewayClient.GetMCPOAuthToken(req.Context(), instance.Spec.UserID, instance.Name, "")
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get OAuth token: %w", err)
+	} else if err == nil {
+		state.HasToken = true
+		state.URL = token.URL
+		state.Scopes = token.Scopes
+		state.HasRefreshToken = token.RefreshToken != ""
+		if !token.Expiry.IsZero() {
+			state.Expiry = types.NewTime(token.Expiry)
+			state.Expired = token.Expiry.Before(time.Now())
+		}
+	}
+
+	if state.PendingAuthorizations, err = req.GatewayClient.CountMCPOAuthPendingStates(req.Context(), instance.Spec.UserID, instance.Name); err != nil {
+		return fmt.Errorf("failed to count pending OAuth authorizations: %w", err)
+	}
+
+	return req.Write(state)
+}
+
+// ResetOAuthState deletes the OAuth tokens and pending authorizations of the user of a server instance, so that the
+// user has to authenticate again. The reset is recorded in the audit logs of the instance.
+func (h *ServerInstancesHandler) ResetOAuthState(req api.Context) error {
+	server, instance, err := serverInstanceForServer(req)
+	if err != nil {
+		return err
+	}
+
+	if err = req.GatewayClient.DeleteMCPOAuthTokens(req.Context(), instance.Spec.UserID, instance.Name); err != nil {
+		return fmt.Errorf("failed to delete OAuth credentials: %w", err)
+	}
+	if err = req.GatewayClient.DeleteMCPOAuthPendingStates(req.Context(), instance.Spec.UserID, instance.Name); err != nil {
+		return fmt.Errorf("failed to delete pending OAuth authorizations: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]string{"userID": instance.Spec.UserID})
+	if err != nil {
+		return err
+	}
+	req.GatewayClient.LogMCPAuditEntry(gtypes.MCPAuditLog{
+		CreatedAt:                 time.Now(),
+		UserID:                    req.User.GetUID(),
+		MCPID:                     instance.Name,
+		MCPServerDisplayName:      server.Spec.Manifest.Name,
+		MCPServerCatalogEntryName: instance.Spec.MCPServerCatalogEntryName,
+		CallType:                  oauthResetCallType,
+		CallIdentifier:            instance.Spec.UserID,
+		RequestBody:               body,
+		UserAgent:                 req.Request.UserAgent(),
+		ResponseStatus:            http.StatusNoContent,
+		ResponseReceived:          true,
+	})
+	log.Infof("Reset OAuth state of MCP server instance: instance=%s user=%s admin=%s", instance.Name, instance.Spec.UserID, req.User.GetUID())
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// serverInstanceForServer returns the server and the server instance in the path, making sure that the instance
+// belongs to the server.
+func serverInstanceForServer(req api.Context) (v1.MCPServer, v1.MCPServerInstance, error) {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return server, v1.MCPServerInstance{}, err
+	}
+	if server.Spec.MCPCatalogID != req.PathValue("catalog_id") {
+		return server, v1.MCPServerInstance{}, types.NewErrNotFound("MCP server not found")
+	}
+
+	var instance v1.MCPServerInstance
+	if err := req.Get(&instance, req.PathValue("mcp_server_instance_id")); err != nil {
+		return server, instance, err
+	}
+	if instance.Spec.MCPServerName != server.Name {
+		return server, instance, types.NewErrNotFound("MCP server instance not found")
+	}
+
+	return server, instance, nil
+}
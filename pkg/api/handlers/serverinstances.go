@@ -12,6 +12,7 @@ import (
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/accesscontrolrule"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/mcp"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -22,13 +23,11 @@ import (
 
 type ServerInstancesHandler struct {
 	acrHelper *accesscontrolrule.Helper
-	serverURL string
 }
 
-func NewServerInstancesHandler(acrHelper *accesscontrolrule.Helper, serverURL string) *ServerInstancesHandler {
+func NewServerInstancesHandler(acrHelper *accesscontrolrule.Helper) *ServerInstancesHandler {
 	return &ServerInstancesHandler{
 		acrHelper: acrHelper,
-		serverURL: serverURL,
 	}
 }
 
@@ -65,7 +64,7 @@ func (h *ServerInstancesHandler) ListServerInstances(req api.Context) error {
 			return fmt.Errorf("failed to determine slug for instance %s: %w", instance.Name, err)
 		}
 
-		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, cred, h.serverURL, slug))
+		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, cred, ConnectBaseURL(req, instance.Spec.MCPCatalogName), slug))
 	}
 
 	return req.Write(types.MCPServerInstanceList{
@@ -89,7 +88,7 @@ func (h *ServerInstancesHandler) GetServerInstance(req api.Context) error {
 		return err
 	}
 
-	return req.Write(ConvertMCPServerInstance(instance, credEnv, h.serverURL, slug))
+	return req.Write(ConvertMCPServerInstance(instance, credEnv, ConnectBaseURL(req, instance.Spec.MCPCatalogName), slug))
 }
 
 func (h *ServerInstancesHandler) CreateServerInstance(req api.Context) error {
@@ -166,7 +165,7 @@ func (h *ServerInstancesHandler) CreateServerInstance(req api.Context) error {
 		return fmt.Errorf("failed to determine slug: %v", err)
 	}
 
-	return req.WriteCreated(ConvertMCPServerInstance(instance, nil, h.serverURL, slug))
+	return req.WriteCreated(ConvertMCPServerInstance(instance, nil, ConnectBaseURL(req, instance.Spec.MCPCatalogName), slug))
 }
 
 func (h *ServerInstancesHandler) DeleteServerInstance(req api.Context) error {
@@ -224,7 +223,7 @@ func (h *ServerInstancesHandler) ConfigureServerInstance(req api.Context) error
 		return fmt.Errorf("failed to determine slug: %v", err)
 	}
 
-	return req.Write(ConvertMCPServerInstance(mcpServerInstance, envVars, h.serverURL, slug))
+	return req.Write(ConvertMCPServerInstance(mcpServerInstance, envVars, ConnectBaseURL(req, mcpServerInstance.Spec.MCPCatalogName), slug))
 }
 
 func (h *ServerInstancesHandler) DeconfigureServerInstance(req api.Context) error {
@@ -245,7 +244,7 @@ func (h *ServerInstancesHandler) DeconfigureServerInstance(req api.Context) erro
 		return fmt.Errorf("failed to determine slug: %v", err)
 	}
 
-	return req.Write(ConvertMCPServerInstance(mcpServerInstance, nil, h.serverURL, slug))
+	return req.Write(ConvertMCPServerInstance(mcpServerInstance, nil, ConnectBaseURL(req, mcpServerInstance.Spec.MCPCatalogName), slug))
 }
 
 func (h *ServerInstancesHandler) RevealConfig(req api.Context) error {
@@ -261,8 +260,45 @@ func (h *ServerInstancesHandler) RevealConfig(req api.Context) error {
 	return req.Write(credEnv)
 }
 
+// SetToolAllowlist restricts the tools that the owner of an instance can list and call through it. The allowlist can
+// only narrow the tools that the catalog tool policy allows.
+func (h *ServerInstancesHandler) SetToolAllowlist(req api.Context) error {
+	var allowlist types.MCPServerInstanceToolAllowlist
+	if err := req.Read(&allowlist); err != nil {
+		return types.NewErrBadRequest("failed to read tool allowlist: %v", err)
+	}
+	for _, pattern := range allowlist.Allow {
+		if err := mcp.ValidateToolPattern(pattern); err != nil {
+			return types.NewErrBadRequest("invalid tool allowlist: %v", err)
+		}
+	}
+
+	var mcpServerInstance v1.MCPServerInstance
+	if err := req.Get(&mcpServerInstance, req.PathValue("mcp_server_instance_id")); err != nil {
+		return err
+	}
+
+	mcpServerInstance.Spec.ToolAllowlist = allowlist.Allow
+	if err := req.Update(&mcpServerInstance); err != nil {
+		return fmt.Errorf("failed to update MCP server instance: %w", err)
+	}
+
+	credEnv, err := mcpServerInstanceCredEnv(req, mcpServerInstance)
+	if err != nil {
+		return err
+	}
+
+	slug, err := SlugForMCPServerInstance(req.Context(), req.Storage, mcpServerInstance)
+	if err != nil {
+		return fmt.Errorf("failed to determine slug: %v", err)
+	}
+
+	return req.Write(ConvertMCPServerInstance(mcpServerInstance, credEnv, ConnectBaseURL(req, mcpServerInstance.Spec.MCPCatalogName), slug))
+}
+
 func ConvertMCPServerInstance(instance v1.MCPServerInstance, credEnv map[string]string, serverURL, slug string) types.MCPServerInstance {
 	_, _, missingHeaders := mcpServerInstanceHeaders(instance, credEnv)
+	blockers := connectBlockers(len(missingHeaders) > 0, instance.Status.ConnectBlockers)
 
 	return types.MCPServerInstance{
 		Metadata:                MetadataFrom(&instance),
@@ -273,8 +309,12 @@ func ConvertMCPServerInstance(instance v1.MCPServerInstance, credEnv map[string]
 		MCPCatalogID:            instance.Spec.MCPCatalogName,
 		MCPServerCatalogEntryID: instance.Spec.MCPServerCatalogEntryName,
 		PowerUserWorkspaceID:    instance.Spec.PowerUserWorkspaceID,
-		ConnectURL:              fmt.Sprintf("%s/mcp-connect/%s", serverURL, slug),
+		ConnectURL:              system.MCPConnectURL(serverURL, slug),
 		MultiUserConfig:         instance.Spec.MultiUserConfig,
+		ToolAllowlist:           instance.Spec.ToolAllowlist,
+		Conditions:              convertConditions(instance.Status.Conditions),
+		ReadyToConnect:          len(blockers) == 0,
+		ConnectBlockers:         blockers,
 	}
 }
 
@@ -361,7 +401,7 @@ func (h *ServerInstancesHandler) ListServerInstancesForServer(req api.Context) e
 		if err != nil {
 			return err
 		}
-		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, credEnv, h.serverURL, slug))
+		convertedInstances = append(convertedInstances, ConvertMCPServerInstance(instance, credEnv, ConnectBaseURL(req, instance.Spec.MCPCatalogName), slug))
 	}
 
 	return req.Write(types.MCPServerInstanceList{
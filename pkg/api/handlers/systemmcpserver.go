@@ -352,6 +352,11 @@ func (h *SystemMCPServerHandler) Logs(req api.Context) error {
 		return types.NewErrBadRequest("failed to transform system server to config: %v", err)
 	}
 
+	mask, err := mcpLogMask(req, h.mcpSessionManager, serverConfig)
+	if err != nil {
+		return err
+	}
+
 	logs, err := h.mcpSessionManager.StreamServerLogs(req.Context(), serverConfig)
 	if err != nil {
 		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
@@ -365,6 +370,7 @@ func (h *SystemMCPServerHandler) Logs(req api.Context) error {
 		SendKeepAlive:  true,
 		SendDisconnect: true,
 		SendEnded:      true,
+		Mask:           mask,
 	})
 }
 
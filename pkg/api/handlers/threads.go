@@ -202,6 +202,8 @@ func (a *ThreadHandler) Update(req api.Context) error {
 	newThread.Tools = existing.Spec.Manifest.Tools
 	// Don't allow update of allowed MCP tools here, do it with the mcpservers/{mcp_server_id}/tools endpoint
 	newThread.AllowedMCPTools = existing.Spec.Manifest.AllowedMCPTools
+	// Tool schema modes are set per project.
+	newThread.MCPToolSchemaModes = existing.Spec.Manifest.MCPToolSchemaModes
 
 	existing.Spec.Manifest = newThread
 	if err := req.Update(&existing); err != nil {
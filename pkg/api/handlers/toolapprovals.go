@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/projects"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type ToolApprovalHandler struct {
+	expiration, warning time.Duration
+}
+
+func NewToolApprovalHandler(expiration, warning time.Duration) *ToolApprovalHandler {
+	return &ToolApprovalHandler{
+		expiration: expiration,
+		warning:    warning,
+	}
+}
+
+// List returns the tool approvals of the user's projects. Admins can list the approvals of all projects.
+func (h *ToolApprovalHandler) List(req api.Context) error {
+	var opts []kclient.ListOption
+	if !req.UserIsAdmin() || req.URL.Query().Get("all") != "true" {
+		opts = append(opts, kclient.MatchingFields{
+			"spec.userUID": req.User.GetUID(),
+		})
+	}
+
+	var threads v1.ThreadList
+	if err := req.List(&threads, opts...); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	items := make([]types.ToolApproval, 0, len(threads.Items))
+	for _, thread := range threads.Items {
+		if !thread.Spec.Project || thread.Spec.Template || !thread.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(thread.Spec.ToolApprovals)) {
+			items = append(items, h.convertToolApproval(&thread, name, now))
+		}
+	}
+
+	return req.Write(types.ToolApprovalList{Items: items})
+}
+
+// Recertify re-approves the tools selected for the given project MCP servers, restoring any tools that were revoked
+// because their approval expired. Only the owner of a project or an admin can re-certify its tools.
+func (h *ToolApprovalHandler) Recertify(req api.Context) error {
+	var input types.ToolRecertificationRequest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if len(input.Approvals) == 0 {
+		return types.NewErrBadRequest("at least one approval is required")
+	}
+
+	serversByProject := make(map[string][]string, len(input.Approvals))
+	for _, ref := range input.Approvals {
+		if ref.ProjectID == "" || ref.ProjectMCPServerID == "" {
+			return types.NewErrBadRequest("projectID and projectMCPServerID are required")
+		}
+		serversByProject[ref.ProjectID] = append(serversByProject[ref.ProjectID], ref.ProjectMCPServerID)
+	}
+
+	var (
+		now   = time.Now()
+		items = make([]types.ToolApproval, 0, len(input.Approvals))
+	)
+	for _, projectID := range slices.Sorted(maps.Keys(serversByProject)) {
+		var thread v1.Thread
+		if err := req.Get(&thread, strings.Replace(projectID, system.ProjectPrefix, system.ThreadPrefix, 1)); err != nil {
+			return err
+		}
+
+		if !thread.Spec.Project || thread.Spec.Template {
+			return types.NewErrNotFound("project %s not found", projectID)
+		}
+		if !req.UserIsAdmin() && thread.Spec.UserID != req.User.GetUID() {
+			return types.NewErrForbidden("only the project owner or an admin can re-certify the tools of project %s", projectID)
+		}
+
+		names := serversByProject[projectID]
+		for _, name := range names {
+			if _, ok := thread.Spec.ToolApprovals[name]; !ok {
+				return types.NewErrNotFound("no tool approval for MCP server %s in project %s", name, projectID)
+			}
+			projects.RecertifyToolApproval(&thread, name, req.User.GetUID(), now)
+		}
+
+		if err := req.Update(&thread); err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			items = append(items, h.convertToolApproval(&thread, name, now))
+		}
+	}
+
+	return req.Write(types.ToolApprovalList{Items: items})
+}
+
+func (h *ToolApprovalHandler) convertToolApproval(thread *v1.Thread, name string, now time.Time) types.ToolApproval {
+	approval := thread.Spec.ToolApprovals[name]
+	result := types.ToolApproval{
+		ProjectID:          strings.Replace(thread.Name, system.ThreadPrefix, system.ProjectPrefix, 1),
+		ProjectMCPServerID: name,
+		ApprovedBy:         approval.ApprovedBy,
+		Status:             projects.ToolApprovalStatus(approval, h.expiration, h.warning, now),
+	}
+
+	if !approval.ApprovedAt.IsZero() {
+		result.ApprovedAt = types.NewTime(approval.ApprovedAt.Time)
+		if h.expiration > 0 {
+			result.ExpiresAt = types.NewTime(approval.ApprovedAt.Add(h.expiration))
+		}
+	}
+
+	return result
+}
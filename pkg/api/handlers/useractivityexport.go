@@ -0,0 +1,425 @@
+package handlers
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maskedValue replaces the values of sensitive configuration in exports.
+	maskedValue = "********"
+
+	// userActivityExportBatchSize is the number of audit logs read from the database at a time while they are written
+	// to an export.
+	userActivityExportBatchSize = 500
+)
+
+type UserActivityExportHandler struct{}
+
+func NewUserActivityExportHandler() *UserActivityExportHandler {
+	return &UserActivityExportHandler{}
+}
+
+// ExportUserActivity handles GET /api/users/{user_id}/activity-export. It writes a zip archive of the MCP audit logs,
+// sessions, server configurations and OAuth consent history of a user, for legal holds and subject access requests.
+// The optional start_time and end_time query parameters limit the activity to a range.
+func (*UserActivityExportHandler) ExportUserActivity(req api.Context) error {
+	userID := req.PathValue("user_id")
+
+	query := req.URL.Query()
+	var start, end time.Time
+	if v := query.Get("start_time"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return types.NewErrBadRequest("invalid start_time: %v", err)
+		}
+		start = t
+	}
+	end = time.Now()
+	if v := query.Get("end_time"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return types.NewErrBadRequest("invalid end_time: %v", err)
+		}
+		end = t
+	}
+	if !start.Before(end) {
+		return types.NewErrBadRequest("start_time must be before end_time")
+	}
+
+	// Deleted users are included, because their activity may be subject to a legal hold.
+	user, err := req.GatewayClient.UserByIDIncludeDeleted(req.Context(), userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.NewErrNotFound("user %s not found", userID)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Everything except the audit logs is gathered before the archive is started, so that errors can still be
+	// returned to the client.
+	servers, err := userActivityExportServers(req, userID)
+	if err != nil {
+		return err
+	}
+	instances, err := userActivityExportServerInstances(req, userID)
+	if err != nil {
+		return err
+	}
+	sessions, err := userActivityExportSessions(req, userID, start, end)
+	if err != nil {
+		return err
+	}
+	oauth, err := userActivityExportOAuth(req, user, start, end)
+	if err != nil {
+		return err
+	}
+
+	manifest := types.UserActivityExportManifest{
+		UserID:                 userID,
+		StartTime:              *types.NewTime(start),
+		EndTime:                *types.NewTime(end),
+		GeneratedAt:            *types.NewTime(time.Now()),
+		GeneratedBy:            req.User.GetUID(),
+		WithRequestAndResponse: req.UserIsAuditor(),
+		Files: map[string]int{
+			"user.json":                 1,
+			"mcp-servers.json":          len(servers),
+			"mcp-server-instances.json": len(instances),
+			"mcp-sessions.json":         len(sessions),
+			"oauth.json":                len(oauth.Grants) + len(oauth.Authorizations) + len(oauth.Tokens) + len(oauth.Activity),
+		},
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "application/zip")
+	req.ResponseWriter.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q",
+		fmt.Sprintf("user-%s-activity-%s.zip", userID, time.Now().UTC().Format("20060102T150405Z"))))
+
+	writer := zip.NewWriter(req.ResponseWriter)
+	for name, content := range map[string]any{
+		"user.json":                 gatewaytypes.ConvertUser(user, false, ""),
+		"mcp-servers.json":          servers,
+		"mcp-server-instances.json": instances,
+		"mcp-sessions.json":         sessions,
+		"oauth.json":                oauth,
+	} {
+		if err := writeUserActivityExportFile(writer, name, content); err != nil {
+			return err
+		}
+	}
+
+	count, err := writeUserActivityExportAuditLogs(req, writer, gateway.MCPAuditLogOptions{
+		UserID:                 []string{userID},
+		StartTime:              start,
+		EndTime:                end,
+		WithRequestAndResponse: manifest.WithRequestAndResponse,
+	})
+	if err != nil {
+		return err
+	}
+	manifest.Files["mcp-audit-logs.jsonl"] = count
+
+	if err := writeUserActivityExportFile(writer, "manifest.json", manifest); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+func writeUserActivityExportFile(writer *zip.Writer, name string, content any) error {
+	w, err := writer.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to export: %w", name, err)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(content)
+}
+
+// writeUserActivityExportAuditLogs writes the audit logs to the archive one per line, oldest first, and returns how
+// many were written. They are read in batches so that the whole history of a user is never held in memory.
+func writeUserActivityExportAuditLogs(req api.Context, writer *zip.Writer, opts gateway.MCPAuditLogOptions) (int, error) {
+	w, err := writer.Create("mcp-audit-logs.jsonl")
+	if err != nil {
+		return 0, fmt.Errorf("failed to add audit logs to export: %w", err)
+	}
+
+	opts.Limit = userActivityExportBatchSize
+	opts.SortBy, opts.SortOrder = "created_at", "asc"
+
+	enc := json.NewEncoder(w)
+	var count int
+	for {
+		opts.Offset = count
+		logs, _, err := req.GatewayClient.GetMCPAuditLogs(req.Context(), opts)
+		if err != nil {
+			return count, fmt.Errorf("failed to get audit logs: %w", err)
+		}
+		for _, log := range logs {
+			if err := enc.Encode(gatewaytypes.ConvertMCPAuditLog(log)); err != nil {
+				return count, err
+			}
+		}
+		count += len(logs)
+		if len(logs) < opts.Limit {
+			return count, nil
+		}
+	}
+}
+
+// userActivityExportServers returns the MCP servers that a user created, with the values of their sensitive
+// configuration masked.
+func userActivityExportServers(req api.Context, userID string) ([]types.UserActivityExportServer, error) {
+	var list v1.MCPServerList
+	if err := req.List(&list, kclient.InNamespace(system.DefaultNamespace), &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.userID", userID),
+	}); err != nil {
+		return nil, err
+	}
+
+	servers := make([]types.UserActivityExportServer, 0, len(list.Items))
+	for _, server := range list.Items {
+		credCtx := fmt.Sprintf("%s-%s", userID, server.Name)
+		if server.Spec.MCPCatalogID != "" {
+			credCtx = fmt.Sprintf("%s-%s", server.Spec.MCPCatalogID, server.Name)
+		} else if server.Spec.PowerUserWorkspaceID != "" {
+			credCtx = fmt.Sprintf("%s-%s", server.Spec.PowerUserWorkspaceID, server.Name)
+		}
+		credEnv, err := userActivityExportCredEnv(req, credCtx, server.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		slug, err := SlugForMCPServer(req.Context(), req.Storage, server, userID, server.Spec.MCPCatalogID, server.Spec.PowerUserWorkspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate slug: %w", err)
+		}
+
+		converted := ConvertMCPServer(server, credEnv, MCPServerConnectBaseURL(req, server), slug)
+		converted.MCPServerManifest = maskManifestHeaders(converted.MCPServerManifest)
+		servers = append(servers, types.UserActivityExportServer{
+			Server:        converted,
+			Configuration: maskServerConfiguration(server.Spec.Manifest, credEnv),
+		})
+	}
+	return servers, nil
+}
+
+// userActivityExportServerInstances returns the connections of a user to multi-user MCP servers. The headers that
+// users configure for them may contain secrets, so their values are always masked.
+func userActivityExportServerInstances(req api.Context, userID string) ([]types.UserActivityExportServerInstance, error) {
+	var list v1.MCPServerInstanceList
+	if err := req.List(&list, kclient.InNamespace(system.DefaultNamespace), &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.userID", userID),
+	}); err != nil {
+		return nil, err
+	}
+
+	instances := make([]types.UserActivityExportServerInstance, 0, len(list.Items))
+	for _, instance := range list.Items {
+		credEnv, err := mcpServerInstanceCredEnv(req, instance)
+		if err != nil {
+			return nil, err
+		}
+
+		slug, err := SlugForMCPServerInstance(req.Context(), req.Storage, instance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate slug: %w", err)
+		}
+
+		var configuration map[string]string
+		for key := range credEnv {
+			if configuration == nil {
+				configuration = make(map[string]string, len(credEnv))
+			}
+			configuration[key] = maskedValue
+		}
+
+		instances = append(instances, types.UserActivityExportServerInstance{
+			Instance:      ConvertMCPServerInstance(instance, credEnv, ConnectBaseURL(req, instance.Spec.MCPCatalogName), slug),
+			Configuration: configuration,
+		})
+	}
+	return instances, nil
+}
+
+func userActivityExportCredEnv(req api.Context, credCtx, name string) (map[string]string, error) {
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{credCtx}, name)
+	if err != nil {
+		if errors.As(err, &gptscript.ErrNotFound{}) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find credential: %w", err)
+	}
+	return cred.Env, nil
+}
+
+// maskServerConfiguration returns the configuration of a server with the values of sensitive environment variables
+// and headers masked. Values that the manifest doesn't describe are masked too, since it isn't known whether they
+// are sensitive.
+func maskServerConfiguration(manifest types.MCPServerManifest, credEnv map[string]string) map[string]string {
+	if len(credEnv) == 0 {
+		return nil
+	}
+
+	sensitive := make(map[string]bool, len(manifest.Env))
+	for _, env := range manifest.Env {
+		sensitive[env.Key] = env.Sensitive
+	}
+	if manifest.RemoteConfig != nil {
+		for _, header := range manifest.RemoteConfig.Headers {
+			sensitive[header.Key] = header.Sensitive
+		}
+	}
+
+	configuration := make(map[string]string, len(credEnv))
+	for key, value := range credEnv {
+		if s, ok := sensitive[key]; !ok || s {
+			value = maskedValue
+		}
+		configuration[key] = value
+	}
+	return configuration
+}
+
+// maskManifestHeaders masks the values of the sensitive static headers of a manifest.
+func maskManifestHeaders(manifest types.MCPServerManifest) types.MCPServerManifest {
+	if manifest.RemoteConfig == nil {
+		return manifest
+	}
+
+	remoteConfig := *manifest.RemoteConfig
+	remoteConfig.Headers = slices.Clone(remoteConfig.Headers)
+	for i, header := range remoteConfig.Headers {
+		if header.Sensitive && header.Value != "" {
+			remoteConfig.Headers[i].Value = maskedValue
+		}
+	}
+	manifest.RemoteConfig = &remoteConfig
+	return manifest
+}
+
+// userActivityExportSessions returns the sessions that a user had with MCP servers that were used in the range.
+func userActivityExportSessions(req api.Context, userID string, start, end time.Time) ([]types.UserActivityExportSession, error) {
+	var list v1.MCPSessionList
+	if err := req.List(&list, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]types.UserActivityExportSession, 0)
+	for _, session := range list.Items {
+		lastUsed := session.Status.LastUsedTime.Time
+		if lastUsed.IsZero() {
+			lastUsed = session.CreationTimestamp.Time
+		}
+		if session.Spec.UserID != userID || lastUsed.Before(start) || !session.CreationTimestamp.Time.Before(end) {
+			continue
+		}
+
+		var lastUsedTime *types.Time
+		if !session.Status.LastUsedTime.IsZero() {
+			lastUsedTime = types.NewTime(session.Status.LastUsedTime.Time)
+		}
+		sessions = append(sessions, types.UserActivityExportSession{
+			ID:           session.Name,
+			MCPID:        session.Spec.MCPID,
+			Created:      *types.NewTime(session.CreationTimestamp.Time),
+			LastUsedTime: lastUsedTime,
+		})
+	}
+
+	slices.SortFunc(sessions, func(a, b types.UserActivityExportSession) int {
+		return a.Created.Time.Compare(b.Created.Time)
+	})
+	return sessions, nil
+}
+
+// userActivityExportOAuth returns the OAuth consent history of a user. Grants and stored tokens are exported as they
+// are now, and authorizations and OAuth activity only from the range.
+func userActivityExportOAuth(req api.Context, user *gatewaytypes.User, start, end time.Time) (types.UserActivityExportOAuth, error) {
+	result := types.UserActivityExportOAuth{
+		Grants:         make([]types.UserActivityExportOAuthGrant, 0),
+		Authorizations: make([]types.UserActivityExportOAuthGrant, 0),
+		Activity:       make([]types.MCPOAuthTelemetry, 0),
+	}
+
+	var tokens v1.OAuthTokenList
+	if err := req.List(&tokens, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		return result, err
+	}
+	for _, token := range tokens.Items {
+		if token.Spec.UserID != user.ID {
+			continue
+		}
+		result.Grants = append(result.Grants, types.UserActivityExportOAuthGrant{
+			ClientID: token.Spec.ClientID,
+			MCPID:    token.Spec.MCPID,
+			Scope:    token.Spec.Scope,
+			Resource: token.Spec.Resource,
+			Created:  *types.NewTime(token.CreationTimestamp.Time),
+		})
+	}
+
+	var requests v1.OAuthAuthRequestList
+	if err := req.List(&requests, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		return result, err
+	}
+	for _, request := range requests.Items {
+		created := request.CreationTimestamp.Time
+		if request.Spec.UserID != user.ID || created.Before(start) || !created.Before(end) {
+			continue
+		}
+		result.Authorizations = append(result.Authorizations, types.UserActivityExportOAuthGrant{
+			ClientID:  request.Spec.ClientID,
+			MCPID:     request.Spec.MCPID,
+			Scope:     request.Spec.Scope,
+			Resource:  request.Spec.Resource,
+			Created:   *types.NewTime(created),
+			Completed: &request.Status.Ok,
+		})
+	}
+
+	for _, grants := range [][]types.UserActivityExportOAuthGrant{result.Grants, result.Authorizations} {
+		slices.SortFunc(grants, func(a, b types.UserActivityExportOAuthGrant) int {
+			return a.Created.Time.Compare(b.Created.Time)
+		})
+	}
+
+	userID := fmt.Sprint(user.ID)
+	health, err := req.GatewayClient.GetMCPOAuthTokenHealth(req.Context(), userID)
+	if err != nil {
+		return result, err
+	}
+	result.Tokens = append(make([]types.MCPOAuthTokenHealth, 0, len(health)), health...)
+
+	// OAuth activity is counted by day, so every day that the range touches is included.
+	activities, failures, err := req.GatewayClient.GetMCPOAuthTelemetry(req.Context(), gateway.MCPUsageOptions{
+		UserID: userID,
+		Start:  start.UTC().Truncate(24 * time.Hour),
+		End:    end.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour),
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, activity := range activities {
+		telemetry := gatewaytypes.ConvertMCPOAuthActivity(activity, failures[activity.MCPID])
+		telemetry.UserID = userID
+		result.Activity = append(result.Activity, telemetry)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func TestMaskServerConfiguration(t *testing.T) {
+	manifest := types.MCPServerManifest{
+		Env: []types.MCPEnv{
+			{MCPHeader: types.MCPHeader{Key: "API_KEY", Sensitive: true}},
+			{MCPHeader: types.MCPHeader{Key: "REGION"}},
+		},
+		RemoteConfig: &types.RemoteRuntimeConfig{
+			Headers: []types.MCPHeader{
+				{Key: "Authorization", Sensitive: true},
+				{Key: "X-Team"},
+			},
+		},
+	}
+
+	got := maskServerConfiguration(manifest, map[string]string{
+		"API_KEY":       "secret",
+		"REGION":        "us-east-1",
+		"Authorization": "Bearer token",
+		"X-Team":        "platform",
+		"UNKNOWN":       "value",
+	})
+	want := map[string]string{
+		"API_KEY":       maskedValue,
+		"REGION":        "us-east-1",
+		"Authorization": maskedValue,
+		"X-Team":        "platform",
+		"UNKNOWN":       maskedValue,
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := maskServerConfiguration(manifest, nil); got != nil {
+		t.Errorf("expected no configuration, got %v", got)
+	}
+}
+
+func TestMaskManifestHeaders(t *testing.T) {
+	manifest := types.MCPServerManifest{
+		RemoteConfig: &types.RemoteRuntimeConfig{
+			Headers: []types.MCPHeader{
+				{Key: "Authorization", Value: "Bearer token", Sensitive: true},
+				{Key: "X-Team", Value: "platform"},
+			},
+		},
+	}
+
+	masked := maskManifestHeaders(manifest)
+	if v := masked.RemoteConfig.Headers[0].Value; v != maskedValue {
+		t.Errorf("expected the sensitive header to be masked, got %q", v)
+	}
+	if v := masked.RemoteConfig.Headers[1].Value; v != "platform" {
+		t.Errorf("expected the header to be kept, got %q", v)
+	}
+	if v := manifest.RemoteConfig.Headers[0].Value; v != "Bearer token" {
+		t.Errorf("expected the original manifest to be unchanged, got %q", v)
+	}
+}
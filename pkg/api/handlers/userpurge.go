@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"gorm.io/gorm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type UserPurgeHandler struct{}
+
+func NewUserPurgeHandler() *UserPurgeHandler {
+	return &UserPurgeHandler{}
+}
+
+// PurgeUser handles POST /api/users/{user_id}/purge. It removes or anonymizes the personal data of a user across the
+// database, storage and credential store, for right-to-be-forgotten requests, and deletes the user first if they
+// weren't already. The request has to confirm the purge with the user's email address.
+//
+// Some of the user's objects are deleted in the background after the user is deleted, so the report says what is
+// still pending, and the purge should be repeated until it is complete.
+func (*UserPurgeHandler) PurgeUser(req api.Context) error {
+	userID := req.PathValue("user_id")
+	if userID == req.User.GetUID() {
+		return types.NewErrBadRequest("users can't purge themselves")
+	}
+
+	user, err := req.GatewayClient.UserByIDIncludeDeleted(req.Context(), userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.NewErrNotFound("user %s not found", userID)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var purgeRequest types.UserPurgeRequest
+	if err := req.Read(&purgeRequest); err != nil {
+		return types.NewErrBadRequest("failed to read purge request: %v", err)
+	}
+	if confirmation := userPurgeConfirmation(user); purgeRequest.Confirm == "" || !strings.EqualFold(purgeRequest.Confirm, confirmation) {
+		return types.NewErrBadRequest("the purge must be confirmed with the user's email address")
+	}
+
+	if !req.UserIsOwner() && (user.Role.HasRole(types.RoleOwner) || user.Role.HasRole(types.RoleAuditor) || user.Role.HasRole(types.RoleUserImpersonation)) {
+		return types.NewErrForbidden("only owners can purge owners, auditors and users with the user impersonation role")
+	}
+
+	if user.DeletedAt == nil {
+		if _, err := req.GatewayClient.DeleteUser(req.Context(), userID); err != nil {
+			if lae := (*gateway.LastAdminError)(nil); errors.As(err, &lae) {
+				return types.NewErrBadRequest("failed to delete user: %v", err)
+			} else if loe := (*gateway.LastOwnerError)(nil); errors.As(err, &loe) {
+				return types.NewErrBadRequest("failed to delete user: %v", err)
+			}
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+
+		if err := req.Create(&v1.UserDelete{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: system.UserDeletePrefix,
+				Namespace:    req.Namespace(),
+			},
+			Spec: v1.UserDeleteSpec{
+				UserID: user.ID,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to start deletion of user owned objects: %w", err)
+		}
+	}
+
+	report := types.UserPurgeReport{
+		UserID:   userID,
+		PurgedAt: *types.NewTime(time.Now()),
+		PurgedBy: req.User.GetUID(),
+	}
+
+	storageDeleted, err := purgeUserStorage(req, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if report.Pending, err = pendingUserStorage(req, user.ID); err != nil {
+		return err
+	}
+
+	// The credentials of some of the user's objects are needed to delete them, so they are only deleted once the
+	// user's objects are.
+	credentials, err := userCredentials(req, userID)
+	if err != nil {
+		return err
+	}
+	if len(report.Pending) == 0 {
+		for _, cred := range credentials {
+			if err := req.GPTClient.DeleteCredential(req.Context(), cred.Context, cred.ToolName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+				return fmt.Errorf("failed to delete credential: %w", err)
+			}
+		}
+		storageDeleted["credentials"] = len(credentials)
+	} else if len(credentials) > 0 {
+		report.Pending["credentials"] = len(credentials)
+	}
+
+	counts, err := req.GatewayClient.PurgeUser(req.Context(), user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to purge user: %w", err)
+	}
+
+	report.Deleted = counts.Deleted
+	for kind, count := range storageDeleted {
+		report.Deleted[kind] = count
+	}
+	report.Anonymized = counts.Anonymized
+	report.Complete = len(report.Pending) == 0
+
+	log.Infof("Purged user personal data: targetUserID=%s purgedBy=%s complete=%v", userID, report.PurgedBy, report.Complete)
+	return req.Write(report)
+}
+
+// userPurgeConfirmation returns the email address that confirms the purge of a user. Deleted users keep their
+// original email address until they are purged.
+func userPurgeConfirmation(user *gatewaytypes.User) string {
+	if user.OriginalEmail != "" {
+		return user.OriginalEmail
+	}
+	return user.Email
+}
+
+// purgeUserStorage deletes the objects in storage that record a user's MCP sessions and OAuth consents. They aren't
+// deleted when a user is deleted.
+func purgeUserStorage(req api.Context, userID uint) (map[string]int, error) {
+	deleted := make(map[string]int)
+
+	var sessions v1.MCPSessionList
+	if err := req.List(&sessions, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		return nil, err
+	}
+	for _, session := range sessions.Items {
+		if session.Spec.UserID != fmt.Sprint(userID) {
+			continue
+		}
+		if err := kclient.IgnoreNotFound(req.Delete(&session)); err != nil {
+			return nil, fmt.Errorf("failed to delete MCP session %s: %w", session.Name, err)
+		}
+		deleted["mcpSessions"]++
+	}
+
+	var tokens v1.OAuthTokenList
+	if err := req.List(&tokens, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		return nil, err
+	}
+	for _, token := range tokens.Items {
+		if token.Spec.UserID != userID {
+			continue
+		}
+		if err := kclient.IgnoreNotFound(req.Delete(&token)); err != nil {
+			return nil, fmt.Errorf("failed to delete OAuth token %s: %w", token.Name, err)
+		}
+		deleted["oauthGrants"]++
+	}
+
+	var requests v1.OAuthAuthRequestList
+	if err := req.List(&requests, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		return nil, err
+	}
+	for _, request := range requests.Items {
+		if request.Spec.UserID != userID {
+			continue
+		}
+		if err := kclient.IgnoreNotFound(req.Delete(&request)); err != nil {
+			return nil, fmt.Errorf("failed to delete OAuth authorization %s: %w", request.Name, err)
+		}
+		deleted["oauthAuthorizations"]++
+	}
+
+	return deleted, nil
+}
+
+// pendingUserStorage counts the objects of a deleted user that the user cleanup hasn't deleted yet. Servers in the
+// default catalog are shared with other users, so they are kept.
+func pendingUserStorage(req api.Context, userID uint) (map[string]int, error) {
+	pending := make(map[string]int)
+	userSelector := &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.userID", fmt.Sprint(userID)),
+	}
+
+	var userDeletes v1.UserDeleteList
+	if err := req.List(&userDeletes); err != nil {
+		return nil, err
+	}
+	for _, userDelete := range userDeletes.Items {
+		if userDelete.Spec.UserID == userID {
+			pending["userCleanup"]++
+		}
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers, kclient.InNamespace(system.DefaultNamespace), userSelector); err != nil {
+		return nil, err
+	}
+	for _, server := range servers.Items {
+		if server.Spec.MCPCatalogID != system.DefaultCatalog {
+			pending["mcpServers"]++
+		}
+	}
+
+	var instances v1.MCPServerInstanceList
+	if err := req.List(&instances, kclient.InNamespace(system.DefaultNamespace), userSelector); err != nil {
+		return nil, err
+	}
+	if len(instances.Items) > 0 {
+		pending["mcpServerInstances"] = len(instances.Items)
+	}
+
+	var agents v1.NanobotAgentList
+	if err := req.List(&agents, kclient.InNamespace(system.DefaultNamespace), userSelector); err != nil {
+		return nil, err
+	}
+	if len(agents.Items) > 0 {
+		pending["nanobotAgents"] = len(agents.Items)
+	}
+
+	var workspaces v1.PowerUserWorkspaceList
+	if err := req.List(&workspaces, kclient.InNamespace(system.DefaultNamespace), userSelector); err != nil {
+		return nil, err
+	}
+	if len(workspaces.Items) > 0 {
+		pending["powerUserWorkspaces"] = len(workspaces.Items)
+	}
+
+	return pending, nil
+}
+
+// userCredentials returns the credentials of the MCP servers and server instances of a user. Their credential
+// contexts start with the user's ID.
+func userCredentials(req api.Context, userID string) ([]gptscript.Credential, error) {
+	creds, err := req.GPTClient.ListCredentials(req.Context(), gptscript.ListCredentialsOptions{
+		AllContexts: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	var userCreds []gptscript.Credential
+	for _, cred := range creds {
+		if strings.HasPrefix(cred.Context, userID+"-") {
+			userCreds = append(userCreds, cred)
+		}
+	}
+	return userCreds, nil
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"testing"
+
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestUserPurgeConfirmation(t *testing.T) {
+	tests := []struct {
+		name string
+		user gatewaytypes.User
+		want string
+	}{
+		{name: "active user", user: gatewaytypes.User{Email: "jdoe@example.com"}, want: "jdoe@example.com"},
+		{name: "deleted user", user: gatewaytypes.User{Email: "jdoe@example.com_deleted_1700000000", OriginalEmail: "jdoe@example.com"}, want: "jdoe@example.com"},
+		{name: "purged user", user: gatewaytypes.User{Email: "purged-user-7"}, want: "purged-user-7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userPurgeConfirmation(&tt.user); got != tt.want {
+				t.Errorf("userPurgeConfirmation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,6 +13,15 @@ func (h *handler) oauthAuthorization(req api.Context) error {
 	return req.Write(h.config)
 }
 
+// openIDConfiguration handles the /.well-known/openid-configuration endpoint, which only exists when the OAuth server is
+// an OpenID Connect provider.
+func (h *handler) openIDConfiguration(req api.Context) error {
+	if !h.config.OpenIDProvider() {
+		return types.NewErrNotFound("OpenID Connect is not enabled")
+	}
+	return req.Write(h.config)
+}
+
 func (h *handler) oauthProtectedResource(req api.Context) error {
 	mcpID := req.PathValue("mcp_id")
 	if mcpID != "" {
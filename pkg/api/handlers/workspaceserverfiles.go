@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxWorkspaceServerFileBytes is the maximum size of a file that a workspace MCP server can write.
+const maxWorkspaceServerFileBytes = 100 * 1024 * 1024
+
+// WorkspaceServerFilesHandler serves the files of the projects that Obot-managed workspace MCP servers are bound to.
+// Workspace servers don't get the credentials of the workspace provider. They read and write the files of their
+// project through these endpoints instead, which confine them to the project's files and enforce the quota.
+type WorkspaceServerFilesHandler struct {
+	quotaBytes int64
+}
+
+func NewWorkspaceServerFilesHandler(quotaBytes int64) *WorkspaceServerFilesHandler {
+	return &WorkspaceServerFilesHandler{
+		quotaBytes: quotaBytes,
+	}
+}
+
+// ListFiles lists the files of the server's project.
+func (h *WorkspaceServerFilesHandler) ListFiles(req api.Context) error {
+	server, err := workspaceServerForToken(req)
+	if err != nil {
+		return err
+	}
+
+	return listFileFromWorkspace(req.Context(), req, req.GPTClient, gptscript.ListFilesInWorkspaceOptions{
+		WorkspaceID: server.Status.WorkspaceID,
+		Prefix:      system.WorkspaceServerFilesPrefix,
+	})
+}
+
+// GetFile reads a file of the server's project.
+func (h *WorkspaceServerFilesHandler) GetFile(req api.Context) error {
+	server, err := workspaceServerForToken(req)
+	if err != nil {
+		return err
+	}
+	if err = validateWorkspaceServerFile(req.PathValue("file")); err != nil {
+		return err
+	}
+
+	return getFileInWorkspace(req, server.Status.WorkspaceID, system.WorkspaceServerFilesPrefix)
+}
+
+// WriteFile writes a file of the server's project. Writes are rejected once the project's files reach the quota, and
+// writes that would take the project's files over the quota are rejected too. The growth of the files is reserved
+// before the file is written, so that concurrent writes can't take the files over the quota together.
+func (h *WorkspaceServerFilesHandler) WriteFile(req api.Context) error {
+	server, err := workspaceServerForToken(req)
+	if err != nil {
+		return err
+	}
+	file := req.PathValue("file")
+	if err = validateWorkspaceServerFile(file); err != nil {
+		return err
+	}
+
+	if h.quotaBytes > 0 && server.Status.WorkspaceUsageBytes >= h.quotaBytes {
+		return types.NewErrHTTP(http.StatusInsufficientStorage, fmt.Sprintf("the project's files reached the quota of %d bytes", h.quotaBytes))
+	}
+
+	contents, err := req.Body(api.BodyOptions{MaxBytes: maxWorkspaceServerFileBytes})
+	if err != nil {
+		return err
+	}
+
+	oldSize, err := workspaceServerFileSize(req, server.Status.WorkspaceID, file)
+	if err != nil {
+		return err
+	}
+
+	delta := int64(len(contents)) - oldSize
+	if err = reserveWorkspaceServerUsage(req, server, delta, h.quotaBytes); err != nil {
+		return err
+	}
+
+	if err = req.GPTClient.WriteFileInWorkspace(req.Context(), system.WorkspaceServerFilesPrefix+file, contents, gptscript.WriteFileInWorkspaceOptions{WorkspaceID: server.Status.WorkspaceID}); err != nil {
+		// Release the reservation, since the file wasn't written.
+		if releaseErr := reserveWorkspaceServerUsage(req, server, -delta, 0); releaseErr != nil {
+			log.Errorf("Failed to release workspace usage of MCP server %s: %v", server.Name, releaseErr)
+		}
+		return fmt.Errorf("failed to write file %q to workspace %q: %w", file, server.Status.WorkspaceID, err)
+	}
+
+	req.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+// DeleteFile deletes a file of the server's project.
+func (h *WorkspaceServerFilesHandler) DeleteFile(req api.Context) error {
+	server, err := workspaceServerForToken(req)
+	if err != nil {
+		return err
+	}
+	file := req.PathValue("file")
+	if err = validateWorkspaceServerFile(file); err != nil {
+		return err
+	}
+
+	size, err := workspaceServerFileSize(req, server.Status.WorkspaceID, file)
+	if err != nil {
+		return err
+	}
+
+	if err = req.GPTClient.DeleteFileInWorkspace(req.Context(), system.WorkspaceServerFilesPrefix+file, gptscript.DeleteFileInWorkspaceOptions{WorkspaceID: server.Status.WorkspaceID}); err != nil {
+		return fmt.Errorf("failed to delete file %q from workspace %q: %w", file, server.Status.WorkspaceID, err)
+	}
+
+	if err = reserveWorkspaceServerUsage(req, server, -size, 0); err != nil {
+		return err
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// workspaceServerForToken returns the workspace server that the bearer token of the request belongs to. These
+// endpoints are not protected by authentication nor authorization, so the token is checked here. Workspace servers
+// authenticate with the token of their MCP server that their audit logs are submitted with.
+func workspaceServerForToken(req api.Context) (*v1.MCPServer, error) {
+	token, ok := strings.CutPrefix(req.Request.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, types.NewErrHTTP(http.StatusUnauthorized, "no token provided")
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("auditLogTokenHash", hash.Digest(token)),
+	}); err != nil {
+		return nil, err
+	}
+	if len(servers.Items) != 1 {
+		return nil, types.NewErrHTTP(http.StatusUnauthorized, "invalid token")
+	}
+
+	server := &servers.Items[0]
+	if !mcp.IsWorkspaceServer(server.Spec.MCPServerCatalogEntryName) || !server.DeletionTimestamp.IsZero() {
+		return nil, types.NewErrForbidden("MCP server %q is not a workspace server", server.Name)
+	}
+	if server.Status.WorkspaceID == "" {
+		return nil, types.NewErrHTTP(http.StatusTooEarly, "workspace server is not bound to a project yet")
+	}
+	return server, nil
+}
+
+// validateWorkspaceServerFile checks that the path of a file is relative to the project's files and stays inside them.
+func validateWorkspaceServerFile(file string) error {
+	if file == "" || !fs.ValidPath(file) {
+		return types.NewErrBadRequest("invalid file path %q", file)
+	}
+	return nil
+}
+
+// workspaceServerFileSize returns the size of a file of the project, or 0 if the file doesn't exist.
+func workspaceServerFileSize(req api.Context, workspaceID, file string) (int64, error) {
+	info, err := req.GPTClient.StatFileInWorkspace(req.Context(), system.WorkspaceServerFilesPrefix+file, gptscript.StatFileInWorkspaceOptions{WorkspaceID: workspaceID})
+	if nfe := (*gptscript.NotFoundInWorkspaceError)(nil); errors.As(err, &nfe) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to stat file %q in workspace %q: %w", file, workspaceID, err)
+	}
+	return info.Size, nil
+}
+
+// reserveWorkspaceServerUsage adds the change in size of the project's files to the usage of the server, so that the
+// quota is enforced between the measurements of the controller, which corrects any drift. Growth that would take the
+// usage over the quota is rejected, unless the quota is 0. The update is conditional on the resource version of the
+// server, so that concurrent reservations are checked against each other's usage.
+func reserveWorkspaceServerUsage(req api.Context, server *v1.MCPServer, delta, quotaBytes int64) error {
+	if delta == 0 {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var current v1.MCPServer
+		if err := req.Get(&current, server.Name); err != nil {
+			return err
+		}
+		if quotaBytes > 0 && delta > 0 && current.Status.WorkspaceUsageBytes+delta > quotaBytes {
+			return types.NewErrHTTP(http.StatusInsufficientStorage, fmt.Sprintf("writing the file would take the project's files over the quota of %d bytes", quotaBytes))
+		}
+		current.Status.WorkspaceUsageBytes = max(current.Status.WorkspaceUsageBytes+delta, 0)
+		return req.Storage.Status().Update(req.Context(), &current)
+	})
+}
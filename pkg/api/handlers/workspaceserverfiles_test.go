@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWorkspaceServerFiles(t *testing.T) {
+	server := func(name, catalogEntryName, token, workspaceID string, usage int64) *v1.MCPServer {
+		return &v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerSpec{MCPServerCatalogEntryName: catalogEntryName},
+			Status: v1.MCPServerStatus{
+				AuditLogTokenHash:   hash.Digest(token),
+				WorkspaceID:         workspaceID,
+				WorkspaceUsageBytes: usage,
+			},
+		}
+	}
+
+	storage := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithIndex(&v1.MCPServer{}, "auditLogTokenHash", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.MCPServer).Status.AuditLogTokenHash}
+		}).
+		WithObjects(
+			server("ms1-full", system.WorkspaceCatalogEntryName, "full-token", "s3://obot-workspaces/full", 1024),
+			server("ms1-unbound", system.WorkspaceCatalogEntryName, "unbound-token", "", 0),
+			server("ms1-other", "default-other", "other-token", "s3://obot-workspaces/other", 0),
+		).
+		Build()
+
+	newContext := func(token, file string) api.Context {
+		req := httptest.NewRequest(http.MethodPut, "/api/workspace-server-files/"+file, strings.NewReader("contents"))
+		req.SetPathValue("file", file)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return api.Context{
+			ResponseWriter: httptest.NewRecorder(),
+			Request:        req,
+			Storage:        storage,
+		}
+	}
+
+	handler := NewWorkspaceServerFilesHandler(1024)
+
+	tests := []struct {
+		name  string
+		token string
+		file  string
+		code  int
+	}{
+		{name: "no token", file: "notes.md", code: http.StatusUnauthorized},
+		{name: "unknown token", token: "unknown", file: "notes.md", code: http.StatusUnauthorized},
+		{name: "not a workspace server", token: "other-token", file: "notes.md", code: http.StatusForbidden},
+		{name: "unbound server", token: "unbound-token", file: "notes.md", code: http.StatusTooEarly},
+		{name: "parent directory", token: "full-token", file: "../secrets.env", code: http.StatusBadRequest},
+		{name: "absolute path", token: "full-token", file: "/etc/passwd", code: http.StatusBadRequest},
+		{name: "quota reached", token: "full-token", file: "notes.md", code: http.StatusInsufficientStorage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := handler.WriteFile(newContext(tt.token, tt.file))
+			var httpErr *types.ErrHTTP
+			if !errors.As(err, &httpErr) {
+				t.Fatalf("WriteFile() error = %v, want HTTP error", err)
+			}
+			if httpErr.Code != tt.code {
+				t.Fatalf("WriteFile() code = %d, want %d", httpErr.Code, tt.code)
+			}
+		})
+	}
+}
+
+func TestReserveWorkspaceServerUsageConcurrently(t *testing.T) {
+	server := &v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms1-test", Namespace: system.DefaultNamespace},
+		Spec:       v1.MCPServerSpec{MCPServerCatalogEntryName: system.WorkspaceCatalogEntryName},
+		Status:     v1.MCPServerStatus{WorkspaceID: "s3://obot-workspaces/test", WorkspaceUsageBytes: 100},
+	}
+	storage := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithStatusSubresource(&v1.MCPServer{}).
+		WithObjects(server).
+		Build()
+
+	req := api.Context{
+		Request: httptest.NewRequest(http.MethodPut, "/api/workspace-server-files/notes.md", nil),
+		Storage: storage,
+	}
+
+	// Each write fits in the quota on its own, but only 9 of them fit together.
+	var (
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		succeeded int
+	)
+	for range 12 {
+		wg.Go(func() {
+			err := reserveWorkspaceServerUsage(req, server, 100, 1000)
+			if err == nil {
+				lock.Lock()
+				succeeded++
+				lock.Unlock()
+				return
+			}
+			if httpErr, ok := errors.AsType[*types.ErrHTTP](err); !ok || httpErr.Code != http.StatusInsufficientStorage {
+				t.Errorf("reserveWorkspaceServerUsage() error = %v, want quota error", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	var current v1.MCPServer
+	if err := storage.Get(t.Context(), kclient.ObjectKeyFromObject(server), &current); err != nil {
+		t.Fatalf("failed to get server: %v", err)
+	}
+	if succeeded != 9 || current.Status.WorkspaceUsageBytes != 1000 {
+		t.Errorf("got %d reservations and usage %d, want 9 reservations and usage 1000", succeeded, current.Status.WorkspaceUsageBytes)
+	}
+
+	// Releasing a reservation isn't limited by the quota.
+	if err := reserveWorkspaceServerUsage(req, server, -100, 1000); err != nil {
+		t.Fatalf("reserveWorkspaceServerUsage() error = %v", err)
+	}
+}
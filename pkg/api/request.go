@@ -33,6 +33,9 @@ type Context struct {
 	GatewayClient *gclient.Client
 	User          user.Info
 	APIBaseURL    string
+	// ExternalBaseURL is the URL that the client used to reach Obot, without the /api path.
+	// It differs from the server URL only when a trusted proxy forwarded the request for another host.
+	ExternalBaseURL string
 }
 
 type (
@@ -45,18 +45,22 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	tasks := handlers.NewTaskHandler(services.Invoker, services.Events)
 	invoker := handlers.NewInvokeHandler(services.Invoker, services.MCPLoader)
 	threads := handlers.NewThreadHandler(services.ProviderDispatcher, services.Events, services.ModelAccessPolicyHelper)
-	runs := handlers.NewRunHandler(services.Events)
+	runs := handlers.NewRunHandler(services.Events, services.RunMCPToolCostWarningUSD)
 	toolRefs := handlers.NewToolReferenceHandler()
 	cronJobs := handlers.NewCronJobHandler()
 	models := handlers.NewModelHandler(services.ModelAccessPolicyHelper)
-	mcpCatalogs := handlers.NewMCPCatalogHandler(services.DefaultMCPCatalogPath, services.ServerURL, services.MCPLoader, oauthChecker, services.GatewayClient, services.AccessControlRuleHelper)
+	mcpCatalogs := handlers.NewMCPCatalogHandler(services.DefaultMCPCatalogPath, services.ServerURL, services.MCPLoader, oauthChecker, services.GatewayClient, services.AccessControlRuleHelper, services.TrustedProxies)
 	systemMCPCatalogs := handlers.NewSystemMCPCatalogHandler(services.DefaultSystemMCPCatalogPath)
+	mcpServerScaffolds := handlers.NewMCPServerScaffoldHandler()
 	accessControlRules := handlers.NewAccessControlRuleHandler()
 	skillRepositories := handlers.NewSkillRepositoryHandler()
 	skillAccessRules := handlers.NewSkillAccessRuleHandler()
 	skills := handlers.NewSkillHandler(services.SkillAccessRuleHelper)
-	powerUserWorkspaces := handlers.NewPowerUserWorkspaceHandler(services.ServerURL, services.AccessControlRuleHelper)
+	powerUserWorkspaces := handlers.NewPowerUserWorkspaceHandler(services.AccessControlRuleHelper)
 	mcpWebhookValidations := handlers.NewMCPWebhookValidationHandler(services.MCPLoader)
+	alertRules := handlers.NewAlertRuleHandler()
+	catalogEventWebhooks := handlers.NewMCPCatalogEventWebhookHandler()
+	backstage := handlers.NewBackstageHandler()
 	availableModels := handlers.NewAvailableModelsHandler(services.ProviderDispatcher)
 	modelProviders := handlers.NewModelProviderHandler(services.ProviderDispatcher, services.Invoker)
 	modelAccessPolicies := handlers.NewModelAccessPolicyHandler()
@@ -76,12 +80,23 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	workflows := handlers.NewWorkflowHandler()
 	images := handlers.NewImageHandler(services.GeminiClient)
 	mcp := handlers.NewMCPHandler(services.MCPLoader, services.AccessControlRuleHelper, oauthChecker, services.MCPRuntimeBackend, services.ServerURL)
-	projectMCP := handlers.NewProjectMCPHandler(services.MCPLoader, services.AccessControlRuleHelper, oauthChecker, services.ServerURL, services.InternalServerURL)
+	catalogBundles := handlers.NewMCPCatalogBundleHandler(mcpCatalogs, mcp, accessControlRules)
+	projectMCP := handlers.NewProjectMCPHandler(services.MCPLoader, services.AccessControlRuleHelper, oauthChecker, services.ServerURL, services.InternalServerURL, services.MCPDefaultToolSelection)
 	projectInvitations := handlers.NewProjectInvitationHandler()
-	mcpGateway := mcpgateway.NewHandler(services.MCPLoader, services.WebhookHelper, services.OAuthServerConfig.ScopesSupported, services.NanobotIntegration)
+	toolApprovals := handlers.NewToolApprovalHandler(services.ToolApprovalExpiration, services.ToolApprovalWarning)
+	credentialBackups := handlers.NewCredentialBackupHandler(services.MCPLoader, services.EncryptionConfig)
+	keyRotation := handlers.NewKeyRotationHandler(services.EncryptionConfig)
+	mcpGateway := mcpgateway.NewHandler(services.MCPLoader, services.WebhookHelper, services.ToolPolicyHelper, services.OAuthServerConfig.ScopesSupported, services.NanobotIntegration, services.MonthlyUserMCPToolCallLimit, mcpgateway.ConnectOptions{
+		PingInterval:       services.MCPConnectPingInterval,
+		IdleTimeout:        services.MCPConnectIdleTimeout,
+		MaxSessionDuration: services.MCPConnectMaxSessionDuration,
+	})
 	mcpAuditLogs := mcpgateway.NewAuditLogHandler()
+	workspaceServerFiles := handlers.NewWorkspaceServerFilesHandler(services.MCPWorkspaceServerQuota)
 	auditLogExports := handlers.NewAuditLogExportHandler(services.GPTClient)
-	serverInstances := handlers.NewServerInstancesHandler(services.AccessControlRuleHelper, services.ServerURL)
+	serverInstances := handlers.NewServerInstancesHandler(services.AccessControlRuleHelper)
+	userActivityExports := handlers.NewUserActivityExportHandler()
+	userPurges := handlers.NewUserPurgeHandler()
 	systemMCPServers := handlers.NewSystemMCPServerHandler(services.MCPLoader)
 	userDefaultRoleSettings := handlers.NewUserDefaultRoleSettingHandler()
 	setupHandler := setup.NewHandler(services.ServerURL)
@@ -383,9 +398,15 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/credentials", handlers.ListCredentials)
 	mux.HandleFunc("DELETE /api/credentials/{id}", handlers.DeleteCredential)
 	mux.HandleFunc("POST /api/credentials/recreate-all", handlers.RecreateAllCredentials)
+	mux.HandleFunc("POST /api/credentials/backup", credentialBackups.Backup)
+	mux.HandleFunc("POST /api/credentials/restore", credentialBackups.Restore)
 	mux.HandleFunc("GET /api/threads/{context}/credentials", handlers.ListCredentials)
 	mux.HandleFunc("DELETE /api/threads/{context}/credentials/{id}", handlers.DeleteCredential)
 
+	// Encryption key rotation
+	mux.HandleFunc("GET /api/encryption/key-rotation", keyRotation.Get)
+	mux.HandleFunc("POST /api/encryption/key-rotation", keyRotation.Start)
+
 	// Environment variable credentials
 	mux.HandleFunc("GET /api/agents/{id}/env", handlers.RevealEnv)
 	mux.HandleFunc("POST /api/agents/{id}/env", handlers.SetEnv)
@@ -413,28 +434,46 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 
 	// User-Deployed MCP Servers (single-user, remote, and composite)
 	mux.HandleFunc("GET /api/mcp-servers", mcp.ListServer)
+	mux.HandleFunc("GET /api/mcp-servers/health", mcp.ServersHealth)
+	mux.HandleFunc("GET /api/mcp-servers/watch", mcp.WatchServers)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}", mcp.GetServer)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/tool-conflicts", mcp.GetToolConflicts)
 	mux.HandleFunc("POST /api/mcp-servers", mcp.CreateServer)
+	mux.HandleFunc("POST /api/mcp-servers/bulk", mcp.BulkServerAction)
 	mux.HandleFunc("PUT /api/mcp-servers/{mcp_server_id}", mcp.UpdateServer)
+	mux.HandleFunc("PATCH /api/mcp-servers/{mcp_server_id}/components", mcp.PatchCompositeComponents)
 	mux.HandleFunc("PUT /api/mcp-servers/{mcp_server_id}/alias", mcp.UpdateServerAlias)
 	mux.HandleFunc("DELETE /api/mcp-servers/{mcp_server_id}", mcp.DeleteServer)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/launch", mcp.LaunchServer)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/launch", mcp.LaunchStatus)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/check-oauth", mcp.CheckOAuth)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/oauth-url", mcp.GetOAuthURL)
 	mux.HandleFunc("DELETE /api/mcp-servers/{mcp_server_id}/oauth", mcp.ClearOAuthCredentials)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/details", mcp.GetServerDetails)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/logs", mcp.StreamServerLogs)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/restart", mcp.RestartServerDeployment)
+	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/clone", mcp.CloneServer)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/configure", mcp.ConfigureServer)
+	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/rotate-credentials", mcp.RotateServerCredentials)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/deconfigure", mcp.DeconfigureServer)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/reveal", mcp.Reveal)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/tools", mcp.GetTools)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/roots", mcp.GetRoots)
+	mux.HandleFunc("PUT /api/mcp-servers/{mcp_server_id}/roots", mcp.SetRoots)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/oauth-token-sources", mcp.ListOAuthTokenSources)
+	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/oauth-token-sources/{oauth_app}/authorize", mcp.AuthorizeOAuthTokenSource)
+	mux.HandleFunc("DELETE /api/mcp-servers/{mcp_server_id}/oauth-token-sources/{oauth_app}", mcp.DeleteOAuthTokenSource)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/resources", mcp.GetResources)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/resources/{resource_uri}", mcp.ReadResource)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/resource-updates", mcp.ResourceUpdates)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/prompts", mcp.GetPrompts)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/prompts/{prompt_name}", mcp.GetPrompt)
+	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/complete", mcp.Complete)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/update-url", mcp.UpdateURL)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/trigger-update", mcp.TriggerUpdate)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/update-preview", mcp.PreviewUpdate)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/catalog-entry-revisions", mcp.ListCatalogEntryRevisions)
+	mux.HandleFunc("PUT /api/mcp-servers/{mcp_server_id}/pinned-revision", mcp.PinRevision)
 
 	// MCPServerInstances
 	mux.HandleFunc("GET /api/mcp-server-instances", serverInstances.ListServerInstances)
@@ -443,6 +482,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/mcp-server-instances/{mcp_server_instance_id}/reveal", serverInstances.RevealConfig)
 	mux.HandleFunc("POST /api/mcp-server-instances/{mcp_server_instance_id}/configure", serverInstances.ConfigureServerInstance)
 	mux.HandleFunc("POST /api/mcp-server-instances/{mcp_server_instance_id}/deconfigure", serverInstances.DeconfigureServerInstance)
+	mux.HandleFunc("PUT /api/mcp-server-instances/{mcp_server_instance_id}/tool-allowlist", serverInstances.SetToolAllowlist)
 	mux.HandleFunc("DELETE /api/mcp-server-instances/{mcp_server_instance_id}", serverInstances.DeleteServerInstance)
 	mux.HandleFunc("DELETE /api/mcp-server-instances/{mcp_server_instance_id}/oauth", serverInstances.ClearOAuthCredentials)
 
@@ -451,8 +491,48 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}", mcpCatalogs.Get)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/categories", mcpCatalogs.ListCategoriesForCatalog)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/refresh", mcpCatalogs.Refresh)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/webhook", mcpCatalogs.GetWebhook)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/webhook", mcpCatalogs.GenerateWebhookSecret)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/webhook", mcpCatalogs.DeleteWebhookSecret)
+	mux.HandleFunc("POST /api/mcp-catalog-webhooks/{catalog_id}", mcpCatalogs.ReceiveWebhook)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/submission-portal", mcpCatalogs.GetSubmissionPortal)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/submission-portal", mcpCatalogs.ConfigureSubmissionPortal)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/submission-portal", mcpCatalogs.DeleteSubmissionPortal)
+	mux.HandleFunc("POST /api/mcp-catalog-submissions/{catalog_id}", mcpCatalogs.SubmitThroughPortal)
+	mux.HandleFunc("GET /api/mcp-catalog-submissions/{catalog_id}/{submission_id}", mcpCatalogs.GetPortalSubmission)
+
+	// Webhooks that the lifecycle events of a catalog are sent to
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/event-webhooks", catalogEventWebhooks.List)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/event-webhooks/{webhook_id}", catalogEventWebhooks.Get)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/event-webhooks", catalogEventWebhooks.Create)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/event-webhooks/{webhook_id}", catalogEventWebhooks.Update)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/event-webhooks/{webhook_id}", catalogEventWebhooks.Delete)
+
+	// Backstage catalog-info entities of a catalog's entries and servers
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/backstage-entities", backstage.CatalogEntities)
+
+	// Export a catalog's entries, servers, and access control rules as a bundle, and import bundles into catalogs
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/export", catalogBundles.Export)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/import", catalogBundles.Import)
 	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}", mcpCatalogs.Update)
 
+	// Validate a catalog entry manifest before it is published to a catalog or workspace
+	mux.HandleFunc("POST /api/catalog-entries/validate", mcpCatalogs.ValidateEntry)
+
+	// Scaffold new MCP server projects from language templates
+	mux.HandleFunc("POST /api/mcp-server-scaffolds", mcpServerScaffolds.Create)
+
+	// Catalog entries submitted by users for review. Admins approve or reject them.
+	mux.HandleFunc("GET /api/pending-catalog-entries", mcpCatalogs.ListPendingEntries)
+	mux.HandleFunc("GET /api/pending-catalog-entries/{pending_entry_id}", mcpCatalogs.GetPendingEntry)
+	mux.HandleFunc("POST /api/pending-catalog-entries", mcpCatalogs.SubmitEntry)
+	mux.HandleFunc("PUT /api/pending-catalog-entries/{pending_entry_id}", mcpCatalogs.UpdatePendingEntry)
+	mux.HandleFunc("DELETE /api/pending-catalog-entries/{pending_entry_id}", mcpCatalogs.DeletePendingEntry)
+	mux.HandleFunc("POST /api/pending-catalog-entries/{pending_entry_id}/comments", mcpCatalogs.CommentOnPendingEntry)
+	mux.HandleFunc("POST /api/pending-catalog-entries/{pending_entry_id}/approve", mcpCatalogs.ApprovePendingEntry)
+	mux.HandleFunc("POST /api/pending-catalog-entries/{pending_entry_id}/reject", mcpCatalogs.RejectPendingEntry)
+	mux.HandleFunc("POST /api/pending-catalog-entries/{pending_entry_id}/checks", mcpCatalogs.RunPendingEntryChecks)
+
 	// MCPServerCatalogEntries (admin only, for single-user and remote MCP servers)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries", mcpCatalogs.ListEntries)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries/{entry_id}", mcpCatalogs.GetEntry)
@@ -473,21 +553,38 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/oauth-credentials", mcpCatalogs.GetOAuthCredentials)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/oauth-credentials", mcpCatalogs.SetOAuthCredentials)
 	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/oauth-credentials", mcpCatalogs.DeleteOAuthCredentials)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/team-credentials", mcpCatalogs.ListTeamCredentials)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/team-credentials/{group_id}", mcpCatalogs.SetTeamCredential)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/team-credentials/{group_id}", mcpCatalogs.DeleteTeamCredential)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/maintenance", mcpCatalogs.SetEntryMaintenanceNotice)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/maintenance", mcpCatalogs.DeleteEntryMaintenanceNotice)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/revisions", mcpCatalogs.ListEntryRevisions)
 
 	// MCPServers within the catalog (admin only, for multi-user MCP servers)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers", mcp.ListServer)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.GetServer)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/tool-conflicts", mcp.GetToolConflicts)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers", mcp.CreateServer)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/bulk", mcp.BulkServerAction)
 	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.UpdateServer)
+	mux.HandleFunc("PATCH /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/components", mcp.PatchCompositeComponents)
 	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.DeleteServer)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/launch", mcp.LaunchServer)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/launch", mcp.LaunchStatus)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/check-oauth", mcp.CheckOAuth)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/oauth-url", mcp.GetOAuthURL)
 	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/oauth", mcp.ClearOAuthCredentials)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/configure", mcp.ConfigureServer)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/rotate-credentials", mcp.RotateServerCredentials)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/deconfigure", mcp.DeconfigureServer)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/reveal", mcp.Reveal)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/connect-alias", mcp.SetConnectAlias)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/external-exposure", mcp.SetExternalExposure)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/maintenance", mcp.SetMaintenanceNotice)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/maintenance", mcp.DeleteMaintenanceNotice)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/instances", serverInstances.ListServerInstancesForServer)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/instances/{mcp_server_instance_id}/oauth", serverInstances.GetOAuthState)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/instances/{mcp_server_instance_id}/oauth", serverInstances.ResetOAuthState)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/k8s-settings-status", mcp.CheckK8sSettingsStatus)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/redeploy-with-k8s-settings", mcp.RedeployWithK8sSettings)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers-needing-k8s-update", mcp.ListServersNeedingK8sUpdateInCatalog)
@@ -542,14 +639,19 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	// Workspace-scoped MCP Servers (PowerUserPlus and higher only)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers", mcp.ListServer)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}", mcp.GetServer)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-conflicts", mcp.GetToolConflicts)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers", mcp.CreateServer)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/bulk", mcp.BulkServerAction)
 	mux.HandleFunc("PUT /api/workspaces/{workspace_id}/servers/{mcp_server_id}", mcp.UpdateServer)
+	mux.HandleFunc("PATCH /api/workspaces/{workspace_id}/servers/{mcp_server_id}/components", mcp.PatchCompositeComponents)
 	mux.HandleFunc("DELETE /api/workspaces/{workspace_id}/servers/{mcp_server_id}", mcp.DeleteServer)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/launch", mcp.LaunchServer)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/launch", mcp.LaunchStatus)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/check-oauth", mcp.CheckOAuth)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/oauth-url", mcp.GetOAuthURL)
 	mux.HandleFunc("DELETE /api/workspaces/{workspace_id}/servers/{mcp_server_id}/oauth", mcp.ClearOAuthCredentials)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/configure", mcp.ConfigureServer)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/rotate-credentials", mcp.RotateServerCredentials)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/deconfigure", mcp.DeconfigureServer)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/reveal", mcp.Reveal)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/details", mcp.GetServerDetails)
@@ -562,6 +664,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 
 	// MCP Webhook Validations (admin only)
 	mux.HandleFunc("GET /api/mcp-webhook-validations", mcpWebhookValidations.List)
+	mux.HandleFunc("GET /api/mcp-webhook-validations/signature-scheme", mcpWebhookValidations.SignatureScheme)
 	mux.HandleFunc("GET /api/mcp-webhook-validations/{mcp_webhook_validation_id}", mcpWebhookValidations.Get)
 	mux.HandleFunc("POST /api/mcp-webhook-validations", mcpWebhookValidations.Create)
 	mux.HandleFunc("PUT /api/mcp-webhook-validations/{mcp_webhook_validation_id}", mcpWebhookValidations.Update)
@@ -574,6 +677,13 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-webhook-validations/{mcp_webhook_validation_id}/details", mcpWebhookValidations.GetDetails)
 	mux.HandleFunc("GET /api/mcp-webhook-validations/{mcp_webhook_validation_id}/logs", mcpWebhookValidations.Logs)
 
+	// Alert rules on MCP server telemetry (admin only)
+	mux.HandleFunc("GET /api/alert-rules", alertRules.List)
+	mux.HandleFunc("GET /api/alert-rules/{id}", alertRules.Get)
+	mux.HandleFunc("POST /api/alert-rules", alertRules.Create)
+	mux.HandleFunc("PUT /api/alert-rules/{id}", alertRules.Update)
+	mux.HandleFunc("DELETE /api/alert-rules/{id}", alertRules.Delete)
+
 	// System MCP Servers (admin only)
 	mux.HandleFunc("GET /api/system-mcp-servers", systemMCPServers.List)
 	mux.HandleFunc("POST /api/system-mcp-servers/restart-nanobot-agent-deployments", systemMCPServers.RestartNanobotAgentDeployments)
@@ -606,6 +716,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	// The first pattern handles the root path, the second handles all sub-paths
 	mux.HandleFunc("/mcp-connect/{mcp_id}", mcpGateway.Proxy)
 	mux.HandleFunc("/mcp-connect/{mcp_id}/{rest...}", mcpGateway.Proxy)
+	mux.HandleFunc("GET /api/mcp-connect-sessions", mcpGateway.ListSessions)
 
 	// Registry API
 	mux.HandleFunc("GET /v0.1/servers", registryHandler.ListServers)
@@ -617,10 +728,24 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/mcp-audit-logs", mcpAuditLogs.SubmitAuditLogs)
 	mux.HandleFunc("GET /api/mcp-audit-logs/filter-options/{filter}", mcpAuditLogs.ListAuditLogFilterOptions)
 	mux.HandleFunc("GET /api/mcp-audit-logs/detail/{audit_log_id}", mcpAuditLogs.GetAuditLog)
+	mux.HandleFunc("GET /api/mcp-audit-logs/stats/tool-calls", mcpAuditLogs.GetToolCallDailyStats)
+	mux.HandleFunc("GET /api/mcp-audit-logs/stats/error-rate", mcpAuditLogs.GetErrorRates)
 	mux.HandleFunc("GET /api/mcp-audit-logs/{mcp_id}", mcpAuditLogs.ListAuditLogs)
+
+	// Files of the projects of workspace MCP servers, authenticated with the token of the server
+	mux.HandleFunc("GET /api/workspace-server-files", workspaceServerFiles.ListFiles)
+	mux.HandleFunc("GET /api/workspace-server-files/{file...}", workspaceServerFiles.GetFile)
+	mux.HandleFunc("PUT /api/workspace-server-files/{file...}", workspaceServerFiles.WriteFile)
+	mux.HandleFunc("DELETE /api/workspace-server-files/{file...}", workspaceServerFiles.DeleteFile)
 	mux.HandleFunc("GET /api/mcp-stats", mcpAuditLogs.GetUsageStats)
 	mux.HandleFunc("GET /api/mcp-stats/{mcp_id}", mcpAuditLogs.GetUsageStats)
 
+	// Exports of a user's MCP activity, for legal holds and subject access requests
+	mux.HandleFunc("GET /api/users/{user_id}/activity-export", userActivityExports.ExportUserActivity)
+
+	// Purges of a user's personal data, for right-to-be-forgotten requests
+	mux.HandleFunc("POST /api/users/{user_id}/purge", userPurges.PurgeUser)
+
 	// Audit Log Exports
 	mux.HandleFunc("POST /api/audit-log-exports", auditLogExports.CreateAuditLogExport)
 	mux.HandleFunc("GET /api/audit-log-exports", auditLogExports.ListAuditLogExports)
@@ -686,6 +811,10 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/mcpservers/{project_mcp_server_id}/prompts", projectMCP.GetPrompts)
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/mcpservers/{project_mcp_server_id}/prompts/{prompt_name}", projectMCP.GetPrompt)
 
+	// Tool approvals
+	mux.HandleFunc("GET /api/tool-approvals", toolApprovals.List)
+	mux.HandleFunc("POST /api/tool-approvals/recertify", toolApprovals.Recertify)
+
 	// OAuthClients
 	mux.HandleFunc("GET /api/oauth-clients", oauthClients.List)
 	mux.HandleFunc("POST /api/oauth-clients", oauthClients.Create)
@@ -703,10 +832,29 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/k8s-settings", k8sSettingsHandler.Get)
 	mux.HandleFunc("PUT /api/k8s-settings", k8sSettingsHandler.Update)
 
+	// MCP log settings
+	mcpLogSettingsHandler := handlers.NewMCPLogSettingsHandler()
+	mux.HandleFunc("GET /api/mcp-log-settings", mcpLogSettingsHandler.Get)
+	mux.HandleFunc("PUT /api/mcp-log-settings", mcpLogSettingsHandler.Update)
+
+	// Database migrations
+	databaseMigrationsHandler := handlers.NewDatabaseMigrationsHandler()
+	mux.HandleFunc("GET /api/database-migrations", databaseMigrationsHandler.Get)
+	mux.HandleFunc("POST /api/database-migrations/transitions/{name}/contract", databaseMigrationsHandler.ContractTransition)
+
 	// MCP Capacity (admin only)
 	mcpCapacityHandler := handlers.NewMCPCapacityHandler(services.MCPLoader)
 	mux.HandleFunc("GET /api/mcp-capacity", mcpCapacityHandler.GetCapacity)
 
+	// MCP controller reconcile stats (admin only)
+	reconcileStatsHandler := handlers.NewReconcileStatsHandler(services.ReconcileStats)
+	mux.HandleFunc("GET /api/mcp-reconcile-stats", reconcileStatsHandler.Get)
+
+	// MCP elicitations (requests for user input from MCP servers during tool calls)
+	mcpElicitations := handlers.NewMCPElicitationHandler(services.MCPLoader)
+	mux.HandleFunc("GET /api/mcp-elicitations/events", mcpElicitations.Events)
+	mux.HandleFunc("POST /api/mcp-elicitations/{elicitation_id}", mcpElicitations.Respond)
+
 	// EULA
 	eulaHandler := handlers.NewEulaHandler()
 	mux.HandleFunc("GET /api/eula", eulaHandler.Get)
@@ -870,7 +1018,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	wellknown.SetupHandlers(services.ServerURL, services.OAuthServerConfig, services.RegistryNoAuth, mux)
 
 	// Obot OAuth
-	oauth.SetupHandlers(oauthChecker, services.MCPOAuthTokenStorage, services.PersistentTokenServer, services.OAuthServerConfig, services.ServerURL, mux)
+	oauth.SetupHandlers(oauthChecker, services.MCPOAuthTokenStorage, services.PersistentTokenServer, services.OAuthServerConfig, services.OAuthChallenge, services.ServerURL, mux)
 
 	// Gateway APIs
 	services.GatewayServer.AddRoutes(services.APIServer)
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/api/server/requestinfo"
+	"github.com/obot-platform/obot/pkg/storage"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// connectAliasCacheTTL is how long the aliases of a hostname are cached, including the absence of aliases.
+const connectAliasCacheTTL = 10 * time.Second
+
+// connectAliases routes requests for the vanity hostnames of multi-user MCP servers to their connect URLs.
+type connectAliases struct {
+	storageClient storage.Client
+	serverHost    string
+
+	lock  sync.Mutex
+	hosts map[string]connectAliasHost
+}
+
+type connectAliasHost struct {
+	// servers maps the path of each alias on the host to the name of its MCP server.
+	servers map[string]string
+	expires time.Time
+}
+
+func newConnectAliases(storageClient storage.Client, baseURL string) *connectAliases {
+	var serverHost string
+	if u, err := url.Parse(baseURL); err == nil {
+		serverHost = strings.ToLower(u.Hostname())
+	}
+
+	return &connectAliases{
+		storageClient: storageClient,
+		serverHost:    serverHost,
+		hosts:         make(map[string]connectAliasHost),
+	}
+}
+
+// rewrite points the request at the connect URL of the MCP server whose alias matches the request's host and path.
+func (c *connectAliases) rewrite(req *http.Request, trustedProxies []*net.IPNet) {
+	host := requestinfo.Host(req, trustedProxies)
+	if host == "" || host == c.serverHost || host == "localhost" || net.ParseIP(host) != nil {
+		return
+	}
+
+	// OAuth discovery and flows are served by Obot itself on any hostname.
+	if strings.HasPrefix(req.URL.Path, "/.well-known/") || strings.HasPrefix(req.URL.Path, "/oauth/") {
+		return
+	}
+
+	servers, err := c.serversForHost(req.Context(), host)
+	if err != nil {
+		log.Warnf("Failed to look up connect aliases for host %s: %v", host, err)
+		return
+	}
+
+	serverName, rest, ok := matchConnectAlias(servers, req.URL.Path)
+	if !ok {
+		return
+	}
+
+	req.URL.Path = "/mcp-connect/" + serverName + rest
+	req.URL.RawPath = ""
+}
+
+func (c *connectAliases) serversForHost(ctx context.Context, host string) (map[string]string, error) {
+	c.lock.Lock()
+	cached, ok := c.hosts[host]
+	c.lock.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.servers, nil
+	}
+
+	var list v1.MCPServerList
+	if err := c.storageClient.List(ctx, &list, kclient.InNamespace(system.DefaultNamespace), kclient.MatchingFields{
+		"spec.connectAliasHost": host,
+	}); err != nil {
+		return nil, err
+	}
+
+	servers := make(map[string]string, len(list.Items))
+	for _, server := range list.Items {
+		_, path := v1.SplitConnectAlias(server.Spec.ConnectAlias)
+		servers[path] = server.Name
+	}
+
+	c.lock.Lock()
+	c.hosts[host] = connectAliasHost{servers: servers, expires: time.Now().Add(connectAliasCacheTTL)}
+	c.lock.Unlock()
+
+	return servers, nil
+}
+
+// matchConnectAlias returns the MCP server whose alias path is the longest prefix of the request path, along with the
+// remainder of the request path.
+func matchConnectAlias(servers map[string]string, path string) (string, string, bool) {
+	var (
+		serverName, rest string
+		matched          = -1
+	)
+	for aliasPath, name := range servers {
+		if len(aliasPath) <= matched {
+			continue
+		}
+		if aliasPath != "" && path != aliasPath && !strings.HasPrefix(path, aliasPath+"/") {
+			continue
+		}
+
+		serverName, rest, matched = name, strings.TrimPrefix(path, aliasPath), len(aliasPath)
+	}
+
+	if rest == "/" {
+		rest = ""
+	}
+	return serverName, rest, matched >= 0
+}
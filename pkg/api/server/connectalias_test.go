@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchConnectAlias(t *testing.T) {
+	servers := map[string]string{
+		"":           "ms1root",
+		"/jira":      "ms1jira",
+		"/jira/prod": "ms1prod",
+	}
+
+	tests := []struct {
+		path       string
+		serverName string
+		rest       string
+	}{
+		{path: "/", serverName: "ms1root"},
+		{path: "/mcp", serverName: "ms1root", rest: "/mcp"},
+		{path: "/jira", serverName: "ms1jira"},
+		{path: "/jira/", serverName: "ms1jira"},
+		{path: "/jira/sse", serverName: "ms1jira", rest: "/sse"},
+		{path: "/jira/prod/mcp", serverName: "ms1prod", rest: "/mcp"},
+		{path: "/jiraprod", serverName: "ms1root", rest: "/jiraprod"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			serverName, rest, ok := matchConnectAlias(servers, tt.path)
+			assert.True(t, ok)
+			assert.Equal(t, tt.serverName, serverName)
+			assert.Equal(t, tt.rest, rest)
+		})
+	}
+
+	_, _, ok := matchConnectAlias(map[string]string{"/jira": "ms1jira"}, "/confluence")
+	assert.False(t, ok, "paths outside of every alias should not match")
+}
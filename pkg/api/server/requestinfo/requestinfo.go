@@ -1,7 +1,11 @@
 package requestinfo
 
 import (
+	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"slices"
 	"strings"
 )
 
@@ -25,3 +29,128 @@ func GetSourceIP(req *http.Request) string {
 	// Fall back to RemoteAddr
 	return req.RemoteAddr
 }
+
+// ClientIP returns the IP address of the client, without a port. The rightmost X-Forwarded-For address is only honored
+// if the request came directly from one of the trusted proxies, so that clients can't choose their address by sending
+// the header themselves.
+func ClientIP(req *http.Request, trustedProxies []*net.IPNet) string {
+	if xff := req.Header.Get("X-Forwarded-For"); xff != "" && fromTrustedProxy(req, trustedProxies) {
+		ips := strings.Split(xff, ",")
+		if ip := strings.TrimSpace(ips[len(ips)-1]); ip != "" {
+			return ip
+		}
+	}
+
+	return remoteHost(req)
+}
+
+// ParseTrustedProxies parses a list of IP addresses and CIDRs of reverse proxies that are trusted to set forwarded headers.
+func ParseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	proxies := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: not an IP address or CIDR", value)
+			}
+
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+		}
+		proxies = append(proxies, ipNet)
+	}
+
+	return proxies, nil
+}
+
+// ExternalBaseURL returns the base URL that the client used to reach Obot.
+// The X-Forwarded-Host and X-Forwarded-Proto headers are only honored if the request came directly from one of the trusted proxies.
+// Otherwise, or if the proxy didn't set X-Forwarded-Host, the configured base URL is returned.
+func ExternalBaseURL(req *http.Request, baseURL string, trustedProxies []*net.IPNet) string {
+	host := forwardedHost(req, trustedProxies)
+	if host == "" {
+		return baseURL
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL
+	}
+
+	switch proto := strings.ToLower(lastHeaderValue(req.Header.Get("X-Forwarded-Proto"))); proto {
+	case "http", "https":
+		u.Scheme = proto
+	}
+	u.Host = host
+
+	return u.String()
+}
+
+// Host returns the hostname, without a port, that the client used to reach Obot.
+// The TLS server name (SNI) is preferred if Obot terminated TLS itself, followed by the X-Forwarded-Host header of a
+// trusted proxy, and finally the Host header.
+func Host(req *http.Request, trustedProxies []*net.IPNet) string {
+	host := req.Host
+	if req.TLS != nil && req.TLS.ServerName != "" {
+		host = req.TLS.ServerName
+	} else if forwarded := forwardedHost(req, trustedProxies); forwarded != "" {
+		host = forwarded
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// forwardedHost returns the rightmost X-Forwarded-Host value if the request came directly from one of the trusted proxies.
+func forwardedHost(req *http.Request, trustedProxies []*net.IPNet) string {
+	if len(trustedProxies) == 0 {
+		return ""
+	}
+
+	host := lastHeaderValue(req.Header.Get("X-Forwarded-Host"))
+	if host == "" || strings.ContainsAny(host, "/\\@?#") || !fromTrustedProxy(req, trustedProxies) {
+		return ""
+	}
+
+	return host
+}
+
+// fromTrustedProxy returns whether the request came directly from one of the trusted proxies.
+func fromTrustedProxy(req *http.Request, trustedProxies []*net.IPNet) bool {
+	remoteIP := net.ParseIP(remoteHost(req))
+	return remoteIP != nil && slices.ContainsFunc(trustedProxies, func(proxy *net.IPNet) bool {
+		return proxy.Contains(remoteIP)
+	})
+}
+
+func remoteHost(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// lastHeaderValue returns the rightmost value of a comma-separated header. Like the rightmost X-Forwarded-For address
+// in GetSourceIP, it's the value set by the trusted proxy in front of Obot, while the values before it may have been
+// sent by the client.
+func lastHeaderValue(value string) string {
+	values := strings.Split(value, ",")
+	return strings.TrimSpace(values[len(values)-1])
+}
@@ -0,0 +1,134 @@
+package requestinfo
+
+import (
+	"crypto/tls"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.1", " 192.168.0.0/16 ", "", "::1"})
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+	assert.Equal(t, "10.0.0.1/32", proxies[0].String())
+	assert.Equal(t, "192.168.0.0/16", proxies[1].String())
+	assert.Equal(t, "::1/128", proxies[2].String())
+
+	_, err = ParseTrustedProxies([]string{"not-an-ip"})
+	assert.Error(t, err)
+
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+}
+
+func TestExternalBaseURL(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		remoteAddr     string
+		forwardedHost  string
+		forwardedProto string
+		proxies        bool
+		expected       string
+	}{
+		{
+			name:          "no trusted proxies",
+			remoteAddr:    "10.0.0.1:1234",
+			forwardedHost: "obot.internal.example.com",
+			expected:      "https://obot.example.com",
+		},
+		{
+			name:          "untrusted proxy",
+			remoteAddr:    "172.16.0.1:1234",
+			forwardedHost: "obot.internal.example.com",
+			proxies:       true,
+			expected:      "https://obot.example.com",
+		},
+		{
+			name:       "trusted proxy without forwarded host",
+			remoteAddr: "10.0.0.1:1234",
+			proxies:    true,
+			expected:   "https://obot.example.com",
+		},
+		{
+			name:           "trusted proxy",
+			remoteAddr:     "10.0.0.1:1234",
+			forwardedHost:  "spoofed.example.com, obot.internal.example.com:8443",
+			forwardedProto: "https, HTTP",
+			proxies:        true,
+			expected:       "http://obot.internal.example.com:8443",
+		},
+		{
+			name:           "trusted proxy with unknown proto",
+			remoteAddr:     "10.0.0.1:1234",
+			forwardedHost:  "obot.internal.example.com",
+			forwardedProto: "ws",
+			proxies:        true,
+			expected:       "https://obot.internal.example.com",
+		},
+		{
+			name:          "trusted proxy with invalid host",
+			remoteAddr:    "10.0.0.1:1234",
+			forwardedHost: "evil.example.com/path",
+			proxies:       true,
+			expected:      "https://obot.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/mcp-servers", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedHost != "" {
+				req.Header.Set("X-Forwarded-Host", tt.forwardedHost)
+			}
+			if tt.forwardedProto != "" {
+				req.Header.Set("X-Forwarded-Proto", tt.forwardedProto)
+			}
+
+			trusted := proxies
+			if !tt.proxies {
+				trusted = nil
+			}
+
+			assert.Equal(t, tt.expected, ExternalBaseURL(req, "https://obot.example.com", trusted))
+		})
+	}
+}
+
+func TestHost(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Host = "Jira.MCP.example.com:443"
+	req.RemoteAddr = "172.16.0.1:1234"
+	req.Header.Set("X-Forwarded-Host", "other.example.com")
+	assert.Equal(t, "jira.mcp.example.com", Host(req, proxies), "untrusted forwarded hosts should be ignored")
+
+	req.RemoteAddr = "10.0.0.1:1234"
+	assert.Equal(t, "other.example.com", Host(req, proxies))
+
+	req.TLS = &tls.ConnectionState{ServerName: "sni.example.com"}
+	assert.Equal(t, "sni.example.com", Host(req, proxies), "the TLS server name should be preferred")
+}
+
+func TestClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "https://obot.example.com/", nil)
+	req.RemoteAddr = "203.0.113.7:1234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	assert.Equal(t, "203.0.113.7", ClientIP(req, proxies), "untrusted clients can't choose their address")
+
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1, 203.0.113.7")
+	assert.Equal(t, "203.0.113.7", ClientIP(req, proxies))
+	assert.Equal(t, "10.0.0.1", ClientIP(req, nil))
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/obot-platform/obot/pkg/api/server/requestinfo"
 	"github.com/obot-platform/obot/pkg/auth"
 	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/mcp"
 	"github.com/obot-platform/obot/pkg/proxy"
 	"github.com/obot-platform/obot/pkg/storage"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -42,12 +45,14 @@ type Server struct {
 	rateLimiter    *ratelimiter.RateLimiter
 	baseURL        string
 	registryNoAuth bool
+	trustedProxies []*net.IPNet
+	connectAliases *connectAliases
 
 	mux         *http.ServeMux
 	otelHandler http.Handler
 }
 
-func NewServer(storageClient storage.Client, gatewayClient *gclient.Client, gptClient *gptscript.GPTScript, authn *authn.Authenticator, authz *authz.Authorizer, proxyManager *proxy.Manager, auditLogger audit.Logger, rateLimiter *ratelimiter.RateLimiter, baseURL string, registryNoAuth bool) *Server {
+func NewServer(storageClient storage.Client, gatewayClient *gclient.Client, gptClient *gptscript.GPTScript, authn *authn.Authenticator, authz *authz.Authorizer, proxyManager *proxy.Manager, auditLogger audit.Logger, rateLimiter *ratelimiter.RateLimiter, baseURL string, registryNoAuth bool, trustedProxies []*net.IPNet) *Server {
 	s := &Server{
 		storageClient:  storageClient,
 		gatewayClient:  gatewayClient,
@@ -59,6 +64,8 @@ func NewServer(storageClient storage.Client, gatewayClient *gclient.Client, gptC
 		auditLogger:    auditLogger,
 		rateLimiter:    rateLimiter,
 		registryNoAuth: registryNoAuth,
+		trustedProxies: trustedProxies,
+		connectAliases: newConnectAliases(storageClient, baseURL),
 		mux:            http.NewServeMux(),
 	}
 	s.otelHandler = otelhttp.NewHandler(
@@ -89,6 +96,7 @@ func (s *Server) HTTPHandle(pattern string, f http.Handler) {
 }
 
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.connectAliases.rewrite(r, s.trustedProxies)
 	s.otelHandler.ServeHTTP(w, r)
 }
 
@@ -205,15 +213,19 @@ func (s *Server) Wrap(f api.HandlerFunc) http.HandlerFunc {
 		}
 
 		err = f(api.Context{
-			ResponseWriter: rw,
-			Request:        req,
-			GPTClient:      s.gptClient,
-			Storage:        s.storageClient,
-			GatewayClient:  s.gatewayClient,
-			User:           user,
-			APIBaseURL:     s.baseURL,
+			ResponseWriter:  rw,
+			Request:         req,
+			GPTClient:       s.gptClient,
+			Storage:         s.storageClient,
+			GatewayClient:   s.gatewayClient,
+			User:            user,
+			APIBaseURL:      s.baseURL,
+			ExternalBaseURL: requestinfo.ExternalBaseURL(req, strings.TrimSuffix(s.baseURL, "/api"), s.trustedProxies),
 		})
-		if errHTTP := (*types.ErrHTTP)(nil); errors.As(err, &errHTTP) {
+		if errCircuitOpen := (*mcp.ErrCircuitOpen)(nil); errors.As(err, &errCircuitOpen) {
+			rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(errCircuitOpen.RetryAfter.Seconds()))))
+			http.Error(rw, errCircuitOpen.Error(), http.StatusServiceUnavailable)
+		} else if errHTTP := (*types.ErrHTTP)(nil); errors.As(err, &errHTTP) {
 			http.Error(rw, errHTTP.Message, errHTTP.Code)
 		} else if errStatus := (*apierrors.StatusError)(nil); errors.As(err, &errStatus) {
 			http.Error(rw, errStatus.Error(), int(errStatus.ErrStatus.Code))
@@ -0,0 +1,79 @@
+package auditlogexport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// DirectoryArchiver writes MCP audit log archives to a directory, such as a mounted workspace volume.
+type DirectoryArchiver struct {
+	dir string
+}
+
+func NewDirectoryArchiver(dir string) (*DirectoryArchiver, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create audit log archive directory: %w", err)
+	}
+	return &DirectoryArchiver{dir: dir}, nil
+}
+
+func (d *DirectoryArchiver) Archive(_ context.Context, name string, data []byte) error {
+	// Write to a temporary file first so that a partially written archive is never mistaken for a complete one.
+	tmp, err := os.CreateTemp(d.dir, "."+name+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(d.dir, name))
+}
+
+// StorageArchiver uploads MCP audit log archives to the storage that is configured for audit log exports.
+type StorageArchiver struct {
+	credProvider CredentialProvider
+	bucket       string
+	keyPrefix    string
+}
+
+func NewStorageArchiver(credProvider CredentialProvider, bucket, keyPrefix string) *StorageArchiver {
+	return &StorageArchiver{
+		credProvider: credProvider,
+		bucket:       bucket,
+		keyPrefix:    keyPrefix,
+	}
+}
+
+func (s *StorageArchiver) Archive(ctx context.Context, name string, data []byte) error {
+	// The storage credentials can change at any time, so look them up for every archive.
+	storageConfig, err := s.credProvider.GetStorageConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get storage config: %w", err)
+	}
+	if storageConfig == nil {
+		return fmt.Errorf("storage config is nil")
+	}
+
+	providerType, err := ProviderType(*storageConfig)
+	if err != nil {
+		return err
+	}
+
+	provider, err := NewStorageProvider(providerType, s.credProvider)
+	if err != nil {
+		return fmt.Errorf("failed to create storage provider: %w", err)
+	}
+
+	return provider.Upload(ctx, *storageConfig, s.bucket, path.Join(s.keyPrefix, name), bytes.NewReader(data))
+}
@@ -45,6 +45,22 @@ func (a *blobStoreAdapter) Upload(ctx context.Context, config types.StorageConfi
 	return store.Upload(ctx, bucket, key, data)
 }
 
+// ProviderType returns the type of the storage provider that the config is for.
+func ProviderType(config types.StorageConfig) (types.StorageProviderType, error) {
+	switch {
+	case config.S3Config != nil:
+		return types.StorageProviderS3, nil
+	case config.GCSConfig != nil:
+		return types.StorageProviderGCS, nil
+	case config.AzureConfig != nil:
+		return types.StorageProviderAzureBlob, nil
+	case config.CustomS3Config != nil:
+		return types.StorageProviderCustomS3, nil
+	default:
+		return "", fmt.Errorf("invalid storage config, no storage provider found")
+	}
+}
+
 // NewStorageProvider creates a storage provider instance based on the provider type.
 func NewStorageProvider(providerType types.StorageProviderType, _ CredentialProvider) (StorageProvider, error) {
 	switch providerType {
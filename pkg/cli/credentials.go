@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/cmd"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type Credentials struct{}
+
+func (c *Credentials) Customize(cmd *cobra.Command) {
+	cmd.Use = "credentials"
+	cmd.Short = "Back up and restore the credentials of multi-user MCP servers"
+	cmd.Args = cobra.NoArgs
+}
+
+func (c *Credentials) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func newCredentialsCommand(root *Obot) *cobra.Command {
+	return cmd.Command(&Credentials{},
+		&CredentialsBackup{root: root},
+		&CredentialsRestore{root: root},
+		&CredentialsRotateKey{root: root},
+	)
+}
+
+type CredentialsBackup struct {
+	Key        string   `usage:"Key to encrypt the backup with. When empty, the backup is encrypted with the server's encryption provider" env:"OBOT_CREDENTIAL_BACKUP_KEY"`
+	Output     string   `usage:"File to write the backup to (default stdout)" short:"o"`
+	MCPServers []string `usage:"IDs of the MCP servers to back up (default all)" name:"mcp-server"`
+
+	root *Obot
+}
+
+func (c *CredentialsBackup) Customize(cmd *cobra.Command) {
+	cmd.Use = "backup"
+	cmd.Short = "Export the encrypted credentials of multi-user MCP servers"
+	cmd.Args = cobra.NoArgs
+}
+
+func (c *CredentialsBackup) Run(cmd *cobra.Command, _ []string) error {
+	backup, err := c.root.Client.BackupCredentials(cmd.Context(), types.CredentialBackupRequest{
+		Key:          c.Key,
+		MCPServerIDs: c.MCPServers,
+	})
+	if err != nil {
+		return fmt.Errorf("back up credentials: %w", err)
+	}
+
+	data, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if c.Output == "" {
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+		return err
+	}
+
+	if err = os.WriteFile(c.Output, data, 0600); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Backed up %d credentials to %s\n", len(backup.Entries), c.Output)
+	return nil
+}
+
+type CredentialsRestore struct {
+	Key     string   `usage:"Key the backup was encrypted with" env:"OBOT_CREDENTIAL_BACKUP_KEY"`
+	Entries []string `usage:"Entries to restore, as ID or ID=TARGET_MCP_SERVER_ID (default all)" name:"entry"`
+	List    bool     `usage:"List the entries of the backup without restoring them"`
+
+	root *Obot
+}
+
+func (c *CredentialsRestore) Customize(cmd *cobra.Command) {
+	cmd.Use = "restore [flags] BACKUP_FILE"
+	cmd.Short = "Restore credentials of multi-user MCP servers from a backup"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (c *CredentialsRestore) Run(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var backup types.CredentialBackup
+	if err = json.Unmarshal(data, &backup); err != nil {
+		return fmt.Errorf("invalid backup file %s: %w", args[0], err)
+	}
+
+	if c.List {
+		for _, entry := range backup.Entries {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\n", entry.ID, entry.MCPServerName, strings.Join(entry.EnvVars, ","))
+		}
+		return nil
+	}
+
+	request := types.CredentialRestoreRequest{
+		Backup: backup,
+		Key:    c.Key,
+	}
+	for _, entry := range c.Entries {
+		id, target, _ := strings.Cut(entry, "=")
+		request.Entries = append(request.Entries, types.CredentialRestoreEntry{
+			ID:                id,
+			TargetMCPServerID: target,
+		})
+	}
+
+	results, err := c.root.Client.RestoreCredentials(cmd.Context(), request)
+	if err != nil {
+		return fmt.Errorf("restore credentials: %w", err)
+	}
+
+	var failed int
+	for _, result := range results.Items {
+		if result.Restored {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: restored to %s\n", result.ID, result.TargetMCPServerID)
+		} else {
+			failed++
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", result.ID, result.Error)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to restore %d of %d credentials", failed, len(results.Items))
+	}
+	return nil
+}
+
+type CredentialsRotateKey struct {
+	Wait bool `usage:"Wait for the rotation to complete"`
+
+	root *Obot
+}
+
+func (c *CredentialsRotateKey) Customize(cmd *cobra.Command) {
+	cmd.Use = "rotate-key"
+	cmd.Short = "Re-encrypt stored secrets with the current key of the encryption provider"
+	cmd.Args = cobra.NoArgs
+}
+
+func (c *CredentialsRotateKey) Run(cmd *cobra.Command, _ []string) error {
+	rotation, err := c.root.Client.RotateEncryptionKey(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("start key rotation: %w", err)
+	}
+
+	for c.Wait && (rotation.State == types.KeyRotationStatePending || rotation.State == types.KeyRotationStateRunning) {
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(2 * time.Second):
+		}
+
+		if rotation, err = c.root.Client.GetEncryptionKeyRotation(cmd.Context()); err != nil {
+			return fmt.Errorf("get key rotation: %w", err)
+		}
+	}
+
+	for _, resource := range rotation.Resources {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\tdone=%t\ttotal=%d\treencrypted=%d\tfailed=%d\t%s\n",
+			resource.Resource, resource.Done, resource.Total, resource.Reencrypted, resource.Failed, resource.Error)
+	}
+
+	if rotation.State == types.KeyRotationStateFailed {
+		return fmt.Errorf("key rotation failed: %s", rotation.Error)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Key rotation %s\n", rotation.State)
+	return nil
+}
@@ -46,6 +46,7 @@ func New() *cobra.Command {
 		&Login{root: root},
 		&Logout{root: root},
 		&Scan{root: root},
+		newCredentialsCommand(root),
 		&Version{},
 	)
 }
@@ -266,6 +266,10 @@ func (c *Controller) PostStart(ctx context.Context, client kclient.Client) {
 		panic(fmt.Errorf("failed to set up default mcp catalog: %w", err))
 	}
 
+	if err := c.ensureManagedCatalogEntries(ctx, client); err != nil {
+		panic(fmt.Errorf("failed to ensure Obot-managed MCP server catalog entries: %w", err))
+	}
+
 	if err := c.mcpCatalogHandler.SetUpDefaultSystemMCPCatalog(ctx, client); err != nil {
 		panic(fmt.Errorf("failed to set up default system mcp catalog: %w", err))
 	}
@@ -281,6 +285,18 @@ func (c *Controller) PostStart(ctx context.Context, client kclient.Client) {
 	go c.retriggerCatalogEntries(ctx, client)
 
 	go c.runServiceAccountKeyRotation(ctx)
+
+	// Only the leader rotates the key that tokens are signed with, so that replicas don't rotate it at once.
+	go c.services.PersistentTokenServer.RunKeyRotation(ctx, c.services.OAuthSigningKeyRotationPeriod)
+
+	// Archiving old audit logs isn't idempotent, so only the leader applies the retention policy.
+	go c.services.GatewayClient.RunAuditLogRetention(ctx, c.services.MCPAuditLogRetentionDays, c.services.MCPAuditLogArchiver)
+
+	// Only the leader copies data to the new schema of expand/contract migrations, so that batches don't conflict.
+	go c.services.GatewayClient.RunSchemaTransitionBackfills(ctx, time.Minute, c.services.DBMigrationAutoContract)
+
+	// Only the leader re-encrypts stored secrets, so that a new leader resumes a rotation that was interrupted.
+	go c.services.GatewayClient.RunKeyRotations(ctx, c.services.GPTClient.RecreateAllCredentials)
 }
 
 // retriggerCatalogEntries touches all MCPServerCatalogEntries to trigger their handlers,
@@ -0,0 +1,422 @@
+package alertrule
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
+	"github.com/obot-platform/obot/logger"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+const (
+	defaultWindow       = 15 * time.Minute
+	defaultBaselineDays = 7
+	notificationTimeout = 10 * time.Second
+
+	// toolCallType is the call type of the audit log entries that the error-rate and latency conditions look at.
+	toolCallType = "tools/call"
+)
+
+// Handler evaluates alert rules against the health of MCP servers and their audit logs, so that alerting, and
+// detecting unusual tool usage by users, works without an external monitoring stack.
+type Handler struct {
+	gptClient     *gptscript.GPTScript
+	gatewayClient *gclient.Client
+	httpClient    *http.Client
+	interval      time.Duration
+}
+
+func New(gptClient *gptscript.GPTScript, gatewayClient *gclient.Client, interval time.Duration) *Handler {
+	if interval <= 0 {
+		log.Infof("Alert rule evaluation: disabled")
+	} else {
+		log.Infof("Alert rule evaluation: every %s", interval)
+	}
+
+	return &Handler{
+		gptClient:     gptClient,
+		gatewayClient: gatewayClient,
+		httpClient:    &http.Client{Timeout: notificationTimeout},
+		interval:      interval,
+	}
+}
+
+// measurement is the value of the metric of an alert rule for one MCP server, or for one user if the rule's condition
+// fires for users.
+type measurement struct {
+	mcpID       string
+	userID      string
+	displayName string
+	value       float64
+}
+
+// subject returns the MCP server or user that the measurement is for.
+func (m measurement) subject() string {
+	return cmp.Or(m.mcpID, m.userID)
+}
+
+// Evaluate measures the condition of the rule for each MCP server that it applies to, and notifies the rule's webhook
+// when the rule starts or stops firing for a server.
+func (h *Handler) Evaluate(req router.Request, resp router.Response) error {
+	rule := req.Object.(*v1.AlertRule)
+	if h.interval <= 0 || !rule.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if rule.Spec.Manifest.Disabled {
+		if len(rule.Status.Firing) == 0 && rule.Status.Error == "" {
+			return nil
+		}
+		rule.Status.Firing = nil
+		rule.Status.Error = ""
+		return req.Client.Status().Update(req.Ctx, rule)
+	}
+
+	if since := time.Since(rule.Status.LastEvaluatedAt.Time); since < h.interval {
+		resp.RetryAfter(h.interval - since)
+		return nil
+	}
+
+	now := time.Now()
+	rule.Status.LastEvaluatedAt = metav1.NewTime(now)
+	resp.RetryAfter(h.interval)
+
+	measurements, err := h.measure(req.Ctx, req.Client, rule, now)
+	if err != nil {
+		rule.Status.Error = fmt.Sprintf("failed to evaluate rule: %v", err)
+		return req.Client.Status().Update(req.Ctx, rule)
+	}
+
+	firing, events := evaluate(rule.Spec.Manifest, rule.Status.Firing, measurements, now)
+	if len(events) > 0 {
+		if err = h.notify(req.Ctx, rule, events); err != nil {
+			// Keep the previous state so that the notification is sent again on the next evaluation.
+			log.Warnf("Failed to send alert notification: rule=%s error=%v", rule.Name, err)
+			rule.Status.Error = fmt.Sprintf("failed to send notification: %v", err)
+			return req.Client.Status().Update(req.Ctx, rule)
+		}
+		log.Infof("Sent alert notification: rule=%s events=%d", rule.Name, len(events))
+	}
+
+	rule.Status.Firing = firing
+	rule.Status.Error = ""
+	return req.Client.Status().Update(req.Ctx, rule)
+}
+
+func (h *Handler) measure(ctx context.Context, client kclient.Client, rule *v1.AlertRule, now time.Time) ([]measurement, error) {
+	manifest := rule.Spec.Manifest
+	switch manifest.Condition {
+	case types.AlertRuleConditionServerHealth, types.AlertRuleConditionRestartCount:
+		var servers v1.MCPServerList
+		if err := client.List(ctx, &servers, kclient.InNamespace(rule.Namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+		}
+
+		var measurements []measurement
+		for _, server := range servers.Items {
+			if server.Spec.Template || !server.DeletionTimestamp.IsZero() || !appliesTo(manifest, server.Name) {
+				continue
+			}
+
+			m := measurement{mcpID: server.Name, displayName: server.Spec.Manifest.Name}
+			if manifest.Condition == types.AlertRuleConditionServerHealth {
+				m.value = float64(server.Status.ConsecutiveProbeFailures)
+			} else {
+				m.value = float64(server.Status.RestartCount)
+			}
+			measurements = append(measurements, m)
+		}
+		return measurements, nil
+	case types.AlertRuleConditionErrorRate, types.AlertRuleConditionLatency:
+		if h.gatewayClient == nil {
+			return nil, errors.New("audit logs are not available")
+		}
+
+		window := defaultWindow
+		if manifest.WindowMinutes > 0 {
+			window = time.Duration(manifest.WindowMinutes) * time.Minute
+		}
+		minCalls := int64(max(manifest.MinCalls, 1))
+		opts := gclient.MCPAuditLogOptions{
+			MCPID:     manifest.MCPServerIDs,
+			CallType:  []string{toolCallType},
+			StartTime: now.Add(-window),
+			EndTime:   now,
+		}
+
+		var measurements []measurement
+		if manifest.Condition == types.AlertRuleConditionErrorRate {
+			stats, err := h.gatewayClient.GetMCPErrorRates(ctx, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get error rates: %w", err)
+			}
+			for _, s := range stats {
+				if s.CallCount >= minCalls {
+					measurements = append(measurements, measurement{mcpID: s.MCPID, displayName: s.MCPServerDisplayName, value: types.ErrorRate(s.ErrorCount, s.CallCount)})
+				}
+			}
+		} else {
+			stats, err := h.gatewayClient.GetMCPLatencies(ctx, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get latencies: %w", err)
+			}
+			for _, s := range stats {
+				if s.CallCount >= minCalls {
+					measurements = append(measurements, measurement{mcpID: s.MCPID, displayName: s.MCPServerDisplayName, value: s.AverageProcessingTimeMs})
+				}
+			}
+		}
+		return measurements, nil
+	case types.AlertRuleConditionUserCallSpike, types.AlertRuleConditionUserOffHoursCalls, types.AlertRuleConditionUserServerCount:
+		if h.gatewayClient == nil {
+			return nil, errors.New("audit logs are not available")
+		}
+
+		measurements, err := h.measureUsers(ctx, manifest, now)
+		if err != nil {
+			return nil, err
+		}
+		for i, m := range measurements {
+			if user, err := h.gatewayClient.UserByID(ctx, m.userID); err == nil {
+				measurements[i].displayName = cmp.Or(user.DisplayName, user.Username)
+			}
+		}
+		return measurements, nil
+	default:
+		return nil, fmt.Errorf("unsupported condition %q", manifest.Condition)
+	}
+}
+
+// measureUsers measures the tool usage of each user who called tools in the window for the conditions that detect
+// unusual usage.
+func (h *Handler) measureUsers(ctx context.Context, manifest types.AlertRuleManifest, now time.Time) ([]measurement, error) {
+	window := defaultWindow
+	if manifest.WindowMinutes > 0 {
+		window = time.Duration(manifest.WindowMinutes) * time.Minute
+	}
+	minCalls := int64(max(manifest.MinCalls, 1))
+	opts := gclient.MCPAuditLogOptions{
+		MCPID:     manifest.MCPServerIDs,
+		CallType:  []string{toolCallType},
+		StartTime: now.Add(-window),
+		EndTime:   now,
+	}
+
+	stats, err := h.gatewayClient.GetMCPUserCallStats(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tool calls per user: %w", err)
+	}
+
+	var measurements []measurement
+	switch manifest.Condition {
+	case types.AlertRuleConditionUserServerCount:
+		for _, s := range stats {
+			if s.CallCount >= minCalls {
+				measurements = append(measurements, measurement{userID: s.UserID, value: float64(s.ServerCount)})
+			}
+		}
+	case types.AlertRuleConditionUserCallSpike:
+		baselineDays := manifest.BaselineDays
+		if baselineDays <= 0 {
+			baselineDays = defaultBaselineDays
+		}
+		baseline := time.Duration(baselineDays) * 24 * time.Hour
+
+		baselineOpts := opts
+		baselineOpts.StartTime = opts.StartTime.Add(-baseline)
+		baselineOpts.EndTime = opts.StartTime
+		baselineStats, err := h.gatewayClient.GetMCPUserCallStats(ctx, baselineOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get baseline tool calls per user: %w", err)
+		}
+
+		baselineCalls := make(map[string]int64, len(baselineStats))
+		for _, s := range baselineStats {
+			baselineCalls[s.UserID] = s.CallCount
+		}
+		for _, s := range stats {
+			if s.CallCount >= minCalls {
+				measurements = append(measurements, measurement{userID: s.UserID, value: callSpike(s.CallCount, baselineCalls[s.UserID], window, baseline)})
+			}
+		}
+	case types.AlertRuleConditionUserOffHoursCalls:
+		hourly, err := h.gatewayClient.GetMCPUserHourlyCallStats(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hourly tool calls per user: %w", err)
+		}
+
+		offHours, err := offHoursCalls(*manifest.ActiveHours, hourly)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stats {
+			if s.CallCount >= minCalls {
+				measurements = append(measurements, measurement{userID: s.UserID, value: float64(offHours[s.UserID])})
+			}
+		}
+	}
+	return measurements, nil
+}
+
+// callSpike returns how many times more calls a user made in the window than their average in a window of the same
+// length over the baseline. Users without calls in the baseline are compared to one call per window, so that a new
+// user who makes many calls at once is also detected.
+func callSpike(calls, baselineCalls int64, window, baseline time.Duration) float64 {
+	average := float64(baselineCalls) * float64(window) / float64(baseline)
+	return float64(calls) / max(average, 1)
+}
+
+// offHoursCalls counts the calls of each user in the hours that are outside the active hours.
+func offHoursCalls(activeHours types.AlertRuleActiveHours, hourly []gatewaytypes.MCPUserHourlyCallStat) (map[string]int64, error) {
+	loc, err := activeHours.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	calls := make(map[string]int64)
+	for _, s := range hourly {
+		hour, err := time.ParseInLocation("2006-01-02 15", s.Hour, time.UTC)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hour %q: %w", s.Hour, err)
+		}
+		if !activeHours.Contains(hour.In(loc).Hour()) {
+			calls[s.UserID] += s.CallCount
+		}
+	}
+	return calls, nil
+}
+
+func appliesTo(manifest types.AlertRuleManifest, mcpID string) bool {
+	return len(manifest.MCPServerIDs) == 0 || slices.Contains(manifest.MCPServerIDs, mcpID)
+}
+
+// evaluate returns the servers or users that the rule fires for, given the current measurements, and the events for
+// those that the rule started or stopped firing for since the previous evaluation.
+func evaluate(manifest types.AlertRuleManifest, previous []types.AlertRuleFiring, measurements []measurement, now time.Time) ([]types.AlertRuleFiring, []types.AlertEvent) {
+	previouslyFiring := make(map[string]types.AlertRuleFiring, len(previous))
+	for _, f := range previous {
+		previouslyFiring[firingSubject(f)] = f
+	}
+
+	var (
+		firing  []types.AlertRuleFiring
+		events  []types.AlertEvent
+		current = make(map[string]measurement, len(measurements))
+	)
+	for _, m := range measurements {
+		current[m.subject()] = m
+		if m.value <= manifest.Threshold {
+			continue
+		}
+
+		f := m.firing(now)
+		if p, ok := previouslyFiring[m.subject()]; ok {
+			f.Since = p.Since
+		} else {
+			events = append(events, firingEvent(types.AlertEventTypeFiring, f, m.value, now))
+		}
+		firing = append(firing, f)
+	}
+
+	for _, p := range previous {
+		if m, ok := current[firingSubject(p)]; ok && m.value > manifest.Threshold {
+			continue
+		}
+
+		// Servers and users without a measurement, such as deleted servers or servers and users without recent calls,
+		// are resolved.
+		events = append(events, firingEvent(types.AlertEventTypeResolved, p, current[firingSubject(p)].value, now))
+	}
+
+	return firing, events
+}
+
+// firing returns the state of a rule that fires for the measured server or user.
+func (m measurement) firing(now time.Time) types.AlertRuleFiring {
+	f := types.AlertRuleFiring{
+		MCPID:  m.mcpID,
+		UserID: m.userID,
+		Value:  m.value,
+		Since:  types.Time{Time: now},
+	}
+	if m.userID != "" {
+		f.UserDisplayName = m.displayName
+	} else {
+		f.MCPServerDisplayName = m.displayName
+	}
+	return f
+}
+
+func firingSubject(f types.AlertRuleFiring) string {
+	return cmp.Or(f.MCPID, f.UserID)
+}
+
+func firingEvent(eventType types.AlertEventType, f types.AlertRuleFiring, value float64, now time.Time) types.AlertEvent {
+	return types.AlertEvent{
+		Type:                 eventType,
+		MCPID:                f.MCPID,
+		MCPServerDisplayName: f.MCPServerDisplayName,
+		UserID:               f.UserID,
+		UserDisplayName:      f.UserDisplayName,
+		Value:                value,
+		Time:                 types.Time{Time: now},
+	}
+}
+
+func (h *Handler) notify(ctx context.Context, rule *v1.AlertRule, events []types.AlertEvent) error {
+	body, err := json.Marshal(types.AlertNotification{
+		AlertRuleID:   rule.Name,
+		AlertRuleName: rule.Spec.Manifest.DisplayName,
+		Condition:     rule.Spec.Manifest.Condition,
+		Threshold:     rule.Spec.Manifest.Threshold,
+		Events:        events,
+	})
+	if err != nil {
+		return err
+	}
+
+	cred, err := h.gptClient.RevealCredential(ctx, []string{system.AlertRuleCredentialContext}, rule.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to reveal credential: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.Spec.Manifest.Notification.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret := cred.Env["secret"]; secret != "" {
+		webhooksignature.SetHeaders(req.Header, secret, time.Now(), body)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package alertrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestEvaluate(t *testing.T) {
+	manifest := types.AlertRuleManifest{Condition: types.AlertRuleConditionErrorRate, Threshold: 0.1}
+	earlier := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	now := earlier.Add(time.Minute)
+
+	previous := []types.AlertRuleFiring{
+		{MCPID: "ms1still", Value: 0.5, Since: types.Time{Time: earlier}},
+		{MCPID: "ms1recovered", Value: 0.5, Since: types.Time{Time: earlier}},
+		{MCPID: "ms1gone", Value: 0.5, Since: types.Time{Time: earlier}},
+	}
+	measurements := []measurement{
+		{mcpID: "ms1still", value: 0.3},
+		{mcpID: "ms1recovered", value: 0.1},
+		{mcpID: "ms1new", displayName: "New", value: 0.2},
+		{mcpID: "ms1fine", value: 0},
+	}
+
+	firing, events := evaluate(manifest, previous, measurements, now)
+
+	wantFiring := []types.AlertRuleFiring{
+		{MCPID: "ms1still", Value: 0.3, Since: types.Time{Time: earlier}},
+		{MCPID: "ms1new", MCPServerDisplayName: "New", Value: 0.2, Since: types.Time{Time: now}},
+	}
+	if len(firing) != len(wantFiring) {
+		t.Fatalf("evaluate() firing = %+v, want %+v", firing, wantFiring)
+	}
+	for i := range wantFiring {
+		if firing[i] != wantFiring[i] {
+			t.Errorf("evaluate() firing[%d] = %+v, want %+v", i, firing[i], wantFiring[i])
+		}
+	}
+
+	wantEvents := []types.AlertEvent{
+		{Type: types.AlertEventTypeFiring, MCPID: "ms1new", MCPServerDisplayName: "New", Value: 0.2, Time: types.Time{Time: now}},
+		{Type: types.AlertEventTypeResolved, MCPID: "ms1recovered", Value: 0.1, Time: types.Time{Time: now}},
+		{Type: types.AlertEventTypeResolved, MCPID: "ms1gone", Time: types.Time{Time: now}},
+	}
+	if len(events) != len(wantEvents) {
+		t.Fatalf("evaluate() events = %+v, want %+v", events, wantEvents)
+	}
+	for i := range wantEvents {
+		if events[i] != wantEvents[i] {
+			t.Errorf("evaluate() events[%d] = %+v, want %+v", i, events[i], wantEvents[i])
+		}
+	}
+}
+
+func TestEvaluateUsers(t *testing.T) {
+	manifest := types.AlertRuleManifest{Condition: types.AlertRuleConditionUserServerCount, Threshold: 3}
+	earlier := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	now := earlier.Add(time.Minute)
+
+	previous := []types.AlertRuleFiring{
+		{UserID: "u1calm", UserDisplayName: "Calm", Value: 5, Since: types.Time{Time: earlier}},
+	}
+	measurements := []measurement{
+		{userID: "u1calm", displayName: "Calm", value: 1},
+		{userID: "u1busy", displayName: "Busy", value: 8},
+	}
+
+	firing, events := evaluate(manifest, previous, measurements, now)
+
+	wantFiring := []types.AlertRuleFiring{
+		{UserID: "u1busy", UserDisplayName: "Busy", Value: 8, Since: types.Time{Time: now}},
+	}
+	if len(firing) != len(wantFiring) || firing[0] != wantFiring[0] {
+		t.Fatalf("evaluate() firing = %+v, want %+v", firing, wantFiring)
+	}
+
+	wantEvents := []types.AlertEvent{
+		{Type: types.AlertEventTypeFiring, UserID: "u1busy", UserDisplayName: "Busy", Value: 8, Time: types.Time{Time: now}},
+		{Type: types.AlertEventTypeResolved, UserID: "u1calm", UserDisplayName: "Calm", Value: 1, Time: types.Time{Time: now}},
+	}
+	if len(events) != len(wantEvents) {
+		t.Fatalf("evaluate() events = %+v, want %+v", events, wantEvents)
+	}
+	for i := range wantEvents {
+		if events[i] != wantEvents[i] {
+			t.Errorf("evaluate() events[%d] = %+v, want %+v", i, events[i], wantEvents[i])
+		}
+	}
+}
+
+func TestCallSpike(t *testing.T) {
+	window := 15 * time.Minute
+	baseline := 7 * 24 * time.Hour
+
+	// 672 calls over a week is an average of one call per 15 minutes.
+	if got := callSpike(10, 672*2, window, baseline); got != 5 {
+		t.Errorf("callSpike() = %v, want 5", got)
+	}
+	// Users without a baseline are compared to one call per window.
+	if got := callSpike(30, 0, window, baseline); got != 30 {
+		t.Errorf("callSpike() without a baseline = %v, want 30", got)
+	}
+}
+
+func TestOffHoursCalls(t *testing.T) {
+	activeHours := types.AlertRuleActiveHours{Start: 8, End: 18, TimeZone: "America/New_York"}
+	hourly := []gatewaytypes.MCPUserHourlyCallStat{
+		// 9 AM and 5 PM in New York.
+		{Hour: "2026-03-02 14", UserID: "u1", CallCount: 4},
+		{Hour: "2026-03-02 22", UserID: "u1", CallCount: 1},
+		// 6 PM and 3 AM in New York.
+		{Hour: "2026-03-02 23", UserID: "u1", CallCount: 2},
+		{Hour: "2026-03-03 08", UserID: "u2", CallCount: 7},
+	}
+
+	calls, err := offHoursCalls(activeHours, hourly)
+	if err != nil {
+		t.Fatalf("offHoursCalls() error = %v", err)
+	}
+	if calls["u1"] != 2 || calls["u2"] != 7 {
+		t.Errorf("offHoursCalls() = %v, want u1=2 u2=7", calls)
+	}
+
+	overnight := types.AlertRuleActiveHours{Start: 22, End: 6}
+	for hour, want := range map[int]bool{21: false, 22: true, 2: true, 6: false} {
+		if got := overnight.Contains(hour); got != want {
+			t.Errorf("Contains(%d) = %v, want %v", hour, got, want)
+		}
+	}
+}
@@ -72,17 +72,9 @@ func (h *Handler) performExport(ctx context.Context, export *v1.AuditLogExport)
 		return fmt.Errorf("storage config is nil")
 	}
 
-	var provider types.StorageProviderType
-	if storageConfig.S3Config != nil {
-		provider = types.StorageProviderS3
-	} else if storageConfig.GCSConfig != nil {
-		provider = types.StorageProviderGCS
-	} else if storageConfig.AzureConfig != nil {
-		provider = types.StorageProviderAzureBlob
-	} else if storageConfig.CustomS3Config != nil {
-		provider = types.StorageProviderCustomS3
-	} else {
-		return fmt.Errorf("invalid storage config, no storage provider found")
+	provider, err := auditlogexport.ProviderType(*storageConfig)
+	if err != nil {
+		return err
 	}
 
 	// Create storage provider
@@ -154,7 +154,7 @@ func (c *Credentials) RemoveMCPCredentials(req router.Request, _ router.Response
 	}
 
 	creds, err := c.gClient.ListCredentials(req.Ctx, gptscript.ListCredentialsOptions{
-		CredentialContexts: []string{credCtx},
+		CredentialContexts: []string{credCtx, system.MCPRotatingCredentialContext(credCtx)},
 	})
 	if err != nil {
 		return err
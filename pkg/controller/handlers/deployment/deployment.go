@@ -111,6 +111,7 @@ func (h *Handler) UpdateMCPServerStatus(req router.Request, _ router.Response) e
 
 	// Manage NeedsK8sUpdate flag for K8s-compatible runtimes
 	isK8sRuntime := mcpServer.Spec.Manifest.Runtime == types.RuntimeContainerized ||
+		mcpServer.Spec.Manifest.Runtime == types.RuntimeSource ||
 		mcpServer.Spec.Manifest.Runtime == types.RuntimeUVX ||
 		mcpServer.Spec.Manifest.Runtime == types.RuntimeNPX
 
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -207,6 +208,18 @@ func isPathSafe(path, baseDir string) error {
 // also accepted for backward compatibility.
 // Returns (cloneURL, branch, error).
 func parseGitURL(catalogURL string) (string, string, error) {
+	cloneURL, branch, err := splitGitURL(catalogURL)
+	if err != nil {
+		return "", "", err
+	}
+	if branch == "" {
+		branch = "main"
+	}
+	return cloneURL, branch, nil
+}
+
+// splitGitURL splits a git repository URL into the clone URL and the branch in its path, if there is one.
+func splitGitURL(catalogURL string) (string, string, error) {
 	u, err := url.Parse(catalogURL)
 	if err != nil {
 		return "", "", fmt.Errorf("invalid git URL: %w", err)
@@ -257,11 +270,66 @@ func parseGitURL(catalogURL string) (string, string, error) {
 		}
 	}
 
-	if branch == "" {
-		branch = "main"
+	return fmt.Sprintf("https://%s/%s", u.Host, repoPath), branch, nil
+}
+
+// gitSource is a Git repository that catalog entries are read from.
+type gitSource struct {
+	cloneURL string
+	ref      plumbing.ReferenceName
+	// dir is the directory of the repository that the entries are read from, relative to its root.
+	dir string
+}
+
+// parseGitSource parses a Git source URL. In addition to the branch in the URL path supported by parseGitURL, the
+// branch or tag query parameter selects the ref to read, and the path query parameter selects the directory of the
+// repository to read, such as https://github.com/org/repo?tag=v1.2.0&path=servers.
+func parseGitSource(catalogURL string) (gitSource, error) {
+	cloneURL, branch, err := splitGitURL(catalogURL)
+	if err != nil {
+		return gitSource{}, err
 	}
 
-	return fmt.Sprintf("https://%s/%s", u.Host, repoPath), branch, nil
+	u, err := url.Parse(catalogURL)
+	if err != nil {
+		return gitSource{}, fmt.Errorf("invalid git URL: %w", err)
+	}
+	query := u.Query()
+
+	source := gitSource{
+		cloneURL: cloneURL,
+		ref:      plumbing.NewBranchReferenceName("main"),
+	}
+	tag, queryBranch := query.Get("tag"), query.Get("branch")
+	if (tag != "" && queryBranch != "") || ((tag != "" || queryBranch != "") && branch != "") {
+		return gitSource{}, fmt.Errorf("invalid git URL, only one branch or tag can be selected")
+	}
+	switch {
+	case tag != "":
+		if err := validateBranchName(tag); err != nil {
+			return gitSource{}, fmt.Errorf("invalid tag name: %w", err)
+		}
+		source.ref = plumbing.NewTagReferenceName(tag)
+	case queryBranch != "":
+		if err := validateBranchName(queryBranch); err != nil {
+			return gitSource{}, fmt.Errorf("invalid branch name: %w", err)
+		}
+		source.ref = plumbing.NewBranchReferenceName(queryBranch)
+	case branch != "":
+		source.ref = plumbing.NewBranchReferenceName(branch)
+	}
+
+	if dir := query.Get("path"); dir != "" {
+		dir = path.Clean(strings.Trim(dir, "/"))
+		if dir == ".." || strings.HasPrefix(dir, "../") || strings.Contains(dir, "\\") {
+			return gitSource{}, fmt.Errorf("invalid path: %s", query.Get("path"))
+		}
+		if dir != "." {
+			source.dir = dir
+		}
+	}
+
+	return source, nil
 }
 
 func readGitCatalogEntries[T any](ctx context.Context, catalogURL string, token string) ([]T, error) {
@@ -278,10 +346,11 @@ func readGitCatalogEntries[T any](ctx context.Context, catalogURL string, token
 		return nil, fmt.Errorf("invalid git URL: %w", err)
 	}
 
-	cloneURL, branch, err := parseGitURL(catalogURL)
+	source, err := parseGitSource(catalogURL)
 	if err != nil {
 		return nil, err
 	}
+	cloneURL := source.cloneURL
 
 	// Per-URL token takes precedence over the global GITHUB_AUTH_TOKEN env var.
 	effectiveToken := resolveToken(token)
@@ -314,7 +383,7 @@ func readGitCatalogEntries[T any](ctx context.Context, catalogURL string, token
 	cloneOptions := &git.CloneOptions{
 		URL:           cloneURL,
 		Depth:         1,
-		ReferenceName: plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", branch)),
+		ReferenceName: source.ref,
 	}
 
 	if effectiveToken != "" {
@@ -338,5 +407,37 @@ func readGitCatalogEntries[T any](ctx context.Context, catalogURL string, token
 		return nil, fmt.Errorf("failed to clone repository: %w", err)
 	}
 
-	return readCatalogDirectory[T](tempDir)
+	dir := tempDir
+	if source.dir != "" {
+		if dir, err = gitSourceDir(tempDir, source.dir); err != nil {
+			return nil, err
+		}
+	}
+
+	return readCatalogDirectory[T](dir)
+}
+
+// gitSourceDir returns the directory of a cloned repository that entries are read from. The directory and its parents
+// can't be symbolic links, so that it can't point outside the repository.
+func gitSourceDir(repoDir, dir string) (string, error) {
+	root, err := filepath.EvalSymlinks(repoDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve repository directory: %w", err)
+	}
+
+	want := filepath.Join(root, filepath.FromSlash(dir))
+	resolved, err := filepath.EvalSymlinks(want)
+	if err != nil {
+		return "", fmt.Errorf("path %s not found in repository: %w", dir, err)
+	}
+	if resolved != want {
+		return "", fmt.Errorf("path %s can't contain symbolic links", dir)
+	}
+
+	if info, err := os.Stat(resolved); err != nil {
+		return "", fmt.Errorf("failed to get info of path %s: %w", dir, err)
+	} else if !info.IsDir() {
+		return "", fmt.Errorf("path %s is not a directory", dir)
+	}
+	return resolved, nil
 }
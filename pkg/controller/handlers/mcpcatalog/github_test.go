@@ -2,10 +2,14 @@ package mcpcatalog
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsGitRepoURL(t *testing.T) {
@@ -110,6 +114,96 @@ func TestParseGitURL(t *testing.T) {
 	}
 }
 
+func TestParseGitSource(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		wantClone string
+		wantRef   plumbing.ReferenceName
+		wantDir   string
+		wantErr   bool
+	}{
+		{
+			name:      "default branch",
+			url:       "https://github.com/org/repo",
+			wantClone: "https://github.com/org/repo.git",
+			wantRef:   "refs/heads/main",
+		},
+		{
+			name:      "branch in path",
+			url:       "https://github.com/org/repo/my-branch",
+			wantClone: "https://github.com/org/repo.git",
+			wantRef:   "refs/heads/my-branch",
+		},
+		{
+			name:      "branch in query",
+			url:       "https://gitlab.com/group/subgroup/repo.git?branch=release",
+			wantClone: "https://gitlab.com/group/subgroup/repo.git",
+			wantRef:   "refs/heads/release",
+		},
+		{
+			name:      "tag and path",
+			url:       "https://github.com/org/repo?tag=v1.2.0&path=/servers/prod/",
+			wantClone: "https://github.com/org/repo.git",
+			wantRef:   "refs/tags/v1.2.0",
+			wantDir:   "servers/prod",
+		},
+		{
+			name:    "tag and branch",
+			url:     "https://github.com/org/repo/my-branch?tag=v1.2.0",
+			wantErr: true,
+		},
+		{
+			name:    "branch in path and query",
+			url:     "https://github.com/org/repo/my-branch?branch=other",
+			wantErr: true,
+		},
+		{
+			name:    "invalid tag",
+			url:     "https://github.com/org/repo?tag=-v1",
+			wantErr: true,
+		},
+		{
+			name:    "path outside the repository",
+			url:     "https://github.com/org/repo?path=servers/../../etc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := parseGitSource(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantClone, source.cloneURL)
+			assert.Equal(t, tt.wantRef, source.ref)
+			assert.Equal(t, tt.wantDir, source.dir)
+		})
+	}
+}
+
+func TestGitSourceDir(t *testing.T) {
+	repo := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, "servers", "prod"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "servers", "server.yaml"), nil, 0o644))
+	require.NoError(t, os.Symlink(outside, filepath.Join(repo, "link")))
+
+	dir, err := gitSourceDir(repo, "servers/prod")
+	require.NoError(t, err)
+	assert.Equal(t, "prod", filepath.Base(dir))
+
+	_, err = gitSourceDir(repo, "link")
+	assert.Error(t, err, "symbolic links should be rejected")
+	_, err = gitSourceDir(repo, "servers/server.yaml")
+	assert.Error(t, err, "files should be rejected")
+	_, err = gitSourceDir(repo, "missing")
+	assert.Error(t, err, "missing directories should be rejected")
+}
+
 func TestReadGitCatalog(t *testing.T) {
 	tests := []struct {
 		name       string
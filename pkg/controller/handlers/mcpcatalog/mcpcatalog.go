@@ -40,6 +40,14 @@ var log = logger.Package()
 // token is stored as a key in the credential's Env map.
 const CatalogCredentialToolName = "catalog-source-tokens"
 
+// CatalogWebhookSecretToolName is the tool name of the credential that stores the secret that Git webhooks for a
+// catalog are verified with.
+const CatalogWebhookSecretToolName = "catalog-webhook-secret"
+
+// CatalogSubmissionPortalToolName is the tool name of the credential that stores the configuration and token of the
+// submission portal of a catalog.
+const CatalogSubmissionPortalToolName = "catalog-submission-portal"
+
 const (
 	// These are used to force catalog sync on startup, used for times when changes are made to
 	// catalogs, and they must be synced on the next start.
@@ -116,9 +124,22 @@ func (h *Handler) Sync(req router.Request, resp router.Response) error {
 
 	toAdd := make([]client.Object, 0)
 	mcpCatalog.Status.SyncErrors = make(map[string]string)
+	mcpCatalog.Status.SyncConflicts = nil
 
 	for _, sourceURL := range mcpCatalog.Spec.SourceURLs {
 		token := h.revealCatalogCredential(req.Ctx, mcpCatalog.Name, sourceURL)
+		if isMCPRegistryURL(sourceURL) {
+			conflicts, err := syncMCPRegistry(req.Ctx, req.Client, mcpCatalog.Name, sourceURL, token)
+			if err != nil {
+				log.Errorf("failed to import MCP registry %s: %v", sourceURL, err)
+				mcpCatalog.Status.SyncErrors[sourceURL] = err.Error()
+			} else {
+				log.Infof("Imported MCP registry successfully: catalog=%s source=%s conflicts=%d", mcpCatalog.Name, sourceURL, len(conflicts))
+			}
+			mcpCatalog.Status.SyncConflicts = append(mcpCatalog.Status.SyncConflicts, conflicts...)
+			continue
+		}
+
 		objs, err := h.readMCPCatalog(req.Ctx, mcpCatalog.Name, sourceURL, token)
 		if err != nil {
 			log.Errorf("failed to read catalog %s: %v", sourceURL, err)
@@ -131,6 +152,10 @@ func (h *Handler) Sync(req router.Request, resp router.Response) error {
 		toAdd = append(toAdd, objs...)
 	}
 
+	if err := pruneMCPRegistryEntries(req.Ctx, req.Client, mcpCatalog); err != nil {
+		log.Errorf("failed to prune MCP registry entries of catalog %s: %v", mcpCatalog.Name, err)
+	}
+
 	mcpCatalog.Status.LastSyncTime = metav1.Now()
 	if err := req.Client.Status().Update(req.Ctx, mcpCatalog); err != nil {
 		return fmt.Errorf("failed to update catalog status: %w", err)
@@ -413,6 +438,10 @@ func (h *Handler) readMCPCatalog(ctx context.Context, catalogName, sourceURL, to
 			errs = append(errs, fmt.Errorf("failed to validate catalog entry %s: %w", entry.Name, err))
 			continue
 		}
+		if catalogName != system.DefaultCatalog && validation.UsesStdioRuntime(entry) {
+			errs = append(errs, fmt.Errorf("catalog entry %s uses the stdio runtime, which is only allowed in the default catalog", entry.Name))
+			continue
+		}
 		catalogEntry.Spec.Manifest = entry
 
 		objs = append(objs, &catalogEntry)
@@ -0,0 +1,497 @@
+package mcpcatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/validation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	officialMCPRegistryHost = "registry.modelcontextprotocol.io"
+	mcpRegistryServersPath  = "/v0/servers"
+	mcpRegistryPageSize     = 100
+
+	// mcpRegistryNameMetadataKey is the key of the manifest metadata that contains the name of the server in the registry.
+	mcpRegistryNameMetadataKey = "mcpRegistryName"
+	// mcpRegistryVersionMetadataKey is the key of the manifest metadata that contains the version of the server in the registry.
+	mcpRegistryVersionMetadataKey = "mcpRegistryVersion"
+)
+
+var invalidEntryNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// isMCPRegistryURL returns true for source URLs that point to the official MCP registry, or to another registry that
+// implements its API.
+func isMCPRegistryURL(sourceURL string) bool {
+	u, err := url.Parse(sourceURL)
+	if err != nil {
+		return false
+	}
+	return u.Host == officialMCPRegistryHost || strings.HasSuffix(strings.TrimSuffix(u.Path, "/"), mcpRegistryServersPath)
+}
+
+type mcpRegistryServerList struct {
+	Servers  []mcpRegistryServerResponse `json:"servers"`
+	Metadata struct {
+		NextCursor string `json:"nextCursor"`
+	} `json:"metadata"`
+}
+
+type mcpRegistryServerResponse struct {
+	Server mcpRegistryServer `json:"server"`
+	Meta   struct {
+		Official struct {
+			Status string `json:"status"`
+		} `json:"io.modelcontextprotocol.registry/official"`
+	} `json:"_meta"`
+}
+
+type mcpRegistryServer struct {
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+	Repository  struct {
+		URL string `json:"url"`
+	} `json:"repository"`
+	Icons []struct {
+		Src string `json:"src"`
+	} `json:"icons"`
+	Packages []mcpRegistryPackage   `json:"packages"`
+	Remotes  []mcpRegistryTransport `json:"remotes"`
+}
+
+type mcpRegistryPackage struct {
+	RegistryType         string                `json:"registryType"`
+	Identifier           string                `json:"identifier"`
+	Version              string                `json:"version"`
+	Transport            mcpRegistryTransport  `json:"transport"`
+	PackageArguments     []mcpRegistryArgument `json:"packageArguments"`
+	EnvironmentVariables []mcpRegistryInput    `json:"environmentVariables"`
+}
+
+type mcpRegistryTransport struct {
+	Type    string             `json:"type"`
+	URL     string             `json:"url"`
+	Headers []mcpRegistryInput `json:"headers"`
+}
+
+type mcpRegistryInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsRequired  bool   `json:"isRequired"`
+	IsSecret    bool   `json:"isSecret"`
+	Value       string `json:"value"`
+}
+
+type mcpRegistryArgument struct {
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Value      string `json:"value"`
+	Default    string `json:"default"`
+	IsRequired bool   `json:"isRequired"`
+}
+
+// readMCPRegistry reads the latest version of every server in an MCP registry and converts the servers that can be
+// run by Obot to catalog entry manifests.
+func readMCPRegistry(ctx context.Context, sourceURL, token string) ([]types.MCPServerCatalogEntryManifest, error) {
+	listURL, err := url.Parse(strings.TrimSuffix(sourceURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid MCP registry URL %s: %w", sourceURL, err)
+	}
+	if !strings.HasSuffix(listURL.Path, mcpRegistryServersPath) {
+		listURL.Path += mcpRegistryServersPath
+	}
+
+	var (
+		manifests []types.MCPServerCatalogEntryManifest
+		cursor    string
+		skipped   int
+	)
+	for {
+		query := listURL.Query()
+		query.Set("version", "latest")
+		query.Set("limit", strconv.Itoa(mcpRegistryPageSize))
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		} else {
+			query.Del("cursor")
+		}
+		listURL.RawQuery = query.Encode()
+
+		page, err := readMCPRegistryPage(ctx, listURL.String(), token)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range page.Servers {
+			if s.Meta.Official.Status == "deleted" {
+				continue
+			}
+			manifest, ok := mcpRegistryServerToManifest(s.Server)
+			if !ok {
+				skipped++
+				continue
+			}
+			manifests = append(manifests, manifest)
+		}
+
+		if page.Metadata.NextCursor == "" || page.Metadata.NextCursor == cursor || len(page.Servers) == 0 {
+			break
+		}
+		cursor = page.Metadata.NextCursor
+	}
+
+	if skipped > 0 {
+		log.Infof("Skipped MCP registry servers without a supported package or remote: source=%s skipped=%d", sourceURL, skipped)
+	}
+
+	return manifests, nil
+}
+
+func readMCPRegistryPage(ctx context.Context, pageURL, token string) (*mcpRegistryServerList, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for MCP registry %s: %w", pageURL, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read MCP registry %s: %w", pageURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unexpected status when reading MCP registry %s: %s: %s", pageURL, resp.Status, string(body))
+	}
+
+	var page mcpRegistryServerList
+	if err = json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode MCP registry %s: %w", pageURL, err)
+	}
+	return &page, nil
+}
+
+// mcpRegistryServerToManifest converts a server from an MCP registry to a catalog entry manifest. Remotes are preferred
+// over packages because they don't need to be deployed. It returns false if the server has neither a remote nor a
+// package that Obot can run.
+func mcpRegistryServerToManifest(server mcpRegistryServer) (types.MCPServerCatalogEntryManifest, bool) {
+	manifest := types.MCPServerCatalogEntryManifest{
+		Metadata: map[string]string{
+			mcpRegistryNameMetadataKey:    server.Name,
+			mcpRegistryVersionMetadataKey: server.Version,
+		},
+		Name:             server.Title,
+		ShortDescription: server.Description,
+		Description:      server.Description,
+		RepoURL:          server.Repository.URL,
+	}
+	if manifest.Name == "" {
+		manifest.Name = server.Name
+	}
+	if len(server.Icons) > 0 {
+		manifest.Icon = server.Icons[0].Src
+	}
+
+	for _, remote := range server.Remotes {
+		if (remote.Type != "streamable-http" && remote.Type != "sse") || strings.Contains(remote.URL, "{") {
+			continue
+		}
+
+		manifest.Runtime = types.RuntimeRemote
+		manifest.RemoteConfig = &types.RemoteCatalogConfig{
+			FixedURL: remote.URL,
+			Headers:  mcpRegistryHeaders(remote.Headers),
+		}
+		return manifest, true
+	}
+
+	for _, pkg := range server.Packages {
+		args, ok := mcpRegistryArgs(pkg.PackageArguments)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case pkg.RegistryType == "npm" && pkg.Transport.Type == "stdio":
+			manifest.Runtime = types.RuntimeNPX
+			manifest.NPXConfig = &types.NPXRuntimeConfig{
+				Package: versioned(pkg.Identifier, "@", pkg.Version),
+				Args:    args,
+			}
+		case pkg.RegistryType == "pypi" && pkg.Transport.Type == "stdio":
+			manifest.Runtime = types.RuntimeUVX
+			manifest.UVXConfig = &types.UVXRuntimeConfig{
+				Package: versioned(pkg.Identifier, "==", pkg.Version),
+				Command: pkg.Identifier,
+				Args:    args,
+			}
+		case pkg.RegistryType == "oci" && pkg.Transport.Type == "streamable-http":
+			port, path, ok := containerPortAndPath(pkg.Transport.URL)
+			if !ok {
+				continue
+			}
+			image := pkg.Identifier
+			if !strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") {
+				image = versioned(image, ":", pkg.Version)
+			}
+			manifest.Runtime = types.RuntimeContainerized
+			manifest.ContainerizedConfig = &types.ContainerizedRuntimeConfig{
+				Image: image,
+				Args:  args,
+				Port:  port,
+				Path:  path,
+			}
+		default:
+			continue
+		}
+
+		for _, env := range pkg.EnvironmentVariables {
+			manifest.Env = append(manifest.Env, types.MCPEnv{
+				MCPHeader: mcpRegistryHeader(env),
+			})
+		}
+		return manifest, true
+	}
+
+	return types.MCPServerCatalogEntryManifest{}, false
+}
+
+func versioned(identifier, separator, version string) string {
+	if version == "" || version == "latest" {
+		return identifier
+	}
+	return identifier + separator + version
+}
+
+// mcpRegistryArgs returns the arguments for a package, or false if an argument needs a value from the user.
+func mcpRegistryArgs(arguments []mcpRegistryArgument) ([]string, bool) {
+	var args []string
+	for _, arg := range arguments {
+		value := arg.Value
+		if value == "" {
+			value = arg.Default
+		}
+		if strings.Contains(value, "{") {
+			return nil, false
+		}
+		if value == "" {
+			if arg.IsRequired {
+				return nil, false
+			}
+			continue
+		}
+
+		if arg.Type == "named" && arg.Name != "" {
+			args = append(args, arg.Name)
+		}
+		args = append(args, value)
+	}
+	return args, true
+}
+
+// containerPortAndPath returns the port and path of the MCP endpoint of a container from the URL of its transport,
+// such as http://localhost:8080/mcp.
+func containerPortAndPath(transportURL string) (int, string, bool) {
+	u, err := url.Parse(transportURL)
+	if err != nil {
+		return 0, "", false
+	}
+	port, err := strconv.Atoi(u.Port())
+	if err != nil || port <= 0 {
+		return 0, "", false
+	}
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+	return port, path, true
+}
+
+func mcpRegistryHeaders(inputs []mcpRegistryInput) []types.MCPHeader {
+	headers := make([]types.MCPHeader, 0, len(inputs))
+	for _, input := range inputs {
+		headers = append(headers, mcpRegistryHeader(input))
+	}
+	return headers
+}
+
+func mcpRegistryHeader(input mcpRegistryInput) types.MCPHeader {
+	header := types.MCPHeader{
+		Name:        input.Name,
+		Description: input.Description,
+		Key:         input.Name,
+		Sensitive:   input.IsSecret,
+		Required:    input.IsRequired,
+	}
+	if !strings.Contains(input.Value, "{") {
+		header.Value = input.Value
+	}
+	return header
+}
+
+// importedManifestHash returns the hash used to tell whether an imported entry was edited in Obot. Tool previews are
+// generated in Obot, so they aren't edits.
+func importedManifestHash(manifest types.MCPServerCatalogEntryManifest) string {
+	manifest.ToolPreview = nil
+	return hash.Digest(manifest)
+}
+
+func mcpRegistryEntryName(catalogName string, manifest types.MCPServerCatalogEntryManifest) string {
+	cleanName := invalidEntryNameChars.ReplaceAllString(strings.ToLower(manifest.Metadata[mcpRegistryNameMetadataKey]), "-")
+	return name.SafeHashConcatName(catalogName, strings.Trim(cleanName, "-"))
+}
+
+// syncMCPRegistry imports the servers of an MCP registry into the catalog. Unlike the entries of other sources,
+// imported entries are editable. Entries that weren't edited in Obot are updated when the registry changes, and
+// deleted when they are removed from the registry. Entries that were edited are kept, and the names of those that
+// changed in the registry since are returned as conflicts.
+func syncMCPRegistry(ctx context.Context, c client.Client, catalogName, sourceURL, token string) ([]string, error) {
+	manifests, err := readMCPRegistry(ctx, sourceURL, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing v1.MCPServerCatalogEntryList
+	if err = c.List(ctx, &existing, client.InNamespace(system.DefaultNamespace), client.MatchingFields{"spec.mcpCatalogName": catalogName}); err != nil {
+		return nil, fmt.Errorf("failed to list catalog entries: %w", err)
+	}
+
+	entries := make(map[string]*v1.MCPServerCatalogEntry, len(existing.Items))
+	for i := range existing.Items {
+		entries[existing.Items[i].Name] = &existing.Items[i]
+	}
+
+	var (
+		conflicts []string
+		errs      []error
+		seen      = make(map[string]struct{}, len(manifests))
+	)
+	for _, manifest := range manifests {
+		sanitizeCatalogEntryManifest(&manifest)
+		if err := validation.ValidateCatalogEntryManifest(manifest); err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate catalog entry %s: %w", manifest.Name, err))
+			continue
+		}
+		if catalogName != system.DefaultCatalog && validation.UsesStdioRuntime(manifest) {
+			errs = append(errs, fmt.Errorf("catalog entry %s uses the stdio runtime, which is only allowed in the default catalog", manifest.Name))
+			continue
+		}
+
+		entryName := mcpRegistryEntryName(catalogName, manifest)
+		seen[entryName] = struct{}{}
+		manifestHash := importedManifestHash(manifest)
+
+		entry, ok := entries[entryName]
+		if !ok {
+			if err := c.Create(ctx, &v1.MCPServerCatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      entryName,
+					Namespace: system.DefaultNamespace,
+				},
+				Spec: v1.MCPServerCatalogEntrySpec{
+					Manifest:             manifest,
+					MCPCatalogName:       catalogName,
+					Editable:             true,
+					SourceURL:            sourceURL,
+					ImportedManifestHash: manifestHash,
+				},
+			}); err != nil && !apierrors.IsAlreadyExists(err) {
+				errs = append(errs, fmt.Errorf("failed to create catalog entry %s: %w", manifest.Name, err))
+			}
+			continue
+		}
+
+		if entry.Spec.SourceURL != sourceURL || entry.Spec.ImportedManifestHash == "" {
+			// The entry belongs to another source.
+			conflicts = append(conflicts, entry.Name)
+			continue
+		}
+		if entry.Spec.ImportedManifestHash == manifestHash {
+			continue
+		}
+		if importedManifestHash(entry.Spec.Manifest) != entry.Spec.ImportedManifestHash {
+			// The entry was edited in Obot, so keep the edits.
+			conflicts = append(conflicts, entry.Name)
+			continue
+		}
+
+		manifest.ToolPreview = entry.Spec.Manifest.ToolPreview
+		entry.Spec.Manifest = manifest
+		entry.Spec.ImportedManifestHash = manifestHash
+		if err := c.Update(ctx, entry); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update catalog entry %s: %w", manifest.Name, err))
+		}
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		// Don't delete anything if the registry couldn't be imported completely.
+		return conflicts, err
+	}
+
+	for _, entry := range entries {
+		if _, ok := seen[entry.Name]; ok || entry.Spec.SourceURL != sourceURL || entry.Spec.ImportedManifestHash == "" {
+			continue
+		}
+		if err := deleteUneditedImportedEntry(ctx, c, entry); err != nil {
+			return conflicts, err
+		}
+	}
+
+	slices.Sort(conflicts)
+	return conflicts, nil
+}
+
+// pruneMCPRegistryEntries deletes the entries imported from MCP registries that are no longer sources of the catalog,
+// unless they were edited in Obot.
+func pruneMCPRegistryEntries(ctx context.Context, c client.Client, catalog *v1.MCPCatalog) error {
+	var entries v1.MCPServerCatalogEntryList
+	if err := c.List(ctx, &entries, client.InNamespace(system.DefaultNamespace), client.MatchingFields{"spec.mcpCatalogName": catalog.Name}); err != nil {
+		return fmt.Errorf("failed to list catalog entries: %w", err)
+	}
+
+	for i := range entries.Items {
+		entry := &entries.Items[i]
+		if entry.Spec.ImportedManifestHash == "" || slices.Contains(catalog.Spec.SourceURLs, entry.Spec.SourceURL) {
+			continue
+		}
+		if err := deleteUneditedImportedEntry(ctx, c, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteUneditedImportedEntry(ctx context.Context, c client.Client, entry *v1.MCPServerCatalogEntry) error {
+	if importedManifestHash(entry.Spec.Manifest) != entry.Spec.ImportedManifestHash {
+		return nil
+	}
+
+	log.Infof("Deleting MCP catalog entry removed from MCP registry: entry=%s source=%s", entry.Name, entry.Spec.SourceURL)
+	if err := c.Delete(ctx, entry); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete catalog entry %s: %w", entry.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package mcpcatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsMCPRegistryURL(t *testing.T) {
+	assert.True(t, isMCPRegistryURL("https://registry.modelcontextprotocol.io"))
+	assert.True(t, isMCPRegistryURL("https://registry.example.com/v0/servers/"))
+	assert.False(t, isMCPRegistryURL("https://github.com/obot-platform/mcp-catalog"))
+	assert.False(t, isMCPRegistryURL("https://example.com/catalog.yaml"))
+}
+
+func TestMCPRegistryServerToManifest(t *testing.T) {
+	tests := []struct {
+		name   string
+		server mcpRegistryServer
+		want   types.MCPServerCatalogEntryManifest
+		wantOK bool
+	}{
+		{
+			name: "remote is preferred over packages",
+			server: mcpRegistryServer{
+				Name:     "io.example/remote",
+				Title:    "Remote",
+				Version:  "1.0.0",
+				Remotes:  []mcpRegistryTransport{{Type: "streamable-http", URL: "https://mcp.example.com/mcp", Headers: []mcpRegistryInput{{Name: "X-API-Key", IsRequired: true, IsSecret: true}}}},
+				Packages: []mcpRegistryPackage{{RegistryType: "npm", Identifier: "remote", Transport: mcpRegistryTransport{Type: "stdio"}}},
+			},
+			want: types.MCPServerCatalogEntryManifest{
+				Metadata: map[string]string{mcpRegistryNameMetadataKey: "io.example/remote", mcpRegistryVersionMetadataKey: "1.0.0"},
+				Name:     "Remote",
+				Runtime:  types.RuntimeRemote,
+				RemoteConfig: &types.RemoteCatalogConfig{
+					FixedURL: "https://mcp.example.com/mcp",
+					Headers:  []types.MCPHeader{{Name: "X-API-Key", Key: "X-API-Key", Sensitive: true, Required: true}},
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name: "npm package",
+			server: mcpRegistryServer{
+				Name:    "io.example/npm",
+				Version: "2.0.0",
+				Remotes: []mcpRegistryTransport{{Type: "streamable-http", URL: "https://{tenant}.example.com/mcp"}},
+				Packages: []mcpRegistryPackage{{
+					RegistryType:         "npm",
+					Identifier:           "@example/server",
+					Version:              "2.0.0",
+					Transport:            mcpRegistryTransport{Type: "stdio"},
+					PackageArguments:     []mcpRegistryArgument{{Type: "named", Name: "--mode", Default: "read-only"}, {Type: "positional", Value: "serve"}},
+					EnvironmentVariables: []mcpRegistryInput{{Name: "EXAMPLE_TOKEN", Description: "Token", IsRequired: true, IsSecret: true}},
+				}},
+			},
+			want: types.MCPServerCatalogEntryManifest{
+				Metadata:  map[string]string{mcpRegistryNameMetadataKey: "io.example/npm", mcpRegistryVersionMetadataKey: "2.0.0"},
+				Name:      "io.example/npm",
+				Runtime:   types.RuntimeNPX,
+				NPXConfig: &types.NPXRuntimeConfig{Package: "@example/server@2.0.0", Args: []string{"--mode", "read-only", "serve"}},
+				Env:       []types.MCPEnv{{MCPHeader: types.MCPHeader{Name: "EXAMPLE_TOKEN", Description: "Token", Key: "EXAMPLE_TOKEN", Sensitive: true, Required: true}}},
+			},
+			wantOK: true,
+		},
+		{
+			name: "pypi package",
+			server: mcpRegistryServer{
+				Name:     "io.example/pypi",
+				Packages: []mcpRegistryPackage{{RegistryType: "pypi", Identifier: "example-server", Version: "0.3.1", Transport: mcpRegistryTransport{Type: "stdio"}}},
+			},
+			want: types.MCPServerCatalogEntryManifest{
+				Metadata:  map[string]string{mcpRegistryNameMetadataKey: "io.example/pypi", mcpRegistryVersionMetadataKey: ""},
+				Name:      "io.example/pypi",
+				Runtime:   types.RuntimeUVX,
+				UVXConfig: &types.UVXRuntimeConfig{Package: "example-server==0.3.1", Command: "example-server"},
+			},
+			wantOK: true,
+		},
+		{
+			name: "oci package",
+			server: mcpRegistryServer{
+				Name:     "io.example/oci",
+				Packages: []mcpRegistryPackage{{RegistryType: "oci", Identifier: "ghcr.io/example/server", Version: "1.2.3", Transport: mcpRegistryTransport{Type: "streamable-http", URL: "http://localhost:8080/mcp"}}},
+			},
+			want: types.MCPServerCatalogEntryManifest{
+				Metadata:            map[string]string{mcpRegistryNameMetadataKey: "io.example/oci", mcpRegistryVersionMetadataKey: ""},
+				Name:                "io.example/oci",
+				Runtime:             types.RuntimeContainerized,
+				ContainerizedConfig: &types.ContainerizedRuntimeConfig{Image: "ghcr.io/example/server:1.2.3", Port: 8080, Path: "/mcp"},
+			},
+			wantOK: true,
+		},
+		{
+			name: "unsupported packages",
+			server: mcpRegistryServer{
+				Name: "io.example/unsupported",
+				Packages: []mcpRegistryPackage{
+					{RegistryType: "nuget", Identifier: "Example.Server", Transport: mcpRegistryTransport{Type: "stdio"}},
+					{RegistryType: "oci", Identifier: "example/server", Transport: mcpRegistryTransport{Type: "stdio"}},
+					{RegistryType: "npm", Identifier: "example", Transport: mcpRegistryTransport{Type: "stdio"}, PackageArguments: []mcpRegistryArgument{{Type: "positional", IsRequired: true}}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := mcpRegistryServerToManifest(tt.server)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+// newMCPRegistryServer returns a fake MCP registry that serves the pages that are set on it.
+func newMCPRegistryServer(t *testing.T, pages *[][]mcpRegistryServerResponse) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != mcpRegistryServersPath || r.URL.Query().Get("version") != "latest" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var page int
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			page = int(cursor[0] - '0')
+		}
+
+		list := mcpRegistryServerList{Servers: (*pages)[page]}
+		if page+1 < len(*pages) {
+			list.Metadata.NextCursor = string(rune('0' + page + 1))
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func npmRegistryServer(name, version string) mcpRegistryServerResponse {
+	return mcpRegistryServerResponse{Server: mcpRegistryServer{
+		Name:     name,
+		Version:  version,
+		Packages: []mcpRegistryPackage{{RegistryType: "npm", Identifier: name, Version: version, Transport: mcpRegistryTransport{Type: "stdio"}}},
+	}}
+}
+
+func TestReadMCPRegistry(t *testing.T) {
+	deleted := npmRegistryServer("deleted", "1.0.0")
+	deleted.Meta.Official.Status = "deleted"
+
+	registry := newMCPRegistryServer(t, &[][]mcpRegistryServerResponse{
+		{npmRegistryServer("first", "1.0.0"), deleted},
+		{npmRegistryServer("second", "1.0.0"), {Server: mcpRegistryServer{Name: "unsupported"}}},
+	})
+
+	manifests, err := readMCPRegistry(context.Background(), registry.URL, "")
+	require.NoError(t, err)
+
+	var names []string
+	for _, m := range manifests {
+		names = append(names, m.Name)
+	}
+	assert.Equal(t, []string{"first", "second"}, names)
+}
+
+func newFakeClient(t *testing.T, objects ...kclient.Object) kclient.Client {
+	t.Helper()
+
+	return fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithIndex(&v1.MCPServerCatalogEntry{}, "spec.mcpCatalogName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.MCPServerCatalogEntry).Spec.MCPCatalogName}
+		}).
+		WithObjects(objects...).
+		Build()
+}
+
+func TestSyncMCPRegistry(t *testing.T) {
+	ctx := context.Background()
+	pages := [][]mcpRegistryServerResponse{{
+		npmRegistryServer("unchanged", "1.0.0"),
+		npmRegistryServer("updated", "1.0.0"),
+		npmRegistryServer("edited", "1.0.0"),
+	}}
+	registry := newMCPRegistryServer(t, &pages)
+
+	c := newFakeClient(t)
+	conflicts, err := syncMCPRegistry(ctx, c, "catalog", registry.URL, "")
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+
+	get := func(serverName string) *v1.MCPServerCatalogEntry {
+		t.Helper()
+		var entry v1.MCPServerCatalogEntry
+		require.NoError(t, c.Get(ctx, router.Key(system.DefaultNamespace, mcpRegistryEntryName("catalog", types.MCPServerCatalogEntryManifest{
+			Metadata: map[string]string{mcpRegistryNameMetadataKey: serverName},
+		})), &entry))
+		return &entry
+	}
+
+	// Tool previews aren't edits.
+	unchanged := get("unchanged")
+	assert.True(t, unchanged.Spec.Editable)
+	unchanged.Spec.Manifest.ToolPreview = []types.MCPServerTool{{Name: "tool"}}
+	require.NoError(t, c.Update(ctx, unchanged))
+
+	edited := get("edited")
+	edited.Spec.Manifest.Description = "Edited in Obot"
+	require.NoError(t, c.Update(ctx, edited))
+
+	// Another source's entry with the same name is left alone.
+	other := &v1.MCPServerCatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: mcpRegistryEntryName("catalog", types.MCPServerCatalogEntryManifest{
+			Metadata: map[string]string{mcpRegistryNameMetadataKey: "other"},
+		}), Namespace: system.DefaultNamespace},
+		Spec: v1.MCPServerCatalogEntrySpec{MCPCatalogName: "catalog", SourceURL: "https://github.com/example/catalog"},
+	}
+	require.NoError(t, c.Create(ctx, other))
+
+	pages = [][]mcpRegistryServerResponse{{
+		npmRegistryServer("unchanged", "1.0.0"),
+		npmRegistryServer("updated", "2.0.0"),
+		npmRegistryServer("edited", "2.0.0"),
+		npmRegistryServer("other", "1.0.0"),
+	}}
+	conflicts, err = syncMCPRegistry(ctx, c, "catalog", registry.URL, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{edited.Name, other.Name}, conflicts)
+
+	assert.Equal(t, []types.MCPServerTool{{Name: "tool"}}, get("unchanged").Spec.Manifest.ToolPreview)
+	assert.Equal(t, "updated@2.0.0", get("updated").Spec.Manifest.NPXConfig.Package)
+	assert.Equal(t, "Edited in Obot", get("edited").Spec.Manifest.Description)
+	assert.Equal(t, "edited@1.0.0", get("edited").Spec.Manifest.NPXConfig.Package)
+
+	// Unedited entries removed from the registry are deleted, and edited ones are kept.
+	pages = [][]mcpRegistryServerResponse{{npmRegistryServer("edited", "2.0.0")}}
+	_, err = syncMCPRegistry(ctx, c, "catalog", registry.URL, "")
+	require.NoError(t, err)
+
+	err = c.Get(ctx, router.Key(system.DefaultNamespace, unchanged.Name), &v1.MCPServerCatalogEntry{})
+	assert.True(t, apierrors.IsNotFound(err), "expected the removed entry to be deleted, got %v", err)
+	get("edited")
+}
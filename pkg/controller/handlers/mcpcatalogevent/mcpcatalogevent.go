@@ -0,0 +1,305 @@
+package mcpcatalogevent
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
+	"github.com/obot-platform/obot/logger"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+const (
+	deliveryTimeout = 10 * time.Second
+	// maxEventAge is how long delivering an event is retried before it is given up on.
+	maxEventAge   = 24 * time.Hour
+	minRetryDelay = 30 * time.Second
+	maxRetryDelay = 30 * time.Minute
+)
+
+// Handler creates the lifecycle events of MCP catalogs as their entries and servers change, and delivers them to the
+// webhooks of the catalogs, so that external systems stay in sync with the catalogs.
+type Handler struct {
+	gptClient  *gptscript.GPTScript
+	httpClient *http.Client
+}
+
+func New(gptClient *gptscript.GPTScript) *Handler {
+	return &Handler{
+		gptClient:  gptClient,
+		httpClient: &http.Client{Timeout: deliveryTimeout},
+	}
+}
+
+// EntryPublished creates an entry-published event when a catalog entry is added to a catalog, and when a new revision
+// of it is published.
+func (h *Handler) EntryPublished(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+	if entry.Spec.MCPCatalogName == "" || !entry.DeletionTimestamp.IsZero() || entry.Status.Revision <= entry.Status.PublishedEventRevision {
+		return nil
+	}
+
+	if err := createEvent(req.Ctx, req.Client, entry.Namespace, types.MCPCatalogEvent{
+		Type:                 types.MCPCatalogEventEntryPublished,
+		MCPCatalogID:         entry.Spec.MCPCatalogName,
+		CatalogEntryID:       entry.Name,
+		CatalogEntryName:     entry.Spec.Manifest.Name,
+		CatalogEntryRevision: entry.Status.Revision,
+	}); err != nil {
+		return err
+	}
+
+	entry.Status.PublishedEventRevision = entry.Status.Revision
+	return req.Client.Status().Update(req.Ctx, entry)
+}
+
+// ServerChanged creates the server-created, server-update-applied and server-health-changed events of the servers of
+// catalogs.
+func (h *Handler) ServerChanged(req router.Request, _ router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	catalogID := serverCatalogID(server)
+	if catalogID == "" || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	state := v1.MCPServerCatalogEventState{
+		ManifestHash:     hash.Digest(server.Spec.Manifest),
+		DeploymentStatus: server.Status.DeploymentStatus,
+	}
+	events := serverEvents(server.Status.CatalogEvents, state)
+	if server.Status.CatalogEvents != nil && state.DeploymentStatus == "" {
+		// Keep the last known deployment status, so that the change from it is detected once the server has one again.
+		state.DeploymentStatus = server.Status.CatalogEvents.DeploymentStatus
+	}
+	if server.Status.CatalogEvents != nil && *server.Status.CatalogEvents == state {
+		return nil
+	}
+
+	for _, event := range events {
+		event.MCPCatalogID = catalogID
+		event.MCPServerID = server.Name
+		event.MCPServerName = cmp.Or(server.Spec.Alias, server.Spec.Manifest.Name)
+		event.UserID = serverUserID(server)
+		event.CatalogEntryID = server.Spec.MCPServerCatalogEntryName
+		if err := createEvent(req.Ctx, req.Client, server.Namespace, event); err != nil {
+			return err
+		}
+	}
+
+	server.Status.CatalogEvents = &state
+	return req.Client.Status().Update(req.Ctx, server)
+}
+
+// ServerDeleted creates the server-deleted event of a server of a catalog.
+func (h *Handler) ServerDeleted(req router.Request, _ router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	catalogID := serverCatalogID(server)
+	if catalogID == "" || server.Status.CatalogEvents == nil {
+		return nil
+	}
+
+	return createEvent(req.Ctx, req.Client, server.Namespace, types.MCPCatalogEvent{
+		Type:             types.MCPCatalogEventServerDeleted,
+		MCPCatalogID:     catalogID,
+		MCPServerID:      server.Name,
+		MCPServerName:    cmp.Or(server.Spec.Alias, server.Spec.Manifest.Name),
+		UserID:           serverUserID(server),
+		CatalogEntryID:   server.Spec.MCPServerCatalogEntryName,
+		DeploymentStatus: server.Status.DeploymentStatus,
+	})
+}
+
+// serverCatalogID returns the catalog of a multi-user server, or of the catalog entry of a single-user server. Template
+// servers and the components of composite servers aren't servers of a catalog of their own.
+func serverCatalogID(server *v1.MCPServer) string {
+	if server.Spec.Template || server.Spec.CompositeName != "" {
+		return ""
+	}
+	return cmp.Or(server.Spec.MCPCatalogID, server.Status.MCPCatalogID)
+}
+
+func serverUserID(server *v1.MCPServer) string {
+	if server.Spec.MCPCatalogID != "" {
+		return ""
+	}
+	return server.Spec.UserID
+}
+
+// serverEvents returns the events for the change of a server from the state that the last events were created for.
+func serverEvents(previous *v1.MCPServerCatalogEventState, current v1.MCPServerCatalogEventState) []types.MCPCatalogEvent {
+	if previous == nil {
+		return []types.MCPCatalogEvent{{
+			Type:             types.MCPCatalogEventServerCreated,
+			DeploymentStatus: current.DeploymentStatus,
+		}}
+	}
+
+	var events []types.MCPCatalogEvent
+	if previous.ManifestHash != current.ManifestHash {
+		events = append(events, types.MCPCatalogEvent{
+			Type:             types.MCPCatalogEventServerUpdateApplied,
+			DeploymentStatus: current.DeploymentStatus,
+		})
+	}
+	if previous.DeploymentStatus != "" && current.DeploymentStatus != "" && previous.DeploymentStatus != current.DeploymentStatus {
+		events = append(events, types.MCPCatalogEvent{
+			Type:                     types.MCPCatalogEventServerHealthChanged,
+			DeploymentStatus:         current.DeploymentStatus,
+			PreviousDeploymentStatus: previous.DeploymentStatus,
+		})
+	}
+	return events
+}
+
+// createEvent creates an event to be delivered, unless no webhook of the catalog is subscribed to events of its type.
+func createEvent(ctx context.Context, client kclient.Client, namespace string, event types.MCPCatalogEvent) error {
+	var webhooks v1.MCPCatalogEventWebhookList
+	if err := client.List(ctx, &webhooks, kclient.InNamespace(namespace), kclient.MatchingFields{
+		"spec.mcpCatalogID": event.MCPCatalogID,
+	}); err != nil {
+		return fmt.Errorf("failed to list webhooks of catalog %s: %w", event.MCPCatalogID, err)
+	}
+	if !slices.ContainsFunc(webhooks.Items, func(webhook v1.MCPCatalogEventWebhook) bool {
+		return webhook.Spec.Manifest.Subscribes(event.Type)
+	}) {
+		return nil
+	}
+
+	event.Time = types.Time{Time: time.Now()}
+	if err := client.Create(ctx, &v1.MCPCatalogEvent{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.MCPCatalogEventPrefix,
+			Namespace:    namespace,
+		},
+		Spec: v1.MCPCatalogEventSpec{
+			Event: event,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to create %s event of catalog %s: %w", event.Type, event.MCPCatalogID, err)
+	}
+	return nil
+}
+
+// Deliver sends an event to each webhook of its catalog that is subscribed to it, and deletes the event once it is
+// delivered to all of them. Deliveries that fail are retried with a backoff until the event is too old.
+func (h *Handler) Deliver(req router.Request, resp router.Response) error {
+	event := req.Object.(*v1.MCPCatalogEvent)
+	if !event.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var webhooks v1.MCPCatalogEventWebhookList
+	if err := req.List(&webhooks, &kclient.ListOptions{
+		Namespace: event.Namespace,
+		FieldSelector: fields.SelectorFromSet(fields.Set{
+			"spec.mcpCatalogID": event.Spec.Event.MCPCatalogID,
+		}),
+	}); err != nil {
+		return err
+	}
+
+	payload := event.Spec.Event
+	payload.ID = event.Name
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	var failed []error
+	for _, webhook := range webhooks.Items {
+		// Webhooks created after the event don't get it.
+		if !webhook.Spec.Manifest.Subscribes(payload.Type) ||
+			webhook.CreationTimestamp.After(event.CreationTimestamp.Time) || slices.Contains(event.Status.Delivered, webhook.Name) {
+			continue
+		}
+
+		err := h.send(req.Ctx, &webhook, body)
+		if err == nil {
+			event.Status.Delivered = append(event.Status.Delivered, webhook.Name)
+			webhook.Status.LastDeliveredAt = metav1.Now()
+			webhook.Status.Error = ""
+		} else {
+			failed = append(failed, fmt.Errorf("webhook %s: %w", webhook.Name, err))
+			webhook.Status.Error = fmt.Sprintf("failed to deliver %s event %s: %v", payload.Type, event.Name, err)
+		}
+		if err := req.Client.Status().Update(req.Ctx, &webhook); err != nil {
+			// The status of a webhook is informational, so failing to update it doesn't fail the delivery.
+			log.Debugf("Failed to update status of MCP catalog event webhook: webhook=%s error=%v", webhook.Name, err)
+		}
+	}
+
+	if len(failed) == 0 {
+		return kclient.IgnoreNotFound(req.Delete(event))
+	}
+	if time.Since(event.CreationTimestamp.Time) > maxEventAge {
+		log.Warnf("Giving up on delivering MCP catalog event: event=%s type=%s catalog=%s error=%v", event.Name, payload.Type, payload.MCPCatalogID, errors.Join(failed...))
+		return kclient.IgnoreNotFound(req.Delete(event))
+	}
+
+	event.Status.Attempts++
+	resp.RetryAfter(retryDelay(event.Status.Attempts))
+	return req.Client.Status().Update(req.Ctx, event)
+}
+
+// retryDelay returns how long to wait before retrying a delivery that failed a number of times.
+func retryDelay(attempts int) time.Duration {
+	delay := minRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+func (h *Handler) send(ctx context.Context, webhook *v1.MCPCatalogEventWebhook, body []byte) error {
+	cred, err := h.gptClient.RevealCredential(ctx, []string{system.MCPCatalogEventWebhookCredentialContext}, webhook.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to reveal credential: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Spec.Manifest.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret := cred.Env["secret"]; secret != "" {
+		webhooksignature.SetHeaders(req.Header, secret, time.Now(), body)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// RemoveSecret deletes the secret of a webhook when the webhook is deleted.
+func (h *Handler) RemoveSecret(req router.Request, _ router.Response) error {
+	if err := h.gptClient.DeleteCredential(req.Ctx, system.MCPCatalogEventWebhookCredentialContext, req.Name); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to delete secret of webhook %s: %w", req.Name, err)
+	}
+	return nil
+}
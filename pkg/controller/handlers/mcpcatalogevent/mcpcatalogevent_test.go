@@ -0,0 +1,85 @@
+package mcpcatalogevent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+func TestServerEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous *v1.MCPServerCatalogEventState
+		current  v1.MCPServerCatalogEventState
+		want     []types.MCPCatalogEvent
+	}{
+		{
+			name:    "new server",
+			current: v1.MCPServerCatalogEventState{ManifestHash: "a", DeploymentStatus: "Progressing"},
+			want: []types.MCPCatalogEvent{
+				{Type: types.MCPCatalogEventServerCreated, DeploymentStatus: "Progressing"},
+			},
+		},
+		{
+			name:     "unchanged",
+			previous: &v1.MCPServerCatalogEventState{ManifestHash: "a", DeploymentStatus: "Available"},
+			current:  v1.MCPServerCatalogEventState{ManifestHash: "a", DeploymentStatus: "Available"},
+		},
+		{
+			name:     "update applied",
+			previous: &v1.MCPServerCatalogEventState{ManifestHash: "a", DeploymentStatus: "Available"},
+			current:  v1.MCPServerCatalogEventState{ManifestHash: "b", DeploymentStatus: "Available"},
+			want: []types.MCPCatalogEvent{
+				{Type: types.MCPCatalogEventServerUpdateApplied, DeploymentStatus: "Available"},
+			},
+		},
+		{
+			name:     "health changed",
+			previous: &v1.MCPServerCatalogEventState{ManifestHash: "a", DeploymentStatus: "Available"},
+			current:  v1.MCPServerCatalogEventState{ManifestHash: "b", DeploymentStatus: "Unavailable"},
+			want: []types.MCPCatalogEvent{
+				{Type: types.MCPCatalogEventServerUpdateApplied, DeploymentStatus: "Unavailable"},
+				{Type: types.MCPCatalogEventServerHealthChanged, DeploymentStatus: "Unavailable", PreviousDeploymentStatus: "Available"},
+			},
+		},
+		{
+			name:     "first deployment status",
+			previous: &v1.MCPServerCatalogEventState{ManifestHash: "a"},
+			current:  v1.MCPServerCatalogEventState{ManifestHash: "a", DeploymentStatus: "Available"},
+		},
+		{
+			name:     "deployment status unknown",
+			previous: &v1.MCPServerCatalogEventState{ManifestHash: "a", DeploymentStatus: "Available"},
+			current:  v1.MCPServerCatalogEventState{ManifestHash: "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := serverEvents(tt.previous, tt.current)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d events, got %+v", len(tt.want), got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("event %d: expected %+v, got %+v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		4:  4 * time.Minute,
+		7:  30 * time.Minute,
+		50: 30 * time.Minute,
+	} {
+		if got := retryDelay(attempts); got != want {
+			t.Errorf("retryDelay(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
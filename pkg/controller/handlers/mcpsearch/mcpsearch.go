@@ -0,0 +1,91 @@
+package mcpsearch
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// The weights of the fields of catalog entries and servers. A search term in the name makes an object more relevant
+// than the same term in its description.
+const (
+	nameWeight             = 4
+	labelWeight            = 2.5
+	shortDescriptionWeight = 2
+	toolNameWeight         = 1.5
+	descriptionWeight      = 1
+	toolDescriptionWeight  = 0.5
+)
+
+// Indexer keeps the search index of MCP catalog entries and servers in the gateway database up to date, so that every
+// Obot replica can search them.
+type Indexer struct {
+	gatewayClient *gclient.Client
+}
+
+func New(gatewayClient *gclient.Client) *Indexer {
+	return &Indexer{
+		gatewayClient: gatewayClient,
+	}
+}
+
+// IndexCatalogEntry indexes the manifest of a catalog entry, and removes it from the index when it is deleted.
+func (i *Indexer) IndexCatalogEntry(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+	if !entry.DeletionTimestamp.IsZero() {
+		return i.remove(req.Ctx, gatewaytypes.MCPSearchKindCatalogEntry, entry.Name)
+	}
+
+	manifest := entry.Spec.Manifest
+	return i.index(req.Ctx, gatewaytypes.MCPSearchKindCatalogEntry, entry.Name,
+		searchFields(manifest.Name, manifest.ShortDescription, manifest.Description, slices.Concat(manifest.Tags, manifest.Categories()), manifest.ToolPreview))
+}
+
+// IndexServer indexes the manifest of a server, and removes it from the index when it is deleted. Templates and the
+// components of composite servers aren't searchable on their own, so they aren't indexed.
+func (i *Indexer) IndexServer(req router.Request, _ router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if !server.DeletionTimestamp.IsZero() || server.Spec.Template || server.Spec.CompositeName != "" {
+		return i.remove(req.Ctx, gatewaytypes.MCPSearchKindServer, server.Name)
+	}
+
+	manifest := server.Spec.Manifest
+	return i.index(req.Ctx, gatewaytypes.MCPSearchKindServer, server.Name,
+		searchFields(manifest.Name, manifest.ShortDescription, manifest.Description, nil, manifest.ToolPreview))
+}
+
+func (i *Indexer) index(ctx context.Context, kind, name string, fields []gclient.MCPSearchField) error {
+	if i.gatewayClient == nil {
+		return nil
+	}
+	return i.gatewayClient.IndexMCPSearchDocument(ctx, kind, name, fields...)
+}
+
+func (i *Indexer) remove(ctx context.Context, kind, name string) error {
+	if i.gatewayClient == nil {
+		return nil
+	}
+	return i.gatewayClient.DeleteMCPSearchDocument(ctx, kind, name)
+}
+
+func searchFields(name, shortDescription, description string, labels []string, tools []types.MCPServerTool) []gclient.MCPSearchField {
+	fields := []gclient.MCPSearchField{
+		{Text: name, Weight: nameWeight},
+		{Text: strings.Join(labels, " "), Weight: labelWeight},
+		{Text: shortDescription, Weight: shortDescriptionWeight},
+		{Text: description, Weight: descriptionWeight},
+	}
+	for _, tool := range tools {
+		fields = append(fields,
+			gclient.MCPSearchField{Text: tool.Name, Weight: toolNameWeight},
+			gclient.MCPSearchField{Text: tool.Description, Weight: toolDescriptionWeight},
+		)
+	}
+	return fields
+}
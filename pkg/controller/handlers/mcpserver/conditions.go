@@ -0,0 +1,153 @@
+package mcpserver
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateConditions sets the standard conditions and the connect blockers on the MCP server's status based on the state
+// reported by the other handlers.
+func (h *Handler) UpdateConditions(req router.Request, _ router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+
+	if setConditions(server) {
+		return h.statusUpdates.Update(req, server)
+	}
+
+	return nil
+}
+
+func setConditions(server *v1.MCPServer) bool {
+	generation := server.Generation
+	conditions := &server.Status.Conditions
+
+	changed := meta.SetStatusCondition(conditions, readyCondition(server.Status.DeploymentStatus, generation))
+
+	if server.Spec.MCPServerCatalogEntryName != "" && server.Spec.CompositeName == "" {
+		condition := metav1.Condition{
+			Type:               v1.MCPConditionDriftDetected,
+			Status:             metav1.ConditionFalse,
+			Reason:             "UpToDate",
+			Message:            "The server configuration matches its catalog entry",
+			ObservedGeneration: generation,
+		}
+		if server.Status.NeedsUpdate {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "CatalogEntryChanged"
+			condition.Message = "The catalog entry has changed and the server needs to be updated"
+		}
+		changed = meta.SetStatusCondition(conditions, condition) || changed
+	} else {
+		changed = meta.RemoveStatusCondition(conditions, v1.MCPConditionDriftDetected) || changed
+	}
+
+	if server.Status.K8sSettingsHash != "" {
+		condition := metav1.Condition{
+			Type:               v1.MCPConditionK8sSettingsApplied,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Applied",
+			Message:            "The server is deployed with the current Kubernetes settings",
+			ObservedGeneration: generation,
+		}
+		if server.Status.NeedsK8sUpdate {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "RedeployRequired"
+			condition.Message = "The Kubernetes settings have changed and the server needs to be redeployed"
+		}
+		changed = meta.SetStatusCondition(conditions, condition) || changed
+	} else {
+		changed = meta.RemoveStatusCondition(conditions, v1.MCPConditionK8sSettingsApplied) || changed
+	}
+
+	if requiresStaticOAuth(server.Spec.Manifest) {
+		changed = meta.SetStatusCondition(conditions, credentialConfiguredCondition(server.Status.OAuthCredentialConfigured, generation)) || changed
+	} else {
+		changed = meta.RemoveStatusCondition(conditions, v1.MCPConditionCredentialConfigured) || changed
+	}
+
+	if blockers := connectBlockers(server, time.Now()); !slices.Equal(blockers, server.Status.ConnectBlockers) {
+		server.Status.ConnectBlockers = blockers
+		changed = true
+	}
+
+	return changed
+}
+
+// connectBlockers returns the reasons that clients can't connect to the server at the given time that don't depend on
+// its credentials.
+func connectBlockers(server *v1.MCPServer, now time.Time) []types.MCPServerConnectBlocker {
+	var blockers []types.MCPServerConnectBlocker
+	if server.Spec.NeedsURL {
+		blockers = append(blockers, types.MCPServerConnectBlockerNeedsURL)
+	}
+	if requiresStaticOAuth(server.Spec.Manifest) && !server.Status.OAuthCredentialConfigured {
+		blockers = append(blockers, types.MCPServerConnectBlockerMissingOAuthCredentials)
+	}
+	// A server that is progressing or shut down is deployed when a client connects, so only failures block connecting.
+	switch {
+	case server.Status.DeploymentFailureReason != "",
+		server.Status.DeploymentStatus == "Unavailable",
+		server.Status.DeploymentStatus == "Needs Attention":
+		blockers = append(blockers, types.MCPServerConnectBlockerDeploymentFailed)
+	}
+	if server.Status.MaintenanceNotice.ActiveAt(now) {
+		blockers = append(blockers, types.MCPServerConnectBlockerInMaintenance)
+	}
+	return blockers
+}
+
+func readyCondition(deploymentStatus string, generation int64) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               v1.MCPConditionReady,
+		ObservedGeneration: generation,
+	}
+
+	switch deploymentStatus {
+	case "":
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "DeploymentStatusUnknown"
+		condition.Message = "The server's deployment status has not been reported"
+	case "Available":
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Available"
+		condition.Message = "The server is deployed and available"
+	default:
+		condition.Status = metav1.ConditionFalse
+		// Reasons must be CamelCase, so "Needs Attention" becomes "NeedsAttention".
+		condition.Reason = strings.ReplaceAll(deploymentStatus, " ", "")
+		condition.Message = "The server deployment status is " + deploymentStatus
+	}
+
+	return condition
+}
+
+func credentialConfiguredCondition(configured bool, generation int64) metav1.Condition {
+	if configured {
+		return metav1.Condition{
+			Type:               v1.MCPConditionCredentialConfigured,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Configured",
+			Message:            "OAuth credentials have been configured",
+			ObservedGeneration: generation,
+		}
+	}
+
+	return metav1.Condition{
+		Type:               v1.MCPConditionCredentialConfigured,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NotConfigured",
+		Message:            "OAuth credentials are required but have not been configured by an administrator",
+		ObservedGeneration: generation,
+	}
+}
+
+func requiresStaticOAuth(manifest types.MCPServerManifest) bool {
+	return manifest.Runtime == types.RuntimeRemote && manifest.RemoteConfig != nil && manifest.RemoteConfig.StaticOAuthRequired
+}
@@ -0,0 +1,67 @@
+package mcpserver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// credentialExpiryCheckInterval is how often the owner's OAuth token of a single-user server is checked. The health
+// of the tokens is checked against their authorization servers at the same interval.
+const credentialExpiryCheckInterval = time.Hour
+
+// CredentialExpiryChecker records in the status of single-user MCP servers whether their owner's stored OAuth token is
+// expiring soon or has to be replaced, so that the owner can be prompted to authenticate again before tool calls fail.
+type CredentialExpiryChecker struct {
+	gatewayClient *gclient.Client
+}
+
+func NewCredentialExpiryChecker(gatewayClient *gclient.Client) *CredentialExpiryChecker {
+	return &CredentialExpiryChecker{
+		gatewayClient: gatewayClient,
+	}
+}
+
+func (c *CredentialExpiryChecker) Check(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if !isUserScoped(server) || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	health, err := c.gatewayClient.GetMCPOAuthTokenHealthForMCPID(req.Ctx, server.Spec.UserID, server.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get OAuth token health of MCP server %s: %w", server.Name, err)
+	}
+
+	var (
+		status  types.MCPOAuthTokenStatus
+		warning string
+		expiry  metav1.Time
+	)
+	if health != nil {
+		status, warning = health.Status, health.Warning
+		if health.Expiry != nil && !health.Refreshable {
+			expiry = metav1.NewTime(health.Expiry.Time)
+		}
+	}
+
+	resp.RetryAfter(credentialExpiryCheckInterval)
+
+	if server.Status.CredentialStatus == status && server.Status.CredentialWarning == warning && server.Status.CredentialExpiry.Equal(&expiry) {
+		return nil
+	}
+
+	if status != server.Status.CredentialStatus && status != "" && status != types.MCPOAuthTokenStatusHealthy {
+		log.Infof("MCP server credential needs attention: server=%s user=%s status=%s", server.Name, server.Spec.UserID, status)
+	}
+
+	server.Status.CredentialStatus = status
+	server.Status.CredentialWarning = warning
+	server.Status.CredentialExpiry = expiry
+	return req.Client.Status().Update(req.Ctx, server)
+}
@@ -0,0 +1,114 @@
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	livenessProbeTimeout = 30 * time.Second
+
+	// livenessAuditCallType is the call type of the audit log entries recorded when a server becomes healthy or unhealthy.
+	livenessAuditCallType = "obot/liveness"
+)
+
+// LivenessProber periodically checks the deployments of MCP servers and records their health in the server's status,
+// so that the health history is available even when nobody is connected to the server.
+type LivenessProber struct {
+	mcpSessionManager *mcp.SessionManager
+	gatewayClient     *gclient.Client
+	interval          time.Duration
+}
+
+func NewLivenessProber(mcpSessionManager *mcp.SessionManager, gatewayClient *gclient.Client, interval time.Duration) *LivenessProber {
+	if interval <= 0 {
+		log.Infof("MCP server liveness probes: disabled")
+	} else {
+		log.Infof("MCP server liveness probes: every %s", interval)
+	}
+
+	return &LivenessProber{
+		mcpSessionManager: mcpSessionManager,
+		gatewayClient:     gatewayClient,
+		interval:          interval,
+	}
+}
+
+func (l *LivenessProber) Probe(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if l.interval <= 0 || server.Spec.Template || !server.DeletionTimestamp.IsZero() || server.Spec.Manifest.Runtime == types.RuntimeStdio {
+		return nil
+	}
+
+	if since := time.Since(server.Status.LastProbeTime.Time); since < l.interval {
+		resp.RetryAfter(l.interval - since)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(req.Ctx, livenessProbeTimeout)
+	details, err := l.mcpSessionManager.ProbeServer(ctx, server.Name)
+	cancel()
+
+	now := metav1.Now()
+	server.Status.LastProbeTime = now
+	server.Status.RestartCount = details.RestartCount
+	server.Status.DeploymentFailureReason = details.FailureReason
+	switch {
+	case errors.Is(err, mcp.ErrServerNotRunning):
+		// Servers that aren't deployed, such as those shut down for being idle, are neither healthy nor unhealthy.
+		server.Status.ConsecutiveProbeFailures = 0
+	case err != nil:
+		server.Status.ConsecutiveProbeFailures++
+		if server.Status.ConsecutiveProbeFailures == 1 {
+			log.Warnf("MCP server became unhealthy: server=%s error=%v", server.Name, err)
+			l.recordTransition(server, now, err)
+		}
+	default:
+		if server.Status.ConsecutiveProbeFailures > 0 {
+			log.Infof("MCP server recovered: server=%s failures=%d", server.Name, server.Status.ConsecutiveProbeFailures)
+			l.recordTransition(server, now, nil)
+		}
+		server.Status.ConsecutiveProbeFailures = 0
+		server.Status.LastHealthyTime = now
+		// A pod of an earlier rollout may still be failing, but the server is available.
+		server.Status.DeploymentFailureReason = ""
+	}
+
+	resp.RetryAfter(l.interval)
+	return req.Client.Status().Update(req.Ctx, server)
+}
+
+// recordTransition records an audit log entry for the server becoming unhealthy, or healthy again if err is nil.
+func (l *LivenessProber) recordTransition(server *v1.MCPServer, now metav1.Time, err error) {
+	if l.gatewayClient == nil {
+		return
+	}
+
+	entry := gtypes.MCPAuditLog{
+		CreatedAt:                 now.Time,
+		MCPID:                     server.Name,
+		PowerUserWorkspaceID:      server.Spec.PowerUserWorkspaceID,
+		MCPServerDisplayName:      server.Spec.Manifest.Name,
+		MCPServerCatalogEntryName: server.Spec.MCPServerCatalogEntryName,
+		CallType:                  livenessAuditCallType,
+		CallIdentifier:            "healthy",
+		ResponseStatus:            http.StatusOK,
+	}
+	if err != nil {
+		entry.CallIdentifier = "unhealthy"
+		entry.ResponseStatus = http.StatusServiceUnavailable
+		entry.Error = err.Error()
+	}
+
+	l.gatewayClient.LogMCPAuditEntry(entry)
+}
@@ -0,0 +1,45 @@
+package mcpserver
+
+import (
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// SyncMaintenanceNotice sets the maintenance notice that applies to the server in its status, so that the gateway
+// doesn't have to look up the catalog entry on every request. The server's own notice takes precedence over the one of
+// its catalog entry, and notices are dropped once their window ends.
+func (h *Handler) SyncMaintenanceNotice(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+
+	notice := server.Spec.MaintenanceNotice
+	if notice == nil && server.Spec.MCPServerCatalogEntryName != "" {
+		var entry v1.MCPServerCatalogEntry
+		if err := req.Get(&entry, server.Namespace, server.Spec.MCPServerCatalogEntryName); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		} else if err == nil {
+			notice = entry.Spec.MaintenanceNotice
+		}
+	}
+
+	now := time.Now()
+	switch {
+	case notice.Expired(now):
+		notice = nil
+	case now.Before(notice.StartTime.Time):
+		// Check again when the window starts, so that the server's connect blockers include the maintenance.
+		resp.RetryAfter(notice.StartTime.Time.Sub(now))
+	default:
+		resp.RetryAfter(notice.EndTime.Time.Sub(now))
+	}
+
+	if equality.Semantic.DeepEqual(server.Status.MaintenanceNotice, notice) {
+		return nil
+	}
+
+	server.Status.MaintenanceNotice = notice.DeepCopy()
+	return req.Client.Status().Update(req.Ctx, server)
+}
@@ -15,10 +15,12 @@ import (
 	"github.com/obot-platform/nah/pkg/untriggered"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/controller/statusupdate"
 	"github.com/obot-platform/obot/pkg/mcp"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/utils"
+	"github.com/obot-platform/obot/pkg/validation"
 	"golang.org/x/crypto/bcrypt"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,6 +40,8 @@ type Handler struct {
 	multiUserIdleShutdownDelay   time.Duration
 	agentIdleShutdownDelay       time.Duration
 	baseURL                      string
+	statusUpdates                *statusupdate.Coalescer
+	statusCounterInterval        time.Duration
 }
 
 func effectiveDenyAllEgress(v *bool, domains []string, defaultWhenEmpty bool) bool {
@@ -47,7 +51,7 @@ func effectiveDenyAllEgress(v *bool, domains []string, defaultWhenEmpty bool) bo
 	return defaultWhenEmpty && len(domains) == 0
 }
 
-func New(gptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager, networkPolicyProviderEnabled, defaultDenyAllEgress bool, singleUserIdleShutdownDelay, multiUserIdleShutdownDelay, agentIdleShutdownDelay time.Duration, baseURL string) *Handler {
+func New(gptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager, networkPolicyProviderEnabled, defaultDenyAllEgress bool, singleUserIdleShutdownDelay, multiUserIdleShutdownDelay, agentIdleShutdownDelay time.Duration, baseURL string, statusUpdates *statusupdate.Coalescer, statusCounterInterval time.Duration) *Handler {
 	return &Handler{
 		gptClient:                    gptClient,
 		mcpSessionManager:            mcpSessionManager,
@@ -57,6 +61,8 @@ func New(gptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager,
 		multiUserIdleShutdownDelay:   multiUserIdleShutdownDelay,
 		agentIdleShutdownDelay:       agentIdleShutdownDelay,
 		baseURL:                      baseURL,
+		statusUpdates:                statusUpdates,
+		statusCounterInterval:        statusCounterInterval,
 	}
 }
 
@@ -74,7 +80,19 @@ func (h *Handler) DetectDrift(req router.Request, _ router.Response) error {
 		return err
 	}
 
-	drifted, err := configurationHasDrifted(server.Spec.Manifest, entry.Spec.Manifest, h.defaultDenyAllEgress)
+	manifest := entry.Spec.Manifest
+	if server.Spec.PinnedCatalogEntryRevision > 0 {
+		// Pinned servers only drift from the revision they are pinned to, not from later changes to the catalog entry.
+		var revision v1.MCPServerCatalogEntryRevision
+		if err := req.Get(&revision, server.Namespace, v1.MCPServerCatalogEntryRevisionName(entry.Name, server.Spec.PinnedCatalogEntryRevision)); apierrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		manifest = revision.Spec.Manifest
+	}
+
+	drifted, err := configurationHasDrifted(server.Spec.Manifest, manifest, h.defaultDenyAllEgress)
 	if err != nil {
 		return err
 	}
@@ -82,7 +100,7 @@ func (h *Handler) DetectDrift(req router.Request, _ router.Response) error {
 	if server.Status.NeedsUpdate != drifted {
 		log.Infof("MCP server catalog drift status changed: server=%s catalogEntry=%s needsUpdate=%v", server.Name, server.Spec.MCPServerCatalogEntryName, drifted)
 		server.Status.NeedsUpdate = drifted
-		return req.Client.Status().Update(req.Ctx, server)
+		return h.statusUpdates.Update(req, server)
 	}
 	return nil
 }
@@ -230,7 +248,7 @@ func (h *Handler) DetectK8sSettingsDrift(req router.Request, _ router.Response)
 	if server.Status.K8sSettingsHash != currentHash && !server.Status.NeedsK8sUpdate {
 		log.Infof("MCP server requires K8s redeploy due to settings drift: server=%s previousHash=%s newHash=%s", server.Name, server.Status.K8sSettingsHash, currentHash)
 		server.Status.NeedsK8sUpdate = true
-		return req.Client.Status().Update(req.Ctx, server)
+		return h.statusUpdates.Update(req, server)
 	}
 
 	return nil
@@ -253,6 +271,10 @@ func configurationHasDrifted(serverManifest types.MCPServerManifest, entryManife
 		drifted = containerizedConfigHasDrifted(serverManifest.ContainerizedConfig, entryManifest.ContainerizedConfig, defaultDenyAllEgress)
 	case types.RuntimeRemote:
 		drifted = remoteConfigHasDrifted(serverManifest.RemoteConfig, entryManifest.RemoteConfig)
+	case types.RuntimeStdio:
+		drifted = stdioConfigHasDrifted(serverManifest.StdioConfig, entryManifest.StdioConfig)
+	case types.RuntimeSource:
+		drifted = sourceConfigHasDrifted(serverManifest.SourceConfig, entryManifest.SourceConfig)
 	case types.RuntimeComposite:
 		var err error
 		drifted, err = compositeConfigHasDrifted(serverManifest.CompositeConfig, entryManifest.CompositeConfig, defaultDenyAllEgress)
@@ -263,7 +285,8 @@ func configurationHasDrifted(serverManifest types.MCPServerManifest, entryManife
 		return false, fmt.Errorf("unknown runtime type: %s", serverManifest.Runtime)
 	}
 
-	if drifted {
+	if drifted || samplingConfigHasDrifted(serverManifest.Sampling, entryManifest.Sampling) ||
+		requestTimeoutsHaveDrifted(serverManifest.RequestTimeouts, entryManifest.RequestTimeouts) {
 		return true, nil
 	}
 
@@ -323,6 +346,65 @@ func containerizedConfigHasDrifted(serverConfig, entryConfig *types.Containerize
 			effectiveDenyAllEgress(entryConfig.DenyAllEgress, entryConfig.EgressDomains, defaultDenyAllEgress)
 }
 
+// stdioConfigHasDrifted checks if stdio configuration has drifted
+func stdioConfigHasDrifted(serverConfig, entryConfig *types.StdioRuntimeConfig) bool {
+	if serverConfig == nil && entryConfig == nil {
+		return false
+	}
+	if serverConfig == nil || entryConfig == nil {
+		return true
+	}
+
+	return serverConfig.Command != entryConfig.Command ||
+		serverConfig.Cwd != entryConfig.Cwd ||
+		!slices.Equal(serverConfig.Args, entryConfig.Args)
+}
+
+// sourceConfigHasDrifted checks if source configuration has drifted
+func sourceConfigHasDrifted(serverConfig, entryConfig *types.SourceRuntimeConfig) bool {
+	if serverConfig == nil && entryConfig == nil {
+		return false
+	}
+	if serverConfig == nil || entryConfig == nil {
+		return true
+	}
+
+	return serverConfig.Repository != entryConfig.Repository ||
+		serverConfig.Ref != entryConfig.Ref ||
+		serverConfig.BundleURL != entryConfig.BundleURL ||
+		serverConfig.ContextDir != entryConfig.ContextDir ||
+		serverConfig.Dockerfile != entryConfig.Dockerfile ||
+		serverConfig.Command != entryConfig.Command ||
+		serverConfig.Port != entryConfig.Port ||
+		serverConfig.Path != entryConfig.Path ||
+		!slices.Equal(serverConfig.Args, entryConfig.Args)
+}
+
+// samplingConfigHasDrifted checks if sampling configuration has drifted
+func samplingConfigHasDrifted(serverConfig, entryConfig *types.MCPSamplingConfig) bool {
+	if serverConfig == nil && entryConfig == nil {
+		return false
+	}
+	if serverConfig == nil || entryConfig == nil {
+		return true
+	}
+
+	return serverConfig.Enabled != entryConfig.Enabled ||
+		!slices.Equal(serverConfig.AllowedModels, entryConfig.AllowedModels)
+}
+
+// requestTimeoutsHaveDrifted checks if the request timeouts have drifted
+func requestTimeoutsHaveDrifted(serverTimeouts, entryTimeouts *types.MCPRequestTimeouts) bool {
+	if serverTimeouts == nil && entryTimeouts == nil {
+		return false
+	}
+	if serverTimeouts == nil || entryTimeouts == nil {
+		return true
+	}
+
+	return *serverTimeouts != *entryTimeouts
+}
+
 // remoteConfigHasDrifted checks if remote configuration has drifted
 func remoteConfigHasDrifted(serverConfig *types.RemoteRuntimeConfig, entryConfig *types.RemoteCatalogConfig) bool {
 	if serverConfig == nil && entryConfig == nil {
@@ -397,7 +479,8 @@ func compositeConfigHasDrifted(serverConfig *types.CompositeRuntimeConfig, entry
 }
 
 // EnsureMCPServerInstanceUserCount ensures that mcp server instance user count for multi-user MCP servers is up to date.
-func (*Handler) EnsureMCPServerInstanceUserCount(req router.Request, _ router.Response) error {
+// Changes of the count are throttled, because it changes whenever users add or remove the server.
+func (h *Handler) EnsureMCPServerInstanceUserCount(req router.Request, resp router.Response) error {
 	server := req.Object.(*v1.MCPServer)
 	if server.Spec.MCPCatalogID == "" && server.Spec.PowerUserWorkspaceID == "" {
 		// Server is not multi-user, ensure we're not tracking the instance user count
@@ -407,7 +490,7 @@ func (*Handler) EnsureMCPServerInstanceUserCount(req router.Request, _ router.Re
 
 		// Corrupt state, drop the field to fix it
 		server.Status.MCPServerInstanceUserCount = nil
-		return req.Client.Status().Update(req.Ctx, server)
+		return h.statusUpdates.Update(req, server)
 	}
 
 	// Get the set of unique users with server instances pointing to this MCP server
@@ -429,7 +512,7 @@ func (*Handler) EnsureMCPServerInstanceUserCount(req router.Request, _ router.Re
 	if oldUserCount, newUserCount := server.Status.MCPServerInstanceUserCount, len(uniqueUsers); oldUserCount == nil || *oldUserCount != newUserCount {
 		log.Infof("Updated MCP server instance user count: server=%s newCount=%d", server.Name, newUserCount)
 		server.Status.MCPServerInstanceUserCount = &newUserCount
-		return req.Client.Status().Update(req.Ctx, server)
+		return h.statusUpdates.UpdateThrottled(req, resp, server, h.statusCounterInterval)
 	}
 
 	return nil
@@ -468,7 +551,7 @@ func (h *Handler) EnsureMCPCatalogID(req router.Request, _ router.Response) erro
 
 		server.Status.MCPCatalogID = mcpCatalogEntry.Spec.MCPCatalogName
 		log.Infof("Resolved MCP catalog ID for server: server=%s catalogEntry=%s catalogID=%s", server.Name, server.Spec.MCPServerCatalogEntryName, server.Status.MCPCatalogID)
-		return req.Client.Status().Update(req.Ctx, server)
+		return h.statusUpdates.Update(req, server)
 	}
 
 	return nil
@@ -576,8 +659,9 @@ func (h *Handler) EnsureMCPServerSecretInfo(req router.Request, _ router.Respons
 	return nil
 }
 
-// CleanupNestedCompositeServers removes component servers with composite runtimes from composite MCP servers.
-// This handler cleans up servers that were created before API validation to prevent nested composite servers.
+// CleanupNestedCompositeServers removes nested composite servers that are nested more than
+// validation.MaxCompositeNestingDepth levels deep or that form cycles from composite MCP servers.
+// This handler cleans up servers that were created before API validation to limit nested composite servers.
 func (h *Handler) CleanupNestedCompositeServers(req router.Request, _ router.Response) error {
 	var (
 		server   = req.Object.(*v1.MCPServer)
@@ -589,18 +673,34 @@ func (h *Handler) CleanupNestedCompositeServers(req router.Request, _ router.Res
 		return nil
 	}
 
-	// Delete component servers with composite runtimes
-	if server.Spec.CompositeName != "" {
-		log.Infof("Deleting nested composite component server: server=%s parentComposite=%s", server.Name, server.Spec.CompositeName)
-		return kclient.IgnoreNotFound(req.Client.Delete(req.Ctx, server))
+	// Find how deep the server is nested in other composite servers.
+	var depth int
+	for parentName := server.Spec.CompositeName; parentName != ""; depth++ {
+		if depth >= validation.MaxCompositeNestingDepth {
+			// Delete component servers that are nested too deep
+			log.Infof("Deleting nested composite component server: server=%s parentComposite=%s", server.Name, server.Spec.CompositeName)
+			return kclient.IgnoreNotFound(req.Client.Delete(req.Ctx, server))
+		}
+
+		var parent v1.MCPServer
+		if err := req.Get(&parent, server.Namespace, parentName); apierrors.IsNotFound(err) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to get parent composite server %s: %w", parentName, err)
+		}
+		parentName = parent.Spec.CompositeName
 	}
-	// Remove all composite components from the server's manifest
+
+	// Remove the composite components that are nested too deep or that form cycles from the server's manifest
 	var (
 		components    = manifest.CompositeConfig.ComponentServers
 		numComponents = len(components)
 	)
 	components = slices.DeleteFunc(components, func(component types.ComponentServer) bool {
-		return component.Manifest.Runtime == types.RuntimeComposite
+		if component.Manifest.Runtime == types.RuntimeComposite && depth >= validation.MaxCompositeNestingDepth {
+			return true
+		}
+		return validation.ValidateComponentNesting(component, server.Name, server.Spec.MCPServerCatalogEntryName) != nil
 	})
 
 	if numComponents == len(components) {
@@ -789,7 +889,7 @@ func (h *Handler) EnsureCompositeComponents(req router.Request, _ router.Respons
 	if manifestHash := hash.Digest(manifest); compositeServer.Status.ObservedCompositeManifestHash != manifestHash {
 		compositeServer.Status.ObservedCompositeManifestHash = manifestHash
 		log.Infof("Updated observed composite manifest hash: composite=%s hash=%s", compositeServer.Name, manifestHash)
-		if err := req.Client.Status().Update(req.Ctx, compositeServer); err != nil {
+		if err := h.statusUpdates.Update(req, compositeServer); err != nil {
 			return fmt.Errorf("failed to update composite server status: %w", err)
 		}
 	}
@@ -805,7 +905,7 @@ func (h *Handler) SyncOAuthCredentialStatus(req router.Request, _ router.Respons
 
 	// Only relevant for servers created from catalog entries
 	if server.Spec.MCPServerCatalogEntryName == "" {
-		return clearOAuthStatusIfSet(req, server)
+		return h.clearOAuthStatusIfSet(req, server)
 	}
 
 	// Look up the catalog entry
@@ -824,14 +924,14 @@ func (h *Handler) SyncOAuthCredentialStatus(req router.Request, _ router.Respons
 		catalogEntry.Spec.Manifest.RemoteConfig.StaticOAuthRequired
 
 	if !requiresStaticOAuth {
-		return clearOAuthStatusIfSet(req, server)
+		return h.clearOAuthStatusIfSet(req, server)
 	}
 
 	// Sync status from catalog entry
 	if server.Status.OAuthCredentialConfigured != catalogEntry.Status.OAuthCredentialConfigured {
 		server.Status.OAuthCredentialConfigured = catalogEntry.Status.OAuthCredentialConfigured
 		log.Infof("Updated MCP server OAuth credential status from catalog entry: server=%s catalogEntry=%s configured=%v", server.Name, catalogEntry.Name, server.Status.OAuthCredentialConfigured)
-		return req.Client.Status().Update(req.Ctx, server)
+		return h.statusUpdates.Update(req, server)
 	}
 
 	return nil
@@ -879,11 +979,11 @@ func (h *Handler) ShutdownIdleServers(req router.Request, resp router.Response)
 }
 
 // clearOAuthStatusIfSet clears the OAuthCredentialConfigured status if it is currently set.
-func clearOAuthStatusIfSet(req router.Request, server *v1.MCPServer) error {
+func (h *Handler) clearOAuthStatusIfSet(req router.Request, server *v1.MCPServer) error {
 	if server.Status.OAuthCredentialConfigured {
 		server.Status.OAuthCredentialConfigured = false
 		log.Infof("Cleared MCP server OAuth credential status: server=%s", server.Name)
-		return req.Client.Status().Update(req.Ctx, server)
+		return h.statusUpdates.Update(req, server)
 	}
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -438,6 +439,31 @@ func TestConfigurationHasDrifted(t *testing.T) {
 			expectedDrift: false,
 			expectedError: true,
 		},
+		{
+			name: "drift - sampling allowed models changed",
+			serverManifest: types.MCPServerManifest{
+				Runtime: types.RuntimeUVX,
+				UVXConfig: &types.UVXRuntimeConfig{
+					Package: "test-package",
+				},
+				Sampling: &types.MCPSamplingConfig{
+					Enabled:       true,
+					AllowedModels: []string{"llm"},
+				},
+			},
+			entryManifest: types.MCPServerCatalogEntryManifest{
+				Runtime: types.RuntimeUVX,
+				UVXConfig: &types.UVXRuntimeConfig{
+					Package: "test-package",
+				},
+				Sampling: &types.MCPSamplingConfig{
+					Enabled:       true,
+					AllowedModels: []string{"llm-mini"},
+				},
+			},
+			expectedDrift: true,
+			expectedError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -941,3 +967,109 @@ func TestEnsureMCPNetworkPolicyDeletesPolicyForUnsupportedRuntime(t *testing.T)
 	}))
 	require.Empty(t, policies.Items)
 }
+
+func TestSetConditions(t *testing.T) {
+	server := &v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms1", Generation: 2},
+		Spec: v1.MCPServerSpec{
+			MCPServerCatalogEntryName: "entry1",
+			Manifest: types.MCPServerManifest{
+				Runtime: types.RuntimeRemote,
+				RemoteConfig: &types.RemoteRuntimeConfig{
+					StaticOAuthRequired: true,
+				},
+			},
+		},
+		Status: v1.MCPServerStatus{
+			DeploymentStatus: "Needs Attention",
+			NeedsUpdate:      true,
+			K8sSettingsHash:  "hash",
+			NeedsK8sUpdate:   true,
+		},
+	}
+
+	require.True(t, setConditions(server))
+	require.Len(t, server.Status.Conditions, 4)
+
+	conditions := make(map[string]metav1.Condition, len(server.Status.Conditions))
+	for _, c := range server.Status.Conditions {
+		assert.Equal(t, int64(2), c.ObservedGeneration)
+		conditions[c.Type] = c
+	}
+
+	assert.Equal(t, metav1.ConditionFalse, conditions[v1.MCPConditionReady].Status)
+	assert.Equal(t, "NeedsAttention", conditions[v1.MCPConditionReady].Reason)
+	assert.Equal(t, metav1.ConditionTrue, conditions[v1.MCPConditionDriftDetected].Status)
+	assert.Equal(t, metav1.ConditionFalse, conditions[v1.MCPConditionK8sSettingsApplied].Status)
+	assert.Equal(t, metav1.ConditionFalse, conditions[v1.MCPConditionCredentialConfigured].Status)
+	assert.Equal(t, []types.MCPServerConnectBlocker{
+		types.MCPServerConnectBlockerMissingOAuthCredentials,
+		types.MCPServerConnectBlockerDeploymentFailed,
+	}, server.Status.ConnectBlockers)
+
+	// Setting the conditions again without any changes should be a no-op.
+	require.False(t, setConditions(server))
+
+	server.Status.DeploymentStatus = "Available"
+	server.Status.NeedsUpdate = false
+	server.Status.NeedsK8sUpdate = false
+	server.Status.OAuthCredentialConfigured = true
+	server.Spec.MCPServerCatalogEntryName = ""
+
+	require.True(t, setConditions(server))
+	require.Len(t, server.Status.Conditions, 3)
+	for _, c := range server.Status.Conditions {
+		assert.Equal(t, metav1.ConditionTrue, c.Status, c.Type)
+	}
+	assert.Empty(t, server.Status.ConnectBlockers)
+}
+
+func compositeManifest(components ...types.ComponentServer) types.MCPServerManifest {
+	return types.MCPServerManifest{
+		Runtime:         types.RuntimeComposite,
+		CompositeConfig: &types.CompositeRuntimeConfig{ComponentServers: components},
+	}
+}
+
+func TestCleanupNestedCompositeServers(t *testing.T) {
+	leaf := types.ComponentServer{CatalogEntryID: "leaf", Manifest: types.MCPServerManifest{Runtime: types.RuntimeRemote}}
+
+	parent := newMCPServer("parent")
+	parent.Spec.Manifest = compositeManifest(
+		types.ComponentServer{CatalogEntryID: "nested", Manifest: compositeManifest(leaf)},
+		types.ComponentServer{CatalogEntryID: "cycle", Manifest: compositeManifest(types.ComponentServer{MCPServerID: "parent"})},
+	)
+
+	child := newMCPServer("child")
+	child.Spec.CompositeName = "parent"
+	child.Spec.Manifest = compositeManifest(leaf, types.ComponentServer{CatalogEntryID: "deeper", Manifest: compositeManifest(leaf)})
+
+	grandchild := newMCPServer("grandchild")
+	grandchild.Spec.CompositeName = "child"
+	grandchild.Spec.Manifest = compositeManifest(leaf)
+
+	client := newFakeClient(t, parent, child, grandchild)
+	h := &Handler{}
+	for _, server := range []*v1.MCPServer{parent, child, grandchild} {
+		require.NoError(t, h.CleanupNestedCompositeServers(router.Request{
+			Client: client,
+			Ctx:    context.Background(),
+			Object: server,
+		}, nil))
+	}
+
+	// One level of nesting is kept, and the component that contains the composite server is removed.
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), kclient.ObjectKeyFromObject(parent), &updated))
+	require.Len(t, updated.Spec.Manifest.CompositeConfig.ComponentServers, 1)
+	assert.Equal(t, "nested", updated.Spec.Manifest.CompositeConfig.ComponentServers[0].CatalogEntryID)
+
+	// The nested composite server can't have composite components.
+	require.NoError(t, client.Get(context.Background(), kclient.ObjectKeyFromObject(child), &updated))
+	require.Len(t, updated.Spec.Manifest.CompositeConfig.ComponentServers, 1)
+	assert.Equal(t, "leaf", updated.Spec.Manifest.CompositeConfig.ComponentServers[0].CatalogEntryID)
+
+	// Composite servers nested too deep are deleted.
+	err := client.Get(context.Background(), kclient.ObjectKeyFromObject(grandchild), &updated)
+	assert.True(t, apierrors.IsNotFound(err), "expected the grandchild to be deleted, got %v", err)
+}
@@ -0,0 +1,79 @@
+package mcpserver
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+)
+
+// memoryUsageCheckInterval is how often the size of the memories of a memory MCP server is measured.
+const memoryUsageCheckInterval = 15 * time.Minute
+
+// MemoryProvisioner manages the stores of the Obot-managed memory MCP servers in Obot's database: it creates the
+// schema of each server before the server is deployed, records how much of its quota the server uses, and drops the
+// schema when the server is deleted.
+type MemoryProvisioner struct {
+	gatewayClient *gclient.Client
+	quotaBytes    int64
+}
+
+func NewMemoryProvisioner(gatewayClient *gclient.Client, quotaBytes int64) *MemoryProvisioner {
+	return &MemoryProvisioner{
+		gatewayClient: gatewayClient,
+		quotaBytes:    quotaBytes,
+	}
+}
+
+func (m *MemoryProvisioner) Provision(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if !mcp.IsMemoryServer(server.Spec.MCPServerCatalogEntryName) || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	schema := system.MemoryStoreSchema(server.Name)
+	if err := m.gatewayClient.EnsureMemoryStore(req.Ctx, schema); errors.Is(err, gclient.ErrMemoryStoresUnsupported) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to provision memory store of MCP server %s: %w", server.Name, err)
+	}
+
+	size, err := m.gatewayClient.MemoryStoreSize(req.Ctx, schema)
+	if err != nil {
+		return fmt.Errorf("failed to get size of memory store of MCP server %s: %w", server.Name, err)
+	}
+
+	resp.RetryAfter(memoryUsageCheckInterval)
+
+	if server.Status.MemoryUsageBytes == size && server.Status.MemoryQuotaBytes == m.quotaBytes {
+		return nil
+	}
+
+	if m.quotaBytes > 0 && size >= m.quotaBytes && server.Status.MemoryUsageBytes < m.quotaBytes {
+		log.Infof("Memory MCP server reached its quota: server=%s user=%s size=%d quota=%d", server.Name, server.Spec.UserID, size, m.quotaBytes)
+	}
+
+	server.Status.MemoryUsageBytes = size
+	server.Status.MemoryQuotaBytes = m.quotaBytes
+	return req.Client.Status().Update(req.Ctx, server)
+}
+
+// Deprovision drops the store of a memory MCP server that is deleted, along with all of its memories.
+func (m *MemoryProvisioner) Deprovision(req router.Request, _ router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if !mcp.IsMemoryServer(server.Spec.MCPServerCatalogEntryName) {
+		return nil
+	}
+
+	if err := m.gatewayClient.DeleteMemoryStore(req.Ctx, system.MemoryStoreSchema(server.Name)); err != nil && !errors.Is(err, gclient.ErrMemoryStoresUnsupported) {
+		return fmt.Errorf("failed to delete memory store of MCP server %s: %w", server.Name, err)
+	}
+
+	log.Infof("Deleted memory store of MCP server: server=%s", server.Name)
+	return nil
+}
@@ -0,0 +1,205 @@
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Prewarmer deploys popular multi-user MCP servers that were shut down while idle again shortly before their usage
+// peaks, so that the first users of the day don't wait for the servers to start. The peaks are learned from the
+// servers' audit logs.
+type Prewarmer struct {
+	mcpSessionManager *mcp.SessionManager
+	gatewayClient     *gclient.Client
+	gptClient         *gptscript.GPTScript
+	serverURL         string
+	lookback          time.Duration
+	lead              time.Duration
+	minUsers          int
+}
+
+func NewPrewarmer(mcpSessionManager *mcp.SessionManager, gatewayClient *gclient.Client, gptClient *gptscript.GPTScript, serverURL string, lookback, lead time.Duration, minUsers int) *Prewarmer {
+	if lookback <= 0 {
+		log.Infof("MCP server pre-warming: disabled")
+	} else {
+		log.Infof("MCP server pre-warming: %s before usage peaks learned from %s of audit logs, for servers with at least %d users", lead, lookback, minUsers)
+	}
+
+	return &Prewarmer{
+		mcpSessionManager: mcpSessionManager,
+		gatewayClient:     gatewayClient,
+		gptClient:         gptClient,
+		serverURL:         serverURL,
+		lookback:          lookback,
+		lead:              lead,
+		minUsers:          minUsers,
+	}
+}
+
+// isPrewarmable returns true for multi-user MCP servers that can be deployed without a user, which excludes servers
+// that source values from their users' OAuth tokens.
+func isPrewarmable(server *v1.MCPServer) bool {
+	return (server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "") &&
+		server.Spec.Manifest.Runtime != types.RuntimeComposite &&
+		server.Spec.Manifest.Runtime != types.RuntimeStdio &&
+		!server.Spec.Template &&
+		!server.Spec.NeedsURL &&
+		len(mcp.OAuthTokenSourceApps(server.Spec.Manifest)) == 0
+}
+
+func (p *Prewarmer) Prewarm(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if p.lookback <= 0 || !isPrewarmable(server) || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	stats, err := p.gatewayClient.GetMCPUserHourlyCallStats(req.Ctx, gclient.MCPAuditLogOptions{
+		MCPID:     []string{server.Name},
+		StartTime: now.Add(-p.lookback),
+		EndTime:   now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get usage of MCP server %s: %w", server.Name, err)
+	}
+
+	users := make(map[string]struct{}, len(stats))
+	for _, stat := range stats {
+		users[stat.UserID] = struct{}{}
+	}
+	if len(users) < p.minUsers {
+		return nil
+	}
+
+	at, due := nextPrewarm(now, usagePeakHours(stats), p.lead)
+	if at.IsZero() {
+		return nil
+	}
+	if !due {
+		if wait := at.Sub(now); wait < 10*time.Hour {
+			// All objects are retried every 10 hours. If we should retry sooner, then trigger a retry.
+			resp.RetryAfter(wait)
+		}
+		return nil
+	}
+
+	// Look for the next peak once this one has started.
+	resp.RetryAfter(p.lead)
+
+	if !server.Status.LastPrewarmTime.Time.Before(at) {
+		return nil
+	}
+
+	if _, err = p.mcpSessionManager.ProbeServer(req.Ctx, server.Name); !errors.Is(err, mcp.ErrServerNotRunning) {
+		// The server is running, or is deployed but not ready, which the liveness prober takes care of.
+		return nil
+	}
+
+	serverConfig, err := p.serverConfig(req.Ctx, *server)
+	if err != nil {
+		log.Warnf("Failed to pre-warm MCP server: server=%s error=%v", server.Name, err)
+		return nil
+	}
+
+	log.Infof("Pre-warming MCP server ahead of its usage peak: server=%s peak=%s", server.Name, at.Add(p.lead).Format(time.RFC3339))
+	p.mcpSessionManager.StartLaunch(server.Name, func(ctx context.Context) error {
+		_, err := p.mcpSessionManager.LaunchServer(ctx, serverConfig)
+		return err
+	}, func(err error) string {
+		return err.Error()
+	})
+
+	server.Status.LastPrewarmTime = metav1.NewTime(now)
+	// Count the pre-warm as a request, so that the server isn't shut down for being idle before its peak.
+	server.Status.LastRequestTime = metav1.NewTime(now)
+	return req.Client.Status().Update(req.Ctx, server)
+}
+
+// serverConfig returns the config that a multi-user server is deployed with, which doesn't depend on its users.
+func (p *Prewarmer) serverConfig(ctx context.Context, server v1.MCPServer) (mcp.ServerConfig, error) {
+	scope := server.Spec.MCPCatalogID
+	if scope == "" {
+		scope = server.Spec.PowerUserWorkspaceID
+	}
+
+	cred, err := p.gptClient.RevealCredential(ctx, []string{fmt.Sprintf("%s-%s", scope, server.Name)}, server.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return mcp.ServerConfig{}, fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	tokenExchangeCred, err := p.gptClient.RevealCredential(ctx, []string{server.Name}, server.Name)
+	if err != nil {
+		return mcp.ServerConfig{}, fmt.Errorf("failed to find token exchange credential: %w", err)
+	}
+
+	serverConfig, missingConfig, err := mcp.ServerToServerConfig(server, server.ValidConnectURLs(p.serverURL), p.serverURL, server.Spec.UserID, scope, scope, cred.Env, tokenExchangeCred.Env)
+	if err != nil {
+		return mcp.ServerConfig{}, err
+	}
+	if len(missingConfig) > 0 {
+		return mcp.ServerConfig{}, fmt.Errorf("missing required config: %v", missingConfig)
+	}
+
+	return serverConfig, nil
+}
+
+// usagePeakHours returns the UTC hours of the day that the usage peaks of a server start at. Peak hours are the hours
+// with at least half as many calls as the busiest hour, and a peak starts at a peak hour that doesn't follow another.
+func usagePeakHours(stats []gtypes.MCPUserHourlyCallStat) []int {
+	var calls [24]int64
+	for _, stat := range stats {
+		hour, err := time.Parse("2006-01-02 15", stat.Hour)
+		if err != nil {
+			continue
+		}
+		calls[hour.Hour()] += stat.CallCount
+	}
+
+	busiest := slices.Max(calls[:])
+	if busiest == 0 {
+		return nil
+	}
+
+	isPeak := func(hour int) bool {
+		return calls[(hour+24)%24]*2 >= busiest
+	}
+
+	var starts []int
+	for hour := range calls {
+		if isPeak(hour) && !isPeak(hour-1) {
+			starts = append(starts, hour)
+		}
+	}
+	return starts
+}
+
+// nextPrewarm returns the start of the next period before a usage peak that a server is deployed in, and whether now
+// is in that period. It returns the zero time if there are no peaks.
+func nextPrewarm(now time.Time, peakHours []int, lead time.Duration) (time.Time, bool) {
+	var next time.Time
+	for day := range 2 {
+		for _, hour := range peakHours {
+			peak := time.Date(now.Year(), now.Month(), now.Day()+day, hour, 0, 0, 0, time.UTC)
+			from := peak.Add(-lead)
+			if !now.Before(from) && now.Before(peak) {
+				return from, true
+			}
+			if from.After(now) && (next.IsZero() || from.Before(next)) {
+				next = from
+			}
+		}
+	}
+	return next, false
+}
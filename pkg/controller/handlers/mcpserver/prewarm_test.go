@@ -0,0 +1,48 @@
+package mcpserver
+
+import (
+	"testing"
+	"time"
+
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsagePeakHours(t *testing.T) {
+	stats := []gtypes.MCPUserHourlyCallStat{
+		{Hour: "2026-10-12 08", UserID: "user-1", CallCount: 2},
+		{Hour: "2026-10-12 09", UserID: "user-1", CallCount: 10},
+		{Hour: "2026-10-13 09", UserID: "user-2", CallCount: 6},
+		{Hour: "2026-10-12 10", UserID: "user-2", CallCount: 9},
+		{Hour: "2026-10-12 12", UserID: "user-3", CallCount: 1},
+		{Hour: "2026-10-12 13", UserID: "user-3", CallCount: 8},
+		{Hour: "2026-10-12 23", UserID: "user-1", CallCount: 8},
+		{Hour: "2026-10-13 00", UserID: "user-1", CallCount: 8},
+		{Hour: "invalid", UserID: "user-1", CallCount: 100},
+	}
+
+	assert.Equal(t, []int{9, 13, 23}, usagePeakHours(stats))
+	assert.Empty(t, usagePeakHours(nil))
+}
+
+func TestNextPrewarm(t *testing.T) {
+	lead := 15 * time.Minute
+	peaks := []int{0, 9, 13}
+
+	at, due := nextPrewarm(time.Date(2026, 10, 17, 8, 50, 0, 0, time.UTC), peaks, lead)
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2026, 10, 17, 8, 45, 0, 0, time.UTC), at)
+
+	at, due = nextPrewarm(time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC), peaks, lead)
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2026, 10, 17, 12, 45, 0, 0, time.UTC), at)
+
+	// The peak at midnight is pre-warmed for the day before.
+	at, due = nextPrewarm(time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC), peaks, lead)
+	assert.False(t, due)
+	assert.Equal(t, time.Date(2026, 10, 17, 23, 45, 0, 0, time.UTC), at)
+
+	at, due = nextPrewarm(time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC), nil, lead)
+	assert.False(t, due)
+	assert.True(t, at.IsZero())
+}
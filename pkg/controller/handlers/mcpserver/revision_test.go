@@ -0,0 +1,71 @@
+package mcpserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/controller/statusupdate"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func uvxCatalogEntryManifest(pkg string) types.MCPServerCatalogEntryManifest {
+	return types.MCPServerCatalogEntryManifest{
+		Runtime:   types.RuntimeUVX,
+		UVXConfig: &types.UVXRuntimeConfig{Package: pkg},
+	}
+}
+
+func TestDetectDriftComparesPinnedServersToTheirRevision(t *testing.T) {
+	entry := &v1.MCPServerCatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry", Namespace: "default"},
+		Spec:       v1.MCPServerCatalogEntrySpec{Manifest: uvxCatalogEntryManifest("server@2")},
+		Status:     v1.MCPServerCatalogEntryStatus{Revision: 2},
+	}
+	revision := &v1.MCPServerCatalogEntryRevision{
+		ObjectMeta: metav1.ObjectMeta{Name: v1.MCPServerCatalogEntryRevisionName("entry", 1), Namespace: "default"},
+		Spec: v1.MCPServerCatalogEntryRevisionSpec{
+			MCPServerCatalogEntryName: "entry",
+			Revision:                  1,
+			Manifest:                  uvxCatalogEntryManifest("server@1"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		pinnedRevision  int
+		wantNeedsUpdate bool
+	}{
+		{name: "unpinned", wantNeedsUpdate: true},
+		{name: "pinned", pinnedRevision: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMCPServer("server")
+			server.Spec.MCPServerCatalogEntryName = "entry"
+			server.Spec.PinnedCatalogEntryRevision = tt.pinnedRevision
+			server.Spec.Manifest = types.MCPServerManifest{
+				Runtime:   types.RuntimeUVX,
+				UVXConfig: &types.UVXRuntimeConfig{Package: "server@1"},
+			}
+
+			client := newFakeClient(t, server, entry.DeepCopy(), revision.DeepCopy())
+			req := router.Request{
+				Client:    client,
+				Ctx:       context.Background(),
+				Object:    server,
+				Namespace: server.Namespace,
+				Name:      server.Name,
+			}
+			require.NoError(t, (&Handler{statusUpdates: statusupdate.New()}).DetectDrift(req, &router.ResponseWrapper{}))
+
+			var updated v1.MCPServer
+			require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+			assert.Equal(t, tt.wantNeedsUpdate, updated.Status.NeedsUpdate)
+		})
+	}
+}
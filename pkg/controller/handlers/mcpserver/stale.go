@@ -0,0 +1,212 @@
+package mcpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"gorm.io/gorm"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const staleNotificationTimeout = 10 * time.Second
+
+// StaleServerReaper flags single-user MCP servers that haven't been used for a while, notifies their owners, and
+// optionally shuts down or deletes them after a grace period, to reclaim capacity from abandoned servers.
+type StaleServerReaper struct {
+	mcpSessionManager *mcp.SessionManager
+	gatewayClient     *gclient.Client
+	httpClient        *http.Client
+	staleAfter        time.Duration
+	gracePeriod       time.Duration
+	action            types.MCPServerStaleAction
+	webhookURL        string
+	webhookSecret     string
+}
+
+func NewStaleServerReaper(mcpSessionManager *mcp.SessionManager, gatewayClient *gclient.Client, staleAfter, gracePeriod time.Duration, action types.MCPServerStaleAction, webhookURL, webhookSecret string) *StaleServerReaper {
+	if staleAfter <= 0 {
+		log.Infof("Stale MCP server cleanup: disabled")
+	} else {
+		log.Infof("Stale MCP server cleanup: flag after %s, action=%s after %s", staleAfter, action, gracePeriod)
+	}
+
+	return &StaleServerReaper{
+		mcpSessionManager: mcpSessionManager,
+		gatewayClient:     gatewayClient,
+		httpClient:        &http.Client{Timeout: staleNotificationTimeout},
+		staleAfter:        staleAfter,
+		gracePeriod:       gracePeriod,
+		action:            action,
+		webhookURL:        webhookURL,
+		webhookSecret:     webhookSecret,
+	}
+}
+
+// isUserScoped returns true for MCP servers that belong to a single user, rather than to a catalog, workspace, project,
+// agent, or composite server.
+func isUserScoped(server *v1.MCPServer) bool {
+	return server.Spec.UserID != "" &&
+		server.Spec.MCPCatalogID == "" &&
+		server.Spec.PowerUserWorkspaceID == "" &&
+		server.Spec.ThreadName == "" &&
+		server.Spec.NanobotAgentID == "" &&
+		server.Spec.CompositeName == "" &&
+		!server.Spec.Template
+}
+
+func (s *StaleServerReaper) Reap(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if s.staleAfter <= 0 || !isUserScoped(server) || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	lastUsed := server.CreationTimestamp.Time
+	if server.Status.LastRequestTime.After(lastUsed) {
+		lastUsed = server.Status.LastRequestTime.Time
+	}
+
+	now := time.Now()
+	if idle := now.Sub(lastUsed); idle < s.staleAfter {
+		if retry := s.staleAfter - idle; retry < 10*time.Hour {
+			// All objects are retried every 10 hours. If we should retry sooner, then trigger a retry.
+			resp.RetryAfter(retry)
+		}
+
+		if server.Status.StaleSince.IsZero() {
+			return nil
+		}
+
+		// The server was used again since it was flagged.
+		log.Infof("Stale MCP server used again: server=%s", server.Name)
+		server.Status.StaleSince = metav1.Time{}
+		server.Status.StaleAction = ""
+		server.Status.StaleActionTime = metav1.Time{}
+		server.Status.StaleShutdown = false
+		return req.Client.Status().Update(req.Ctx, server)
+	}
+
+	if server.Status.StaleSince.IsZero() {
+		notification := types.MCPServerStaleNotification{
+			Type:     types.MCPServerStaleEventTypeFlagged,
+			LastUsed: types.Time{Time: lastUsed},
+		}
+		if s.action != types.MCPServerStaleActionNone {
+			notification.Action = s.action
+			notification.ActionTime = types.NewTime(now.Add(s.gracePeriod))
+		}
+		if err := s.notify(req.Ctx, server, notification); err != nil {
+			// Try again on the next retry, so that the owner is notified before anything happens to the server.
+			return fmt.Errorf("failed to notify owner of stale MCP server %s: %w", server.Name, err)
+		}
+
+		log.Infof("Flagged stale MCP server: server=%s user=%s lastUsed=%s", server.Name, server.Spec.UserID, lastUsed.Format(time.RFC3339))
+		server.Status.StaleSince = metav1.NewTime(now)
+		if notification.ActionTime != nil {
+			server.Status.StaleAction = s.action
+			server.Status.StaleActionTime = metav1.NewTime(notification.ActionTime.Time)
+		}
+		return req.Client.Status().Update(req.Ctx, server)
+	}
+
+	if server.Status.StaleAction == "" || server.Status.StaleShutdown {
+		return nil
+	}
+	if remaining := server.Status.StaleActionTime.Sub(now); remaining > 0 {
+		if remaining < 10*time.Hour {
+			resp.RetryAfter(remaining)
+		}
+		return nil
+	}
+
+	notification := types.MCPServerStaleNotification{
+		LastUsed: types.Time{Time: lastUsed},
+	}
+	switch server.Status.StaleAction {
+	case types.MCPServerStaleActionShutdown:
+		if err := s.mcpSessionManager.ShutdownIdleServer(req.Ctx, server.Name); err != nil {
+			return fmt.Errorf("failed to shutdown stale server %s: %w", server.Name, err)
+		}
+
+		log.Infof("Shut down stale MCP server: server=%s user=%s", server.Name, server.Spec.UserID)
+		notification.Type = types.MCPServerStaleEventTypeShutdown
+		s.notifyBestEffort(req.Ctx, server, notification)
+
+		server.Status.StaleShutdown = true
+		return req.Client.Status().Update(req.Ctx, server)
+	case types.MCPServerStaleActionDelete:
+		if err := req.Client.Delete(req.Ctx, server); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete stale server %s: %w", server.Name, err)
+		}
+
+		log.Infof("Deleted stale MCP server: server=%s user=%s", server.Name, server.Spec.UserID)
+		notification.Type = types.MCPServerStaleEventTypeDeleted
+		s.notifyBestEffort(req.Ctx, server, notification)
+	}
+
+	return nil
+}
+
+// notifyBestEffort notifies the owner of a server of something that already happened to it, where retrying isn't possible.
+func (s *StaleServerReaper) notifyBestEffort(ctx context.Context, server *v1.MCPServer, notification types.MCPServerStaleNotification) {
+	if err := s.notify(ctx, server, notification); err != nil {
+		log.Warnf("Failed to notify owner of stale MCP server: server=%s type=%s error=%v", server.Name, notification.Type, err)
+	}
+}
+
+func (s *StaleServerReaper) notify(ctx context.Context, server *v1.MCPServer, notification types.MCPServerStaleNotification) error {
+	if s.webhookURL == "" {
+		// Owners see the flag on their servers in the API.
+		return nil
+	}
+
+	notification.MCPID = server.Name
+	notification.MCPServerDisplayName = server.Spec.Manifest.Name
+	notification.UserID = server.Spec.UserID
+	if s.gatewayClient != nil {
+		user, err := s.gatewayClient.UserByID(ctx, server.Spec.UserID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get owner: %w", err)
+		} else if err == nil {
+			notification.UserEmail = user.Email
+		}
+	}
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.webhookSecret != "" {
+		webhooksignature.SetHeaders(req.Header, s.webhookSecret, time.Now(), body)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
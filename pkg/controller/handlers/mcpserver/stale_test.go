@@ -0,0 +1,160 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newStaleMCPServer(name string, lastUsed time.Time) *v1.MCPServer {
+	server := newMCPServer(name)
+	server.Spec.UserID = "user-1"
+	server.Spec.Manifest.Name = "Experiment"
+	server.CreationTimestamp = metav1.NewTime(lastUsed.Add(-time.Hour))
+	server.Status.LastRequestTime = metav1.NewTime(lastUsed)
+	return server
+}
+
+func TestReapFlagsStaleServersAndNotifiesOwner(t *testing.T) {
+	var notification types.MCPServerStaleNotification
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler := webhooksignature.Middleware("secret", 0, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&notification)
+		}))
+		handler.ServeHTTP(w, r)
+	}))
+	defer webhook.Close()
+
+	lastUsed := time.Now().Add(-40 * 24 * time.Hour)
+	server := newStaleMCPServer("stale-server", lastUsed)
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	reaper := NewStaleServerReaper(nil, nil, 30*24*time.Hour, 7*24*time.Hour, types.MCPServerStaleActionDelete, webhook.URL, "secret")
+	require.NoError(t, reaper.Reap(req, &router.ResponseWrapper{}))
+
+	assert.Equal(t, types.MCPServerStaleEventTypeFlagged, notification.Type)
+	assert.Equal(t, "stale-server", notification.MCPID)
+	assert.Equal(t, "Experiment", notification.MCPServerDisplayName)
+	assert.Equal(t, "user-1", notification.UserID)
+	assert.Equal(t, types.MCPServerStaleActionDelete, notification.Action)
+	assert.WithinDuration(t, lastUsed, notification.LastUsed.Time, time.Second)
+	require.NotNil(t, notification.ActionTime)
+	assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), notification.ActionTime.Time, 5*time.Second)
+
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+	assert.WithinDuration(t, time.Now(), updated.Status.StaleSince.Time, 5*time.Second)
+	assert.Equal(t, types.MCPServerStaleActionDelete, updated.Status.StaleAction)
+	assert.WithinDuration(t, notification.ActionTime.Time, updated.Status.StaleActionTime.Time, time.Second)
+}
+
+func TestReapKeepsServerFlaggedWhenNotificationFails(t *testing.T) {
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer webhook.Close()
+
+	server := newStaleMCPServer("stale-server", time.Now().Add(-40*24*time.Hour))
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	reaper := NewStaleServerReaper(nil, nil, 30*24*time.Hour, 7*24*time.Hour, types.MCPServerStaleActionNone, webhook.URL, "")
+	require.Error(t, reaper.Reap(req, &router.ResponseWrapper{}))
+
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+	assert.True(t, updated.Status.StaleSince.IsZero())
+}
+
+func TestReapClearsFlagWhenServerIsUsedAgain(t *testing.T) {
+	server := newStaleMCPServer("used-again", time.Now().Add(-time.Hour))
+	server.Status.StaleSince = metav1.NewTime(time.Now().Add(-2 * 24 * time.Hour))
+	server.Status.StaleAction = types.MCPServerStaleActionShutdown
+	server.Status.StaleActionTime = metav1.NewTime(time.Now().Add(5 * 24 * time.Hour))
+
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	reaper := NewStaleServerReaper(nil, nil, 30*24*time.Hour, 7*24*time.Hour, types.MCPServerStaleActionShutdown, "", "")
+	require.NoError(t, reaper.Reap(req, &router.ResponseWrapper{}))
+
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+	assert.True(t, updated.Status.StaleSince.IsZero())
+	assert.Empty(t, updated.Status.StaleAction)
+	assert.True(t, updated.Status.StaleActionTime.IsZero())
+}
+
+func TestReapDeletesStaleServersAfterGracePeriod(t *testing.T) {
+	server := newStaleMCPServer("abandoned", time.Now().Add(-40*24*time.Hour))
+	server.Status.StaleSince = metav1.NewTime(time.Now().Add(-8 * 24 * time.Hour))
+	server.Status.StaleAction = types.MCPServerStaleActionDelete
+	server.Status.StaleActionTime = metav1.NewTime(time.Now().Add(-24 * time.Hour))
+
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	reaper := NewStaleServerReaper(nil, nil, 30*24*time.Hour, 7*24*time.Hour, types.MCPServerStaleActionDelete, "", "")
+	require.NoError(t, reaper.Reap(req, &router.ResponseWrapper{}))
+
+	err := client.Get(context.Background(), router.Key(server.Namespace, server.Name), &v1.MCPServer{})
+	assert.True(t, apierrors.IsNotFound(err), "expected the server to be deleted, got %v", err)
+}
+
+func TestReapIgnoresServersThatAreNotUserScoped(t *testing.T) {
+	server := newStaleMCPServer("shared", time.Now().Add(-40*24*time.Hour))
+	server.Spec.MCPCatalogID = "catalog-1"
+
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	reaper := NewStaleServerReaper(nil, nil, 30*24*time.Hour, 7*24*time.Hour, types.MCPServerStaleActionDelete, "", "")
+	require.NoError(t, reaper.Reap(req, &router.ResponseWrapper{}))
+
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+	assert.True(t, updated.Status.StaleSince.IsZero())
+}
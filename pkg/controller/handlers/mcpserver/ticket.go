@@ -0,0 +1,215 @@
+package mcpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	failureTicketTimeout = 30 * time.Second
+
+	// failureTicketMaxEvents is the number of the most recent deployment events that are included in a failure report.
+	failureTicketMaxEvents = 20
+)
+
+// FailureTicketCreator opens a ticket in an external system, such as ServiceNow or Jira, when the liveness prober finds
+// that the deployment of an MCP server is failing permanently. One ticket is created for each failure: another is only
+// created if the server fails again after recovering.
+type FailureTicketCreator struct {
+	mcpSessionManager *mcp.SessionManager
+	httpClient        *http.Client
+	url               string
+	template          *template.Template
+	authorization     string
+}
+
+// NewFailureTicketCreator returns a FailureTicketCreator that posts the rendered bodyTemplate, or the JSON diagnostics
+// report of the server if it is nil, to url. Tickets aren't created if url is empty.
+func NewFailureTicketCreator(mcpSessionManager *mcp.SessionManager, url string, bodyTemplate *template.Template, authorization string) *FailureTicketCreator {
+	if url == "" {
+		log.Infof("MCP server failure tickets: disabled")
+	} else {
+		log.Infof("MCP server failure tickets: enabled")
+	}
+
+	return &FailureTicketCreator{
+		mcpSessionManager: mcpSessionManager,
+		httpClient:        &http.Client{Timeout: failureTicketTimeout},
+		url:               url,
+		template:          bodyTemplate,
+		authorization:     authorization,
+	}
+}
+
+// ParseFailureTicketTemplate parses the template of the body of ticket requests. Its data is a
+// types.MCPServerFailureReport, and its json function encodes a value as JSON. It returns nil if text is empty.
+func ParseFailureTicketTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	tmpl, err := template.New("failure-ticket").Funcs(template.FuncMap{
+		"json": toJSON,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse MCP server failure ticket template: %w", err)
+	}
+	return tmpl, nil
+}
+
+func (f *FailureTicketCreator) CreateTicket(req router.Request, _ router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if f.url == "" || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if server.Status.DeploymentFailureReason == "" {
+		if server.Status.FailureTicketTime.IsZero() {
+			return nil
+		}
+
+		// The server recovered, so create a new ticket if it fails again.
+		server.Status.FailureTicketTime = metav1.Time{}
+		return req.Client.Status().Update(req.Ctx, server)
+	}
+
+	if !server.Status.FailureTicketTime.IsZero() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(req.Ctx, failureTicketTimeout)
+	defer cancel()
+
+	var details types.MCPServerDetails
+	if f.mcpSessionManager != nil {
+		// The details are only used for the report, so the server still being unavailable is expected.
+		details, _ = f.mcpSessionManager.ProbeServer(ctx, server.Name)
+	}
+	if err := f.create(ctx, failureReport(server, details)); err != nil {
+		// Return the error so that creating the ticket is retried.
+		return fmt.Errorf("failed to create ticket for failing MCP server %s: %w", server.Name, err)
+	}
+
+	log.Infof("Created ticket for failing MCP server: server=%s reason=%s", server.Name, server.Status.DeploymentFailureReason)
+	server.Status.FailureTicketTime = metav1.Now()
+	return req.Client.Status().Update(req.Ctx, server)
+}
+
+func (f *FailureTicketCreator) create(ctx context.Context, report types.MCPServerFailureReport) error {
+	body, err := f.body(report)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if f.authorization != "" {
+		req.Header.Set("Authorization", f.authorization)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("ticket endpoint returned %s", resp.Status)
+	}
+	return nil
+}
+
+// body returns the body of the request that creates the ticket: the rendered template if there is one, otherwise the
+// report itself.
+func (f *FailureTicketCreator) body(report types.MCPServerFailureReport) ([]byte, error) {
+	if f.template == nil {
+		return json.Marshal(report)
+	}
+
+	var buf bytes.Buffer
+	if err := f.template.Execute(&buf, report); err != nil {
+		return nil, fmt.Errorf("failed to render ticket template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func failureReport(server *v1.MCPServer, details types.MCPServerDetails) types.MCPServerFailureReport {
+	if len(details.Events) > failureTicketMaxEvents {
+		details.Events = details.Events[len(details.Events)-failureTicketMaxEvents:]
+	}
+
+	name := server.Spec.Manifest.Name
+	if name == "" {
+		name = server.Name
+	}
+
+	report := types.MCPServerFailureReport{
+		MCPID:                     server.Name,
+		MCPServerDisplayName:      server.Spec.Manifest.Name,
+		MCPServerCatalogEntryName: server.Spec.MCPServerCatalogEntryName,
+		MCPCatalogID:              server.Spec.MCPCatalogID,
+		PowerUserWorkspaceID:      server.Spec.PowerUserWorkspaceID,
+		UserID:                    server.Spec.UserID,
+		FailureReason:             server.Status.DeploymentFailureReason,
+		ConsecutiveProbeFailures:  server.Status.ConsecutiveProbeFailures,
+		Summary:                   fmt.Sprintf("MCP server %s is failing: %s", name, server.Status.DeploymentFailureReason),
+		Details:                   details,
+	}
+	if !server.Status.LastHealthyTime.IsZero() {
+		report.LastHealthyTime = types.NewTime(server.Status.LastHealthyTime.Time)
+	}
+
+	var description strings.Builder
+	fmt.Fprintf(&description, "The deployment of MCP server %s (%s) is failing: %s.\n", name, server.Name, report.FailureReason)
+	if report.MCPServerCatalogEntryName != "" {
+		fmt.Fprintf(&description, "Catalog entry: %s\n", report.MCPServerCatalogEntryName)
+	}
+	if report.MCPCatalogID != "" {
+		fmt.Fprintf(&description, "Catalog: %s\n", report.MCPCatalogID)
+	}
+	if report.PowerUserWorkspaceID != "" {
+		fmt.Fprintf(&description, "Workspace: %s\n", report.PowerUserWorkspaceID)
+	}
+	if report.UserID != "" {
+		fmt.Fprintf(&description, "Owner: %s\n", report.UserID)
+	}
+	if details.DeploymentName != "" {
+		fmt.Fprintf(&description, "Deployment: %s/%s (%d/%d replicas ready, %d restarts)\n", details.Namespace, details.DeploymentName, details.ReadyReplicas, details.Replicas, details.RestartCount)
+	}
+	if report.LastHealthyTime != nil {
+		fmt.Fprintf(&description, "Last healthy: %s\n", report.LastHealthyTime.Time.Format(time.RFC3339))
+	}
+	fmt.Fprintf(&description, "Consecutive failed liveness probes: %d\n", report.ConsecutiveProbeFailures)
+	if len(details.Events) > 0 {
+		description.WriteString("\nRecent events:\n")
+		for _, event := range details.Events {
+			fmt.Fprintf(&description, "%s %s %s/%s %s: %s\n", event.Time.Time.Format(time.RFC3339), event.EventType, event.ResourceKind, event.ResourceName, event.Reason, event.Message)
+		}
+	}
+	report.Description = description.String()
+
+	return report
+}
+
+// toJSON is the json function of ticket templates, so that values can be put in JSON bodies safely.
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
@@ -0,0 +1,168 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newFailingMCPServer(name string) *v1.MCPServer {
+	server := newMCPServer(name)
+	server.Spec.Manifest.Name = "GitHub"
+	server.Spec.MCPServerCatalogEntryName = "github"
+	server.Status.DeploymentFailureReason = "CrashLoopBackOff"
+	server.Status.ConsecutiveProbeFailures = 3
+	return server
+}
+
+func TestCreateTicketPostsReportOnce(t *testing.T) {
+	var (
+		requests      int
+		report        types.MCPServerFailureReport
+		authorization string
+	)
+	endpoint := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		requests++
+		authorization = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&report)
+	}))
+	defer endpoint.Close()
+
+	server := newFailingMCPServer("failing-server")
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	creator := NewFailureTicketCreator(nil, endpoint.URL, nil, "Basic dXNlcjpwYXNz")
+	require.NoError(t, creator.CreateTicket(req, &router.ResponseWrapper{}))
+
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, "Basic dXNlcjpwYXNz", authorization)
+	assert.Equal(t, "failing-server", report.MCPID)
+	assert.Equal(t, "github", report.MCPServerCatalogEntryName)
+	assert.Equal(t, "CrashLoopBackOff", report.FailureReason)
+	assert.Equal(t, "MCP server GitHub is failing: CrashLoopBackOff", report.Summary)
+	assert.Contains(t, report.Description, "Catalog entry: github")
+
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+	assert.WithinDuration(t, time.Now(), updated.Status.FailureTicketTime.Time, 5*time.Second)
+
+	req.Object = &updated
+	require.NoError(t, creator.CreateTicket(req, &router.ResponseWrapper{}))
+	assert.Equal(t, 1, requests, "a second ticket should not be created for the same failure")
+}
+
+func TestCreateTicketRetriesWhenEndpointFails(t *testing.T) {
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer endpoint.Close()
+
+	server := newFailingMCPServer("failing-server")
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	creator := NewFailureTicketCreator(nil, endpoint.URL, nil, "")
+	require.Error(t, creator.CreateTicket(req, &router.ResponseWrapper{}))
+
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+	assert.True(t, updated.Status.FailureTicketTime.IsZero())
+}
+
+func TestCreateTicketResetsWhenServerRecovers(t *testing.T) {
+	server := newMCPServer("recovered-server")
+	server.Status.FailureTicketTime = metav1.NewTime(time.Now().Add(-time.Hour))
+	client := newFakeClient(t, server)
+	req := router.Request{
+		Client:    client,
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	creator := NewFailureTicketCreator(nil, "http://127.0.0.1:1", nil, "")
+	require.NoError(t, creator.CreateTicket(req, &router.ResponseWrapper{}))
+
+	var updated v1.MCPServer
+	require.NoError(t, client.Get(context.Background(), router.Key(server.Namespace, server.Name), &updated))
+	assert.True(t, updated.Status.FailureTicketTime.IsZero())
+}
+
+func TestCreateTicketRendersTemplate(t *testing.T) {
+	var body []byte
+	endpoint := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer endpoint.Close()
+
+	tmpl, err := ParseFailureTicketTemplate(`{"short_description": {{json .Summary}}, "urgency": "2", "reason": "{{.FailureReason}}"}`)
+	require.NoError(t, err)
+
+	server := newFailingMCPServer("failing-server")
+	server.Spec.Manifest.Name = `"Quoted" server`
+	req := router.Request{
+		Client:    newFakeClient(t, server),
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	require.NoError(t, NewFailureTicketCreator(nil, endpoint.URL, tmpl, "").CreateTicket(req, &router.ResponseWrapper{}))
+
+	var ticket map[string]string
+	require.NoError(t, json.Unmarshal(body, &ticket))
+	assert.Equal(t, map[string]string{
+		"short_description": `MCP server "Quoted" server is failing: CrashLoopBackOff`,
+		"urgency":           "2",
+		"reason":            "CrashLoopBackOff",
+	}, ticket)
+}
+
+func TestParseFailureTicketTemplate(t *testing.T) {
+	tmpl, err := ParseFailureTicketTemplate("")
+	require.NoError(t, err)
+	assert.Nil(t, tmpl)
+
+	_, err = ParseFailureTicketTemplate("{{.Summary")
+	assert.Error(t, err)
+}
+
+func TestCreateTicketDisabledWithoutURL(t *testing.T) {
+	server := newFailingMCPServer("failing-server")
+	req := router.Request{
+		Client:    newFakeClient(t, server),
+		Ctx:       context.Background(),
+		Object:    server,
+		Namespace: server.Namespace,
+		Name:      server.Name,
+	}
+
+	require.NoError(t, NewFailureTicketCreator(nil, "", nil, "").CreateTicket(req, &router.ResponseWrapper{}))
+	assert.True(t, server.Status.FailureTicketTime.IsZero())
+}
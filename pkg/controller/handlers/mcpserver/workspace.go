@@ -0,0 +1,146 @@
+package mcpserver
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// workspaceUsageCheckInterval is how often the size of the files of the project of a workspace MCP server is measured.
+const workspaceUsageCheckInterval = 15 * time.Minute
+
+// WorkspaceBinder binds the Obot-managed workspace MCP servers to the project that they are first added to, so that
+// agents in the project and external clients of the server share the project's files, and records how much of its
+// quota the project's files use.
+type WorkspaceBinder struct {
+	gptClient  *gptscript.GPTScript
+	quotaBytes int64
+}
+
+func NewWorkspaceBinder(gptClient *gptscript.GPTScript, quotaBytes int64) *WorkspaceBinder {
+	return &WorkspaceBinder{
+		gptClient:  gptClient,
+		quotaBytes: quotaBytes,
+	}
+}
+
+// BindProject binds the workspace server of a project MCP server to the project, if the server isn't bound yet.
+func (w *WorkspaceBinder) BindProject(req router.Request, _ router.Response) error {
+	projectServer := req.Object.(*v1.ProjectMCPServer)
+	if projectServer.Spec.MCPServerName == "" || projectServer.Spec.ThreadName == "" || !projectServer.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var server v1.MCPServer
+	if err := req.Get(&server, projectServer.Namespace, projectServer.Spec.MCPServerName); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !mcp.IsWorkspaceServer(server.Spec.MCPServerCatalogEntryName) || server.Status.WorkspaceProjectName != "" || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	log.Infof("Binding workspace MCP server to project: server=%s project=%s", server.Name, projectServer.Spec.ThreadName)
+	server.Status.WorkspaceProjectName = projectServer.Spec.ThreadName
+	return req.Client.Status().Update(req.Ctx, &server)
+}
+
+// MeasureUsage records the workspace of the project that a workspace server is bound to and the size of the project's
+// files. The workspace server file API rejects writes once the size reaches the quota, and keeps the size up to date
+// between measurements. A server whose project was deleted is unbound, so that it can be added to another project.
+func (w *WorkspaceBinder) MeasureUsage(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+	if !mcp.IsWorkspaceServer(server.Spec.MCPServerCatalogEntryName) || server.Status.WorkspaceProjectName == "" || !server.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var project v1.Thread
+	if err := req.Get(&project, server.Namespace, server.Status.WorkspaceProjectName); apierrors.IsNotFound(err) || err == nil && !project.DeletionTimestamp.IsZero() {
+		log.Infof("Unbinding workspace MCP server from deleted project: server=%s project=%s", server.Name, server.Status.WorkspaceProjectName)
+		server.Status.WorkspaceProjectName = ""
+		server.Status.WorkspaceID = ""
+		server.Status.WorkspaceUsageBytes = 0
+		server.Status.WorkspaceQuotaBytes = 0
+		return req.Client.Status().Update(req.Ctx, server)
+	} else if err != nil {
+		return err
+	}
+
+	workspaceID, err := projectWorkspaceID(req, project)
+	if err != nil {
+		return err
+	}
+	if workspaceID == "" {
+		// The project's workspace isn't created yet.
+		resp.RetryAfter(10 * time.Second)
+		return nil
+	}
+
+	size, err := w.workspaceSize(req, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get size of workspace of MCP server %s: %w", server.Name, err)
+	}
+
+	resp.RetryAfter(workspaceUsageCheckInterval)
+
+	if server.Status.WorkspaceID == workspaceID && server.Status.WorkspaceUsageBytes == size && server.Status.WorkspaceQuotaBytes == w.quotaBytes {
+		return nil
+	}
+
+	if w.quotaBytes > 0 && size >= w.quotaBytes && server.Status.WorkspaceUsageBytes < w.quotaBytes {
+		log.Infof("Workspace MCP server reached its quota, rejecting writes: server=%s project=%s size=%d quota=%d", server.Name, project.Name, size, w.quotaBytes)
+	}
+
+	server.Status.WorkspaceID = workspaceID
+	server.Status.WorkspaceUsageBytes = size
+	server.Status.WorkspaceQuotaBytes = w.quotaBytes
+	return req.Client.Status().Update(req.Ctx, server)
+}
+
+// projectWorkspaceID returns the ID of the workspace that the files of the project are stored in.
+func projectWorkspaceID(req router.Request, project v1.Thread) (string, error) {
+	if project.Status.SharedWorkspaceName == "" {
+		return project.Status.WorkspaceID, nil
+	}
+
+	var workspace v1.Workspace
+	if err := req.Get(&workspace, project.Namespace, project.Status.SharedWorkspaceName); apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return workspace.Status.WorkspaceID, nil
+}
+
+// workspaceSize returns the total size of the project's files in the workspace.
+func (w *WorkspaceBinder) workspaceSize(req router.Request, workspaceID string) (int64, error) {
+	files, err := w.gptClient.ListFilesInWorkspace(req.Ctx, gptscript.ListFilesInWorkspaceOptions{
+		WorkspaceID: workspaceID,
+		Prefix:      system.WorkspaceServerFilesPrefix,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, file := range files {
+		info, err := w.gptClient.StatFileInWorkspace(req.Ctx, file, gptscript.StatFileInWorkspaceOptions{WorkspaceID: workspaceID})
+		if nfe := (*gptscript.NotFoundInWorkspaceError)(nil); errors.As(err, &nfe) {
+			// The file was deleted after the files were listed.
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		size += info.Size
+	}
+	return size, nil
+}
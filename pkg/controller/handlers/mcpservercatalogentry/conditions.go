@@ -0,0 +1,78 @@
+package mcpservercatalogentry
+
+import (
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateConditions sets the standard conditions on the catalog entry's status based on the state reported by the other handlers.
+func (*Handler) UpdateConditions(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+
+	if setConditions(entry) {
+		return req.Client.Status().Update(req.Ctx, entry)
+	}
+
+	return nil
+}
+
+func setConditions(entry *v1.MCPServerCatalogEntry) bool {
+	generation := entry.Generation
+	conditions := &entry.Status.Conditions
+
+	synced := metav1.Condition{
+		Type:               v1.MCPConditionSynced,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ManifestObserved",
+		Message:            "The latest catalog entry configuration has been processed",
+		ObservedGeneration: generation,
+	}
+	if entry.Status.ManifestHash != hash.Digest(entry.Spec.Manifest) {
+		synced.Status = metav1.ConditionFalse
+		synced.Reason = "ManifestChanged"
+		synced.Message = "The catalog entry configuration has changed and has not been processed yet"
+	}
+	changed := meta.SetStatusCondition(conditions, synced)
+
+	if entry.Spec.Manifest.Runtime == types.RuntimeComposite {
+		condition := metav1.Condition{
+			Type:               v1.MCPConditionDriftDetected,
+			Status:             metav1.ConditionFalse,
+			Reason:             "UpToDate",
+			Message:            "The component snapshots match their sources",
+			ObservedGeneration: generation,
+		}
+		if entry.Status.NeedsUpdate {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "ComponentsChanged"
+			condition.Message = "One or more component servers have changed and the composite entry needs to be refreshed"
+		}
+		changed = meta.SetStatusCondition(conditions, condition) || changed
+	} else {
+		changed = meta.RemoveStatusCondition(conditions, v1.MCPConditionDriftDetected) || changed
+	}
+
+	if entry.Spec.Manifest.Runtime == types.RuntimeRemote && entry.Spec.Manifest.RemoteConfig != nil && entry.Spec.Manifest.RemoteConfig.StaticOAuthRequired {
+		condition := metav1.Condition{
+			Type:               v1.MCPConditionCredentialConfigured,
+			Status:             metav1.ConditionFalse,
+			Reason:             "NotConfigured",
+			Message:            "OAuth credentials are required but have not been configured",
+			ObservedGeneration: generation,
+		}
+		if entry.Status.OAuthCredentialConfigured {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "Configured"
+			condition.Message = "OAuth credentials have been configured"
+		}
+		changed = meta.SetStatusCondition(conditions, condition) || changed
+	} else {
+		changed = meta.RemoveStatusCondition(conditions, v1.MCPConditionCredentialConfigured) || changed
+	}
+
+	return changed
+}
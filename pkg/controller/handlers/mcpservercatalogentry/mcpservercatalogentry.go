@@ -12,6 +12,7 @@ import (
 	"github.com/obot-platform/obot/logger"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/validation"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -166,8 +167,9 @@ func (*Handler) DetectCompositeDrift(req router.Request, _ router.Response) erro
 	return nil
 }
 
-// CleanupNestedCompositeServers removes component servers with composite runtimes from composite catalog entries.
-// This handler cleans up entries that were created before API validation to prevent nested composite servers.
+// CleanupNestedCompositeEntries removes nested composite components that are nested more than
+// validation.MaxCompositeNestingDepth levels deep or that form cycles from composite catalog entries.
+// This handler cleans up entries that were created before API validation to limit nested composite servers.
 func (*Handler) CleanupNestedCompositeEntries(req router.Request, _ router.Response) error {
 	var (
 		entry    = req.Object.(*v1.MCPServerCatalogEntry)
@@ -179,13 +181,13 @@ func (*Handler) CleanupNestedCompositeEntries(req router.Request, _ router.Respo
 		return nil
 	}
 
-	// Remove all composite components from the server's manifest
+	// Remove the composite components that are nested too deep or that form cycles from the entry's manifest
 	var (
 		components    = manifest.CompositeConfig.ComponentServers
 		numComponents = len(components)
 	)
 	components = slices.DeleteFunc(components, func(component types.CatalogComponentServer) bool {
-		return component.Manifest.Runtime == types.RuntimeComposite
+		return validation.ValidateCatalogComponentNesting(component, entry.Name) != nil
 	})
 
 	if numComponents == len(components) {
@@ -272,6 +274,27 @@ func (h *Handler) EnsureOAuthCredentialStatus(req router.Request, _ router.Respo
 	return nil
 }
 
+// RemoveCredentials removes the team and OAuth credentials of a catalog entry when it is deleted.
+func (h *Handler) RemoveCredentials(req router.Request, resp router.Response) error {
+	if err := h.RemoveTeamCredentials(req, resp); err != nil {
+		return err
+	}
+	return h.RemoveOAuthCredentials(req, resp)
+}
+
+// RemoveTeamCredentials removes the team credentials of a catalog entry when it is deleted.
+func (h *Handler) RemoveTeamCredentials(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+
+	for _, groupID := range entry.Spec.TeamCredentialGroupIDs {
+		if err := h.gClient.DeleteCredential(req.Ctx, system.MCPTeamCredentialContext(entry.Spec.MCPCatalogName, entry.Name, groupID), entry.Name); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+			return fmt.Errorf("failed to delete team credential: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // RemoveOAuthCredentials removes OAuth credentials when a catalog entry is deleted.
 func (h *Handler) RemoveOAuthCredentials(req router.Request, _ router.Response) error {
 	entry := req.Object.(*v1.MCPServerCatalogEntry)
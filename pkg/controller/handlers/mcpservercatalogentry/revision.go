@@ -0,0 +1,60 @@
+package mcpservercatalogentry
+
+import (
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/nah/pkg/router"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EnsureRevision creates a new revision of the catalog entry each time its manifest changes, so that servers can be
+// pinned to a revision and users can see what changed before they update their servers.
+func (*Handler) EnsureRevision(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+	if !entry.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	manifestHash := hash.Digest(entry.Spec.Manifest)
+	if entry.Status.Revision > 0 {
+		var latest v1.MCPServerCatalogEntryRevision
+		if err := req.Get(&latest, entry.Namespace, v1.MCPServerCatalogEntryRevisionName(entry.Name, entry.Status.Revision)); err == nil {
+			if latest.Spec.ManifestHash == manifestHash {
+				return nil
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for next := entry.Status.Revision + 1; ; next++ {
+		revision := v1.MCPServerCatalogEntryRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1.MCPServerCatalogEntryRevisionName(entry.Name, next),
+				Namespace: entry.Namespace,
+			},
+			Spec: v1.MCPServerCatalogEntryRevisionSpec{
+				MCPServerCatalogEntryName: entry.Name,
+				Revision:                  next,
+				Manifest:                  entry.Spec.Manifest,
+				ManifestHash:              manifestHash,
+			},
+		}
+		if err := req.Client.Create(req.Ctx, &revision); apierrors.IsAlreadyExists(err) {
+			// The revision was created before the status could be updated. Reuse it if the manifest didn't change since.
+			if err = req.Client.Get(req.Ctx, router.Key(entry.Namespace, revision.Name), &revision); err != nil {
+				return err
+			}
+			if revision.Spec.ManifestHash != manifestHash {
+				continue
+			}
+		} else if err != nil {
+			return err
+		}
+
+		log.Infof("Created MCP catalog entry revision: entry=%s revision=%d", entry.Name, next)
+		entry.Status.Revision = next
+		return req.Client.Status().Update(req.Ctx, entry)
+	}
+}
@@ -0,0 +1,126 @@
+package mcpservercatalogentry
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api/handlers"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const toolPreviewGenerationTimeout = 5 * time.Minute
+
+// ToolPreviewGenerator keeps the tool previews of editable catalog entries up to date by deploying a temporary server
+// from each entry when its manifest changes, listing its tools, and tearing it down.
+type ToolPreviewGenerator struct {
+	gptClient         *gptscript.GPTScript
+	mcpSessionManager *mcp.SessionManager
+	gatewayClient     *gclient.Client
+	serverURL         string
+	enabled           bool
+}
+
+func NewToolPreviewGenerator(gptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager, gatewayClient *gclient.Client, serverURL string, enabled bool) *ToolPreviewGenerator {
+	if !enabled {
+		log.Infof("Automatic MCP catalog entry tool preview generation: disabled")
+	}
+
+	return &ToolPreviewGenerator{
+		gptClient:         gptClient,
+		mcpSessionManager: mcpSessionManager,
+		gatewayClient:     gatewayClient,
+		serverURL:         serverURL,
+		enabled:           enabled,
+	}
+}
+
+// GenerateToolPreviews generates the tool previews of an editable catalog entry if its manifest changed since they
+// were last generated. Entries that can't be deployed without input from a user, such as composite entries, entries
+// with required configuration, and entries that require OAuth, record why in their status instead.
+func (g *ToolPreviewGenerator) GenerateToolPreviews(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+	// Entries imported from MCP registries are skipped, because a registry can import hundreds at once.
+	if !g.enabled || !entry.Spec.Editable || entry.Spec.ImportedManifestHash != "" || !entry.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	manifestHash := entry.ToolPreviewsHash()
+	if entry.Status.ToolPreviewsManifestHash == manifestHash {
+		return nil
+	}
+
+	if entry.Status.ToolPreviewsManifestHash == "" && (entry.Status.ToolPreviewsLastGenerated != nil || len(entry.Spec.Manifest.ToolPreview) > 0) {
+		// The entry's tool previews were generated before they were generated automatically. Assume they match the
+		// manifest, rather than deploying every existing entry at once.
+		entry.Status.ToolPreviewsManifestHash = manifestHash
+		return req.Client.Status().Update(req.Ctx, entry)
+	}
+
+	toolPreviews, err := g.toolPreviews(req.Ctx, req.Client, entry)
+	if err != nil && req.Ctx.Err() != nil {
+		// The controller is shutting down or lost its leadership. This isn't a failure of the entry, so it isn't
+		// recorded, and the next leader generates the tool previews, resuming the launch of the temporary server.
+		return err
+	}
+	if err != nil {
+		log.Infof("Failed to generate tool previews for MCP catalog entry: entry=%s error=%v", entry.Name, err)
+		entry.Status.ToolPreviewsManifestHash = manifestHash
+		entry.Status.ToolPreviewsError = err.Error()
+		return req.Client.Status().Update(req.Ctx, entry)
+	}
+
+	if !reflect.DeepEqual(entry.Spec.Manifest.ToolPreview, toolPreviews) {
+		entry.Spec.Manifest.ToolPreview = toolPreviews
+		if err = req.Client.Update(req.Ctx, entry); err != nil {
+			return fmt.Errorf("failed to update tool previews: %w", err)
+		}
+	}
+
+	log.Infof("Generated tool previews for MCP catalog entry: entry=%s tools=%d", entry.Name, len(toolPreviews))
+	now := metav1.Now()
+	entry.Status.ToolPreviewsLastGenerated = &now
+	entry.Status.ToolPreviewsManifestHash = manifestHash
+	entry.Status.ToolPreviewsError = ""
+	return req.Client.Status().Update(req.Ctx, entry)
+}
+
+// toolPreviews deploys a temporary server from a catalog entry, lists its tools, and tears it down.
+func (g *ToolPreviewGenerator) toolPreviews(ctx context.Context, client kclient.Client, entry *v1.MCPServerCatalogEntry) ([]types.MCPServerTool, error) {
+	manifest := entry.Spec.Manifest
+	switch {
+	case manifest.Runtime == types.RuntimeComposite:
+		return nil, fmt.Errorf("tool previews of composite servers must be generated with the configuration of their components")
+	case manifest.Runtime == types.RuntimeRemote && manifest.RemoteConfig != nil && manifest.RemoteConfig.StaticOAuthRequired:
+		return nil, fmt.Errorf("MCP server requires OAuth authentication")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, toolPreviewGenerationTimeout)
+	defer cancel()
+
+	catalogName := entry.Spec.MCPCatalogName
+	if catalogName == "" {
+		catalogName = entry.Spec.PowerUserWorkspaceID
+	}
+
+	server, serverConfig, err := handlers.TempServerAndConfig(ctx, g.gptClient, client, entry.Namespace, catalogName, manifest, nil, "", g.serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverConfig.Runtime == types.RuntimeRemote {
+		defer func() {
+			_ = g.gatewayClient.DeleteMCPOAuthTokens(context.Background(), "system", server.Name)
+		}()
+	}
+
+	return g.mcpSessionManager.GenerateToolPreviews(ctx, server, serverConfig)
+}
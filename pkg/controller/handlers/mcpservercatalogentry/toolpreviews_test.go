@@ -0,0 +1,106 @@
+package mcpservercatalogentry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGenerateToolPreviewsWithoutDeploying(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifest  types.MCPServerCatalogEntryManifest
+		wantError bool
+	}{
+		{
+			name: "existing previews are kept",
+			manifest: types.MCPServerCatalogEntryManifest{
+				Runtime:     types.RuntimeNPX,
+				NPXConfig:   &types.NPXRuntimeConfig{Package: "test-server"},
+				ToolPreview: []types.MCPServerTool{{Name: "tool"}},
+			},
+		},
+		{
+			name: "composite entries record an error",
+			manifest: types.MCPServerCatalogEntryManifest{
+				Runtime:         types.RuntimeComposite,
+				CompositeConfig: &types.CompositeCatalogConfig{},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &v1.MCPServerCatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "entry", Namespace: system.DefaultNamespace},
+				Spec: v1.MCPServerCatalogEntrySpec{
+					Editable: true,
+					Manifest: tt.manifest,
+				},
+			}
+			client := fake.NewClientBuilder().
+				WithScheme(storagescheme.Scheme).
+				WithStatusSubresource(&v1.MCPServerCatalogEntry{}).
+				WithObjects(entry).
+				Build()
+
+			g := NewToolPreviewGenerator(nil, nil, nil, "http://localhost:8080", true)
+			require.NoError(t, g.GenerateToolPreviews(router.Request{
+				Client: client,
+				Ctx:    context.Background(),
+				Object: entry,
+			}, nil))
+
+			var updated v1.MCPServerCatalogEntry
+			require.NoError(t, client.Get(context.Background(), kclient.ObjectKeyFromObject(entry), &updated))
+			assert.Equal(t, updated.ToolPreviewsHash(), updated.Status.ToolPreviewsManifestHash)
+			assert.Equal(t, tt.wantError, updated.Status.ToolPreviewsError != "")
+			assert.Equal(t, tt.manifest.ToolPreview, updated.Spec.Manifest.ToolPreview)
+		})
+	}
+}
+
+func TestGenerateToolPreviewsNotRecordedWhenCanceled(t *testing.T) {
+	entry := &v1.MCPServerCatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry", Namespace: system.DefaultNamespace},
+		Spec: v1.MCPServerCatalogEntrySpec{
+			Editable: true,
+			Manifest: types.MCPServerCatalogEntryManifest{
+				Runtime:         types.RuntimeComposite,
+				CompositeConfig: &types.CompositeCatalogConfig{},
+			},
+		},
+	}
+	client := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithStatusSubresource(&v1.MCPServerCatalogEntry{}).
+		WithObjects(entry).
+		Build()
+
+	// The controller lost its leadership while generating the tool previews.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	g := NewToolPreviewGenerator(nil, nil, nil, "http://localhost:8080", true)
+	require.Error(t, g.GenerateToolPreviews(router.Request{
+		Client: client,
+		Ctx:    ctx,
+		Object: entry,
+	}, nil))
+
+	var updated v1.MCPServerCatalogEntry
+	require.NoError(t, client.Get(context.Background(), kclient.ObjectKeyFromObject(entry), &updated))
+	assert.Empty(t, updated.Status.ToolPreviewsManifestHash)
+	assert.Empty(t, updated.Status.ToolPreviewsError)
+}
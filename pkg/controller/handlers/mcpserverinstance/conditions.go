@@ -0,0 +1,69 @@
+package mcpserverinstance
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/router"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateConditions sets the Ready and Synced conditions on the instance's status.
+// Ready and the connect blockers mirror those of the MCP server the instance points to.
+func (h *Handler) UpdateConditions(req router.Request, _ router.Response) error {
+	instance := req.Object.(*v1.MCPServerInstance)
+
+	var server v1.MCPServer
+	if err := req.Get(&server, req.Namespace, instance.Spec.MCPServerName); apierrors.IsNotFound(err) {
+		// The server no longer exists, another controller will delete this instance, so do nothing.
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	generation := instance.Generation
+	conditions := &instance.Status.Conditions
+
+	ready := metav1.Condition{
+		Type:               v1.MCPConditionReady,
+		Status:             metav1.ConditionUnknown,
+		Reason:             "ServerStatusUnknown",
+		Message:            "The MCP server has not reported its status",
+		ObservedGeneration: generation,
+	}
+	if serverReady := meta.FindStatusCondition(server.Status.Conditions, v1.MCPConditionReady); serverReady != nil {
+		ready.Status = serverReady.Status
+		ready.Reason = serverReady.Reason
+		ready.Message = serverReady.Message
+	}
+	changed := meta.SetStatusCondition(conditions, ready)
+
+	synced := metav1.Condition{
+		Type:               v1.MCPConditionSynced,
+		Status:             metav1.ConditionTrue,
+		Reason:             "UpToDate",
+		Message:            "The instance configuration matches its MCP server",
+		ObservedGeneration: generation,
+	}
+	if (server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "") &&
+		!equality.Semantic.DeepEqual(instance.Spec.MultiUserConfig, server.Spec.Manifest.MultiUserConfig) {
+		synced.Status = metav1.ConditionFalse
+		synced.Reason = "ServerConfigChanged"
+		synced.Message = "The MCP server's multi-user configuration has changed and has not been applied to this instance yet"
+	}
+	changed = meta.SetStatusCondition(conditions, synced) || changed
+
+	if !slices.Equal(instance.Status.ConnectBlockers, server.Status.ConnectBlockers) {
+		instance.Status.ConnectBlockers = slices.Clone(server.Status.ConnectBlockers)
+		changed = true
+	}
+
+	if changed {
+		return h.statusUpdates.Update(req, instance)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package mcpserverinstance
 import (
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/controller/statusupdate"
 	gateway "github.com/obot-platform/obot/pkg/gateway/client"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -13,11 +14,13 @@ var log = logger.Package()
 
 type Handler struct {
 	gatewayClient *gateway.Client
+	statusUpdates *statusupdate.Coalescer
 }
 
-func New(gatewayClient *gateway.Client) *Handler {
+func New(gatewayClient *gateway.Client, statusUpdates *statusupdate.Coalescer) *Handler {
 	return &Handler{
 		gatewayClient: gatewayClient,
+		statusUpdates: statusUpdates,
 	}
 }
 
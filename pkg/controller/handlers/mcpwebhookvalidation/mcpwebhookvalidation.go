@@ -8,6 +8,7 @@ import (
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/apiclient/webhooksignature"
 	"github.com/obot-platform/obot/pkg/controller/handlers/systemmcpserver"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
@@ -133,6 +134,10 @@ func desiredSystemServer(webhookValidation *v1.MCPWebhookValidation, image strin
 				{
 					MCPHeader: types.MCPHeader{Key: "WEBHOOK_SECRET", Sensitive: true},
 				},
+				{
+					// Sign requests with the timestamped scheme in addition to the legacy signature.
+					MCPHeader: types.MCPHeader{Key: "WEBHOOK_SIGNATURE_VERSION", Value: webhooksignature.Version},
+				},
 				{
 					MCPHeader: types.MCPHeader{Key: "PORT", Value: "8099"},
 				},
@@ -339,6 +339,7 @@ func (h *Handler) ensureCredentials(ctx context.Context, req router.Request, res
 		fmt.Sprintf("API key for nanobot agent %s", agent.Name),
 		&expiresAt,
 		[]string{"*"}, // Access to all servers
+		nil,           // Access to all tools
 		true,          // Access to skills
 	)
 	if err != nil {
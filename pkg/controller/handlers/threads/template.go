@@ -141,8 +141,8 @@ func (t *Handler) UpgradeThread(req router.Request, _ router.Response) error {
 	return req.Client.Update(req.Ctx, remapCopiedAllowedMCPTools(thread))
 }
 
-// remapCopiedAllowedMCPTools remaps the keys of a source ThreadManifest's allowedMCPTools map to match the names
-// of a copied thread's ProjectMCPServers.
+// remapCopiedAllowedMCPTools remaps the keys of a source ThreadManifest's allowedMCPTools and mcpToolSchemaModes maps
+// to match the names of a copied thread's ProjectMCPServers.
 func remapCopiedAllowedMCPTools(copiedThread *v1.Thread) *v1.Thread {
 	if copiedThread.Spec.SourceThreadName == "" {
 		return copiedThread
@@ -159,6 +159,14 @@ func remapCopiedAllowedMCPTools(copiedThread *v1.Thread) *v1.Thread {
 	}
 	copiedThread.Spec.Manifest.AllowedMCPTools = remapped
 
+	if schemaModes := copiedThread.Spec.Manifest.MCPToolSchemaModes; len(schemaModes) > 0 {
+		remappedModes := make(map[string]types.MCPToolSchemaMode, len(schemaModes))
+		for pmsName, mode := range schemaModes {
+			remappedModes[name.SafeHashConcatName(pmsName, copiedThread.Name)] = mode
+		}
+		copiedThread.Spec.Manifest.MCPToolSchemaModes = remappedModes
+	}
+
 	return copiedThread
 }
 
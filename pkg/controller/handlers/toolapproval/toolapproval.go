@@ -0,0 +1,118 @@
+package toolapproval
+
+import (
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/projects"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+// maxRecheckInterval bounds how long a project waits to be checked again, so that MCP servers added to the project
+// without an update to the project itself are picked up.
+const maxRecheckInterval = 24 * time.Hour
+
+// ExpireToolApprovals revokes the tools selected for a project's MCP servers once their approval is older than the
+// expiration, until the project owner or an admin re-certifies them. Projects with approvals that expire within the
+// warning period are flagged as requiring re-certification.
+func ExpireToolApprovals(expiration, warning time.Duration) router.HandlerFunc {
+	if expiration <= 0 {
+		log.Infof("tool approval expiration: disabled")
+	} else {
+		log.Infof("tool approval expiration: %s", expiration)
+	}
+
+	return func(req router.Request, resp router.Response) error {
+		if expiration <= 0 {
+			return nil
+		}
+
+		thread := req.Object.(*v1.Thread)
+		if !thread.Spec.Project || thread.Spec.Template || !thread.DeletionTimestamp.IsZero() {
+			return nil
+		}
+
+		var projectServers v1.ProjectMCPServerList
+		if err := req.Client.List(req.Ctx, &projectServers, kclient.InNamespace(thread.Namespace), &kclient.ListOptions{
+			FieldSelector: fields.SelectorFromSet(map[string]string{
+				"spec.threadName": thread.Name,
+			}),
+		}); err != nil {
+			return err
+		}
+
+		var (
+			now       = time.Now()
+			changed   = len(projectServers.Items) != len(thread.Spec.ToolApprovals)
+			approvals = make(map[string]v1.ToolApproval, len(projectServers.Items))
+		)
+		for _, projectServer := range projectServers.Items {
+			approval, ok := thread.Spec.ToolApprovals[projectServer.Name]
+			if !ok {
+				// Servers added before approvals were tracked are considered approved by the user that added them.
+				approval = v1.ToolApproval{
+					ApprovedBy: projectServer.Spec.UserID,
+					ApprovedAt: metav1.NewTime(now),
+				}
+				changed = true
+			}
+			approvals[projectServer.Name] = approval
+		}
+		// Approvals of MCP servers that were removed from the project are dropped.
+		thread.Spec.ToolApprovals = approvals
+
+		var (
+			recertificationRequired bool
+			next                    = maxRecheckInterval
+		)
+		for name, approval := range approvals {
+			status := projects.ToolApprovalStatus(approval, expiration, warning, now)
+			if status != types.ToolApprovalApproved {
+				recertificationRequired = true
+			}
+
+			if approval.Expired {
+				continue
+			}
+			if status == types.ToolApprovalExpired {
+				log.Infof("Tool approval expired, revoking tools: project=%s projectMCPServer=%s", thread.Name, name)
+				projects.ExpireToolApproval(thread, name)
+				changed = true
+				continue
+			}
+
+			expiresAt := approval.ApprovedAt.Add(expiration)
+			for _, at := range []time.Time{expiresAt.Add(-warning), expiresAt} {
+				if d := at.Sub(now); d > 0 && d < next {
+					next = d
+				}
+			}
+		}
+
+		if changed {
+			if len(thread.Spec.ToolApprovals) == 0 {
+				thread.Spec.ToolApprovals = nil
+			}
+			return req.Client.Update(req.Ctx, thread)
+		}
+
+		resp.RetryAfter(next)
+
+		if thread.Status.ToolRecertificationRequired != recertificationRequired {
+			if recertificationRequired {
+				log.Infof("Project requires tool re-certification: project=%s owner=%s", thread.Name, thread.Spec.UserID)
+			}
+			thread.Status.ToolRecertificationRequired = recertificationRequired
+			return req.Client.Status().Update(req.Ctx, thread)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ensureManagedCatalogEntries keeps the catalog entries of the Obot-managed MCP servers in the default catalog up to
+// date with the configured images.
+func (c *Controller) ensureManagedCatalogEntries(ctx context.Context, client kclient.Client) error {
+	if err := ensureManagedCatalogEntry(ctx, client, system.MemoryCatalogEntryName, "memory", c.services.MCPMemoryServerImage, memoryCatalogEntryManifest); err != nil {
+		return err
+	}
+	return ensureManagedCatalogEntry(ctx, client, system.WorkspaceCatalogEntryName, "workspace", c.services.MCPWorkspaceServerImage, workspaceCatalogEntryManifest)
+}
+
+// ensureManagedCatalogEntry creates or updates the catalog entry of an Obot-managed MCP server with the manifest for the
+// image. The entry is removed when the image is empty because the server is disabled. The servers that users already
+// created from it are kept, and their data along with them.
+func ensureManagedCatalogEntry(ctx context.Context, client kclient.Client, name, kind, image string, manifestFor func(string) types.MCPServerCatalogEntryManifest) error {
+	var existing v1.MCPServerCatalogEntry
+	err := client.Get(ctx, kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: name}, &existing)
+	if apierrors.IsNotFound(err) {
+		if image == "" {
+			return nil
+		}
+
+		log.Infof("Creating %s MCP server catalog entry (image=%s)", kind, image)
+		return kclient.IgnoreAlreadyExists(client.Create(ctx, &v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: system.DefaultNamespace,
+			},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: system.DefaultCatalog,
+				Manifest:       manifestFor(image),
+			},
+		}))
+	} else if err != nil {
+		return err
+	}
+
+	if image == "" {
+		log.Infof("Deleting %s MCP server catalog entry, because %s servers are disabled", kind, kind)
+		return kclient.IgnoreNotFound(client.Delete(ctx, &existing))
+	}
+
+	manifest := manifestFor(image)
+	// The tool previews are generated by the controller.
+	manifest.ToolPreview = existing.Spec.Manifest.ToolPreview
+	if equality.Semantic.DeepEqual(existing.Spec.Manifest, manifest) && !existing.Spec.Editable {
+		return nil
+	}
+
+	log.Infof("Updating %s MCP server catalog entry (image=%s)", kind, image)
+	existing.Spec.Manifest = manifest
+	existing.Spec.Editable = false
+	return client.Update(ctx, &existing)
+}
+
+func memoryCatalogEntryManifest(image string) types.MCPServerCatalogEntryManifest {
+	return types.MCPServerCatalogEntryManifest{
+		Metadata: map[string]string{
+			"categories": "Memory",
+		},
+		Name:             "Memory",
+		ShortDescription: "Durable memory for agents, stored by Obot",
+		Description: "Gives agents memory that lasts across threads. Memories are stored as embeddings in Obot's " +
+			"database, so they can be searched by meaning, and are deleted with the server. Add one server to each " +
+			"project that should have its own memory.",
+		Runtime: types.RuntimeContainerized,
+		ContainerizedConfig: &types.ContainerizedRuntimeConfig{
+			Image: image,
+			Port:  8080,
+			Path:  "/mcp",
+		},
+	}
+}
+
+func workspaceCatalogEntryManifest(image string) types.MCPServerCatalogEntryManifest {
+	return types.MCPServerCatalogEntryManifest{
+		Metadata: map[string]string{
+			"categories": "File System",
+		},
+		Name:             "Workspace",
+		ShortDescription: "Read and write the files of a project",
+		Description: "Gives agents and MCP clients tools to list, read, write, move, and delete the files of a " +
+			"project, which are the same files that the project's agents and users see in Obot. The server is bound " +
+			"to the first project that it is added to, and can't reach files outside of it. Add one server to each " +
+			"project whose files should be shared.",
+		Runtime: types.RuntimeContainerized,
+		ContainerizedConfig: &types.ContainerizedRuntimeConfig{
+			Image: image,
+			Port:  8080,
+			Path:  "/mcp",
+		},
+	}
+}
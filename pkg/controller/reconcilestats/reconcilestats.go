@@ -0,0 +1,158 @@
+package reconcilestats
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// Tracker records reconcile statistics for controller handlers so that operators can see stuck reconciles
+// without scraping controller logs. Use Middleware to wrap the routes that should be tracked.
+type Tracker struct {
+	lock     sync.RWMutex
+	handlers map[handlerKey]*handlerStats
+	now      func() time.Time
+}
+
+type handlerKey struct {
+	handler, kind string
+}
+
+type handlerStats struct {
+	reconciles    int64
+	errors        int64
+	lastReconcile time.Time
+	failing       map[objectKey]*objectError
+}
+
+type objectKey struct {
+	namespace, name string
+}
+
+type objectError struct {
+	err          string
+	retryCount   int
+	firstFailure time.Time
+	lastFailure  time.Time
+}
+
+func New() *Tracker {
+	return &Tracker{
+		handlers: make(map[handlerKey]*handlerStats),
+		now:      time.Now,
+	}
+}
+
+// Middleware wraps a handler and records the result of each reconcile.
+func (t *Tracker) Middleware(h router.Handler) router.Handler {
+	name := handlerName(h)
+	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		err := h.Handle(req, resp)
+		t.record(handlerKey{handler: name, kind: req.GVK.Kind}, objectKey{namespace: req.Namespace, name: req.Name}, err)
+		return err
+	})
+}
+
+func (t *Tracker) record(key handlerKey, obj objectKey, err error) {
+	now := t.now()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	stats, ok := t.handlers[key]
+	if !ok {
+		stats = &handlerStats{
+			failing: make(map[objectKey]*objectError),
+		}
+		t.handlers[key] = stats
+	}
+
+	stats.reconciles++
+	stats.lastReconcile = now
+
+	if err == nil {
+		delete(stats.failing, obj)
+		return
+	}
+
+	stats.errors++
+	failure, ok := stats.failing[obj]
+	if !ok {
+		failure = &objectError{firstFailure: now}
+		stats.failing[obj] = failure
+	}
+
+	failure.err = err.Error()
+	failure.retryCount++
+	failure.lastFailure = now
+}
+
+// Stats returns a snapshot of the reconcile statistics, sorted by kind and handler name.
+func (t *Tracker) Stats() types.ReconcileStats {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	result := types.ReconcileStats{
+		Handlers: make([]types.ReconcileHandlerStats, 0, len(t.handlers)),
+	}
+	for key, stats := range t.handlers {
+		handler := types.ReconcileHandlerStats{
+			Handler:           key.handler,
+			Kind:              key.kind,
+			Reconciles:        stats.reconciles,
+			Errors:            stats.errors,
+			Backlog:           len(stats.failing),
+			LastReconcileTime: types.NewTime(stats.lastReconcile),
+		}
+
+		for obj, failure := range stats.failing {
+			handler.FailingObjects = append(handler.FailingObjects, types.ReconcileObjectError{
+				Namespace:        obj.namespace,
+				Name:             obj.name,
+				Error:            failure.err,
+				RetryCount:       failure.retryCount,
+				FirstFailureTime: *types.NewTime(failure.firstFailure),
+				LastFailureTime:  *types.NewTime(failure.lastFailure),
+			})
+		}
+
+		slices.SortFunc(handler.FailingObjects, func(a, b types.ReconcileObjectError) int {
+			return b.LastFailureTime.Time.Compare(a.LastFailureTime.Time)
+		})
+
+		result.Handlers = append(result.Handlers, handler)
+	}
+
+	slices.SortFunc(result.Handlers, func(a, b types.ReconcileHandlerStats) int {
+		if c := strings.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Handler, b.Handler)
+	})
+
+	return result
+}
+
+// handlerName returns a short, human-readable name for a handler, e.g. "mcpserver.(*Handler).DetectDrift".
+func handlerName(h router.Handler) string {
+	switch h := h.(type) {
+	case router.HandlerFunc:
+		name := runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name()
+		name = strings.TrimSuffix(name, "-fm")
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		return name
+	case router.FinalizerHandler:
+		return handlerName(h.Next)
+	default:
+		return fmt.Sprintf("%T", h)
+	}
+}
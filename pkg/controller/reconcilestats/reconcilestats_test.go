@@ -0,0 +1,60 @@
+package reconcilestats
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func reconcile(router.Request, router.Response) error {
+	return nil
+}
+
+func TestTracker(t *testing.T) {
+	tracker := New()
+
+	var fail bool
+	h := tracker.Middleware(router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		if fail {
+			return errors.New("boom")
+		}
+		return reconcile(req, resp)
+	}))
+
+	req := router.Request{
+		GVK:       schema.GroupVersionKind{Kind: "MCPServer"},
+		Namespace: "default",
+		Name:      "ms1",
+	}
+
+	fail = true
+	require.Error(t, h.Handle(req, nil))
+	require.Error(t, h.Handle(req, nil))
+
+	stats := tracker.Stats()
+	require.Len(t, stats.Handlers, 1)
+	require.Equal(t, "MCPServer", stats.Handlers[0].Kind)
+	require.EqualValues(t, 2, stats.Handlers[0].Reconciles)
+	require.EqualValues(t, 2, stats.Handlers[0].Errors)
+	require.Equal(t, 1, stats.Handlers[0].Backlog)
+	require.Len(t, stats.Handlers[0].FailingObjects, 1)
+	require.Equal(t, "ms1", stats.Handlers[0].FailingObjects[0].Name)
+	require.Equal(t, "boom", stats.Handlers[0].FailingObjects[0].Error)
+	require.Equal(t, 2, stats.Handlers[0].FailingObjects[0].RetryCount)
+
+	fail = false
+	require.NoError(t, h.Handle(req, nil))
+
+	stats = tracker.Stats()
+	require.EqualValues(t, 3, stats.Handlers[0].Reconciles)
+	require.Equal(t, 0, stats.Handlers[0].Backlog)
+	require.Empty(t, stats.Handlers[0].FailingObjects)
+}
+
+func TestHandlerName(t *testing.T) {
+	require.Equal(t, "reconcilestats.reconcile", handlerName(router.HandlerFunc(reconcile)))
+	require.Equal(t, "reconcilestats.reconcile", handlerName(router.FinalizerHandler{Next: router.HandlerFunc(reconcile)}))
+}
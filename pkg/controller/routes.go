@@ -7,6 +7,7 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/accesscontrolrule"
 	"github.com/obot-platform/obot/pkg/controller/handlers/adminworkspace"
 	"github.com/obot-platform/obot/pkg/controller/handlers/agents"
+	"github.com/obot-platform/obot/pkg/controller/handlers/alertrule"
 	"github.com/obot-platform/obot/pkg/controller/handlers/alias"
 	"github.com/obot-platform/obot/pkg/controller/handlers/auditlogexport"
 	"github.com/obot-platform/obot/pkg/controller/handlers/cleanup"
@@ -16,6 +17,8 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesource"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesummary"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalogevent"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpsearch"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpserver"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpservercatalogentry"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpserverinstance"
@@ -38,6 +41,7 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/systemmcpserver"
 	"github.com/obot-platform/obot/pkg/controller/handlers/threads"
 	"github.com/obot-platform/obot/pkg/controller/handlers/threadshare"
+	"github.com/obot-platform/obot/pkg/controller/handlers/toolapproval"
 	"github.com/obot-platform/obot/pkg/controller/handlers/toolinfo"
 	"github.com/obot-platform/obot/pkg/controller/handlers/toolreference"
 	"github.com/obot-platform/obot/pkg/controller/handlers/workflow"
@@ -49,6 +53,8 @@ import (
 
 func (c *Controller) setupRoutes() {
 	root := c.router
+	// Reconciles of MCP-related types are tracked so that admins can see stuck reconciles through the API.
+	mcpRoot := root.Middleware(c.services.ReconcileStats.Middleware)
 
 	workflowExecution := workflowexecution.New(c.services.Invoker)
 	workflowStep := workflowstep.New(c.services.Invoker, c.services.GPTClient, c.services.MCPLoader)
@@ -75,15 +81,26 @@ func (c *Controller) setupRoutes() {
 	mcpCatalog := mcpcatalog.New(c.services.DefaultMCPCatalogPath, c.services.DefaultSystemMCPCatalogPath, c.services.GPTClient, c.services.GatewayClient, c.services.AccessControlRuleHelper)
 	skillRepository := skillrepository.New()
 	mcpSession := mcpsession.New(c.services.GPTClient)
-	mcpserver := mcpserver.New(c.services.GPTClient, c.services.MCPLoader, c.services.MCPNetworkPolicyEnabled, c.services.MCPDefaultDenyAllEgress, c.services.SingleUserIdleServerShutdownInterval, c.services.MultiUserIdleServerShutdownInterval, c.services.AgentIdleServerShutdownInterval, c.services.ServerURL)
-	mcpserverinstance := mcpserverinstance.New(c.services.GatewayClient)
+	mcpServerLiveness := mcpserver.NewLivenessProber(c.services.MCPLoader, c.services.GatewayClient, c.services.MCPLivenessProbeInterval)
+	mcpSearchIndexer := mcpsearch.New(c.services.GatewayClient)
+	mcpServerCredentialExpiry := mcpserver.NewCredentialExpiryChecker(c.services.GatewayClient)
+	staleMCPServerReaper := mcpserver.NewStaleServerReaper(c.services.MCPLoader, c.services.GatewayClient, c.services.MCPStaleServerAfter, c.services.MCPStaleServerGracePeriod, c.services.MCPStaleServerAction, c.services.MCPStaleServerWebhookURL, c.services.MCPStaleServerWebhookSecret)
+	mcpServerPrewarmer := mcpserver.NewPrewarmer(c.services.MCPLoader, c.services.GatewayClient, c.services.GPTClient, c.services.ServerURL, c.services.MCPPrewarmLookback, c.services.MCPPrewarmLead, c.services.MCPPrewarmMinUsers)
+	mcpServerMemory := mcpserver.NewMemoryProvisioner(c.services.GatewayClient, c.services.MCPMemoryServerQuota)
+	mcpServerWorkspace := mcpserver.NewWorkspaceBinder(c.services.GPTClient, c.services.MCPWorkspaceServerQuota)
+	mcpServerFailureTickets := mcpserver.NewFailureTicketCreator(c.services.MCPLoader, c.services.MCPFailureTicketURL, c.services.MCPFailureTicketTemplate, c.services.MCPFailureTicketAuthorization)
+	mcpserver := mcpserver.New(c.services.GPTClient, c.services.MCPLoader, c.services.MCPNetworkPolicyEnabled, c.services.MCPDefaultDenyAllEgress, c.services.SingleUserIdleServerShutdownInterval, c.services.MultiUserIdleServerShutdownInterval, c.services.AgentIdleServerShutdownInterval, c.services.ServerURL, c.services.StatusUpdates, c.services.MCPStatusCounterInterval)
+	mcpserverinstance := mcpserverinstance.New(c.services.GatewayClient, c.services.StatusUpdates)
 	accesscontrolrule := accesscontrolrule.New(c.services.AccessControlRuleHelper)
 	mcpWebhookValidations := mcpwebhookvalidation.New(c.services.GPTClient, c.services.MCPHTTPWebhookBaseImage)
 	powerUserWorkspaceHandler := poweruserworkspace.NewHandler(c.services.GatewayClient)
 	adminWorkspaceHandler := adminworkspace.New(c.services.GatewayClient)
 	mcpServerCatalogEntryHandler := mcpservercatalogentry.NewHandler(c.services.GPTClient)
+	toolPreviewGenerator := mcpservercatalogentry.NewToolPreviewGenerator(c.services.GPTClient, c.services.MCPLoader, c.services.GatewayClient, c.services.ServerURL, c.services.MCPToolPreviewAutoGeneration)
 	auditLogExportHandler := auditlogexport.NewHandler(c.services.GPTClient, c.services.GatewayClient, c.services.EncryptionConfig)
 	scheduledAuditLogExportHandler := scheduledauditlogexport.NewHandler()
+	alertRuleHandler := alertrule.New(c.services.GPTClient, c.services.GatewayClient, c.services.AlertRuleEvaluationInterval)
+	mcpCatalogEvents := mcpcatalogevent.New(c.services.GPTClient)
 	oauthclients := oauthclients.NewHandler(c.services.GPTClient)
 	projectMCPServerHandler := projectmcpserver.NewHandler()
 	systemMCPServerHandler := systemmcpserver.New(c.services.GPTClient, c.services.MCPLoader, c.services.ServerURL)
@@ -102,6 +119,7 @@ func (c *Controller) setupRoutes() {
 	// Threads
 	root.Type(&v1.Thread{}).HandlerFunc(retention.Migrate)
 	root.Type(&v1.Thread{}).HandlerFunc(retention.RunRetention(c.services.RetentionPolicy))
+	root.Type(&v1.Thread{}).HandlerFunc(toolapproval.ExpireToolApprovals(c.services.ToolApprovalExpiration, c.services.ToolApprovalWarning))
 	root.Type(&v1.Thread{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.Thread{}).HandlerFunc(threads.CreateWorkspaces)
 	root.Type(&v1.Thread{}).HandlerFunc(threads.CreateSharedWorkspace)
@@ -227,12 +245,12 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.UserDelete{}).HandlerFunc(userCleanup.Cleanup)
 
 	// MCPCatalog
-	root.Type(&v1.MCPCatalog{}).HandlerFunc(mcpCatalog.Sync)
-	root.Type(&v1.MCPCatalog{}).HandlerFunc(mcpCatalog.DeleteUnauthorizedMCPServersForCatalog)
-	root.Type(&v1.MCPCatalog{}).HandlerFunc(mcpCatalog.DeleteUnauthorizedMCPServerInstancesForCatalog)
+	mcpRoot.Type(&v1.MCPCatalog{}).HandlerFunc(mcpCatalog.Sync)
+	mcpRoot.Type(&v1.MCPCatalog{}).HandlerFunc(mcpCatalog.DeleteUnauthorizedMCPServersForCatalog)
+	mcpRoot.Type(&v1.MCPCatalog{}).HandlerFunc(mcpCatalog.DeleteUnauthorizedMCPServerInstancesForCatalog)
 
 	// SystemMCPCatalog
-	root.Type(&v1.SystemMCPCatalog{}).HandlerFunc(mcpCatalog.SyncSystem)
+	mcpRoot.Type(&v1.SystemMCPCatalog{}).HandlerFunc(mcpCatalog.SyncSystem)
 
 	// SkillRepository
 	root.Type(&v1.SkillRepository{}).HandlerFunc(skillRepository.Sync)
@@ -241,45 +259,70 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.Skill{}).HandlerFunc(cleanup.Cleanup)
 
 	// MCPServerCatalogEntry
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(cleanup.Cleanup)
-	root.Type(&v1.MCPServerCatalogEntry{}).FinalizeFunc(v1.MCPServerCatalogEntryFinalizer, mcpServerCatalogEntryHandler.RemoveOAuthCredentials)
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.DeleteEntriesWithoutRuntime)
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.UpdateManifestHashAndLastUpdated)
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.CleanupNestedCompositeEntries)
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.DetectCompositeDrift)
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.EnsureUserCount)
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.CleanupUnusedOAuthCredentials)
-	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.EnsureOAuthCredentialStatus)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).FinalizeFunc(v1.MCPServerCatalogEntryFinalizer, mcpServerCatalogEntryHandler.RemoveCredentials)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.DeleteEntriesWithoutRuntime)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.UpdateManifestHashAndLastUpdated)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.EnsureRevision)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.CleanupNestedCompositeEntries)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.DetectCompositeDrift)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.EnsureUserCount)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.CleanupUnusedOAuthCredentials)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.EnsureOAuthCredentialStatus)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.UpdateConditions)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(toolPreviewGenerator.GenerateToolPreviews)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpSearchIndexer.IndexCatalogEntry)
+	mcpRoot.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpCatalogEvents.EntryPublished)
 
 	// SystemMCPServerCatalogEntry
-	root.Type(&v1.SystemMCPServerCatalogEntry{}).HandlerFunc(cleanup.Cleanup)
-	root.Type(&v1.SystemMCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.UpdateSystemManifestHashAndLastUpdated)
+	mcpRoot.Type(&v1.SystemMCPServerCatalogEntry{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.SystemMCPServerCatalogEntry{}).HandlerFunc(mcpServerCatalogEntryHandler.UpdateSystemManifestHashAndLastUpdated)
 
 	// MCPServer
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPCatalogID)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.MigrateSharedWithinMCPCatalogName)
-	root.Type(&v1.MCPServer{}).HandlerFunc(cleanup.Cleanup)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DeleteServersWithoutRuntime)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DeleteServersForAnonymousUser)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.CleanupNestedCompositeServers)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DetectDrift)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DetectK8sSettingsDrift)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPNetworkPolicy)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPServerInstanceUserCount)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.SyncOAuthCredentialStatus)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPServerSecretInfo)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureCompositeComponents)
-	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.ShutdownIdleServers)
-	root.Type(&v1.MCPServer{}).FinalizeFunc(v1.MCPServerFinalizer, credentialCleanup.RemoveMCPCredentials)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPCatalogID)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.MigrateSharedWithinMCPCatalogName)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DeleteServersWithoutRuntime)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DeleteServersForAnonymousUser)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.CleanupNestedCompositeServers)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DetectDrift)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.SyncMaintenanceNotice)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DetectK8sSettingsDrift)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPNetworkPolicy)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPServerInstanceUserCount)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.SyncOAuthCredentialStatus)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPServerSecretInfo)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureCompositeComponents)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.ShutdownIdleServers)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(staleMCPServerReaper.Reap)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpServerPrewarmer.Prewarm)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpServerMemory.Provision)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpServerWorkspace.MeasureUsage)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpServerLiveness.Probe)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpServerCredentialExpiry.Check)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpServerFailureTickets.CreateTicket)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.UpdateConditions)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpSearchIndexer.IndexServer)
+	mcpRoot.Type(&v1.MCPServer{}).HandlerFunc(mcpCatalogEvents.ServerChanged)
+	mcpRoot.Type(&v1.MCPServer{}).FinalizeFunc(v1.MCPServerCatalogEventFinalizer, mcpCatalogEvents.ServerDeleted)
+	mcpRoot.Type(&v1.MCPServer{}).FinalizeFunc(v1.MCPServerMemoryFinalizer, mcpServerMemory.Deprovision)
+	mcpRoot.Type(&v1.MCPServer{}).FinalizeFunc(v1.MCPServerFinalizer, credentialCleanup.RemoveMCPCredentials)
 
 	// MCPNetworkPolicy
-	root.Type(&v1.MCPNetworkPolicy{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.MCPNetworkPolicy{}).HandlerFunc(cleanup.Cleanup)
+
+	// MCPServerCatalogEntryRevision
+	mcpRoot.Type(&v1.MCPServerCatalogEntryRevision{}).HandlerFunc(cleanup.Cleanup)
+
+	// PendingCatalogEntry
+	mcpRoot.Type(&v1.PendingCatalogEntry{}).HandlerFunc(cleanup.Cleanup)
 
 	// MCPServerInstance
-	root.Type(&v1.MCPServerInstance{}).HandlerFunc(cleanup.Cleanup)
-	root.Type(&v1.MCPServerInstance{}).HandlerFunc(mcpserverinstance.MigrationDeleteSingleUserInstances)
-	root.Type(&v1.MCPServerInstance{}).HandlerFunc(mcpserverinstance.UpdateMultiUserConfig)
-	root.Type(&v1.MCPServerInstance{}).FinalizeFunc(v1.MCPServerInstanceFinalizer, credentialCleanup.RemoveMCPInstanceCredentials)
+	mcpRoot.Type(&v1.MCPServerInstance{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.MCPServerInstance{}).HandlerFunc(mcpserverinstance.MigrationDeleteSingleUserInstances)
+	mcpRoot.Type(&v1.MCPServerInstance{}).HandlerFunc(mcpserverinstance.UpdateMultiUserConfig)
+	mcpRoot.Type(&v1.MCPServerInstance{}).HandlerFunc(mcpserverinstance.UpdateConditions)
+	mcpRoot.Type(&v1.MCPServerInstance{}).FinalizeFunc(v1.MCPServerInstanceFinalizer, credentialCleanup.RemoveMCPInstanceCredentials)
 
 	// AccessControlRule
 	root.Type(&v1.AccessControlRule{}).HandlerFunc(cleanup.Cleanup)
@@ -313,12 +356,12 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.OAuthToken{}).HandlerFunc(cleanup.Cleanup)
 
 	// MCP Sessions
-	root.Type(&v1.MCPSession{}).HandlerFunc(mcpSession.RemoveUnused)
-	root.Type(&v1.MCPSession{}).FinalizeFunc(v1.MCPSessionFinalizer, mcpSession.CleanupCredentials)
+	mcpRoot.Type(&v1.MCPSession{}).HandlerFunc(mcpSession.RemoveUnused)
+	mcpRoot.Type(&v1.MCPSession{}).FinalizeFunc(v1.MCPSessionFinalizer, mcpSession.CleanupCredentials)
 
 	// MCP Webhook Validations
-	root.Type(&v1.MCPWebhookValidation{}).HandlerFunc(mcpWebhookValidations.CleanupResources)
-	root.Type(&v1.MCPWebhookValidation{}).HandlerFunc(mcpWebhookValidations.EnsureSystemServer)
+	mcpRoot.Type(&v1.MCPWebhookValidation{}).HandlerFunc(mcpWebhookValidations.CleanupResources)
+	mcpRoot.Type(&v1.MCPWebhookValidation{}).HandlerFunc(mcpWebhookValidations.EnsureSystemServer)
 
 	// UserRoleChange
 	root.Type(&v1.UserRoleChange{}).HandlerFunc(powerUserWorkspaceHandler.HandleRoleChange)
@@ -338,15 +381,16 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.PowerUserWorkspace{}).HandlerFunc(mcpCatalog.DeleteUnauthorizedMCPServerInstancesForWorkspace)
 
 	// Project-based MCP Servers
-	root.Type(&v1.ProjectMCPServer{}).HandlerFunc(projectMCPServerHandler.EnsureMCPServerName)
-	root.Type(&v1.ProjectMCPServer{}).FinalizeFunc(v1.ProjectMCPServerFinalizer, credentialCleanup.ShutdownProjectMCP)
-	root.Type(&v1.ProjectMCPServer{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.ProjectMCPServer{}).HandlerFunc(projectMCPServerHandler.EnsureMCPServerName)
+	mcpRoot.Type(&v1.ProjectMCPServer{}).HandlerFunc(mcpServerWorkspace.BindProject)
+	mcpRoot.Type(&v1.ProjectMCPServer{}).FinalizeFunc(v1.ProjectMCPServerFinalizer, credentialCleanup.ShutdownProjectMCP)
+	mcpRoot.Type(&v1.ProjectMCPServer{}).HandlerFunc(cleanup.Cleanup)
 
 	// System MCP Servers
-	root.Type(&v1.SystemMCPServer{}).HandlerFunc(systemMCPServerHandler.EnsureSecretInfo)
-	root.Type(&v1.SystemMCPServer{}).HandlerFunc(systemMCPServerHandler.EnsureDeployment)
-	root.Type(&v1.SystemMCPServer{}).HandlerFunc(cleanup.Cleanup)
-	root.Type(&v1.SystemMCPServer{}).FinalizeFunc(v1.SystemMCPServerFinalizer, systemMCPServerHandler.CleanupDeployment)
+	mcpRoot.Type(&v1.SystemMCPServer{}).HandlerFunc(systemMCPServerHandler.EnsureSecretInfo)
+	mcpRoot.Type(&v1.SystemMCPServer{}).HandlerFunc(systemMCPServerHandler.EnsureDeployment)
+	mcpRoot.Type(&v1.SystemMCPServer{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.SystemMCPServer{}).FinalizeFunc(v1.SystemMCPServerFinalizer, systemMCPServerHandler.CleanupDeployment)
 
 	// AuditLogExport
 	root.Type(&v1.AuditLogExport{}).HandlerFunc(auditLogExportHandler.ExportAuditLogs)
@@ -354,6 +398,17 @@ func (c *Controller) setupRoutes() {
 	// ScheduledAuditLogExport
 	root.Type(&v1.ScheduledAuditLogExport{}).HandlerFunc(scheduledAuditLogExportHandler.ScheduleExports)
 
+	// AlertRule
+	root.Type(&v1.AlertRule{}).HandlerFunc(alertRuleHandler.Evaluate)
+
+	// MCPCatalogEventWebhook
+	mcpRoot.Type(&v1.MCPCatalogEventWebhook{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.MCPCatalogEventWebhook{}).FinalizeFunc(v1.MCPCatalogEventWebhookFinalizer, mcpCatalogEvents.RemoveSecret)
+
+	// MCPCatalogEvent
+	mcpRoot.Type(&v1.MCPCatalogEvent{}).HandlerFunc(cleanup.Cleanup)
+	mcpRoot.Type(&v1.MCPCatalogEvent{}).HandlerFunc(mcpCatalogEvents.Deliver)
+
 	// NanobotAgent
 	if c.services.NanobotIntegration {
 		root.Type(&v1.NanobotAgent{}).HandlerFunc(nanobotAgentHandler.EnsureMCPServer)
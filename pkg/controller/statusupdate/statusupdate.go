@@ -0,0 +1,162 @@
+package statusupdate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/nah/pkg/router"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	reasonIdentical = "identical"
+	reasonThrottled = "throttled"
+
+	// entryTTL is how long the last write of an object is remembered. Objects that are not written for longer, such as
+	// deleted objects, are forgotten.
+	entryTTL = time.Hour
+)
+
+var (
+	statusWrites = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      "obot",
+		Subsystem:      "controller",
+		Name:           "status_writes_total",
+		Help:           "The number of status updates written by controllers, by kind",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"kind"})
+	suppressedStatusWrites = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      "obot",
+		Subsystem:      "controller",
+		Name:           "status_writes_suppressed_total",
+		Help:           "The number of status updates that controllers did not write because they were identical to the last write or throttled, by kind and reason",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"kind", "reason"})
+)
+
+func init() {
+	legacyregistry.MustRegister(statusWrites, suppressedStatusWrites)
+}
+
+// Coalescer reduces the status updates that controllers write. Updates that are identical to the last update written
+// for an object are not written, and updates of frequently changing fields, such as counters, can be throttled so that
+// the changes within an interval are written together.
+type Coalescer struct {
+	lock      sync.Mutex
+	written   map[ktypes.UID]write
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// write is the last status update written for an object.
+type write struct {
+	resourceVersion string
+	statusHash      string
+	time            time.Time
+}
+
+func New() *Coalescer {
+	return &Coalescer{
+		written: make(map[ktypes.UID]write),
+		now:     time.Now,
+	}
+}
+
+// Update writes the status of the object, unless it is identical to the status last written for the object and the
+// object has not changed since.
+func (c *Coalescer) Update(req router.Request, obj kclient.Object) error {
+	statusHash, err := hashStatus(obj)
+	if err != nil {
+		return err
+	}
+
+	kind := kindOf(obj)
+	if last, ok := c.lastWrite(obj.GetUID()); ok && last.resourceVersion == obj.GetResourceVersion() && last.statusHash == statusHash {
+		suppressedStatusWrites.WithLabelValues(kind, reasonIdentical).Inc()
+		return nil
+	}
+
+	if err := req.Client.Status().Update(req.Ctx, obj); err != nil {
+		return err
+	}
+
+	statusWrites.WithLabelValues(kind).Inc()
+	c.record(obj.GetUID(), write{
+		resourceVersion: obj.GetResourceVersion(),
+		statusHash:      statusHash,
+		time:            c.now(),
+	})
+	return nil
+}
+
+// UpdateThrottled writes the status of the object like Update, unless the status of the object was written less than
+// interval ago. Then the object is reconciled again when the interval has passed, so that the changes within the
+// interval are written together. Use it for fields that change frequently and don't need to be current, like counters.
+func (c *Coalescer) UpdateThrottled(req router.Request, resp router.Response, obj kclient.Object, interval time.Duration) error {
+	if last, ok := c.lastWrite(obj.GetUID()); ok && interval > 0 {
+		if elapsed := c.now().Sub(last.time); elapsed < interval {
+			suppressedStatusWrites.WithLabelValues(kindOf(obj), reasonThrottled).Inc()
+			resp.RetryAfter(interval - elapsed)
+			return nil
+		}
+	}
+
+	return c.Update(req, obj)
+}
+
+func (c *Coalescer) lastWrite(uid ktypes.UID) (write, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	last, ok := c.written[uid]
+	return last, ok
+}
+
+func (c *Coalescer) record(uid ktypes.UID, w write) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.written[uid] = w
+
+	if w.time.Sub(c.lastSweep) < entryTTL {
+		return
+	}
+	c.lastSweep = w.time
+	for uid, last := range c.written {
+		if w.time.Sub(last.time) > entryTTL {
+			delete(c.written, uid)
+		}
+	}
+}
+
+// hashStatus returns a hash of the status of the object.
+func hashStatus(obj kclient.Object) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s: %w", obj.GetName(), err)
+	}
+
+	var status struct {
+		Status json.RawMessage `json:"status"`
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return "", fmt.Errorf("failed to unmarshal status of %s: %w", obj.GetName(), err)
+	}
+
+	return hash.Digest(string(status.Status)), nil
+}
+
+func kindOf(obj kclient.Object) string {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
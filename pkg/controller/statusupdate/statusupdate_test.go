@@ -0,0 +1,88 @@
+package statusupdate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/metrics/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type response struct {
+	router.ResponseWrapper
+	retryAfter time.Duration
+}
+
+func (r *response) RetryAfter(delay time.Duration) {
+	r.retryAfter = delay
+}
+
+func suppressed(t *testing.T, reason string) float64 {
+	t.Helper()
+	value, err := testutil.GetCounterMetricValue(suppressedStatusWrites.WithLabelValues("MCPServer", reason))
+	require.NoError(t, err)
+	return value
+}
+
+func TestCoalescer(t *testing.T) {
+	server := &v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms1", Namespace: "default", UID: "ms1-uid"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(storagescheme.Scheme).
+		WithStatusSubresource(&v1.MCPServer{}).
+		WithObjects(server).
+		Build()
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	coalescer := New()
+	coalescer.now = func() time.Time { return now }
+
+	req := router.Request{Client: c, Ctx: context.Background()}
+	identical, throttled := suppressed(t, reasonIdentical), suppressed(t, reasonThrottled)
+
+	server.Status.NeedsUpdate = true
+	require.NoError(t, coalescer.Update(req, server))
+	resourceVersion := server.ResourceVersion
+
+	// Writing the same status again is suppressed.
+	require.NoError(t, coalescer.Update(req, server))
+	require.Equal(t, resourceVersion, server.ResourceVersion)
+	require.Equal(t, identical+1, suppressed(t, reasonIdentical))
+
+	// A counter changed within the interval is not written, and the object is reconciled again after the interval.
+	count := 3
+	server.Status.MCPServerInstanceUserCount = &count
+	now = now.Add(10 * time.Second)
+	resp := &response{}
+	require.NoError(t, coalescer.UpdateThrottled(req, resp, server, 30*time.Second))
+	require.Equal(t, resourceVersion, server.ResourceVersion)
+	require.Equal(t, 20*time.Second, resp.retryAfter)
+	require.Equal(t, throttled+1, suppressed(t, reasonThrottled))
+
+	// After the interval, the counter is written.
+	now = now.Add(20 * time.Second)
+	resp = &response{}
+	require.NoError(t, coalescer.UpdateThrottled(req, resp, server, 30*time.Second))
+	require.NotEqual(t, resourceVersion, server.ResourceVersion)
+	require.Zero(t, resp.retryAfter)
+
+	var stored v1.MCPServer
+	require.NoError(t, c.Get(context.Background(), router.Key("default", "ms1"), &stored))
+	require.True(t, stored.Status.NeedsUpdate)
+	require.Equal(t, 3, *stored.Status.MCPServerInstanceUserCount)
+
+	// Changes to the object since the last write are not suppressed, even if the status is the same.
+	server.ResourceVersion = stored.ResourceVersion
+	server.Labels = map[string]string{"changed": "true"}
+	require.NoError(t, c.Update(context.Background(), server))
+	identical = suppressed(t, reasonIdentical)
+	require.NoError(t, coalescer.Update(req, server))
+	require.Equal(t, identical, suppressed(t, reasonIdentical))
+}
@@ -0,0 +1,82 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+const (
+	passphraseSaltSize   = 16
+	passphraseKeySize    = 32
+	passphraseIterations = 600_000
+)
+
+// ErrDecryptionFailed is returned when data cannot be decrypted, usually because the passphrase is wrong.
+var ErrDecryptionFailed = errors.New("failed to decrypt data, the key may be incorrect")
+
+// EncryptWithPassphrase encrypts the plaintext with AES-GCM using a key derived from the passphrase.
+// The salt and nonce are prepended to the returned ciphertext.
+func EncryptWithPassphrase(plaintext []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, passphraseSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := passphraseCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := append(salt, nonce...)
+	return gcm.Seal(out, nonce, plaintext, nil), nil
+}
+
+// DecryptWithPassphrase decrypts data encrypted by EncryptWithPassphrase.
+func DecryptWithPassphrase(ciphertext []byte, passphrase string) ([]byte, error) {
+	if len(ciphertext) < passphraseSaltSize {
+		return nil, ErrDecryptionFailed
+	}
+
+	gcm, err := passphraseCipher(passphrase, ciphertext[:passphraseSaltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext = ciphertext[passphraseSaltSize:]
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, ErrDecryptionFailed
+	}
+
+	plaintext, err := gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+func passphraseCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	if passphrase == "" {
+		return nil, errors.New("passphrase is required")
+	}
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, passphraseIterations, passphraseKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,34 @@
+package encryption
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestPassphraseRoundTrip(t *testing.T) {
+	plaintext := []byte(`{"ms1abc":{"API_KEY":"secret"}}`)
+
+	ciphertext, err := EncryptWithPassphrase(plaintext, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("EncryptWithPassphrase() error = %v", err)
+	}
+	if bytes.Contains(ciphertext, []byte("secret")) {
+		t.Fatal("ciphertext contains the plaintext")
+	}
+
+	decrypted, err := DecryptWithPassphrase(ciphertext, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("DecryptWithPassphrase() error = %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("DecryptWithPassphrase() = %q, want %q", decrypted, plaintext)
+	}
+
+	if _, err = DecryptWithPassphrase(ciphertext, "wrong"); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("DecryptWithPassphrase() with wrong passphrase error = %v, want %v", err, ErrDecryptionFailed)
+	}
+	if _, err = DecryptWithPassphrase(ciphertext[:10], "correct horse battery staple"); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("DecryptWithPassphrase() with truncated data error = %v, want %v", err, ErrDecryptionFailed)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"time"
 
@@ -38,6 +39,15 @@ func cloneAPIKey(apiKey types.APIKey) types.APIKey {
 	if apiKey.ExpiresAt != nil {
 		cloned.ExpiresAt = new(*apiKey.ExpiresAt)
 	}
+	if apiKey.RevokedAt != nil {
+		cloned.RevokedAt = new(*apiKey.RevokedAt)
+	}
+	if apiKey.MCPServerTools != nil {
+		cloned.MCPServerTools = make(map[string][]string, len(apiKey.MCPServerTools))
+		for id, tools := range apiKey.MCPServerTools {
+			cloned.MCPServerTools[id] = append([]string(nil), tools...)
+		}
+	}
 	return cloned
 }
 
@@ -143,7 +153,7 @@ func (c *Client) invalidateValidatedAPIKeysByID(keyID uint) {
 
 // CreateAPIKey generates a new API key for the given user.
 // Returns the full key only once in the response.
-func (c *Client) CreateAPIKey(ctx context.Context, userID uint, name, description string, expiresAt *time.Time, mcpServerIDs []string, canAccessSkills bool) (*types.APIKeyCreateResponse, error) {
+func (c *Client) CreateAPIKey(ctx context.Context, userID uint, name, description string, expiresAt *time.Time, mcpServerIDs []string, mcpServerTools map[string][]string, canAccessSkills bool) (*types.APIKeyCreateResponse, error) {
 	// Generate cryptographically secure random secret
 	secretBytes := make([]byte, apiKeySecretLength)
 	if _, err := rand.Read(secretBytes); err != nil {
@@ -167,6 +177,7 @@ func (c *Client) CreateAPIKey(ctx context.Context, userID uint, name, descriptio
 		ExpiresAt:       expiresAt,
 		CreatedAt:       time.Now(),
 		MCPServerIDs:    mcpServerIDs,
+		MCPServerTools:  mcpServerTools,
 	}
 
 	if err := c.db.WithContext(ctx).Create(apiKey).Error; err != nil {
@@ -213,11 +224,16 @@ func (c *Client) DeleteAPIKey(ctx context.Context, userID uint, keyID uint) erro
 // ValidateAPIKey validates an API key and returns the associated APIKey record.
 // The key format is: ok1-<user_id>-<key_id>-<secret>
 // Lookup is done by key ID, then bcrypt is used to verify the secret.
-// Cache hits return a previously validated key without touching the database.
+// Cache hits return a previously validated key without verifying the secret again, but still check that the key
+// wasn't revoked or deleted, so that revocation takes effect immediately on every replica.
 // On cache misses, last_used_at is updated only if more than a minute has elapsed.
 func (c *Client) ValidateAPIKey(ctx context.Context, key string) (*types.APIKey, error) {
 	cacheNow := time.Now()
 	if cachedAPIKey, ok := c.getValidatedAPIKeyFromCache(key, cacheNow); ok {
+		if err := c.checkAPIKeyNotRevoked(ctx, cachedAPIKey.ID); err != nil {
+			c.invalidateValidatedAPIKeysByID(cachedAPIKey.ID)
+			return nil, err
+		}
 		return cachedAPIKey, nil
 	}
 
@@ -239,6 +255,10 @@ func (c *Client) ValidateAPIKey(ctx context.Context, key string) (*types.APIKey,
 			return fmt.Errorf("invalid API key")
 		}
 
+		if apiKey.RevokedAt != nil {
+			return ErrAPIKeyRevoked
+		}
+
 		// Check expiration
 		if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
 			return fmt.Errorf("API key has expired")
@@ -260,6 +280,53 @@ func (c *Client) ValidateAPIKey(ctx context.Context, key string) (*types.APIKey,
 	return &apiKey, nil
 }
 
+// ErrAPIKeyRevoked is returned when a revoked API key is used.
+var ErrAPIKeyRevoked = errors.New("API key has been revoked")
+
+// checkAPIKeyNotRevoked returns an error if the API key was revoked or deleted.
+func (c *Client) checkAPIKeyNotRevoked(ctx context.Context, keyID uint) error {
+	var count int64
+	if err := c.db.WithContext(ctx).Model(&types.APIKey{}).Where("id = ?", keyID).Where("revoked_at IS NULL").Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check whether API key is revoked: %w", err)
+	}
+	if count == 0 {
+		return ErrAPIKeyRevoked
+	}
+	return nil
+}
+
+// RevokeAPIKey revokes an API key of a user, which can't be used from then on. Unlike deleted keys, revoked keys are
+// still listed.
+func (c *Client) RevokeAPIKey(ctx context.Context, userID uint, keyID uint) (*types.APIKey, error) {
+	return c.revokeAPIKey(ctx, userID, keyID)
+}
+
+// revokeAPIKey revokes an API key. If userID is 0, then the key of any user is revoked.
+func (c *Client) revokeAPIKey(ctx context.Context, userID uint, keyID uint) (*types.APIKey, error) {
+	var key types.APIKey
+	if err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("id = ?", keyID)
+		if userID != 0 {
+			query = query.Where("user_id = ?", userID)
+		}
+		if err := query.First(&key).Error; err != nil {
+			return err
+		}
+		if key.RevokedAt != nil {
+			return nil
+		}
+
+		now := time.Now()
+		key.RevokedAt = &now
+		return tx.Model(&key).Update("revoked_at", now).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	c.invalidateValidatedAPIKeysByID(key.ID)
+	return &key, nil
+}
+
 // ParseAPIKey parses an API key string and extracts its components.
 // Returns prefix, userID, keyID, secret, and an error if the format is invalid.
 func ParseAPIKey(key string) (prefix string, userID uint, keyID uint, secret string, err error) {
@@ -303,6 +370,11 @@ func (c *Client) DeleteAPIKeyByID(ctx context.Context, keyID uint) error {
 	return nil
 }
 
+// RevokeAPIKeyByID revokes an API key by ID without user filtering (for admin use).
+func (c *Client) RevokeAPIKeyByID(ctx context.Context, keyID uint) (*types.APIKey, error) {
+	return c.revokeAPIKey(ctx, 0, keyID)
+}
+
 // UpdateAPIKeyLastUsed updates the last_used_at timestamp for an API key
 // if more than a minute has elapsed since the previous timestamp.
 func (c *Client) UpdateAPIKeyLastUsed(ctx context.Context, key *types.APIKey) error {
@@ -1,8 +1,13 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
 )
 
 func TestParseAPIKey(t *testing.T) {
@@ -182,3 +187,71 @@ func TestParseAPIKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRevokeAPIKey(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	c.apiKeyCache = make(map[[32]byte]apiKeyValidationCacheEntry)
+	c.apiKeyCacheTTL = time.Minute
+
+	created, err := c.CreateAPIKey(ctx, 7, "scoped", "", nil, []string{"ms1test"}, map[string][]string{"ms1test": {"read_*"}}, false)
+	if err != nil {
+		t.Fatalf("failed to create API key: %v", err)
+	}
+
+	apiKey, err := c.ValidateAPIKey(ctx, created.Key)
+	if err != nil {
+		t.Fatalf("failed to validate API key: %v", err)
+	}
+	if !apiKey.AllowsMCPServer("ms1test") || apiKey.AllowsMCPServer("ms1other") {
+		t.Fatalf("unexpected MCP servers of API key: %v", apiKey.MCPServerIDs)
+	}
+	if tools := apiKey.ToolAllowlist("ms1test"); len(tools) != 1 || tools[0] != "read_*" {
+		t.Fatalf("unexpected tools of API key: %v", tools)
+	}
+	if apiKey.LastUsedAt == nil {
+		t.Fatal("expected the last use of the API key to be recorded")
+	}
+
+	if _, err = c.RevokeAPIKey(ctx, 8, apiKey.ID); err == nil {
+		t.Fatal("expected revoking the API key of another user to fail")
+	}
+
+	// Revoke another key in the database directly, like another replica would, so that the cache entry of this one is
+	// kept.
+	other, err := c.CreateAPIKey(ctx, 8, "other", "", nil, nil, nil, false)
+	if err != nil {
+		t.Fatalf("failed to create API key: %v", err)
+	}
+	otherAPIKey, err := c.ValidateAPIKey(ctx, other.Key)
+	if err != nil {
+		t.Fatalf("failed to validate API key: %v", err)
+	}
+	if err = c.db.WithContext(ctx).Model(&types.APIKey{}).Where("id = ?", otherAPIKey.ID).Update("revoked_at", time.Now()).Error; err != nil {
+		t.Fatalf("failed to revoke API key: %v", err)
+	}
+	if _, err = c.ValidateAPIKey(ctx, other.Key); !errors.Is(err, ErrAPIKeyRevoked) {
+		t.Fatalf("expected the API key revoked by another replica to be rejected, got %v", err)
+	}
+
+	revoked, err := c.RevokeAPIKey(ctx, 7, apiKey.ID)
+	if err != nil {
+		t.Fatalf("failed to revoke API key: %v", err)
+	}
+	if revoked.RevokedAt == nil {
+		t.Fatal("expected the API key to be revoked")
+	}
+
+	// The key was cached when it was validated, so revoking it must evict it.
+	if _, err = c.ValidateAPIKey(ctx, created.Key); !errors.Is(err, ErrAPIKeyRevoked) {
+		t.Fatalf("expected the revoked API key to be rejected, got %v", err)
+	}
+
+	keys, err := c.ListAPIKeys(ctx, 7)
+	if err != nil {
+		t.Fatalf("failed to list API keys: %v", err)
+	}
+	if len(keys) != 1 || keys[0].RevokedAt == nil {
+		t.Fatalf("expected the revoked API key to still be listed, got %+v", keys)
+	}
+}
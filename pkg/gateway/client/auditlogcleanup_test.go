@@ -1,10 +1,17 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"testing"
 	"time"
 
+	types2 "github.com/obot-platform/obot/apiclient/types"
+
 	gatewaydb "github.com/obot-platform/obot/pkg/gateway/db"
 	"github.com/obot-platform/obot/pkg/gateway/types"
 	sservices "github.com/obot-platform/obot/pkg/storage/services"
@@ -29,9 +36,10 @@ func newTestClient(t *testing.T) *Client {
 	}
 
 	return &Client{
-		db:                      db,
-		auditLogCleanupInterval: 50 * time.Millisecond,
-		auditLogDeleteBatchSize: 3,
+		db:                         db,
+		auditLogCleanupInterval:    50 * time.Millisecond,
+		auditLogDeleteBatchSize:    3,
+		mcpOAuthTokenExpiryWarning: 24 * time.Hour,
 	}
 }
 
@@ -69,7 +77,7 @@ func TestDeleteOldAuditLogs(t *testing.T) {
 	insertAuditLog(t, c, now.AddDate(0, 0, -1))    // recent - should be kept
 	insertAuditLog(t, c, now)                      // recent - should be kept
 
-	if err := c.deleteOldAuditLogs(ctx, now, 90); err != nil {
+	if err := c.deleteOldAuditLogs(ctx, now, 90, nil); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -87,7 +95,7 @@ func TestDeleteOldAuditLogsDisabled(t *testing.T) {
 	insertAuditLog(t, c, now.AddDate(0, 0, -100))
 
 	// retentionDays=0 should be a no-op
-	if err := c.deleteOldAuditLogs(ctx, now, 0); err != nil {
+	if err := c.deleteOldAuditLogs(ctx, now, 0, nil); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -108,7 +116,7 @@ func TestDeleteOldAuditLogsBatching(t *testing.T) {
 	insertAuditLog(t, c, now.AddDate(0, 0, -1))
 	insertAuditLog(t, c, now)
 
-	if err := c.deleteOldAuditLogs(ctx, now, 90); err != nil {
+	if err := c.deleteOldAuditLogs(ctx, now, 90, nil); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -127,7 +135,7 @@ func TestRunAuditLogCleanup(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	go c.runAuditLogCleanup(ctx, 90)
+	go c.RunAuditLogRetention(ctx, 90, nil)
 
 	// Wait until the cleanup has deleted old logs, or time out.
 	deadline := time.Now().Add(2 * time.Second)
@@ -159,9 +167,88 @@ func TestRunAuditLogCleanupDisabled(t *testing.T) {
 
 	// retentionDays=0 means the function returns immediately without cleanup.
 	// Call synchronously — if it ever blocks, the test timeout will catch it.
-	c.runAuditLogCleanup(t.Context(), 0)
+	c.RunAuditLogRetention(t.Context(), 0, nil)
 
 	if got := countAuditLogs(t, c); got != 2 {
 		t.Errorf("expected 2 audit logs (cleanup disabled), got %d", got)
 	}
 }
+
+type testArchiver struct {
+	archives map[string][]byte
+	err      error
+}
+
+func (a *testArchiver) Archive(_ context.Context, name string, data []byte) error {
+	if a.err != nil {
+		return a.err
+	}
+	if a.archives == nil {
+		a.archives = make(map[string][]byte)
+	}
+	a.archives[name] = data
+	return nil
+}
+
+func TestDeleteOldAuditLogsArchives(t *testing.T) {
+	c := newTestClient(t) // auditLogDeleteBatchSize = 3
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	for range 4 {
+		insertAuditLog(t, c, now.AddDate(0, 0, -100))
+	}
+	insertAuditLog(t, c, now)
+
+	archiver := &testArchiver{}
+	if err := c.deleteOldAuditLogs(ctx, now, 90, archiver); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := countAuditLogs(t, c); got != 1 {
+		t.Errorf("expected 1 audit log after cleanup, got %d", got)
+	}
+	if len(archiver.archives) != 2 {
+		t.Fatalf("expected 2 archives, got %d", len(archiver.archives))
+	}
+
+	var archived int
+	for name, data := range archiver.archives {
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("archive %s is not gzip-compressed: %v", name, err)
+		}
+		contents, err := io.ReadAll(gz)
+		if err != nil {
+			t.Fatalf("failed to read archive %s: %v", name, err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(contents))
+		for dec.More() {
+			var entry types2.MCPAuditLog
+			if err := dec.Decode(&entry); err != nil {
+				t.Fatalf("archive %s contains an invalid log: %v", name, err)
+			}
+			archived++
+		}
+	}
+	if archived != 4 {
+		t.Errorf("expected 4 archived audit logs, got %d", archived)
+	}
+}
+
+func TestDeleteOldAuditLogsArchiveFailure(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	insertAuditLog(t, c, now.AddDate(0, 0, -100))
+	insertAuditLog(t, c, now)
+
+	if err := c.deleteOldAuditLogs(ctx, now, 90, &testArchiver{err: errors.New("unavailable")}); err == nil {
+		t.Fatal("expected an error when archiving fails")
+	}
+
+	if got := countAuditLogs(t, c); got != 2 {
+		t.Errorf("expected 2 audit logs (archive failed), got %d", got)
+	}
+}
@@ -1,8 +1,12 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/obot-platform/obot/logger"
@@ -56,12 +60,21 @@ func (c *Client) runPersistenceLoop(ctx context.Context, flushInterval time.Dura
 	}
 }
 
-func (c *Client) runAuditLogCleanup(ctx context.Context, retentionDays int) {
+// AuditLogArchiver stores MCP audit logs before the retention policy deletes them.
+type AuditLogArchiver interface {
+	// Archive stores data, a gzip-compressed file of JSON lines, under the given name.
+	Archive(ctx context.Context, name string, data []byte) error
+}
+
+// RunAuditLogRetention deletes the MCP audit logs that are older than the retention period once a day. If an archiver
+// is given, the logs are archived before they are deleted, and logs that fail to archive are kept until the next run.
+// Only one replica should run this at a time.
+func (c *Client) RunAuditLogRetention(ctx context.Context, retentionDays int, archiver AuditLogArchiver) {
 	if retentionDays <= 0 {
 		return
 	}
 
-	err := c.deleteOldAuditLogs(ctx, time.Now().UTC(), retentionDays)
+	err := c.deleteOldAuditLogs(ctx, time.Now().UTC(), retentionDays, archiver)
 	if err != nil && !errors.Is(err, context.Canceled) {
 		log.Errorf("Failed to delete old audit logs: %v", err)
 	}
@@ -74,7 +87,7 @@ func (c *Client) runAuditLogCleanup(ctx context.Context, retentionDays int) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			err = c.deleteOldAuditLogs(ctx, time.Now().UTC(), retentionDays)
+			err = c.deleteOldAuditLogs(ctx, time.Now().UTC(), retentionDays, archiver)
 			if err != nil && !errors.Is(err, context.Canceled) {
 				log.Errorf("Failed to delete old audit logs: %v", err)
 			}
@@ -82,7 +95,7 @@ func (c *Client) runAuditLogCleanup(ctx context.Context, retentionDays int) {
 	}
 }
 
-func (c *Client) deleteOldAuditLogs(ctx context.Context, now time.Time, retentionDays int) error {
+func (c *Client) deleteOldAuditLogs(ctx context.Context, now time.Time, retentionDays int, archiver AuditLogArchiver) error {
 	if retentionDays <= 0 {
 		return nil
 	}
@@ -91,6 +104,9 @@ func (c *Client) deleteOldAuditLogs(ctx context.Context, now time.Time, retentio
 	}
 
 	cutoff := now.Truncate(24*time.Hour).AddDate(0, 0, -retentionDays)
+	if archiver != nil {
+		return c.archiveOldAuditLogs(ctx, cutoff, archiver)
+	}
 
 	for {
 		if ctx.Err() != nil {
@@ -110,6 +126,65 @@ func (c *Client) deleteOldAuditLogs(ctx context.Context, now time.Time, retentio
 	}
 }
 
+func (c *Client) archiveOldAuditLogs(ctx context.Context, cutoff time.Time, archiver AuditLogArchiver) error {
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var logs []types.MCPAuditLog
+		if err := c.db.WithContext(ctx).Where("created_at < ?", cutoff).Order("id").Limit(c.auditLogDeleteBatchSize).Find(&logs).Error; err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+
+		data, err := c.compressAuditLogs(ctx, logs)
+		if err != nil {
+			return fmt.Errorf("failed to compress audit logs: %w", err)
+		}
+
+		// The name only depends on the logs in the batch, so a batch that is archived again after a failed delete
+		// replaces the earlier archive instead of duplicating it.
+		first, last := logs[0], logs[len(logs)-1]
+		name := fmt.Sprintf("mcp-audit-logs-%s-%d-%d.jsonl.gz", first.CreatedAt.UTC().Format("20060102"), first.ID, last.ID)
+		if err = archiver.Archive(ctx, name, data); err != nil {
+			return fmt.Errorf("failed to archive audit logs: %w", err)
+		}
+
+		ids := make([]uint, 0, len(logs))
+		for _, l := range logs {
+			ids = append(ids, l.ID)
+		}
+		if err = c.db.WithContext(ctx).Delete(&types.MCPAuditLog{}, ids).Error; err != nil {
+			return err
+		}
+		if len(logs) < c.auditLogDeleteBatchSize {
+			return nil
+		}
+	}
+}
+
+func (c *Client) compressAuditLogs(ctx context.Context, logs []types.MCPAuditLog) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(gz)
+	for _, l := range logs {
+		if err := c.decryptMCPAuditLog(ctx, &l); err != nil {
+			// Archive the log as it is stored rather than losing it.
+			log.Warnf("Failed to decrypt MCP audit log %d for archival: %v", l.ID, err)
+		}
+		if err := enc.Encode(types.ConvertMCPAuditLog(l)); err != nil {
+			return nil, err
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 func (c *Client) persistAuditLogs() error {
 	c.auditLock.Lock()
 	if len(c.auditBuffer) == 0 {
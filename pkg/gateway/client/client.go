@@ -39,9 +39,12 @@ type Client struct {
 	auditLogDeleteBatchSize int
 	oktaGroupMigrationMu    sync.Mutex
 	oktaGroupMigrationDone  bool
+	// mcpOAuthTokenExpiryWarning is how long before a stored MCP OAuth token that can't be refreshed expires that it
+	// is reported as expiring soon.
+	mcpOAuthTokenExpiryWarning time.Duration
 }
 
-func New(ctx context.Context, db *db.DB, storageClient kclient.Client, encryptionConfig *encryptionconfig.EncryptionConfiguration, ownerEmails, adminEmails []string, auditLogPersistenceInterval time.Duration, auditLogBatchSize, auditLogRetentionDays int) *Client {
+func New(ctx context.Context, db *db.DB, storageClient kclient.Client, encryptionConfig *encryptionconfig.EncryptionConfiguration, ownerEmails, adminEmails []string, auditLogPersistenceInterval time.Duration, auditLogBatchSize int, mcpOAuthTokenExpiryWarning time.Duration) *Client {
 	explicitRoleEmailsSet := make(map[string]types2.Role, len(ownerEmails)+len(adminEmails))
 	for _, email := range adminEmails {
 		explicitRoleEmailsSet[strings.ToLower(email)] = types2.RoleAdmin
@@ -51,24 +54,25 @@ func New(ctx context.Context, db *db.DB, storageClient kclient.Client, encryptio
 		explicitRoleEmailsSet[strings.ToLower(email)] = types2.RoleOwner
 	}
 	c := &Client{
-		db:                      db,
-		encryptionConfig:        encryptionConfig,
-		emailsWithExplicitRoles: explicitRoleEmailsSet,
-		auditBuffer:             make([]types.MCPAuditLog, 0, 2*auditLogBatchSize),
-		kickAuditPersist:        make(chan struct{}),
-		storageClient:           storageClient,
-		apiKeyCache:             make(map[[32]byte]apiKeyValidationCacheEntry),
-		apiKeyCacheTTL:          apiKeyValidationCacheTTL,
-		serviceAccountCache:     make(map[[32]byte]serviceAccountValidationCacheEntry),
-		serviceAccountCacheTTL:  serviceAccountValidationCacheTTL,
-		auditLogCleanupInterval: defaultAuditLogCleanupInterval,
-		auditLogDeleteBatchSize: defaultAuditLogDeleteBatchSize,
+		db:                         db,
+		encryptionConfig:           encryptionConfig,
+		emailsWithExplicitRoles:    explicitRoleEmailsSet,
+		auditBuffer:                make([]types.MCPAuditLog, 0, 2*auditLogBatchSize),
+		kickAuditPersist:           make(chan struct{}),
+		storageClient:              storageClient,
+		apiKeyCache:                make(map[[32]byte]apiKeyValidationCacheEntry),
+		apiKeyCacheTTL:             apiKeyValidationCacheTTL,
+		serviceAccountCache:        make(map[[32]byte]serviceAccountValidationCacheEntry),
+		serviceAccountCacheTTL:     serviceAccountValidationCacheTTL,
+		auditLogCleanupInterval:    defaultAuditLogCleanupInterval,
+		auditLogDeleteBatchSize:    defaultAuditLogDeleteBatchSize,
+		mcpOAuthTokenExpiryWarning: mcpOAuthTokenExpiryWarning,
 	}
 
 	go c.runPersistenceLoop(ctx, auditLogPersistenceInterval)
 	go c.runPendingStateCleanup(ctx)
 	go c.runAPIKeyCacheCleanup(ctx)
-	go c.runAuditLogCleanup(ctx, auditLogRetentionDays)
+	go c.runMCPOAuthTokenHealthChecks(ctx)
 	return c
 }
 
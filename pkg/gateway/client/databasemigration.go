@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/db"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+// schemaTransitionBackfillBatchSize is the number of rows that are copied to the new schema of an expand/contract
+// migration in each transaction.
+const schemaTransitionBackfillBatchSize = 500
+
+// ErrSchemaTransitionNotBackfilled is returned when an expand/contract migration is contracted before all of its data
+// was copied to the new schema.
+var ErrSchemaTransitionNotBackfilled = db.ErrSchemaTransitionNotBackfilled
+
+// DatabaseMigrationDryRun returns whether pending changes to the database were left unapplied at startup.
+func (c *Client) DatabaseMigrationDryRun() bool {
+	return c.db.DryRun()
+}
+
+// PendingDatabaseChanges returns the changes that the next startup without dry run makes to the database.
+func (c *Client) PendingDatabaseChanges(ctx context.Context) ([]types2.DatabaseChange, error) {
+	return c.db.Plan(ctx)
+}
+
+// DatabaseMigrations returns the startups that changed the database, the most recent first.
+func (c *Client) DatabaseMigrations(ctx context.Context) ([]types.DatabaseMigration, error) {
+	var migrations []types.DatabaseMigration
+	return migrations, c.db.WithContext(ctx).Order("applied_at DESC, id DESC").Find(&migrations).Error
+}
+
+// SchemaTransitions returns the expand/contract migrations of the database.
+func (c *Client) SchemaTransitions(ctx context.Context) ([]types.SchemaTransition, error) {
+	return c.db.SchemaTransitions(ctx)
+}
+
+// SchemaTransitionContracted returns whether the old schema of an expand/contract migration was removed. Until it is,
+// the changed data must be written to both schemas and read from the old one.
+func (c *Client) SchemaTransitionContracted(ctx context.Context, name string) (bool, error) {
+	return c.db.SchemaTransitionContracted(ctx, name)
+}
+
+// ContractSchemaTransition removes the old schema of a backfilled expand/contract migration. It must only be called
+// once no instance of the previous release is running.
+func (c *Client) ContractSchemaTransition(ctx context.Context, name string) error {
+	return c.db.ContractSchemaTransition(ctx, name)
+}
+
+// RunSchemaTransitionBackfills copies the existing data of expand/contract migrations to their new schema every
+// interval, until they are contracted. If autoContract is true, then they are contracted as soon as they are
+// backfilled. Only one replica should run this at a time.
+func (c *Client) RunSchemaTransitionBackfills(ctx context.Context, interval time.Duration, autoContract bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.db.BackfillSchemaTransitions(ctx, schemaTransitionBackfillBatchSize, autoContract); err != nil && !errors.Is(err, context.Canceled) {
+			log.Errorf("Failed to backfill schema transitions: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
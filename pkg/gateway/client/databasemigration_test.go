@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	gatewaydb "github.com/obot-platform/obot/pkg/gateway/db"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	sservices "github.com/obot-platform/obot/pkg/storage/services"
+)
+
+func TestDatabaseMigrationPlan(t *testing.T) {
+	ctx := context.Background()
+	services, err := sservices.New(sservices.Config{
+		DSN: "sqlite://:memory:",
+	})
+	if err != nil {
+		t.Fatalf("failed to create storage services: %v", err)
+	}
+
+	// A new database is migrated even in dry run.
+	dryRunDB, err := gatewaydb.New(services.DB.DB, services.DB.SQLDB, false)
+	if err != nil {
+		t.Fatalf("failed to create gateway db: %v", err)
+	}
+	if err = dryRunDB.AutoMigrate(); err != nil {
+		t.Fatalf("failed to auto-migrate: %v", err)
+	}
+
+	c := &Client{db: dryRunDB}
+	if !c.DatabaseMigrationDryRun() {
+		t.Fatal("expected dry run")
+	}
+
+	pending, err := c.PendingDatabaseChanges(ctx)
+	if err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending changes after migration, got %v", pending)
+	}
+
+	history, err := c.DatabaseMigrations(ctx)
+	if err != nil {
+		t.Fatalf("failed to list migrations: %v", err)
+	}
+	if len(history) != 1 || len(history[0].Changes) == 0 {
+		t.Fatalf("expected the initial migration to be recorded, got %v", history)
+	}
+
+	// Make the database look like it is from an older release. SQLite drops a column by recreating the table, which
+	// also drops the table's indexes.
+	if err = services.DB.DB.Migrator().DropColumn(&types.RotatedOAuthRefreshToken{}, "mcp_id"); err != nil {
+		t.Fatalf("failed to drop column: %v", err)
+	}
+	if err = services.DB.DB.Migrator().DropTable(&types.RevokedOAuthToken{}); err != nil {
+		t.Fatalf("failed to drop table: %v", err)
+	}
+	if err = services.DB.DB.Where("name = ?", "remove_github_groups").Delete(&types.Migration{}).Error; err != nil {
+		t.Fatalf("failed to delete migration: %v", err)
+	}
+
+	expected := []types2.DatabaseChange{
+		{Type: types2.DatabaseChangeTypeCreateTable, Table: "revoked_o_auth_tokens"},
+		{Type: types2.DatabaseChangeTypeAddColumn, Table: "rotated_o_auth_refresh_tokens", Name: "mcp_id"},
+		{Type: types2.DatabaseChangeTypeCreateIndex, Table: "rotated_o_auth_refresh_tokens", Name: "idx_rotated_o_auth_refresh_tokens_grant_id"},
+		{Type: types2.DatabaseChangeTypeCreateIndex, Table: "rotated_o_auth_refresh_tokens", Name: "idx_rotated_o_auth_refresh_tokens_expires_at"},
+		{Type: types2.DatabaseChangeTypeDataMigration, Name: "remove_github_groups"},
+	}
+
+	// Dry run leaves the changes pending.
+	if err = dryRunDB.AutoMigrate(); err != nil {
+		t.Fatalf("failed to auto-migrate in dry run: %v", err)
+	}
+	pending, err = c.PendingDatabaseChanges(ctx)
+	if err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+	assertDatabaseChanges(t, expected, pending)
+
+	db, err := gatewaydb.New(services.DB.DB, services.DB.SQLDB, true)
+	if err != nil {
+		t.Fatalf("failed to create gateway db: %v", err)
+	}
+	if err = db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to auto-migrate: %v", err)
+	}
+
+	pending, err = c.PendingDatabaseChanges(ctx)
+	if err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending changes after migration, got %v", pending)
+	}
+
+	history, err = c.DatabaseMigrations(ctx)
+	if err != nil {
+		t.Fatalf("failed to list migrations: %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected 2 migrations, got %d", len(history))
+	}
+	assertDatabaseChanges(t, expected, history[0].Changes)
+}
+
+func assertDatabaseChanges(t *testing.T, expected, actual []types2.DatabaseChange) {
+	t.Helper()
+	if len(expected) != len(actual) {
+		t.Fatalf("expected changes %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if expected[i] != actual[i] {
+			t.Fatalf("expected changes %v, got %v", expected, actual)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+)
+
+const keyRotationPollInterval = 5 * time.Second
+
+// ErrKeyRotationRunning is returned when a key rotation is requested while another one is pending or running.
+var ErrKeyRotationRunning = errors.New("a key rotation is already running")
+
+// keyRotationResources are the resources that a key rotation re-encrypts, in the order that they are re-encrypted.
+var keyRotationResources = []string{
+	"credentials",
+	"mcpoauthtokens",
+	"mcpoauthpendingstates",
+	"users",
+	"identities",
+	"mcpauditlogs",
+	"messagepolicyviolations",
+	"runstates",
+}
+
+// StartKeyRotation requests a key rotation. The job is run by the leader, see RunKeyRotations.
+func (c *Client) StartKeyRotation(ctx context.Context, startedBy string) (types.KeyRotation, error) {
+	rotation := types.KeyRotation{
+		State:     types2.KeyRotationStatePending,
+		StartedBy: startedBy,
+		StartedAt: time.Now(),
+	}
+	for _, resource := range keyRotationResources {
+		rotation.Resources = append(rotation.Resources, types2.KeyRotationResource{Resource: resource})
+	}
+
+	return rotation, c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(new(types.KeyRotation)).Where("state IN ?", []types2.KeyRotationState{types2.KeyRotationStatePending, types2.KeyRotationStateRunning}).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrKeyRotationRunning
+		}
+		return tx.Create(&rotation).Error
+	})
+}
+
+// LatestKeyRotation returns the most recently requested key rotation.
+func (c *Client) LatestKeyRotation(ctx context.Context) (types.KeyRotation, error) {
+	var rotation types.KeyRotation
+	return rotation, c.db.WithContext(ctx).Order("id DESC").First(&rotation).Error
+}
+
+// RunKeyRotations runs the requested key rotations until the context is canceled. It should only be run by the leader,
+// so that two replicas don't re-encrypt the same records at once. A rotation that is interrupted is left running and
+// is resumed from the start by the next leader, since re-encrypting a record again is harmless.
+func (c *Client) RunKeyRotations(ctx context.Context, recreateCredentials func(context.Context) error) {
+	ticker := time.NewTicker(keyRotationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.runKeyRotation(ctx, recreateCredentials); err != nil && !errors.Is(err, context.Canceled) {
+			log.Errorf("Failed to run key rotation: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) runKeyRotation(ctx context.Context, recreateCredentials func(context.Context) error) error {
+	var rotation types.KeyRotation
+	if err := c.db.WithContext(ctx).Where("state IN ?", []types2.KeyRotationState{types2.KeyRotationStatePending, types2.KeyRotationStateRunning}).Order("id").First(&rotation).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	steps := map[string]func(context.Context, func(ReencryptProgress)) (ReencryptProgress, error){
+		"credentials": func(ctx context.Context, _ func(ReencryptProgress)) (ReencryptProgress, error) {
+			// The credential store re-encrypts every credential as a whole and doesn't report counts.
+			return ReencryptProgress{}, recreateCredentials(ctx)
+		},
+		"mcpoauthtokens":          c.ReencryptMCPOAuthTokens,
+		"mcpoauthpendingstates":   c.ReencryptMCPOAuthPendingStates,
+		"users":                   c.ReencryptUsers,
+		"identities":              c.ReencryptIdentities,
+		"mcpauditlogs":            c.ReencryptMCPAuditLogs,
+		"messagepolicyviolations": c.ReencryptMessagePolicyViolations,
+		"runstates":               c.ReencryptRunStates,
+	}
+
+	log.Infof("Key rotation running: id=%d user=%s", rotation.ID, rotation.StartedBy)
+	rotation.State = types2.KeyRotationStateRunning
+	if err := c.db.WithContext(ctx).Save(&rotation).Error; err != nil {
+		return err
+	}
+
+	var failed bool
+	for i := range rotation.Resources {
+		resource := &rotation.Resources[i]
+		step, ok := steps[resource.Resource]
+		if !ok {
+			resource.Done = true
+			resource.Error = "unknown resource"
+			failed = true
+			continue
+		}
+
+		p, err := step(ctx, func(p ReencryptProgress) {
+			resource.Total, resource.Reencrypted, resource.Failed = p.Total, p.Reencrypted, p.Failed
+			if err := c.db.WithContext(ctx).Save(&rotation).Error; err != nil {
+				log.Warnf("Failed to record key rotation progress: %v", err)
+			}
+		})
+		if ctx.Err() != nil {
+			// Leave the rotation running, so that the next leader resumes it.
+			return ctx.Err()
+		}
+
+		resource.Total, resource.Reencrypted, resource.Failed = p.Total, p.Reencrypted, p.Failed
+		resource.Done = true
+		if err != nil {
+			resource.Error = err.Error()
+			log.Errorf("Failed to re-encrypt %s: %v", resource.Resource, err)
+		} else {
+			log.Infof("Re-encrypted %s: total=%d reencrypted=%d failed=%d", resource.Resource, p.Total, p.Reencrypted, p.Failed)
+		}
+		failed = failed || err != nil || p.Failed > 0
+
+		if err = c.db.WithContext(ctx).Save(&rotation).Error; err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	rotation.CompletedAt = &now
+	if failed {
+		rotation.State = types2.KeyRotationStateFailed
+		rotation.Error = "some records could not be re-encrypted and are still encrypted with a previous key, keep the previous keys configured and run the rotation again"
+		log.Errorf("Key rotation failed, some records could not be re-encrypted: id=%d", rotation.ID)
+	} else {
+		rotation.State = types2.KeyRotationStateSucceeded
+		log.Infof("Key rotation succeeded: id=%d", rotation.ID)
+	}
+
+	return c.db.WithContext(ctx).Save(&rotation).Error
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestKeyRotation(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	c.encryptionConfig = testEncryptionConfig(t, "old")
+	token := types.MCPOAuthToken{MCPID: "ms1abc", UserID: "user", AccessToken: "access"}
+	if err := c.encryptMCPOAuthToken(ctx, &token); err != nil {
+		t.Fatalf("failed to encrypt token: %v", err)
+	}
+	if err := c.db.WithContext(ctx).Create(&token).Error; err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	c.encryptionConfig = testEncryptionConfig(t, "new", "old")
+	if _, err := c.StartKeyRotation(ctx, "admin"); err != nil {
+		t.Fatalf("StartKeyRotation() error = %v", err)
+	}
+	if _, err := c.StartKeyRotation(ctx, "admin"); !errors.Is(err, ErrKeyRotationRunning) {
+		t.Fatalf("StartKeyRotation() error = %v, want %v", err, ErrKeyRotationRunning)
+	}
+
+	var recreated bool
+	if err := c.runKeyRotation(ctx, func(context.Context) error {
+		recreated = true
+		return nil
+	}); err != nil {
+		t.Fatalf("runKeyRotation() error = %v", err)
+	}
+	if !recreated {
+		t.Error("expected the credentials to be recreated")
+	}
+
+	// The status is read back from the database, so every replica reports it.
+	rotation, err := c.LatestKeyRotation(ctx)
+	if err != nil {
+		t.Fatalf("LatestKeyRotation() error = %v", err)
+	}
+	if rotation.State != types2.KeyRotationStateSucceeded || rotation.CompletedAt == nil {
+		t.Fatalf("LatestKeyRotation() = %+v, want succeeded", rotation)
+	}
+	if len(rotation.Resources) != len(keyRotationResources) {
+		t.Fatalf("LatestKeyRotation() resources = %+v, want %d", rotation.Resources, len(keyRotationResources))
+	}
+	for _, resource := range rotation.Resources {
+		if !resource.Done {
+			t.Errorf("resource %s is not done", resource.Resource)
+		}
+		if resource.Resource == "mcpoauthtokens" && resource.Reencrypted != 1 {
+			t.Errorf("resource %s = %+v, want 1 re-encrypted", resource.Resource, resource)
+		}
+	}
+
+	// A new rotation can be started once the previous one completed.
+	if _, err = c.StartKeyRotation(ctx, "admin"); err != nil {
+		t.Fatalf("StartKeyRotation() error = %v", err)
+	}
+}
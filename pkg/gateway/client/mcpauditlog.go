@@ -24,6 +24,13 @@ var (
 	}
 )
 
+const (
+	MCPAuditLogResultSuccess = "success"
+	MCPAuditLogResultError   = "error"
+
+	mcpAuditLogErrorCondition = "(error != '' OR response_status >= 400)"
+)
+
 func (c *Client) insertMCPAuditLogs(ctx context.Context, logs []types.MCPAuditLog) error {
 	if len(logs) == 0 {
 		return nil
@@ -51,11 +58,17 @@ func (c *Client) insertMCPAuditLogs(ctx context.Context, logs []types.MCPAuditLo
 
 	// Use a transaction to ensure atomicity
 	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Tool calls are metered in the same transaction so that they are counted exactly once.
+		meter := make(mcpUsageMeter)
+
 		// Insert request-only and complete logs in batches
 		if len(toInsert) > 0 {
 			if err := tx.CreateInBatches(toInsert, 100).Error; err != nil {
 				return fmt.Errorf("failed to insert audit logs: %w", err)
 			}
+			for _, log := range toInsert {
+				meter.addCall(log)
+			}
 		}
 
 		// Process response-only logs
@@ -109,24 +122,31 @@ func (c *Client) insertMCPAuditLogs(ctx context.Context, logs []types.MCPAuditLo
 				}
 
 				// Calculate processing time as difference between response and request timestamps
-				updates["processing_time_ms"] = responseLog.CreatedAt.Sub(existingLog.CreatedAt).Milliseconds()
+				processingTimeMs := responseLog.CreatedAt.Sub(existingLog.CreatedAt).Milliseconds()
+				updates["processing_time_ms"] = processingTimeMs
 
 				// Update the existing log
 				if err := tx.Model(&existingLog).Updates(updates).Error; err != nil {
 					return fmt.Errorf("failed to update audit log with response data: %w", err)
 				}
+
+				if existingLog.UserID == "" {
+					existingLog.UserID = responseLog.UserID
+				}
+				meter.addResponse(existingLog, processingTimeMs, responseLog.Error, responseLog.ResponseStatus)
 			} else if errors.Is(err, gorm.ErrRecordNotFound) {
 				// No matching request found - insert as new record
 				if err := tx.Create(&responseLog).Error; err != nil {
 					return fmt.Errorf("failed to insert orphaned response audit log: %w", err)
 				}
+				meter.addCall(responseLog)
 			} else {
 				// Database error
 				return fmt.Errorf("failed to query for existing audit log: %w", err)
 			}
 		}
 
-		return nil
+		return meter.record(tx)
 	})
 }
 
@@ -134,8 +154,84 @@ func (c *Client) insertMCPAuditLogs(ctx context.Context, logs []types.MCPAuditLo
 func (c *Client) GetMCPAuditLogs(ctx context.Context, opts MCPAuditLogOptions) ([]types.MCPAuditLog, int64, error) {
 	var logs []types.MCPAuditLog
 
-	db := c.db.WithContext(ctx).Model(&types.MCPAuditLog{})
+	db, err := c.filterMCPAuditLogs(ctx, c.db.WithContext(ctx).Model(&types.MCPAuditLog{}), opts)
+	if err != nil {
+		return nil, 0, err
+	}
 
+	// Get the total before applying the limit
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	if opts.Limit > 0 {
+		db = db.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		db = db.Offset(opts.Offset)
+	}
+
+	// Apply sorting
+	if opts.SortBy != "" {
+		// Validate sort field to prevent SQL injection
+		validSortFields := map[string]bool{
+			"created_at":                    true,
+			"mcp_id":                        true,
+			"mcp_server_display_name":       true,
+			"mcp_server_catalog_entry_name": true,
+			"call_type":                     true,
+			"call_identifier":               true,
+			"processing_time_ms":            true,
+			"client_name":                   true,
+			"client_version":                true,
+			"response_status":               true,
+			"client_ip":                     true,
+		}
+
+		if validSortFields[opts.SortBy] {
+			sortOrder := "DESC" // default to descending
+			if opts.SortOrder == "asc" {
+				sortOrder = "ASC"
+			}
+			db = db.Order(opts.SortBy + " " + sortOrder)
+		} else {
+			// Fallback to default sorting if invalid field
+			db = db.Order("created_at DESC")
+		}
+	} else {
+		// Default sorting by created_at descending
+		db = db.Order("created_at DESC")
+	}
+
+	if err = db.Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Decrypt the logs after fetching
+	for i := range logs {
+		if !opts.WithRequestAndResponse {
+			// These are the only fields that are encrypted right now.
+			// So, just blank them out and skip decryption.
+			logs[i].RequestBody = nil
+			logs[i].MutatedRequestBody = nil
+			logs[i].ResponseBody = nil
+			logs[i].OriginalResponseBody = nil
+			logs[i].RequestHeaders = nil
+			logs[i].ResponseHeaders = nil
+		} else {
+			if err := c.decryptMCPAuditLog(ctx, &logs[i]); err != nil {
+				return nil, 0, fmt.Errorf("failed to decrypt MCP audit log: %w", err)
+			}
+		}
+	}
+
+	return logs, total, nil
+}
+
+// filterMCPAuditLogs applies the text search and filters of the options to the query.
+func (c *Client) filterMCPAuditLogs(ctx context.Context, db *gorm.DB, opts MCPAuditLogOptions) (*gorm.DB, error) {
 	// Apply text search across multiple fields
 	if opts.Query != "" {
 		searchTerm := "%" + opts.Query + "%"
@@ -148,7 +244,7 @@ func (c *Client) GetMCPAuditLogs(ctx context.Context, opts MCPAuditLogOptions) (
 
 		users, err := c.UsersIncludeDeleted(ctx, types.UserQuery{})
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to get users: %w", err)
+			return nil, fmt.Errorf("failed to get users: %w", err)
 		}
 
 		var userIDs []string
@@ -215,6 +311,12 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 	if len(opts.SessionID) > 0 {
 		db = db.Where("session_id IN (?)", opts.SessionID)
 	}
+	if len(opts.RunID) > 0 {
+		db = db.Where("run_id IN (?)", opts.RunID)
+	}
+	if len(opts.ProjectID) > 0 {
+		db = db.Where("project_id IN (?)", opts.ProjectID)
+	}
 	if len(opts.ClientName) > 0 {
 		db = db.Where("client_name IN (?)", opts.ClientName)
 	}
@@ -227,6 +329,9 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 	if len(opts.ClientIP) > 0 {
 		db = db.Where("client_ip IN (?)", opts.ClientIP)
 	}
+	if where := mcpAuditLogResultCondition(opts.Result); where != "" {
+		db = db.Where(where)
+	}
 	if opts.ProcessingTimeMin > 0 {
 		db = db.Where("processing_time_ms >= ?", opts.ProcessingTimeMin)
 	}
@@ -240,76 +345,20 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 		db = db.Where("created_at < ?", opts.EndTime.UTC())
 	}
 
-	// Get the total before applying the limit
-	var total int64
-	if err := db.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply pagination
-	if opts.Limit > 0 {
-		db = db.Limit(opts.Limit)
-	}
-	if opts.Offset > 0 {
-		db = db.Offset(opts.Offset)
-	}
-
-	// Apply sorting
-	if opts.SortBy != "" {
-		// Validate sort field to prevent SQL injection
-		validSortFields := map[string]bool{
-			"created_at":                    true,
-			"mcp_id":                        true,
-			"mcp_server_display_name":       true,
-			"mcp_server_catalog_entry_name": true,
-			"call_type":                     true,
-			"call_identifier":               true,
-			"processing_time_ms":            true,
-			"client_name":                   true,
-			"client_version":                true,
-			"response_status":               true,
-			"client_ip":                     true,
-		}
-
-		if validSortFields[opts.SortBy] {
-			sortOrder := "DESC" // default to descending
-			if opts.SortOrder == "asc" {
-				sortOrder = "ASC"
-			}
-			db = db.Order(opts.SortBy + " " + sortOrder)
-		} else {
-			// Fallback to default sorting if invalid field
-			db = db.Order("created_at DESC")
-		}
-	} else {
-		// Default sorting by created_at descending
-		db = db.Order("created_at DESC")
-	}
+	return db, nil
+}
 
-	err := db.Find(&logs).Error
-	if err != nil {
-		return nil, 0, err
+// mcpAuditLogResultCondition returns the condition that matches audit logs with the given result, or an empty string
+// if the result is not filtered. A call is an error if it recorded an error or responded with an error status.
+func mcpAuditLogResultCondition(result string) string {
+	switch result {
+	case MCPAuditLogResultSuccess:
+		return "NOT " + mcpAuditLogErrorCondition
+	case MCPAuditLogResultError:
+		return mcpAuditLogErrorCondition
+	default:
+		return ""
 	}
-
-	// Decrypt the logs after fetching
-	for i := range logs {
-		if !opts.WithRequestAndResponse {
-			// These are the only fields that are encrypted right now.
-			// So, just blank them out and skip decryption.
-			logs[i].RequestBody = nil
-			logs[i].MutatedRequestBody = nil
-			logs[i].ResponseBody = nil
-			logs[i].OriginalResponseBody = nil
-			logs[i].RequestHeaders = nil
-			logs[i].ResponseHeaders = nil
-		} else {
-			if err := c.decryptMCPAuditLog(ctx, &logs[i]); err != nil {
-				return nil, 0, fmt.Errorf("failed to decrypt MCP audit log: %w", err)
-			}
-		}
-	}
-
-	return logs, total, nil
 }
 
 // GetMCPAuditLog retrieves a single MCP audit log by ID
@@ -362,6 +411,12 @@ func (c *Client) GetAuditLogFilterOptions(ctx context.Context, option string, op
 	if len(opts.SessionID) > 0 {
 		db = db.Where("session_id IN (?)", opts.SessionID)
 	}
+	if len(opts.RunID) > 0 {
+		db = db.Where("run_id IN (?)", opts.RunID)
+	}
+	if len(opts.ProjectID) > 0 {
+		db = db.Where("project_id IN (?)", opts.ProjectID)
+	}
 	if len(opts.ClientName) > 0 {
 		db = db.Where("client_name IN (?)", opts.ClientName)
 	}
@@ -374,6 +429,9 @@ func (c *Client) GetAuditLogFilterOptions(ctx context.Context, option string, op
 	if len(opts.ClientIP) > 0 {
 		db = db.Where("client_ip IN (?)", opts.ClientIP)
 	}
+	if where := mcpAuditLogResultCondition(opts.Result); where != "" {
+		db = db.Where(where)
+	}
 	// Apply scope filtering (union of workspace servers OR own servers)
 	if len(opts.PowerUserWorkspaceID) > 0 || len(opts.OwnServerMCPIDs) > 0 {
 		var (
@@ -554,6 +612,107 @@ func (c *Client) GetMCPUsageStats(ctx context.Context, opts MCPUsageStatsOptions
 	}, nil
 }
 
+// GetMCPToolCallDailyStats counts the tool calls that match the options per server, tool, and day. Days are in UTC.
+func (c *Client) GetMCPToolCallDailyStats(ctx context.Context, opts MCPAuditLogOptions) ([]types.MCPToolCallDailyStat, error) {
+	db, err := c.filterMCPAuditLogs(ctx, c.db.WithContext(ctx).Model(&types.MCPAuditLog{}), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []types.MCPToolCallDailyStat
+	return stats, db.
+		Select(fmt.Sprintf(`%s AS date, mcp_id, MAX(mcp_server_display_name) AS mcp_server_display_name,
+call_identifier AS tool_name, COUNT(*) AS call_count, %s AS error_count`, mcpAuditLogDay(db), mcpAuditLogErrorCount)).
+		Where("call_type = ? AND call_identifier != ''", "tools/call").
+		Group("date, mcp_id, call_identifier").
+		Order("date, mcp_id, tool_name").
+		Scan(&stats).Error
+}
+
+// GetMCPErrorRates returns the number of calls and errors of the audit logs that match the options per server.
+func (c *Client) GetMCPErrorRates(ctx context.Context, opts MCPAuditLogOptions) ([]types.MCPErrorRateStat, error) {
+	db, err := c.filterMCPAuditLogs(ctx, c.db.WithContext(ctx).Model(&types.MCPAuditLog{}), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []types.MCPErrorRateStat
+	return stats, db.
+		Select(fmt.Sprintf(`mcp_id, MAX(mcp_server_display_name) AS mcp_server_display_name,
+COUNT(*) AS call_count, %s AS error_count`, mcpAuditLogErrorCount)).
+		Group("mcp_id").
+		Order("mcp_id").
+		Scan(&stats).Error
+}
+
+// GetMCPLatencies returns the number of calls and their average processing time for each MCP server
+func (c *Client) GetMCPLatencies(ctx context.Context, opts MCPAuditLogOptions) ([]types.MCPLatencyStat, error) {
+	db, err := c.filterMCPAuditLogs(ctx, c.db.WithContext(ctx).Model(&types.MCPAuditLog{}), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []types.MCPLatencyStat
+	return stats, db.
+		Select(`mcp_id, MAX(mcp_server_display_name) AS mcp_server_display_name,
+COUNT(*) AS call_count, AVG(processing_time_ms) AS average_processing_time_ms`).
+		Group("mcp_id").
+		Order("mcp_id").
+		Scan(&stats).Error
+}
+
+// GetMCPUserCallStats returns the number of calls of the audit logs that match the options, and the number of MCP
+// servers they were sent to, per user.
+func (c *Client) GetMCPUserCallStats(ctx context.Context, opts MCPAuditLogOptions) ([]types.MCPUserCallStat, error) {
+	db, err := c.filterMCPAuditLogs(ctx, c.db.WithContext(ctx).Model(&types.MCPAuditLog{}), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []types.MCPUserCallStat
+	return stats, db.
+		Select("user_id, COUNT(*) AS call_count, COUNT(DISTINCT mcp_id) AS server_count").
+		Where("user_id != ''").
+		Group("user_id").
+		Order("user_id").
+		Scan(&stats).Error
+}
+
+// GetMCPUserHourlyCallStats counts the calls of the audit logs that match the options per user and hour. Hours are in
+// UTC.
+func (c *Client) GetMCPUserHourlyCallStats(ctx context.Context, opts MCPAuditLogOptions) ([]types.MCPUserHourlyCallStat, error) {
+	db, err := c.filterMCPAuditLogs(ctx, c.db.WithContext(ctx).Model(&types.MCPAuditLog{}), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []types.MCPUserHourlyCallStat
+	return stats, db.
+		Select(fmt.Sprintf("%s AS hour, user_id, COUNT(*) AS call_count", mcpAuditLogHour(db))).
+		Where("user_id != ''").
+		Group("hour, user_id").
+		Order("hour, user_id").
+		Scan(&stats).Error
+}
+
+const mcpAuditLogErrorCount = "SUM(CASE WHEN " + mcpAuditLogErrorCondition + " THEN 1 ELSE 0 END)"
+
+// mcpAuditLogDay returns the expression that formats the creation time of an audit log as a UTC date.
+func mcpAuditLogDay(db *gorm.DB) string {
+	if db.Name() == "postgres" {
+		return "to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')"
+	}
+	return "strftime('%Y-%m-%d', created_at)"
+}
+
+// mcpAuditLogHour returns the expression that formats the creation time of an audit log as a UTC date and hour.
+func mcpAuditLogHour(db *gorm.DB) string {
+	if db.Name() == "postgres" {
+		return "to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD HH24')"
+	}
+	return "strftime('%Y-%m-%d %H', created_at)"
+}
+
 // MCPAuditLogOptions represents options for querying MCP audit logs
 type MCPAuditLogOptions struct {
 	WithRequestAndResponse    bool
@@ -566,10 +725,13 @@ type MCPAuditLogOptions struct {
 	CallType                  []string
 	CallIdentifier            []string
 	SessionID                 []string
+	RunID                     []string
+	ProjectID                 []string
 	ClientName                []string
 	ClientVersion             []string
 	ResponseStatus            []string
 	ClientIP                  []string
+	Result                    string // Filter by the result of the call: "success" or "error"
 	ProcessingTimeMin         int64
 	ProcessingTimeMax         int64
 	Query                     string // Search term for text search across multiple fields
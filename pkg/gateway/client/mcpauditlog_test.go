@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestMCPAuditLogAggregations(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	day1 := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	logs := []types.MCPAuditLog{
+		{CreatedAt: day1, MCPID: "ms1a", MCPServerDisplayName: "A", CallType: "tools/call", CallIdentifier: "search", ResponseStatus: 200, ProcessingTimeMs: 100},
+		{CreatedAt: day1, MCPID: "ms1a", MCPServerDisplayName: "A", CallType: "tools/call", CallIdentifier: "search", ResponseStatus: 200, Error: "boom", ProcessingTimeMs: 300},
+		{CreatedAt: day1, MCPID: "ms1a", MCPServerDisplayName: "A", CallType: "tools/call", CallIdentifier: "fetch", ResponseStatus: 500},
+		{CreatedAt: day2, MCPID: "ms1a", MCPServerDisplayName: "A", CallType: "tools/call", CallIdentifier: "search", ResponseStatus: 200},
+		{CreatedAt: day2, MCPID: "ms1b", MCPServerDisplayName: "B", CallType: "tools/list", ResponseStatus: 200},
+	}
+	if err := c.db.WithContext(ctx).Create(&logs).Error; err != nil {
+		t.Fatalf("failed to insert audit logs: %v", err)
+	}
+
+	opts := MCPAuditLogOptions{StartTime: day1.Add(-time.Hour), EndTime: day2.Add(time.Hour)}
+
+	daily, err := c.GetMCPToolCallDailyStats(ctx, opts)
+	if err != nil {
+		t.Fatalf("GetMCPToolCallDailyStats() error = %v", err)
+	}
+	want := []types.MCPToolCallDailyStat{
+		{Date: "2026-03-01", MCPID: "ms1a", MCPServerDisplayName: "A", ToolName: "fetch", CallCount: 1, ErrorCount: 1},
+		{Date: "2026-03-01", MCPID: "ms1a", MCPServerDisplayName: "A", ToolName: "search", CallCount: 2, ErrorCount: 1},
+		{Date: "2026-03-02", MCPID: "ms1a", MCPServerDisplayName: "A", ToolName: "search", CallCount: 1},
+	}
+	if len(daily) != len(want) {
+		t.Fatalf("GetMCPToolCallDailyStats() = %+v, want %+v", daily, want)
+	}
+	for i := range want {
+		if daily[i] != want[i] {
+			t.Errorf("GetMCPToolCallDailyStats()[%d] = %+v, want %+v", i, daily[i], want[i])
+		}
+	}
+
+	rates, err := c.GetMCPErrorRates(ctx, opts)
+	if err != nil {
+		t.Fatalf("GetMCPErrorRates() error = %v", err)
+	}
+	wantRates := []types.MCPErrorRateStat{
+		{MCPID: "ms1a", MCPServerDisplayName: "A", CallCount: 4, ErrorCount: 2},
+		{MCPID: "ms1b", MCPServerDisplayName: "B", CallCount: 1},
+	}
+	if len(rates) != len(wantRates) {
+		t.Fatalf("GetMCPErrorRates() = %+v, want %+v", rates, wantRates)
+	}
+	for i := range wantRates {
+		if rates[i] != wantRates[i] {
+			t.Errorf("GetMCPErrorRates()[%d] = %+v, want %+v", i, rates[i], wantRates[i])
+		}
+	}
+
+	latencies, err := c.GetMCPLatencies(ctx, opts)
+	if err != nil {
+		t.Fatalf("GetMCPLatencies() error = %v", err)
+	}
+	wantLatencies := []types.MCPLatencyStat{
+		{MCPID: "ms1a", MCPServerDisplayName: "A", CallCount: 4, AverageProcessingTimeMs: 100},
+		{MCPID: "ms1b", MCPServerDisplayName: "B", CallCount: 1},
+	}
+	if len(latencies) != len(wantLatencies) {
+		t.Fatalf("GetMCPLatencies() = %+v, want %+v", latencies, wantLatencies)
+	}
+	for i := range wantLatencies {
+		if latencies[i] != wantLatencies[i] {
+			t.Errorf("GetMCPLatencies()[%d] = %+v, want %+v", i, latencies[i], wantLatencies[i])
+		}
+	}
+
+	opts.Result = MCPAuditLogResultError
+	errorLogs, total, err := c.GetMCPAuditLogs(ctx, opts)
+	if err != nil {
+		t.Fatalf("GetMCPAuditLogs() error = %v", err)
+	}
+	if total != 2 || len(errorLogs) != 2 {
+		t.Errorf("GetMCPAuditLogs() with result=error returned %d logs, want 2", total)
+	}
+}
+
+func TestMCPUserCallAggregations(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	start := time.Date(2026, 3, 1, 22, 15, 0, 0, time.UTC)
+	logs := []types.MCPAuditLog{
+		{CreatedAt: start, UserID: "u1", MCPID: "ms1a", CallType: "tools/call"},
+		{CreatedAt: start.Add(10 * time.Minute), UserID: "u1", MCPID: "ms1b", CallType: "tools/call"},
+		{CreatedAt: start.Add(time.Hour), UserID: "u1", MCPID: "ms1a", CallType: "tools/call"},
+		{CreatedAt: start.Add(time.Hour), UserID: "u2", MCPID: "ms1a", CallType: "tools/call"},
+		{CreatedAt: start.Add(time.Hour), MCPID: "ms1a", CallType: "tools/call"},
+	}
+	if err := c.db.WithContext(ctx).Create(&logs).Error; err != nil {
+		t.Fatalf("failed to insert audit logs: %v", err)
+	}
+
+	opts := MCPAuditLogOptions{StartTime: start.Add(-time.Minute), EndTime: start.Add(2 * time.Hour)}
+
+	stats, err := c.GetMCPUserCallStats(ctx, opts)
+	if err != nil {
+		t.Fatalf("GetMCPUserCallStats() error = %v", err)
+	}
+	want := []types.MCPUserCallStat{
+		{UserID: "u1", CallCount: 3, ServerCount: 2},
+		{UserID: "u2", CallCount: 1, ServerCount: 1},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("GetMCPUserCallStats() = %+v, want %+v", stats, want)
+	}
+	for i := range want {
+		if stats[i] != want[i] {
+			t.Errorf("GetMCPUserCallStats()[%d] = %+v, want %+v", i, stats[i], want[i])
+		}
+	}
+
+	hourly, err := c.GetMCPUserHourlyCallStats(ctx, opts)
+	if err != nil {
+		t.Fatalf("GetMCPUserHourlyCallStats() error = %v", err)
+	}
+	wantHourly := []types.MCPUserHourlyCallStat{
+		{Hour: "2026-03-01 22", UserID: "u1", CallCount: 2},
+		{Hour: "2026-03-01 23", UserID: "u1", CallCount: 1},
+		{Hour: "2026-03-01 23", UserID: "u2", CallCount: 1},
+	}
+	if len(hourly) != len(wantHourly) {
+		t.Fatalf("GetMCPUserHourlyCallStats() = %+v, want %+v", hourly, wantHourly)
+	}
+	for i := range wantHourly {
+		if hourly[i] != wantHourly[i] {
+			t.Errorf("GetMCPUserHourlyCallStats()[%d] = %+v, want %+v", i, hourly[i], wantHourly[i])
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxMCPOAuthErrorLength is the length that error messages are truncated to before they are recorded.
+const maxMCPOAuthErrorLength = 1024
+
+// RecordMCPOAuthEvent adds an outcome of the OAuth flow between a user and an MCP server to the activity of its day.
+func (c *Client) RecordMCPOAuthEvent(ctx context.Context, event types.MCPOAuthEvent) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	activity := types.MCPOAuthActivity{
+		Day:    event.Time.UTC().Truncate(24 * time.Hour),
+		UserID: event.UserID,
+		MCPID:  event.MCPID,
+	}
+	var column string
+	switch event.Type {
+	case types.MCPOAuthEventInitiated:
+		activity.Initiated, column = 1, "initiated"
+	case types.MCPOAuthEventCompleted:
+		activity.Completed, column = 1, "completed"
+	case types.MCPOAuthEventFailed:
+		activity.Failed, column = 1, "failed"
+	case types.MCPOAuthEventRefreshed:
+		activity.Refreshed, column = 1, "refreshed"
+	case types.MCPOAuthEventRefreshFailed:
+		activity.RefreshFailed, column = 1, "refresh_failed"
+	case types.MCPOAuthEventRejected:
+		activity.Rejected, column = 1, "rejected"
+	default:
+		return fmt.Errorf("unknown MCP OAuth event type %q", event.Type)
+	}
+
+	updates := map[string]any{
+		column: gorm.Expr("mcpo_auth_activities."+column+" + ?", 1),
+	}
+	if event.ExchangeDuration > 0 {
+		activity.Exchanges, activity.TotalExchangeMs = 1, event.ExchangeDuration.Milliseconds()
+		updates["exchanges"] = gorm.Expr("mcpo_auth_activities.exchanges + ?", 1)
+		updates["total_exchange_ms"] = gorm.Expr("mcpo_auth_activities.total_exchange_ms + ?", activity.TotalExchangeMs)
+	}
+
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "day"}, {Name: "user_id"}, {Name: "mcp_id"}},
+			DoUpdates: clause.Assignments(updates),
+		}).Create(&activity).Error; err != nil {
+			return fmt.Errorf("failed to record MCP OAuth activity: %w", err)
+		}
+
+		if event.FailureCategory == "" {
+			return nil
+		}
+
+		errMsg := event.Error
+		if len(errMsg) > maxMCPOAuthErrorLength {
+			errMsg = errMsg[:maxMCPOAuthErrorLength]
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "day"}, {Name: "user_id"}, {Name: "mcp_id"}, {Name: "category"}, {Name: "code"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"count":         gorm.Expr("mcpo_auth_failures.count + ?", 1),
+				"last_error":    errMsg,
+				"last_error_at": event.Time,
+			}),
+		}).Create(&types.MCPOAuthFailure{
+			Day:         activity.Day,
+			UserID:      event.UserID,
+			MCPID:       event.MCPID,
+			Category:    string(event.FailureCategory),
+			Code:        event.FailureCode,
+			Count:       1,
+			LastError:   errMsg,
+			LastErrorAt: event.Time,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to record MCP OAuth failure: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetMCPOAuthTelemetry returns the OAuth activity of each MCP server in the range, ordered by the number of failures,
+// and the failures of each server, ordered by category and code.
+func (c *Client) GetMCPOAuthTelemetry(ctx context.Context, opts MCPUsageOptions) ([]types.MCPOAuthActivity, map[string][]types.MCPOAuthFailure, error) {
+	filter := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("day >= ? AND day < ?", opts.Start.UTC(), opts.End.UTC())
+		if opts.UserID != "" {
+			db = db.Where("user_id = ?", opts.UserID)
+		}
+		if opts.MCPID != "" {
+			db = db.Where("mcp_id = ?", opts.MCPID)
+		}
+		return db
+	}
+
+	var activities []types.MCPOAuthActivity
+	if err := c.db.WithContext(ctx).Model(&types.MCPOAuthActivity{}).Scopes(filter).
+		Select(`mcp_id, SUM(initiated) AS initiated, SUM(completed) AS completed, SUM(failed) AS failed,
+SUM(refreshed) AS refreshed, SUM(refresh_failed) AS refresh_failed, SUM(rejected) AS rejected,
+SUM(exchanges) AS exchanges, SUM(total_exchange_ms) AS total_exchange_ms`).
+		Group("mcp_id").
+		Order("SUM(failed) + SUM(refresh_failed) DESC, mcp_id").
+		Scan(&activities).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var rows []types.MCPOAuthFailure
+	if err := c.db.WithContext(ctx).Scopes(filter).
+		Order("mcp_id, category, code, last_error_at").
+		Find(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+
+	// Merge the failures of each day and user, keeping the last error.
+	failures := make(map[string][]types.MCPOAuthFailure, len(activities))
+	for _, row := range rows {
+		mcpFailures := failures[row.MCPID]
+		if n := len(mcpFailures); n > 0 && mcpFailures[n-1].Category == row.Category && mcpFailures[n-1].Code == row.Code {
+			last := &mcpFailures[n-1]
+			last.Count += row.Count
+			last.LastError, last.LastErrorAt = row.LastError, row.LastErrorAt
+			continue
+		}
+
+		row.Day, row.UserID = time.Time{}, ""
+		failures[row.MCPID] = append(mcpFailures, row)
+	}
+
+	return activities, failures, nil
+}
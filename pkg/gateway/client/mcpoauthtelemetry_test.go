@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestGetMCPOAuthTelemetry(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	events := []types.MCPOAuthEvent{
+		{Time: now, Type: types.MCPOAuthEventInitiated, UserID: "1", MCPID: "ms1"},
+		{Time: now, Type: types.MCPOAuthEventCompleted, UserID: "1", MCPID: "ms1", ExchangeDuration: 100 * time.Millisecond},
+		{Time: now, Type: types.MCPOAuthEventInitiated, UserID: "2", MCPID: "ms1"},
+		{Time: now, Type: types.MCPOAuthEventFailed, UserID: "2", MCPID: "ms1", FailureCategory: types2.MCPOAuthFailureCategoryTokenExchange, FailureCode: "invalid_client", Error: "first", ExchangeDuration: 300 * time.Millisecond},
+		{Time: now.Add(time.Second), Type: types.MCPOAuthEventFailed, UserID: "1", MCPID: "ms1", FailureCategory: types2.MCPOAuthFailureCategoryTokenExchange, FailureCode: "invalid_client", Error: "second"},
+		{Time: now, Type: types.MCPOAuthEventRefreshed, UserID: "1", MCPID: "ms2"},
+	}
+	for _, event := range events {
+		if err := c.RecordMCPOAuthEvent(ctx, event); err != nil {
+			t.Fatalf("failed to record event: %v", err)
+		}
+	}
+
+	start := now.Truncate(24 * time.Hour)
+	activities, failures, err := c.GetMCPOAuthTelemetry(ctx, MCPUsageOptions{Start: start, End: start.AddDate(0, 0, 1)})
+	if err != nil {
+		t.Fatalf("failed to get telemetry: %v", err)
+	}
+	if len(activities) != 2 || activities[0].MCPID != "ms1" || activities[1].MCPID != "ms2" {
+		t.Fatalf("expected activity for ms1 then ms2, got %+v", activities)
+	}
+
+	a := activities[0]
+	if a.Initiated != 2 || a.Completed != 1 || a.Failed != 2 || a.Exchanges != 2 || a.TotalExchangeMs != 400 {
+		t.Errorf("unexpected activity: %+v", a)
+	}
+	if activities[1].Refreshed != 1 {
+		t.Errorf("expected 1 refresh, got %+v", activities[1])
+	}
+
+	if len(failures["ms1"]) != 1 {
+		t.Fatalf("expected 1 failure category for ms1, got %+v", failures["ms1"])
+	}
+	f := failures["ms1"][0]
+	if f.Category != string(types2.MCPOAuthFailureCategoryTokenExchange) || f.Code != "invalid_client" || f.Count != 2 || f.LastError != "second" {
+		t.Errorf("unexpected failure: %+v", f)
+	}
+
+	activities, _, err = c.GetMCPOAuthTelemetry(ctx, MCPUsageOptions{UserID: "2", Start: start, End: start.AddDate(0, 0, 1)})
+	if err != nil {
+		t.Fatalf("failed to get telemetry for user: %v", err)
+	}
+	if len(activities) != 1 || activities[0].Initiated != 1 || activities[0].Failed != 1 {
+		t.Errorf("unexpected activity for user: %+v", activities)
+	}
+}
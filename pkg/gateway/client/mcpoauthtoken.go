@@ -74,6 +74,9 @@ func (c *Client) ReplaceMCPOAuthToken(ctx context.Context, userID, mcpID, url, o
 		RedirectURL:        oauthConf.RedirectURL,
 		Scopes:             strings.Join(oauthConf.Scopes, " "),
 	}
+	if scope, ok := token.Extra("scope").(string); ok {
+		t.GrantedScopes = scope
+	}
 
 	if err := c.encryptMCPOAuthToken(ctx, t); err != nil {
 		return fmt.Errorf("failed to encrypt token: %w", err)
@@ -82,6 +85,23 @@ func (c *Client) ReplaceMCPOAuthToken(ctx context.Context, userID, mcpID, url, o
 	return c.db.WithContext(ctx).Save(t).Error
 }
 
+// CountMCPOAuthTokensMissingScopes returns the number of users whose token for the MCP server wasn't granted all the
+// scopes.
+func (c *Client) CountMCPOAuthTokensMissingScopes(ctx context.Context, mcpID string, scopes []string) (int, error) {
+	var tokens []types.MCPOAuthToken
+	if err := c.db.WithContext(ctx).Select("user_id", "scopes", "granted_scopes").Where("mcp_id = ?", mcpID).Find(&tokens).Error; err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, token := range tokens {
+		if len(token.MissingScopes(scopes)) > 0 {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func (c *Client) DeleteMCPOAuthTokenForURL(ctx context.Context, userID, mcpID, mcpURL string) error {
 	if err := c.db.WithContext(ctx).Delete(&types.MCPOAuthToken{}, "user_id = ? AND mcp_id = ? AND (url = ? OR url = ?)", userID, mcpID, mcpURL, "").Error; !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
@@ -149,6 +169,17 @@ func (c *Client) DeleteMCPOAuthPendingState(ctx context.Context, hashedState str
 	return c.db.WithContext(ctx).Delete(&types.MCPOAuthPendingState{}, "hashed_state = ?", hashedState).Error
 }
 
+// CountMCPOAuthPendingStates returns the number of OAuth authorizations that the user started for the MCP server and
+// hasn't finished.
+func (c *Client) CountMCPOAuthPendingStates(ctx context.Context, userID, mcpID string) (int64, error) {
+	var count int64
+	return count, c.db.WithContext(ctx).Model(&types.MCPOAuthPendingState{}).Where("user_id = ? AND mcp_id = ?", userID, mcpID).Count(&count).Error
+}
+
+func (c *Client) DeleteMCPOAuthPendingStates(ctx context.Context, userID, mcpID string) error {
+	return c.db.WithContext(ctx).Delete(&types.MCPOAuthPendingState{}, "user_id = ? AND mcp_id = ?", userID, mcpID).Error
+}
+
 const pendingStateTTL = 30 * time.Minute
 
 func (c *Client) CleanupExpiredMCPOAuthPendingStates(ctx context.Context, olderThan time.Duration) error {
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestDeleteMCPOAuthPendingStates(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	conf := &oauth2.Config{ClientID: "client"}
+	for _, ps := range []struct{ userID, mcpID, state string }{
+		{"1", "msi1", "a"},
+		{"1", "msi1", "b"},
+		{"1", "msi2", "c"},
+		{"2", "msi1", "d"},
+	} {
+		if err := c.CreateMCPOAuthPendingState(ctx, ps.userID, ps.mcpID, "https://example.com/mcp", "", ps.state, "verifier", conf); err != nil {
+			t.Fatalf("failed to create pending state: %v", err)
+		}
+	}
+
+	count, err := c.CountMCPOAuthPendingStates(ctx, "1", "msi1")
+	if err != nil {
+		t.Fatalf("failed to count pending states: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 pending states, got %d", count)
+	}
+
+	if err = c.DeleteMCPOAuthPendingStates(ctx, "1", "msi1"); err != nil {
+		t.Fatalf("failed to delete pending states: %v", err)
+	}
+
+	if _, err = c.GetMCPOAuthPendingState(ctx, "a"); err == nil {
+		t.Errorf("expected the pending state of the user and server to be deleted")
+	}
+	for _, state := range []string{"c", "d"} {
+		if _, err = c.GetMCPOAuthPendingState(ctx, state); err != nil {
+			t.Errorf("expected pending state %s of another user or server to be kept: %v", state, err)
+		}
+	}
+}
+
+func TestCountMCPOAuthTokensMissingScopes(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	conf := &oauth2.Config{Scopes: []string{"repo", "gist"}}
+	tokens := map[string]*oauth2.Token{
+		// The authorization server reported granting fewer scopes than were requested.
+		"1": (&oauth2.Token{AccessToken: "a"}).WithExtra(map[string]any{"scope": "repo"}),
+		// GitHub separates the granted scopes with commas.
+		"2": (&oauth2.Token{AccessToken: "b"}).WithExtra(map[string]any{"scope": "repo,gist,read:org"}),
+		// The requested scopes are assumed to be granted if the authorization server doesn't report them.
+		"3": {AccessToken: "c"},
+	}
+	for userID, token := range tokens {
+		if err := c.ReplaceMCPOAuthToken(ctx, userID, "oauth-app-github", "", "", conf, token); err != nil {
+			t.Fatalf("failed to store token: %v", err)
+		}
+	}
+
+	for _, tt := range []struct {
+		scopes []string
+		want   int
+	}{
+		{scopes: []string{"repo"}, want: 0},
+		{scopes: []string{"gist"}, want: 1},
+		{scopes: []string{"read:org"}, want: 2},
+		{scopes: []string{"admin:org"}, want: 3},
+	} {
+		got, err := c.CountMCPOAuthTokensMissingScopes(ctx, "oauth-app-github", tt.scopes)
+		if err != nil {
+			t.Fatalf("failed to count tokens: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("tokens missing %v = %d, want %d", tt.scopes, got, tt.want)
+		}
+	}
+}
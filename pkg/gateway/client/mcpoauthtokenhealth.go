@@ -0,0 +1,316 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+const (
+	mcpOAuthTokenHealthCheckInterval = time.Hour
+	mcpOAuthTokenHealthCheckBatch    = 100
+	mcpOAuthTokenProbeTimeout        = 10 * time.Second
+)
+
+// errMCPOAuthProbeUnsupported is returned when the authorization server of a token has no endpoint to check it with.
+var errMCPOAuthProbeUnsupported = errors.New("authorization server doesn't support checking tokens")
+
+// authorizationServerMetadata is the part of the OAuth or OpenID Connect discovery document of an authorization server
+// that is used to check tokens.
+type authorizationServerMetadata struct {
+	IntrospectionEndpoint string `json:"introspection_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// CheckMCPOAuthTokens asks the authorization server of each stored MCP OAuth token whether it is still valid, using the
+// lightest check that the server supports, and records the answer. Tokens whose servers don't support any check, or
+// that can't be reached, keep the result of their last check.
+func (c *Client) CheckMCPOAuthTokens(ctx context.Context) error {
+	var (
+		httpClient = &http.Client{Timeout: mcpOAuthTokenProbeTimeout}
+		metadata   = make(map[string]authorizationServerMetadata)
+		last       *types.MCPOAuthToken
+	)
+	for {
+		query := c.db.WithContext(ctx).Order("mcp_id, user_id").Limit(mcpOAuthTokenHealthCheckBatch)
+		if last != nil {
+			query = query.Where("mcp_id > ? OR (mcp_id = ? AND user_id > ?)", last.MCPID, last.MCPID, last.UserID)
+		}
+
+		var batch []types.MCPOAuthToken
+		if err := query.Find(&batch).Error; err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		last = &batch[len(batch)-1]
+
+		for _, token := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := c.checkMCPOAuthToken(ctx, httpClient, metadata, token); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// checkMCPOAuthToken checks a stored token and records the result, unless the token was replaced in the meantime.
+func (c *Client) checkMCPOAuthToken(ctx context.Context, httpClient *http.Client, metadata map[string]authorizationServerMetadata, token types.MCPOAuthToken) error {
+	storedAccessToken := token.AccessToken
+	if err := c.decryptMCPOAuthToken(ctx, &token); err != nil {
+		log.Errorf("Failed to decrypt MCP OAuth token of user %s for %s: %v", token.UserID, token.MCPID, err)
+		return nil
+	}
+
+	healthErr, err := probeMCPOAuthToken(ctx, httpClient, metadata, token)
+	if errors.Is(err, errMCPOAuthProbeUnsupported) {
+		return nil
+	} else if err != nil {
+		log.Debugf("Failed to check MCP OAuth token of user %s for %s: %v", token.UserID, token.MCPID, err)
+		return nil
+	}
+
+	if err = c.db.WithContext(ctx).Model(&types.MCPOAuthToken{}).
+		Where("mcp_id = ? AND user_id = ? AND access_token = ?", token.MCPID, token.UserID, storedAccessToken).
+		Updates(map[string]any{
+			"health_checked_at": time.Now(),
+			"health_error":      healthErr,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to record MCP OAuth token health: %w", err)
+	}
+	return nil
+}
+
+// GetMCPOAuthTokenHealth returns the health of the OAuth tokens that a user has stored for MCP servers.
+func (c *Client) GetMCPOAuthTokenHealth(ctx context.Context, userID string) ([]types2.MCPOAuthTokenHealth, error) {
+	var tokens []types.MCPOAuthToken
+	if err := c.db.WithContext(ctx).Where("user_id = ?", userID).Order("mcp_id").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make([]types2.MCPOAuthTokenHealth, 0, len(tokens))
+	for _, token := range tokens {
+		if err := c.decryptMCPOAuthToken(ctx, &token); err != nil {
+			return nil, fmt.Errorf("failed to decrypt token: %w", err)
+		}
+		result = append(result, mcpOAuthTokenHealth(token, now, c.mcpOAuthTokenExpiryWarning))
+	}
+
+	return result, nil
+}
+
+// GetMCPOAuthTokenHealthForMCPID returns the health of the OAuth token that a user has stored for an MCP server, or nil
+// if there is none.
+func (c *Client) GetMCPOAuthTokenHealthForMCPID(ctx context.Context, userID, mcpID string) (*types2.MCPOAuthTokenHealth, error) {
+	var tokens []types.MCPOAuthToken
+	if err := c.db.WithContext(ctx).Where("user_id = ? AND mcp_id = ?", userID, mcpID).Limit(1).Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	if err := c.decryptMCPOAuthToken(ctx, &tokens[0]); err != nil {
+		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+	}
+
+	health := mcpOAuthTokenHealth(tokens[0], time.Now(), c.mcpOAuthTokenExpiryWarning)
+	return &health, nil
+}
+
+func (c *Client) runMCPOAuthTokenHealthChecks(ctx context.Context) {
+	timer := time.NewTimer(mcpOAuthTokenHealthCheckInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if err := c.CheckMCPOAuthTokens(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Failed to check MCP OAuth tokens: %v", err)
+		}
+
+		timer.Reset(mcpOAuthTokenHealthCheckInterval)
+	}
+}
+
+// mcpOAuthTokenHealth returns the health of a decrypted token at a time. Tokens that can't be refreshed are expiring
+// soon within the warning period before their expiry.
+func mcpOAuthTokenHealth(token types.MCPOAuthToken, now time.Time, expiryWarning time.Duration) types2.MCPOAuthTokenHealth {
+	health := types2.MCPOAuthTokenHealth{
+		MCPID:       token.MCPID,
+		URL:         token.URL,
+		Status:      types2.MCPOAuthTokenStatusHealthy,
+		Refreshable: token.RefreshToken != "",
+	}
+	if !token.Expiry.IsZero() {
+		health.Expiry = types2.NewTime(token.Expiry)
+	}
+	if !token.HealthCheckedAt.IsZero() {
+		health.CheckedAt = types2.NewTime(token.HealthCheckedAt)
+	}
+
+	switch {
+	case token.HealthError != "":
+		health.Status = types2.MCPOAuthTokenStatusReauthenticationRequired
+		health.Warning = "The authorization server reported that the token is no longer valid: " + token.HealthError
+	case health.Refreshable || token.Expiry.IsZero():
+	case !token.Expiry.After(now):
+		health.Status = types2.MCPOAuthTokenStatusReauthenticationRequired
+		health.Warning = "The token expired and can't be refreshed."
+	case token.Expiry.Before(now.Add(expiryWarning)):
+		health.Status = types2.MCPOAuthTokenStatusExpiringSoon
+		health.Warning = fmt.Sprintf("The token can't be refreshed and expires at %s.", token.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	return health
+}
+
+// probeMCPOAuthToken asks the authorization server of a decrypted token whether it is still valid. It returns why the
+// token isn't valid, or an empty string if it is. The refresh token is introspected if there is one, because it
+// outlives the access token. Otherwise, an unexpired access token is introspected or used to get the user's info.
+func probeMCPOAuthToken(ctx context.Context, httpClient *http.Client, metadata map[string]authorizationServerMetadata, token types.MCPOAuthToken) (string, error) {
+	issuer := token.TokenURL
+	if issuer == "" {
+		issuer = token.AuthURL
+	}
+	u, err := url.Parse(issuer)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", errMCPOAuthProbeUnsupported
+	}
+	origin := u.Scheme + "://" + u.Host
+
+	md, ok := metadata[origin]
+	if !ok {
+		md = discoverAuthorizationServer(ctx, httpClient, origin)
+		metadata[origin] = md
+	}
+
+	accessTokenValid := token.AccessToken != "" && (token.Expiry.IsZero() || token.Expiry.After(time.Now()))
+	switch {
+	case md.IntrospectionEndpoint != "" && token.RefreshToken != "":
+		return introspectToken(ctx, httpClient, md.IntrospectionEndpoint, token, token.RefreshToken, "refresh_token")
+	case md.IntrospectionEndpoint != "" && accessTokenValid:
+		return introspectToken(ctx, httpClient, md.IntrospectionEndpoint, token, token.AccessToken, "access_token")
+	case md.UserinfoEndpoint != "" && accessTokenValid:
+		return getUserinfo(ctx, httpClient, md.UserinfoEndpoint, token.AccessToken)
+	default:
+		return "", errMCPOAuthProbeUnsupported
+	}
+}
+
+// discoverAuthorizationServer returns the endpoints that the authorization server at an origin advertises for
+// checking tokens, or none if it doesn't publish a discovery document.
+func discoverAuthorizationServer(ctx context.Context, httpClient *http.Client, origin string) authorizationServerMetadata {
+	var md authorizationServerMetadata
+	for _, path := range []string{"/.well-known/oauth-authorization-server", "/.well-known/openid-configuration"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+path, nil)
+		if err != nil {
+			return md
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			continue
+		}
+
+		var doc authorizationServerMetadata
+		err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&doc)
+		resp.Body.Close()
+		if err != nil || resp.StatusCode != http.StatusOK {
+			continue
+		}
+
+		if md.IntrospectionEndpoint == "" {
+			md.IntrospectionEndpoint = doc.IntrospectionEndpoint
+		}
+		if md.UserinfoEndpoint == "" {
+			md.UserinfoEndpoint = doc.UserinfoEndpoint
+		}
+	}
+	return md
+}
+
+// introspectToken asks an RFC 7662 introspection endpoint whether a token is active, authenticating as the token's
+// client.
+func introspectToken(ctx context.Context, httpClient *http.Client, endpoint string, token types.MCPOAuthToken, value, hint string) (string, error) {
+	form := url.Values{
+		"token":           {value},
+		"token_type_hint": {hint},
+	}
+	if token.ClientSecret == "" {
+		form.Set("client_id", token.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if token.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(token.ClientID), url.QueryEscape(token.ClientSecret))
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("introspection endpoint returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Active bool `json:"active"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode introspection response: %w", err)
+	}
+	if !result.Active {
+		return fmt.Sprintf("the %s is not active", strings.ReplaceAll(hint, "_", " ")), nil
+	}
+	return "", nil
+}
+
+// getUserinfo uses an access token to get the user's info from an OpenID Connect userinfo endpoint.
+func getUserinfo(ctx context.Context, httpClient *http.Client, endpoint, accessToken string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return "the access token was rejected", nil
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return "", nil
+	default:
+		return "", fmt.Errorf("userinfo endpoint returned status %d", resp.StatusCode)
+	}
+}
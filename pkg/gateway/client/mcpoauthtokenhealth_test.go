@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"golang.org/x/oauth2"
+)
+
+func TestCheckMCPOAuthTokens(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	mux.HandleFunc("GET /.well-known/oauth-authorization-server", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"introspection_endpoint": srv.URL + "/introspect"})
+	})
+	mux.HandleFunc("POST /introspect", func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, ok := r.BasicAuth(); !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]bool{"active": r.PostFormValue("token") == "good"})
+	})
+
+	conf := &oauth2.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Endpoint:     oauth2.Endpoint{TokenURL: srv.URL + "/token"},
+	}
+	tokens := map[string]*oauth2.Token{
+		"valid":    {AccessToken: "access", RefreshToken: "good", Expiry: time.Now().Add(-time.Hour)},
+		"revoked":  {AccessToken: "access", RefreshToken: "revoked", Expiry: time.Now().Add(time.Hour)},
+		"expiring": {AccessToken: "good", Expiry: time.Now().Add(time.Hour)},
+		"expired":  {AccessToken: "good", Expiry: time.Now().Add(-time.Hour)},
+	}
+	for mcpID, token := range tokens {
+		if err := c.ReplaceMCPOAuthToken(ctx, "1", mcpID, "", "", conf, token); err != nil {
+			t.Fatalf("failed to store token: %v", err)
+		}
+	}
+
+	if err := c.CheckMCPOAuthTokens(ctx); err != nil {
+		t.Fatalf("failed to check tokens: %v", err)
+	}
+
+	health, err := c.GetMCPOAuthTokenHealth(ctx, "1")
+	if err != nil {
+		t.Fatalf("failed to get token health: %v", err)
+	}
+
+	expected := map[string]types2.MCPOAuthTokenStatus{
+		"valid":    types2.MCPOAuthTokenStatusHealthy,
+		"revoked":  types2.MCPOAuthTokenStatusReauthenticationRequired,
+		"expiring": types2.MCPOAuthTokenStatusExpiringSoon,
+		"expired":  types2.MCPOAuthTokenStatusReauthenticationRequired,
+	}
+	if len(health) != len(expected) {
+		t.Fatalf("expected %d tokens, got %+v", len(expected), health)
+	}
+	for _, h := range health {
+		if h.Status != expected[h.MCPID] {
+			t.Errorf("expected %s to be %s, got %+v", h.MCPID, expected[h.MCPID], h)
+		}
+		// The expired token can't be checked, because it can't be refreshed.
+		if checked := h.CheckedAt != nil; checked == (h.MCPID == "expired") {
+			t.Errorf("unexpected check time for %s: %+v", h.MCPID, h)
+		}
+	}
+
+	// Replacing a token clears the result of its last check.
+	if err = c.ReplaceMCPOAuthToken(ctx, "1", "revoked", "", "", conf, tokens["valid"]); err != nil {
+		t.Fatalf("failed to replace token: %v", err)
+	}
+	health, err = c.GetMCPOAuthTokenHealth(ctx, "1")
+	if err != nil {
+		t.Fatalf("failed to get token health: %v", err)
+	}
+	for _, h := range health {
+		if h.MCPID == "revoked" && (h.Status != types2.MCPOAuthTokenStatusHealthy || h.CheckedAt != nil) {
+			t.Errorf("expected the replaced token to be unchecked and healthy, got %+v", h)
+		}
+	}
+}
+
+func TestGetMCPOAuthTokenHealthForMCPID(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	conf := &oauth2.Config{ClientID: "client"}
+	if err := c.ReplaceMCPOAuthToken(ctx, "1", "expiring", "", "", conf, &oauth2.Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatalf("failed to store token: %v", err)
+	}
+
+	health, err := c.GetMCPOAuthTokenHealthForMCPID(ctx, "1", "expiring")
+	if err != nil {
+		t.Fatalf("failed to get token health: %v", err)
+	}
+	if health == nil || health.Status != types2.MCPOAuthTokenStatusExpiringSoon || health.Expiry == nil {
+		t.Errorf("expected the token to be expiring soon, got %+v", health)
+	}
+
+	// Without a warning period, tokens are only reported once they expired.
+	c.mcpOAuthTokenExpiryWarning = 0
+	if health, err = c.GetMCPOAuthTokenHealthForMCPID(ctx, "1", "expiring"); err != nil {
+		t.Fatalf("failed to get token health: %v", err)
+	} else if health.Status != types2.MCPOAuthTokenStatusHealthy {
+		t.Errorf("expected the token to be healthy without a warning period, got %+v", health)
+	}
+
+	if health, err = c.GetMCPOAuthTokenHealthForMCPID(ctx, "2", "expiring"); err != nil {
+		t.Fatalf("failed to get token health: %v", err)
+	} else if health != nil {
+		t.Errorf("expected no token for another user, got %+v", health)
+	}
+}
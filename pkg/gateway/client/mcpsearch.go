@@ -0,0 +1,281 @@
+package client
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// bm25K1 and bm25B are the usual parameters of the BM25 ranking function: how quickly repeated terms stop adding to
+	// the relevance, and how much long documents are penalized.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// prefixMatchBoost and typoMatchBoost scale the relevance of terms that start with a search term, or that are a
+	// typo away from it, compared to exact matches.
+	prefixMatchBoost = 0.75
+	typoMatchBoost   = 0.5
+)
+
+// MCPSearchField is text of an MCP catalog entry or server to index, such as its name or description. Terms in fields
+// with a higher weight make the object more relevant to searches for them.
+type MCPSearchField struct {
+	Text   string
+	Weight float64
+}
+
+// IndexMCPSearchDocument replaces the indexed text of an MCP catalog entry or server. It does nothing if the text
+// hasn't changed since it was last indexed.
+func (c *Client) IndexMCPSearchDocument(ctx context.Context, kind, name string, fields ...MCPSearchField) error {
+	h := sha256.New()
+	weights := make(map[string]float64)
+	var length int
+	for _, f := range fields {
+		_, _ = fmt.Fprintf(h, "%g\x00%s\x00", f.Weight, f.Text)
+		for _, term := range searchTerms(f.Text) {
+			weights[term] += f.Weight
+			length++
+		}
+	}
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []types.MCPSearchDocument
+		if err := tx.Where("kind = ? AND name = ?", kind, name).Limit(1).Find(&existing).Error; err != nil {
+			return err
+		}
+		if len(existing) > 0 && existing[0].Hash == hash {
+			return nil
+		}
+
+		if err := tx.Where("kind = ? AND name = ?", kind, name).Delete(&types.MCPSearchTerm{}).Error; err != nil {
+			return err
+		}
+
+		terms := make([]types.MCPSearchTerm, 0, len(weights))
+		for term, weight := range weights {
+			terms = append(terms, types.MCPSearchTerm{Kind: kind, Name: name, Term: term, Weight: weight})
+		}
+		if len(terms) > 0 {
+			if err := tx.CreateInBatches(&terms, 500).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&types.MCPSearchDocument{
+			Kind:   kind,
+			Name:   name,
+			Hash:   hash,
+			Length: length,
+		}).Error
+	})
+}
+
+// DeleteMCPSearchDocument removes an MCP catalog entry or server from the search index.
+func (c *Client) DeleteMCPSearchDocument(ctx context.Context, kind, name string) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("kind = ? AND name = ?", kind, name).Delete(&types.MCPSearchTerm{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("kind = ? AND name = ?", kind, name).Delete(&types.MCPSearchDocument{}).Error
+	})
+}
+
+// SearchMCP returns the indexed MCP catalog entries or servers that match every term of the query, most relevant
+// first. Terms match indexed terms that are equal to them, that start with them, or that are a typo away from them.
+func (c *Client) SearchMCP(ctx context.Context, kind, query string) ([]types.MCPSearchResult, error) {
+	queryTerms := searchTerms(query)
+	slices.Sort(queryTerms)
+	queryTerms = slices.Compact(queryTerms)
+	if len(queryTerms) == 0 {
+		return nil, nil
+	}
+
+	db := c.db.WithContext(ctx)
+
+	var vocabulary []string
+	if err := db.Model(&types.MCPSearchTerm{}).Where("kind = ?", kind).Distinct().Pluck("term", &vocabulary).Error; err != nil {
+		return nil, err
+	}
+
+	var (
+		matches    = make([]map[string]float64, len(queryTerms))
+		candidates []string
+	)
+	for i, q := range queryTerms {
+		matches[i] = searchTermMatches(q, vocabulary)
+		if len(matches[i]) == 0 {
+			// Every term of the query has to match.
+			return nil, nil
+		}
+		for term := range matches[i] {
+			candidates = append(candidates, term)
+		}
+	}
+
+	var postings []types.MCPSearchTerm
+	if err := db.Where("kind = ? AND term IN ?", kind, candidates).Find(&postings).Error; err != nil {
+		return nil, err
+	}
+
+	var documents []types.MCPSearchDocument
+	if err := db.Where("kind = ?", kind).Find(&documents).Error; err != nil {
+		return nil, err
+	}
+
+	return rankSearchResults(matches, postings, documents), nil
+}
+
+// rankSearchResults scores the documents that have a match for every query term with BM25.
+func rankSearchResults(matches []map[string]float64, postings []types.MCPSearchTerm, documents []types.MCPSearchDocument) []types.MCPSearchResult {
+	lengths := make(map[string]int, len(documents))
+	var totalLength int
+	for _, d := range documents {
+		lengths[d.Name] = d.Length
+		totalLength += d.Length
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+	averageLength := max(float64(totalLength)/float64(len(documents)), 1)
+
+	documentFrequency := make(map[string]int)
+	tf := make(map[string]map[string]float64)
+	for _, p := range postings {
+		documentFrequency[p.Term]++
+		if tf[p.Name] == nil {
+			tf[p.Name] = make(map[string]float64)
+		}
+		tf[p.Name][p.Term] = p.Weight
+	}
+
+	var results []types.MCPSearchResult
+	for name, weights := range tf {
+		length, ok := lengths[name]
+		if !ok {
+			continue
+		}
+		norm := bm25K1 * (1 - bm25B + bm25B*float64(length)/averageLength)
+
+		var score float64
+		for _, m := range matches {
+			var best float64
+			for term, boost := range m {
+				weight, ok := weights[term]
+				if !ok {
+					continue
+				}
+				df := float64(documentFrequency[term])
+				idf := math.Log(1 + (float64(len(documents))-df+0.5)/(df+0.5))
+				best = max(best, boost*idf*weight*(bm25K1+1)/(weight+norm))
+			}
+			if best == 0 {
+				score = 0
+				break
+			}
+			score += best
+		}
+
+		if score > 0 {
+			results = append(results, types.MCPSearchResult{Name: name, Score: score})
+		}
+	}
+
+	slices.SortFunc(results, func(a, b types.MCPSearchResult) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return results
+}
+
+// searchTerms splits text into lowercase words.
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// searchTermMatches returns the indexed terms that a search term matches, and how relevant each match is.
+func searchTermMatches(query string, vocabulary []string) map[string]float64 {
+	maxEdits := 0
+	switch n := len([]rune(query)); {
+	case n >= 8:
+		maxEdits = 2
+	case n >= 4:
+		maxEdits = 1
+	}
+
+	matches := make(map[string]float64)
+	for _, term := range vocabulary {
+		switch {
+		case term == query:
+			matches[term] = 1
+		case len(query) >= 2 && strings.HasPrefix(term, query):
+			matches[term] = prefixMatchBoost
+		case maxEdits > 0:
+			if edits := editDistance(query, term, maxEdits); edits <= maxEdits {
+				matches[term] = typoMatchBoost / float64(edits)
+			}
+		}
+	}
+	return matches
+}
+
+// editDistance returns the number of insertions, deletions, substitutions, and transpositions of adjacent letters that
+// turn a into b, or maxEdits+1 if it is more than maxEdits.
+func editDistance(a, b string, maxEdits int) int {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > maxEdits {
+		return maxEdits + 1
+	}
+
+	// Only the last three rows of the distance matrix are needed, the one before the previous for transpositions.
+	prevPrev := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prevPrev[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > maxEdits {
+			return maxEdits + 1
+		}
+		prevPrev, prev, cur = prev, cur, prevPrev
+	}
+
+	return min(prev[len(rb)], maxEdits+1)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestSearchMCP(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	index := func(name, title, description string) {
+		t.Helper()
+		if err := c.IndexMCPSearchDocument(ctx, types.MCPSearchKindCatalogEntry, name,
+			MCPSearchField{Text: title, Weight: 4},
+			MCPSearchField{Text: description, Weight: 1},
+		); err != nil {
+			t.Fatalf("failed to index %s: %v", name, err)
+		}
+	}
+	index("github", "GitHub", "Manage repositories, issues and pull requests")
+	index("gitlab", "GitLab", "Manage GitLab projects and merge requests")
+	index("slack", "Slack", "Send messages to channels")
+	index("jira", "Jira", "Track issues for GitHub projects")
+
+	search := func(query string) []string {
+		t.Helper()
+		results, err := c.SearchMCP(ctx, types.MCPSearchKindCatalogEntry, query)
+		if err != nil {
+			t.Fatalf("failed to search for %q: %v", query, err)
+		}
+		var names []string
+		for _, r := range results {
+			names = append(names, r.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		// A match in the name ranks above a match in the description.
+		{query: "github", want: []string{"github", "jira"}},
+		// Every term of the query has to match.
+		{query: "github issues", want: []string{"github", "jira"}},
+		{query: "github merge", want: nil},
+		// Prefixes and typos match.
+		{query: "git", want: []string{"gitlab", "github", "jira"}},
+		{query: "slakc", want: []string{"slack"}},
+		{query: "mesages", want: []string{"slack"}},
+		{query: "", want: nil},
+	}
+	for _, tt := range tests {
+		if got := search(tt.query); !slices.Equal(got, tt.want) {
+			t.Errorf("search for %q = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	// Other kinds of objects are searched separately.
+	results, err := c.SearchMCP(ctx, types.MCPSearchKindServer, "github")
+	if err != nil || len(results) != 0 {
+		t.Errorf("expected no servers, got %v, %v", results, err)
+	}
+
+	// Indexing again replaces the indexed text.
+	index("slack", "Slack", "Chat with your team")
+	if got := search("messages"); len(got) != 0 {
+		t.Errorf("expected the old text of slack to be removed, got %v", got)
+	}
+	if got := search("chat"); !slices.Equal(got, []string{"slack"}) {
+		t.Errorf("search for chat = %v, want [slack]", got)
+	}
+
+	if err := c.DeleteMCPSearchDocument(ctx, types.MCPSearchKindCatalogEntry, "github"); err != nil {
+		t.Fatalf("failed to delete github: %v", err)
+	}
+	if got := search("github"); !slices.Equal(got, []string{"jira"}) {
+		t.Errorf("search for github after deleting it = %v, want [jira]", got)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "slack", b: "slack", want: 0},
+		{a: "slakc", b: "slack", want: 1},
+		{a: "slck", b: "slack", want: 1},
+		{a: "slacks", b: "slack", want: 1},
+		{a: "sluck", b: "slack", want: 1},
+		{a: "kcals", b: "slack", want: 3},
+		{a: "s", b: "slack", want: 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, 2); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
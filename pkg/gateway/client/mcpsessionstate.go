@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+)
+
+// GetMCPSessionState returns the persisted state of the MCP session for the client, or nil if there is none.
+func (c *Client) GetMCPSessionState(ctx context.Context, clientID string) (*types.MCPSessionState, error) {
+	var state types.MCPSessionState
+	if err := c.db.WithContext(ctx).Where("client_id = ?", clientID).First(&state).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (c *Client) SaveMCPSessionState(ctx context.Context, state *types.MCPSessionState) error {
+	return c.db.WithContext(ctx).Save(state).Error
+}
+
+func (c *Client) DeleteMCPSessionState(ctx context.Context, clientID string) error {
+	if err := c.db.WithContext(ctx).Delete(&types.MCPSessionState{}, "client_id = ?", clientID).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
+
+// DeleteMCPSessionStatesForServer removes the persisted state of every session with the MCP server.
+func (c *Client) DeleteMCPSessionStatesForServer(ctx context.Context, mcpServerName string) error {
+	if err := c.db.WithContext(ctx).Delete(&types.MCPSessionState{}, "mcp_server_name = ?", mcpServerName).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestMCPSessionState(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	state, err := c.GetMCPSessionState(ctx, "missing")
+	if err != nil {
+		t.Fatalf("unexpected error getting missing session state: %v", err)
+	}
+	if state != nil {
+		t.Fatalf("expected no session state, got %v", state)
+	}
+
+	for _, s := range []types.MCPSessionState{
+		{ClientID: "a", MCPServerName: "server1", State: []byte(`{"id":"1"}`)},
+		{ClientID: "b", MCPServerName: "server1", State: []byte(`{"id":"2"}`)},
+		{ClientID: "c", MCPServerName: "server2", State: []byte(`{"id":"3"}`)},
+		// Saving again replaces the state of the session.
+		{ClientID: "a", MCPServerName: "server1", State: []byte(`{"id":"4"}`)},
+	} {
+		if err := c.SaveMCPSessionState(ctx, &s); err != nil {
+			t.Fatalf("failed to save session state: %v", err)
+		}
+	}
+
+	state, err = c.GetMCPSessionState(ctx, "a")
+	if err != nil {
+		t.Fatalf("failed to get session state: %v", err)
+	}
+	if string(state.State) != `{"id":"4"}` {
+		t.Fatalf("expected replaced session state, got %s", state.State)
+	}
+
+	if err := c.DeleteMCPSessionState(ctx, "c"); err != nil {
+		t.Fatalf("failed to delete session state: %v", err)
+	}
+	if err := c.DeleteMCPSessionState(ctx, "c"); err != nil {
+		t.Fatalf("deleting a missing session state should not fail: %v", err)
+	}
+	if err := c.DeleteMCPSessionStatesForServer(ctx, "server1"); err != nil {
+		t.Fatalf("failed to delete session states for server: %v", err)
+	}
+
+	for _, id := range []string{"a", "b", "c"} {
+		if state, err = c.GetMCPSessionState(ctx, id); err != nil {
+			t.Fatalf("failed to get session state: %v", err)
+		} else if state != nil {
+			t.Fatalf("expected session state %s to be deleted", id)
+		}
+	}
+}
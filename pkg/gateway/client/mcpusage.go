@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// toolCallType is the call type of the audit log entries that are metered.
+	toolCallType = "tools/call"
+
+	// mcpSamplingRunPrefix is the prefix of the run name recorded with the token usage of MCP sampling requests.
+	mcpSamplingRunPrefix = "mcp-sampling-"
+)
+
+type mcpUsageKey struct {
+	day    time.Time
+	userID string
+	mcpID  string
+}
+
+// mcpUsageMeter sums the tool calls in a batch of audit logs so that they can be added to the usage with one upsert
+// per user, server, and day.
+type mcpUsageMeter map[mcpUsageKey]*types.MCPToolUsage
+
+func (m mcpUsageMeter) usage(log types.MCPAuditLog) *types.MCPToolUsage {
+	key := mcpUsageKey{
+		day:    log.CreatedAt.UTC().Truncate(24 * time.Hour),
+		userID: log.UserID,
+		mcpID:  log.MCPID,
+	}
+
+	u, ok := m[key]
+	if !ok {
+		u = &types.MCPToolUsage{
+			Day:                  key.day,
+			UserID:               key.userID,
+			MCPID:                key.mcpID,
+			MCPServerDisplayName: log.MCPServerDisplayName,
+		}
+		m[key] = u
+	}
+	return u
+}
+
+// addCall counts a tool call, including its duration and whether it failed if its response was received.
+func (m mcpUsageMeter) addCall(log types.MCPAuditLog) {
+	if log.CallType != toolCallType {
+		return
+	}
+
+	u := m.usage(log)
+	u.ToolCalls++
+	u.EstimatedCostUSD += log.EstimatedCostUSD
+	u.EstimatedCredits += log.EstimatedCredits
+	if log.ResponseReceived {
+		m.addResult(u, log.ProcessingTimeMs, log.Error, log.ResponseStatus)
+	}
+}
+
+// addResponse adds the duration and result of a tool call that was counted when its request was logged.
+func (m mcpUsageMeter) addResponse(request types.MCPAuditLog, processingTimeMs int64, errMsg string, status int) {
+	if request.CallType != toolCallType {
+		return
+	}
+	m.addResult(m.usage(request), processingTimeMs, errMsg, status)
+}
+
+func (m mcpUsageMeter) addResult(u *types.MCPToolUsage, processingTimeMs int64, errMsg string, status int) {
+	u.TotalDurationMs += processingTimeMs
+	if errMsg != "" || status >= 400 {
+		u.ErrorCount++
+	}
+}
+
+func (m mcpUsageMeter) record(tx *gorm.DB) error {
+	for _, u := range m {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "day"}, {Name: "user_id"}, {Name: "mcp_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"tool_calls":         gorm.Expr("mcp_tool_usages.tool_calls + ?", u.ToolCalls),
+				"error_count":        gorm.Expr("mcp_tool_usages.error_count + ?", u.ErrorCount),
+				"total_duration_ms":  gorm.Expr("mcp_tool_usages.total_duration_ms + ?", u.TotalDurationMs),
+				"estimated_cost_usd": gorm.Expr("mcp_tool_usages.estimated_cost_usd + ?", u.EstimatedCostUSD),
+				"estimated_credits":  gorm.Expr("mcp_tool_usages.estimated_credits + ?", u.EstimatedCredits),
+			}),
+		}).Create(u).Error; err != nil {
+			return fmt.Errorf("failed to record MCP usage: %w", err)
+		}
+	}
+	return nil
+}
+
+// MCPUsageOptions filters the MCP usage.
+type MCPUsageOptions struct {
+	UserID string
+	MCPID  string
+	// Start and End are the range of days, in UTC, with Start inclusive and End exclusive.
+	Start time.Time
+	End   time.Time
+}
+
+// GetMCPUsage returns the tool calls made to each MCP server by each user in the range, ordered by user and server.
+func (c *Client) GetMCPUsage(ctx context.Context, opts MCPUsageOptions) ([]types.MCPToolUsage, error) {
+	db := c.db.WithContext(ctx).Model(&types.MCPToolUsage{}).Where("day >= ? AND day < ?", opts.Start.UTC(), opts.End.UTC())
+	if opts.UserID != "" {
+		db = db.Where("user_id = ?", opts.UserID)
+	}
+	if opts.MCPID != "" {
+		db = db.Where("mcp_id = ?", opts.MCPID)
+	}
+
+	var usage []types.MCPToolUsage
+	return usage, db.
+		Select(`user_id, mcp_id, MAX(mcp_server_display_name) AS mcp_server_display_name,
+SUM(tool_calls) AS tool_calls, SUM(error_count) AS error_count, SUM(total_duration_ms) AS total_duration_ms,
+SUM(estimated_cost_usd) AS estimated_cost_usd, SUM(estimated_credits) AS estimated_credits`).
+		Group("user_id, mcp_id").
+		Order("user_id, mcp_id").
+		Scan(&usage).Error
+}
+
+// GetMCPProjectUsage returns the estimated cost of the tool calls made by the agent runs of each project in the range,
+// from the audit logs, ordered by project.
+func (c *Client) GetMCPProjectUsage(ctx context.Context, opts MCPUsageOptions) ([]types.MCPProjectUsage, error) {
+	db := c.db.WithContext(ctx).Model(&types.MCPAuditLog{}).
+		Where("created_at >= ? AND created_at < ?", opts.Start.UTC(), opts.End.UTC()).
+		Where("call_type = ? AND project_id <> ''", toolCallType)
+	if opts.UserID != "" {
+		db = db.Where("user_id = ?", opts.UserID)
+	}
+	if opts.MCPID != "" {
+		db = db.Where("mcp_id = ?", opts.MCPID)
+	}
+
+	var usage []types.MCPProjectUsage
+	return usage, db.
+		Select("project_id, COUNT(*) AS tool_calls, SUM(estimated_cost_usd) AS estimated_cost_usd, SUM(estimated_credits) AS estimated_credits").
+		Group("project_id").
+		Order("project_id").
+		Scan(&usage).Error
+}
+
+// GetMCPSamplingTokenUsage returns the tokens used by the sampling requests of each MCP server on behalf of each user in the range.
+func (c *Client) GetMCPSamplingTokenUsage(ctx context.Context, opts MCPUsageOptions) ([]types.MCPSamplingTokenUsage, error) {
+	db := c.db.WithContext(ctx).Model(&types.RunTokenActivity{}).
+		Where("created_at >= ? AND created_at < ?", opts.Start.UTC(), opts.End.UTC()).
+		Where("name LIKE ?", mcpSamplingRunPrefix+"%")
+	if opts.UserID != "" {
+		db = db.Where("user_id = ?", opts.UserID)
+	}
+	if opts.MCPID != "" {
+		db = db.Where("name = ?", mcpSamplingRunPrefix+opts.MCPID)
+	}
+
+	var rows []struct {
+		UserID           string
+		Name             string
+		PromptTokens     int
+		CompletionTokens int
+	}
+	if err := db.Select("user_id, name, SUM(prompt_tokens) AS prompt_tokens, SUM(completion_tokens) AS completion_tokens").
+		Group("user_id, name").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	usage := make([]types.MCPSamplingTokenUsage, 0, len(rows))
+	for _, r := range rows {
+		usage = append(usage, types.MCPSamplingTokenUsage{
+			UserID:           r.UserID,
+			MCPID:            strings.TrimPrefix(r.Name, mcpSamplingRunPrefix),
+			PromptTokens:     r.PromptTokens,
+			CompletionTokens: r.CompletionTokens,
+		})
+	}
+	return usage, nil
+}
+
+// RemainingMCPToolCallsForUser returns the number of tool calls that the user made this month and their monthly limit.
+// The user's own limit takes precedence over the default limit. Admins and limits of zero or less are unlimited.
+func (c *Client) RemainingMCPToolCallsForUser(ctx context.Context, userID string, defaultLimit int, now time.Time) (types.RemainingMCPToolCalls, error) {
+	user, err := c.UserByID(ctx, userID)
+	if err != nil {
+		return types.RemainingMCPToolCalls{}, err
+	}
+
+	limit := defaultLimit
+	if user.MonthlyMCPToolCallLimit != nil && *user.MonthlyMCPToolCallLimit != 0 {
+		limit = *user.MonthlyMCPToolCallLimit
+	}
+
+	start := MonthStart(now)
+	var toolCalls int64
+	if err = c.db.WithContext(ctx).Model(&types.MCPToolUsage{}).
+		Where("user_id = ? AND day >= ?", userID, start).
+		Select("COALESCE(SUM(tool_calls), 0)").
+		Scan(&toolCalls).Error; err != nil {
+		return types.RemainingMCPToolCalls{}, err
+	}
+
+	return types.RemainingMCPToolCalls{
+		Limit:     int64(limit),
+		ToolCalls: toolCalls,
+		Unlimited: limit <= 0 || user.Role.HasRole(types2.RoleAdmin),
+	}, nil
+}
+
+// MonthStart returns the start of the month of t, in UTC.
+func MonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
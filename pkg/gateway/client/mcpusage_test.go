@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestInsertMCPAuditLogsRecordsUsage(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	logs := []types.MCPAuditLog{
+		// A complete tool call that failed.
+		{CreatedAt: now, UserID: "1", MCPID: "ms1", MCPServerDisplayName: "Server", CallType: toolCallType, RequestBody: json.RawMessage(`{}`), ResponseReceived: true, ProcessingTimeMs: 100, Error: "boom"},
+		// A tool call whose response arrives in the next batch.
+		{CreatedAt: now, UserID: "1", MCPID: "ms1", MCPServerDisplayName: "Server", CallType: toolCallType, RequestID: "2", SessionID: "s", RequestBody: json.RawMessage(`{}`)},
+		// Other calls are not metered.
+		{CreatedAt: now, UserID: "1", MCPID: "ms1", CallType: "tools/list", RequestBody: json.RawMessage(`{}`)},
+	}
+	if err := c.insertMCPAuditLogs(ctx, logs); err != nil {
+		t.Fatalf("failed to insert audit logs: %v", err)
+	}
+
+	response := types.MCPAuditLog{CreatedAt: now.Add(250 * time.Millisecond), UserID: "1", RequestID: "2", SessionID: "s", ResponseReceived: true, ResponseStatus: 200}
+	if err := c.insertMCPAuditLogs(ctx, []types.MCPAuditLog{response}); err != nil {
+		t.Fatalf("failed to insert response audit log: %v", err)
+	}
+
+	usage, err := c.GetMCPUsage(ctx, MCPUsageOptions{Start: MonthStart(now), End: MonthStart(now).AddDate(0, 1, 0)})
+	if err != nil {
+		t.Fatalf("failed to get usage: %v", err)
+	}
+	if len(usage) != 1 {
+		t.Fatalf("expected usage for 1 user and server, got %+v", usage)
+	}
+
+	u := usage[0]
+	if u.UserID != "1" || u.MCPID != "ms1" || u.MCPServerDisplayName != "Server" {
+		t.Errorf("unexpected usage key: %+v", u)
+	}
+	if u.ToolCalls != 2 || u.ErrorCount != 1 || u.TotalDurationMs != 350 {
+		t.Errorf("expected 2 tool calls, 1 error, and 350ms, got %d, %d, and %dms", u.ToolCalls, u.ErrorCount, u.TotalDurationMs)
+	}
+}
+
+func TestMCPUsageEstimatedCosts(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	if err := c.insertMCPAuditLogs(ctx, []types.MCPAuditLog{
+		{CreatedAt: now, UserID: "1", MCPID: "ms1", CallType: toolCallType, ProjectID: "p1", EstimatedCostUSD: 0.25, EstimatedCredits: 2},
+		{CreatedAt: now, UserID: "1", MCPID: "ms1", CallType: toolCallType, ProjectID: "p1", EstimatedCostUSD: 0.5, EstimatedCredits: 1},
+		// Calls made outside of agent runs have no project.
+		{CreatedAt: now, UserID: "1", MCPID: "ms1", CallType: toolCallType, EstimatedCostUSD: 1},
+	}); err != nil {
+		t.Fatalf("failed to insert audit logs: %v", err)
+	}
+
+	opts := MCPUsageOptions{Start: MonthStart(now), End: MonthStart(now).AddDate(0, 1, 0)}
+	usage, err := c.GetMCPUsage(ctx, opts)
+	if err != nil {
+		t.Fatalf("failed to get usage: %v", err)
+	}
+	if len(usage) != 1 || usage[0].EstimatedCostUSD != 1.75 || usage[0].EstimatedCredits != 3 {
+		t.Errorf("expected an estimated cost of $1.75 and 3 credits, got %+v", usage)
+	}
+
+	projects, err := c.GetMCPProjectUsage(ctx, opts)
+	if err != nil {
+		t.Fatalf("failed to get project usage: %v", err)
+	}
+	if len(projects) != 1 || projects[0].ProjectID != "p1" || projects[0].ToolCalls != 2 || projects[0].EstimatedCostUSD != 0.75 || projects[0].EstimatedCredits != 3 {
+		t.Errorf("expected 2 tool calls costing $0.75 and 3 credits for project p1, got %+v", projects)
+	}
+}
+
+func TestRemainingMCPToolCallsForUser(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	limit := 3
+	user := types.User{Username: "user", HashedUsername: "user", Role: types2.RoleBasic, MonthlyMCPToolCallLimit: &limit}
+	if err := c.db.WithContext(ctx).Create(&user).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	userID := "1"
+
+	now := time.Now().UTC()
+	if err := c.insertMCPAuditLogs(ctx, []types.MCPAuditLog{
+		{CreatedAt: now, UserID: userID, MCPID: "ms1", CallType: toolCallType},
+		{CreatedAt: now, UserID: userID, MCPID: "ms2", CallType: toolCallType},
+		// Calls from last month don't count.
+		{CreatedAt: MonthStart(now).Add(-time.Hour), UserID: userID, MCPID: "ms1", CallType: toolCallType},
+	}); err != nil {
+		t.Fatalf("failed to insert audit logs: %v", err)
+	}
+
+	remaining, err := c.RemainingMCPToolCallsForUser(ctx, userID, 100, now)
+	if err != nil {
+		t.Fatalf("failed to get remaining tool calls: %v", err)
+	}
+	if remaining.Unlimited || remaining.Limit != 3 || remaining.ToolCalls != 2 {
+		t.Errorf("expected 2 of 3 tool calls used, got %+v", remaining)
+	}
+
+	user.MonthlyMCPToolCallLimit = nil
+	if err = c.db.WithContext(ctx).Save(&user).Error; err != nil {
+		t.Fatalf("failed to update user: %v", err)
+	}
+
+	remaining, err = c.RemainingMCPToolCallsForUser(ctx, userID, 0, now)
+	if err != nil {
+		t.Fatalf("failed to get remaining tool calls: %v", err)
+	}
+	if !remaining.Unlimited {
+		t.Errorf("expected tool calls to be unlimited without a limit, got %+v", remaining)
+	}
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrMemoryStoresUnsupported is returned when memory stores are used with a database other than PostgreSQL.
+var ErrMemoryStoresUnsupported = errors.New("memory MCP servers are only supported with PostgreSQL")
+
+// EnsureMemoryStore creates the schema that a memory MCP server stores its memories in, if it doesn't exist. The server
+// creates its own tables in the schema.
+func (c *Client) EnsureMemoryStore(ctx context.Context, schema string) error {
+	db := c.db.WithContext(ctx)
+	if db.Name() != "postgres" {
+		return ErrMemoryStoresUnsupported
+	}
+
+	return db.Exec(fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", pgx.Identifier{schema}.Sanitize())).Error
+}
+
+// MemoryStoreSize returns the size in bytes of the tables in the schema of a memory MCP server, including their indexes.
+func (c *Client) MemoryStoreSize(ctx context.Context, schema string) (int64, error) {
+	db := c.db.WithContext(ctx)
+	if db.Name() != "postgres" {
+		return 0, ErrMemoryStoresUnsupported
+	}
+
+	var size int64
+	return size, db.Raw(`SELECT COALESCE(SUM(pg_total_relation_size(c.oid)), 0)
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = ? AND c.relkind IN ('r', 'm')`, schema).Scan(&size).Error
+}
+
+// DeleteMemoryStore drops the schema of a memory MCP server with all the memories in it.
+func (c *Client) DeleteMemoryStore(ctx context.Context, schema string) error {
+	db := c.db.WithContext(ctx)
+	if db.Name() != "postgres" {
+		return ErrMemoryStoresUnsupported
+	}
+
+	return db.Exec(fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", pgx.Identifier{schema}.Sanitize())).Error
+}
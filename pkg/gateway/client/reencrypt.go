@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const reencryptBatchSize = 100
+
+// ReencryptProgress reports how far a re-encryption of a resource has progressed.
+type ReencryptProgress struct {
+	Total       int
+	Reencrypted int
+	Failed      int
+}
+
+// ReencryptMCPOAuthTokens rewrites every stored MCP OAuth token with the current write key of the encryption provider.
+// Tokens that cannot be re-encrypted are left untouched and counted as failed.
+func (c *Client) ReencryptMCPOAuthTokens(ctx context.Context, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	return reencrypt(ctx, c.db.WithContext(ctx), "mcp_id, user_id",
+		func(db *gorm.DB, last *types.MCPOAuthToken) *gorm.DB {
+			return db.Where("mcp_id > ? OR (mcp_id = ? AND user_id > ?)", last.MCPID, last.MCPID, last.UserID)
+		},
+		c.decryptMCPOAuthToken, c.encryptMCPOAuthToken,
+		func(t *types.MCPOAuthToken) []string {
+			return []string{t.AccessToken, t.RefreshToken, t.ClientID, t.ClientSecret}
+		},
+		[]string{"access_token", "refresh_token", "client_id", "client_secret", "encrypted"}, progress)
+}
+
+// ReencryptMCPOAuthPendingStates rewrites every stored MCP OAuth pending state with the current write key of the
+// encryption provider. Pending states that cannot be re-encrypted are left untouched and counted as failed.
+func (c *Client) ReencryptMCPOAuthPendingStates(ctx context.Context, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	return reencrypt(ctx, c.db.WithContext(ctx), "hashed_state",
+		func(db *gorm.DB, last *types.MCPOAuthPendingState) *gorm.DB {
+			return db.Where("hashed_state > ?", last.HashedState)
+		},
+		c.decryptMCPOAuthPendingState, c.encryptMCPOAuthPendingState,
+		func(ps *types.MCPOAuthPendingState) []string {
+			return []string{ps.State, ps.Verifier, ps.ClientID, ps.ClientSecret}
+		},
+		[]string{"state", "verifier", "client_id", "client_secret", "encrypted"}, progress)
+}
+
+// ReencryptUsers rewrites the encrypted fields of every user with the current write key of the encryption provider.
+// Users that cannot be re-encrypted are left untouched and counted as failed.
+func (c *Client) ReencryptUsers(ctx context.Context, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	return reencrypt(ctx, c.db.WithContext(ctx), "id",
+		func(db *gorm.DB, last *types.User) *gorm.DB {
+			return db.Where("id > ?", last.ID)
+		},
+		c.decryptUser, c.encryptUser,
+		func(u *types.User) []string {
+			return []string{u.Username, u.Email, u.IconURL, u.DisplayName, u.OriginalEmail, u.OriginalUsername}
+		},
+		[]string{"username", "email", "icon_url", "display_name", "original_email", "original_username", "encrypted"}, progress)
+}
+
+// ReencryptIdentities rewrites the encrypted fields of every identity with the current write key of the encryption
+// provider. Identities that cannot be re-encrypted are left untouched and counted as failed.
+func (c *Client) ReencryptIdentities(ctx context.Context, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	return reencrypt(ctx, c.db.WithContext(ctx), "auth_provider_name, auth_provider_namespace, hashed_provider_user_id",
+		func(db *gorm.DB, last *types.Identity) *gorm.DB {
+			return db.Where("auth_provider_name > ? OR (auth_provider_name = ? AND auth_provider_namespace > ?) OR (auth_provider_name = ? AND auth_provider_namespace = ? AND hashed_provider_user_id > ?)",
+				last.AuthProviderName,
+				last.AuthProviderName, last.AuthProviderNamespace,
+				last.AuthProviderName, last.AuthProviderNamespace, last.HashedProviderUserID)
+		},
+		c.decryptIdentity, c.encryptIdentity,
+		func(i *types.Identity) []string {
+			return []string{i.ProviderUsername, i.Email, i.ProviderUserID, i.ProviderGroupLookupID, i.IconURL}
+		},
+		[]string{"provider_username", "email", "provider_user_id", "provider_group_lookup_id", "icon_url", "encrypted"}, progress)
+}
+
+// ReencryptMCPAuditLogs rewrites the request and response bodies and headers of every MCP audit log with the current
+// write key of the encryption provider. Audit logs that cannot be re-encrypted are left untouched and counted as failed.
+func (c *Client) ReencryptMCPAuditLogs(ctx context.Context, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	return reencrypt(ctx, c.db.WithContext(ctx), "id",
+		func(db *gorm.DB, last *types.MCPAuditLog) *gorm.DB {
+			return db.Where("id > ?", last.ID)
+		},
+		c.decryptMCPAuditLog, c.encryptMCPAuditLog,
+		func(l *types.MCPAuditLog) []string {
+			return []string{
+				string(l.RequestBody), string(l.MutatedRequestBody), string(l.ResponseBody), string(l.OriginalResponseBody),
+				string(l.RequestHeaders), string(l.ResponseHeaders),
+			}
+		},
+		[]string{"request_body", "mutated_request_body", "response_body", "original_response_body", "request_headers", "response_headers", "encrypted"}, progress)
+}
+
+// ReencryptMessagePolicyViolations rewrites the blocked content of every message policy violation with the current
+// write key of the encryption provider. Violations that cannot be re-encrypted are left untouched and counted as
+// failed.
+func (c *Client) ReencryptMessagePolicyViolations(ctx context.Context, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	return reencrypt(ctx, c.db.WithContext(ctx), "id",
+		func(db *gorm.DB, last *types.MessagePolicyViolation) *gorm.DB {
+			return db.Where("id > ?", last.ID)
+		},
+		c.decryptMessagePolicyViolation, c.encryptMessagePolicyViolation,
+		func(v *types.MessagePolicyViolation) []string {
+			return []string{string(v.BlockedContent)}
+		},
+		[]string{"blocked_content", "encrypted"}, progress)
+}
+
+// ReencryptRunStates rewrites the output, call frame, and chat state of every run state with the current write key of
+// the encryption provider. Run states that cannot be re-encrypted are left untouched and counted as failed.
+func (c *Client) ReencryptRunStates(ctx context.Context, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	return reencrypt(ctx, c.db.WithContext(ctx), "namespace, name",
+		func(db *gorm.DB, last *types.RunState) *gorm.DB {
+			return db.Where("namespace > ? OR (namespace = ? AND name > ?)", last.Namespace, last.Namespace, last.Name)
+		},
+		c.decryptRunState, c.encryptRunState,
+		func(r *types.RunState) []string {
+			return []string{string(r.Output), string(r.CallFrame), string(r.ChatState)}
+		},
+		[]string{"output", "call_frame", "chat_state"}, progress)
+}
+
+// reencrypt walks the table of T in batches ordered by primary key, decrypting each record and encrypting it again.
+// The new ciphertext is decrypted and compared to the plaintext before the record is saved, so a record is only
+// replaced once it is known to be readable with the current configuration. Only the encrypted columns are written, so
+// that concurrent changes to the other columns of a record aren't lost.
+func reencrypt[T any](ctx context.Context, db *gorm.DB, order string, after func(*gorm.DB, *T) *gorm.DB, decrypt, encrypt func(context.Context, *T) error, secrets func(*T) []string, columns []string, progress func(ReencryptProgress)) (ReencryptProgress, error) {
+	var (
+		p     ReencryptProgress
+		total int64
+	)
+	if err := db.Model(new(T)).Count(&total).Error; err != nil {
+		return p, err
+	}
+	p.Total = int(total)
+	if progress != nil {
+		progress(p)
+	}
+
+	var last *T
+	for {
+		query := db.Order(order).Limit(reencryptBatchSize)
+		if last != nil {
+			query = after(query, last)
+		}
+
+		var batch []T
+		if err := query.Find(&batch).Error; err != nil {
+			return p, err
+		}
+		if len(batch) == 0 {
+			return p, nil
+		}
+
+		for i := range batch {
+			if err := reencryptRecord(ctx, db, &batch[i], decrypt, encrypt, secrets, columns); errors.Is(err, gorm.ErrRecordNotFound) {
+				// The record was deleted since the batch was read, so there is nothing left to re-encrypt.
+				p.Total--
+			} else if err != nil {
+				log.Errorf("Failed to re-encrypt %T record: %v", batch[i], err)
+				p.Failed++
+			} else {
+				p.Reencrypted++
+			}
+		}
+
+		last = &batch[len(batch)-1]
+		if progress != nil {
+			progress(p)
+		}
+		if err := ctx.Err(); err != nil {
+			return p, err
+		}
+	}
+}
+
+// reencryptRecord re-encrypts a record in a transaction that locks its row, so that writes to the record that land
+// during the rotation, like a token refresh, aren't overwritten with the values that were read before them.
+func reencryptRecord[T any](ctx context.Context, db *gorm.DB, record *T, decrypt, encrypt func(context.Context, *T) error, secrets func(*T) []string, columns []string) error {
+	key, err := primaryKey(ctx, db, record)
+	if err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		// Read the record again now that its row is locked.
+		if err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Where(key).Take(record).Error; err != nil {
+			return err
+		}
+
+		if err := decrypt(ctx, record); err != nil {
+			return fmt.Errorf("failed to decrypt: %w", err)
+		}
+		plaintext := secrets(record)
+
+		if err := encrypt(ctx, record); err != nil {
+			return fmt.Errorf("failed to encrypt: %w", err)
+		}
+
+		check := *record
+		if err := decrypt(ctx, &check); err != nil {
+			return fmt.Errorf("failed to verify: %w", err)
+		}
+		if !slices.Equal(secrets(&check), plaintext) {
+			return fmt.Errorf("failed to verify: decrypted values do not match")
+		}
+
+		return tx.Model(new(T)).Where(key).Select(columns).UpdateColumns(record).Error
+	})
+}
+
+// primaryKey returns the condition that matches the row of a record by its primary key. Unlike the conditions that gorm
+// derives from a record, it doesn't skip the columns of the key that are empty.
+func primaryKey[T any](ctx context.Context, db *gorm.DB, record *T) (clause.Expression, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(record); err != nil {
+		return nil, err
+	}
+
+	var key clause.AndConditions
+	for _, field := range stmt.Schema.PrimaryFields {
+		value, _ := field.ValueOf(ctx, reflect.ValueOf(record).Elem())
+		key.Exprs = append(key.Exprs, clause.Eq{Column: clause.Column{Table: stmt.Schema.Table, Name: field.DBName}, Value: value})
+	}
+	return key, nil
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"crypto/aes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/server/options/encryptionconfig"
+	"k8s.io/apiserver/pkg/storage/value"
+	aestransformer "k8s.io/apiserver/pkg/storage/value/encrypt/aes"
+)
+
+func testEncryptionConfig(t *testing.T, keys ...string) *encryptionconfig.EncryptionConfiguration {
+	t.Helper()
+
+	prefixes := make([]value.PrefixTransformer, 0, len(keys))
+	for _, name := range keys {
+		key := make([]byte, 32)
+		copy(key, name)
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			t.Fatalf("failed to create cipher: %v", err)
+		}
+		prefixes = append(prefixes, value.PrefixTransformer{
+			Prefix:      []byte("k8s:enc:aescbc:v1:" + name + ":"),
+			Transformer: aestransformer.NewCBCTransformer(block),
+		})
+	}
+
+	transformers := make(map[schema.GroupResource]value.Transformer)
+	for _, gr := range []schema.GroupResource{
+		mcpOAuthTokenGroupResource,
+		mcpOAuthPendingStateGroupResource,
+		userGroupResource,
+		identityGroupResource,
+		mcpAuditLogGroupResource,
+		messagePolicyViolationGroupResource,
+		runStatesGroupResource,
+	} {
+		transformers[gr] = value.NewPrefixTransformers(errors.New("no matching key"), prefixes...)
+	}
+
+	return &encryptionconfig.EncryptionConfiguration{
+		Transformers: transformers,
+	}
+}
+
+func TestReencryptMCPOAuthTokens(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	// Store tokens with the old key.
+	c.encryptionConfig = testEncryptionConfig(t, "old")
+	for i := range 250 {
+		token := types.MCPOAuthToken{
+			MCPID:        fmt.Sprintf("ms1%03d", i%7),
+			UserID:       fmt.Sprintf("user%03d", i),
+			AccessToken:  fmt.Sprintf("access-%d", i),
+			RefreshToken: fmt.Sprintf("refresh-%d", i),
+			ClientID:     "client",
+			ClientSecret: "secret",
+		}
+		if err := c.encryptMCPOAuthToken(ctx, &token); err != nil {
+			t.Fatalf("failed to encrypt token: %v", err)
+		}
+		if err := c.db.WithContext(ctx).Create(&token).Error; err != nil {
+			t.Fatalf("failed to create token: %v", err)
+		}
+	}
+
+	// Rotate: the new key is used for writes and the old key is still available for reads.
+	c.encryptionConfig = testEncryptionConfig(t, "new", "old")
+
+	var updates int
+	p, err := c.ReencryptMCPOAuthTokens(ctx, func(ReencryptProgress) { updates++ })
+	if err != nil {
+		t.Fatalf("ReencryptMCPOAuthTokens() error = %v", err)
+	}
+	if p.Total != 250 || p.Reencrypted != 250 || p.Failed != 0 {
+		t.Errorf("ReencryptMCPOAuthTokens() progress = %+v, want 250 re-encrypted", p)
+	}
+	if updates < 3 {
+		t.Errorf("expected progress to be reported for each batch, got %d updates", updates)
+	}
+
+	// Once the old key is removed, every token must still be readable.
+	c.encryptionConfig = testEncryptionConfig(t, "new")
+	for i := range 250 {
+		token, err := c.GetMCPOAuthToken(ctx, fmt.Sprintf("user%03d", i), fmt.Sprintf("ms1%03d", i%7), "")
+		if err != nil {
+			t.Fatalf("GetMCPOAuthToken() error = %v", err)
+		}
+		if token.AccessToken != fmt.Sprintf("access-%d", i) || token.ClientSecret != "secret" {
+			t.Fatalf("unexpected token values after rotation: %+v", token)
+		}
+	}
+}
+
+func TestReencryptMCPOAuthTokensKeepsUnreadableRecords(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	c.encryptionConfig = testEncryptionConfig(t, "lost")
+	token := types.MCPOAuthToken{MCPID: "ms1abc", UserID: "user", AccessToken: "access"}
+	if err := c.encryptMCPOAuthToken(ctx, &token); err != nil {
+		t.Fatalf("failed to encrypt token: %v", err)
+	}
+	if err := c.db.WithContext(ctx).Create(&token).Error; err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	c.encryptionConfig = testEncryptionConfig(t, "new")
+	p, err := c.ReencryptMCPOAuthTokens(ctx, nil)
+	if err != nil {
+		t.Fatalf("ReencryptMCPOAuthTokens() error = %v", err)
+	}
+	if p.Failed != 1 || p.Reencrypted != 0 {
+		t.Errorf("ReencryptMCPOAuthTokens() progress = %+v, want 1 failed", p)
+	}
+
+	var stored types.MCPOAuthToken
+	if err = c.db.WithContext(ctx).First(&stored).Error; err != nil {
+		t.Fatalf("failed to get token: %v", err)
+	}
+	if stored.AccessToken != token.AccessToken {
+		t.Error("expected the unreadable token to be left untouched")
+	}
+}
+
+func TestReencryptRunStates(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	c.encryptionConfig = testEncryptionConfig(t, "old")
+	for i := range 3 {
+		if err := c.CreateRunState(ctx, &types.RunState{
+			Name:      fmt.Sprintf("r1%03d", i),
+			Namespace: "default",
+			Output:    []byte(fmt.Sprintf("output-%d", i)),
+			CallFrame: []byte("{}"),
+			ChatState: []byte("{}"),
+		}); err != nil {
+			t.Fatalf("failed to create run state: %v", err)
+		}
+	}
+
+	c.encryptionConfig = testEncryptionConfig(t, "new", "old")
+	p, err := c.ReencryptRunStates(ctx, nil)
+	if err != nil {
+		t.Fatalf("ReencryptRunStates() error = %v", err)
+	}
+	if p.Total != 3 || p.Reencrypted != 3 || p.Failed != 0 {
+		t.Errorf("ReencryptRunStates() progress = %+v, want 3 re-encrypted", p)
+	}
+
+	c.encryptionConfig = testEncryptionConfig(t, "new")
+	for i := range 3 {
+		runState, err := c.RunState(ctx, "default", fmt.Sprintf("r1%03d", i))
+		if err != nil {
+			t.Fatalf("RunState() error = %v", err)
+		}
+		if string(runState.Output) != fmt.Sprintf("output-%d", i) {
+			t.Fatalf("unexpected run state output after rotation: %q", runState.Output)
+		}
+	}
+}
+
+func TestReencryptKeepsConcurrentWrites(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	c.encryptionConfig = testEncryptionConfig(t, "old")
+	token := types.MCPOAuthToken{MCPID: "ms1abc", UserID: "user", AccessToken: "access", RefreshToken: "refresh"}
+	if err := c.encryptMCPOAuthToken(ctx, &token); err != nil {
+		t.Fatalf("failed to encrypt token: %v", err)
+	}
+	if err := c.db.WithContext(ctx).Create(&token).Error; err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+	stale := token
+
+	// The token is refreshed after the rotation read it.
+	refreshed := types.MCPOAuthToken{MCPID: "ms1abc", UserID: "user", AccessToken: "refreshed-access", RefreshToken: "refreshed-refresh"}
+	if err := c.encryptMCPOAuthToken(ctx, &refreshed); err != nil {
+		t.Fatalf("failed to encrypt token: %v", err)
+	}
+	if err := c.db.WithContext(ctx).Model(new(types.MCPOAuthToken)).Where("mcp_id = ? AND user_id = ?", "ms1abc", "user").
+		Updates(map[string]any{"access_token": refreshed.AccessToken, "refresh_token": refreshed.RefreshToken}).Error; err != nil {
+		t.Fatalf("failed to refresh token: %v", err)
+	}
+
+	c.encryptionConfig = testEncryptionConfig(t, "new", "old")
+	if err := reencryptRecord(ctx, c.db.WithContext(ctx), &stale, c.decryptMCPOAuthToken, c.encryptMCPOAuthToken,
+		func(t *types.MCPOAuthToken) []string {
+			return []string{t.AccessToken, t.RefreshToken, t.ClientID, t.ClientSecret}
+		},
+		[]string{"access_token", "refresh_token", "client_id", "client_secret", "encrypted"}); err != nil {
+		t.Fatalf("reencryptRecord() error = %v", err)
+	}
+
+	c.encryptionConfig = testEncryptionConfig(t, "new")
+	got, err := c.GetMCPOAuthToken(ctx, "user", "ms1abc", "")
+	if err != nil {
+		t.Fatalf("GetMCPOAuthToken() error = %v", err)
+	}
+	if got.AccessToken != "refreshed-access" || got.RefreshToken != "refreshed-refresh" {
+		t.Errorf("expected the refreshed token to be kept, got access=%q refresh=%q", got.AccessToken, got.RefreshToken)
+	}
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm/clause"
+)
+
+// RevokeOAuthTokens records the token or grant IDs as revoked until expiresAt.
+func (c *Client) RevokeOAuthTokens(ctx context.Context, expiresAt time.Time, ids ...string) error {
+	revoked := make([]types.RevokedOAuthToken, 0, len(ids))
+	for _, id := range ids {
+		if id != "" {
+			revoked = append(revoked, types.RevokedOAuthToken{ID: id, ExpiresAt: expiresAt})
+		}
+	}
+	if len(revoked) == 0 {
+		return nil
+	}
+
+	return c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"expires_at"}),
+	}).Create(&revoked).Error
+}
+
+// OAuthTokenRevoked returns whether any of the token or grant IDs is revoked.
+func (c *Client) OAuthTokenRevoked(ctx context.Context, ids ...string) (bool, error) {
+	if len(ids) == 0 {
+		return false, nil
+	}
+
+	var count int64
+	if err := c.db.WithContext(ctx).Model(&types.RevokedOAuthToken{}).Where("id IN ? AND expires_at > ?", ids, time.Now()).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRevokeOAuthTokens(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	revoked, err := c.OAuthTokenRevoked(ctx, "token1", "grant1")
+	if err != nil {
+		t.Fatalf("unexpected error checking revocation: %v", err)
+	}
+	if revoked {
+		t.Fatal("expected token not to be revoked")
+	}
+
+	if err := c.RevokeOAuthTokens(ctx, time.Now().Add(time.Hour), "grant1", ""); err != nil {
+		t.Fatalf("failed to revoke grant: %v", err)
+	}
+	// Revoking again extends the revocation instead of failing.
+	if err := c.RevokeOAuthTokens(ctx, time.Now().Add(2*time.Hour), "grant1"); err != nil {
+		t.Fatalf("failed to revoke grant again: %v", err)
+	}
+	if err := c.RevokeOAuthTokens(ctx, time.Now().Add(-time.Minute), "expired"); err != nil {
+		t.Fatalf("failed to revoke expired token: %v", err)
+	}
+
+	for _, tc := range []struct {
+		ids      []string
+		expected bool
+	}{
+		{ids: []string{"token1", "grant1"}, expected: true},
+		{ids: []string{"token1", "grant2"}, expected: false},
+		{ids: []string{"expired"}, expected: false},
+		{ids: nil, expected: false},
+	} {
+		if revoked, err = c.OAuthTokenRevoked(ctx, tc.ids...); err != nil {
+			t.Fatalf("unexpected error checking revocation of %v: %v", tc.ids, err)
+		} else if revoked != tc.expected {
+			t.Fatalf("expected revocation of %v to be %v, got %v", tc.ids, tc.expected, revoked)
+		}
+	}
+}
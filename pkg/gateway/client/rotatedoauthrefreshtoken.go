@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecordRotatedOAuthRefreshToken records that a refresh token was exchanged for a new one. Concurrent refreshes of the
+// same token record it once.
+func (c *Client) RecordRotatedOAuthRefreshToken(ctx context.Context, token types.RotatedOAuthRefreshToken) error {
+	return c.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&token).Error
+}
+
+// RotatedOAuthRefreshToken returns the rotated refresh token with the hash, or nil if the hash isn't of a refresh token
+// that was rotated, or the record of its rotation expired.
+func (c *Client) RotatedOAuthRefreshToken(ctx context.Context, hash string) (*types.RotatedOAuthRefreshToken, error) {
+	var token types.RotatedOAuthRefreshToken
+	if err := c.db.WithContext(ctx).Where("hash = ? AND expires_at > ?", hash, time.Now()).First(&token).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
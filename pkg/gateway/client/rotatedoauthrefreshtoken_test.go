@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestRotatedOAuthRefreshToken(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	token, err := c.RotatedOAuthRefreshToken(ctx, "hash1")
+	if err != nil {
+		t.Fatalf("unexpected error getting rotated token: %v", err)
+	}
+	if token != nil {
+		t.Fatal("expected token not to be rotated")
+	}
+
+	now := time.Now()
+	if err = c.RecordRotatedOAuthRefreshToken(ctx, types.RotatedOAuthRefreshToken{Hash: "hash1", GrantID: "grant1", UserID: 1, RotatedAt: now, ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("failed to record rotated token: %v", err)
+	}
+	// Concurrent refreshes of the same token record it once.
+	if err = c.RecordRotatedOAuthRefreshToken(ctx, types.RotatedOAuthRefreshToken{Hash: "hash1", GrantID: "grant2", UserID: 2, RotatedAt: now, ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("failed to record rotated token again: %v", err)
+	}
+	if err = c.RecordRotatedOAuthRefreshToken(ctx, types.RotatedOAuthRefreshToken{Hash: "expired", GrantID: "grant1", UserID: 1, RotatedAt: now, ExpiresAt: now.Add(-time.Minute)}); err != nil {
+		t.Fatalf("failed to record expired rotated token: %v", err)
+	}
+
+	if token, err = c.RotatedOAuthRefreshToken(ctx, "hash1"); err != nil {
+		t.Fatalf("unexpected error getting rotated token: %v", err)
+	} else if token == nil || token.GrantID != "grant1" || token.UserID != 1 {
+		t.Fatalf("expected rotated token of grant1, got %+v", token)
+	}
+
+	if token, err = c.RotatedOAuthRefreshToken(ctx, "expired"); err != nil {
+		t.Fatalf("unexpected error getting expired rotated token: %v", err)
+	} else if token != nil {
+		t.Fatalf("expected expired rotated token not to be found, got %+v", token)
+	}
+}
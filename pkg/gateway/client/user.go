@@ -231,7 +231,7 @@ func (c *Client) UpdateUser(ctx context.Context, actingUserCanChangeRole bool, u
 			existingUser.AutonomousToolUseEnabled = updatedUser.AutonomousToolUseEnabled
 		}
 
-		// Only admins can change user roles.
+		// Only admins can change user roles and limits.
 		if actingUserCanChangeRole {
 			if updatedUser.Role > 0 {
 				// Check if we're removing the Owner role
@@ -279,6 +279,10 @@ func (c *Client) UpdateUser(ctx context.Context, actingUserCanChangeRole bool, u
 
 				existingUser.Role = updatedUser.Role
 			}
+
+			if updatedUser.MonthlyMCPToolCallLimit != nil {
+				existingUser.MonthlyMCPToolCallLimit = updatedUser.MonthlyMCPToolCallLimit
+			}
 		}
 
 		// Copy the user object that is returned to the caller so they don't get the encrypted values
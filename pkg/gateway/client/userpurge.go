@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/hash"
+	"gorm.io/gorm"
+)
+
+// PurgeUser removes the personal data of a deleted user from the database. The records that only exist for the user,
+// like their identities, tokens and usage, are deleted. The records that are kept for auditing, like the MCP audit
+// logs, keep the user's ID but lose everything else that could identify them. The user itself is kept, without any
+// personal data, so that the records that are kept still refer to a user.
+//
+// Purging a user again is safe, so a purge can be retried until it succeeds.
+func (c *Client) PurgeUser(ctx context.Context, userID uint) (types.UserPurgeCounts, error) {
+	counts := types.UserPurgeCounts{
+		Deleted:    make(map[string]int),
+		Anonymized: make(map[string]int),
+	}
+	userIDString := fmt.Sprint(userID)
+
+	return counts, c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		user := new(types.User)
+		if err := tx.Where("id = ?", userID).First(user).Error; err != nil {
+			return err
+		}
+		if user.DeletedAt == nil {
+			return errors.New("user must be deleted before it is purged")
+		}
+
+		// Replace the user's names and email with values that are unique, so that they can't conflict with other users.
+		purgedName := fmt.Sprintf("purged-user-%d", userID)
+		*user = types.User{
+			ID:             user.ID,
+			CreatedAt:      user.CreatedAt,
+			DeletedAt:      user.DeletedAt,
+			Role:           user.Role,
+			Username:       purgedName,
+			HashedUsername: hash.String(purgedName),
+			Email:          purgedName,
+			HashedEmail:    hash.String(purgedName),
+		}
+		if err := c.encryptUser(ctx, user); err != nil {
+			return fmt.Errorf("failed to encrypt user: %w", err)
+		}
+		if err := tx.Save(user).Error; err != nil {
+			return err
+		}
+
+		for _, records := range []struct {
+			name   string
+			model  any
+			userID any
+		}{
+			{name: "identities", model: new(types.Identity), userID: userID},
+			{name: "authTokens", model: new(types.AuthToken), userID: userID},
+			{name: "apiKeys", model: new(types.APIKey), userID: userID},
+			{name: "groupMemberships", model: new(types.GroupMemberships), userID: userID},
+			{name: "tempSetupUsers", model: new(types.TempSetupUser), userID: userID},
+			{name: "mcpOAuthTokens", model: new(types.MCPOAuthToken), userID: userIDString},
+			{name: "mcpOAuthPendingStates", model: new(types.MCPOAuthPendingState), userID: userIDString},
+			{name: "mcpOAuthActivity", model: new(types.MCPOAuthActivity), userID: userIDString},
+			{name: "mcpOAuthFailures", model: new(types.MCPOAuthFailure), userID: userIDString},
+			{name: "mcpToolUsage", model: new(types.MCPToolUsage), userID: userIDString},
+			{name: "apiActivity", model: new(types.APIActivity), userID: userIDString},
+			{name: "llmProxyActivity", model: new(types.LLMProxyActivity), userID: userIDString},
+			{name: "runTokenActivity", model: new(types.RunTokenActivity), userID: userIDString},
+			{name: "runStates", model: new(types.RunState), userID: userIDString},
+		} {
+			result := tx.Where("user_id = ?", records.userID).Delete(records.model)
+			if result.Error != nil {
+				return fmt.Errorf("failed to delete %s: %w", records.name, result.Error)
+			}
+			counts.Deleted[records.name] = int(result.RowsAffected)
+		}
+
+		// Audit records are kept, but without the contents of the requests and anything else that describes the user.
+		for _, records := range []struct {
+			name, userColumn string
+			model            any
+			updates          map[string]any
+		}{
+			{
+				name:       "mcpAuditLogs",
+				userColumn: "user_id",
+				model:      new(types.MCPAuditLog),
+				updates: map[string]any{
+					"api_key":                "",
+					"client_ip":              "",
+					"user_agent":             "",
+					"request_body":           nil,
+					"mutated_request_body":   nil,
+					"response_body":          nil,
+					"original_response_body": nil,
+					"request_headers":        nil,
+					"response_headers":       nil,
+				},
+			},
+			{
+				name:       "messagePolicyViolations",
+				userColumn: "user_id",
+				model:      new(types.MessagePolicyViolation),
+				updates:    map[string]any{"blocked_content": nil},
+			},
+			{
+				name:       "deviceScans",
+				userColumn: "submitted_by",
+				model:      new(types.DeviceScan),
+				updates:    map[string]any{"hostname": "", "username": ""},
+			},
+		} {
+			result := tx.Model(records.model).Where(records.userColumn+" = ?", userIDString).Updates(records.updates)
+			if result.Error != nil {
+				return fmt.Errorf("failed to anonymize %s: %w", records.name, result.Error)
+			}
+			counts.Anonymized[records.name] = int(result.RowsAffected)
+		}
+
+		return nil
+	})
+}
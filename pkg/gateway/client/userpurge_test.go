@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestPurgeUser(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+	db := c.db.WithContext(ctx)
+
+	user := types.User{
+		Username:       "jdoe",
+		HashedUsername: "jdoe",
+		Email:          "jdoe@example.com",
+		DisplayName:    "Jane Doe",
+		IconURL:        "https://example.com/jdoe.png",
+	}
+	other := types.User{Username: "other", HashedUsername: "other", Email: "other@example.com"}
+	for _, u := range []*types.User{&user, &other} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	userID, otherID := fmt.Sprint(user.ID), fmt.Sprint(other.ID)
+
+	for _, record := range []any{
+		&types.Identity{ProviderUserID: "jdoe", HashedProviderUserID: "jdoe", UserID: user.ID},
+		&types.MCPOAuthToken{MCPID: "ms1", UserID: userID, AccessToken: "secret"},
+		&types.APIActivity{UserID: userID},
+		&types.APIActivity{UserID: otherID},
+		&types.MCPAuditLog{UserID: userID, MCPID: "ms1", ClientIP: "10.0.0.1", RequestBody: json.RawMessage(`{"q":"private"}`)},
+		&types.MCPAuditLog{UserID: otherID, MCPID: "ms1", ClientIP: "10.0.0.2", RequestBody: json.RawMessage(`{"q":"kept"}`)},
+	} {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("failed to create %T: %v", record, err)
+		}
+	}
+
+	if _, err := c.PurgeUser(ctx, user.ID); err == nil {
+		t.Fatal("expected purging a user that isn't deleted to fail")
+	}
+
+	if _, err := c.DeleteUser(ctx, userID); err != nil {
+		t.Fatalf("failed to delete user: %v", err)
+	}
+
+	counts, err := c.PurgeUser(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("failed to purge user: %v", err)
+	}
+	if counts.Deleted["identities"] != 1 || counts.Deleted["mcpOAuthTokens"] != 1 || counts.Deleted["apiActivity"] != 1 {
+		t.Errorf("unexpected deleted counts: %v", counts.Deleted)
+	}
+	if counts.Anonymized["mcpAuditLogs"] != 1 {
+		t.Errorf("unexpected anonymized counts: %v", counts.Anonymized)
+	}
+
+	purged, err := c.UserByIDIncludeDeleted(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get purged user: %v", err)
+	}
+	if purged.DisplayName != "" || purged.IconURL != "" || purged.OriginalEmail != "" || purged.OriginalUsername != "" ||
+		purged.Email != fmt.Sprintf("purged-user-%d", user.ID) || purged.DeletedAt == nil {
+		t.Errorf("expected the user's personal data to be removed, got %+v", purged)
+	}
+
+	var logs []types.MCPAuditLog
+	if err := db.Order("id").Find(&logs).Error; err != nil {
+		t.Fatalf("failed to list audit logs: %v", err)
+	}
+	if len(logs) != 2 {
+		t.Fatalf("expected the audit logs to be kept, got %d", len(logs))
+	}
+	if logs[0].UserID != userID || logs[0].ClientIP != "" || len(logs[0].RequestBody) != 0 {
+		t.Errorf("expected the purged user's audit log to be anonymized, got %+v", logs[0])
+	}
+	if logs[1].ClientIP != "10.0.0.2" || string(logs[1].RequestBody) != `{"q":"kept"}` {
+		t.Errorf("expected the other user's audit log to be unchanged, got %+v", logs[1])
+	}
+
+	var activity int64
+	if err := db.Model(new(types.APIActivity)).Count(&activity).Error; err != nil {
+		t.Fatalf("failed to count API activity: %v", err)
+	}
+	if activity != 1 {
+		t.Errorf("expected only the other user's API activity to be kept, got %d", activity)
+	}
+
+	// Purging again is safe.
+	if _, err := c.PurgeUser(ctx, user.ID); err != nil {
+		t.Errorf("failed to purge user again: %v", err)
+	}
+}
@@ -4,17 +4,27 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/version"
 	"gorm.io/gorm"
 )
 
+var log = logger.Package()
+
 type DB struct {
 	gormDB      *gorm.DB
 	sqlDB       *sql.DB
 	autoMigrate bool
+
+	contractedTransitions sync.Map
 }
 
+// New returns the gateway database. If autoMigrate is false, then AutoMigrate only migrates a new database, and the
+// changes that an existing database needs are left for operators to review with Plan.
 func New(db *gorm.DB, sqlDB *sql.DB, autoMigrate bool) (*DB, error) {
 	return &DB{
 		gormDB:      db,
@@ -23,8 +33,83 @@ func New(db *gorm.DB, sqlDB *sql.DB, autoMigrate bool) (*DB, error) {
 	}, nil
 }
 
+// gatewayModels are the models of the tables that AutoMigrate creates and updates.
+var gatewayModels = []any{
+	types.AuthToken{},
+	types.TokenRequest{},
+	types.LLMProxyActivity{},
+	types.OAuthTokenRequestChallenge{},
+	types.OAuthTokenResponse{},
+	types.User{},
+	types.Identity{},
+	types.Group{},
+	types.GroupMemberships{},
+	types.GroupRoleAssignment{},
+	types.APIActivity{},
+	types.Image{},
+	types.RunState{},
+	types.FileScannerConfig{},
+	types.RunTokenActivity{},
+	types.MCPOAuthToken{},
+	types.MCPOAuthPendingState{},
+	types.MCPAuditLog{},
+	types.MCPToolUsage{},
+	types.MCPOAuthActivity{},
+	types.MCPOAuthFailure{},
+	types.MCPSessionState{},
+	types.RevokedOAuthToken{},
+	types.RotatedOAuthRefreshToken{},
+	types.KeyRotation{},
+	types.MCPSearchDocument{},
+	types.MCPSearchTerm{},
+	types.TempSetupUser{},
+	types.Property{},
+	types.APIKey{},
+	types.ServiceAccountAPIKey{},
+	types.MessagePolicyViolation{},
+	types.DeviceScan{},
+	types.DeviceScanMCPServer{},
+	types.DeviceScanSkill{},
+	types.DeviceScanPlugin{},
+	types.DeviceScanFile{},
+	types.DeviceScanClient{},
+	types.DatabaseMigration{},
+	types.SchemaTransition{},
+}
+
+// dataMigration is a migration of existing data that only runs once, which is recorded in the migrations table.
+type dataMigration struct {
+	name    string
+	migrate func(*gorm.DB) error
+}
+
+var dataMigrations = []dataMigration{
+	{name: "auditor_user_role", migrate: migrateUserRoles},
+	{name: "drop_session_cookies", migrate: dropSessionCookiesTable},
+	{name: "remove_github_groups", migrate: removeGitHubGroups},
+	{name: "drop_obot_mcp_tokens", migrate: dropObotMCPTokensTable},
+	{name: "drop_mcp_oauth_token_state_columns", migrate: dropMCPOAuthTokenStateColumns},
+	{name: "apikey_skills_access_backfill", migrate: migrateAPIKeySkillsAccess},
+}
+
+// DryRun returns whether pending changes to an existing database are left unapplied.
+func (db *DB) DryRun() bool {
+	return !db.autoMigrate
+}
+
 func (db *DB) AutoMigrate() (err error) {
-	if !db.autoMigrate {
+	// A new database has nothing to review, so it is always migrated.
+	newDatabase := !db.gormDB.Migrator().HasTable(&types.Migration{})
+
+	changes, err := db.plan(db.gormDB)
+	if err != nil {
+		return fmt.Errorf("failed to plan database migration: %w", err)
+	}
+
+	if !db.autoMigrate && !newDatabase {
+		for _, change := range changes {
+			log.Warnf("Skipped pending database change in dry run: type=%s table=%s name=%s", change.Type, change.Table, change.Name)
+		}
 		return nil
 	}
 
@@ -56,69 +141,21 @@ func (db *DB) AutoMigrate() (err error) {
 		return fmt.Errorf("failed to drop mcp_server_instance table: %w", err)
 	}
 
-	if err = migrateIfEntryNotFoundInMigrationsTable(tx, "auditor_user_role", migrateUserRoles); err != nil {
-		return fmt.Errorf("failed to migrate user roles: %w", err)
-	}
-
 	if err = migrateMCPAuditLogClientInfo(tx); err != nil {
 		return fmt.Errorf("failed to migrate mcp_audit_log client info: %w", err)
 	}
 
-	if err = migrateIfEntryNotFoundInMigrationsTable(tx, "drop_session_cookies", dropSessionCookiesTable); err != nil {
-		return fmt.Errorf("failed to drop session_cookies table: %w", err)
-	}
-
-	if err = migrateIfEntryNotFoundInMigrationsTable(tx, "remove_github_groups", removeGitHubGroups); err != nil {
-		return fmt.Errorf("failed to remove GitHub groups: %w", err)
-	}
-
-	if err = migrateIfEntryNotFoundInMigrationsTable(tx, "drop_obot_mcp_tokens", dropObotMCPTokensTable); err != nil {
-		return fmt.Errorf("failed to drop obot_mcp_tokens table: %w", err)
-	}
-
-	if err = migrateIfEntryNotFoundInMigrationsTable(tx, "drop_mcp_oauth_token_state_columns", dropMCPOAuthTokenStateColumns); err != nil {
-		return fmt.Errorf("failed to drop state columns from mcp_oauth_tokens: %w", err)
-	}
-
-	if err = migrateIfEntryNotFoundInMigrationsTable(tx, "apikey_skills_access_backfill", migrateAPIKeySkillsAccess); err != nil {
-		return fmt.Errorf("failed to migrate API key skills access: %w", err)
+	for _, m := range dataMigrations {
+		if err = migrateIfEntryNotFoundInMigrationsTable(tx, m.name, m.migrate); err != nil {
+			return fmt.Errorf("failed to run data migration %s: %w", m.name, err)
+		}
 	}
 
 	if err := tx.AutoMigrate(&GptscriptCredential{}); err != nil {
 		return fmt.Errorf("failed to auto migrate GptscriptCredential: %w", err)
 	}
 
-	if err := tx.AutoMigrate(
-		types.AuthToken{},
-		types.TokenRequest{},
-		types.LLMProxyActivity{},
-		types.OAuthTokenRequestChallenge{},
-		types.OAuthTokenResponse{},
-		types.User{},
-		types.Identity{},
-		types.Group{},
-		types.GroupMemberships{},
-		types.GroupRoleAssignment{},
-		types.APIActivity{},
-		types.Image{},
-		types.RunState{},
-		types.FileScannerConfig{},
-		types.RunTokenActivity{},
-		types.MCPOAuthToken{},
-		types.MCPOAuthPendingState{},
-		types.MCPAuditLog{},
-		types.TempSetupUser{},
-		types.Property{},
-		types.APIKey{},
-		types.ServiceAccountAPIKey{},
-		types.MessagePolicyViolation{},
-		types.DeviceScan{},
-		types.DeviceScanMCPServer{},
-		types.DeviceScanSkill{},
-		types.DeviceScanPlugin{},
-		types.DeviceScanFile{},
-		types.DeviceScanClient{},
-	); err != nil {
+	if err := tx.AutoMigrate(gatewayModels...); err != nil {
 		return fmt.Errorf("failed to auto migrate gateway types: %w", err)
 	}
 
@@ -135,6 +172,20 @@ func (db *DB) AutoMigrate() (err error) {
 		}
 	}
 
+	if err = ensureSchemaTransitions(tx, newDatabase); err != nil {
+		return err
+	}
+
+	if len(changes) > 0 {
+		if err = tx.Create(&types.DatabaseMigration{
+			AppliedAt: time.Now(),
+			Version:   version.Get().String(),
+			Changes:   changes,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to record database migration: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+)
+
+// Plan returns the changes that AutoMigrate would make to the database, without making them.
+func (db *DB) Plan(ctx context.Context) ([]types2.DatabaseChange, error) {
+	return db.plan(db.gormDB.WithContext(ctx))
+}
+
+// plan compares the models of the gateway to the tables in the database. It finds the tables, columns, and indexes
+// that are missing, and the data migrations that haven't run. Changes of the types of existing columns, and the
+// migrations of older releases that are detected from the schema, aren't found.
+func (db *DB) plan(tx *gorm.DB) ([]types2.DatabaseChange, error) {
+	var changes []types2.DatabaseChange
+	for _, model := range append([]any{&types.Migration{}, &GptscriptCredential{}}, gatewayModels...) {
+		modelChanges, err := planModel(tx, model)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, modelChanges...)
+	}
+
+	completed := make(map[string]struct{}, len(dataMigrations))
+	if tx.Migrator().HasTable(&types.Migration{}) {
+		var migrations []types.Migration
+		if err := tx.Find(&migrations).Error; err != nil {
+			return nil, fmt.Errorf("failed to list completed migrations: %w", err)
+		}
+		for _, m := range migrations {
+			completed[m.Name] = struct{}{}
+		}
+	}
+
+	for _, m := range dataMigrations {
+		if _, ok := completed[m.name]; !ok {
+			changes = append(changes, types2.DatabaseChange{
+				Type: types2.DatabaseChangeTypeDataMigration,
+				Name: m.name,
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func planModel(tx *gorm.DB, model any) ([]types2.DatabaseChange, error) {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(model); err != nil {
+		return nil, fmt.Errorf("failed to parse model %T: %w", model, err)
+	}
+	table := stmt.Schema.Table
+
+	migrator := tx.Migrator()
+	if !migrator.HasTable(model) {
+		return []types2.DatabaseChange{{
+			Type:  types2.DatabaseChangeTypeCreateTable,
+			Table: table,
+		}}, nil
+	}
+
+	var changes []types2.DatabaseChange
+	for _, dbName := range stmt.Schema.DBNames {
+		if field := stmt.Schema.FieldsByDBName[dbName]; field.IgnoreMigration || migrator.HasColumn(model, dbName) {
+			continue
+		}
+		changes = append(changes, types2.DatabaseChange{
+			Type:  types2.DatabaseChangeTypeAddColumn,
+			Table: table,
+			Name:  dbName,
+		})
+	}
+
+	for _, index := range stmt.Schema.ParseIndexes() {
+		if migrator.HasIndex(model, index.Name) {
+			continue
+		}
+		changes = append(changes, types2.DatabaseChange{
+			Type:  types2.DatabaseChangeTypeCreateIndex,
+			Table: table,
+			Name:  index.Name,
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Transition is an expand/contract migration, which changes the schema without downtime while instances of the
+// previous and new release run side by side, such as during a blue/green upgrade.
+//
+// The new schema is added by AutoMigrate, next to the old one, which expands the schema. Until the transition is
+// contracted, code that writes the changed data writes both the old and the new schema, and code that reads it reads
+// the old schema, because instances of the previous release only write the old one. Use SchemaTransitionContracted to
+// decide. Meanwhile, the leader copies the existing data from the old schema to the new one in batches. Once all of it
+// has been copied, the transition can be contracted: the data is copied once more from the start, to catch up with
+// changes made by instances of the previous release, and then the old schema is removed.
+type Transition struct {
+	// Name identifies the transition in the schema_transitions table.
+	Name string
+	// Backfill copies the next batch of at most limit rows after cursor from the old schema to the new one. It returns
+	// the cursor of the last row that it copied, and the number of rows that it copied, which is 0 when there are none
+	// left. It must be safe to copy a row more than once.
+	Backfill func(tx *gorm.DB, cursor string, limit int) (string, int, error)
+	// Contract removes the old schema. It must be safe to call when the old schema doesn't exist, because the
+	// transitions of a new database are contracted right away.
+	Contract func(tx *gorm.DB) error
+}
+
+// transitions are the expand/contract migrations of the gateway, in the order that they were added.
+var transitions []Transition
+
+func transitionByName(name string) (Transition, bool) {
+	for _, t := range transitions {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// ensureSchemaTransitions starts the transitions that aren't in the schema_transitions table yet. Transitions of a new
+// database start contracted, because there is no data in the old schema to copy.
+func ensureSchemaTransitions(tx *gorm.DB, newDatabase bool) error {
+	for _, t := range transitions {
+		now := time.Now()
+		transition := types.SchemaTransition{
+			Name:      t.Name,
+			Phase:     types2.SchemaTransitionPhaseExpanded,
+			StartedAt: now,
+		}
+		if newDatabase {
+			if err := t.Contract(tx); err != nil {
+				return fmt.Errorf("failed to contract schema transition %s: %w", t.Name, err)
+			}
+			transition.Phase = types2.SchemaTransitionPhaseContracted
+			transition.BackfilledAt = &now
+			transition.ContractedAt = &now
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&transition).Error; err != nil {
+			return fmt.Errorf("failed to start schema transition %s: %w", t.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SchemaTransitionContracted returns whether the old schema of a transition was removed. Until it is, the changed
+// data must be written to both schemas and read from the old one.
+func (db *DB) SchemaTransitionContracted(ctx context.Context, name string) (bool, error) {
+	// Transitions are never un-contracted, so only that is cached.
+	if _, ok := db.contractedTransitions.Load(name); ok {
+		return true, nil
+	}
+
+	var transition types.SchemaTransition
+	if err := db.gormDB.WithContext(ctx).Where("name = ?", name).First(&transition).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if transition.Phase == types2.SchemaTransitionPhaseContracted {
+		db.contractedTransitions.Store(name, struct{}{})
+		return true, nil
+	}
+	return false, nil
+}
+
+// SchemaTransitions returns the expand/contract migrations of the database.
+func (db *DB) SchemaTransitions(ctx context.Context) ([]types.SchemaTransition, error) {
+	var result []types.SchemaTransition
+	return result, db.gormDB.WithContext(ctx).Order("started_at, name").Find(&result).Error
+}
+
+// BackfillSchemaTransitions copies the data of the transitions that aren't contracted to their new schema, in batches
+// of batchSize rows, until there is none left. If autoContract is true, then transitions are contracted as soon as
+// they are backfilled, which is only safe when no instance of the previous release is running.
+func (db *DB) BackfillSchemaTransitions(ctx context.Context, batchSize int, autoContract bool) error {
+	for _, t := range transitions {
+		if err := db.backfillSchemaTransition(ctx, t, batchSize); err != nil {
+			return fmt.Errorf("failed to backfill schema transition %s: %w", t.Name, err)
+		}
+
+		if autoContract {
+			if err := db.ContractSchemaTransition(ctx, t.Name); err != nil && !errors.Is(err, ErrSchemaTransitionNotBackfilled) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (db *DB) backfillSchemaTransition(ctx context.Context, t Transition, batchSize int) error {
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var done bool
+		if err := db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var transition types.SchemaTransition
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", t.Name).First(&transition).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+				// The database hasn't been migrated to this release yet.
+				done = true
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if transition.Phase == types2.SchemaTransitionPhaseContracted {
+				done = true
+				return nil
+			}
+
+			cursor, n, err := t.Backfill(tx, transition.Cursor, batchSize)
+			if err != nil {
+				return err
+			}
+
+			if n == 0 {
+				done = true
+				if transition.Phase != types2.SchemaTransitionPhaseExpanded {
+					return nil
+				}
+				now := time.Now()
+				transition.Phase = types2.SchemaTransitionPhaseBackfilled
+				transition.BackfilledAt = &now
+				log.Infof("Backfilled schema transition: name=%s rows=%d", t.Name, transition.BackfilledRows)
+			} else {
+				transition.Cursor = cursor
+				transition.BackfilledRows += int64(n)
+			}
+
+			return tx.Save(&transition).Error
+		}); err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+	}
+}
+
+// ErrSchemaTransitionNotBackfilled is returned when a transition is contracted before all of its data was copied to
+// the new schema.
+var ErrSchemaTransitionNotBackfilled = errors.New("schema transition isn't backfilled yet")
+
+// ContractSchemaTransition copies all the data of a backfilled transition to the new schema again, to catch up with
+// the changes made by instances of the previous release, and then removes the old schema. It must only be called once
+// no instance of the previous release is running.
+func (db *DB) ContractSchemaTransition(ctx context.Context, name string) error {
+	t, ok := transitionByName(name)
+	if !ok {
+		return fmt.Errorf("unknown schema transition %s: %w", name, gorm.ErrRecordNotFound)
+	}
+
+	return db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var transition types.SchemaTransition
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", name).First(&transition).Error; err != nil {
+			return err
+		}
+
+		switch transition.Phase {
+		case types2.SchemaTransitionPhaseContracted:
+			return nil
+		case types2.SchemaTransitionPhaseBackfilled:
+		default:
+			return ErrSchemaTransitionNotBackfilled
+		}
+
+		var cursor string
+		for {
+			next, n, err := t.Backfill(tx, cursor, defaultBackfillBatchSize)
+			if err != nil {
+				return fmt.Errorf("failed to catch up schema transition %s: %w", name, err)
+			}
+			if n == 0 {
+				break
+			}
+			cursor = next
+		}
+
+		if err := t.Contract(tx); err != nil {
+			return fmt.Errorf("failed to contract schema transition %s: %w", name, err)
+		}
+
+		now := time.Now()
+		transition.Phase = types2.SchemaTransitionPhaseContracted
+		transition.ContractedAt = &now
+		if err := tx.Save(&transition).Error; err != nil {
+			return err
+		}
+
+		log.Infof("Contracted schema transition: name=%s", name)
+		return nil
+	})
+}
+
+const defaultBackfillBatchSize = 1000
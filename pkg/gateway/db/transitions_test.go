@@ -0,0 +1,191 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	sservices "github.com/obot-platform/obot/pkg/storage/services"
+	"gorm.io/gorm"
+)
+
+type transitionTestRow struct {
+	ID      uint
+	Value   string
+	ValueV2 string
+}
+
+// previousTransitionTestRow is the row of the previous release, which only has the old column.
+type previousTransitionTestRow struct {
+	ID    uint
+	Value string
+}
+
+func (previousTransitionTestRow) TableName() string {
+	return "transition_test_rows"
+}
+
+// testTransition copies the value column of the transition_test_rows table to value_v2, and then drops value.
+var testTransition = Transition{
+	Name: "transition_test_value_v2",
+	Backfill: func(tx *gorm.DB, cursor string, limit int) (string, int, error) {
+		var after uint64
+		if cursor != "" {
+			var err error
+			if after, err = strconv.ParseUint(cursor, 10, 64); err != nil {
+				return "", 0, err
+			}
+		}
+
+		var rows []transitionTestRow
+		if err := tx.Where("id > ?", after).Order("id").Limit(limit).Find(&rows).Error; err != nil {
+			return "", 0, err
+		}
+		for _, row := range rows {
+			if err := tx.Model(&row).Update("value_v2", row.Value).Error; err != nil {
+				return "", 0, err
+			}
+		}
+		if len(rows) == 0 {
+			return cursor, 0, nil
+		}
+		return strconv.FormatUint(uint64(rows[len(rows)-1].ID), 10), len(rows), nil
+	},
+	Contract: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&transitionTestRow{}, "value") {
+			return nil
+		}
+		return tx.Migrator().DropColumn(&transitionTestRow{}, "value")
+	},
+}
+
+func newTestDB(t *testing.T) (*DB, *gorm.DB) {
+	t.Helper()
+
+	services, err := sservices.New(sservices.Config{
+		DSN: "sqlite://:memory:",
+	})
+	if err != nil {
+		t.Fatalf("failed to create storage services: %v", err)
+	}
+
+	db, err := New(services.DB.DB, services.DB.SQLDB, true)
+	if err != nil {
+		t.Fatalf("failed to create gateway db: %v", err)
+	}
+	return db, services.DB.DB
+}
+
+func setTestTransitions(t *testing.T, ts ...Transition) {
+	t.Helper()
+	previous := transitions
+	transitions = ts
+	t.Cleanup(func() {
+		transitions = previous
+	})
+}
+
+func TestSchemaTransitionOfNewDatabaseIsContracted(t *testing.T) {
+	setTestTransitions(t, testTransition)
+	db, gormDB := newTestDB(t)
+	ctx := context.Background()
+
+	if err := gormDB.AutoMigrate(&transitionTestRow{}); err != nil {
+		t.Fatalf("failed to create test table: %v", err)
+	}
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to auto-migrate: %v", err)
+	}
+
+	contracted, err := db.SchemaTransitionContracted(ctx, testTransition.Name)
+	if err != nil {
+		t.Fatalf("failed to check schema transition: %v", err)
+	}
+	if !contracted {
+		t.Fatal("expected the transition of a new database to be contracted")
+	}
+	if gormDB.Migrator().HasColumn(&transitionTestRow{}, "value") {
+		t.Fatal("expected the old column to be dropped")
+	}
+}
+
+func TestSchemaTransition(t *testing.T) {
+	setTestTransitions(t)
+	db, gormDB := newTestDB(t)
+	ctx := context.Background()
+
+	// The database of the previous release, which only has the old column.
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to auto-migrate: %v", err)
+	}
+	if err := gormDB.AutoMigrate(&previousTransitionTestRow{}); err != nil {
+		t.Fatalf("failed to create test table: %v", err)
+	}
+	for i := range 5 {
+		if err := gormDB.Create(&previousTransitionTestRow{Value: "value" + strconv.Itoa(i)}).Error; err != nil {
+			t.Fatalf("failed to insert row: %v", err)
+		}
+	}
+
+	// The new release expands the schema.
+	setTestTransitions(t, testTransition)
+	if err := gormDB.AutoMigrate(&transitionTestRow{}); err != nil {
+		t.Fatalf("failed to add new column: %v", err)
+	}
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to auto-migrate: %v", err)
+	}
+
+	contracted, err := db.SchemaTransitionContracted(ctx, testTransition.Name)
+	if err != nil {
+		t.Fatalf("failed to check schema transition: %v", err)
+	}
+	if contracted {
+		t.Fatal("expected the transition not to be contracted")
+	}
+
+	if err = db.ContractSchemaTransition(ctx, testTransition.Name); err != ErrSchemaTransitionNotBackfilled {
+		t.Fatalf("expected contracting before backfill to fail, got %v", err)
+	}
+
+	if err = db.BackfillSchemaTransitions(ctx, 2, false); err != nil {
+		t.Fatalf("failed to backfill: %v", err)
+	}
+
+	schemaTransitions, err := db.SchemaTransitions(ctx)
+	if err != nil {
+		t.Fatalf("failed to list schema transitions: %v", err)
+	}
+	if len(schemaTransitions) != 1 || schemaTransitions[0].Phase != types2.SchemaTransitionPhaseBackfilled || schemaTransitions[0].BackfilledRows != 5 || schemaTransitions[0].BackfilledAt == nil {
+		t.Fatalf("expected the transition to be backfilled, got %+v", schemaTransitions)
+	}
+
+	// An instance of the previous release changes a row that was already copied.
+	if err = gormDB.Exec("UPDATE transition_test_rows SET value = ? WHERE id = 1", "changed").Error; err != nil {
+		t.Fatalf("failed to update row: %v", err)
+	}
+
+	if err = db.ContractSchemaTransition(ctx, testTransition.Name); err != nil {
+		t.Fatalf("failed to contract: %v", err)
+	}
+
+	contracted, err = db.SchemaTransitionContracted(ctx, testTransition.Name)
+	if err != nil {
+		t.Fatalf("failed to check schema transition: %v", err)
+	}
+	if !contracted {
+		t.Fatal("expected the transition to be contracted")
+	}
+	if gormDB.Migrator().HasColumn(&transitionTestRow{}, "value") {
+		t.Fatal("expected the old column to be dropped")
+	}
+
+	var value string
+	if err = gormDB.Raw("SELECT value_v2 FROM transition_test_rows WHERE id = 1").Scan(&value).Error; err != nil {
+		t.Fatalf("failed to read row: %v", err)
+	}
+	if value != "changed" {
+		t.Fatalf("expected the change to be copied when contracting, got %q", value)
+	}
+}
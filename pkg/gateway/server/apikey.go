@@ -19,11 +19,13 @@ import (
 )
 
 type createAPIKeyRequest struct {
-	Name            string     `json:"name"`
-	Description     string     `json:"description,omitempty"`
-	ExpiresAt       *time.Time `json:"expiresAt,omitempty"`
-	MCPServerIDs    []string   `json:"mcpServerIds,omitempty"`
-	CanAccessSkills bool       `json:"canAccessSkills"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	MCPServerIDs []string   `json:"mcpServerIds,omitempty"`
+	// MCPServerTools restricts the key to some tools of the MCP servers in MCPServerIDs.
+	MCPServerTools  map[string][]string `json:"mcpServerTools,omitempty"`
+	CanAccessSkills bool                `json:"canAccessSkills"`
 }
 
 // createAPIKey creates an API key for the authenticated user.
@@ -41,6 +43,20 @@ func (s *Server) createAPIKey(apiContext api.Context) error {
 		return types2.NewErrBadRequest("at least one MCP server must be specified or skills access must be enabled")
 	}
 
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return types2.NewErrBadRequest("expiresAt must be in the future")
+	}
+
+	scope := types.APIKey{MCPServerIDs: req.MCPServerIDs}
+	for serverID, tools := range req.MCPServerTools {
+		if !scope.AllowsMCPServer(serverID) {
+			return types2.NewErrBadRequest("tools can only be restricted for the MCP servers of the key, %q isn't one of them", serverID)
+		}
+		if len(tools) == 0 || slices.Contains(tools, "") {
+			return types2.NewErrBadRequest("the tools of MCP server %q must not be empty", serverID)
+		}
+	}
+
 	userID := apiContext.UserID()
 	if userID == 0 {
 		pkgLog.Infof("Rejecting API key creation for unauthenticated request")
@@ -77,11 +93,11 @@ func (s *Server) createAPIKey(apiContext api.Context) error {
 		return types2.NewErrHTTP(http.StatusBadRequest, errors.Join(errs...).Error())
 	}
 
-	response, err := apiContext.GatewayClient.CreateAPIKey(apiContext.Context(), userID, req.Name, req.Description, req.ExpiresAt, req.MCPServerIDs, req.CanAccessSkills)
+	response, err := apiContext.GatewayClient.CreateAPIKey(apiContext.Context(), userID, req.Name, req.Description, req.ExpiresAt, req.MCPServerIDs, req.MCPServerTools, req.CanAccessSkills)
 	if err != nil {
 		return types2.NewErrHTTP(http.StatusInternalServerError, fmt.Sprintf("failed to create API key: %v", err))
 	}
-	pkgLog.Infof("Created API key for user: userID=%d serverScopes=%d", userID, len(req.MCPServerIDs))
+	pkgLog.Infof("Created API key for user: userID=%d serverScopes=%d toolScopes=%d", userID, len(req.MCPServerIDs), len(req.MCPServerTools))
 
 	return apiContext.WriteCreated(response)
 }
@@ -167,6 +183,30 @@ func (s *Server) deleteAPIKey(apiContext api.Context) error {
 	return apiContext.Write(map[string]any{"deleted": true})
 }
 
+// revokeAPIKey revokes an API key of the authenticated user. The key can't be used from then on, but is still listed.
+func (s *Server) revokeAPIKey(apiContext api.Context) error {
+	userID := apiContext.UserID()
+	if userID == 0 {
+		return types2.NewErrHTTP(http.StatusUnauthorized, "user not authenticated")
+	}
+
+	keyID, err := strconv.ParseUint(apiContext.PathValue("id"), 10, 64)
+	if err != nil {
+		return types2.NewErrBadRequest("invalid key ID")
+	}
+
+	key, err := apiContext.GatewayClient.RevokeAPIKey(apiContext.Context(), userID, uint(keyID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types2.NewErrNotFound("API key not found")
+		}
+		return types2.NewErrHTTP(http.StatusInternalServerError, fmt.Sprintf("failed to revoke API key: %v", err))
+	}
+	pkgLog.Infof("Revoked API key for user: userID=%d keyID=%d", userID, keyID)
+
+	return apiContext.Write(key)
+}
+
 // Admin endpoints for managing any user's API keys
 
 // listAllAPIKeys lists all API keys in the system (admin/owner only).
@@ -211,6 +251,25 @@ func (s *Server) deleteAnyAPIKey(apiContext api.Context) error {
 	return apiContext.Write(map[string]any{"deleted": true})
 }
 
+// revokeAnyAPIKey revokes any API key by ID (admin/owner only).
+func (s *Server) revokeAnyAPIKey(apiContext api.Context) error {
+	keyID, err := strconv.ParseUint(apiContext.PathValue("id"), 10, 64)
+	if err != nil {
+		return types2.NewErrBadRequest("invalid key ID")
+	}
+
+	key, err := apiContext.GatewayClient.RevokeAPIKeyByID(apiContext.Context(), uint(keyID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types2.NewErrNotFound("API key not found")
+		}
+		return types2.NewErrHTTP(http.StatusInternalServerError, fmt.Sprintf("failed to revoke API key: %v", err))
+	}
+	pkgLog.Infof("Revoked API key: keyID=%d keyUserID=%d revokedBy=%s", keyID, key.UserID, apiContext.User.GetName())
+
+	return apiContext.Write(key)
+}
+
 // Authentication webhook endpoint
 
 type apiKeyAuthRequest struct {
@@ -277,24 +336,38 @@ func (s *Server) authenticateAPIKey(apiContext api.Context) error {
 		})
 	}
 
-	hasWildcard := slices.Contains(apiKey.MCPServerIDs, "*")
+	var (
+		hasWildcard   = slices.Contains(apiKey.MCPServerIDs, "*")
+		compositeName string
+	)
 	// Tokens have access to all webhook system MCP servers, so we only need to check if the request is not for such an MCP server.
 	if !system.IsWebhookSystemMCPServerID(req.MCPID) {
 		// Check if this server is in the key's allowed list
 		// "*" is a special wildcard that grants access to all servers the user can access
-		if !hasWildcard && !slices.Contains(apiKey.MCPServerIDs, req.MCPID) {
+		if !apiKey.AllowsMCPServer(req.MCPID) {
 			// Check if this is a component server - if so, check the composite server ID
 			var mcpServer v1.MCPServer
-			if err := apiContext.Storage.Get(apiContext.Context(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: req.MCPID}, &mcpServer); err != nil || mcpServer.Spec.CompositeName == "" || !slices.Contains(apiKey.MCPServerIDs, mcpServer.Spec.CompositeName) {
+			if err := apiContext.Storage.Get(apiContext.Context(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: req.MCPID}, &mcpServer); err != nil || !apiKey.AllowsMCPServer(mcpServer.Spec.CompositeName) {
 				pkgLog.Infof("Denied API key auth request: reason=api_key_scope_mismatch keyUserID=%d mcpID=%s", apiKey.UserID, req.MCPID)
 				return apiContext.Write(apiKeyAuthResponse{
 					Allowed: false,
 					Reason:  "API key does not have access to this MCP server",
 				})
 			}
+			compositeName = mcpServer.Spec.CompositeName
 		}
 	}
 
+	// The shim that calls this webhook doesn't know the tool restrictions of the key, so it can't enforce them. Keys that
+	// are restricted to some tools can only be used through the MCP gateway, which does.
+	if len(apiKey.ToolAllowlist(req.MCPID, compositeName)) > 0 {
+		pkgLog.Infof("Denied API key auth request: reason=api_key_tool_restricted keyUserID=%d mcpID=%s", apiKey.UserID, req.MCPID)
+		return apiContext.Write(apiKeyAuthResponse{
+			Allowed: false,
+			Reason:  "API key is restricted to some tools of this MCP server and can only be used through the MCP gateway",
+		})
+	}
+
 	pkgLog.Debugf("Authorized API key request: keyUserID=%d mcpID=%s wildcardScope=%v", apiKey.UserID, req.MCPID, hasWildcard)
 
 	err = apiContext.Write(apiKeyAuthResponse{
@@ -61,6 +61,12 @@ func (a *APIKeyAuthenticator) AuthenticateRequest(req *http.Request) (*authentic
 	extra := map[string][]string{
 		"email":                           {u.Email},
 		types2.APIKeySkillsAccessExtraKey: {fmt.Sprintf("%t", apiKey.CanAccessSkills)},
+		types2.APIKeyMCPServerIDsExtraKey: apiKey.MCPServerIDs,
+	}
+	for serverID, tools := range apiKey.MCPServerTools {
+		for _, tool := range tools {
+			extra[types2.APIKeyToolsExtraKey] = append(extra[types2.APIKeyToolsExtraKey], serverID+"/"+tool)
+		}
 	}
 
 	// Look up auth provider group memberships so that group-based access
@@ -71,7 +77,8 @@ func (a *APIKeyAuthenticator) AuthenticateRequest(req *http.Request) (*authentic
 	}
 
 	// IMPORTANT: API key users only get GroupAPIKey, not the full user groups.
-	// This restricts them to MCP-connect routes and /api/me only.
+	// This restricts them to MCP-connect routes and /api/me only, and the authorizer restricts MCP-connect routes
+	// to the MCP servers of the key.
 	return &authenticator.Response{
 		User: &user.DefaultInfo{
 			Name:   u.Username,
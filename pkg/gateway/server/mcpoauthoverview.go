@@ -0,0 +1,74 @@
+package server
+
+import (
+	"fmt"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// mcpOAuthOverview returns the health of the OAuth tokens that the current user has stored for MCP servers, so that
+// they can authenticate again before tool calls start failing.
+func (s *Server) mcpOAuthOverview(apiContext api.Context) error {
+	items, err := apiContext.GatewayClient.GetMCPOAuthTokenHealth(apiContext.Context(), apiContext.User.GetUID())
+	if err != nil {
+		return err
+	}
+
+	overview := types2.MCPOAuthOverview{
+		Items: items,
+	}
+	for _, item := range items {
+		if item.Status != types2.MCPOAuthTokenStatusHealthy {
+			overview.NeedsAttention++
+		}
+	}
+
+	return apiContext.Write(overview)
+}
+
+// mcpServersNeedingReauthentication lists the MCP servers whose OAuth tokens stored by the current user are expiring
+// soon or have to be replaced. Tokens of servers that no longer exist are left out.
+func (s *Server) mcpServersNeedingReauthentication(apiContext api.Context) error {
+	tokens, err := apiContext.GatewayClient.GetMCPOAuthTokenHealth(apiContext.Context(), apiContext.User.GetUID())
+	if err != nil {
+		return err
+	}
+
+	items := make([]types2.MCPServerReauthentication, 0, len(tokens))
+	for _, token := range tokens {
+		if token.Status == types2.MCPOAuthTokenStatusHealthy {
+			continue
+		}
+
+		item := types2.MCPServerReauthentication{
+			MCPServerID: token.MCPID,
+			ConnectURL:  system.MCPConnectURL(s.baseURL, token.MCPID),
+			Token:       token,
+		}
+		if system.IsMCPServerInstanceID(token.MCPID) {
+			var instance v1.MCPServerInstance
+			if err := apiContext.Get(&instance, token.MCPID); apierrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to get MCP server instance %s: %w", token.MCPID, err)
+			}
+			item.MCPServerInstanceID, item.MCPServerID = instance.Name, instance.Spec.MCPServerName
+		}
+
+		var server v1.MCPServer
+		if err := apiContext.Get(&server, item.MCPServerID); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to get MCP server %s: %w", item.MCPServerID, err)
+		}
+		item.DisplayName = server.Spec.Manifest.Name
+
+		items = append(items, item)
+	}
+
+	return apiContext.Write(types2.MCPServerReauthenticationList{Items: items})
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+// mcpOAuthTelemetryReport returns the outcomes of the OAuth flows and token refreshes of each MCP server in the days
+// overlapping a range, the last 24 hours by default. Servers with the most failures are first.
+func (s *Server) mcpOAuthTelemetryReport(apiContext api.Context) error {
+	query := apiContext.Request.URL.Query()
+	start, end, err := parseDateRange(query.Get("start"), query.Get("end"))
+	if err != nil {
+		return types2.NewErrBadRequest("invalid date range: %v", err)
+	}
+
+	activities, failures, err := apiContext.GatewayClient.GetMCPOAuthTelemetry(apiContext.Context(), client.MCPUsageOptions{
+		UserID: query.Get("user_id"),
+		MCPID:  query.Get("mcp_id"),
+		Start:  start.UTC().Truncate(24 * time.Hour),
+		End:    end,
+	})
+	if err != nil {
+		return err
+	}
+
+	items := make([]types2.MCPOAuthTelemetry, 0, len(activities))
+	for _, a := range activities {
+		a.UserID = query.Get("user_id")
+		items = append(items, types.ConvertMCPOAuthActivity(a, failures[a.MCPID]))
+	}
+
+	return apiContext.Write(types2.MCPOAuthTelemetryReport{
+		Start: *types2.NewTime(start),
+		End:   *types2.NewTime(end),
+		Items: items,
+	})
+}
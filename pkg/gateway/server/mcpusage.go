@@ -0,0 +1,87 @@
+package server
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+const mcpUsageMonthFormat = "2006-01"
+
+// mcpUsageReport returns the tool calls and sampling tokens of each user and MCP server in a month, the current month by default.
+func (s *Server) mcpUsageReport(apiContext api.Context) error {
+	query := apiContext.Request.URL.Query()
+
+	start := client.MonthStart(time.Now())
+	if month := query.Get("month"); month != "" {
+		var err error
+		start, err = time.Parse(mcpUsageMonthFormat, month)
+		if err != nil {
+			return types2.NewErrBadRequest("invalid month %q: must be in YYYY-MM format", month)
+		}
+	}
+
+	opts := client.MCPUsageOptions{
+		UserID: query.Get("user_id"),
+		MCPID:  query.Get("mcp_id"),
+		Start:  start,
+		End:    start.AddDate(0, 1, 0),
+	}
+
+	usage, err := apiContext.GatewayClient.GetMCPUsage(apiContext.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	samplingUsage, err := apiContext.GatewayClient.GetMCPSamplingTokenUsage(apiContext.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	sampling := make(map[[2]string]types.MCPSamplingTokenUsage, len(samplingUsage))
+	for _, u := range samplingUsage {
+		sampling[[2]string{u.UserID, u.MCPID}] = u
+	}
+
+	items := make([]types2.MCPUsage, 0, len(usage))
+	for _, u := range usage {
+		key := [2]string{u.UserID, u.MCPID}
+		items = append(items, types.ConvertMCPUsage(u, sampling[key]))
+		delete(sampling, key)
+	}
+	// Servers can use sampling without any tool calls, such as while handling other requests.
+	for _, u := range samplingUsage {
+		if _, ok := sampling[[2]string{u.UserID, u.MCPID}]; ok {
+			items = append(items, types.ConvertMCPUsage(types.MCPToolUsage{UserID: u.UserID, MCPID: u.MCPID}, u))
+		}
+	}
+
+	projectUsage, err := apiContext.GatewayClient.GetMCPProjectUsage(apiContext.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	projects := make([]types2.MCPProjectUsage, 0, len(projectUsage))
+	for _, u := range projectUsage {
+		projects = append(projects, types.ConvertMCPProjectUsage(u))
+	}
+
+	return apiContext.Write(types2.MCPUsageReport{
+		Month:    start.Format(mcpUsageMonthFormat),
+		Items:    items,
+		Projects: projects,
+	})
+}
+
+func (s *Server) remainingMCPToolCallsForUser(apiContext api.Context) error {
+	userID := apiContext.PathValue("user_id")
+	remaining, err := apiContext.GatewayClient.RemainingMCPToolCallsForUser(apiContext.Context(), userID, s.monthlyUserMCPToolCallLimit, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return apiContext.Write(types.ConvertRemainingMCPToolCalls(userID, remaining))
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+// setOAuthAppClientAuth adds the client credentials to a request to the token endpoint of an OAuth app's provider.
+// The credentials are always in the body, which the OAuth 2.0 RFC doesn't require but some providers do.
+func setOAuthAppClientAuth(req *http.Request, provider types2.OAuthAppProvider, clientID, clientSecret string, refresh bool) {
+	switch provider.ClientAuthMethod {
+	case types2.OAuthAppClientAuthMethodBody:
+	case types2.OAuthAppClientAuthMethodBasicAndBody:
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	default:
+		if !refresh {
+			req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+		}
+	}
+}
+
+// parseOAuthAppTokenResponse parses a response of the token endpoint of an OAuth app's provider in the provider's
+// format, and adds the provider's extras to it.
+func parseOAuthAppTokenResponse(body io.Reader, provider types2.OAuthAppProvider) (*types.OAuthTokenResponse, error) {
+	tokenResp := new(types.OAuthTokenResponse)
+
+	switch provider.TokenResponseFormat {
+	case types2.OAuthAppTokenResponseFormatSlack:
+		slackTokenResp := new(types.SlackOAuthTokenResponse)
+		if err := json.NewDecoder(body).Decode(slackTokenResp); err != nil {
+			return nil, fmt.Errorf("failed to parse token response: %w", err)
+		}
+
+		tokenResp = &types.OAuthTokenResponse{
+			Ok:    slackTokenResp.Ok,
+			Error: slackTokenResp.Error,
+			Data: map[string]string{
+				"slack_app_id":    slackTokenResp.AppID,
+				"slack_team_id":   slackTokenResp.Team.ID,
+				"slack_team_name": slackTokenResp.Team.Name,
+			},
+		}
+
+		// Delegated user permissions are returned in authed_user, while bot permissions are returned at the top level.
+		if slackTokenResp.AuthedUser.AccessToken != "" {
+			tokenResp.AccessToken = slackTokenResp.AuthedUser.AccessToken
+			tokenResp.Scope = slackTokenResp.AuthedUser.Scope
+		} else if slackTokenResp.AccessToken != "" {
+			tokenResp.AccessToken = slackTokenResp.AccessToken
+			tokenResp.Scope = slackTokenResp.Scope
+		}
+	case types2.OAuthAppTokenResponseFormatForm:
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		values, err := url.ParseQuery(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse token response: %w", err)
+		}
+
+		tokenResp = &types.OAuthTokenResponse{
+			TokenType:    values.Get("token_type"),
+			Scope:        values.Get("scope"),
+			AccessToken:  values.Get("access_token"),
+			RefreshToken: values.Get("refresh_token"),
+			Error:        values.Get("error"),
+		}
+		if expiresIn := values.Get("expires_in"); expiresIn != "" {
+			if tokenResp.ExpiresIn, err = strconv.Atoi(expiresIn); err != nil {
+				return nil, fmt.Errorf("failed to parse token response: %w", err)
+			}
+		}
+	case types2.OAuthAppTokenResponseFormatSalesforce:
+		salesforceTokenResp := new(types.SalesforceOAuthTokenResponse)
+		if err := json.NewDecoder(body).Decode(salesforceTokenResp); err != nil {
+			return nil, fmt.Errorf("failed to parse token response: %w", err)
+		}
+		issuedAt, err := strconv.ParseInt(salesforceTokenResp.IssuedAt, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse token response: %w", err)
+		}
+
+		tokenResp = &types.OAuthTokenResponse{
+			TokenType:    salesforceTokenResp.TokenType,
+			Scope:        salesforceTokenResp.Scope,
+			AccessToken:  salesforceTokenResp.AccessToken,
+			ExpiresIn:    7200, // Relies on Salesforce admin not overriding the default 2 hours
+			CreatedAt:    time.UnixMilli(issuedAt),
+			RefreshToken: salesforceTokenResp.RefreshToken,
+			Extras: map[string]string{
+				"GPTSCRIPT_SALESFORCE_URL": salesforceTokenResp.InstanceURL,
+			},
+		}
+	default:
+		if err := json.NewDecoder(body).Decode(tokenResp); err != nil {
+			return nil, fmt.Errorf("failed to parse token response: %w", err)
+		}
+	}
+
+	if provider.TokenResponseFormat != types2.OAuthAppTokenResponseFormatSlack {
+		// Only Slack reports whether the request succeeded, other providers only report errors.
+		tokenResp.Ok = tokenResp.Error == ""
+	}
+
+	if len(provider.Extras) > 0 {
+		if tokenResp.Extras == nil {
+			tokenResp.Extras = map[string]string{}
+		}
+		maps.Copy(tokenResp.Extras, provider.Extras)
+	}
+
+	return tokenResp, nil
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestParseOAuthAppTokenResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider types2.OAuthAppProvider
+		body     string
+		check    func(*testing.T, *types.OAuthTokenResponse)
+	}{
+		{
+			name:     "json",
+			provider: types2.OAuthAppProvider{Extras: map[string]string{"GPTSCRIPT_GITLAB_BASEURL": "https://gitlab.example.com"}},
+			body:     `{"access_token":"at","refresh_token":"rt","expires_in":3600,"token_type":"bearer"}`,
+			check: func(t *testing.T, resp *types.OAuthTokenResponse) {
+				if resp.AccessToken != "at" || resp.RefreshToken != "rt" || resp.ExpiresIn != 3600 || !resp.Ok {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.Extras["GPTSCRIPT_GITLAB_BASEURL"] != "https://gitlab.example.com" {
+					t.Errorf("expected provider extras, got %v", resp.Extras)
+				}
+			},
+		},
+		{
+			name:     "json error",
+			provider: types2.OAuthAppProvider{},
+			body:     `{"error":"invalid_grant"}`,
+			check: func(t *testing.T, resp *types.OAuthTokenResponse) {
+				if resp.Ok || resp.Error != "invalid_grant" {
+					t.Errorf("expected error response, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:     "form",
+			provider: types2.OAuthAppProvider{TokenResponseFormat: types2.OAuthAppTokenResponseFormatForm},
+			body:     "access_token=at&scope=repo&token_type=bearer&expires_in=28800",
+			check: func(t *testing.T, resp *types.OAuthTokenResponse) {
+				if resp.AccessToken != "at" || resp.Scope != "repo" || resp.ExpiresIn != 28800 || !resp.Ok {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:     "slack user token",
+			provider: types2.OAuthAppProvider{TokenResponseFormat: types2.OAuthAppTokenResponseFormatSlack},
+			body:     `{"ok":true,"app_id":"A1","team":{"id":"T1","name":"Team"},"authed_user":{"access_token":"user","scope":"chat:write"},"access_token":"bot","scope":"bot"}`,
+			check: func(t *testing.T, resp *types.OAuthTokenResponse) {
+				if resp.AccessToken != "user" || resp.Scope != "chat:write" || !resp.Ok {
+					t.Errorf("expected user token, got %+v", resp)
+				}
+				if resp.Data["slack_team_id"] != "T1" {
+					t.Errorf("expected Slack team data, got %v", resp.Data)
+				}
+			},
+		},
+		{
+			name:     "slack error",
+			provider: types2.OAuthAppProvider{TokenResponseFormat: types2.OAuthAppTokenResponseFormatSlack},
+			body:     `{"ok":false,"error":"invalid_code"}`,
+			check: func(t *testing.T, resp *types.OAuthTokenResponse) {
+				if resp.Ok || resp.Error != "invalid_code" {
+					t.Errorf("expected error response, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:     "salesforce",
+			provider: types2.OAuthAppProvider{TokenResponseFormat: types2.OAuthAppTokenResponseFormatSalesforce},
+			body:     `{"access_token":"at","instance_url":"https://example.my.salesforce.com","issued_at":"1700000000123","token_type":"Bearer"}`,
+			check: func(t *testing.T, resp *types.OAuthTokenResponse) {
+				if resp.AccessToken != "at" || resp.ExpiresIn != 7200 || !resp.Ok {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if !resp.CreatedAt.Equal(time.UnixMilli(1700000000123)) {
+					t.Errorf("expected creation time from issued_at, got %v", resp.CreatedAt)
+				}
+				if resp.Extras["GPTSCRIPT_SALESFORCE_URL"] != "https://example.my.salesforce.com" {
+					t.Errorf("expected instance URL in extras, got %v", resp.Extras)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := parseOAuthAppTokenResponse(strings.NewReader(tt.body), tt.provider)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, resp)
+		})
+	}
+}
+
+func TestSetOAuthAppClientAuth(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    types2.OAuthAppClientAuthMethod
+		refresh   bool
+		wantBasic bool
+	}{
+		{name: "default code exchange", wantBasic: true},
+		{name: "default refresh", refresh: true},
+		{name: "body code exchange", method: types2.OAuthAppClientAuthMethodBody},
+		{name: "basic and body refresh", method: types2.OAuthAppClientAuthMethodBasicAndBody, refresh: true, wantBasic: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "https://provider.example.com/token", nil)
+			setOAuthAppClientAuth(req, types2.OAuthAppProvider{ClientAuthMethod: tt.method}, "client", "secret", tt.refresh)
+
+			user, pass, ok := req.BasicAuth()
+			if ok != tt.wantBasic {
+				t.Fatalf("expected basic auth %v, got %v", tt.wantBasic, ok)
+			}
+			if ok && (user != "client" || pass != "secret") {
+				t.Errorf("unexpected basic auth credentials: %s:%s", user, pass)
+			}
+		})
+	}
+}
+
+func TestOAuthAppProviderFor(t *testing.T) {
+	// Apps that set their provider quirks use them instead of the ones of their type.
+	custom := types2.OAuthAppProvider{TokenResponseFormat: types2.OAuthAppTokenResponseFormatForm}
+	if got := types.OAuthAppProviderFor(types2.OAuthAppManifest{Type: types2.OAuthAppTypeSlack, Provider: &custom}); got.TokenResponseFormat != types2.OAuthAppTokenResponseFormatForm || got.OmitEmptyScope {
+		t.Errorf("expected the app's provider quirks, got %+v", got)
+	}
+
+	if got := types.OAuthAppProviderFor(types2.OAuthAppManifest{Type: types2.OAuthAppTypeHubSpot, OptionalScope: "crm.objects.contacts.read"}); got.AuthorizeParams["optional_scope"] != "crm.objects.contacts.read" || got.TokenParams["optional_scope"] != "crm.objects.contacts.read" {
+		t.Errorf("expected HubSpot optional scope params, got %+v", got)
+	}
+
+	if got := types.OAuthAppProviderFor(types2.OAuthAppManifest{Type: types2.OAuthAppTypeCustom}); got.TokenResponseFormat != "" || len(got.AuthorizeParams) > 0 {
+		t.Errorf("expected no quirks for custom apps, got %+v", got)
+	}
+}
@@ -4,15 +4,11 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
-	"encoding/base64"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 	"time"
 
@@ -76,6 +72,16 @@ func (s *Server) oAuthCleanup(ctx context.Context) {
 					}
 				}
 			}
+
+			// Delete revocations of OAuth tokens that have expired.
+			if err := s.db.WithContext(ctx).Where("expires_at <= ?", time.Now()).Delete(&types.RevokedOAuthToken{}).Error; err != nil {
+				logger.Debugf("failed to delete expired token revocations: error=%v", err)
+			}
+
+			// Delete records of rotated OAuth refresh tokens that are no longer checked for reuse.
+			if err := s.db.WithContext(ctx).Where("expires_at <= ?", time.Now()).Delete(&types.RotatedOAuthRefreshToken{}).Error; err != nil {
+				logger.Debugf("failed to delete expired rotated refresh tokens: error=%v", err)
+			}
 		}
 
 		t.Reset(cleanupTick)
@@ -298,40 +304,17 @@ func (s *Server) authorizeOAuthApp(apiContext api.Context) error {
 	q.Set("redirect_uri", app.RedirectURL(s.baseURL))
 	q.Set("state", state)
 
-	// HubSpot supports setting optional scopes in this query param so that we can support an app that is able to have broad permissions,
-	// while at the same time only granting specific stuff.
-	if app.Spec.Manifest.Type == types2.OAuthAppTypeHubSpot {
-		q.Set("optional_scope", app.Spec.Manifest.OptionalScope)
-	}
-
-	// Atlassian requires the audience and prompt parameters to be set.
-	// See https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#1--direct-the-user-to-the-authorization-url-to-get-an-authorization-code
-	// for details.
-	if app.Spec.Manifest.Type == types2.OAuthAppTypeAtlassian {
-		q.Set("audience", "api.atlassian.com")
-		q.Set("prompt", "consent")
-	}
-
-	// For Google: access_type=offline instructs Google to return a refresh token and an access token on the initial authorization.
-	// This can be used to refresh the access token when a user is not present at the browser
-	// prompt=consent instructs Google to show the consent screen every time the authorization flow happens so that we get a new refresh token.
-	if app.Spec.Manifest.Type == types2.OAuthAppTypeGoogle {
-		q.Set("access_type", "offline")
-		q.Set("prompt", "consent")
+	provider := types.OAuthAppProviderFor(app.Spec.Manifest)
+	if scope != "" || !provider.OmitEmptyScope {
+		q.Set("scope", scope)
 	}
-
-	// Slack is annoying and makes us call this query parameter user_scope instead of scope.
-	// user_scope is used for delegated user permissions (which is what we want), while just scope is used for bot permissions.
-	if app.Spec.Manifest.Type == types2.OAuthAppTypeSlack {
-		if scope != "" {
-			q.Set("scope", scope)
+	for _, param := range provider.PassthroughAuthorizeParams {
+		if value := apiContext.URL.Query().Get(param); value != "" {
+			q.Set(param, value)
 		}
-		userScope := apiContext.URL.Query().Get("user_scope")
-		if userScope != "" {
-			q.Set("user_scope", userScope)
-		}
-	} else {
-		q.Set("scope", scope)
+	}
+	for param, value := range provider.AuthorizeParams {
+		q.Set(param, value)
 	}
 
 	u.RawQuery = q.Encode()
@@ -376,14 +359,15 @@ func (s *Server) refreshOAuthApp(apiContext api.Context) error {
 		clientSecret = cred.Env["CLIENT_SECRET"]
 	}
 
+	provider := types.OAuthAppProviderFor(app.Spec.Manifest)
+
 	data := url.Values{}
 	data.Set("client_id", app.Spec.Manifest.ClientID)
 	data.Set("client_secret", clientSecret)
-	if app.Spec.Manifest.Type != types2.OAuthAppTypeSalesforce && app.Spec.Manifest.Type != types2.OAuthAppTypeSmartThings {
-		data.Set("scope", scope)
-	}
-	if app.Spec.Manifest.Type != types2.OAuthAppTypeSmartThings {
-		data.Set("redirect_uri", app.RedirectURL(s.baseURL))
+	data.Set("scope", scope)
+	data.Set("redirect_uri", app.RedirectURL(s.baseURL))
+	for _, param := range provider.RefreshOmitParams {
+		data.Del(param)
 	}
 	data.Set("refresh_token", refreshToken)
 	data.Set("grant_type", "refresh_token")
@@ -393,10 +377,7 @@ func (s *Server) refreshOAuthApp(apiContext api.Context) error {
 		return fmt.Errorf("failed to make token request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	if app.Spec.Manifest.Type == types2.OAuthAppTypeSmartThings {
-		encodedAuth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", app.Spec.Manifest.ClientID, clientSecret)))
-		req.Header.Set("Authorization", fmt.Sprintf("Basic %s", encodedAuth))
-	}
+	setOAuthAppClientAuth(req, provider, app.Spec.Manifest.ClientID, clientSecret, true)
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to make token request: %w", err)
@@ -409,54 +390,13 @@ func (s *Server) refreshOAuthApp(apiContext api.Context) error {
 		return fmt.Errorf("failed to get tokens: %d %s", resp.StatusCode, bodyBuf.String())
 	}
 
-	tokenResp := new(types.OAuthTokenResponse)
-
-	switch app.Spec.Manifest.Type {
-	case types2.OAuthAppTypeSalesforce:
-		salesforceTokenResp := new(types.SalesforceOAuthTokenResponse)
-		if err := json.NewDecoder(resp.Body).Decode(salesforceTokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-		issuedAt, err := strconv.ParseInt(salesforceTokenResp.IssuedAt, 10, 64)
-		if err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-		createdAt := time.Unix(issuedAt/1000, (issuedAt%1000)*1000000)
-
-		tokenResp = &types.OAuthTokenResponse{
-			TokenType:    salesforceTokenResp.TokenType,
-			AccessToken:  salesforceTokenResp.AccessToken,
-			ExpiresIn:    7200, // Relies on Salesforce admin not overriding the default 2 hours
-			Ok:           true, // Assuming true if no error is present
-			CreatedAt:    createdAt,
-			RefreshToken: refreshToken,
-			Extras: map[string]string{
-				"GPTSCRIPT_SALESFORCE_URL": salesforceTokenResp.InstanceURL,
-			},
-		}
-	case types2.OAuthAppTypeGoogle:
-		if err := json.NewDecoder(resp.Body).Decode(tokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-		tokenResp.RefreshToken = refreshToken
-	case types2.OAuthAppTypeGitLab:
-		// For GitLab, decode the standard token response and then add the base URL to extras
-		if err := json.NewDecoder(resp.Body).Decode(tokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
+	tokenResp, err := parseOAuthAppTokenResponse(resp.Body, provider)
+	if err != nil {
+		return err
+	}
+	if tokenResp.RefreshToken == "" {
+		// Providers that don't rotate refresh tokens don't return them, and the same one is used again.
 		tokenResp.RefreshToken = refreshToken
-
-		// Add GitLab base URL to extras if it's a custom instance
-		if app.Spec.Manifest.GitLabBaseURL != "" {
-			if tokenResp.Extras == nil {
-				tokenResp.Extras = map[string]string{}
-			}
-			tokenResp.Extras["GPTSCRIPT_GITLAB_BASEURL"] = app.Spec.Manifest.GitLabBaseURL
-		}
-	default:
-		if err := json.NewDecoder(resp.Body).Decode(tokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
 	}
 	logger.Infof("Refreshed OAuth app token: appID=%s hasRefreshToken=%v", app.Name, tokenResp.RefreshToken != "")
 
@@ -516,8 +456,9 @@ func (s *Server) callbackOAuthApp(apiContext api.Context) error {
 	data.Set("redirect_uri", app.RedirectURL(s.baseURL))
 	data.Set("grant_type", "authorization_code")
 
-	if app.Spec.Manifest.Type == types2.OAuthAppTypeHubSpot {
-		data.Set("optional_scope", app.Spec.Manifest.OptionalScope)
+	provider := types.OAuthAppProviderFor(app.Spec.Manifest)
+	for param, value := range provider.TokenParams {
+		data.Set(param, value)
 	}
 
 	req, err := http.NewRequest("POST", app.Spec.Manifest.TokenURL, bytes.NewBufferString(data.Encode()))
@@ -525,10 +466,7 @@ func (s *Server) callbackOAuthApp(apiContext api.Context) error {
 		return fmt.Errorf("failed to create token request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	if app.Spec.Manifest.Type != types2.OAuthAppTypeGoogle &&
-		app.Spec.Manifest.Type != types2.OAuthAppTypePagerDuty {
-		req.SetBasicAuth(url.QueryEscape(app.Spec.Manifest.ClientID), url.QueryEscape(clientSecret))
-	}
+	setOAuthAppClientAuth(req, provider, app.Spec.Manifest.ClientID, clientSecret, false)
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -543,116 +481,12 @@ func (s *Server) callbackOAuthApp(apiContext api.Context) error {
 	}
 
 	// Get the response and save it to the db so that the cred tool can acquire it.
-	// Once again, Slack and GitHub are annoying and do their own thing.
-	tokenResp := new(types.OAuthTokenResponse)
-	switch app.Spec.Manifest.Type {
-	case types2.OAuthAppTypeSlack:
-		slackTokenResp := new(types.SlackOAuthTokenResponse)
-		if err := json.NewDecoder(resp.Body).Decode(slackTokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-
-		tokenResp = &types.OAuthTokenResponse{
-			State:     state,
-			Ok:        slackTokenResp.Ok,
-			Error:     slackTokenResp.Error,
-			CreatedAt: time.Now(),
-			Data: map[string]string{
-				"slack_app_id":    slackTokenResp.AppID,
-				"slack_team_id":   slackTokenResp.Team.ID,
-				"slack_team_name": slackTokenResp.Team.Name,
-			},
-		}
-
-		if slackTokenResp.AuthedUser.AccessToken != "" {
-			tokenResp.AccessToken = slackTokenResp.AuthedUser.AccessToken
-			tokenResp.Scope = slackTokenResp.AuthedUser.Scope
-		} else if slackTokenResp.AccessToken != "" {
-			tokenResp.AccessToken = slackTokenResp.AccessToken
-			tokenResp.Scope = slackTokenResp.Scope
-		}
-	case types2.OAuthAppTypeGitHub:
-		// Read the response body
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Errorf("failed to read response body: %w", err)
-		}
-
-		// Parse the URL-encoded body
-		values, err := url.ParseQuery(string(body))
-		if err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-
-		// Map the parsed values to the struct
-		tokenResp = &types.OAuthTokenResponse{
-			State:       state,
-			TokenType:   values.Get("token_type"),
-			Scope:       values.Get("scope"),
-			AccessToken: values.Get("access_token"),
-			Ok:          true, // Assuming true if no error is present
-			CreatedAt:   time.Now(),
-		}
-	case types2.OAuthAppTypeGoogle:
-		googleTokenResp := new(types.GoogleOAuthTokenResponse)
-		if err := json.NewDecoder(resp.Body).Decode(googleTokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-
-		tokenResp = &types.OAuthTokenResponse{
-			State:        state,
-			TokenType:    googleTokenResp.TokenType,
-			Scope:        googleTokenResp.Scope,
-			AccessToken:  googleTokenResp.AccessToken,
-			ExpiresIn:    googleTokenResp.ExpiresIn,
-			Ok:           true, // Assuming true if no error is present
-			CreatedAt:    time.Now(),
-			RefreshToken: googleTokenResp.RefreshToken,
-		}
-	case types2.OAuthAppTypeSalesforce:
-		salesforceTokenResp := new(types.SalesforceOAuthTokenResponse)
-		if err := json.NewDecoder(resp.Body).Decode(salesforceTokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-		issuedAt, err := strconv.ParseInt(salesforceTokenResp.IssuedAt, 10, 64)
-		if err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-		createdAt := time.Unix(issuedAt/1000, (issuedAt%1000)*1000000)
-
-		tokenResp = &types.OAuthTokenResponse{
-			State:        state,
-			TokenType:    salesforceTokenResp.TokenType,
-			Scope:        salesforceTokenResp.Scope,
-			AccessToken:  salesforceTokenResp.AccessToken,
-			ExpiresIn:    7200, // Relies on Salesforce admin not overriding the default 2 hours
-			Ok:           true, // Assuming true if no error is present
-			CreatedAt:    createdAt,
-			RefreshToken: salesforceTokenResp.RefreshToken,
-			Extras: map[string]string{
-				"GPTSCRIPT_SALESFORCE_URL": salesforceTokenResp.InstanceURL,
-			},
-		}
-	case types2.OAuthAppTypeGitLab:
-		// For GitLab, decode the standard token response and then add the base URL to extras
-		if err := json.NewDecoder(resp.Body).Decode(tokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-		tokenResp.State = state
-		tokenResp.CreatedAt = time.Now()
-
-		// Add GitLab base URL to extras if it's a custom instance
-		if app.Spec.Manifest.GitLabBaseURL != "" {
-			if tokenResp.Extras == nil {
-				tokenResp.Extras = map[string]string{}
-			}
-			tokenResp.Extras["GPTSCRIPT_GITLAB_BASEURL"] = app.Spec.Manifest.GitLabBaseURL
-		}
-	default:
-		if err := json.NewDecoder(resp.Body).Decode(tokenResp); err != nil {
-			return fmt.Errorf("failed to parse token response: %w", err)
-		}
-		tokenResp.State = state
+	tokenResp, err := parseOAuthAppTokenResponse(resp.Body, provider)
+	if err != nil {
+		return err
+	}
+	tokenResp.State = state
+	if tokenResp.CreatedAt.IsZero() {
 		tokenResp.CreatedAt = time.Now()
 	}
 
@@ -28,6 +28,8 @@ func (s *Server) AddRoutes(mux *server.Server) {
 	mux.HandleFunc("GET /api/me", wrap(s.getCurrentUser))
 	mux.HandleFunc("DELETE /api/me", wrap(s.deleteUser))
 	mux.HandleFunc("PATCH /api/me", wrap(s.updateUser))
+	mux.HandleFunc("GET /api/me/mcp-oauth-overview", wrap(s.mcpOAuthOverview))
+	mux.HandleFunc("GET /api/me/mcp-servers-needing-reauthentication", wrap(s.mcpServersNeedingReauthentication))
 	mux.HandleFunc("POST /api/logout-all", wrap(s.logoutAll))
 	mux.HandleFunc("GET /api/users", wrap(s.getUsers))
 	mux.HandleFunc("GET /api/groups", wrap(s.listAuthGroups))
@@ -42,6 +44,7 @@ func (s *Server) AddRoutes(mux *server.Server) {
 	mux.HandleFunc("GET /api/users/{user_id}/token-usage", wrap(s.usageForUser))
 	mux.HandleFunc("GET /api/users/{user_id}/total-token-usage", wrap(s.totalUsageForUser))
 	mux.HandleFunc("GET /api/users/{user_id}/remaining-token-usage", wrap(s.remainingUsageForUser))
+	mux.HandleFunc("GET /api/users/{user_id}/remaining-mcp-tool-calls", wrap(s.remainingMCPToolCallsForUser))
 	mux.HandleFunc("PATCH /api/users/{user_id}", wrap(s.updateUser))
 	mux.HandleFunc("POST /api/users/{user_id}/internal", wrap(s.markUserInternal))
 	mux.HandleFunc("POST /api/users/{user_id}/external", wrap(s.markUserExternal))
@@ -50,6 +53,8 @@ func (s *Server) AddRoutes(mux *server.Server) {
 
 	mux.HandleFunc("GET /api/token-usage", wrap(s.systemTokenUsageByUser))
 	mux.HandleFunc("GET /api/total-token-usage", wrap(s.totalSystemTokenUsage))
+	mux.HandleFunc("GET /api/mcp-usage", wrap(s.mcpUsageReport))
+	mux.HandleFunc("GET /api/mcp-oauth-telemetry", wrap(s.mcpOAuthTelemetryReport))
 
 	mux.HandleFunc("POST /api/token-request", s.tokenRequest)
 	mux.HandleFunc("GET /api/token-request/{id}", s.checkForToken)
@@ -91,11 +96,13 @@ func (s *Server) AddRoutes(mux *server.Server) {
 	mux.HandleFunc("GET /api/api-keys", wrap(s.listAPIKeys))
 	mux.HandleFunc("GET /api/api-keys/{id}", wrap(s.getAPIKey))
 	mux.HandleFunc("DELETE /api/api-keys/{id}", wrap(s.deleteAPIKey))
+	mux.HandleFunc("POST /api/api-keys/{id}/revoke", wrap(s.revokeAPIKey))
 
 	// API Keys admin endpoints - for managing any user's keys (admin/owner only)
 	mux.HandleFunc("GET /api/admin-api-keys", wrap(s.listAllAPIKeys))
 	mux.HandleFunc("GET /api/admin-api-keys/{id}", wrap(s.getAnyAPIKey))
 	mux.HandleFunc("DELETE /api/admin-api-keys/{id}", wrap(s.deleteAnyAPIKey))
+	mux.HandleFunc("POST /api/admin-api-keys/{id}/revoke", wrap(s.revokeAnyAPIKey))
 
 	// API Key authentication webhook (called by nanobot shim)
 	// This endpoint is unauthenticated - it validates the API key passed in the header
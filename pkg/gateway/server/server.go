@@ -17,6 +17,7 @@ type Options struct {
 
 	DailyUserPromptTokenLimit     int `usage:"The maximum number of daily user prompt/input token to allow, <= 0 disables the limit" default:"10000000"`     // default is 10 million
 	DailyUserCompletionTokenLimit int `usage:"The maximum number of daily user completion/output tokens to allow, <= 0 disables the limit" default:"100000"` // default is 100 thousand
+	MonthlyUserMCPToolCallLimit   int `usage:"The maximum number of MCP tool calls to allow per user per month, <= 0 disables the limit" default:"0"`
 }
 
 type Server struct {
@@ -29,6 +30,7 @@ type Server struct {
 	messagePolicyHelper                *messagepolicy.Helper
 	dailyUserTokenPromptTokenLimit     int
 	dailyUserTokenCompletionTokenLimit int
+	monthlyUserMCPToolCallLimit        int
 }
 
 func New(ctx context.Context, db *db.DB, tokenService *persistent.TokenService, modelProviderDispatcher *dispatcher.Dispatcher, acrHelper *accesscontrolrule.Helper, mapHelper *modelaccesspolicy.Helper, messagePolicyHelper *messagepolicy.Helper, opts Options) (*Server, error) {
@@ -43,6 +45,7 @@ func New(ctx context.Context, db *db.DB, tokenService *persistent.TokenService,
 		messagePolicyHelper:                messagePolicyHelper,
 		dailyUserTokenPromptTokenLimit:     opts.DailyUserPromptTokenLimit,
 		dailyUserTokenCompletionTokenLimit: opts.DailyUserCompletionTokenLimit,
+		monthlyUserMCPToolCallLimit:        opts.MonthlyUserMCPToolCallLimit,
 	}
 
 	go s.autoCleanupTokens(ctx)
@@ -2,6 +2,7 @@
 package types
 
 import (
+	"slices"
 	"time"
 )
 
@@ -19,12 +20,41 @@ type APIKey struct {
 	CreatedAt       time.Time  `json:"createdAt"`
 	LastUsedAt      *time.Time `json:"lastUsedAt,omitempty"`
 	ExpiresAt       *time.Time `json:"expiresAt,omitempty"` // nil means no expiration
+	RevokedAt       *time.Time `json:"revokedAt,omitempty"` // Revoked keys are kept so that their use stays attributable
 
 	// MCPServerIDs contains Kubernetes resource names of MCPServers this key can access.
 	// Supports all server types: single-user, multi-user, remote, and composite.
 	// Use "*" as a wildcard to grant access to all servers the user can access.
 	// This may be empty for skills-only API keys.
 	MCPServerIDs []string `json:"mcpServerIds,omitempty" gorm:"serializer:json"`
+
+	// MCPServerTools restricts the key to some tools of the MCP servers, keyed by MCP server ID. The tools are names or
+	// patterns like those of the tool allowlist of an MCP server instance. All tools of the servers that aren't in the
+	// map can be called.
+	MCPServerTools map[string][]string `json:"mcpServerTools,omitempty" gorm:"serializer:json"`
+}
+
+// AllowsMCPServer returns whether the key can access the MCP server with any of the IDs. Pass the IDs that the server
+// is known by, such as the ID of its composite server.
+func (k APIKey) AllowsMCPServer(ids ...string) bool {
+	// "*" grants access to all servers the user can access.
+	if slices.Contains(k.MCPServerIDs, "*") {
+		return true
+	}
+	return slices.ContainsFunc(ids, func(id string) bool {
+		return id != "" && slices.Contains(k.MCPServerIDs, id)
+	})
+}
+
+// ToolAllowlist returns the tools of the MCP server with any of the IDs that the key can call. It is empty if the key
+// can call all of them.
+func (k APIKey) ToolAllowlist(ids ...string) []string {
+	for _, id := range ids {
+		if tools := k.MCPServerTools[id]; len(tools) > 0 {
+			return tools
+		}
+	}
+	return nil
 }
 
 // APIKeyCreateResponse is returned when creating an API key.
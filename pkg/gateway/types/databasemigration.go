@@ -0,0 +1,24 @@
+package types
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+// DatabaseMigration records a startup that changed the schema or data of the database, and the changes that it made.
+type DatabaseMigration struct {
+	ID        uint      `gorm:"primaryKey"`
+	AppliedAt time.Time `gorm:"index"`
+	Version   string
+	Changes   []types2.DatabaseChange `gorm:"serializer:json"`
+}
+
+func ConvertDatabaseMigration(m DatabaseMigration) types2.DatabaseMigration {
+	return types2.DatabaseMigration{
+		ID:        m.ID,
+		AppliedAt: *types2.NewTime(m.AppliedAt),
+		Version:   m.Version,
+		Changes:   m.Changes,
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+// KeyRotation is a job that re-encrypts the stored secrets with the current write key of the encryption provider. Admins
+// request it through the API, and the leader runs it and records its progress, so that every replica reports it and a
+// new leader resumes it.
+type KeyRotation struct {
+	ID          uint                    `gorm:"primaryKey"`
+	State       types2.KeyRotationState `gorm:"index"`
+	StartedBy   string
+	StartedAt   time.Time
+	CompletedAt *time.Time
+	Resources   []types2.KeyRotationResource `gorm:"serializer:json"`
+	Error       string
+}
+
+func ConvertKeyRotation(r KeyRotation) types2.KeyRotation {
+	return types2.KeyRotation{
+		State:       r.State,
+		StartedBy:   r.StartedBy,
+		StartedAt:   types2.NewTime(r.StartedAt),
+		CompletedAt: types2.NewTimeFromPointer(r.CompletedAt),
+		Resources:   slices.Clone(r.Resources),
+		Error:       r.Error,
+	}
+}
@@ -34,6 +34,10 @@ type MCPAuditLog struct {
 	Error                     string                                `json:"error,omitempty"`
 	ProcessingTimeMs          int64                                 `json:"processingTimeMs" gorm:"index"`
 	SessionID                 string                                `json:"sessionID,omitempty" gorm:"index"`
+	RunID                     string                                `json:"runID,omitempty" gorm:"index"`
+	ProjectID                 string                                `json:"projectID,omitempty" gorm:"index"`
+	EstimatedCostUSD          float64                               `json:"estimatedCostUSD,omitempty"`
+	EstimatedCredits          float64                               `json:"estimatedCredits,omitempty"`
 	WebhookStatuses           datatypes.JSONSlice[MCPWebhookStatus] `json:"webhookStatuses,omitempty"`
 
 	// Additional metadata
@@ -102,6 +106,47 @@ type MCPPromptReadStats struct {
 	ReadCount  int64  `json:"readCount"`
 }
 
+// MCPToolCallDailyStat is the number of calls of a tool of an MCP server on a day
+type MCPToolCallDailyStat struct {
+	Date                 string
+	MCPID                string
+	MCPServerDisplayName string
+	ToolName             string
+	CallCount            int64
+	ErrorCount           int64
+}
+
+// MCPErrorRateStat is the number of calls and errors of an MCP server
+type MCPErrorRateStat struct {
+	MCPID                string
+	MCPServerDisplayName string
+	CallCount            int64
+	ErrorCount           int64
+}
+
+// MCPLatencyStat is the number of calls and their average processing time of an MCP server
+type MCPLatencyStat struct {
+	MCPID                   string
+	MCPServerDisplayName    string
+	CallCount               int64
+	AverageProcessingTimeMs float64
+}
+
+// MCPUserCallStat is the number of calls of a user and the number of MCP servers they called
+type MCPUserCallStat struct {
+	UserID      string
+	CallCount   int64
+	ServerCount int64
+}
+
+// MCPUserHourlyCallStat is the number of calls of a user in an hour
+type MCPUserHourlyCallStat struct {
+	// Hour is the UTC hour of the calls, formatted as 2006-01-02 15.
+	Hour      string
+	UserID    string
+	CallCount int64
+}
+
 // ConvertMCPAuditLog converts internal MCPAuditLog to API type
 func ConvertMCPAuditLog(a MCPAuditLog) types2.MCPAuditLog {
 	webhookStatus := make([]types2.WebhookStatus, len(a.WebhookStatuses))
@@ -143,6 +188,10 @@ func ConvertMCPAuditLog(a MCPAuditLog) types2.MCPAuditLog {
 		WebhookStatuses:      webhookStatus,
 		ProcessingTimeMs:     a.ProcessingTimeMs,
 		SessionID:            a.SessionID,
+		RunID:                a.RunID,
+		ProjectID:            a.ProjectID,
+		EstimatedCostUSD:     a.EstimatedCostUSD,
+		EstimatedCredits:     a.EstimatedCredits,
 		RequestID:            a.RequestID,
 		UserAgent:            a.UserAgent,
 		RequestHeaders:       a.RequestHeaders,
@@ -197,3 +246,26 @@ func ConvertMCPUsageStats(s MCPUsageStatItem) types2.MCPUsageStatItem {
 		PromptReads:               promptReads,
 	}
 }
+
+// ConvertMCPToolCallDailyStat converts internal MCPToolCallDailyStat to API type
+func ConvertMCPToolCallDailyStat(s MCPToolCallDailyStat) types2.MCPToolCallDailyStat {
+	return types2.MCPToolCallDailyStat{
+		Date:                 s.Date,
+		MCPID:                s.MCPID,
+		MCPServerDisplayName: s.MCPServerDisplayName,
+		ToolName:             s.ToolName,
+		CallCount:            s.CallCount,
+		ErrorCount:           s.ErrorCount,
+	}
+}
+
+// ConvertMCPErrorRateStat converts internal MCPErrorRateStat to API type
+func ConvertMCPErrorRateStat(s MCPErrorRateStat) types2.MCPErrorRateStat {
+	return types2.MCPErrorRateStat{
+		MCPID:                s.MCPID,
+		MCPServerDisplayName: s.MCPServerDisplayName,
+		CallCount:            s.CallCount,
+		ErrorCount:           s.ErrorCount,
+		ErrorRate:            types2.ErrorRate(s.ErrorCount, s.CallCount),
+	}
+}
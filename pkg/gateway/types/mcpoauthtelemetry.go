@@ -0,0 +1,96 @@
+package types
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+// MCPOAuthEventType is an outcome of the OAuth flow between a user and an MCP server.
+type MCPOAuthEventType string
+
+const (
+	// MCPOAuthEventInitiated is recorded when a user is sent to the authorization server.
+	MCPOAuthEventInitiated MCPOAuthEventType = "initiated"
+	// MCPOAuthEventCompleted is recorded when the authorization code is exchanged for a token.
+	MCPOAuthEventCompleted MCPOAuthEventType = "completed"
+	// MCPOAuthEventFailed is recorded when the authorization server returns an error or the code can't be exchanged.
+	MCPOAuthEventFailed MCPOAuthEventType = "failed"
+	// MCPOAuthEventRefreshed is recorded when an expired token is refreshed.
+	MCPOAuthEventRefreshed MCPOAuthEventType = "refreshed"
+	// MCPOAuthEventRefreshFailed is recorded when an expired token that has a refresh token is discarded because the
+	// MCP server requires the user to authorize again.
+	MCPOAuthEventRefreshFailed MCPOAuthEventType = "refreshFailed"
+	// MCPOAuthEventRejected is recorded when a token that hasn't expired is discarded because the MCP server rejected it.
+	MCPOAuthEventRejected MCPOAuthEventType = "rejected"
+)
+
+// MCPOAuthEvent is an outcome of the OAuth flow between a user and an MCP server.
+type MCPOAuthEvent struct {
+	Time   time.Time
+	Type   MCPOAuthEventType
+	UserID string
+	MCPID  string
+	// FailureCategory and FailureCode describe why a flow or refresh failed.
+	FailureCategory types2.MCPOAuthFailureCategory
+	FailureCode     string
+	Error           string
+	// ExchangeDuration is how long the authorization server took to exchange the code, if it was exchanged.
+	ExchangeDuration time.Duration
+}
+
+// MCPOAuthActivity is the number of each OAuth outcome between a user and an MCP server on a day.
+type MCPOAuthActivity struct {
+	// Day is the start of the day, in UTC.
+	Day             time.Time `gorm:"primaryKey"`
+	UserID          string    `gorm:"primaryKey"`
+	MCPID           string    `gorm:"primaryKey"`
+	Initiated       int64
+	Completed       int64
+	Failed          int64
+	Refreshed       int64
+	RefreshFailed   int64
+	Rejected        int64
+	Exchanges       int64
+	TotalExchangeMs int64
+}
+
+// MCPOAuthFailure is the number of OAuth failures of a category between a user and an MCP server on a day, with the
+// last error message.
+type MCPOAuthFailure struct {
+	Day         time.Time `gorm:"primaryKey"`
+	UserID      string    `gorm:"primaryKey"`
+	MCPID       string    `gorm:"primaryKey"`
+	Category    string    `gorm:"primaryKey"`
+	Code        string    `gorm:"primaryKey"`
+	Count       int64
+	LastError   string
+	LastErrorAt time.Time
+}
+
+func ConvertMCPOAuthActivity(a MCPOAuthActivity, failures []MCPOAuthFailure) types2.MCPOAuthTelemetry {
+	result := types2.MCPOAuthTelemetry{
+		MCPID:         a.MCPID,
+		UserID:        a.UserID,
+		Initiated:     a.Initiated,
+		Completed:     a.Completed,
+		Failed:        a.Failed,
+		Refreshed:     a.Refreshed,
+		RefreshFailed: a.RefreshFailed,
+		Rejected:      a.Rejected,
+		Failures:      make([]types2.MCPOAuthFailure, 0, len(failures)),
+	}
+	if a.Exchanges > 0 {
+		result.AverageExchangeMs = float64(a.TotalExchangeMs) / float64(a.Exchanges)
+	}
+	for _, f := range failures {
+		result.Failures = append(result.Failures, types2.MCPOAuthFailure{
+			Category:    types2.MCPOAuthFailureCategory(f.Category),
+			Code:        f.Code,
+			Count:       f.Count,
+			LastError:   f.LastError,
+			LastErrorAt: types2.NewTime(f.LastErrorAt),
+		})
+	}
+	return result
+}
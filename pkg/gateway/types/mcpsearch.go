@@ -0,0 +1,33 @@
+package types
+
+const (
+	MCPSearchKindCatalogEntry = "catalog-entry"
+	MCPSearchKindServer       = "server"
+)
+
+// MCPSearchDocument is an MCP catalog entry or server in the search index.
+type MCPSearchDocument struct {
+	// Kind is the kind of the indexed object, such as catalog-entry or server.
+	Kind string `gorm:"primaryKey"`
+	Name string `gorm:"primaryKey"`
+	// Hash is the hash of the indexed text, so that unchanged objects aren't indexed again.
+	Hash string
+	// Length is the number of terms in the document.
+	Length int
+}
+
+// MCPSearchTerm is the weight of a term in an indexed MCP catalog entry or server.
+type MCPSearchTerm struct {
+	ID   uint   `gorm:"primaryKey"`
+	Kind string `gorm:"index:idx_mcp_search_terms_document;index:idx_mcp_search_terms_term"`
+	Name string `gorm:"index:idx_mcp_search_terms_document"`
+	Term string `gorm:"index:idx_mcp_search_terms_term"`
+	// Weight is the number of times the term occurs in the document, weighted by the fields it occurs in.
+	Weight float64
+}
+
+// MCPSearchResult is an indexed MCP catalog entry or server that matches a search, and its relevance.
+type MCPSearchResult struct {
+	Name  string
+	Score float64
+}
@@ -0,0 +1,15 @@
+package types
+
+import "time"
+
+// MCPSessionState is the state of a session between Obot and an MCP server, persisted so that the session can be
+// resumed after Obot restarts.
+type MCPSessionState struct {
+	// ClientID identifies the client of the session. It is derived from the MCP server's configuration and the
+	// client scope, so a reconfigured server never matches the state of a session with its previous configuration.
+	ClientID      string `gorm:"primaryKey"`
+	MCPServerName string `gorm:"index"`
+	// State is the JSON-encoded session ID, negotiated capabilities, and attributes of the session.
+	State     []byte
+	UpdatedAt time.Time
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+// MCPToolUsage is the number and duration of the tool calls that a user made to an MCP server on a day.
+// It is kept separately from the audit logs so that usage reports don't depend on the audit log retention.
+type MCPToolUsage struct {
+	// Day is the start of the day, in UTC.
+	Day                  time.Time `gorm:"primaryKey"`
+	UserID               string    `gorm:"primaryKey"`
+	MCPID                string    `gorm:"primaryKey"`
+	MCPServerDisplayName string
+	ToolCalls            int64
+	ErrorCount           int64
+	TotalDurationMs      int64
+	EstimatedCostUSD     float64
+	EstimatedCredits     float64
+}
+
+// MCPProjectUsage is the estimated cost of the MCP tool calls made by the agent runs of a project.
+type MCPProjectUsage struct {
+	ProjectID        string
+	ToolCalls        int64
+	EstimatedCostUSD float64
+	EstimatedCredits float64
+}
+
+// MCPSamplingTokenUsage is the number of tokens used by the sampling requests of an MCP server on behalf of a user.
+type MCPSamplingTokenUsage struct {
+	UserID           string
+	MCPID            string
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// RemainingMCPToolCalls is the number of tool calls that a user can still make this month.
+type RemainingMCPToolCalls struct {
+	Limit     int64
+	ToolCalls int64
+	Unlimited bool
+}
+
+func ConvertMCPUsage(u MCPToolUsage, sampling MCPSamplingTokenUsage) types2.MCPUsage {
+	result := types2.MCPUsage{
+		UserID:                   u.UserID,
+		MCPID:                    u.MCPID,
+		MCPServerDisplayName:     u.MCPServerDisplayName,
+		ToolCalls:                u.ToolCalls,
+		ErrorCount:               u.ErrorCount,
+		TotalDurationMs:          u.TotalDurationMs,
+		SamplingPromptTokens:     sampling.PromptTokens,
+		SamplingCompletionTokens: sampling.CompletionTokens,
+		EstimatedCostUSD:         u.EstimatedCostUSD,
+		EstimatedCredits:         u.EstimatedCredits,
+	}
+	if u.ToolCalls > 0 {
+		result.AverageDurationMs = float64(u.TotalDurationMs) / float64(u.ToolCalls)
+	}
+	return result
+}
+
+func ConvertRemainingMCPToolCalls(userID string, r RemainingMCPToolCalls) types2.RemainingMCPToolCalls {
+	result := types2.RemainingMCPToolCalls{
+		UserID:    userID,
+		ToolCalls: r.ToolCalls,
+		Unlimited: r.Unlimited,
+	}
+	if !r.Unlimited {
+		result.Limit = r.Limit
+		result.Remaining = max(r.Limit-r.ToolCalls, 0)
+	}
+	return result
+}
+
+func ConvertMCPProjectUsage(u MCPProjectUsage) types2.MCPProjectUsage {
+	return types2.MCPProjectUsage{
+		ProjectID:        u.ProjectID,
+		ToolCalls:        u.ToolCalls,
+		EstimatedCostUSD: u.EstimatedCostUSD,
+		EstimatedCredits: u.EstimatedCredits,
+	}
+}
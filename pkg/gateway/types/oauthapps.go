@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"slices"
 	"time"
 
 	"github.com/obot-platform/obot/apiclient/types"
@@ -178,6 +179,12 @@ func ValidateAndSetDefaultsOAuthAppManifest(r *types.OAuthAppManifest, create bo
 		}
 	}
 
+	if r.Provider != nil {
+		if err := validateOAuthAppProvider(*r.Provider); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	// Users are allowed to create OAuth Apps without specifying the fields that they would get from the provider.
 	// Things like client ID, client secret, app ID, tenant ID, etc.
 	// They will then have to update the Oauth App to add these fields.
@@ -196,6 +203,88 @@ func ValidateAndSetDefaultsOAuthAppManifest(r *types.OAuthAppManifest, create bo
 	return errors.Join(errs...)
 }
 
+func validateOAuthAppProvider(p types.OAuthAppProvider) error {
+	var errs []error
+	switch p.TokenResponseFormat {
+	case "", types.OAuthAppTokenResponseFormatJSON, types.OAuthAppTokenResponseFormatForm, types.OAuthAppTokenResponseFormatSlack, types.OAuthAppTokenResponseFormatSalesforce:
+	default:
+		errs = append(errs, fmt.Errorf("invalid provider tokenResponseFormat: %s", p.TokenResponseFormat))
+	}
+	switch p.ClientAuthMethod {
+	case types.OAuthAppClientAuthMethodDefault, types.OAuthAppClientAuthMethodBody, types.OAuthAppClientAuthMethodBasicAndBody:
+	default:
+		errs = append(errs, fmt.Errorf("invalid provider clientAuthMethod: %s", p.ClientAuthMethod))
+	}
+	for _, param := range p.RefreshOmitParams {
+		if !slices.Contains([]string{"scope", "redirect_uri"}, param) {
+			errs = append(errs, fmt.Errorf("invalid provider refreshOmitParams, only scope and redirect_uri can be omitted: %s", param))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// OAuthAppProviderFor returns the quirks of the app's provider: the ones set on the app, or else the ones of the
+// provider of its type.
+func OAuthAppProviderFor(r types.OAuthAppManifest) types.OAuthAppProvider {
+	if r.Provider != nil {
+		return *r.Provider
+	}
+
+	switch r.Type {
+	case types.OAuthAppTypeAtlassian:
+		// See https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#1--direct-the-user-to-the-authorization-url-to-get-an-authorization-code
+		return types.OAuthAppProvider{
+			AuthorizeParams: map[string]string{"audience": "api.atlassian.com", "prompt": "consent"},
+		}
+	case types.OAuthAppTypeHubSpot:
+		// HubSpot supports optional scopes, so that an app can have broad permissions while only granting specific ones.
+		return types.OAuthAppProvider{
+			AuthorizeParams: map[string]string{"optional_scope": r.OptionalScope},
+			TokenParams:     map[string]string{"optional_scope": r.OptionalScope},
+		}
+	case types.OAuthAppTypeGoogle:
+		// access_type=offline returns a refresh token, and prompt=consent shows the consent screen every time, so that a
+		// new refresh token is returned every time.
+		return types.OAuthAppProvider{
+			AuthorizeParams:  map[string]string{"access_type": "offline", "prompt": "consent"},
+			ClientAuthMethod: types.OAuthAppClientAuthMethodBody,
+		}
+	case types.OAuthAppTypeSlack:
+		// user_scope requests delegated user permissions, while scope requests bot permissions.
+		return types.OAuthAppProvider{
+			PassthroughAuthorizeParams: []string{"user_scope"},
+			OmitEmptyScope:             true,
+			TokenResponseFormat:        types.OAuthAppTokenResponseFormatSlack,
+		}
+	case types.OAuthAppTypeGitHub:
+		return types.OAuthAppProvider{
+			TokenResponseFormat: types.OAuthAppTokenResponseFormatForm,
+		}
+	case types.OAuthAppTypeSalesforce:
+		return types.OAuthAppProvider{
+			RefreshOmitParams:   []string{"scope"},
+			TokenResponseFormat: types.OAuthAppTokenResponseFormatSalesforce,
+		}
+	case types.OAuthAppTypePagerDuty:
+		return types.OAuthAppProvider{
+			ClientAuthMethod: types.OAuthAppClientAuthMethodBody,
+		}
+	case types.OAuthAppTypeSmartThings:
+		return types.OAuthAppProvider{
+			RefreshOmitParams: []string{"scope", "redirect_uri"},
+			ClientAuthMethod:  types.OAuthAppClientAuthMethodBasicAndBody,
+		}
+	case types.OAuthAppTypeGitLab:
+		if r.GitLabBaseURL != "" {
+			return types.OAuthAppProvider{
+				Extras: map[string]string{"GPTSCRIPT_GITLAB_BASEURL": r.GitLabBaseURL},
+			}
+		}
+	}
+
+	return types.OAuthAppProvider{}
+}
+
 func MergeOAuthAppManifests(r, other types.OAuthAppManifest) types.OAuthAppManifest {
 	retVal := r
 
@@ -237,6 +326,9 @@ func MergeOAuthAppManifests(r, other types.OAuthAppManifest) types.OAuthAppManif
 	if other.InstanceURL != "" {
 		retVal.InstanceURL = other.InstanceURL
 	}
+	if other.Provider != nil {
+		retVal.Provider = other.Provider
+	}
 	if other.GitLabBaseURL != "" {
 		retVal.GitLabBaseURL = other.GitLabBaseURL
 		//Will reset during validation
@@ -264,14 +356,6 @@ type OAuthTokenResponse struct {
 	Data         map[string]string `json:"data" gorm:"serializer:json"`
 }
 
-type GoogleOAuthTokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	ExpiresIn    int    `json:"expires_in"`
-	RefreshToken string `json:"refresh_token"`
-	Scope        string `json:"scope"`
-	TokenType    string `json:"token_type"`
-}
-
 type SalesforceOAuthTokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	Signature    string `json:"signature"`
@@ -0,0 +1,10 @@
+package types
+
+import "time"
+
+// RevokedOAuthToken is an access token, or a grant of access and refresh tokens, that was revoked before it expired.
+// ID is either the token's ID or the grant's ID. It is kept until every token that it revokes has expired.
+type RevokedOAuthToken struct {
+	ID        string    `gorm:"primaryKey"`
+	ExpiresAt time.Time `gorm:"index"`
+}
@@ -0,0 +1,16 @@
+package types
+
+import "time"
+
+// RotatedOAuthRefreshToken is a refresh token that was exchanged for a new one. Hash is the SHA-256 hash of the token.
+// It is kept until ExpiresAt, so that a client that uses the token again is detected, which is a sign that the token
+// was stolen.
+type RotatedOAuthRefreshToken struct {
+	Hash      string `gorm:"primaryKey"`
+	GrantID   string `gorm:"index"`
+	ClientID  string
+	UserID    uint
+	MCPID     string
+	RotatedAt time.Time
+	ExpiresAt time.Time `gorm:"index"`
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+// SchemaTransition is the progress of an expand/contract migration of the database. Cursor is where the backfill of
+// the new schema continues from, and BackfilledRows is the number of rows that it copied.
+type SchemaTransition struct {
+	Name           string `gorm:"primaryKey"`
+	Phase          types2.SchemaTransitionPhase
+	Cursor         string
+	BackfilledRows int64
+	StartedAt      time.Time
+	BackfilledAt   *time.Time
+	ContractedAt   *time.Time
+}
+
+func ConvertSchemaTransition(t SchemaTransition) types2.SchemaTransition {
+	return types2.SchemaTransition{
+		Name:           t.Name,
+		Phase:          t.Phase,
+		BackfilledRows: t.BackfilledRows,
+		StartedAt:      *types2.NewTime(t.StartedAt),
+		BackfilledAt:   types2.NewTimeFromPointer(t.BackfilledAt),
+		ContractedAt:   types2.NewTimeFromPointer(t.ContractedAt),
+	}
+}
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -39,6 +41,9 @@ type MCPOAuthToken struct {
 	ClientSecret string
 	RedirectURL  string
 	Scopes       string
+	// GrantedScopes are the scopes that the authorization server reported granting, if it did. Otherwise, the
+	// requested Scopes are assumed to be granted.
+	GrantedScopes string
 
 	MCPID              string `gorm:"primaryKey"`
 	UserID             string `gorm:"primaryKey"`
@@ -50,9 +55,40 @@ type MCPOAuthToken struct {
 	Expiry             time.Time
 	ExpiresIn          int64
 
+	// HealthCheckedAt is when the authorization server last confirmed whether the token is valid, and HealthError is
+	// why it reported that the token isn't valid, if it did.
+	HealthCheckedAt time.Time
+	HealthError     string
+
 	Encrypted bool
 }
 
+// GrantedScopeList returns the scopes that the token was granted.
+func (t MCPOAuthToken) GrantedScopeList() []string {
+	granted := t.GrantedScopes
+	if granted == "" {
+		granted = t.Scopes
+	}
+
+	// Some authorization servers, such as GitHub, separate scopes with commas.
+	return strings.FieldsFunc(granted, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+}
+
+// MissingScopes returns the scopes that the token wasn't granted.
+func (t MCPOAuthToken) MissingScopes(scopes []string) []string {
+	granted := t.GrantedScopeList()
+
+	var missing []string
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
 type MCPOAuthPendingState struct {
 	HashedState        string `gorm:"primaryKey"`
 	State              string
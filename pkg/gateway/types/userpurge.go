@@ -0,0 +1,8 @@
+package types
+
+// UserPurgeCounts counts the records of a user that a purge deleted or anonymized in the database, by the kind of
+// record.
+type UserPurgeCounts struct {
+	Deleted    map[string]int
+	Anonymized map[string]int
+}
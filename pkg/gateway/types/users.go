@@ -31,7 +31,10 @@ type User struct {
 	Internal                   bool      `json:"internal" gorm:"default:false"`
 	DailyPromptTokensLimit     int       `json:"dailyPromptTokensLimit"`
 	DailyCompletionTokensLimit int       `json:"dailyCompletionTokensLimit"`
-	Encrypted                  bool      `json:"encrypted"`
+	// MonthlyMCPToolCallLimit overrides the default limit of MCP tool calls per month, if it is set and not zero.
+	// A negative limit is unlimited.
+	MonthlyMCPToolCallLimit *int `json:"monthlyMCPToolCallLimit,omitempty"`
+	Encrypted               bool `json:"encrypted"`
 	// Soft delete fields
 	DeletedAt        *time.Time `json:"deletedAt,omitempty"`
 	OriginalEmail    string     `json:"-"`
@@ -72,6 +75,7 @@ func ConvertUserWithEffectiveRole(u *User, roleFixed bool, authProviderName stri
 		Internal:                   u.Internal,
 		DailyPromptTokensLimit:     u.DailyPromptTokensLimit,
 		DailyCompletionTokensLimit: u.DailyCompletionTokensLimit,
+		MonthlyMCPToolCallLimit:    u.MonthlyMCPToolCallLimit,
 		OriginalEmail:              u.OriginalEmail,
 		OriginalUsername:           u.OriginalUsername,
 	}
@@ -0,0 +1,304 @@
+package persistent
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/system"
+)
+
+const (
+	// keyEnvVar holds the key that tokens are currently signed with, which is the only key that older releases read.
+	keyEnvVar = "JWK_KEY"
+	// keysEnvVar holds all the signing keys, including the retired ones that still verify tokens.
+	keysEnvVar = "JWK_KEYS"
+	// legacyKID is the ID of the single key of older releases, which didn't rotate keys.
+	legacyKID = "obot"
+
+	// retiredKeyGracePeriod is how long a rotated key still verifies tokens. It is longer than the lifetime of the
+	// longest-lived tokens, which is a day, so that no token is invalidated by a rotation.
+	retiredKeyGracePeriod = 48 * time.Hour
+	// keyReloadInterval is how often the keys are read again, so that every replica picks up keys rotated by another.
+	keyReloadInterval = 5 * time.Minute
+	// unknownKIDReloadInterval limits how often a token signed with an unknown key causes the keys to be read again.
+	unknownKIDReloadInterval = 10 * time.Second
+	// keyRotationCheckInterval is how often the leader checks whether the signing key is due for rotation.
+	keyRotationCheckInterval = time.Hour
+)
+
+// signingKey is a key that tokens are signed with. Keys are rotated by adding a new one, which signs tokens from then
+// on, and retiring the previous one, which still verifies the tokens that it signed until RetiresAt.
+type signingKey struct {
+	KID       string             `json:"kid"`
+	Key       ed25519.PrivateKey `json:"key"`
+	CreatedAt time.Time          `json:"createdAt"`
+	RetiresAt *time.Time         `json:"retiresAt,omitempty"`
+}
+
+// verifies returns whether the key still verifies tokens at now.
+func (k signingKey) verifies(now time.Time) bool {
+	return k.RetiresAt == nil || now.Before(*k.RetiresAt)
+}
+
+func newSigningKey(now time.Time) (signingKey, error) {
+	_, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	return signingKey{
+		KID:       strings.ToLower(rand.Text()),
+		Key:       key,
+		CreatedAt: now,
+	}, nil
+}
+
+// currentSigningKey returns the newest key that isn't retired, which is the one that tokens are signed with.
+func currentSigningKey(keys []signingKey) (signingKey, bool) {
+	for _, key := range slices.Backward(keys) {
+		if key.RetiresAt == nil {
+			return key, true
+		}
+	}
+	return signingKey{}, false
+}
+
+// pruneKeys removes the retired keys that no longer verify tokens.
+func pruneKeys(keys []signingKey, now time.Time) []signingKey {
+	return slices.DeleteFunc(slices.Clone(keys), func(key signingKey) bool {
+		return !key.verifies(now)
+	})
+}
+
+// rotateKeys retires the current signing key and adds a new one.
+func rotateKeys(keys []signingKey, now time.Time) ([]signingKey, error) {
+	newKey, err := newSigningKey(now)
+	if err != nil {
+		return nil, err
+	}
+
+	keys = pruneKeys(keys, now)
+	retiresAt := now.Add(retiredKeyGracePeriod)
+	for i := range keys {
+		if keys[i].RetiresAt == nil {
+			keys[i].RetiresAt = &retiresAt
+		}
+	}
+
+	return append(keys, newKey), nil
+}
+
+// publicJWKS returns the JSON Web Key Set of the public keys that verify tokens.
+func publicJWKS(ctx context.Context, keys []signingKey, now time.Time) (json.RawMessage, error) {
+	jwkSet := jwkset.NewMemoryStorage()
+	for _, key := range keys {
+		if !key.verifies(now) {
+			continue
+		}
+
+		jwk, err := jwkset.NewJWKFromKey(key.Key, jwkset.JWKOptions{
+			Metadata: jwkset.JWKMetadataOptions{
+				KID: key.KID,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := jwkSet.KeyWrite(ctx, jwk); err != nil {
+			return nil, err
+		}
+	}
+
+	return jwkSet.JSONPublic(ctx)
+}
+
+// readKeys reads the signing keys from the credential. The single key of older releases is read as the legacy key.
+func (t *TokenService) readKeys(ctx context.Context) ([]signingKey, error) {
+	cred, err := t.credOnlyGPTClient.RevealCredential(ctx, []string{system.JWKCredentialContext}, system.JWKCredentialContext)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if keysData := cred.Env[keysEnvVar]; keysData != "" {
+		var keys []signingKey
+		if err := json.Unmarshal([]byte(keysData), &keys); err != nil {
+			return nil, fmt.Errorf("failed to decode JWKs: %w", err)
+		}
+		return keys, nil
+	}
+
+	if keyData := cred.Env[keyEnvVar]; keyData != "" {
+		key, err := base64.StdEncoding.DecodeString(keyData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode JWK: %w", err)
+		}
+		return []signingKey{{
+			KID: legacyKID,
+			Key: key,
+		}}, nil
+	}
+
+	return nil, nil
+}
+
+// writeKeys stores the signing keys in the credential. The current signing key is also stored on its own, so that an
+// older release that is rolled back to keeps signing tokens with it.
+func (t *TokenService) writeKeys(ctx context.Context, keys []signingKey) error {
+	current, ok := currentSigningKey(keys)
+	if !ok {
+		return fmt.Errorf("no signing key to store")
+	}
+
+	keysData, err := json.Marshal(keys)
+	if err != nil {
+		return fmt.Errorf("failed to encode JWKs: %w", err)
+	}
+
+	return t.credOnlyGPTClient.CreateCredential(ctx, gptscript.Credential{
+		Context:  system.JWKCredentialContext,
+		ToolName: system.JWKCredentialContext,
+		Type:     gptscript.CredentialTypeTool,
+		Env: map[string]string{
+			keyEnvVar:  base64.StdEncoding.EncodeToString(current.Key),
+			keysEnvVar: string(keysData),
+		},
+	})
+}
+
+// loadKeys reads the signing keys from the credential and uses them to sign and verify tokens.
+func (t *TokenService) loadKeys(ctx context.Context) error {
+	keys, err := t.readKeys(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := currentSigningKey(keys); !ok {
+		return fmt.Errorf("JWK not found in credential")
+	}
+
+	now := time.Now()
+	jwks, err := publicJWKS(ctx, keys, now)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.keys = keys
+	t.jwks = jwks
+	t.keysLoadedAt = now
+
+	return nil
+}
+
+// signingKeys returns the signing keys, and reads them again if they were loaded more than maxAge ago. If they can't be
+// read again, then the keys that were loaded before are returned.
+func (t *TokenService) signingKeys(ctx context.Context, maxAge time.Duration) ([]signingKey, json.RawMessage, error) {
+	t.lock.RLock()
+	keys, jwks, loadedAt := t.keys, t.jwks, t.keysLoadedAt
+	t.lock.RUnlock()
+
+	if keys != nil && time.Since(loadedAt) < maxAge {
+		return keys, jwks, nil
+	}
+
+	if err := t.loadKeys(ctx); err != nil {
+		if keys == nil {
+			return nil, nil, err
+		}
+		log.Warnf("failed to reload JWKs, using the ones loaded at %s: %v", loadedAt.Format(time.RFC3339), err)
+		return keys, jwks, nil
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.keys, t.jwks, nil
+}
+
+// RotateJWK signs tokens with a new key from now on. The tokens signed with the previous key remain valid until they
+// expire.
+func (t *TokenService) RotateJWK(req api.Context) error {
+	if err := t.rotateKey(req.Context()); err != nil {
+		return fmt.Errorf("failed to rotate key: %w", err)
+	}
+	return nil
+}
+
+func (t *TokenService) rotateKey(ctx context.Context) error {
+	keys, err := t.readKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	if keys, err = rotateKeys(keys, time.Now()); err != nil {
+		return err
+	}
+	if err = t.writeKeys(ctx, keys); err != nil {
+		return err
+	}
+
+	current, _ := currentSigningKey(keys)
+	log.Infof("Rotated JWK: kid=%s", current.KID)
+
+	return t.loadKeys(ctx)
+}
+
+// RunKeyRotation rotates the signing key once it is older than period, and removes the retired keys that no longer
+// verify tokens. It should only be run by the leader, so that keys aren't rotated by several replicas at once.
+func (t *TokenService) RunKeyRotation(ctx context.Context, period time.Duration) {
+	if period <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(keyRotationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.reconcileKeyRotation(ctx, period); err != nil {
+			log.Errorf("failed to rotate JWK: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *TokenService) reconcileKeyRotation(ctx context.Context, period time.Duration) error {
+	keys, err := t.readKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, ok := currentSigningKey(keys)
+	if !ok {
+		// EnsureJWK hasn't created the key yet.
+		return nil
+	}
+
+	now := time.Now()
+	if now.Sub(current.CreatedAt) >= period {
+		return t.rotateKey(ctx)
+	}
+
+	if pruned := pruneKeys(keys, now); len(pruned) != len(keys) {
+		return t.writeKeys(ctx, pruned)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package persistent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testServerURL = "https://obot.example.com"
+
+func newTestTokenService(t *testing.T, keys []signingKey) *TokenService {
+	t.Helper()
+
+	jwks, err := publicJWKS(context.Background(), keys, time.Now())
+	if err != nil {
+		t.Fatalf("failed to build JWKS: %v", err)
+	}
+
+	return &TokenService{
+		serverURL:    testServerURL,
+		keys:         keys,
+		jwks:         jwks,
+		keysLoadedAt: time.Now(),
+	}
+}
+
+func newTestToken(t *testing.T, ts *TokenService) string {
+	t.Helper()
+
+	now := time.Now()
+	_, token, err := ts.NewTokenWithClaims(context.Background(), jwt.MapClaims{
+		"aud": testServerURL,
+		"exp": float64(now.Add(time.Hour).Unix()),
+		"iat": float64(now.Unix()),
+		"sub": "1",
+	})
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestRotateKeys(t *testing.T) {
+	now := time.Now()
+	original, err := newSigningKey(now.Add(-100 * 24 * time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create key: %v", err)
+	}
+	expired := now.Add(-time.Minute)
+	retired, err := newSigningKey(now.Add(-200 * 24 * time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create key: %v", err)
+	}
+	retired.RetiresAt = &expired
+
+	keys, err := rotateKeys([]signingKey{retired, original}, now)
+	if err != nil {
+		t.Fatalf("failed to rotate keys: %v", err)
+	}
+
+	if len(keys) != 2 {
+		t.Fatalf("expected the expired key to be pruned, got %d keys", len(keys))
+	}
+	if keys[0].KID != original.KID || keys[0].RetiresAt == nil || !keys[0].RetiresAt.Equal(now.Add(retiredKeyGracePeriod)) {
+		t.Fatalf("expected the previous key to be retired after the grace period, got %+v", keys[0])
+	}
+	if current, ok := currentSigningKey(keys); !ok || current.KID != keys[1].KID || current.KID == original.KID {
+		t.Fatalf("expected the new key to sign tokens, got %+v", current)
+	}
+}
+
+func TestDecodeTokenAfterRotation(t *testing.T) {
+	ctx := context.Background()
+	original, err := newSigningKey(time.Now())
+	if err != nil {
+		t.Fatalf("failed to create key: %v", err)
+	}
+
+	ts := newTestTokenService(t, []signingKey{original})
+	oldToken := newTestToken(t, ts)
+
+	keys, err := rotateKeys([]signingKey{original}, time.Now())
+	if err != nil {
+		t.Fatalf("failed to rotate keys: %v", err)
+	}
+	ts = newTestTokenService(t, keys)
+	newToken := newTestToken(t, ts)
+
+	for name, token := range map[string]string{"old": oldToken, "new": newToken} {
+		if _, err = ts.DecodeToken(ctx, token); err != nil {
+			t.Fatalf("expected the %s token to be valid, got %v", name, err)
+		}
+	}
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	if current, _ := currentSigningKey(keys); parsed.Header["kid"] != current.KID {
+		t.Fatalf("expected the token to name the current key %s, got %v", current.KID, parsed.Header["kid"])
+	}
+
+	var jwks struct {
+		Keys []struct {
+			KID string `json:"kid"`
+		} `json:"keys"`
+	}
+	if err = json.Unmarshal(ts.jwks, &jwks); err != nil {
+		t.Fatalf("failed to decode JWKS: %v", err)
+	}
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("expected the JWKS to publish both keys, got %+v", jwks.Keys)
+	}
+
+	// Once the previous key retires, the tokens that it signed are no longer valid.
+	expired := time.Now().Add(-time.Minute)
+	keys[0].RetiresAt = &expired
+	ts = newTestTokenService(t, keys)
+	if _, err = ts.DecodeToken(ctx, oldToken); err == nil {
+		t.Fatal("expected the token of the retired key to be invalid")
+	}
+	if _, err = ts.DecodeToken(ctx, newToken); err != nil {
+		t.Fatalf("expected the new token to be valid, got %v", err)
+	}
+}
+
+func TestDecodeLegacyToken(t *testing.T) {
+	legacy, err := newSigningKey(time.Now())
+	if err != nil {
+		t.Fatalf("failed to create key: %v", err)
+	}
+	legacy.KID = legacyKID
+
+	// Tokens of older releases don't name their key.
+	token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"iss": testServerURL,
+		"aud": testServerURL,
+		"exp": float64(time.Now().Add(time.Hour).Unix()),
+		"sub": "1",
+	}).SignedString(legacy.Key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	keys, err := rotateKeys([]signingKey{legacy}, time.Now())
+	if err != nil {
+		t.Fatalf("failed to rotate keys: %v", err)
+	}
+	if _, err = newTestTokenService(t, keys).DecodeToken(context.Background(), token); err != nil {
+		t.Fatalf("expected the legacy token to be valid, got %v", err)
+	}
+}
@@ -2,10 +2,7 @@ package persistent
 
 import (
 	"context"
-	"crypto/ed25519"
-	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -14,13 +11,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/MicahParks/jwkset"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/api"
 	"github.com/obot-platform/obot/pkg/gateway/client"
-	"github.com/obot-platform/obot/pkg/system"
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 	"k8s.io/apiserver/pkg/authentication/user"
 )
@@ -29,8 +25,9 @@ var log = logger.Package()
 
 type TokenService struct {
 	lock              sync.RWMutex
-	privateKey        ed25519.PrivateKey
+	keys              []signingKey
 	jwks              json.RawMessage
+	keysLoadedAt      time.Time
 	gatewayClient     *client.Client
 	credOnlyGPTClient *gptscript.GPTScript
 	serverURL         string
@@ -50,88 +47,56 @@ type TokenType string
 const (
 	TokenTypeRun      TokenType = "run"
 	TokenTypeWorkflow TokenType = "workflow"
+	// TokenTypeClient tokens are issued to OAuth clients with the client_credentials grant, and don't belong to a user.
+	TokenTypeClient TokenType = "client"
+	// TokenTypeID tokens are OpenID Connect ID tokens. They identify a user to an OAuth client, and can't be used as
+	// access tokens.
+	TokenTypeID TokenType = "id"
 )
 
-// EnsureJWK ensures that the JWK is created and stored in the GPTScript client. It should only be called in a controller post-start hook which only allows one to be run at a time.
+// EnsureJWK ensures that the signing keys are created and stored in the GPTScript client. The single key of older
+// releases is kept, and tokens are signed with it until it is rotated. It should only be called in a controller
+// post-start hook which only allows one to be run at a time.
 func (t *TokenService) EnsureJWK(ctx context.Context) error {
-	// Read the credential, if it exists, then use it.
-	cred, err := t.credOnlyGPTClient.RevealCredential(ctx, []string{system.JWKCredentialContext}, system.JWKCredentialContext)
-	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+	keys, err := t.readKeys(ctx)
+	if err != nil {
 		return err
 	}
 
-	var configuredKey ed25519.PrivateKey
-	if keyData := cred.Env[keyEnvVar]; keyData != "" {
-		configuredKey, err = base64.StdEncoding.DecodeString(keyData)
-		if err != nil {
-			return err
-		}
-	} else {
+	now := time.Now()
+	keys = pruneKeys(keys, now)
+	if _, ok := currentSigningKey(keys); !ok {
 		// Create a key.
-		_, configuredKey, err = ed25519.GenerateKey(nil)
+		key, err := newSigningKey(now)
 		if err != nil {
 			return err
 		}
+		keys = append(keys, key)
 	}
-
-	// Write the key to the JWK Set storage.
-	if err := t.credOnlyGPTClient.CreateCredential(ctx, gptscript.Credential{
-		Context:  system.JWKCredentialContext,
-		ToolName: system.JWKCredentialContext,
-		Type:     gptscript.CredentialTypeTool,
-		Env: map[string]string{
-			keyEnvVar: base64.StdEncoding.EncodeToString(configuredKey),
-		},
-	}); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// SetJWK sets the JWK in the GPTScript client. It should be called after the JWK is created and stored in the GPTScript client.
-func (t *TokenService) setJWK(ctx context.Context) error {
-	cred, err := t.credOnlyGPTClient.RevealCredential(ctx, []string{system.JWKCredentialContext}, system.JWKCredentialContext)
-	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
-		return err
-	}
-
-	value, ok := cred.Env[keyEnvVar]
-	if !ok || value == "" {
-		return fmt.Errorf("JWK not found in credential")
-	}
-
-	key, err := base64.StdEncoding.DecodeString(value)
-	if err != nil {
-		return fmt.Errorf("failed to decode JWK: %w", err)
-	}
-
-	if err := t.replaceKey(ctx, key); err != nil {
-		return err
+	for i := range keys {
+		if keys[i].CreatedAt.IsZero() {
+			// The key of older releases doesn't know when it was created, so its rotation period starts now.
+			keys[i].CreatedAt = now
+		}
 	}
 
-	return nil
+	// Write the keys to the JWK Set storage.
+	return t.writeKeys(ctx, keys)
 }
 
+// ReplaceJWK replaces all the signing keys with a new one, which invalidates every token. Use RotateJWK to change the
+// signing key without invalidating tokens.
 func (t *TokenService) ReplaceJWK(req api.Context) error {
-	// Create a key.
-	_, newKey, err := ed25519.GenerateKey(nil)
+	key, err := newSigningKey(time.Now())
 	if err != nil {
-		return fmt.Errorf("failed to generate key: %w", err)
+		return err
 	}
 
-	if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
-		Context:  system.JWKCredentialContext,
-		ToolName: system.JWKCredentialContext,
-		Type:     gptscript.CredentialTypeTool,
-		Env: map[string]string{
-			keyEnvVar: base64.StdEncoding.EncodeToString(newKey),
-		},
-	}); err != nil {
+	if err := t.writeKeys(req.Context(), []signingKey{key}); err != nil {
 		return fmt.Errorf("failed to create credential: %w", err)
 	}
 
-	if err := t.replaceKey(req.Context(), newKey); err != nil {
+	if err := t.loadKeys(req.Context()); err != nil {
 		return fmt.Errorf("failed to replace key: %w", err)
 	}
 
@@ -153,6 +118,12 @@ type TokenContext struct {
 	AuthProviderUserID    string
 
 	MCPID string
+	// ClientID is the OAuth client of client tokens.
+	ClientID string
+	// TokenID identifies an OAuth access token, and GrantID the authorization that it and its refresh tokens were
+	// issued for, so that either can be revoked.
+	TokenID string
+	GrantID string
 
 	// The following fields are for runs
 	Namespace         string
@@ -203,6 +174,21 @@ func (t *TokenService) AuthenticateRequest(req *http.Request) (*authenticator.Re
 				},
 			},
 		}, true, nil
+	case TokenTypeClient:
+		// OAuth clients only get GroupOAuthClient, which restricts them to the MCP server of the token's audience.
+		return &authenticator.Response{
+			User: &user.DefaultInfo{
+				UID:    tokenContext.ClientID,
+				Name:   tokenContext.ClientID,
+				Groups: []string{types.GroupOAuthClient},
+				Extra: map[string][]string{
+					"mcp_id":          {tokenContext.MCPID},
+					"resource":        {tokenContext.Audience},
+					"oauthScope":      {tokenContext.OAuthScope},
+					"oauth_client_id": {tokenContext.ClientID},
+				},
+			},
+		}, true, nil
 	default:
 		extra := map[string][]string{
 			"email":                   {tokenContext.UserEmail},
@@ -244,24 +230,14 @@ func (t *TokenService) AuthenticateRequest(req *http.Request) (*authenticator.Re
 }
 
 func (t *TokenService) DecodeToken(ctx context.Context, token string) (*TokenContext, error) {
-	t.lock.RLock()
-	privateKey := t.privateKey
-	t.lock.RUnlock()
-
-	if privateKey == nil {
-		if err := t.setJWK(ctx); err != nil {
-			return nil, err
-		}
-
-		t.lock.RLock()
-		privateKey = t.privateKey
-		t.lock.RUnlock()
+	keys, _, err := t.signingKeys(ctx, keyReloadInterval)
+	if err != nil {
+		return nil, err
 	}
 
-	tk, err := jwt.Parse(token, func(*jwt.Token) (any, error) {
-		t.lock.RLock()
-		defer t.lock.RUnlock()
-		return privateKey.Public(), nil
+	tk, err := jwt.Parse(token, func(tk *jwt.Token) (any, error) {
+		kid, _ := tk.Header["kid"].(string)
+		return t.verificationKeys(ctx, keys, kid)
 	}, jwt.WithIssuer(t.serverURL))
 	if err != nil {
 		return nil, err
@@ -270,6 +246,9 @@ func (t *TokenService) DecodeToken(ctx context.Context, token string) (*TokenCon
 	if !ok {
 		return nil, err
 	}
+	if tokenType, _ := claims["TokenType"].(string); TokenType(tokenType) == TokenTypeID {
+		return nil, fmt.Errorf("ID tokens can't be used as access tokens")
+	}
 
 	var groups []string
 	if userGroups, ok := claims["UserGroups"].(string); ok {
@@ -294,7 +273,7 @@ func (t *TokenService) DecodeToken(ctx context.Context, token string) (*TokenCon
 		return ""
 	}
 
-	return &TokenContext{
+	tokenContext := &TokenContext{
 		IssuedAt:              issuedAt,
 		ExpiresAt:             expiresAt,
 		UserGroups:            groups,
@@ -306,6 +285,9 @@ func (t *TokenService) DecodeToken(ctx context.Context, token string) (*TokenCon
 		AuthProviderNamespace: getStringClaim("AuthProviderNamespace"),
 		AuthProviderUserID:    getStringClaim("AuthProviderUserID"),
 		MCPID:                 getStringClaim("MCPID"),
+		ClientID:              getStringClaim("ClientID"),
+		TokenID:               getStringClaim("jti"),
+		GrantID:               getStringClaim("GrantID"),
 		Namespace:             getStringClaim("Namespace"),
 		RunID:                 getStringClaim("RunID"),
 		ThreadID:              getStringClaim("ThreadID"),
@@ -322,7 +304,18 @@ func (t *TokenService) DecodeToken(ctx context.Context, token string) (*TokenCon
 		// This makes this backwards compatible with older tokens.
 		UserName:  getStringClaim("name", "UserName"),
 		UserEmail: getStringClaim("email", "UserEmail"),
-	}, nil
+	}
+
+	// Only OAuth access tokens have IDs, so other tokens don't pay for the lookup.
+	if ids := slices.DeleteFunc([]string{tokenContext.TokenID, tokenContext.GrantID}, func(id string) bool { return id == "" }); len(ids) > 0 {
+		if revoked, err := t.gatewayClient.OAuthTokenRevoked(ctx, ids...); err != nil {
+			return nil, fmt.Errorf("failed to check whether token is revoked: %w", err)
+		} else if revoked {
+			return nil, fmt.Errorf("token is revoked")
+		}
+	}
+
+	return tokenContext, nil
 }
 
 func (t *TokenService) NewToken(ctx context.Context, context TokenContext) (string, error) {
@@ -345,6 +338,9 @@ func (t *TokenService) NewToken(ctx context.Context, context TokenContext) (stri
 		"AuthProviderNamespace": context.AuthProviderNamespace,
 		"AuthProviderUserID":    context.AuthProviderUserID,
 		"MCPID":                 context.MCPID,
+		"ClientID":              context.ClientID,
+		"jti":                   context.TokenID,
+		"GrantID":               context.GrantID,
 		"Namespace":             context.Namespace,
 		"RunID":                 context.RunID,
 		"ThreadID":              context.ThreadID,
@@ -364,18 +360,13 @@ func (t *TokenService) NewToken(ctx context.Context, context TokenContext) (stri
 }
 
 func (t *TokenService) NewTokenWithClaims(ctx context.Context, claims jwt.MapClaims) (*jwt.Token, string, error) {
-	t.lock.RLock()
-	privateKey := t.privateKey
-	t.lock.RUnlock()
-
-	if privateKey == nil {
-		if err := t.setJWK(ctx); err != nil {
-			return nil, "", err
-		}
-
-		t.lock.RLock()
-		privateKey = t.privateKey
-		t.lock.RUnlock()
+	keys, _, err := t.signingKeys(ctx, keyReloadInterval)
+	if err != nil {
+		return nil, "", err
+	}
+	key, ok := currentSigningKey(keys)
+	if !ok {
+		return nil, "", fmt.Errorf("no JWK to sign the token with")
 	}
 
 	claims["iss"] = t.serverURL
@@ -384,55 +375,43 @@ func (t *TokenService) NewTokenWithClaims(ctx context.Context, claims jwt.MapCla
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
-	s, err := token.SignedString(privateKey)
+	token.Header["kid"] = key.KID
+	s, err := token.SignedString(key.Key)
 	return token, s, err
 }
 
 func (t *TokenService) ServeJWKS(api api.Context) error {
-	t.lock.RLock()
-	jwks := t.jwks
-	t.lock.RUnlock()
-
-	if jwks == nil {
-		if err := t.setJWK(api.Context()); err != nil {
-			return err
-		}
-
-		t.lock.RLock()
-		jwks = t.jwks
-		t.lock.RUnlock()
+	_, jwks, err := t.signingKeys(api.Context(), keyReloadInterval)
+	if err != nil {
+		return err
 	}
 
 	return api.Write(jwks)
 }
 
-const keyEnvVar = "JWK_KEY"
-
-func (t *TokenService) replaceKey(ctx context.Context, key ed25519.PrivateKey) error {
-	jwk, err := jwkset.NewJWKFromKey(key, jwkset.JWKOptions{
-		Metadata: jwkset.JWKMetadataOptions{
-			KID: "obot",
-		},
-	})
-	if err != nil {
-		return err
-	}
-
-	jwkSet := jwkset.NewMemoryStorage()
-	if err := jwkSet.KeyWrite(ctx, jwk); err != nil {
-		return err
+// verificationKeys returns the public keys that verify a token signed with the key kid. Tokens of older releases don't
+// name their key, so they are verified with any key that isn't retired.
+func (t *TokenService) verificationKeys(ctx context.Context, keys []signingKey, kid string) (jwt.VerificationKeySet, error) {
+	result := activeKeys(keys, time.Now(), kid)
+	if kid == "" || len(result.Keys) > 0 || slices.ContainsFunc(keys, func(key signingKey) bool { return key.KID == kid }) {
+		return result, nil
 	}
 
-	publicJSON, err := jwkSet.JSONPublic(ctx)
+	// The token may be signed with a key that another replica rotated to.
+	keys, _, err := t.signingKeys(ctx, unknownKIDReloadInterval)
 	if err != nil {
-		return err
+		return result, err
 	}
+	return activeKeys(keys, time.Now(), kid), nil
+}
 
-	t.lock.Lock()
-	defer t.lock.Unlock()
-
-	t.privateKey = key
-	t.jwks = publicJSON
-
-	return nil
+// activeKeys returns the public keys that verify tokens at now, or only the one with kid, if it is set.
+func activeKeys(keys []signingKey, now time.Time, kid string) jwt.VerificationKeySet {
+	var result jwt.VerificationKeySet
+	for _, key := range keys {
+		if key.verifies(now) && (kid == "" || key.KID == kid) {
+			result.Keys = append(result.Keys, key.Key.Public())
+		}
+	}
+	return result
 }
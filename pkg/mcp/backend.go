@@ -49,6 +49,8 @@ var (
 	ErrPodSchedulingFailed    = errors.New("pod could not be scheduled")
 	ErrPodConfigurationFailed = errors.New("pod configuration is invalid")
 	ErrInsufficientCapacity   = errors.New("insufficient cluster capacity to deploy MCP server")
+	ErrDeploymentQueueFull    = errors.New("too many MCP server deployments are waiting, try again later")
+	ErrDeploymentQueueTimeout = errors.New("timed out waiting in the MCP server deployment queue")
 )
 
 func ensureServerReady(ctx context.Context, url string, server ServerConfig) error {
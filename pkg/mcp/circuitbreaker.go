@@ -0,0 +1,128 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+)
+
+// ErrCircuitOpen is returned without contacting an MCP server after too many consecutive requests to it have failed.
+type ErrCircuitOpen struct {
+	MCPServerName string
+	// RetryAfter is how long until requests to the server are attempted again.
+	RetryAfter time.Duration
+}
+
+func (e *ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("MCP server %s is unavailable after repeated failures, retry in %s", e.MCPServerName, e.RetryAfter.Round(time.Second))
+}
+
+// circuitBreaker tracks consecutive failures of each MCP server. After threshold failures in a row, requests to the
+// server fail fast for the cooldown period. The first request after the cooldown is let through, and the circuit is
+// closed again if it succeeds or opened for another cooldown if it fails.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	lock    sync.Mutex
+	servers map[string]*circuitState
+}
+
+type circuitState struct {
+	failures  int
+	openUntil time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		servers:   make(map[string]*circuitState),
+	}
+}
+
+func (c *circuitBreaker) enabled() bool {
+	return c != nil && c.threshold > 0 && c.cooldown > 0
+}
+
+// allow returns an [ErrCircuitOpen] error if requests to the server should fail fast.
+func (c *circuitBreaker) allow(serverName string) error {
+	if !c.enabled() {
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	state, ok := c.servers[serverName]
+	if !ok {
+		return nil
+	}
+	if remaining := time.Until(state.openUntil); remaining > 0 {
+		return &ErrCircuitOpen{MCPServerName: serverName, RetryAfter: remaining}
+	}
+	if state.failures >= c.threshold {
+		// Let this request probe the server, and keep failing the others fast until its result is recorded.
+		state.openUntil = time.Now().Add(c.cooldown)
+	}
+	return nil
+}
+
+// record counts the result of a request to the server. Errors that show the server is reachable, like JSON-RPC errors
+// and OAuth challenges, count as successes, and requests canceled by the caller aren't counted at all.
+func (c *circuitBreaker) record(serverName string, err error) {
+	if !c.enabled() || errors.Is(err, context.Canceled) || errors.As(err, new(*ErrCircuitOpen)) ||
+		errors.Is(err, ErrDeploymentQueueFull) || errors.Is(err, ErrDeploymentQueueTimeout) {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !serverFailure(err) {
+		delete(c.servers, serverName)
+		return
+	}
+
+	state, ok := c.servers[serverName]
+	if !ok {
+		state = new(circuitState)
+		c.servers[serverName] = state
+	}
+
+	state.failures++
+	if state.failures >= c.threshold {
+		state.openUntil = time.Now().Add(c.cooldown)
+		if state.failures == c.threshold {
+			log.Infof("opening circuit for MCP server %s after %d consecutive failures: %v", serverName, state.failures, err)
+		}
+	}
+}
+
+// reset closes the circuit for the server, for example after it has been restarted.
+func (c *circuitBreaker) reset(serverName string) {
+	if !c.enabled() {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.servers, serverName)
+}
+
+func serverFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var (
+		rpcErr  *nmcp.RPCError
+		authErr nmcp.AuthRequiredErr
+	)
+	return !errors.As(err, &rpcErr) && !errors.As(err, &authErr)
+}
@@ -0,0 +1,73 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerTripsAfterConsecutiveFailures(t *testing.T) {
+	cb := newCircuitBreaker(3, time.Minute)
+	failure := errors.New("connection refused")
+
+	for range 2 {
+		cb.record("server", failure)
+		require.NoError(t, cb.allow("server"))
+	}
+
+	cb.record("server", failure)
+
+	var errOpen *ErrCircuitOpen
+	require.ErrorAs(t, cb.allow("server"), &errOpen)
+	assert.Equal(t, "server", errOpen.MCPServerName)
+	assert.InDelta(t, time.Minute, errOpen.RetryAfter, float64(time.Second))
+
+	// Other servers are unaffected.
+	assert.NoError(t, cb.allow("other"))
+}
+
+func TestCircuitBreakerSuccessResetsFailures(t *testing.T) {
+	cb := newCircuitBreaker(2, time.Minute)
+
+	cb.record("server", errors.New("timeout"))
+	cb.record("server", nil)
+	cb.record("server", errors.New("timeout"))
+	assert.NoError(t, cb.allow("server"))
+
+	// Errors from a server that responded count as successes, and canceled requests don't count.
+	cb.record("server", &nmcp.RPCError{Code: -32602, Message: "invalid params"})
+	cb.record("server", context.Canceled)
+	cb.record("server", errors.New("timeout"))
+	assert.NoError(t, cb.allow("server"))
+}
+
+func TestCircuitBreakerProbesAfterCooldown(t *testing.T) {
+	cb := newCircuitBreaker(1, time.Minute)
+
+	cb.record("server", errors.New("connection refused"))
+	require.Error(t, cb.allow("server"))
+
+	// Move past the cooldown.
+	cb.servers["server"].openUntil = time.Now().Add(-time.Second)
+
+	// One request probes the server while the others keep failing fast.
+	require.NoError(t, cb.allow("server"))
+	require.Error(t, cb.allow("server"))
+
+	cb.record("server", nil)
+	assert.NoError(t, cb.allow("server"))
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	cb := newCircuitBreaker(0, time.Minute)
+
+	for range 10 {
+		cb.record("server", errors.New("connection refused"))
+	}
+	assert.NoError(t, cb.allow("server"))
+}
@@ -3,6 +3,7 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	gtypes "github.com/gptscript-ai/gptscript/pkg/types"
 	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
 	"github.com/obot-platform/obot/apiclient/types"
+	"golang.org/x/oauth2"
 )
 
 type Client struct {
@@ -18,7 +20,8 @@ type Client struct {
 	ID     string
 	Config ServerConfig
 
-	jwt *jwt.Token
+	jwt        *jwt.Token
+	prefetched *capabilityPrefetch
 }
 
 func (c *Client) hasValidToken() bool {
@@ -60,6 +63,7 @@ func (sm *SessionManager) clientForServerWithOptions(ctx context.Context, client
 
 	session, err := sm.loadSession(ctx, config, clientScope, opt)
 	if err != nil {
+		sm.circuitBreaker.record(serverConfig.MCPServerName, err)
 		return nil, err
 	}
 
@@ -94,7 +98,9 @@ func (sm *SessionManager) loadSession(ctx context.Context, server ServerConfig,
 
 	sm.contextLock.Lock()
 	if sm.sessionCtx == nil {
-		sm.sessionCtx, sm.cancel = context.WithCancel(context.Background())
+		// Clients of MCP servers that use OAuth build their HTTP clients from the one in the context.
+		base := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: sm.remoteTransport})
+		sm.sessionCtx, sm.cancel = context.WithCancel(base)
 	}
 	sm.contextLock.Unlock()
 
@@ -127,10 +133,55 @@ func (sm *SessionManager) loadSession(ctx context.Context, server ServerConfig,
 		headers.Set("Authorization", "Bearer "+token)
 	}
 
-	c, err := nmcp.NewClient(sm.sessionCtx, server.MCPServerDisplayName, nmcp.Server{
+	mcpServer := nmcp.Server{
 		BaseURL: server.URL,
 		Headers: headers,
-	}, clientOpts)
+	}
+	if server.Runtime == types.RuntimeStdio {
+		var err error
+		if mcpServer, err = stdioServer(server); err != nil {
+			return nil, err
+		}
+		// The command, arguments and environment of the subprocess are expanded with the client's environment, which
+		// must not leak anything into it.
+		clientOpts.Env = nil
+	}
+
+	if samplingEnabled(server) && clientOpts.OnSampling == nil {
+		clientOpts.OnSampling = sm.onSampling(server)
+	}
+	if server.UserID != "" && clientOpts.OnElicit == nil {
+		clientOpts.OnElicit = sm.onElicit(server)
+	}
+	if clientOpts.OnRoots == nil {
+		clientOpts.OnRoots = sm.onRoots(server)
+	}
+	if clientOpts.OnNotify == nil {
+		clientOpts.OnNotify = sm.onNotify(server)
+	}
+
+	resumable := sm.resumableSession(server, clientOpts)
+	if resumable {
+		clientOpts.SessionState = sm.storedSessionState(ctx, clientScope)
+	}
+
+	c, err := nmcp.NewClient(sm.sessionCtx, server.MCPServerDisplayName, mcpServer, clientOpts)
+	if clientOpts.SessionState != nil && resumable {
+		// Make sure the server still knows the session before using it. If it doesn't, start a new one.
+		if err == nil {
+			if _, err = c.Ping(ctx); err != nil {
+				c.Close(false)
+			}
+		}
+		if err != nil {
+			log.Debugf("failed to resume MCP session for client %s, starting a new session: %v", clientScope, err)
+			sm.deleteSessionState(clientScope)
+			clientOpts.SessionState = nil
+			c, err = nmcp.NewClient(sm.sessionCtx, server.MCPServerDisplayName, mcpServer, clientOpts)
+		} else {
+			log.Debugf("resumed MCP session for client %s", clientScope)
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create MCP client: %w", err)
 	}
@@ -141,6 +192,9 @@ func (sm *SessionManager) loadSession(ctx context.Context, server ServerConfig,
 		Config: server,
 		jwt:    jwtToken,
 	}
+	if sm.prefetchCapabilities {
+		result.prefetched = newCapabilityPrefetch()
+	}
 
 	res, ok := clientSessions.LoadOrStore(clientScope, result)
 	if ok {
@@ -158,6 +212,14 @@ func (sm *SessionManager) loadSession(ctx context.Context, server ServerConfig,
 		}()
 	}
 
+	if resumable {
+		sm.storeSessionState(ctx, server.MCPServerName, result)
+	}
+
+	if result.prefetched != nil {
+		prefetchCapabilities(sm.sessionCtx, result)
+	}
+
 	return result, nil
 }
 
@@ -0,0 +1,51 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+type completeRequest struct {
+	Ref      types.MCPCompletionReference `json:"ref"`
+	Argument types.MCPCompletionArgument  `json:"argument"`
+	Context  *completeContext             `json:"context,omitempty"`
+}
+
+type completeContext struct {
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+type completeResult struct {
+	Completion types.MCPCompletionResult `json:"completion"`
+}
+
+// Complete asks the server for completions of a prompt argument or resource template variable.
+func (sm *SessionManager) Complete(ctx context.Context, serverConfig ServerConfig, req types.MCPCompletionRequest) (types.MCPCompletionResult, error) {
+	client, err := sm.clientForServer(ctx, serverConfig)
+	if err != nil {
+		return types.MCPCompletionResult{}, err
+	}
+
+	in := completeRequest{
+		Ref:      req.Ref,
+		Argument: req.Argument,
+	}
+	if len(req.Arguments) > 0 {
+		in.Context = &completeContext{
+			Arguments: req.Arguments,
+		}
+	}
+
+	var out completeResult
+	if err = client.Session.Exchange(ctx, "completion/complete", in, &out); err != nil {
+		return types.MCPCompletionResult{}, fmt.Errorf("failed to get MCP completions: %w", err)
+	}
+
+	if out.Completion.Values == nil {
+		out.Completion.Values = []string{}
+	}
+
+	return out.Completion, nil
+}
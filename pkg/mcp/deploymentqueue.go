@@ -0,0 +1,130 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// deploymentQueue bounds the number of MCP server deployments that run at the same time, in total and for each user.
+// Deployments beyond the limits wait in a first-in, first-out queue, so that concurrent launches don't all hit the
+// container runtime and the image registry at once. A deployment that is held back only by its user's limit doesn't
+// hold back the deployments of other users behind it.
+type deploymentQueue struct {
+	lock         sync.Mutex
+	workers      int
+	perUser      int
+	maxQueued    int
+	active       int
+	activeByUser map[string]int
+	waiting      []*queuedDeployment
+}
+
+type queuedDeployment struct {
+	serverName string
+	userID     string
+	started    chan struct{}
+}
+
+func newDeploymentQueue(workers, perUser, maxQueued int) *deploymentQueue {
+	return &deploymentQueue{
+		workers:      workers,
+		perUser:      perUser,
+		maxQueued:    maxQueued,
+		activeByUser: make(map[string]int),
+	}
+}
+
+func (q *deploymentQueue) enabled() bool {
+	return q != nil && (q.workers > 0 || q.perUser > 0)
+}
+
+// acquire waits until the deployment of the server can start, and returns a function that must be called when the
+// deployment is done.
+func (q *deploymentQueue) acquire(ctx context.Context, serverName, userID string) (func(), error) {
+	if !q.enabled() {
+		return func() {}, nil
+	}
+
+	d := &queuedDeployment{
+		serverName: serverName,
+		userID:     userID,
+		started:    make(chan struct{}),
+	}
+
+	q.lock.Lock()
+	q.waiting = append(q.waiting, d)
+	q.dispatch()
+	if q.maxQueued > 0 && len(q.waiting) > q.maxQueued && q.remove(d) {
+		q.lock.Unlock()
+		return nil, ErrDeploymentQueueFull
+	}
+	q.lock.Unlock()
+
+	release := func() {
+		q.lock.Lock()
+		defer q.lock.Unlock()
+
+		q.active--
+		if q.activeByUser[userID]--; q.activeByUser[userID] <= 0 {
+			delete(q.activeByUser, userID)
+		}
+		q.dispatch()
+	}
+
+	select {
+	case <-d.started:
+		return release, nil
+	case <-ctx.Done():
+		q.lock.Lock()
+		removed := q.remove(d)
+		q.lock.Unlock()
+		if !removed {
+			// The deployment started while the context was being canceled.
+			release()
+		}
+		return nil, fmt.Errorf("%w: %w", ErrDeploymentQueueTimeout, ctx.Err())
+	}
+}
+
+// position returns the position of the server's deployment in the queue, starting at 1, or false if it isn't waiting.
+func (q *deploymentQueue) position(serverName string) (int, bool) {
+	if !q.enabled() {
+		return 0, false
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for i, d := range q.waiting {
+		if d.serverName == serverName {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// dispatch starts the waiting deployments that are within the limits, in order. The lock must be held.
+func (q *deploymentQueue) dispatch() {
+	q.waiting = slices.DeleteFunc(q.waiting, func(d *queuedDeployment) bool {
+		if (q.workers > 0 && q.active >= q.workers) || (q.perUser > 0 && q.activeByUser[d.userID] >= q.perUser) {
+			return false
+		}
+
+		q.active++
+		q.activeByUser[d.userID]++
+		close(d.started)
+		return true
+	})
+}
+
+// remove removes the deployment from the queue, and returns false if it isn't waiting. The lock must be held.
+func (q *deploymentQueue) remove(d *queuedDeployment) bool {
+	i := slices.Index(q.waiting, d)
+	if i < 0 {
+		return false
+	}
+	q.waiting = slices.Delete(q.waiting, i, i+1)
+	return true
+}
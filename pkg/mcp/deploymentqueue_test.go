@@ -0,0 +1,147 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// acquireAsync starts acquiring a deployment slot in the background and returns a channel that receives the release
+// function once the deployment starts.
+func acquireAsync(t *testing.T, ctx context.Context, q *deploymentQueue, serverName, userID string) <-chan func() {
+	t.Helper()
+
+	started := make(chan func(), 1)
+	go func() {
+		release, err := q.acquire(ctx, serverName, userID)
+		if err == nil {
+			started <- release
+		}
+	}()
+
+	// Wait for the deployment to be dispatched or queued.
+	require.Eventually(t, func() bool {
+		if len(started) > 0 {
+			return true
+		}
+		_, queued := q.position(serverName)
+		return queued
+	}, time.Second, time.Millisecond)
+	return started
+}
+
+func TestDeploymentQueueLimitsWorkers(t *testing.T) {
+	q := newDeploymentQueue(1, 0, 0)
+
+	release, err := q.acquire(t.Context(), "server-1", "user-1")
+	require.NoError(t, err)
+
+	second := acquireAsync(t, t.Context(), q, "server-2", "user-2")
+	third := acquireAsync(t, t.Context(), q, "server-3", "user-3")
+
+	position, ok := q.position("server-2")
+	require.True(t, ok)
+	assert.Equal(t, 1, position)
+	position, ok = q.position("server-3")
+	require.True(t, ok)
+	assert.Equal(t, 2, position)
+
+	release()
+
+	select {
+	case release = <-second:
+	case <-time.After(time.Second):
+		t.Fatal("second deployment didn't start")
+	}
+	_, ok = q.position("server-2")
+	assert.False(t, ok)
+	position, _ = q.position("server-3")
+	assert.Equal(t, 1, position)
+
+	release()
+	select {
+	case release = <-third:
+		release()
+	case <-time.After(time.Second):
+		t.Fatal("third deployment didn't start")
+	}
+}
+
+func TestDeploymentQueuePerUserLimitDoesNotBlockOtherUsers(t *testing.T) {
+	q := newDeploymentQueue(3, 1, 0)
+
+	release, err := q.acquire(t.Context(), "server-1", "user-1")
+	require.NoError(t, err)
+
+	sameUser := acquireAsync(t, t.Context(), q, "server-2", "user-1")
+	otherUser := acquireAsync(t, t.Context(), q, "server-3", "user-2")
+
+	// The other user's deployment starts even though it was queued after the first user's.
+	select {
+	case otherRelease := <-otherUser:
+		otherRelease()
+	case <-time.After(time.Second):
+		t.Fatal("other user's deployment didn't start")
+	}
+	_, ok := q.position("server-2")
+	assert.True(t, ok)
+
+	release()
+	select {
+	case release = <-sameUser:
+		release()
+	case <-time.After(time.Second):
+		t.Fatal("user's second deployment didn't start")
+	}
+}
+
+func TestDeploymentQueueFull(t *testing.T) {
+	q := newDeploymentQueue(1, 0, 1)
+
+	release, err := q.acquire(t.Context(), "server-1", "user-1")
+	require.NoError(t, err)
+	defer release()
+
+	_ = acquireAsync(t, t.Context(), q, "server-2", "user-1")
+
+	_, err = q.acquire(t.Context(), "server-3", "user-1")
+	require.ErrorIs(t, err, ErrDeploymentQueueFull)
+	_, ok := q.position("server-3")
+	assert.False(t, ok)
+}
+
+func TestDeploymentQueueCanceledWhileWaiting(t *testing.T) {
+	q := newDeploymentQueue(1, 0, 0)
+
+	release, err := q.acquire(t.Context(), "server-1", "user-1")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = q.acquire(ctx, "server-2", "user-1")
+	require.ErrorIs(t, err, ErrDeploymentQueueTimeout)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	_, ok := q.position("server-2")
+	assert.False(t, ok)
+
+	// The canceled deployment doesn't take a slot once the first one is done.
+	release()
+	release, err = q.acquire(t.Context(), "server-3", "user-1")
+	require.NoError(t, err)
+	release()
+}
+
+func TestDeploymentQueueDisabled(t *testing.T) {
+	var q *deploymentQueue
+
+	release, err := q.acquire(t.Context(), "server", "user")
+	require.NoError(t, err)
+	release()
+
+	_, ok := q.position("server")
+	assert.False(t, ok)
+}
@@ -15,6 +15,10 @@ var ErrServerNotRunning = errors.New("mcp server is not running")
 // GetServerDetails will get the details of a specific MCP server based on its configuration, if the backend supports it.
 // If the backend does not support the operation, it will return an [ErrNotSupportedByBackend] error.
 func (sm *SessionManager) GetServerDetails(ctx context.Context, serverConfig ServerConfig) (types.MCPServerDetails, error) {
+	if serverConfig.Runtime == types.RuntimeStdio {
+		return types.MCPServerDetails{}, &ErrNotSupportedByBackend{Feature: "server details", Backend: stdioBackendName}
+	}
+
 	// Try to get details first - only deploy if server doesn't exist
 	// This prevents unnecessary redeployments that would update K8s settings and clear the NeedsK8sUpdate flag
 	details, err := sm.backend.getServerDetails(ctx, serverConfig.MCPServerName)
@@ -38,6 +42,10 @@ func (sm *SessionManager) GetServerDetails(ctx context.Context, serverConfig Ser
 // StreamServerLogs will stream the logs of a specific MCP server based on its configuration, if the backend supports it.
 // If the backend does not support the operation, it will return an [ErrNotSupportedByBackend] error.
 func (sm *SessionManager) StreamServerLogs(ctx context.Context, serverConfig ServerConfig) (io.ReadCloser, error) {
+	if serverConfig.Runtime == types.RuntimeStdio {
+		return nil, &ErrNotSupportedByBackend{Feature: "server logs", Backend: stdioBackendName}
+	}
+
 	// Check if server exists first - only deploy if it doesn't
 	// This prevents unnecessary redeployments that would update K8s settings and clear the NeedsK8sUpdate flag
 	_, err := sm.backend.getServerDetails(ctx, serverConfig.MCPServerName)
@@ -59,6 +67,12 @@ func (sm *SessionManager) StreamServerLogs(ctx context.Context, serverConfig Ser
 }
 
 func (sm *SessionManager) deployServer(ctx context.Context, server ServerConfig) error {
+	server, err := sm.secretRefs.resolve(ctx, server)
+	if err != nil {
+		return err
+	}
+	server = sm.workspaceServers.configure(sm.memoryServers.configure(server))
+
 	var webhooks []Webhook
 	if !server.ComponentMCPServer {
 		// Don't get webhooks for servers that are components of composite servers.
@@ -47,6 +47,8 @@ type dockerBackend struct {
 	deploymentCache               map[string]*dockerDeploymentCacheEntry
 	fileSyncMu                    sync.RWMutex
 	syncedFilesHash               map[string]string
+	syncedComponentsHash          map[string]string
+	deployments                   *deploymentQueue
 }
 
 type dockerDeploymentCacheEntry struct {
@@ -55,7 +57,7 @@ type dockerDeploymentCacheEntry struct {
 	containerIDs map[string]string
 }
 
-func newDockerBackend(ctx context.Context, exposedPort int, opts Options) (backend, error) {
+func newDockerBackend(ctx context.Context, exposedPort int, opts Options, deployments *deploymentQueue) (backend, error) {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Docker client: %w", err)
@@ -90,6 +92,8 @@ func newDockerBackend(ctx context.Context, exposedPort int, opts Options) (backe
 		auditLogsFlushIntervalSeconds: opts.MCPAuditLogPersistIntervalSeconds,
 		deploymentCache:               map[string]*dockerDeploymentCacheEntry{},
 		syncedFilesHash:               map[string]string{},
+		syncedComponentsHash:          map[string]string{},
+		deployments:                   deployments,
 	}
 
 	if err = d.cleanupDeprecatedContainers(ctx); err != nil {
@@ -180,7 +184,7 @@ func (d *dockerBackend) cleanupDeprecatedContainers(ctx context.Context) error {
 // deployServer will deploy the underlying container for the server. It will not deploy any shims or webhooks.
 // This is only to give users the opportunity to view logs and debug the server they are trying to deploy.
 func (d *dockerBackend) deployServer(ctx context.Context, server ServerConfig, _ []Webhook) error {
-	configHash := serverID(server)
+	configHash := deploymentID(server)
 	// Check if container already exists
 	existing, err := d.getContainer(ctx, server.MCPServerName)
 	if err == nil && existing != nil {
@@ -193,6 +197,10 @@ func (d *dockerBackend) deployServer(ctx context.Context, server ServerConfig, _
 }
 
 func (d *dockerBackend) ensureServerDeployment(ctx context.Context, server ServerConfig, webhooks []Webhook) (ServerConfig, error) {
+	if server.Source != nil {
+		return ServerConfig{}, &ErrNotSupportedByBackend{Feature: "source runtime", Backend: "docker"}
+	}
+
 	serverName := server.MCPServerName
 	serverConfigHash := hash.Digest(map[string]any{"server": server, "webhooks": webhooks})
 	var err error
@@ -210,6 +218,12 @@ func (d *dockerBackend) ensureServerDeployment(ctx context.Context, server Serve
 		d.deleteDeploymentCache(serverName)
 	}
 
+	release, err := d.deployments.acquire(ctx, serverName, deploymentUserID(server))
+	if err != nil {
+		return ServerConfig{}, err
+	}
+	defer release()
+
 	expectedContainers := make(map[string]string, 2)
 
 	mcpServerName := server.MCPServerName
@@ -266,7 +280,7 @@ func (d *dockerBackend) ensureDeployment(ctx context.Context, server ServerConfi
 		webhooks[i] = webhook
 	}
 
-	configHash := serverID(server)
+	configHash := deploymentID(server)
 	desiredFileEnvKeysHash := fileEnvKeysHash(server.Files)
 	if len(webhooks) > 0 {
 		// Include webhooks in the config hash so that changes to webhooks trigger a redeployment
@@ -315,6 +329,10 @@ func (d *dockerBackend) ensureDeployment(ctx context.Context, server ServerConfi
 				return ServerConfig{}, fmt.Errorf("failed syncing container files: %w", err)
 			}
 
+			if err := d.syncCompositeComponents(ctx, server, existing); err != nil {
+				return ServerConfig{}, fmt.Errorf("failed syncing composite components: %w", err)
+			}
+
 			containerPort := defaultContainerPort
 			if server.Runtime == otypes.RuntimeContainerized && server.ContainerPort != 0 {
 				containerPort = server.ContainerPort
@@ -469,6 +487,7 @@ eventLoop:
 		ReadyReplicas:  readyReplicas,
 		Replicas:       1,
 		IsAvailable:    container.State == "running",
+		RestartCount:   int32(inspect.RestartCount),
 		Events:         mcpEvents,
 	}, nil
 }
@@ -546,7 +565,7 @@ func applyServerConfigToContainerConfig(config *container.Config, server ServerC
 		config.Labels = map[string]string{}
 	}
 
-	config.Labels["mcp.config.hash"] = serverID(server)
+	config.Labels["mcp.config.hash"] = deploymentID(server)
 	config.Labels["mcp.file.env.keys.hash"] = fileEnvKeysHash(server.Files)
 }
 
@@ -1141,6 +1160,33 @@ func (d *dockerBackend) syncContainerFiles(ctx context.Context, server ServerCon
 	return nil
 }
 
+// syncCompositeComponents rewrites the nanobot.yaml of a running composite server when its components change. Nanobot
+// reads it for new sessions, so the container is not restarted and the sessions of the other components are kept.
+func (d *dockerBackend) syncCompositeComponents(ctx context.Context, server ServerConfig, c *container.Summary) error {
+	if c == nil || server.Runtime != otypes.RuntimeComposite {
+		return nil
+	}
+
+	desiredComponentsHash := hash.Digest(server.Components)
+
+	d.fileSyncMu.RLock()
+	if d.syncedComponentsHash[c.ID] == desiredComponentsHash {
+		d.fileSyncMu.RUnlock()
+		return nil
+	}
+	d.fileSyncMu.RUnlock()
+
+	if _, err := d.prepareMCPServerNanobotConfig(ctx, server, nil, nil); err != nil {
+		return fmt.Errorf("failed to update composite server nanobot config: %w", err)
+	}
+
+	d.fileSyncMu.Lock()
+	d.syncedComponentsHash[c.ID] = desiredComponentsHash
+	d.fileSyncMu.Unlock()
+
+	return nil
+}
+
 func (d *dockerBackend) ensureWorkspaceVolume(ctx context.Context, server ServerConfig, mcpServerName string) (string, error) {
 	volumeName := server.MCPServerName + "-workspace"
 	labels := map[string]string{
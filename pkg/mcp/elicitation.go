@@ -0,0 +1,188 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// elicitationTimeout is how long a tool call waits for the user to respond to an elicitation before it is cancelled.
+const elicitationTimeout = 10 * time.Minute
+
+// ErrElicitationNotFound is returned when responding to an elicitation that does not exist, has expired, or belongs to another user.
+var ErrElicitationNotFound = errors.New("elicitation not found")
+
+type pendingElicitation struct {
+	userID      string
+	elicitation types.MCPElicitation
+	response    chan nmcp.ElicitResult
+}
+
+// elicitationBroker tracks elicitation requests from MCP servers that are waiting on a user and
+// fans them out to the user's subscribers.
+type elicitationBroker struct {
+	lock        sync.Mutex
+	pending     map[string]*pendingElicitation
+	subscribers map[string]map[chan types.MCPElicitation]struct{}
+}
+
+func newElicitationBroker() *elicitationBroker {
+	return &elicitationBroker{
+		pending:     make(map[string]*pendingElicitation),
+		subscribers: make(map[string]map[chan types.MCPElicitation]struct{}),
+	}
+}
+
+// subscribe returns a channel that receives the user's pending elicitations followed by any new ones.
+// The channel is closed when the context is done.
+func (b *elicitationBroker) subscribe(ctx context.Context, userID string) <-chan types.MCPElicitation {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	var pending []types.MCPElicitation
+	for _, p := range b.pending {
+		if p.userID == userID {
+			pending = append(pending, p.elicitation)
+		}
+	}
+
+	// Buffer enough room for the pending elicitations so that they can be sent while holding the lock.
+	ch := make(chan types.MCPElicitation, len(pending)+10)
+	for _, e := range pending {
+		ch <- e
+	}
+
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[chan types.MCPElicitation]struct{})
+	}
+	b.subscribers[userID][ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		b.lock.Lock()
+		defer b.lock.Unlock()
+
+		delete(b.subscribers[userID], ch)
+		if len(b.subscribers[userID]) == 0 {
+			delete(b.subscribers, userID)
+		}
+		close(ch)
+	}()
+
+	return ch
+}
+
+// add registers a pending elicitation and publishes it to the user's subscribers.
+func (b *elicitationBroker) add(p *pendingElicitation) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.pending[p.elicitation.ID] = p
+	for ch := range b.subscribers[p.userID] {
+		select {
+		case ch <- p.elicitation:
+		default:
+			// The subscriber isn't keeping up. It will get the elicitation when it reconnects.
+		}
+	}
+}
+
+func (b *elicitationBroker) remove(id string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.pending, id)
+}
+
+// respond delivers the user's response to a pending elicitation.
+func (b *elicitationBroker) respond(userID, id string, result nmcp.ElicitResult) error {
+	b.lock.Lock()
+	p, ok := b.pending[id]
+	if ok && p.userID == userID {
+		delete(b.pending, id)
+	}
+	b.lock.Unlock()
+
+	if !ok || p.userID != userID {
+		return ErrElicitationNotFound
+	}
+
+	// The response channel is buffered and only ever receives one response.
+	p.response <- result
+	return nil
+}
+
+// onElicit returns the handler for elicitation requests issued by the given server.
+// The request is surfaced to the server's user and the tool call waits until the user responds or the elicitation expires.
+func (sm *SessionManager) onElicit(server ServerConfig) func(context.Context, nmcp.Message, nmcp.ElicitRequest) (nmcp.ElicitResult, error) {
+	return func(ctx context.Context, _ nmcp.Message, req nmcp.ElicitRequest) (nmcp.ElicitResult, error) {
+		var requestedSchema json.RawMessage
+		if req.RequestedSchema.Type != "" {
+			var err error
+			if requestedSchema, err = json.Marshal(req.RequestedSchema); err != nil {
+				return nmcp.ElicitResult{}, fmt.Errorf("failed to marshal requested schema: %w", err)
+			}
+		}
+
+		now := time.Now()
+		p := &pendingElicitation{
+			userID: server.UserID,
+			elicitation: types.MCPElicitation{
+				ID:                   uuid.NewString(),
+				MCPServerID:          server.MCPServerName,
+				MCPServerDisplayName: server.MCPServerDisplayName,
+				Message:              req.Message,
+				Mode:                 req.Mode,
+				URL:                  req.URL,
+				RequestedSchema:      requestedSchema,
+				Created:              *types.NewTime(now),
+				ExpiresAt:            *types.NewTime(now.Add(elicitationTimeout)),
+			},
+			response: make(chan nmcp.ElicitResult, 1),
+		}
+
+		sm.elicitations.add(p)
+		defer sm.elicitations.remove(p.elicitation.ID)
+
+		timer := time.NewTimer(elicitationTimeout)
+		defer timer.Stop()
+
+		select {
+		case result := <-p.response:
+			return result, nil
+		case <-timer.C:
+			log.Infof("Elicitation %s from MCP server %s expired without a response", p.elicitation.ID, server.MCPServerName)
+			return nmcp.ElicitResult{Action: string(types.MCPElicitationActionCancel)}, nil
+		case <-ctx.Done():
+			return nmcp.ElicitResult{}, ctx.Err()
+		}
+	}
+}
+
+// SubscribeElicitations returns a channel that receives the elicitation requests from MCP servers that are waiting on the given user.
+// Requests that are already pending are sent first. The channel is closed when the context is done.
+func (sm *SessionManager) SubscribeElicitations(ctx context.Context, userID string) <-chan types.MCPElicitation {
+	return sm.elicitations.subscribe(ctx, userID)
+}
+
+// RespondToElicitation resumes the tool call waiting on the given elicitation with the user's response.
+func (sm *SessionManager) RespondToElicitation(userID, id string, response types.MCPElicitationResponse) error {
+	result := nmcp.ElicitResult{
+		Action: string(response.Action),
+	}
+	if response.Action == types.MCPElicitationActionAccept && len(response.Content) > 0 {
+		if err := json.Unmarshal(response.Content, &result.Content); err != nil {
+			return fmt.Errorf("elicitation content must be a JSON object: %w", err)
+		}
+	}
+
+	return sm.elicitations.respond(userID, id, result)
+}
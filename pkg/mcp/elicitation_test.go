@@ -0,0 +1,75 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElicitationResumesOnResponse(t *testing.T) {
+	sm := &SessionManager{elicitations: newElicitationBroker()}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	events := sm.SubscribeElicitations(ctx, "user1")
+
+	type result struct {
+		res nmcp.ElicitResult
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		res, err := sm.onElicit(ServerConfig{UserID: "user1", MCPServerName: "ms1abc"})(t.Context(), nmcp.Message{}, nmcp.ElicitRequest{
+			Message: "What is your favorite color?",
+			RequestedSchema: nmcp.PrimitiveSchema{
+				Type: "object",
+				Properties: map[string]nmcp.PrimitiveProperty{
+					"color": {Type: "string"},
+				},
+			},
+		})
+		done <- result{res, err}
+	}()
+
+	var elicitation types.MCPElicitation
+	select {
+	case elicitation = <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for elicitation event")
+	}
+	assert.Equal(t, "ms1abc", elicitation.MCPServerID)
+	assert.Equal(t, "What is your favorite color?", elicitation.Message)
+	assert.JSONEq(t, `{"type":"object","properties":{"color":{"type":"string"}}}`, string(elicitation.RequestedSchema))
+
+	// Another user cannot respond to the elicitation.
+	err := sm.RespondToElicitation("user2", elicitation.ID, types.MCPElicitationResponse{Action: types.MCPElicitationActionDecline})
+	assert.ErrorIs(t, err, ErrElicitationNotFound)
+
+	// A subscriber that connects late still sees the pending elicitation.
+	late := sm.SubscribeElicitations(ctx, "user1")
+	assert.Equal(t, elicitation.ID, (<-late).ID)
+
+	require.NoError(t, sm.RespondToElicitation("user1", elicitation.ID, types.MCPElicitationResponse{
+		Action:  types.MCPElicitationActionAccept,
+		Content: json.RawMessage(`{"color":"blue"}`),
+	}))
+
+	select {
+	case r := <-done:
+		require.NoError(t, r.err)
+		assert.Equal(t, "accept", r.res.Action)
+		assert.Equal(t, map[string]any{"color": "blue"}, r.res.Content)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the elicitation to resume")
+	}
+
+	// The elicitation is no longer pending.
+	err = sm.RespondToElicitation("user1", elicitation.ID, types.MCPElicitationResponse{Action: types.MCPElicitationActionCancel})
+	assert.ErrorIs(t, err, ErrElicitationNotFound)
+}
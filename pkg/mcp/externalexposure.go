@@ -0,0 +1,155 @@
+package mcp
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	externalExposureIngress = "ingress"
+	externalExposureGateway = "gateway"
+
+	certManagerClusterIssuerAnnotation = "cert-manager.io/cluster-issuer"
+)
+
+var (
+	ingressGVK   = networkingv1.SchemeGroupVersion.WithKind("Ingress")
+	httpRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+)
+
+// externalExposer generates the objects that expose multi-user MCP servers directly on custom hostnames. The objects
+// route to the service of the server, which is served by its shim, so requests are still authenticated.
+type externalExposer struct {
+	mode             string
+	ingressClassName string
+	gatewayName      string
+	gatewayNamespace string
+	certIssuer       string
+}
+
+// newExternalExposer returns the exposer configured by the options, or nil if exposing servers isn't configured.
+func newExternalExposer(opts Options) (*externalExposer, error) {
+	e := &externalExposer{
+		mode:             opts.MCPExternalExposure,
+		ingressClassName: opts.MCPExternalIngressClassName,
+		certIssuer:       opts.MCPExternalCertIssuer,
+	}
+
+	switch opts.MCPExternalExposure {
+	case "":
+		return nil, nil
+	case externalExposureIngress:
+	case externalExposureGateway:
+		namespace, gatewayName, ok := strings.Cut(opts.MCPExternalGateway, "/")
+		if !ok || namespace == "" || gatewayName == "" {
+			return nil, fmt.Errorf("the gateway that exposes MCP servers must be set as namespace/name, got %q", opts.MCPExternalGateway)
+		}
+		e.gatewayNamespace, e.gatewayName = namespace, gatewayName
+	default:
+		return nil, fmt.Errorf("unknown MCP external exposure %q, must be %s or %s", opts.MCPExternalExposure, externalExposureIngress, externalExposureGateway)
+	}
+
+	return e, nil
+}
+
+// gvk returns the kind of the objects that expose servers, so that they are pruned when a server stops being exposed.
+func (e *externalExposer) gvk() schema.GroupVersionKind {
+	if e.mode == externalExposureGateway {
+		return httpRouteGVK
+	}
+	return ingressGVK
+}
+
+// object returns the object that exposes the server on its external hostname, or nil if the server isn't exposed.
+func (e *externalExposer) object(server ServerConfig, namespace string, annotations map[string]string) kclient.Object {
+	if server.ExternalHostname == "" {
+		return nil
+	}
+	if e.mode == externalExposureGateway {
+		return e.httpRoute(server, namespace, annotations)
+	}
+	return e.ingress(server, namespace, annotations)
+}
+
+func (e *externalExposer) ingress(server ServerConfig, namespace string, annotations map[string]string) *networkingv1.Ingress {
+	// The annotations are shared with the other objects of the server.
+	annotations = maps.Clone(annotations)
+	if e.certIssuer != "" {
+		annotations[certManagerClusterIssuerAnnotation] = e.certIssuer
+	}
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        server.MCPServerName,
+			Namespace:   namespace,
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{{
+				Hosts:      []string{server.ExternalHostname},
+				SecretName: server.ExternalTLSSecretName,
+			}},
+			Rules: []networkingv1.IngressRule{{
+				Host: server.ExternalHostname,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: new(networkingv1.PathTypePrefix),
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: server.MCPServerName,
+									Port: networkingv1.ServiceBackendPort{Name: "http"},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if e.ingressClassName != "" {
+		ingress.Spec.IngressClassName = new(e.ingressClassName)
+	}
+
+	return ingress
+}
+
+// httpRoute returns the HTTPRoute that attaches the server to the configured Gateway. The Gateway terminates TLS, so the
+// TLS secret of the server isn't used. The route is unstructured so that Obot doesn't depend on the Gateway API types.
+func (e *externalExposer) httpRoute(server ServerConfig, namespace string, annotations map[string]string) *unstructured.Unstructured {
+	route := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"parentRefs": []any{
+				map[string]any{
+					"name":      e.gatewayName,
+					"namespace": e.gatewayNamespace,
+				},
+			},
+			"hostnames": []any{server.ExternalHostname},
+			"rules": []any{
+				map[string]any{
+					"backendRefs": []any{
+						map[string]any{
+							"name": server.MCPServerName,
+							"port": int64(80),
+						},
+					},
+				},
+			},
+		},
+	}}
+	route.SetGroupVersionKind(httpRouteGVK)
+	route.SetName(server.MCPServerName)
+	route.SetNamespace(namespace)
+	route.SetAnnotations(maps.Clone(annotations))
+
+	return route
+}
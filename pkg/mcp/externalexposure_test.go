@@ -0,0 +1,76 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNewExternalExposer(t *testing.T) {
+	exposer, err := newExternalExposer(Options{})
+	require.NoError(t, err)
+	assert.Nil(t, exposer)
+
+	for _, opts := range []Options{
+		{MCPExternalExposure: "loadbalancer"},
+		{MCPExternalExposure: externalExposureGateway},
+		{MCPExternalExposure: externalExposureGateway, MCPExternalGateway: "gateway"},
+	} {
+		_, err = newExternalExposer(opts)
+		assert.Error(t, err, opts.MCPExternalExposure+" "+opts.MCPExternalGateway)
+	}
+}
+
+func TestExternalExposerIngress(t *testing.T) {
+	exposer, err := newExternalExposer(Options{
+		MCPExternalExposure:         externalExposureIngress,
+		MCPExternalIngressClassName: "nginx",
+		MCPExternalCertIssuer:       "letsencrypt",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ingressGVK, exposer.gvk())
+
+	annotations := map[string]string{"mcp-server-scope": "ms1abc"}
+	assert.Nil(t, exposer.object(ServerConfig{MCPServerName: "ms1abc"}, "obot-mcp", annotations))
+
+	obj := exposer.object(ServerConfig{MCPServerName: "ms1abc", ExternalHostname: "jira.mcp.example.com", ExternalTLSSecretName: "ms1abc-tls"}, "obot-mcp", annotations)
+	ingress, ok := obj.(*networkingv1.Ingress)
+	require.True(t, ok)
+	assert.Equal(t, "ms1abc", ingress.Name)
+	assert.Equal(t, "obot-mcp", ingress.Namespace)
+	assert.Equal(t, "letsencrypt", ingress.Annotations[certManagerClusterIssuerAnnotation])
+	assert.NotContains(t, annotations, certManagerClusterIssuerAnnotation, "the shared annotations must not be modified")
+	assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+	assert.Equal(t, []networkingv1.IngressTLS{{Hosts: []string{"jira.mcp.example.com"}, SecretName: "ms1abc-tls"}}, ingress.Spec.TLS)
+	require.Len(t, ingress.Spec.Rules, 1)
+	assert.Equal(t, "jira.mcp.example.com", ingress.Spec.Rules[0].Host)
+	backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+	assert.Equal(t, "ms1abc", backend.Name)
+	assert.Equal(t, "http", backend.Port.Name)
+}
+
+func TestExternalExposerHTTPRoute(t *testing.T) {
+	exposer, err := newExternalExposer(Options{
+		MCPExternalExposure: externalExposureGateway,
+		MCPExternalGateway:  "gateway-system/public",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, httpRouteGVK, exposer.gvk())
+
+	obj := exposer.object(ServerConfig{MCPServerName: "ms1abc", ExternalHostname: "jira.mcp.example.com"}, "obot-mcp", nil)
+	route, ok := obj.(*unstructured.Unstructured)
+	require.True(t, ok)
+	assert.Equal(t, httpRouteGVK, route.GroupVersionKind())
+	assert.Equal(t, "ms1abc", route.GetName())
+	assert.Equal(t, "obot-mcp", route.GetNamespace())
+
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	assert.Equal(t, []string{"jira.mcp.example.com"}, hostnames)
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	assert.Equal(t, []any{map[string]any{"name": "public", "namespace": "gateway-system"}}, parentRefs)
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	assert.Equal(t, []any{map[string]any{"backendRefs": []any{map[string]any{"name": "ms1abc", "port": int64(80)}}}}, rules)
+}
@@ -0,0 +1,83 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// fakeBackend deploys nothing. Servers are expected to already be running at their URLs, as the mock MCP servers of
+// tests are. It is selected with the "fake" runtime backend and is meant for tests, not for running Obot.
+type fakeBackend struct {
+	lock     sync.Mutex
+	deployed map[string]ServerConfig
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{
+		deployed: make(map[string]ServerConfig),
+	}
+}
+
+func (f *fakeBackend) ensureServerDeployment(_ context.Context, server ServerConfig, _ []Webhook) (ServerConfig, error) {
+	if server.URL == "" {
+		return ServerConfig{}, fmt.Errorf("MCP server %s has no URL, the fake backend can only run servers that are already running", server.MCPServerName)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.deployed[server.MCPServerName] = server
+	return server, nil
+}
+
+func (f *fakeBackend) deployServer(ctx context.Context, server ServerConfig, webhooks []Webhook) error {
+	_, err := f.ensureServerDeployment(ctx, server, webhooks)
+	return err
+}
+
+func (f *fakeBackend) transformConfig(_ context.Context, server ServerConfig) (*ServerConfig, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	deployed, ok := f.deployed[server.MCPServerName]
+	if !ok {
+		return nil, nil
+	}
+	return &deployed, nil
+}
+
+func (f *fakeBackend) streamServerLogs(context.Context, string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func (f *fakeBackend) getServerDetails(_ context.Context, id string) (types.MCPServerDetails, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	_, ok := f.deployed[id]
+	return types.MCPServerDetails{
+		DeploymentName: id,
+		IsAvailable:    ok,
+	}, nil
+}
+
+func (f *fakeBackend) restartServer(context.Context, ServerConfig) error {
+	return nil
+}
+
+func (f *fakeBackend) shutdownServer(_ context.Context, id string, _ bool) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	delete(f.deployed, id)
+	return nil
+}
+
+func (f *fakeBackend) transformObotHostname(url string) string {
+	return url
+}
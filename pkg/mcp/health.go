@@ -0,0 +1,73 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// ServerHealth reports the health of the MCP server. Unlike the other operations of the session manager, it does not
+// deploy the server if it isn't running.
+func (sm *SessionManager) ServerHealth(ctx context.Context, serverConfig ServerConfig) types.MCPServerHealthStatus {
+	switch {
+	case serverConfig.Runtime == types.RuntimeStdio:
+		// Stdio servers only run while Obot has a client for them.
+		if !sm.hasClients(serverConfig.MCPServerName) {
+			return types.MCPServerHealthNotDeployed
+		}
+	case serverConfig.Runtime == types.RuntimeRemote && serverConfig.ProjectMCPServer:
+		// Obot doesn't deploy anything for these servers.
+	default:
+		if _, err := sm.backend.getServerDetails(ctx, serverConfig.MCPServerName); errors.Is(err, ErrServerNotRunning) {
+			return types.MCPServerHealthNotDeployed
+		} else if err != nil {
+			log.Debugf("failed to get details of MCP server %s: %v", serverConfig.MCPServerName, err)
+			return types.MCPServerHealthUnhealthy
+		}
+	}
+
+	if _, err := sm.PingServer(ctx, serverConfig); err != nil {
+		if _, ok := errors.AsType[nmcp.AuthRequiredErr](err); ok {
+			return types.MCPServerHealthAuthRequired
+		}
+		log.Debugf("failed to ping MCP server %s: %v", serverConfig.MCPServerName, err)
+		return types.MCPServerHealthUnhealthy
+	}
+
+	return types.MCPServerHealthReady
+}
+
+// ProbeServer checks that the deployment of the MCP server is ready without deploying it or connecting to it, and
+// returns the details of the deployment. It returns ErrServerNotRunning if the server is not deployed.
+func (sm *SessionManager) ProbeServer(ctx context.Context, serverName string) (types.MCPServerDetails, error) {
+	details, err := sm.backend.getServerDetails(ctx, serverName)
+	if err != nil {
+		return details, err
+	}
+	if !details.IsAvailable {
+		return details, ErrHealthCheckFailed
+	}
+	return details, nil
+}
+
+func (sm *SessionManager) hasClients(serverName string) bool {
+	sessions, ok := sm.sessions.Load(serverName)
+	if !ok {
+		return false
+	}
+
+	clientSessions, ok := sessions.(*sync.Map)
+	if !ok || clientSessions == nil {
+		return false
+	}
+
+	var found bool
+	clientSessions.Range(func(any, any) bool {
+		found = true
+		return false
+	})
+	return found
+}
@@ -0,0 +1,272 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/wait"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	imageBuilderKaniko     = "kaniko"
+	imageBuilderBuildpacks = "buildpacks"
+
+	defaultKanikoImage     = "gcr.io/kaniko-project/executor:latest"
+	defaultBuildpacksImage = "paketobuildpacks/builder-jammy-base:latest"
+
+	// imageBuildTimeout is how long a build of an image can take before the launch of its server fails.
+	imageBuildTimeout = 30 * time.Minute
+
+	imageBuildLabel = "obot.ai/mcp-image-build"
+	sourceDir       = "/workspace/source"
+)
+
+var ErrImageBuildFailed = errors.New("failed to build MCP server image")
+
+// imageBuilder builds the images of MCP servers with the source runtime, and returns the built image.
+type imageBuilder interface {
+	buildImage(ctx context.Context, source types.SourceRuntimeConfig) (string, error)
+}
+
+// jobImageBuilder builds images with Kubernetes jobs that fetch the source of a server, and build and push its image
+// with Kaniko or Cloud Native Buildpacks. Images are tagged with the hash of their source configuration, so each
+// source is only built once.
+type jobImageBuilder struct {
+	client       kclient.WithWatch
+	namespace    string
+	builder      string
+	builderImage string
+	sourceImage  string
+	registry     string
+	pushSecret   string
+
+	lock  sync.Mutex
+	built map[string]struct{}
+}
+
+// newJobImageBuilder returns the image builder configured by the options, or nil if building images isn't configured.
+func newJobImageBuilder(client kclient.WithWatch, opts Options) (*jobImageBuilder, error) {
+	builderImage := opts.MCPImageBuilderImage
+	switch opts.MCPImageBuilder {
+	case "":
+		return nil, nil
+	case imageBuilderKaniko:
+		if builderImage == "" {
+			builderImage = defaultKanikoImage
+		}
+	case imageBuilderBuildpacks:
+		if builderImage == "" {
+			builderImage = defaultBuildpacksImage
+		}
+	default:
+		return nil, fmt.Errorf("unknown MCP image builder %q, must be %s or %s", opts.MCPImageBuilder, imageBuilderKaniko, imageBuilderBuildpacks)
+	}
+
+	if opts.MCPImageBuildRegistry == "" {
+		return nil, fmt.Errorf("an image registry is required to build MCP server images with %s", opts.MCPImageBuilder)
+	}
+
+	namespace := opts.MCPImageBuildNamespace
+	if namespace == "" {
+		namespace = opts.MCPNamespace
+	}
+
+	return &jobImageBuilder{
+		client:       client,
+		namespace:    namespace,
+		builder:      opts.MCPImageBuilder,
+		builderImage: builderImage,
+		sourceImage:  opts.MCPImageBuildSourceImage,
+		registry:     opts.MCPImageBuildRegistry,
+		pushSecret:   opts.MCPImageBuildPushSecret,
+		built:        make(map[string]struct{}),
+	}, nil
+}
+
+func (b *jobImageBuilder) buildImage(ctx context.Context, source types.SourceRuntimeConfig) (string, error) {
+	digest := sourceDigest(b.builder, source)
+	image := fmt.Sprintf("%s:%s", b.registry, digest)
+
+	b.lock.Lock()
+	_, built := b.built[image]
+	b.lock.Unlock()
+	if built {
+		return image, nil
+	}
+
+	jobName := name.SafeConcatName("mcp-image-build", digest)
+
+	var job batchv1.Job
+	if err := b.client.Get(ctx, kclient.ObjectKey{Namespace: b.namespace, Name: jobName}, &job); apierrors.IsNotFound(err) {
+		if err = b.createJob(ctx, jobName, image, source); err != nil {
+			return "", err
+		}
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get image build job %s: %w", jobName, err)
+	} else if jobFailed(&job) {
+		// Build the image again, in case the failure was temporary or the source has changed since.
+		if err = b.client.Delete(ctx, &job, kclient.PropagationPolicy(metav1.DeletePropagationBackground)); kclient.IgnoreNotFound(err) != nil {
+			return "", fmt.Errorf("failed to delete failed image build job %s: %w", jobName, err)
+		}
+		if err = b.createJob(ctx, jobName, image, source); err != nil {
+			return "", err
+		}
+	}
+
+	olog.Debugf("Waiting for image build job: job=%s image=%s", jobName, image)
+	if _, err := wait.For(ctx, b.client, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: b.namespace}},
+		func(job *batchv1.Job) (bool, error) {
+			if jobFailed(job) {
+				return false, fmt.Errorf("%w: image build job %s in namespace %s failed, check its logs", ErrImageBuildFailed, jobName, b.namespace)
+			}
+			return job.Status.Succeeded > 0, nil
+		},
+		wait.Option{Timeout: imageBuildTimeout},
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return "", fmt.Errorf("%w: timed out waiting for image build job %s", ErrImageBuildFailed, jobName)
+		}
+		return "", err
+	}
+
+	b.lock.Lock()
+	b.built[image] = struct{}{}
+	b.lock.Unlock()
+
+	return image, nil
+}
+
+func (b *jobImageBuilder) createJob(ctx context.Context, jobName, image string, source types.SourceRuntimeConfig) error {
+	olog.Infof("Building MCP server image: job=%s image=%s", jobName, image)
+	if err := b.client.Create(ctx, b.job(jobName, image, source)); kclient.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("failed to create image build job %s: %w", jobName, err)
+	}
+	return nil
+}
+
+// job returns the job that builds the image from the source. An init container fetches the source into a shared
+// volume, and the builder builds and pushes the image from it.
+func (b *jobImageBuilder) job(jobName, image string, source types.SourceRuntimeConfig) *batchv1.Job {
+	var (
+		fetchScript string
+		fetchEnv    []corev1.EnvVar
+	)
+	// The source is passed in environment variables, so that it can't change the scripts.
+	if source.Repository != "" {
+		fetchScript = `git clone "$REPOSITORY" ` + sourceDir + ` && if [ -n "$REF" ]; then git -C ` + sourceDir + ` checkout "$REF"; fi`
+		fetchEnv = []corev1.EnvVar{{Name: "REPOSITORY", Value: source.Repository}, {Name: "REF", Value: source.Ref}}
+	} else {
+		fetchScript = `mkdir -p ` + sourceDir + ` && wget -qO- "$BUNDLE_URL" | tar -xz -C ` + sourceDir
+		fetchEnv = []corev1.EnvVar{{Name: "BUNDLE_URL", Value: source.BundleURL}}
+	}
+	// The builder may not run as the same user as the fetcher.
+	fetchScript += ` && chmod -R a+rwX /workspace`
+
+	contextDir := path.Join(sourceDir, source.ContextDir)
+	workspaceMount := corev1.VolumeMount{Name: "workspace", MountPath: "/workspace"}
+	builder := corev1.Container{
+		Name:         "build",
+		Image:        b.builderImage,
+		VolumeMounts: []corev1.VolumeMount{workspaceMount},
+	}
+
+	var dockerConfigDir string
+	switch b.builder {
+	case imageBuilderKaniko:
+		dockerfile := source.Dockerfile
+		if dockerfile == "" {
+			dockerfile = "Dockerfile"
+		}
+		builder.Args = []string{
+			"--context=dir://" + contextDir,
+			"--dockerfile=" + path.Join(contextDir, dockerfile),
+			"--destination=" + image,
+		}
+		dockerConfigDir = "/kaniko/.docker"
+	case imageBuilderBuildpacks:
+		builder.Command = []string{"/cnb/lifecycle/creator"}
+		builder.Args = []string{"-app=" + contextDir, image}
+		dockerConfigDir = "/docker-config"
+		builder.Env = append(builder.Env, corev1.EnvVar{Name: "DOCKER_CONFIG", Value: dockerConfigDir})
+	}
+
+	volumes := []corev1.Volume{{
+		Name:         "workspace",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}}
+	if b.pushSecret != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: "docker-config",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: b.pushSecret,
+					Items:      []corev1.KeyToPath{{Key: corev1.DockerConfigJsonKey, Path: "config.json"}},
+				},
+			},
+		})
+		builder.VolumeMounts = append(builder.VolumeMounts, corev1.VolumeMount{Name: "docker-config", MountPath: dockerConfigDir, ReadOnly: true})
+	}
+
+	labels := map[string]string{imageBuildLabel: "true"}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: b.namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				"obot.ai/image": image,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: new(int32(0)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					InitContainers: []corev1.Container{{
+						Name:         "fetch",
+						Image:        b.sourceImage,
+						Command:      []string{"sh", "-c", fetchScript},
+						Env:          fetchEnv,
+						VolumeMounts: []corev1.VolumeMount{workspaceMount},
+					}},
+					Containers: []corev1.Container{builder},
+					Volumes:    volumes,
+				},
+			},
+		},
+	}
+}
+
+func jobFailed(job *batchv1.Job) bool {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceDigest returns the hash of the parts of the source configuration that change the built image.
+func sourceDigest(builder string, source types.SourceRuntimeConfig) string {
+	return hash.Digest(map[string]string{
+		"builder":    builder,
+		"repository": source.Repository,
+		"ref":        source.Ref,
+		"bundleURL":  source.BundleURL,
+		"contextDir": source.ContextDir,
+		"dockerfile": source.Dockerfile,
+	})
+}
@@ -0,0 +1,81 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestNewJobImageBuilder(t *testing.T) {
+	builder, err := newJobImageBuilder(nil, Options{})
+	require.NoError(t, err)
+	assert.Nil(t, builder)
+
+	_, err = newJobImageBuilder(nil, Options{MCPImageBuilder: "docker", MCPImageBuildRegistry: "registry.example.com/mcp"})
+	require.Error(t, err)
+
+	_, err = newJobImageBuilder(nil, Options{MCPImageBuilder: imageBuilderKaniko})
+	require.Error(t, err)
+
+	builder, err = newJobImageBuilder(nil, Options{MCPImageBuilder: imageBuilderBuildpacks, MCPImageBuildRegistry: "registry.example.com/mcp", MCPNamespace: "obot-mcp"})
+	require.NoError(t, err)
+	assert.Equal(t, defaultBuildpacksImage, builder.builderImage)
+	assert.Equal(t, "obot-mcp", builder.namespace)
+}
+
+func TestJobImageBuilderJob(t *testing.T) {
+	source := types.SourceRuntimeConfig{
+		Repository: "https://github.com/example/mcp-server.git",
+		Ref:        "v1.2.0",
+		ContextDir: "server",
+		Dockerfile: "build/Dockerfile",
+	}
+
+	kaniko := &jobImageBuilder{namespace: "builds", builder: imageBuilderKaniko, builderImage: defaultKanikoImage, sourceImage: "alpine/git", pushSecret: "push"}
+	job := kaniko.job("build", "registry.example.com/mcp:abc", source)
+
+	assert.Equal(t, "builds", job.Namespace)
+	fetch := job.Spec.Template.Spec.InitContainers[0]
+	assert.Contains(t, fetch.Env, corev1.EnvVar{Name: "REPOSITORY", Value: source.Repository})
+	assert.Contains(t, fetch.Env, corev1.EnvVar{Name: "REF", Value: source.Ref})
+	// The source is never put in the script itself.
+	assert.NotContains(t, fetch.Command[2], source.Repository)
+
+	build := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []string{
+		"--context=dir:///workspace/source/server",
+		"--dockerfile=/workspace/source/server/build/Dockerfile",
+		"--destination=registry.example.com/mcp:abc",
+	}, build.Args)
+	assert.Contains(t, build.VolumeMounts, corev1.VolumeMount{Name: "docker-config", MountPath: "/kaniko/.docker", ReadOnly: true})
+
+	buildpacks := &jobImageBuilder{namespace: "builds", builder: imageBuilderBuildpacks, builderImage: defaultBuildpacksImage, sourceImage: "alpine/git"}
+	job = buildpacks.job("build", "registry.example.com/mcp:abc", types.SourceRuntimeConfig{BundleURL: "https://example.com/mcp.tar.gz"})
+
+	fetch = job.Spec.Template.Spec.InitContainers[0]
+	assert.Equal(t, []corev1.EnvVar{{Name: "BUNDLE_URL", Value: "https://example.com/mcp.tar.gz"}}, fetch.Env)
+	build = job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []string{"/cnb/lifecycle/creator"}, build.Command)
+	assert.Equal(t, []string{"-app=/workspace/source", "registry.example.com/mcp:abc"}, build.Args)
+	assert.Len(t, job.Spec.Template.Spec.Volumes, 1)
+}
+
+func TestSourceDigest(t *testing.T) {
+	source := types.SourceRuntimeConfig{Repository: "https://github.com/example/mcp-server.git", Ref: "v1", Port: 8080, Path: "/mcp"}
+
+	digest := sourceDigest(imageBuilderKaniko, source)
+	assert.Len(t, digest, 64)
+
+	// Changes that don't change the image don't change the digest.
+	changed := source
+	changed.Port, changed.Path, changed.Args = 9090, "/", []string{"--verbose"}
+	assert.Equal(t, digest, sourceDigest(imageBuilderKaniko, changed))
+
+	changed = source
+	changed.Ref = "v2"
+	assert.NotEqual(t, digest, sourceDigest(imageBuilderKaniko, changed))
+	assert.NotEqual(t, digest, sourceDigest(imageBuilderBuildpacks, source))
+}
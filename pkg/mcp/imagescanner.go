@@ -0,0 +1,156 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/wait"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// imageScanTimeout is how long a scan of an image can take before it fails.
+	imageScanTimeout = 15 * time.Minute
+	// imageScanLogLimit is the number of bytes of the output of a failed scan that is returned with its findings.
+	imageScanLogLimit = 16 * 1024
+
+	imageScanLabel = "obot.ai/mcp-image-scan"
+)
+
+var (
+	ErrImageScanNotConfigured = errors.New("scanning images is not configured")
+	// ErrImageScanFindings is returned when a scan finds vulnerabilities of the configured severities in an image.
+	ErrImageScanFindings = errors.New("image scan found vulnerabilities")
+)
+
+// jobImageScanner scans the images of MCP servers for vulnerabilities with Kubernetes jobs that run Trivy.
+type jobImageScanner struct {
+	client       kclient.WithWatch
+	clientset    kubernetes.Interface
+	namespace    string
+	scannerImage string
+	severity     string
+}
+
+// newJobImageScanner returns the image scanner configured by the options, or nil if scanning images isn't configured.
+func newJobImageScanner(client kclient.WithWatch, clientset kubernetes.Interface, opts Options) *jobImageScanner {
+	if opts.MCPImageScannerImage == "" {
+		return nil
+	}
+
+	namespace := opts.MCPImageBuildNamespace
+	if namespace == "" {
+		namespace = opts.MCPNamespace
+	}
+
+	return &jobImageScanner{
+		client:       client,
+		clientset:    clientset,
+		namespace:    namespace,
+		scannerImage: opts.MCPImageScannerImage,
+		severity:     opts.MCPImageScanSeverity,
+	}
+}
+
+// scanImage scans the image, and returns the output of the scanner. The error wraps ErrImageScanFindings if the image
+// has vulnerabilities of the configured severities.
+func (s *jobImageScanner) scanImage(ctx context.Context, image string) (string, error) {
+	// Every scan gets its own job, since the image of a tag can change and new vulnerabilities are found over time.
+	// Finished jobs are cleaned up after a day.
+	job := s.job(image)
+	if err := s.client.Create(ctx, job); err != nil {
+		return "", fmt.Errorf("failed to create image scan job: %w", err)
+	}
+	jobName := job.Name
+	olog.Infof("Scanning MCP server image: job=%s image=%s", jobName, image)
+
+	job, err := wait.For(ctx, s.client, job, func(job *batchv1.Job) (bool, error) {
+		return job.Status.Succeeded > 0 || jobFailed(job), nil
+	}, wait.Option{Timeout: imageScanTimeout})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "", fmt.Errorf("timed out waiting for image scan job %s", jobName)
+	} else if err != nil {
+		return "", err
+	}
+
+	// Trivy exits with an error for vulnerabilities of the configured severities, and if it can't scan the image, which
+	// the output explains.
+	output := s.output(ctx, jobName)
+	if jobFailed(job) {
+		return output, fmt.Errorf("%w: %s vulnerabilities in %s", ErrImageScanFindings, s.severity, image)
+	}
+	return output, nil
+}
+
+// output returns the end of the logs of the pod of the scan job, or an empty string if they can't be read.
+func (s *jobImageScanner) output(ctx context.Context, jobName string) string {
+	pods, err := s.clientset.CoreV1().Pods(s.namespace).List(ctx, metav1.ListOptions{LabelSelector: "job-name=" + jobName})
+	if err != nil || len(pods.Items) == 0 {
+		return ""
+	}
+
+	logs, err := s.clientset.CoreV1().Pods(s.namespace).GetLogs(pods.Items[0].Name, &corev1.PodLogOptions{
+		LimitBytes: new(int64(imageScanLogLimit)),
+	}).Stream(ctx)
+	if err != nil {
+		return ""
+	}
+	defer logs.Close()
+
+	output, _ := io.ReadAll(logs)
+	return strings.TrimSpace(string(output))
+}
+
+// job returns the job that scans the image. The job fails if the image has vulnerabilities of the configured
+// severities.
+func (s *jobImageScanner) job(image string) *batchv1.Job {
+	labels := map[string]string{imageScanLabel: "true"}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "mcp-image-scan-",
+			Namespace:    s.namespace,
+			Labels:       labels,
+			Annotations: map[string]string{
+				"obot.ai/image": image,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            new(int32(0)),
+			TTLSecondsAfterFinished: new(int32(24 * 60 * 60)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:  "scan",
+						Image: s.scannerImage,
+						Args: []string{
+							"image",
+							"--exit-code=1",
+							"--no-progress",
+							"--severity=" + s.severity,
+							image,
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// ScanImage scans the image of an MCP server for vulnerabilities, and returns the output of the scanner. It returns
+// ErrImageScanNotConfigured if there is no image scanner, which is only supported by the Kubernetes backend.
+func (sm *SessionManager) ScanImage(ctx context.Context, image string) (string, error) {
+	if sm.imageScanner == nil {
+		return "", ErrImageScanNotConfigured
+	}
+	return sm.imageScanner.scanImage(ctx, image)
+}
@@ -0,0 +1,23 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJobImageScanner(t *testing.T) {
+	assert.Nil(t, newJobImageScanner(nil, nil, Options{}))
+
+	scanner := newJobImageScanner(nil, nil, Options{MCPImageScannerImage: "aquasec/trivy:latest", MCPImageScanSeverity: "CRITICAL", MCPNamespace: "obot-mcp"})
+	require.NotNil(t, scanner)
+	assert.Equal(t, "obot-mcp", scanner.namespace)
+
+	job := scanner.job("ghcr.io/example/mcp-server:v1")
+	assert.Equal(t, "obot-mcp", job.Namespace)
+	assert.Equal(t, "mcp-image-scan-", job.GenerateName)
+	require.Len(t, job.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, "aquasec/trivy:latest", job.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, []string{"image", "--exit-code=1", "--no-progress", "--severity=CRITICAL", "ghcr.io/example/mcp-server:v1"}, job.Spec.Template.Spec.Containers[0].Args)
+}
@@ -38,7 +38,12 @@ import (
 
 var olog = logger.Package()
 
-const maxDeploymentWatchRetries = 5
+const (
+	maxDeploymentWatchRetries = 5
+
+	// objectsHashAnnotation is the annotation on the deployment of a server with the hash of the objects it was applied with.
+	objectsHashAnnotation = "obot.ai/objects-hash"
+)
 
 type kubernetesBackend struct {
 	clientset                     *kubernetes.Clientset
@@ -54,6 +59,11 @@ type kubernetesBackend struct {
 	obotClient                    kclient.Client
 	deploymentCacheMu             sync.RWMutex
 	deploymentCache               map[string]*kubernetesDeploymentCacheEntry
+	deployments                   *deploymentQueue
+	imageBuilder                  imageBuilder
+	exposer                       *externalExposer
+	zoneAwareRouting              bool
+	clientIPAffinity              bool
 }
 
 type kubernetesDeploymentCacheEntry struct {
@@ -61,13 +71,13 @@ type kubernetesDeploymentCacheEntry struct {
 	podName string
 }
 
-func newKubernetesBackend(clientset *kubernetes.Clientset, client kclient.WithWatch, obotClient kclient.Client, opts Options) backend {
+func newKubernetesBackend(clientset *kubernetes.Clientset, client kclient.WithWatch, obotClient kclient.Client, opts Options, deployments *deploymentQueue, builder *jobImageBuilder, exposer *externalExposer) backend {
 	var serviceFQDN string
 	if opts.ServiceName != "" && opts.ServiceNamespace != "" {
 		serviceFQDN = fmt.Sprintf("%s.%s.svc.%s", opts.ServiceName, opts.ServiceNamespace, opts.MCPClusterDomain)
 	}
 
-	return &kubernetesBackend{
+	k := &kubernetesBackend{
 		clientset:                     clientset,
 		client:                        client,
 		baseImage:                     opts.MCPBaseImage,
@@ -80,7 +90,16 @@ func newKubernetesBackend(clientset *kubernetes.Clientset, client kclient.WithWa
 		auditLogsFlushIntervalSeconds: opts.MCPAuditLogPersistIntervalSeconds,
 		obotClient:                    obotClient,
 		deploymentCache:               map[string]*kubernetesDeploymentCacheEntry{},
+		deployments:                   deployments,
+		exposer:                       exposer,
+		zoneAwareRouting:              opts.MCPZoneAwareRouting,
+		clientIPAffinity:              opts.MCPClientIPAffinity,
 	}
+	if builder != nil {
+		// Only assign the builder if it is configured, so that the interface isn't a typed nil.
+		k.imageBuilder = builder
+	}
+	return k
 }
 
 func (k *kubernetesBackend) deployServer(ctx context.Context, server ServerConfig, webhooks []Webhook) error {
@@ -107,7 +126,7 @@ func (k *kubernetesBackend) deployServerObjects(ctx context.Context, server Serv
 		return fmt.Errorf("failed to cleanup old MCP deployment %s: %w", server.MCPServerName, err)
 	}
 
-	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(server.MCPServerName).Apply(ctx, nil, objs...); err != nil {
+	if err := k.serverObjectsApply(server.MCPServerName).Apply(ctx, nil, objs...); err != nil {
 		return fmt.Errorf("failed to create MCP deployment %s: %w", server.MCPServerName, err)
 	}
 
@@ -121,29 +140,72 @@ func (k *kubernetesBackend) ensureServerDeployment(ctx context.Context, server S
 		server.Components[i] = component
 	}
 
+	if server.Source != nil {
+		// Images are tagged with the hash of their source, so this only waits for a build the first time.
+		if k.imageBuilder == nil {
+			return ServerConfig{}, &ErrNotSupportedByBackend{Feature: "source runtime without an image builder", Backend: "kubernetes"}
+		}
+
+		image, err := k.imageBuilder.buildImage(ctx, *server.Source)
+		if err != nil {
+			return ServerConfig{}, err
+		}
+		server.ContainerImage = image
+	}
+
 	serverConfigHash := hash.Digest(map[string]any{"server": server, "webhooks": webhooks})
 	cachedDeployment := k.getDeploymentCache(server.MCPServerName)
 
-	shouldDeploy := cachedDeployment == nil || cachedDeployment.hash != serverConfigHash
+	var (
+		objs         []kclient.Object
+		err          error
+		shouldDeploy = cachedDeployment != nil && cachedDeployment.hash != serverConfigHash
+	)
 	if !shouldDeploy {
 		var deployment appsv1.Deployment
 		if err := k.client.Get(ctx, kclient.ObjectKey{Name: server.MCPServerName, Namespace: k.mcpNamespace}, &deployment); apierrors.IsNotFound(err) {
 			shouldDeploy = true
 		} else if err != nil {
 			return ServerConfig{}, fmt.Errorf("failed to get deployment %s: %w", server.MCPServerName, err)
+		} else if cachedDeployment == nil {
+			// This replica hasn't launched the server, for example because it just became the controller leader while
+			// another replica was waiting for the deployment. If the deployment was applied from the same objects, then
+			// resume waiting for it instead of redeploying it.
+			objs, err = k.k8sObjects(ctx, server, webhooks)
+			if err != nil {
+				return ServerConfig{}, fmt.Errorf("failed to generate kubernetes objects for server %s: %w", server.MCPServerName, err)
+			}
+
+			shouldDeploy = deployment.Annotations[objectsHashAnnotation] != deploymentObjectsHash(objs)
+			if !shouldDeploy {
+				olog.Debugf("Resuming launch of MCP server %s from its existing deployment", server.MCPServerName)
+			}
 		}
 	}
 
 	if shouldDeploy {
-		olog.Infof("Triggering redeploy for MCP server %s", server.MCPServerName)
-		objs, err := k.k8sObjects(ctx, server, webhooks)
+		// Only deployments wait in the deployment queue, so that requests to servers that are already running don't.
+		release, err := k.deployments.acquire(ctx, server.MCPServerName, deploymentUserID(server))
 		if err != nil {
-			return ServerConfig{}, fmt.Errorf("failed to generate kubernetes objects for server %s: %w", server.MCPServerName, err)
+			return ServerConfig{}, err
+		}
+		defer release()
+
+		olog.Infof("Triggering redeploy for MCP server %s", server.MCPServerName)
+		if objs == nil {
+			objs, err = k.k8sObjects(ctx, server, webhooks)
+			if err != nil {
+				return ServerConfig{}, fmt.Errorf("failed to generate kubernetes objects for server %s: %w", server.MCPServerName, err)
+			}
 		}
 
 		if err := k.deployServerObjects(ctx, server, objs); err != nil {
 			return ServerConfig{}, err
 		}
+
+		if server.Runtime == types.RuntimeComposite && cachedDeployment != nil {
+			k.refreshCompositeConfig(ctx, server)
+		}
 	}
 
 	u := fmt.Sprintf("http://%s.%s.svc.%s", server.MCPServerName, k.mcpNamespace, k.mcpClusterDomain)
@@ -215,9 +277,10 @@ func (k *kubernetesBackend) getServerDetails(ctx context.Context, id string) (ty
 	}
 
 	var (
-		lastRestart types.Time
-		pods        corev1.PodList
-		podEvents   []corev1.Event
+		lastRestart  types.Time
+		restartCount int32
+		pods         corev1.PodList
+		podEvents    []corev1.Event
 	)
 	if err := k.client.List(ctx, &pods, kclient.InNamespace(k.mcpNamespace), kclient.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
 		return types.MCPServerDetails{}, fmt.Errorf("failed to get pods: %w", err)
@@ -227,6 +290,9 @@ func (k *kubernetesBackend) getServerDetails(ctx context.Context, id string) (ty
 		if pod.Status.Phase == corev1.PodRunning {
 			lastRestart = types.Time{Time: pod.CreationTimestamp.Time}
 		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			restartCount += cs.RestartCount
+		}
 
 		var eventList corev1.EventList
 		if err := k.client.List(ctx, &eventList, kclient.InNamespace(k.mcpNamespace), kclient.MatchingFieldsSelector{
@@ -279,10 +345,30 @@ func (k *kubernetesBackend) getServerDetails(ctx context.Context, id string) (ty
 		ReadyReplicas:  deployment.Status.ReadyReplicas,
 		Replicas:       deployment.Status.Replicas,
 		IsAvailable:    deployment.Status.ReadyReplicas > 0,
+		RestartCount:   restartCount,
+		FailureReason:  permanentFailureReason(pods.Items),
 		Events:         mcpEvents,
 	}, nil
 }
 
+// permanentFailureReason returns the reason that a container of the pods is waiting, if it is one that won't resolve
+// without someone fixing the server's image or configuration. ErrImagePull is not included because the image may be
+// pulled on the next attempt, but ImagePullBackOff is, because it means that pulling the image failed repeatedly.
+func permanentFailureReason(pods []corev1.Pod) string {
+	for _, pod := range pods {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting == nil {
+				continue
+			}
+			switch cs.State.Waiting.Reason {
+			case "CrashLoopBackOff", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError", "CreateContainerError", "RunContainerError":
+				return cs.State.Waiting.Reason
+			}
+		}
+	}
+	return ""
+}
+
 func (k *kubernetesBackend) streamServerLogs(ctx context.Context, id string) (io.ReadCloser, error) {
 	var deployment appsv1.Deployment
 	if err := k.client.Get(ctx, kclient.ObjectKey{Name: id, Namespace: k.mcpNamespace}, &deployment); err != nil {
@@ -361,7 +447,7 @@ func (k *kubernetesBackend) shutdownServer(ctx context.Context, id string, hardS
 	if hardShutdown {
 		prunedTypes = append(prunedTypes, new(corev1.PersistentVolumeClaim))
 	}
-	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(id).WithPruneTypes(prunedTypes...).Apply(ctx, nil, nil); err != nil {
+	if err := k.serverObjectsApply(id).WithPruneTypes(prunedTypes...).Apply(ctx, nil, nil); err != nil {
 		return fmt.Errorf("failed to delete MCP deployment %s: %w", id, err)
 	}
 
@@ -370,6 +456,16 @@ func (k *kubernetesBackend) shutdownServer(ctx context.Context, id string, hardS
 	return nil
 }
 
+// serverObjectsApply returns the apply for the objects of a server. The object that exposes the server on a custom
+// hostname is always pruned, so that it is deleted when the server stops being exposed.
+func (k *kubernetesBackend) serverObjectsApply(id string) apply.Apply {
+	a := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(id)
+	if k.exposer != nil {
+		a = a.WithPruneGVKs(k.exposer.gvk())
+	}
+	return a
+}
+
 func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig, webhooks []Webhook) ([]kclient.Object, error) {
 	var (
 		command  []string
@@ -812,10 +908,17 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 	if server.Runtime != types.RuntimeContainerized {
 		// Setup the MCP server nanobot config (nanobot.yaml that configures how nanobot proxies
 		// to the underlying MCP server) and mount it into the last container in the deployment.
-		var nanobotFileString []byte
+		var (
+			nanobotFileString []byte
+			runAnnotations    = annotations
+		)
 		if server.Runtime == types.RuntimeComposite {
 			nanobotFileString, err = constructMCPServerNanobotYAMLForComposite(server.Components)
-			annotations["nanobot-composite-file-rev"] = hash.Digest(nanobotFileString)
+			// The revision of the components is only set on the secret, not on the pod, so that changing the components
+			// doesn't restart the composite server. The kubelet updates the mounted nanobot.yaml, and nanobot reads it
+			// for new sessions, so the sessions of the components that didn't change are kept.
+			runAnnotations = maps.Clone(annotations)
+			runAnnotations["nanobot-composite-file-rev"] = hash.Digest(nanobotFileString)
 		} else {
 			nanobotFileString, err = constructMCPServerNanobotYAML(server.MCPServerDisplayName, server.URL, server.Command, server.Args, server.PassthroughHeaderNames, secretEnvData, headerData, webhooks)
 		}
@@ -827,7 +930,7 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        name.SafeConcatName(server.MCPServerName, "mcp", "run"),
 				Namespace:   k.mcpNamespace,
-				Annotations: annotations,
+				Annotations: runAnnotations,
 			},
 			Data: map[string][]byte{
 				"nanobot.yaml": nanobotFileString,
@@ -879,7 +982,7 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 		})
 	}
 
-	objs = append(objs, &corev1.Service{
+	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        server.MCPServerName,
 			Namespace:   k.mcpNamespace,
@@ -892,11 +995,73 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 			},
 			Type: corev1.ServiceTypeClusterIP,
 		},
-	})
+	}
+	k.setReplicaRouting(server, dep, service)
+	objs = append(objs, service)
 
+	if k.exposer != nil {
+		if obj := k.exposer.object(server, k.mcpNamespace, annotations); obj != nil {
+			objs = append(objs, obj)
+		}
+	}
+
+	setObjectsHash(objs)
 	return objs, nil
 }
 
+// setReplicaRouting configures how the replicas of a multi-user server are scheduled and how requests are routed to
+// them. Servers only have more than one replica if they are scaled outside of Obot, such as with a
+// HorizontalPodAutoscaler, but the settings don't change how servers with a single replica work.
+func (k *kubernetesBackend) setReplicaRouting(server ServerConfig, dep *appsv1.Deployment, service *corev1.Service) {
+	if !server.MultiUser {
+		return
+	}
+
+	if k.zoneAwareRouting {
+		dep.Spec.Template.Spec.TopologySpreadConstraints = append(dep.Spec.Template.Spec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     dep.Spec.Selector,
+		})
+		// Requests from Obot go to a replica in its own zone if there is a ready one, and to any replica otherwise.
+		service.Spec.TrafficDistribution = new(corev1.ServiceTrafficDistributionPreferClose)
+	}
+
+	if k.clientIPAffinity {
+		// The session manager keeps its sessions with a server open, so each Obot replica keeps talking to the replica
+		// of the server that has the state of its sessions.
+		service.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+	}
+}
+
+// setObjectsHash records a hash of the objects of a server on its deployment, so that a replica that didn't apply
+// them, such as a new controller leader, can tell whether the deployment is already being rolled out from the same
+// objects.
+func setObjectsHash(objs []kclient.Object) {
+	objectsHash := hash.Digest(objs)
+	for _, obj := range objs {
+		if dep, ok := obj.(*appsv1.Deployment); ok {
+			// The annotations are shared with the other objects, so only the deployment's copy gets the hash.
+			dep.Annotations = maps.Clone(dep.Annotations)
+			if dep.Annotations == nil {
+				dep.Annotations = make(map[string]string, 1)
+			}
+			dep.Annotations[objectsHashAnnotation] = objectsHash
+		}
+	}
+}
+
+// deploymentObjectsHash returns the objects hash that setObjectsHash recorded on the deployment in objs.
+func deploymentObjectsHash(objs []kclient.Object) string {
+	for _, obj := range objs {
+		if dep, ok := obj.(*appsv1.Deployment); ok {
+			return dep.Annotations[objectsHashAnnotation]
+		}
+	}
+	return ""
+}
+
 // getNewestPod finds and returns the most recently created pod from the list.
 func getNewestPod(pods []corev1.Pod) (*corev1.Pod, error) {
 	if len(pods) == 0 {
@@ -983,6 +1148,20 @@ func analyzePodStatus(pod *corev1.Pod) (bool, error) {
 	return true, fmt.Errorf("pod in phase %s, waiting for containers to be ready", pod.Status.Phase)
 }
 
+// deploymentRolledOut returns true when all the desired replicas of a deployment are updated and available, and no
+// replica of an earlier rollout is left. Multi-user servers can be scaled to more than one replica outside of Obot.
+func deploymentRolledOut(dep *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if dep.Spec.Replicas != nil {
+		replicas = *dep.Spec.Replicas
+	}
+	return dep.Generation == dep.Status.ObservedGeneration &&
+		dep.Status.Replicas == replicas &&
+		dep.Status.UpdatedReplicas == replicas &&
+		dep.Status.ReadyReplicas == replicas &&
+		dep.Status.AvailableReplicas == replicas
+}
+
 func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id string, server ServerConfig, previousPodName string) (string, error) {
 	// Wait for the deployment to be ready, checking pod status on each update to fail fast on permanent errors.
 	var (
@@ -992,7 +1171,7 @@ func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id strin
 	for attempt := range maxDeploymentWatchRetries {
 		_, err := wait.For(ctx, k.client, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: id, Namespace: k.mcpNamespace}},
 			func(dep *appsv1.Deployment) (bool, error) {
-				if dep.Generation == dep.Status.ObservedGeneration && dep.Status.UpdatedReplicas == 1 && dep.Status.ReadyReplicas == 1 && dep.Status.AvailableReplicas == 1 {
+				if deploymentRolledOut(dep) {
 					return true, nil
 				}
 
@@ -1408,6 +1587,39 @@ func (k *kubernetesBackend) deploymentSettingsMatch(deployment *appsv1.Deploymen
 	return true
 }
 
+// refreshCompositeConfig annotates the pods of a composite server with the revision of its components, so that the
+// kubelet updates their mounted nanobot.yaml without waiting for its periodic sync. The pods are not restarted.
+func (k *kubernetesBackend) refreshCompositeConfig(ctx context.Context, server ServerConfig) {
+	var pods corev1.PodList
+	if err := k.client.List(ctx, &pods, &kclient.ListOptions{
+		Namespace: k.mcpNamespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			"app": server.MCPServerName,
+		}),
+	}); err != nil {
+		olog.Warnf("failed to list pods of composite MCP server to refresh its config: id=%s error=%v", server.MCPServerName, err)
+		return
+	}
+
+	patchBytes, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				"nanobot-composite-rev": hash.Digest(server.Components),
+			},
+		},
+	})
+	if err != nil {
+		olog.Warnf("failed to marshal composite config patch: id=%s error=%v", server.MCPServerName, err)
+		return
+	}
+
+	for _, pod := range pods.Items {
+		if err := k.client.Patch(ctx, &pod, kclient.RawPatch(ktypes.MergePatchType, patchBytes)); err != nil && !apierrors.IsNotFound(err) {
+			olog.Warnf("failed to annotate pod of composite MCP server to refresh its config: id=%s pod=%s error=%v", server.MCPServerName, pod.Name, err)
+		}
+	}
+}
+
 // patchDeploymentHash applies only the K8s settings hash annotation to the deployment.
 // This should be called after verifying that the actual settings have been applied.
 func (k *kubernetesBackend) patchDeploymentHash(ctx context.Context, deployment *appsv1.Deployment, k8sSettingsHash string) error {
@@ -3,6 +3,7 @@ package mcp
 import (
 	"context"
 	"errors"
+	"maps"
 	"strings"
 	"testing"
 	"time"
@@ -149,7 +150,7 @@ func TestNewKubernetesBackend_ServiceFQDN(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			backend := newKubernetesBackend(nil, nil, nil, Options{ServiceName: tt.serviceName, ServiceNamespace: tt.serviceNamespace, MCPClusterDomain: tt.clusterDomain})
+			backend := newKubernetesBackend(nil, nil, nil, Options{ServiceName: tt.serviceName, ServiceNamespace: tt.serviceNamespace, MCPClusterDomain: tt.clusterDomain}, nil, nil, nil)
 			k := backend.(*kubernetesBackend)
 			if k.serviceFQDN != tt.expectedFQDN {
 				t.Errorf("newKubernetesBackend() serviceFQDN = %v, want %v", k.serviceFQDN, tt.expectedFQDN)
@@ -210,6 +211,98 @@ func TestK8sObjects_NonAgentShimKeepsAuditLogConfig(t *testing.T) {
 	assertHasAuditLogEnv(t, shimConfigSecret.Data)
 }
 
+func TestK8sObjects_CompositeComponentsDontRestartPod(t *testing.T) {
+	k := newTestKubernetesBackend(t)
+
+	server := ServerConfig{
+		Runtime:              types.RuntimeComposite,
+		MCPServerName:        "composite-server",
+		MCPServerDisplayName: "Composite Server",
+		UserID:               "user-1",
+		OwnerUserID:          "user-1",
+		Components:           []ComponentServer{{Name: "GitHub", URL: "http://obot.obot-system.svc.cluster.local/mcp-connect/ms1github"}},
+	}
+	deployment := func(objs []client.Object) *appsv1.Deployment {
+		t.Helper()
+		for _, obj := range objs {
+			if dep, ok := obj.(*appsv1.Deployment); ok {
+				return dep
+			}
+		}
+		t.Fatal("expected a deployment")
+		return nil
+	}
+
+	objs, err := k.k8sObjects(context.Background(), server, nil)
+	if err != nil {
+		t.Fatalf("k8sObjects() error = %v", err)
+	}
+
+	server.Components = append(server.Components, ComponentServer{Name: "Linear", URL: "http://obot.obot-system.svc.cluster.local/mcp-connect/ms1linear"})
+	updatedObjs, err := k.k8sObjects(context.Background(), server, nil)
+	if err != nil {
+		t.Fatalf("k8sObjects() error = %v", err)
+	}
+
+	if before, after := deployment(objs).Spec.Template.Annotations, deployment(updatedObjs).Spec.Template.Annotations; !maps.Equal(before, after) {
+		t.Errorf("expected pod template annotations not to change with the components, got %v and %v", before, after)
+	}
+
+	runSecret := name.SafeConcatName("composite-server", "mcp", "run")
+	if before, after := findSecret(t, objs, runSecret), findSecret(t, updatedObjs, runSecret); before.Annotations["nanobot-composite-file-rev"] == after.Annotations["nanobot-composite-file-rev"] {
+		t.Error("expected the run secret's revision to change with the components")
+	}
+}
+
+func TestK8sObjects_ObjectsHash(t *testing.T) {
+	k := newTestKubernetesBackend(t)
+
+	server := ServerConfig{
+		Runtime:              types.RuntimeNPX,
+		MCPServerName:        "hashed-server",
+		MCPServerDisplayName: "Hashed Server",
+		UserID:               "user-1",
+		OwnerUserID:          "user-1",
+	}
+
+	objs, err := k.k8sObjects(context.Background(), server, nil)
+	if err != nil {
+		t.Fatalf("k8sObjects() error = %v", err)
+	}
+	objectsHash := deploymentObjectsHash(objs)
+	if objectsHash == "" {
+		t.Fatal("expected the deployment to have an objects hash")
+	}
+
+	for _, obj := range objs {
+		if dep, ok := obj.(*appsv1.Deployment); ok {
+			if _, ok := dep.Spec.Template.Annotations[objectsHashAnnotation]; ok {
+				t.Error("expected the pod template not to have the objects hash")
+			}
+		} else if _, ok := obj.GetAnnotations()[objectsHashAnnotation]; ok {
+			t.Errorf("expected only the deployment to have the objects hash, got it on %s", obj.GetName())
+		}
+	}
+
+	// A replica that didn't apply the objects generates the same hash, so it can resume waiting for the deployment.
+	sameObjs, err := k.k8sObjects(context.Background(), server, nil)
+	if err != nil {
+		t.Fatalf("k8sObjects() error = %v", err)
+	}
+	if got := deploymentObjectsHash(sameObjs); got != objectsHash {
+		t.Errorf("expected the objects hash to be stable, got %s and %s", objectsHash, got)
+	}
+
+	server.Env = []string{"KEY=value"}
+	changedObjs, err := k.k8sObjects(context.Background(), server, nil)
+	if err != nil {
+		t.Fatalf("k8sObjects() error = %v", err)
+	}
+	if deploymentObjectsHash(changedObjs) == objectsHash {
+		t.Error("expected the objects hash to change with the server")
+	}
+}
+
 func TestK8sObjects_ServicePorts(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -261,6 +354,95 @@ func TestK8sObjects_ServicePorts(t *testing.T) {
 	}
 }
 
+func TestK8sObjects_ReplicaRouting(t *testing.T) {
+	tests := []struct {
+		name             string
+		multiUser        bool
+		zoneAwareRouting bool
+		clientIPAffinity bool
+	}{
+		{name: "single-user server ignores the settings", zoneAwareRouting: true, clientIPAffinity: true},
+		{name: "multi-user server without the settings", multiUser: true},
+		{name: "multi-user server with zone-aware routing", multiUser: true, zoneAwareRouting: true},
+		{name: "multi-user server with client IP affinity", multiUser: true, clientIPAffinity: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := newTestKubernetesBackend(t)
+			k.zoneAwareRouting, k.clientIPAffinity = tt.zoneAwareRouting, tt.clientIPAffinity
+
+			objs, err := k.k8sObjects(context.Background(), ServerConfig{
+				Runtime:              types.RuntimeContainerized,
+				MCPServerName:        "test-server",
+				MCPServerDisplayName: "Test Server",
+				OwnerUserID:          "user-1",
+				ContainerImage:       "ghcr.io/obot-platform/mcp-images/stdio-wrapper:main",
+				ContainerPort:        8080,
+				ContainerPath:        "/mcp",
+				MultiUser:            tt.multiUser,
+			}, nil)
+			if err != nil {
+				t.Fatalf("k8sObjects() error = %v", err)
+			}
+
+			service := findService(t, objs, "test-server")
+			dep := findDeployment(t, objs, "test-server")
+
+			wantZoneAware := tt.multiUser && tt.zoneAwareRouting
+			if gotZoneAware := service.Spec.TrafficDistribution != nil; gotZoneAware != wantZoneAware {
+				t.Errorf("traffic distribution = %v, want zone-aware %v", service.Spec.TrafficDistribution, wantZoneAware)
+			} else if wantZoneAware && *service.Spec.TrafficDistribution != corev1.ServiceTrafficDistributionPreferClose {
+				t.Errorf("traffic distribution = %q, want %q", *service.Spec.TrafficDistribution, corev1.ServiceTrafficDistributionPreferClose)
+			}
+			if gotSpread := len(dep.Spec.Template.Spec.TopologySpreadConstraints) == 1; gotSpread != wantZoneAware {
+				t.Errorf("topology spread constraints = %+v, want zone spread %v", dep.Spec.Template.Spec.TopologySpreadConstraints, wantZoneAware)
+			}
+
+			wantAffinity := corev1.ServiceAffinity("")
+			if tt.multiUser && tt.clientIPAffinity {
+				wantAffinity = corev1.ServiceAffinityClientIP
+			}
+			if service.Spec.SessionAffinity != wantAffinity {
+				t.Errorf("session affinity = %q, want %q", service.Spec.SessionAffinity, wantAffinity)
+			}
+		})
+	}
+}
+
+func TestDeploymentRolledOut(t *testing.T) {
+	deployment := func(desired *int32, replicas, updated, ready, available int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Replicas: desired},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          replicas,
+				UpdatedReplicas:   updated,
+				ReadyReplicas:     ready,
+				AvailableReplicas: available,
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		dep  *appsv1.Deployment
+		want bool
+	}{
+		{name: "single replica by default", dep: deployment(nil, 1, 1, 1, 1), want: true},
+		{name: "old replica left", dep: deployment(nil, 2, 1, 2, 2)},
+		{name: "scaled and rolled out", dep: deployment(new(int32(3)), 3, 3, 3, 3), want: true},
+		{name: "scaled with replica not ready", dep: deployment(new(int32(3)), 3, 3, 2, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deploymentRolledOut(tt.dep); got != tt.want {
+				t.Errorf("deploymentRolledOut() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAnalyzePodStatus(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -391,6 +573,65 @@ func TestAnalyzePodStatus(t *testing.T) {
 	}
 }
 
+func TestPermanentFailureReason(t *testing.T) {
+	waiting := func(reasons ...string) corev1.Pod {
+		var pod corev1.Pod
+		for _, reason := range reasons {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+				Name: "mcp",
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: reason},
+				},
+			})
+		}
+		return pod
+	}
+
+	tests := []struct {
+		name string
+		pods []corev1.Pod
+		want string
+	}{
+		{
+			name: "no pods",
+		},
+		{
+			name: "running",
+			pods: []corev1.Pod{{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "mcp"}}}}},
+		},
+		{
+			name: "creating",
+			pods: []corev1.Pod{waiting("ContainerCreating")},
+		},
+		{
+			name: "first image pull failure",
+			pods: []corev1.Pod{waiting("ErrImagePull")},
+		},
+		{
+			name: "image pull backoff",
+			pods: []corev1.Pod{waiting("ImagePullBackOff")},
+			want: "ImagePullBackOff",
+		},
+		{
+			name: "crash loop in second container",
+			pods: []corev1.Pod{waiting("ContainerCreating", "CrashLoopBackOff")},
+			want: "CrashLoopBackOff",
+		},
+		{
+			name: "configuration error in second pod",
+			pods: []corev1.Pod{waiting("PodInitializing"), waiting("CreateContainerConfigError")},
+			want: "CreateContainerConfigError",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := permanentFailureReason(tt.pods); got != tt.want {
+				t.Fatalf("permanentFailureReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 type fakeWithWatch struct {
 	client.Client // controller-runtime fake for Get/List/Create etc.
 	watcher       *watch.FakeWatcher
@@ -0,0 +1,120 @@
+package mcp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	otypes "github.com/obot-platform/obot/apiclient/types"
+)
+
+// launchResultTTL is how long the result of an asynchronous launch is kept after it finishes.
+const launchResultTTL = 10 * time.Minute
+
+// launchTracker records the asynchronous launches of MCP servers, so that their status can be checked.
+type launchTracker struct {
+	lock     sync.Mutex
+	launches map[string]*asyncLaunch
+}
+
+type asyncLaunch struct {
+	done     bool
+	failure  string
+	finished time.Time
+}
+
+func newLaunchTracker() *launchTracker {
+	return &launchTracker{
+		launches: make(map[string]*asyncLaunch),
+	}
+}
+
+// start records a launch of the server, and returns false if a launch of the server is already in progress.
+func (t *launchTracker) start(serverName string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	for name, launch := range t.launches {
+		if launch.done && now.Sub(launch.finished) > launchResultTTL {
+			delete(t.launches, name)
+		}
+	}
+
+	if launch, ok := t.launches[serverName]; ok && !launch.done {
+		return false
+	}
+	t.launches[serverName] = new(asyncLaunch)
+	return true
+}
+
+func (t *launchTracker) finish(serverName, failure string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.launches[serverName] = &asyncLaunch{
+		done:     true,
+		failure:  failure,
+		finished: time.Now(),
+	}
+}
+
+func (t *launchTracker) get(serverName string) (asyncLaunch, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	launch, ok := t.launches[serverName]
+	if !ok {
+		return asyncLaunch{}, false
+	}
+	return *launch, true
+}
+
+// StartLaunch runs launch for the server in the background, unless a launch of the server is already in progress. The
+// status of the launch is returned by LaunchStatus. errMessage returns the message of the error that the launch
+// failed with.
+func (sm *SessionManager) StartLaunch(serverName string, launch func(context.Context) error, errMessage func(error) string) {
+	if !sm.launches.start(serverName) {
+		return
+	}
+
+	go func() {
+		var failure string
+		if err := launch(context.Background()); err != nil {
+			log.Infof("Asynchronous launch of MCP server failed: server=%s error=%v", serverName, err)
+			failure = errMessage(err)
+		}
+		sm.launches.finish(serverName, failure)
+	}()
+}
+
+// LaunchStatus returns the status of the last asynchronous launch of the server, and false if there is none.
+func (sm *SessionManager) LaunchStatus(serverName string) (otypes.MCPServerLaunchStatus, bool) {
+	launch, ok := sm.launches.get(serverName)
+	if !ok {
+		return otypes.MCPServerLaunchStatus{}, false
+	}
+
+	switch {
+	case launch.failure != "":
+		return otypes.MCPServerLaunchStatus{State: otypes.MCPServerLaunchStateFailed, Error: launch.failure}, true
+	case launch.done:
+		return otypes.MCPServerLaunchStatus{State: otypes.MCPServerLaunchStateReady}, true
+	}
+
+	if position, ok := sm.deployments.position(serverName); ok {
+		return otypes.MCPServerLaunchStatus{State: otypes.MCPServerLaunchStateQueued, QueuePosition: position}, true
+	}
+	return otypes.MCPServerLaunchStatus{State: otypes.MCPServerLaunchStateDeploying}, true
+}
+
+// deploymentUserID returns the user that a deployment of the server counts against in the deployment queue.
+func deploymentUserID(server ServerConfig) string {
+	if server.UserID != "" {
+		return server.UserID
+	}
+	if server.OwnerUserID != "" {
+		return server.OwnerUserID
+	}
+	return "system"
+}
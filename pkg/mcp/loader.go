@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/gptscript-ai/gptscript/pkg/hash"
 	"github.com/gptscript-ai/gptscript/pkg/types"
@@ -31,11 +33,70 @@ type Options struct {
 	MCPNamespace                      string   `usage:"The namespace to use for MCP containers" default:"obot-mcp"`
 	MCPClusterDomain                  string   `usage:"The cluster domain to use for MCP containers" default:"cluster.local"`
 	DisallowLocalhostMCP              bool     `usage:"Allow MCP containers to run on localhost"`
-	MCPRuntimeBackend                 string   `usage:"The runtime backend to use for running MCP servers: docker, kubernetes, or local. Defaults to docker." default:"docker"`
+	MCPAllowStdioRuntime              bool     `usage:"Allow MCP servers using the stdio runtime to run as subprocesses of the Obot server, intended for development and small single-node installs"`
+	MCPRuntimeBackend                 string   `usage:"The runtime backend to use for running MCP servers: docker, kubernetes, or memory. The memory backend runs no servers and is meant for local development. Defaults to docker." default:"docker"`
 	MCPImagePullSecrets               []string `usage:"The name of the image pull secret to use for pulling MCP images"`
 	SingleUserIdleServerShutdownHours int      `usage:"The interval in hours to check for idle MCP servers designated to a single user and shut them down, set to -1 to disable shutdown" default:"24"`
 	MultiUserIdleServerShutdownHours  int      `usage:"The interval in hours to check for idle multi-user MCP servers and shut them down, set to -1 to disable" default:"168"`
 	IdleAgentShutdownHours            int      `usage:"The interval in hours to check for idle agents and shut them down, set to -1 to disable" default:"72"`
+	MCPStaleServerDays                int      `usage:"The number of days without requests after which MCP servers designated to a single user are flagged as stale and their owners notified, set to 0 to disable" default:"0"`
+	MCPStaleServerGracePeriodDays     int      `usage:"The number of days after a single-user MCP server is flagged as stale before the stale server action is taken" default:"7"`
+	MCPStaleServerAction              string   `usage:"What to do with single-user MCP servers that are still stale at the end of the grace period (none, shutdown, delete)" default:"none"`
+	MCPStaleServerWebhookURL          string   `usage:"The URL that notifications about stale single-user MCP servers are sent to, so that their owners can be notified"`
+	MCPStaleServerWebhookSecret       string   `usage:"The secret used to sign notifications about stale single-user MCP servers"`
+	MCPFailureTicketURL               string   `usage:"The URL that tickets are created at, such as a ServiceNow or Jira API, when the deployment of an MCP server fails permanently, empty to disable"`
+	MCPFailureTicketTemplate          string   `usage:"The Go template of the body of the requests that create tickets for failing MCP servers, which is the JSON diagnostics report of the server if empty"`
+	MCPFailureTicketAuthorization     string   `usage:"The value of the Authorization header of the requests that create tickets for failing MCP servers"`
+	MCPPrewarmLookbackDays            int      `usage:"The number of days of audit logs that the usage peaks of multi-user MCP servers are learned from, so that servers shut down while idle are deployed again shortly before their peaks, set to 0 to disable" default:"0"`
+	MCPPrewarmLeadMinutes             int      `usage:"The number of minutes before a usage peak of a multi-user MCP server that it is deployed again" default:"15"`
+	MCPPrewarmMinUsers                int      `usage:"The number of users that must have used a multi-user MCP server in the lookback period for it to be deployed again before its usage peaks" default:"3"`
+	MCPToolCacheDurationSeconds       int      `usage:"The number of seconds to cache tools/list results and results of MCP tool calls annotated as read-only or idempotent, set to 0 to disable caching" default:"0"`
+	MCPPrefetchCapabilities           bool     `usage:"List the tools, prompts, and resources of MCP servers concurrently when Obot starts a session with them, and answer later list requests on the session from the results"`
+	MCPCircuitBreakerThreshold        int      `usage:"The number of consecutive failed requests to an MCP server after which requests to it fail fast for the cooldown period, set to 0 to disable" default:"5"`
+	MCPCircuitBreakerCooldownSeconds  int      `usage:"The number of seconds requests to an MCP server fail fast after its circuit breaker trips" default:"30"`
+	MCPToolsListTimeoutSeconds        int      `usage:"The number of seconds to wait for an MCP server to list its tools, set to 0 for no timeout" default:"60"`
+	MCPToolsCallTimeoutSeconds        int      `usage:"The number of seconds to wait for an MCP server to respond to a tool call, set to 0 for no timeout" default:"0"`
+	MCPResourcesReadTimeoutSeconds    int      `usage:"The number of seconds to wait for an MCP server to respond to a resource read, set to 0 for no timeout" default:"0"`
+	MCPDeploymentWorkers              int      `usage:"The maximum number of MCP server deployments that run at the same time, with the others waiting in a queue, set to 0 for no limit" default:"10"`
+	MCPDeploymentWorkersPerUser       int      `usage:"The maximum number of MCP server deployments of each user that run at the same time, set to 0 for no limit" default:"3"`
+	MCPDeploymentQueueSize            int      `usage:"The maximum number of MCP server deployments that wait in the deployment queue, after which launches fail until the queue shrinks, set to 0 for no limit" default:"100"`
+	MCPMemoryServerQuotaMB            int      `usage:"The maximum size in megabytes of the memories stored by each Obot-managed memory MCP server, set to 0 for no limit" default:"100"`
+	MCPWorkspaceServerQuotaMB         int      `usage:"The maximum size in megabytes of the files of each project that Obot-managed workspace MCP servers can write, set to 0 for no limit" default:"1024"`
+
+	// Image builds for MCP servers with the source runtime, which are only supported by the Kubernetes backend
+	MCPImageBuilder          string `usage:"The builder of the images of MCP servers with the source runtime (kaniko or buildpacks), empty to disable the source runtime"`
+	MCPImageBuilderImage     string `usage:"The image of the builder, defaults to the official image of the builder"`
+	MCPImageBuildSourceImage string `usage:"The image that fetches the source of MCP servers before they are built, which needs git, wget, and tar" default:"alpine/git:latest"`
+	MCPImageBuildRegistry    string `usage:"The image repository that built images of MCP servers are pushed to, such as registry.example.com/obot/mcp-servers"`
+	MCPImageBuildPushSecret  string `usage:"The name of a kubernetes.io/dockerconfigjson secret in the build namespace with the credentials to push built images"`
+	MCPImageBuildNamespace   string `usage:"The namespace that images of MCP servers are built in, defaults to the MCP namespace"`
+	MCPImageScannerImage     string `usage:"The Trivy image that scans the images of MCP servers submitted through the catalog submission portal, empty to disable image scanning"`
+	MCPImageScanSeverity     string `usage:"The comma-separated severities of vulnerabilities that fail image scans" default:"HIGH,CRITICAL"`
+
+	// Direct exposure of multi-user MCP servers on custom hostnames, which is only supported by the Kubernetes backend
+	MCPExternalExposure         string `usage:"How multi-user MCP servers are exposed directly on custom hostnames (ingress or gateway), empty to disable"`
+	MCPExternalIngressClassName string `usage:"The ingress class of the Ingresses that expose MCP servers on custom hostnames"`
+	MCPExternalGateway          string `usage:"The Gateway, as namespace/name, that the HTTPRoutes that expose MCP servers on custom hostnames attach to"`
+	MCPExternalCertIssuer       string `usage:"The cert-manager ClusterIssuer that issues the TLS certificates of the Ingresses that expose MCP servers on custom hostnames"`
+
+	// Routing to multi-user MCP servers scaled to multiple replicas, which is only supported by the Kubernetes backend
+	MCPZoneAwareRouting bool `usage:"Spread the replicas of multi-user MCP servers across zones, and route requests to replicas in the same zone when there are any"`
+	MCPClientIPAffinity bool `usage:"Route all requests from an Obot replica to the same replica of a multi-user MCP server, for servers that keep the state of sessions in memory"`
+
+	// External secret managers that the environment variables of catalog entries can reference
+	MCPSecretManagerVaultAddress   string `usage:"The address of the HashiCorp Vault server that environment variables of MCP servers can reference secrets in, empty to disable Vault references"`
+	MCPSecretManagerVaultToken     string `usage:"The token that Obot reads secrets from Vault with"`
+	MCPSecretManagerVaultNamespace string `usage:"The Vault Enterprise namespace that secrets are read from"`
+	MCPSecretManagerAWSRegion      string `usage:"The region of AWS Secrets Manager that environment variables of MCP servers can reference secrets in, empty to disable AWS references"`
+	MCPSecretManagerGCP            bool   `usage:"Allow environment variables of MCP servers to reference secrets in Google Cloud Secret Manager, which are read with the application default credentials of Obot"`
+
+	// Connection pool for the HTTP connections Obot makes to MCP servers
+	MCPRemoteMaxIdleConnsPerHost       int `usage:"The maximum number of idle keep-alive connections to keep open to each MCP server host" default:"64"`
+	MCPRemoteMaxConnsPerHost           int `usage:"The maximum number of connections to each MCP server host, including those in use, set to 0 for no limit" default:"0"`
+	MCPRemoteIdleConnTimeoutSeconds    int `usage:"The number of seconds an idle keep-alive connection to an MCP server is kept open" default:"90"`
+	MCPRemoteKeepAliveSeconds          int `usage:"The interval in seconds between TCP keep-alive probes on connections to MCP servers, set to -1 to disable" default:"30"`
+	MCPRemoteConnectRetries            int `usage:"The number of times to retry a request to an MCP server when a connection to it can't be established" default:"2"`
+	MCPRemoteConnectRetryBackoffMillis int `usage:"The delay in milliseconds before the first retry of a connection to an MCP server, doubling with each retry" default:"100"`
 
 	// Kubernetes settings from Helm
 	MCPK8sSettingsAffinity             string `usage:"Affinity rules for MCP server pods (JSON)"`
@@ -53,9 +114,12 @@ type Options struct {
 	ServiceAccountName string `usage:"The Kubernetes service account name for the obot server"`
 
 	// Audit log configuration
-	MCPAuditLogPersistIntervalSeconds int `usage:"The interval in seconds to persist MCP audit logs to the database" default:"5"`
-	MCPAuditLogsPersistBatchSize      int `usage:"The number of MCP audit logs to persist in a single batch" default:"1000"`
-	MCPAuditLogRetentionDays          int `usage:"The number of days to retain MCP audit logs (0 to disable cleanup)" default:"90"`
+	MCPAuditLogPersistIntervalSeconds int    `usage:"The interval in seconds to persist MCP audit logs to the database" default:"5"`
+	MCPAuditLogsPersistBatchSize      int    `usage:"The number of MCP audit logs to persist in a single batch" default:"1000"`
+	MCPAuditLogRetentionDays          int    `usage:"The number of days to retain MCP audit logs (0 to disable cleanup, -1 to follow the retention policy)" default:"-1"`
+	MCPAuditLogArchiveDir             string `usage:"A directory to archive MCP audit logs to before they are deleted"`
+	MCPAuditLogArchiveBucket          string `usage:"A bucket in the audit log export storage to archive MCP audit logs to before they are deleted"`
+	MCPAuditLogArchiveKeyPrefix       string `usage:"The key prefix for MCP audit log archives in the bucket" default:"mcp-audit-logs/archive/"`
 
 	// Pod Security Admission configuration for MCP namespace
 	MCPPodSecurityEnabled        bool   `usage:"Enable Pod Security Admission labels on the MCP namespace" default:"true"`
@@ -69,15 +133,35 @@ type Options struct {
 
 type SessionManager struct {
 	backend           backend
+	deployments       *deploymentQueue
+	launches          *launchTracker
 	contextLock       sync.Mutex
 	sessionCtx        context.Context
 	cancel            func()
 	sessions          sync.Map
 	tokenService      TokenService
 	baseURL           string
+	internalServerURL string
 	allowLocalhostMCP bool
-
-	webhookHelper *WebhookHelper
+	allowStdioRuntime bool
+
+	prefetchCapabilities bool
+	sessionStore         SessionStore
+	remoteTransport      http.RoundTripper
+	circuitBreaker       *circuitBreaker
+	requestTimeouts      requestTimeouts
+	secretRefs           *secretRefResolver
+	memoryServers        *memoryServers
+	workspaceServers     *workspaceServers
+	imageScanner         *jobImageScanner
+	externalExposure     bool
+
+	webhookHelper         *WebhookHelper
+	toolPolicyHelper      *ToolPolicyHelper
+	elicitations          *elicitationBroker
+	roots                 *rootsRegistry
+	resourceSubscriptions *resourceSubscriptions
+	toolCache             *toolCache
 }
 
 const streamableHTTPHealthcheckBody string = `{
@@ -94,12 +178,17 @@ const streamableHTTPHealthcheckBody string = `{
     }
 }`
 
-func NewSessionManager(ctx context.Context, tokenService TokenService, baseURL string, httpListenPort int, opts Options, webhookHelper *WebhookHelper, localK8sConfig *rest.Config, obotStorageClient storage.Client) (*SessionManager, error) {
-	var backend backend
+func NewSessionManager(ctx context.Context, tokenService TokenService, baseURL string, httpListenPort int, opts Options, webhookHelper *WebhookHelper, toolPolicyHelper *ToolPolicyHelper, localK8sConfig *rest.Config, obotStorageClient storage.Client, sessionStore SessionStore, memoryDSN string) (*SessionManager, error) {
+	var (
+		backend      backend
+		imageScanner *jobImageScanner
+		exposer      *externalExposer
+		deployments  = newDeploymentQueue(opts.MCPDeploymentWorkers, opts.MCPDeploymentWorkersPerUser, opts.MCPDeploymentQueueSize)
+	)
 
 	switch opts.MCPRuntimeBackend {
 	case "docker":
-		dockerBackend, err := newDockerBackend(ctx, httpListenPort, opts)
+		dockerBackend, err := newDockerBackend(ctx, httpListenPort, opts, deployments)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize Docker backend: %w", err)
 		}
@@ -143,17 +232,56 @@ func NewSessionManager(ctx context.Context, tokenService TokenService, baseURL s
 			return nil, err
 		}
 
-		backend = newKubernetesBackend(clientset, client, obotStorageClient, opts)
+		builder, err := newJobImageBuilder(client, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		exposer, err = newExternalExposer(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		backend = newKubernetesBackend(clientset, client, obotStorageClient, opts, deployments, builder, exposer)
+		imageScanner = newJobImageScanner(client, clientset, opts)
+	case memoryBackendName, "noop":
+		memoryBackend, err := newMemoryBackend(ctx, obotStorageClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize in-memory backend: %w", err)
+		}
+
+		backend = memoryBackend
+	case "fake":
+		backend = newFakeBackend()
 	default:
 		return nil, fmt.Errorf("unknown runtime backend: %s", opts.MCPRuntimeBackend)
 	}
 
 	return &SessionManager{
-		webhookHelper:     webhookHelper,
-		tokenService:      tokenService,
-		backend:           backend,
-		baseURL:           baseURL,
-		allowLocalhostMCP: !opts.DisallowLocalhostMCP,
+		webhookHelper:         webhookHelper,
+		toolPolicyHelper:      toolPolicyHelper,
+		elicitations:          newElicitationBroker(),
+		roots:                 newRootsRegistry(),
+		resourceSubscriptions: newResourceSubscriptions(),
+		toolCache:             newToolCache(time.Duration(opts.MCPToolCacheDurationSeconds) * time.Second),
+		tokenService:          tokenService,
+		backend:               backend,
+		deployments:           deployments,
+		launches:              newLaunchTracker(),
+		baseURL:               baseURL,
+		internalServerURL:     fmt.Sprintf("http://localhost:%d", httpListenPort),
+		allowLocalhostMCP:     !opts.DisallowLocalhostMCP,
+		allowStdioRuntime:     opts.MCPAllowStdioRuntime,
+		prefetchCapabilities:  opts.MCPPrefetchCapabilities,
+		sessionStore:          sessionStore,
+		remoteTransport:       newRemoteTransport(opts),
+		circuitBreaker:        newCircuitBreaker(opts.MCPCircuitBreakerThreshold, time.Duration(opts.MCPCircuitBreakerCooldownSeconds)*time.Second),
+		requestTimeouts:       newRequestTimeouts(opts),
+		secretRefs:            newSecretRefResolver(opts),
+		memoryServers:         newMemoryServers(memoryDSN, opts),
+		workspaceServers:      newWorkspaceServers(backend.transformObotHostname, opts),
+		imageScanner:          imageScanner,
+		externalExposure:      exposer != nil,
 	}, nil
 }
 
@@ -161,6 +289,12 @@ func (sm *SessionManager) TransformObotHostname(hostname string) string {
 	return sm.backend.transformObotHostname(hostname)
 }
 
+// ExternalExposureEnabled returns whether multi-user MCP servers can be exposed directly on custom hostnames, which is
+// only supported by the Kubernetes backend.
+func (sm *SessionManager) ExternalExposureEnabled() bool {
+	return sm.externalExposure
+}
+
 // Load is used by GPTScript to load tools from dynamic MCP server tool definitions.
 // Obot is responsible for loading these tools and managing the clients and sessions.
 // Error here to catch any server tools that slipped through. This should never be called.
@@ -217,6 +351,9 @@ func (sm *SessionManager) CloseClient(ctx context.Context, server ServerConfig,
 }
 
 func (sm *SessionManager) closeClient(server ServerConfig, clientScope string) {
+	id := clientID(server, clientScope)
+	sm.deleteSessionState(id)
+
 	sm.contextLock.Lock()
 	if sm.sessionCtx == nil {
 		sm.contextLock.Unlock()
@@ -234,7 +371,7 @@ func (sm *SessionManager) closeClient(server ServerConfig, clientScope string) {
 		return
 	}
 
-	sess, ok := clientSessions.LoadAndDelete(clientID(server, clientScope))
+	sess, ok := clientSessions.LoadAndDelete(id)
 	if !ok || sess == nil {
 		return
 	}
@@ -250,8 +387,16 @@ func (sm *SessionManager) LaunchServer(ctx context.Context, serverConfig ServerC
 	if serverConfig.ProjectMCPServer {
 		return "", errors.New("cannot launch project MCP server")
 	}
+	if serverConfig.Runtime == otypes.RuntimeStdio {
+		// Stdio servers only exist as subprocesses of Obot's own clients, so there is no URL to proxy to.
+		return "", &ErrNotSupportedByBackend{Feature: "connecting through the MCP gateway", Backend: stdioBackendName}
+	}
 
 	c, err := sm.ensureDeployment(ctx, serverConfig, true)
+	if err == nil {
+		// Clients connect to the server directly, so a successful deployment is all there is to go on.
+		sm.circuitBreaker.record(serverConfig.MCPServerName, nil)
+	}
 	return c.URL, err
 }
 
@@ -267,11 +412,19 @@ func (sm *SessionManager) ShutdownIdleServer(ctx context.Context, serverName str
 
 func (sm *SessionManager) shutdownServer(ctx context.Context, serverName string, hardShutdown bool) error {
 	sm.closeClients(serverName)
+	sm.circuitBreaker.reset(serverName)
+
+	if err := removeStdioFiles(serverName); err != nil {
+		return err
+	}
 
 	return sm.backend.shutdownServer(ctx, serverName, hardShutdown)
 }
 
 func (sm *SessionManager) closeClients(serverName string) {
+	// The sessions are being deleted, so they can't be resumed, even by clients that haven't loaded them since a restart.
+	sm.deleteSessionStates(serverName)
+
 	sm.contextLock.Lock()
 	if sm.sessionCtx == nil {
 		sm.contextLock.Unlock()
@@ -279,6 +432,8 @@ func (sm *SessionManager) closeClients(serverName string) {
 	}
 	sm.contextLock.Unlock()
 
+	sm.toolCache.invalidate(serverName)
+
 	sessions, ok := sm.sessions.LoadAndDelete(serverName)
 	if !ok || sessions == nil {
 		return
@@ -301,15 +456,35 @@ func (sm *SessionManager) closeClients(serverName string) {
 // RestartServerDeployment restarts the server in the currently used backend, if the backend supports it.
 // If the backend does not support restarts, then an [ErrNotSupportedByBackend] error is returned.
 func (sm *SessionManager) RestartServerDeployment(ctx context.Context, server ServerConfig) error {
+	sm.circuitBreaker.reset(server.MCPServerName)
+	if server.Runtime == otypes.RuntimeStdio {
+		sm.closeClients(server.MCPServerName)
+		return nil
+	}
 	return sm.backend.restartServer(ctx, server)
 }
 
 func (sm *SessionManager) ensureDeployment(ctx context.Context, server ServerConfig, transformRemote bool) (ServerConfig, error) {
+	if server.Runtime == otypes.RuntimeStdio && !sm.allowStdioRuntime {
+		return ServerConfig{}, ErrStdioRuntimeDisabled
+	}
+
+	// External secrets are read each time the server is deployed, so that they are never stored by Obot.
+	server, err := sm.secretRefs.resolve(ctx, server)
+	if err != nil {
+		return ServerConfig{}, err
+	}
+	server = sm.workspaceServers.configure(sm.memoryServers.configure(server))
+
+	if server.Runtime == otypes.RuntimeStdio {
+		// Stdio servers are spawned when a client is created, so there is nothing to deploy.
+		return server, nil
+	}
+
 	var webhooks []Webhook
 	if !server.ComponentMCPServer && !server.SystemMCPServer {
 		// Don't get webhooks for servers that are components of composite servers.
 		// The webhooks would be called at the composite level.
-		var err error
 		webhooks, err = sm.webhookHelper.GetWebhooksForMCPServer(server)
 		if err != nil {
 			return ServerConfig{}, err
@@ -356,10 +531,18 @@ func (sm *SessionManager) ensureDeployment(ctx context.Context, server ServerCon
 		}
 	}
 
+	if err := sm.circuitBreaker.allow(server.MCPServerName); err != nil {
+		return ServerConfig{}, err
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, server.StartupTimeout)
 	defer cancel()
 
-	return sm.backend.ensureServerDeployment(ctx, server, webhooks)
+	deployed, err := sm.backend.ensureServerDeployment(ctx, server, webhooks)
+	if err != nil {
+		sm.circuitBreaker.record(server.MCPServerName, err)
+	}
+	return deployed, err
 }
 
 func serverID(server ServerConfig) string {
@@ -367,6 +550,10 @@ func serverID(server ServerConfig) string {
 	server.UserID = ""
 	// Neither are the passthrough header values since they are per-user.
 	server.PassthroughHeaderValues = nil
+	// Sampling configuration, request timeouts, and tool allowlists only affect Obot's clients, not the deployment.
+	server.Sampling = nil
+	server.RequestTimeouts = nil
+	server.ToolAllowlist = nil
 
 	// File values are dynamic and can be updated in place.
 	// Keep file env keys, but clear file contents before hashing.
@@ -385,8 +572,15 @@ func serverID(server ServerConfig) string {
 	return "mcp" + hash.Digest(server)
 }
 
+// deploymentID returns the hash of the configuration of the server's deployment. The components of a composite server
+// are not part of it, because they are updated without redeploying the composite server.
+func deploymentID(server ServerConfig) string {
+	server.Components = nil
+	return serverID(server)
+}
+
 func clientID(server ServerConfig, clientScope string) string {
-	return serverID(server) + hash.Digest(server.PassthroughHeaderValues) + clientScope
+	return serverID(server) + hash.Digest(server.PassthroughHeaderValues) + hash.Digest(server.Sampling) + hash.Digest(server.RequestTimeouts) + clientScope
 }
 
 // GenerateToolPreviews creates a temporary MCP server from a catalog entry, lists its tools,
@@ -394,7 +588,12 @@ func clientID(server ServerConfig, clientScope string) string {
 func (sm *SessionManager) GenerateToolPreviews(ctx context.Context, tempMCPServer v1.MCPServer, serverConfig ServerConfig) ([]otypes.MCPServerTool, error) {
 	// Ensure cleanup happens regardless of success or failure
 	defer func() {
-		if cleanupErr := sm.ShutdownServer(ctx, serverConfig.MCPServerName); cleanupErr != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			// The caller went away, for example because the controller lost its leadership. Leave the temporary
+			// instance so that the next attempt resumes its launch instead of starting over.
+			return
+		}
+		if cleanupErr := sm.ShutdownServer(context.WithoutCancel(ctx), serverConfig.MCPServerName); cleanupErr != nil {
 			log.Errorf("failed to clean up temporary instance %s: %v", tempMCPServer.Name, cleanupErr)
 		}
 	}()
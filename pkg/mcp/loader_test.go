@@ -103,3 +103,21 @@ func TestServerIDIgnoresPassThroughHeaderValues(t *testing.T) {
 		t.Fatalf("expected same server ID when only passthrough header values change")
 	}
 }
+
+func TestDeploymentIDIgnoresCompositeComponents(t *testing.T) {
+	serverA := ServerConfig{
+		Runtime:       "composite",
+		MCPServerName: "test-server",
+		Components:    []ComponentServer{{Name: "GitHub", URL: "http://localhost:8080/mcp-connect/ms1github"}},
+	}
+
+	serverB := serverA
+	serverB.Components = append(serverB.Components, ComponentServer{Name: "Linear", URL: "http://localhost:8080/mcp-connect/ms1linear"})
+
+	if deploymentID(serverA) != deploymentID(serverB) {
+		t.Fatalf("expected same deployment ID when only the components change")
+	}
+	if serverID(serverA) == serverID(serverB) {
+		t.Fatalf("expected different server IDs when the components change")
+	}
+}
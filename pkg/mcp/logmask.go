@@ -0,0 +1,115 @@
+package mcp
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// RedactedLogValue replaces the secrets in the logs of MCP servers.
+	RedactedLogValue = "[REDACTED]"
+
+	// minMaskedValueLength is the length of the shortest configuration value that is masked. Shorter values, such as
+	// flags and small numbers, would redact unrelated parts of the logs.
+	minMaskedValueLength = 6
+)
+
+var (
+	// secretPatterns match common kinds of credentials, in case a server logs one that isn't in its configuration.
+	secretPatterns = []*regexp.Regexp{
+		// AWS access key IDs
+		regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+		// GitHub tokens
+		regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+		regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}\b`),
+		// GitLab personal access tokens
+		regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}\b`),
+		// Slack tokens
+		regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}\b`),
+		// OpenAI, Anthropic, and Stripe style secret keys
+		regexp.MustCompile(`\b(?:sk|rk)[-_][A-Za-z0-9_-]{20,}\b`),
+		// Google API keys
+		regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`),
+		// JSON web tokens
+		regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+		// The first line of PEM private keys
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	}
+
+	// secretAssignmentPattern matches credentials that are assigned to keys that name them, such as password=..., and
+	// bearer tokens. Only the value is masked.
+	secretAssignmentPattern = regexp.MustCompile(`(?i)(\b(?:password|passwd|secret|token|api[_-]?key|access[_-]?key|client[_-]?secret)["']?\s*[:=]\s*["']?|\bbearer\s+)([^\s"',;&]{4,})`)
+)
+
+// LogMasker redacts secrets from the logs of an MCP server: the values of its configuration, and strings that look
+// like common kinds of credentials.
+type LogMasker struct {
+	replacer *strings.Replacer
+}
+
+// NewLogMasker returns a masker for the logs of the server. Secrets that the server reads from external secret
+// managers are only masked if they are resolved in the server's configuration.
+func NewLogMasker(server ServerConfig) *LogMasker {
+	var values []string
+	add := func(value string) {
+		if len(value) >= minMaskedValueLength {
+			values = append(values, value)
+		}
+	}
+	for _, list := range [][]string{server.Env, server.Headers} {
+		for _, kv := range list {
+			if _, value, ok := strings.Cut(kv, "="); ok {
+				add(value)
+			}
+		}
+	}
+	for _, file := range server.Files {
+		add(file.Data)
+		// Servers may log the lines of a file, such as a key, rather than all of it.
+		for line := range strings.SplitSeq(file.Data, "\n") {
+			add(strings.TrimSpace(line))
+		}
+	}
+	add(server.TokenExchangeClientSecret)
+	add(server.AuditLogToken)
+
+	// Replace longer values first, so that a value that contains another is redacted completely.
+	slices.SortFunc(values, func(a, b string) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+		return strings.Compare(a, b)
+	})
+	values = slices.Compact(values)
+
+	oldnew := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		oldnew = append(oldnew, value, RedactedLogValue)
+	}
+	return &LogMasker{replacer: strings.NewReplacer(oldnew...)}
+}
+
+// Mask redacts the secrets in a line of logs.
+func (m *LogMasker) Mask(line string) string {
+	line = m.replacer.Replace(line)
+	for _, pattern := range secretPatterns {
+		line = pattern.ReplaceAllLiteralString(line, RedactedLogValue)
+	}
+	return secretAssignmentPattern.ReplaceAllString(line, "${1}"+RedactedLogValue)
+}
+
+// LogMasker returns a masker for the logs of the server. The secrets that the server reads from external secret
+// managers are resolved so that they are masked too. If they can't be resolved, the rest of the secrets are still
+// masked.
+func (sm *SessionManager) LogMasker(ctx context.Context, server ServerConfig) *LogMasker {
+	if len(server.SecretRefs) > 0 {
+		if resolved, err := sm.secretRefs.resolve(ctx, server); err != nil {
+			log.Warnf("failed to resolve secret references to mask the logs of MCP server %s: %v", server.MCPServerName, err)
+		} else {
+			server = resolved
+		}
+	}
+	return NewLogMasker(sm.workspaceServers.configure(sm.memoryServers.configure(server)))
+}
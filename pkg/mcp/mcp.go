@@ -12,10 +12,11 @@ import (
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/gptscript-ai/gptscript/pkg/types"
 	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	otypes "github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 )
 
-func (sm *SessionManager) GPTScriptTools(ctx context.Context, projectMCPServer v1.ProjectMCPServer, userID, mcpServerDisplayName, internalServerURL, serverURL string, allowedTools []string) ([]gptscript.ToolDef, error) {
+func (sm *SessionManager) GPTScriptTools(ctx context.Context, projectMCPServer v1.ProjectMCPServer, userID, mcpServerDisplayName, internalServerURL, serverURL string, allowedTools []string, schemaMode otypes.MCPToolSchemaMode) ([]gptscript.ToolDef, error) {
 	if mcpServerDisplayName == "" {
 		mcpServerDisplayName = projectMCPServer.Name
 	}
@@ -35,22 +36,36 @@ func (sm *SessionManager) GPTScriptTools(ctx context.Context, projectMCPServer v
 		return nil, determineError(err, mcpServerDisplayName)
 	}
 
-	tools, err := client.ListTools(ctx)
+	tools, err := sm.listTools(ctx, client)
 	if err != nil {
 		return nil, determineError(err, mcpServerDisplayName)
 	}
 
+	policy, err := sm.toolPolicyHelper.PolicyForServer(serverConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	allToolsAllowed := allowedTools == nil || slices.Contains(allowedTools, "*")
+	schemaMode = validToolSchemaMode(schemaMode)
 
 	toolDefs := []gptscript.ToolDef{{ /* this is a placeholder for main tool */ }}
-	var toolNames []string
+	var (
+		toolNames []string
+		lazyTools []lazyTool
+	)
 
-	for _, tool := range tools.Tools {
+	for _, tool := range tools {
 		if tool.Name == "" {
 			// I dunno, bad tool?
 			continue
 		}
-		if !allToolsAllowed && !slices.Contains(allowedTools, tool.Name) {
+		if !allToolsAllowed && !slices.Contains(allowedTools, tool.Name) || !ToolAllowedForServer(policy, serverConfig, tool.Name) {
+			continue
+		}
+
+		if schemaMode == otypes.MCPToolSchemaModeLazy {
+			lazyTools = append(lazyTools, lazyTool{Name: tool.Name, Description: tool.Description})
 			continue
 		}
 
@@ -61,6 +76,12 @@ func (sm *SessionManager) GPTScriptTools(ctx context.Context, projectMCPServer v
 			return nil, fmt.Errorf("failed to unmarshal tool input schema: %w", err)
 		}
 
+		description := tool.Description
+		if schemaMode == otypes.MCPToolSchemaModeSummarized {
+			description = summarizeDescription(description)
+			summarizeSchema(&schema)
+		}
+
 		annotations, err := json.Marshal(tool.Annotations)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal tool annotations: %w", err)
@@ -68,7 +89,7 @@ func (sm *SessionManager) GPTScriptTools(ctx context.Context, projectMCPServer v
 
 		toolDef := gptscript.ToolDef{
 			Name:         toolName,
-			Description:  tool.Description,
+			Description:  description,
 			Arguments:    &schema,
 			Instructions: fmt.Sprintf("%s%s %s default", types.MCPInvokePrefix, tool.Name, client.ID),
 			MetaData: map[string]string{
@@ -90,6 +111,20 @@ func (sm *SessionManager) GPTScriptTools(ctx context.Context, projectMCPServer v
 		toolDefs = append(toolDefs, toolDef)
 	}
 
+	if len(lazyTools) > 0 {
+		lazyToolDefs, err := lazyToolDefs(mcpServerDisplayName, client.ID, lazyTools, map[string]string{
+			"obot-server-config": string(serverConfigForMetadata),
+			"obot-user-id":       userID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, toolDef := range lazyToolDefs {
+			toolNames = append(toolNames, toolDef.Name)
+		}
+		toolDefs = append(toolDefs, lazyToolDefs...)
+	}
+
 	main := gptscript.ToolDef{
 		Name:        mcpServerDisplayName + "-bundle",
 		Description: client.Session.InitializeResult.ServerInfo.Name,
@@ -0,0 +1,279 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const memoryBackendName = "memory"
+
+// memoryBackendToolCallError is the result of every tool call to a server "deployed" with the in-memory backend.
+const memoryBackendToolCallError = "This MCP server is not running: Obot is using the in-memory runtime backend, which lists the tools from the server's tool preview but cannot call them."
+
+// memoryBackend runs no MCP servers, so Obot can be developed without Docker or Kubernetes. Each server is answered by
+// an in-process stand-in that lists the tools from the server's tool preview and returns an error for every tool call.
+// Remote servers need nothing deployed, so they are connected to directly.
+type memoryBackend struct {
+	obotClient kclient.Client
+	baseURL    string
+
+	lock     sync.Mutex
+	deployed map[string]ServerConfig
+	servers  map[string]*nmcp.HTTPServer
+}
+
+func newMemoryBackend(ctx context.Context, obotClient kclient.Client) (*memoryBackend, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for in-memory MCP servers: %w", err)
+	}
+
+	m := &memoryBackend{
+		obotClient: obotClient,
+		baseURL:    "http://" + listener.Addr().String(),
+		deployed:   make(map[string]ServerConfig),
+		servers:    make(map[string]*nmcp.HTTPServer),
+	}
+
+	server := &http.Server{Handler: m}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("in-memory MCP server listener stopped: %v", err)
+		}
+	}()
+
+	return m, nil
+}
+
+// ServeHTTP routes requests for /{server name}/mcp to the stand-in for that server.
+func (m *memoryBackend) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	name, rest, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+
+	m.lock.Lock()
+	server, ok := m.servers[name]
+	m.lock.Unlock()
+	if !ok || rest != "mcp" {
+		http.NotFound(rw, req)
+		return
+	}
+
+	server.ServeHTTP(rw, req)
+}
+
+func (m *memoryBackend) ensureServerDeployment(ctx context.Context, server ServerConfig, webhooks []Webhook) (ServerConfig, error) {
+	if err := m.deployServer(ctx, server, webhooks); err != nil {
+		return ServerConfig{}, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.deployed[server.MCPServerName], nil
+}
+
+func (m *memoryBackend) deployServer(ctx context.Context, server ServerConfig, _ []Webhook) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if server.Runtime == types.RuntimeRemote {
+		m.deployed[server.MCPServerName] = server
+		return nil
+	}
+
+	if _, ok := m.servers[server.MCPServerName]; !ok {
+		handler, err := nmcp.NewHTTPServer(ctx, nil, &memoryServer{backend: m, config: server}, nmcp.HTTPServerOptions{BaseContext: context.Background()})
+		if err != nil {
+			return fmt.Errorf("failed to create in-memory MCP server: %w", err)
+		}
+		m.servers[server.MCPServerName] = handler
+	}
+
+	deployed := server
+	deployed.Runtime = types.RuntimeRemote
+	deployed.URL = fmt.Sprintf("%s/%s/mcp", m.baseURL, server.MCPServerName)
+	m.deployed[server.MCPServerName] = deployed
+	return nil
+}
+
+func (m *memoryBackend) transformConfig(_ context.Context, server ServerConfig) (*ServerConfig, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	deployed, ok := m.deployed[server.MCPServerName]
+	if !ok {
+		return nil, nil
+	}
+	return &deployed, nil
+}
+
+func (m *memoryBackend) streamServerLogs(context.Context, string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("The in-memory runtime backend does not run MCP servers, so there are no logs.\n")), nil
+}
+
+func (m *memoryBackend) getServerDetails(_ context.Context, id string) (types.MCPServerDetails, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.deployed[id]; !ok {
+		return types.MCPServerDetails{}, ErrServerNotRunning
+	}
+
+	return types.MCPServerDetails{
+		DeploymentName: id,
+		IsAvailable:    true,
+		Replicas:       1,
+		ReadyReplicas:  1,
+	}, nil
+}
+
+func (m *memoryBackend) restartServer(context.Context, ServerConfig) error {
+	return nil
+}
+
+func (m *memoryBackend) shutdownServer(_ context.Context, id string, _ bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.deployed, id)
+	delete(m.servers, id)
+	return nil
+}
+
+func (m *memoryBackend) transformObotHostname(url string) string {
+	return url
+}
+
+// toolPreview returns the tool preview of the server, or of its catalog entry if the server doesn't have one.
+func (m *memoryBackend) toolPreview(ctx context.Context, server ServerConfig) ([]types.MCPServerTool, error) {
+	namespace := server.MCPServerNamespace
+	if namespace == "" {
+		namespace = system.DefaultNamespace
+	}
+
+	var mcpServer v1.MCPServer
+	if err := m.obotClient.Get(ctx, kclient.ObjectKey{Namespace: namespace, Name: server.MCPServerName}, &mcpServer); err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	} else if err == nil && len(mcpServer.Spec.Manifest.ToolPreview) > 0 {
+		return mcpServer.Spec.Manifest.ToolPreview, nil
+	}
+
+	if server.MCPCatalogEntryName == "" {
+		return nil, nil
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := m.obotClient.Get(ctx, kclient.ObjectKey{Namespace: namespace, Name: server.MCPCatalogEntryName}, &entry); err != nil {
+		return nil, kclient.IgnoreNotFound(err)
+	}
+	return entry.Spec.Manifest.ToolPreview, nil
+}
+
+// memoryServer is the in-process stand-in for an MCP server deployed with the in-memory backend.
+type memoryServer struct {
+	backend *memoryBackend
+	config  ServerConfig
+}
+
+func (s *memoryServer) OnMessage(ctx context.Context, msg nmcp.Message) {
+	switch msg.Method {
+	case "initialize":
+		nmcp.Invoke(ctx, msg, s.initialize)
+	case "notifications/initialized":
+	case "notifications/cancelled":
+		nmcp.HandleCancelled(ctx, msg)
+	case "ping":
+		nmcp.Invoke(ctx, msg, s.ping)
+	case "tools/list":
+		nmcp.Invoke(ctx, msg, s.listTools)
+	case "tools/call":
+		nmcp.Invoke(ctx, msg, s.callTool)
+	default:
+		msg.SendError(ctx, nmcp.ErrRPCMethodNotFound.WithMessage("%v", msg.Method))
+	}
+}
+
+func (s *memoryServer) initialize(_ context.Context, _ nmcp.Message, params nmcp.InitializeRequest) (*nmcp.InitializeResult, error) {
+	return &nmcp.InitializeResult{
+		ProtocolVersion: params.ProtocolVersion,
+		Capabilities: nmcp.ServerCapabilities{
+			Tools: &nmcp.ToolsServerCapability{},
+		},
+		ServerInfo: nmcp.ServerInfo{
+			Name:    s.config.MCPServerDisplayName,
+			Version: memoryBackendName,
+		},
+	}, nil
+}
+
+func (s *memoryServer) ping(context.Context, nmcp.Message, struct{}) (*nmcp.PingResult, error) {
+	return &nmcp.PingResult{}, nil
+}
+
+func (s *memoryServer) listTools(ctx context.Context, _ nmcp.Message, _ nmcp.ListToolsRequest) (*nmcp.ListToolsResult, error) {
+	preview, err := s.backend.toolPreview(ctx, s.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tool preview: %w", err)
+	}
+
+	tools := make([]nmcp.Tool, 0, len(preview))
+	for _, t := range preview {
+		tool, err := previewTool(t)
+		if err != nil {
+			return nil, err
+		}
+		tools = append(tools, tool)
+	}
+
+	return &nmcp.ListToolsResult{Tools: tools}, nil
+}
+
+func (s *memoryServer) callTool(context.Context, nmcp.Message, nmcp.CallToolRequest) (*nmcp.CallToolResult, error) {
+	return &nmcp.CallToolResult{
+		IsError: true,
+		Content: []nmcp.Content{{Type: "text", Text: memoryBackendToolCallError}},
+	}, nil
+}
+
+// previewTool converts a tool from a tool preview to an MCP tool. The preview only has the descriptions of the tool's
+// parameters, so they are all described as strings.
+func previewTool(t types.MCPServerTool) (nmcp.Tool, error) {
+	properties := make(map[string]any, len(t.Params))
+	for name, description := range t.Params {
+		properties[name] = map[string]string{
+			"type":        "string",
+			"description": description,
+		}
+	}
+
+	schema, err := json.Marshal(map[string]any{
+		"type":       "object",
+		"properties": properties,
+	})
+	if err != nil {
+		return nmcp.Tool{}, fmt.Errorf("failed to marshal input schema for tool %s: %w", t.Name, err)
+	}
+
+	return nmcp.Tool{
+		Name:        t.Name,
+		Description: t.Description,
+		InputSchema: schema,
+	}, nil
+}
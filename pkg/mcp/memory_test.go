@@ -0,0 +1,77 @@
+package mcp
+
+import (
+	"testing"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	storagescheme "github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMemoryBackendServesToolPreview(t *testing.T) {
+	storage := clientfake.NewClientBuilder().WithScheme(storagescheme.Scheme).WithObjects(
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1server", Namespace: system.DefaultNamespace},
+		},
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "entry", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				Manifest: types.MCPServerCatalogEntryManifest{
+					ToolPreview: []types.MCPServerTool{{
+						Name:        "search",
+						Description: "Search for things",
+						Params:      map[string]string{"query": "What to search for"},
+					}},
+				},
+			},
+		},
+	).Build()
+
+	backend, err := newMemoryBackend(t.Context(), storage)
+	require.NoError(t, err)
+
+	// The server has no tool preview of its own, so the catalog entry's is used.
+	config, err := backend.ensureServerDeployment(t.Context(), ServerConfig{
+		Runtime:             types.RuntimeContainerized,
+		MCPServerName:       "ms1server",
+		MCPCatalogEntryName: "entry",
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, types.RuntimeRemote, config.Runtime)
+
+	client, err := nmcp.NewClient(t.Context(), "ms1server", nmcp.Server{BaseURL: config.URL})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.Close(true)
+	})
+
+	tools, err := client.ListTools(t.Context())
+	require.NoError(t, err)
+	require.Len(t, tools.Tools, 1)
+	assert.Equal(t, "search", tools.Tools[0].Name)
+	assert.JSONEq(t, `{"type":"object","properties":{"query":{"type":"string","description":"What to search for"}}}`, string(tools.Tools[0].InputSchema))
+
+	result, err := client.Call(t.Context(), "search", map[string]any{"query": "anything"})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestMemoryBackendPassesRemoteServersThrough(t *testing.T) {
+	backend, err := newMemoryBackend(t.Context(), clientfake.NewClientBuilder().WithScheme(storagescheme.Scheme).Build())
+	require.NoError(t, err)
+
+	remote := ServerConfig{
+		Runtime:       types.RuntimeRemote,
+		URL:           "https://mcp.example.com/mcp",
+		MCPServerName: "ms1remote",
+	}
+	config, err := backend.ensureServerDeployment(t.Context(), remote, nil)
+	require.NoError(t, err)
+	assert.Equal(t, remote, config)
+}
@@ -0,0 +1,48 @@
+package mcp
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/obot-platform/obot/pkg/system"
+)
+
+// memoryServers configures the deployments of the Obot-managed memory MCP servers, which store their memories in
+// Obot's PostgreSQL database with pgvector. The controller provisions the schema of each server and removes it when
+// the server is deleted.
+type memoryServers struct {
+	dsn        string
+	quotaBytes int64
+}
+
+func newMemoryServers(dsn string, opts Options) *memoryServers {
+	return &memoryServers{
+		dsn:        dsn,
+		quotaBytes: MemoryServerQuotaBytes(opts),
+	}
+}
+
+// MemoryServerQuotaBytes returns the maximum size of the memories of each memory MCP server, or 0 if there is no limit.
+func MemoryServerQuotaBytes(opts Options) int64 {
+	return int64(max(opts.MCPMemoryServerQuotaMB, 0)) * 1024 * 1024
+}
+
+// IsMemoryServer returns whether the server was created from the catalog entry of the Obot-managed memory MCP server.
+func IsMemoryServer(catalogEntryName string) bool {
+	return catalogEntryName == system.MemoryCatalogEntryName
+}
+
+// configure adds the database, the schema of the server's memories, and their quota to the environment of memory
+// servers. Other servers are returned unchanged.
+func (m *memoryServers) configure(server ServerConfig) ServerConfig {
+	if m.dsn == "" || !IsMemoryServer(server.MCPCatalogEntryName) {
+		return server
+	}
+
+	server.Env = append(slices.Clone(server.Env),
+		"MEMORY_DSN="+m.dsn,
+		"MEMORY_SCHEMA="+system.MemoryStoreSchema(server.MCPServerName),
+		fmt.Sprintf("MEMORY_MAX_BYTES=%d", m.quotaBytes),
+	)
+	return server
+}
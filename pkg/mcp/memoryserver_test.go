@@ -0,0 +1,32 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryServersConfigure(t *testing.T) {
+	m := newMemoryServers("postgres://obot:secret@db:5432/obot", Options{MCPMemoryServerQuotaMB: 10})
+
+	server := m.configure(ServerConfig{
+		MCPServerName:       "ms1-abc",
+		MCPCatalogEntryName: system.MemoryCatalogEntryName,
+		Env:                 []string{"FOO=bar"},
+	})
+	assert.Equal(t, []string{
+		"FOO=bar",
+		"MEMORY_DSN=postgres://obot:secret@db:5432/obot",
+		"MEMORY_SCHEMA=memory_ms1_abc",
+		"MEMORY_MAX_BYTES=10485760",
+	}, server.Env)
+
+	// Servers from other catalog entries aren't changed.
+	other := ServerConfig{MCPServerName: "ms1-def", MCPCatalogEntryName: "default-other", Env: []string{"FOO=bar"}}
+	assert.Equal(t, other, m.configure(other))
+
+	// Memory servers aren't configured when Obot doesn't use PostgreSQL.
+	server = newMemoryServers("", Options{}).configure(ServerConfig{MCPCatalogEntryName: system.MemoryCatalogEntryName})
+	assert.Empty(t, server.Env)
+}
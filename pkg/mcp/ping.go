@@ -12,5 +12,7 @@ func (sm *SessionManager) PingServer(ctx context.Context, serverConfig ServerCon
 		return nil, err
 	}
 
-	return client.Ping(ctx)
+	result, err := client.Ping(ctx)
+	sm.circuitBreaker.record(serverConfig.MCPServerName, err)
+	return result, err
 }
@@ -0,0 +1,169 @@
+package mcp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+)
+
+// prefetchedList holds the result of a list request sent when a session started.
+type prefetchedList[T any] struct {
+	done  chan struct{}
+	lock  sync.Mutex
+	items []T
+	valid bool
+}
+
+func newPrefetchedList[T any]() *prefetchedList[T] {
+	return &prefetchedList[T]{done: make(chan struct{})}
+}
+
+// get waits for the list request to complete and returns its result.
+// False is returned if the request failed or the server has reported that the list changed since.
+func (p *prefetchedList[T]) get(ctx context.Context) ([]T, bool) {
+	if p == nil {
+		return nil, false
+	}
+
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		return nil, false
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.items, p.valid
+}
+
+func (p *prefetchedList[T]) complete(items []T, err error) {
+	p.lock.Lock()
+	p.items, p.valid = items, err == nil
+	p.lock.Unlock()
+
+	close(p.done)
+}
+
+func (p *prefetchedList[T]) invalidate() {
+	if p == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.items, p.valid = nil, false
+}
+
+// capabilityPrefetch holds the tools, prompts, and resources that were requested concurrently when a session started.
+type capabilityPrefetch struct {
+	tools     *prefetchedList[nmcp.Tool]
+	prompts   *prefetchedList[nmcp.Prompt]
+	resources *prefetchedList[nmcp.Resource]
+}
+
+func newCapabilityPrefetch() *capabilityPrefetch {
+	return &capabilityPrefetch{
+		tools:     newPrefetchedList[nmcp.Tool](),
+		prompts:   newPrefetchedList[nmcp.Prompt](),
+		resources: newPrefetchedList[nmcp.Resource](),
+	}
+}
+
+func (c *capabilityPrefetch) getTools(ctx context.Context) ([]nmcp.Tool, bool) {
+	if c == nil {
+		return nil, false
+	}
+	return c.tools.get(ctx)
+}
+
+func (c *capabilityPrefetch) getPrompts(ctx context.Context) ([]nmcp.Prompt, bool) {
+	if c == nil {
+		return nil, false
+	}
+	return c.prompts.get(ctx)
+}
+
+func (c *capabilityPrefetch) getResources(ctx context.Context) ([]nmcp.Resource, bool) {
+	if c == nil {
+		return nil, false
+	}
+	return c.resources.get(ctx)
+}
+
+// prefetchCapabilities sends the tools/list, prompts/list, and resources/list requests concurrently, so that they
+// are answered from the session afterward instead of each waiting on the server when they are first needed.
+func prefetchCapabilities(ctx context.Context, client *Client) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		resp, err := client.ListTools(ctx)
+		if err != nil {
+			log.Debugf("failed to prefetch tools for MCP server %s: %v", client.Config.MCPServerName, err)
+			client.prefetched.tools.complete(nil, err)
+			return
+		}
+		client.prefetched.tools.complete(resp.Tools, nil)
+	}()
+	go func() {
+		defer wg.Done()
+		resp, err := client.ListPrompts(ctx)
+		if err != nil {
+			log.Debugf("failed to prefetch prompts for MCP server %s: %v", client.Config.MCPServerName, err)
+			client.prefetched.prompts.complete(nil, err)
+			return
+		}
+		client.prefetched.prompts.complete(resp.Prompts, nil)
+	}()
+	go func() {
+		defer wg.Done()
+		resp, err := client.ListResources(ctx)
+		if err != nil {
+			log.Debugf("failed to prefetch resources for MCP server %s: %v", client.Config.MCPServerName, err)
+			client.prefetched.resources.complete(nil, err)
+			return
+		}
+		client.prefetched.resources.complete(resp.Resources, nil)
+	}()
+
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+}
+
+// invalidatePrefetched drops the prefetched list of the client with the given session when the server reports that the list changed.
+func (sm *SessionManager) invalidatePrefetched(serverName string, session *nmcp.Session, method string) {
+	sessions, ok := sm.sessions.Load(serverName)
+	if !ok || sessions == nil {
+		return
+	}
+
+	clientSessions, ok := sessions.(*sync.Map)
+	if !ok || clientSessions == nil {
+		return
+	}
+
+	clientSessions.Range(func(_, c any) bool {
+		client, ok := c.(*Client)
+		if !ok || client.Client == nil || client.Session != session || client.prefetched == nil {
+			return true
+		}
+
+		switch method {
+		case "notifications/tools/list_changed":
+			client.prefetched.tools.invalidate()
+		case "notifications/prompts/list_changed":
+			client.prefetched.prompts.invalidate()
+		case "notifications/resources/list_changed":
+			client.prefetched.resources.invalidate()
+		}
+		return false
+	})
+}
@@ -0,0 +1,47 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefetchedList(t *testing.T) {
+	p := newPrefetchedList[nmcp.Tool]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, ok := p.get(ctx)
+	assert.False(t, ok, "incomplete lists should miss when the context is done")
+
+	p.complete([]nmcp.Tool{{Name: "tool"}}, nil)
+	tools, ok := p.get(context.Background())
+	assert.True(t, ok)
+	assert.Equal(t, []nmcp.Tool{{Name: "tool"}}, tools)
+
+	p.invalidate()
+	_, ok = p.get(context.Background())
+	assert.False(t, ok, "invalidated lists should miss")
+}
+
+func TestPrefetchedListError(t *testing.T) {
+	p := newPrefetchedList[nmcp.Prompt]()
+	p.complete(nil, errors.New("method not found"))
+
+	_, ok := p.get(context.Background())
+	assert.False(t, ok, "failed requests should miss")
+}
+
+func TestCapabilityPrefetchDisabled(t *testing.T) {
+	var c *capabilityPrefetch
+
+	_, ok := c.getTools(context.Background())
+	assert.False(t, ok)
+	_, ok = c.getPrompts(context.Background())
+	assert.False(t, ok)
+	_, ok = c.getResources(context.Background())
+	assert.False(t, ok)
+}
@@ -13,6 +13,10 @@ func (sm *SessionManager) ListPrompts(ctx context.Context, serverConfig ServerCo
 		return nil, err
 	}
 
+	if prompts, ok := client.prefetched.getPrompts(ctx); ok {
+		return prompts, nil
+	}
+
 	resp, err := client.ListPrompts(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list MCP prompts: %w", err)
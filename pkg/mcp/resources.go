@@ -13,6 +13,10 @@ func (sm *SessionManager) ListResources(ctx context.Context, serverConfig Server
 		return nil, err
 	}
 
+	if resources, ok := client.prefetched.getResources(ctx); ok {
+		return resources, nil
+	}
+
 	resp, err := client.ListResources(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list MCP resources: %w", err)
@@ -27,6 +31,9 @@ func (sm *SessionManager) ReadResource(ctx context.Context, serverConfig ServerC
 		return nil, err
 	}
 
+	ctx, cancel := withTimeout(ctx, sm.requestTimeouts.forServer(client.Config.RequestTimeouts).resourcesRead)
+	defer cancel()
+
 	resp, err := client.ReadResource(ctx, uri)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get MCP resource: %w", err)
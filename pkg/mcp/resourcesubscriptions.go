@@ -0,0 +1,236 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// resourceSubscriptionRefreshInterval is how often a subscription checks whether the session it is subscribed on
+// has been replaced, for example because its token expired, and moves its subscriptions to the new session.
+const resourceSubscriptionRefreshInterval = time.Minute
+
+// ErrResourceSubscriptionsNotSupported is returned when subscribing to the resources of a server that does not support subscriptions.
+var ErrResourceSubscriptionsNotSupported = errors.New("MCP server does not support resource subscriptions")
+
+type resourceSubscriber struct {
+	session *nmcp.Session
+	uris    map[string]struct{}
+	ch      chan types.MCPResourceUpdate
+}
+
+// resourceSubscriptions tracks the API clients subscribed to resource updates and reference counts the
+// resources/subscribe requests sent on each MCP session, so that one subscriber going away doesn't
+// unsubscribe the others from the same resource.
+type resourceSubscriptions struct {
+	lock        sync.Mutex
+	subscribers map[*resourceSubscriber]struct{}
+	refs        map[*nmcp.Session]map[string]int
+}
+
+func newResourceSubscriptions() *resourceSubscriptions {
+	return &resourceSubscriptions{
+		subscribers: make(map[*resourceSubscriber]struct{}),
+		refs:        make(map[*nmcp.Session]map[string]int),
+	}
+}
+
+// acquire records a reference to the resource on the session and reports whether it is the first one.
+func (r *resourceSubscriptions) acquire(session *nmcp.Session, uri string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.refs[session] == nil {
+		r.refs[session] = make(map[string]int)
+	}
+	r.refs[session][uri]++
+	return r.refs[session][uri] == 1
+}
+
+// release removes a reference to the resource on the session and reports whether it was the last one.
+func (r *resourceSubscriptions) release(session *nmcp.Session, uri string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.refs[session][uri] == 0 {
+		return false
+	}
+
+	r.refs[session][uri]--
+	if r.refs[session][uri] > 0 {
+		return false
+	}
+
+	delete(r.refs[session], uri)
+	if len(r.refs[session]) == 0 {
+		delete(r.refs, session)
+	}
+	return true
+}
+
+func (r *resourceSubscriptions) add(s *resourceSubscriber) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.subscribers[s] = struct{}{}
+}
+
+func (r *resourceSubscriptions) remove(s *resourceSubscriber) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.subscribers, s)
+	close(s.ch)
+}
+
+func (r *resourceSubscriptions) setSession(s *resourceSubscriber, session *nmcp.Session) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	s.session = session
+}
+
+// publish sends the update to the subscribers of the resource on the given session.
+func (r *resourceSubscriptions) publish(session *nmcp.Session, update types.MCPResourceUpdate) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for s := range r.subscribers {
+		if s.session != session {
+			continue
+		}
+		if _, ok := s.uris[update.URI]; !ok {
+			continue
+		}
+
+		select {
+		case s.ch <- update:
+		default:
+			// The subscriber isn't keeping up. It can re-read the resource when it catches up.
+		}
+	}
+}
+
+// onNotify returns the handler for notifications sent by the given server.
+func (sm *SessionManager) onNotify(server ServerConfig) func(context.Context, nmcp.Message) error {
+	return func(_ context.Context, msg nmcp.Message) error {
+		switch msg.Method {
+		case "notifications/resources/updated":
+			return sm.onResourceUpdated(server, msg)
+		case "notifications/tools/list_changed":
+			sm.toolCache.invalidate(server.MCPServerName)
+			sm.invalidatePrefetched(server.MCPServerName, msg.Session, msg.Method)
+		case "notifications/prompts/list_changed", "notifications/resources/list_changed":
+			sm.invalidatePrefetched(server.MCPServerName, msg.Session, msg.Method)
+		}
+		return nil
+	}
+}
+
+func (sm *SessionManager) onResourceUpdated(server ServerConfig, msg nmcp.Message) error {
+	var params struct {
+		URI string `json:"uri"`
+	}
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return fmt.Errorf("failed to unmarshal notifications/resources/updated: %w", err)
+	}
+
+	sm.resourceSubscriptions.publish(msg.Session, types.MCPResourceUpdate{
+		MCPServerID: server.MCPServerName,
+		URI:         params.URI,
+		Time:        *types.NewTime(time.Now()),
+	})
+	return nil
+}
+
+// SubscribeResourceUpdates subscribes to updates of the given resources and returns a channel that receives a
+// notification each time the server reports that one of them changed. The subscriptions are removed from the
+// server and the channel is closed when the context is done.
+func (sm *SessionManager) SubscribeResourceUpdates(ctx context.Context, serverConfig ServerConfig, uris []string) (<-chan types.MCPResourceUpdate, error) {
+	client, err := sm.clientForServer(ctx, serverConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if caps := client.Session.InitializeResult.Capabilities.Resources; caps == nil || !caps.Subscribe {
+		return nil, ErrResourceSubscriptionsNotSupported
+	}
+
+	if err = sm.subscribeResources(ctx, client, uris); err != nil {
+		return nil, err
+	}
+
+	s := &resourceSubscriber{
+		session: client.Session,
+		uris:    make(map[string]struct{}, len(uris)),
+		ch:      make(chan types.MCPResourceUpdate, 10),
+	}
+	for _, uri := range uris {
+		s.uris[uri] = struct{}{}
+	}
+	sm.resourceSubscriptions.add(s)
+
+	go func() {
+		ticker := time.NewTicker(resourceSubscriptionRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				sm.resourceSubscriptions.remove(s)
+
+				// The request context is done, so use a new one to clean up the subscriptions on the server.
+				cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				sm.unsubscribeResources(cleanupCtx, client, uris)
+				cancel()
+				return
+			case <-ticker.C:
+				current, err := sm.clientForServer(ctx, serverConfig)
+				if err != nil || current == client {
+					continue
+				}
+
+				if err = sm.subscribeResources(ctx, current, uris); err != nil {
+					log.Warnf("failed to move resource subscriptions for MCP server %s to new session: %v", serverConfig.MCPServerName, err)
+					continue
+				}
+				sm.resourceSubscriptions.setSession(s, current.Session)
+				sm.unsubscribeResources(ctx, client, uris)
+				client = current
+			}
+		}
+	}()
+
+	return s.ch, nil
+}
+
+func (sm *SessionManager) subscribeResources(ctx context.Context, client *Client, uris []string) error {
+	for i, uri := range uris {
+		if !sm.resourceSubscriptions.acquire(client.Session, uri) {
+			continue
+		}
+		if _, err := client.SubscribeResource(ctx, uri); err != nil {
+			sm.resourceSubscriptions.release(client.Session, uri)
+			sm.unsubscribeResources(ctx, client, uris[:i])
+			return fmt.Errorf("failed to subscribe to MCP resource %s: %w", uri, err)
+		}
+	}
+	return nil
+}
+
+func (sm *SessionManager) unsubscribeResources(ctx context.Context, client *Client, uris []string) {
+	for _, uri := range uris {
+		if !sm.resourceSubscriptions.release(client.Session, uri) {
+			continue
+		}
+		if _, err := client.UnsubscribeResource(ctx, uri); err != nil {
+			log.Debugf("failed to unsubscribe from MCP resource %s: %v", uri, err)
+		}
+	}
+}
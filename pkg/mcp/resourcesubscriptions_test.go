@@ -0,0 +1,49 @@
+package mcp
+
+import (
+	"testing"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceSubscriptionsRefCounting(t *testing.T) {
+	r := newResourceSubscriptions()
+	session := nmcp.NewEmptySession(t.Context())
+
+	assert.True(t, r.acquire(session, "file:///a"), "first reference should subscribe")
+	assert.False(t, r.acquire(session, "file:///a"), "second reference should reuse the subscription")
+	assert.True(t, r.acquire(nmcp.NewEmptySession(t.Context()), "file:///a"), "references are per session")
+
+	assert.False(t, r.release(session, "file:///a"), "a reference remains")
+	assert.True(t, r.release(session, "file:///a"), "last reference should unsubscribe")
+	assert.False(t, r.release(session, "file:///a"), "releasing an unknown reference is a no-op")
+}
+
+func TestResourceSubscriptionsPublish(t *testing.T) {
+	r := newResourceSubscriptions()
+	session := nmcp.NewEmptySession(t.Context())
+
+	s := &resourceSubscriber{
+		session: session,
+		uris:    map[string]struct{}{"file:///a": {}},
+		ch:      make(chan types.MCPResourceUpdate, 10),
+	}
+	r.add(s)
+
+	// Updates for other resources or from other sessions are not delivered.
+	r.publish(session, types.MCPResourceUpdate{URI: "file:///b"})
+	r.publish(nmcp.NewEmptySession(t.Context()), types.MCPResourceUpdate{URI: "file:///a"})
+	r.publish(session, types.MCPResourceUpdate{MCPServerID: "ms1abc", URI: "file:///a"})
+
+	require.Len(t, s.ch, 1)
+	update := <-s.ch
+	assert.Equal(t, "ms1abc", update.MCPServerID)
+	assert.Equal(t, "file:///a", update.URI)
+
+	r.remove(s)
+	_, ok := <-s.ch
+	assert.False(t, ok, "channel should be closed once the subscriber is removed")
+}
@@ -0,0 +1,124 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// rootsRegistry holds the roots that API clients have declared for a user's sessions with an MCP server.
+type rootsRegistry struct {
+	lock  sync.RWMutex
+	roots map[string][]nmcp.Root
+}
+
+func newRootsRegistry() *rootsRegistry {
+	return &rootsRegistry{
+		roots: make(map[string][]nmcp.Root),
+	}
+}
+
+func rootsKey(server ServerConfig) string {
+	return server.MCPServerName + "/" + server.UserID
+}
+
+func (r *rootsRegistry) get(server ServerConfig) []nmcp.Root {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return slices.Clone(r.roots[rootsKey(server)])
+}
+
+func (r *rootsRegistry) set(server ServerConfig, roots []nmcp.Root) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(roots) == 0 {
+		delete(r.roots, rootsKey(server))
+		return
+	}
+	r.roots[rootsKey(server)] = roots
+}
+
+// onRoots returns the handler for roots/list requests issued by the given server.
+func (sm *SessionManager) onRoots(server ServerConfig) func(context.Context, nmcp.Message) error {
+	return func(ctx context.Context, msg nmcp.Message) error {
+		roots := sm.roots.get(server)
+		if roots == nil {
+			// The roots field is required, so never send null.
+			roots = []nmcp.Root{}
+		}
+		return msg.Reply(ctx, nmcp.ListRootsResult{Roots: roots})
+	}
+}
+
+// Roots returns the roots declared for the user's sessions with the server.
+func (sm *SessionManager) Roots(serverConfig ServerConfig) []types.MCPRoot {
+	roots := sm.roots.get(serverConfig)
+	result := make([]types.MCPRoot, 0, len(roots))
+	for _, r := range roots {
+		result = append(result, types.MCPRoot{
+			URI:  r.URI,
+			Name: r.Name,
+		})
+	}
+	return result
+}
+
+// SetRoots replaces the roots declared for the user's sessions with the server, and notifies
+// the server of the change on any of the user's sessions that are currently open.
+func (sm *SessionManager) SetRoots(ctx context.Context, serverConfig ServerConfig, roots []types.MCPRoot) error {
+	nRoots := make([]nmcp.Root, 0, len(roots))
+	for _, r := range roots {
+		if err := validateRootURI(r.URI); err != nil {
+			return err
+		}
+		nRoots = append(nRoots, nmcp.Root{
+			URI:  r.URI,
+			Name: r.Name,
+		})
+	}
+
+	sm.roots.set(serverConfig, nRoots)
+
+	sessions, ok := sm.sessions.Load(serverConfig.MCPServerName)
+	if !ok || sessions == nil {
+		return nil
+	}
+
+	clientSessions, ok := sessions.(*sync.Map)
+	if !ok || clientSessions == nil {
+		return nil
+	}
+
+	clientSessions.Range(func(_, value any) bool {
+		c, ok := value.(*Client)
+		if !ok || c.Client == nil || c.Session == nil || c.Config.UserID != serverConfig.UserID {
+			return true
+		}
+
+		if err := c.Session.SendPayload(ctx, "notifications/roots/list_changed", struct{}{}); err != nil {
+			log.Warnf("failed to send roots/list_changed notification to MCP server %s: %v", serverConfig.MCPServerName, err)
+		}
+		return true
+	})
+
+	return nil
+}
+
+// validateRootURI checks that a root is a file URI, as required by the MCP specification.
+func validateRootURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid root URI %q: %w", uri, err)
+	}
+	if u.Scheme != "file" || u.Path == "" {
+		return fmt.Errorf("invalid root URI %q: roots must be file:// URIs", uri)
+	}
+	return nil
+}
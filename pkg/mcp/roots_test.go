@@ -0,0 +1,32 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetRoots(t *testing.T) {
+	sm := &SessionManager{roots: newRootsRegistry()}
+
+	user1 := ServerConfig{MCPServerName: "ms1abc", UserID: "user1"}
+	user2 := ServerConfig{MCPServerName: "ms1abc", UserID: "user2"}
+
+	require.NoError(t, sm.SetRoots(t.Context(), user1, []types.MCPRoot{
+		{URI: "file:///workspace/knowledge", Name: "Knowledge"},
+	}))
+
+	assert.Equal(t, []types.MCPRoot{{URI: "file:///workspace/knowledge", Name: "Knowledge"}}, sm.Roots(user1))
+	assert.Empty(t, sm.Roots(user2))
+
+	// Roots must be file URIs.
+	assert.Error(t, sm.SetRoots(t.Context(), user1, []types.MCPRoot{{URI: "https://example.com"}}))
+	assert.Error(t, sm.SetRoots(t.Context(), user1, []types.MCPRoot{{URI: "file://"}}))
+	assert.Len(t, sm.Roots(user1), 1)
+
+	// Setting no roots clears them.
+	require.NoError(t, sm.SetRoots(t.Context(), user1, nil))
+	assert.Empty(t, sm.Roots(user1))
+}
@@ -11,6 +11,15 @@ import (
 	gtypes "github.com/gptscript-ai/gptscript/pkg/types"
 )
 
+const (
+	// RunIDMetaKey is the key in the _meta of tool calls that holds the ID of the agent run that made the call, so that
+	// the audit logs of the calls can be linked to the run.
+	RunIDMetaKey = "obot.ai/run-id"
+	// ProjectIDMetaKey is the key in the _meta of tool calls that holds the ID of the project of the agent run that made
+	// the call, so that the costs of the calls can be added up per project.
+	ProjectIDMetaKey = "obot.ai/project-id"
+)
+
 // Run is responsible for calling MCP tools when the LLM requests their execution. This method is called by GPTScript.
 func (sm *SessionManager) Run(ctx engine.Context, _ chan<- gtypes.CompletionStatus, tool gtypes.Tool, input string) (string, error) {
 	fields := strings.Fields(tool.Instructions)
@@ -72,7 +81,37 @@ func (sm *SessionManager) Run(ctx engine.Context, _ chan<- gtypes.CompletionStat
 		}
 	}
 
-	result, err := session.Call(ctx.Ctx, toolName, arguments)
+	// Servers in the lazy schema mode expose tools that describe and call their tools by name.
+	switch toolName {
+	case describeToolsName:
+		return sm.describeTools(ctx.Ctx, session, tool.MetaData[allowedToolsMetaKey], arguments)
+	case callToolName:
+		var err error
+		if toolName, arguments, err = lazyToolCall(tool.MetaData[allowedToolsMetaKey], arguments); err != nil {
+			return "", fmt.Errorf("failed to call tool: %w", err)
+		}
+	}
+
+	policy, err := sm.toolPolicyHelper.PolicyForServer(session.Config)
+	if err != nil {
+		return "", err
+	}
+	if !ToolAllowed(policy, toolName) {
+		return "", fmt.Errorf("failed to call tool %s: %w", toolName, ErrToolDenied)
+	}
+	if !ToolAllowlisted(session.Config.ToolAllowlist, toolName) {
+		return "", fmt.Errorf("failed to call tool %s: %w", toolName, ErrToolNotAllowlisted)
+	}
+
+	var meta map[string]any
+	if runID := envValue(ctx, "OBOT_RUN_ID"); runID != "" {
+		meta = map[string]any{RunIDMetaKey: runID}
+		if projectID := envValue(ctx, "OBOT_PROJECT_ID"); projectID != "" {
+			meta[ProjectIDMetaKey] = projectID
+		}
+	}
+
+	output, result, err := sm.callTool(ctx.Ctx, session, toolName, arguments, meta)
 	if err != nil {
 		if ctx.ToolCategory == engine.NoCategory && ctx.Parent != nil {
 			var output []byte
@@ -85,10 +124,19 @@ func (sm *SessionManager) Run(ctx engine.Context, _ chan<- gtypes.CompletionStat
 		return "", fmt.Errorf("failed to call tool %s: %w", toolName, err)
 	}
 
-	str, err := json.Marshal(result)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
-	}
+	return string(output), nil
+}
 
-	return string(str), nil
+// envValue returns a variable that Obot sets in the environment of the run that GPTScript is calling a tool for, such
+// as the ID of the run.
+func envValue(ctx engine.Context, name string) string {
+	if ctx.Engine == nil {
+		return ""
+	}
+	for _, env := range ctx.Engine.Env {
+		if value, ok := strings.CutPrefix(env, name+"="); ok {
+			return value
+		}
+	}
+	return ""
 }
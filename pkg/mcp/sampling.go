@@ -0,0 +1,200 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/tidwall/gjson"
+)
+
+// samplingRunPrefix prefixes the run name recorded with the token usage of sampling requests so that the cost can be attributed to the MCP server.
+const samplingRunPrefix = "mcp-sampling-"
+
+// samplingEnabled returns whether sampling requests from the server should be handled by Obot.
+// When it is not, the sampling capability is not advertised to the server.
+func samplingEnabled(server ServerConfig) bool {
+	return server.Sampling != nil && server.Sampling.Enabled
+}
+
+// samplingModel selects the model to use for a sampling request. Model hints are honored, in order, only if they match an allowed model.
+// Otherwise, the first allowed model is used, falling back to the default llm alias if there are no model restrictions.
+func samplingModel(config *types.MCPSamplingConfig, preferences nmcp.ModelPreferences) string {
+	if config == nil || len(config.AllowedModels) == 0 {
+		return string(types.DefaultModelAliasTypeLLM)
+	}
+
+	for _, hint := range preferences.Hints {
+		if slices.Contains(config.AllowedModels, hint.Name) {
+			return hint.Name
+		}
+	}
+
+	return config.AllowedModels[0]
+}
+
+// samplingInputMessage is an input message in an OpenResponses request.
+type samplingInputMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// samplingRequest is the OpenResponses request body sent to the LLM proxy for a sampling request.
+type samplingRequest struct {
+	Model           string                 `json:"model"`
+	Input           []samplingInputMessage `json:"input"`
+	Instructions    string                 `json:"instructions,omitempty"`
+	MaxOutputTokens int                    `json:"max_output_tokens,omitempty"`
+	Temperature     *json.Number           `json:"temperature,omitempty"`
+	Stream          bool                   `json:"stream"`
+}
+
+// newSamplingRequest converts an MCP sampling request into an OpenResponses request. Only text content is supported.
+func newSamplingRequest(model string, req nmcp.CreateMessageRequest) (samplingRequest, error) {
+	input := make([]samplingInputMessage, 0, len(req.Messages))
+	for _, msg := range req.Messages {
+		var text strings.Builder
+		for _, content := range msg.Content {
+			if content.Type != "text" {
+				return samplingRequest{}, fmt.Errorf("unsupported sampling content type %q", content.Type)
+			}
+			text.WriteString(content.Text)
+		}
+
+		input = append(input, samplingInputMessage{
+			Role:    msg.Role,
+			Content: text.String(),
+		})
+	}
+
+	return samplingRequest{
+		Model:           model,
+		Input:           input,
+		Instructions:    req.SystemPrompt,
+		MaxOutputTokens: req.MaxTokens,
+		Temperature:     req.Temperature,
+		Stream:          true,
+	}, nil
+}
+
+// onSampling returns the handler for sampling requests issued by the given server.
+// Requests are sent through Obot's LLM proxy on behalf of the server's user, so model access policies,
+// token limits, and message policies all apply, and the token usage is recorded against the server.
+func (sm *SessionManager) onSampling(server ServerConfig) func(context.Context, nmcp.CreateMessageRequest) (nmcp.CreateMessageResult, error) {
+	return func(ctx context.Context, req nmcp.CreateMessageRequest) (nmcp.CreateMessageResult, error) {
+		model := samplingModel(server.Sampling, req.ModelPreferences)
+		body, err := newSamplingRequest(model, req)
+		if err != nil {
+			return nmcp.CreateMessageResult{}, err
+		}
+
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nmcp.CreateMessageResult{}, fmt.Errorf("failed to marshal sampling request: %w", err)
+		}
+
+		now := time.Now().Add(-time.Second)
+		_, token, err := sm.tokenService.NewTokenWithClaims(ctx, jwt.MapClaims{
+			"exp":       float64(now.Add(15 * time.Minute).Unix()),
+			"iat":       float64(now.Unix()),
+			"sub":       server.UserID,
+			"MCPID":     server.MCPServerName,
+			"Namespace": system.DefaultNamespace,
+			"RunID":     samplingRunPrefix + server.MCPServerName,
+		})
+		if err != nil {
+			return nmcp.CreateMessageResult{}, fmt.Errorf("failed to create token for sampling request: %w", err)
+		}
+
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, sm.internalServerURL+"/api/llm-proxy/responses", bytes.NewReader(b))
+		if err != nil {
+			return nmcp.CreateMessageResult{}, fmt.Errorf("failed to create sampling request: %w", err)
+		}
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(httpReq)
+		if err != nil {
+			return nmcp.CreateMessageResult{}, fmt.Errorf("sampling request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			respBody, _ := io.ReadAll(resp.Body)
+			return nmcp.CreateMessageResult{}, fmt.Errorf("sampling request returned status %d: %s", resp.StatusCode, string(respBody))
+		}
+
+		result, err := readSamplingResponse(resp.Body)
+		if err != nil {
+			return nmcp.CreateMessageResult{}, err
+		}
+		if result.Model == "" {
+			result.Model = model
+		}
+
+		log.Debugf("Handled sampling request for MCP server %s with model %s", server.MCPServerName, result.Model)
+		return result, nil
+	}
+}
+
+// readSamplingResponse reads a streamed OpenResponses response and converts it into an MCP sampling result.
+func readSamplingResponse(r io.Reader) (nmcp.CreateMessageResult, error) {
+	var (
+		text       strings.Builder
+		model      string
+		stopReason = "endTurn"
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok || data == "[DONE]" {
+			continue
+		}
+
+		switch gjson.Get(data, "type").String() {
+		case "response.output_text.delta":
+			text.WriteString(gjson.Get(data, "delta").String())
+		case "response.completed":
+			model = gjson.Get(data, "response.model").String()
+		case "response.incomplete":
+			model = gjson.Get(data, "response.model").String()
+			if gjson.Get(data, "response.incomplete_details.reason").String() == "max_output_tokens" {
+				stopReason = "maxTokens"
+			}
+		case "response.failed", "error":
+			msg := gjson.Get(data, "response.error.message").String()
+			if msg == "" {
+				msg = gjson.Get(data, "message").String()
+			}
+			return nmcp.CreateMessageResult{}, fmt.Errorf("sampling request failed: %s", msg)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nmcp.CreateMessageResult{}, fmt.Errorf("failed to read sampling response: %w", err)
+	}
+
+	return nmcp.CreateMessageResult{
+		Content: nmcp.Contents{
+			{
+				Type: "text",
+				Text: text.String(),
+			},
+		},
+		Role:       "assistant",
+		Model:      model,
+		StopReason: stopReason,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package mcp
+
+import (
+	"strings"
+	"testing"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSamplingModel(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *types.MCPSamplingConfig
+		preferences nmcp.ModelPreferences
+		expected    string
+	}{
+		{
+			name:     "no restrictions uses the default alias",
+			config:   &types.MCPSamplingConfig{Enabled: true},
+			expected: "llm",
+		},
+		{
+			name:        "no restrictions ignores hints",
+			config:      &types.MCPSamplingConfig{Enabled: true},
+			preferences: nmcp.ModelPreferences{Hints: []nmcp.ModelHint{{Name: "claude-opus"}}},
+			expected:    "llm",
+		},
+		{
+			name:        "allowed hint is honored",
+			config:      &types.MCPSamplingConfig{Enabled: true, AllowedModels: []string{"llm", "llm-mini"}},
+			preferences: nmcp.ModelPreferences{Hints: []nmcp.ModelHint{{Name: "gpt-5"}, {Name: "llm-mini"}}},
+			expected:    "llm-mini",
+		},
+		{
+			name:        "disallowed hint falls back to the first allowed model",
+			config:      &types.MCPSamplingConfig{Enabled: true, AllowedModels: []string{"m1-abc", "llm"}},
+			preferences: nmcp.ModelPreferences{Hints: []nmcp.ModelHint{{Name: "gpt-5"}}},
+			expected:    "m1-abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, samplingModel(tt.config, tt.preferences))
+		})
+	}
+}
+
+func TestNewSamplingRequestRejectsNonText(t *testing.T) {
+	_, err := newSamplingRequest("llm", nmcp.CreateMessageRequest{
+		Messages: []nmcp.SamplingMessage{{
+			Role:    "user",
+			Content: nmcp.Contents{{Type: "image", Data: "abc", MIMEType: "image/png"}},
+		}},
+	})
+	assert.Error(t, err)
+}
+
+func TestReadSamplingResponse(t *testing.T) {
+	stream := strings.Join([]string{
+		`event: response.output_text.delta`,
+		`data: {"type":"response.output_text.delta","delta":"Hello, "}`,
+		``,
+		`data: {"type":"response.output_text.delta","delta":"world"}`,
+		``,
+		`data: {"type":"response.incomplete","response":{"model":"gpt-4.1","incomplete_details":{"reason":"max_output_tokens"}}}`,
+		``,
+	}, "\n")
+
+	result, err := readSamplingResponse(strings.NewReader(stream))
+	require.NoError(t, err)
+	assert.Equal(t, "assistant", result.Role)
+	assert.Equal(t, "gpt-4.1", result.Model)
+	assert.Equal(t, "maxTokens", result.StopReason)
+	require.Len(t, result.Content, 1)
+	assert.Equal(t, "Hello, world", result.Content[0].Text)
+
+	_, err = readSamplingResponse(strings.NewReader(`data: {"type":"response.failed","response":{"error":{"message":"boom"}}}`))
+	assert.ErrorContains(t, err, "boom")
+}
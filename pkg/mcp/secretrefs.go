@@ -0,0 +1,381 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	secretManagerVault = "vault"
+	secretManagerAWS   = "aws"
+	secretManagerGCP   = "gcp"
+)
+
+var (
+	ErrSecretResolutionFailed = errors.New("failed to resolve external secret")
+	errSecretNotFound         = errors.New("secret not found")
+)
+
+// SecretRef is an environment variable of a server whose value is a secret in an external secret manager.
+type SecretRef struct {
+	EnvKey   string `json:"envKey"`
+	Ref      string `json:"ref"`
+	Prefix   string `json:"prefix,omitempty"`
+	File     bool   `json:"file,omitempty"`
+	Dynamic  bool   `json:"dynamic,omitempty"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// ParseSecretRef splits a reference to an external secret, such as vault:secret/data/app#token, into the secret
+// manager, the name of the secret, and the optional key of the value in a secret that is a JSON object.
+func ParseSecretRef(ref string) (manager, name, key string, err error) {
+	manager, rest, ok := strings.Cut(ref, ":")
+	if !ok || rest == "" {
+		return "", "", "", fmt.Errorf("secret reference %q must have the form <manager>:<name>[#key]", ref)
+	}
+
+	switch manager {
+	case secretManagerVault, secretManagerAWS, secretManagerGCP:
+	default:
+		return "", "", "", fmt.Errorf("secret reference %q has unknown secret manager %q, must be %s, %s, or %s", ref, manager, secretManagerVault, secretManagerAWS, secretManagerGCP)
+	}
+
+	name, key, _ = strings.Cut(rest, "#")
+	if name == "" {
+		return "", "", "", fmt.Errorf("secret reference %q is missing the name of the secret", ref)
+	}
+
+	return manager, name, key, nil
+}
+
+// secretManager reads secrets from an external secret manager.
+type secretManager interface {
+	getSecret(ctx context.Context, name string) (string, error)
+}
+
+// secretRefResolver resolves the external secrets of servers with the secret managers that are configured.
+type secretRefResolver struct {
+	managers map[string]secretManager
+}
+
+func newSecretRefResolver(opts Options) *secretRefResolver {
+	r := &secretRefResolver{
+		managers: make(map[string]secretManager, 3),
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	if opts.MCPSecretManagerVaultAddress != "" {
+		r.managers[secretManagerVault] = &vaultSecretManager{
+			client:    client,
+			address:   strings.TrimSuffix(opts.MCPSecretManagerVaultAddress, "/"),
+			token:     opts.MCPSecretManagerVaultToken,
+			namespace: opts.MCPSecretManagerVaultNamespace,
+		}
+	}
+	if opts.MCPSecretManagerAWSRegion != "" {
+		r.managers[secretManagerAWS] = &awsSecretManager{
+			client:   client,
+			region:   opts.MCPSecretManagerAWSRegion,
+			endpoint: fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", opts.MCPSecretManagerAWSRegion),
+		}
+	}
+	if opts.MCPSecretManagerGCP {
+		r.managers[secretManagerGCP] = &gcpSecretManager{
+			client:   client,
+			endpoint: "https://secretmanager.googleapis.com",
+		}
+	}
+
+	return r
+}
+
+// resolve reads the external secrets of the server, and adds them to its environment variables and files. Secrets
+// that don't exist are skipped, unless they are required.
+func (r *secretRefResolver) resolve(ctx context.Context, server ServerConfig) (ServerConfig, error) {
+	if len(server.SecretRefs) == 0 {
+		return server, nil
+	}
+
+	env := make([]string, 0, len(server.Env)+len(server.SecretRefs))
+	env = append(env, server.Env...)
+	files := make([]File, 0, len(server.Files)+len(server.SecretRefs))
+	files = append(files, server.Files...)
+
+	for _, ref := range server.SecretRefs {
+		val, err := r.resolveRef(ctx, ref.Ref)
+		if errors.Is(err, errSecretNotFound) && !ref.Required {
+			continue
+		} else if err != nil {
+			return ServerConfig{}, fmt.Errorf("%w for %s: %w", ErrSecretResolutionFailed, ref.EnvKey, err)
+		}
+
+		val = applyPrefix(val, ref.Prefix)
+		if ref.File {
+			files = append(files, File{
+				Data:    val,
+				EnvKey:  ref.EnvKey,
+				Dynamic: ref.Dynamic,
+			})
+		} else {
+			env = append(env, fmt.Sprintf("%s=%s", ref.EnvKey, val))
+		}
+	}
+
+	server.Env = env
+	server.Files = files
+	server.SecretRefs = nil
+	return server, nil
+}
+
+func (r *secretRefResolver) resolveRef(ctx context.Context, ref string) (string, error) {
+	managerName, name, key, err := ParseSecretRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	manager, ok := r.managers[managerName]
+	if !ok {
+		return "", fmt.Errorf("secret manager %s is not configured", managerName)
+	}
+
+	val, err := manager.getSecret(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s from %s: %w", name, managerName, err)
+	}
+
+	if key == "" {
+		return val, nil
+	}
+	return secretKey(val, key)
+}
+
+// secretKey returns the value of the key in a secret that is a JSON object.
+func secretKey(secret, key string) (string, error) {
+	var values map[string]any
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", fmt.Errorf("secret is not a JSON object, so it has no key %s", key)
+	}
+
+	val, ok := values[key]
+	if !ok || val == nil {
+		return "", fmt.Errorf("%w: secret has no key %s", errSecretNotFound, key)
+	}
+	if s, ok := val.(string); ok {
+		return s, nil
+	}
+
+	b, err := json.Marshal(val)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// doSecretRequest sends the request to a secret manager and decodes the JSON response into out.
+func doSecretRequest(client *http.Client, req *http.Request, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return errSecretNotFound
+	case resp.StatusCode == http.StatusBadRequest && strings.Contains(string(body), "ResourceNotFoundException"):
+		// AWS Secrets Manager reports missing secrets as bad requests.
+		return errSecretNotFound
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// vaultSecretManager reads secrets from the HashiCorp Vault HTTP API. The name of a secret is its path, such as
+// secret/data/app for a KV version 2 secrets engine.
+type vaultSecretManager struct {
+	client    *http.Client
+	address   string
+	token     string
+	namespace string
+}
+
+func (v *vaultSecretManager) getSecret(ctx context.Context, name string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.address+"/v1/"+strings.TrimPrefix(name, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	var resp struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := doSecretRequest(v.client, req, &resp); err != nil {
+		return "", err
+	}
+
+	data := resp.Data
+	// KV version 2 secrets nest their values in another data field, next to their metadata.
+	if nested, ok := data["data"]; ok {
+		if _, ok := data["metadata"]; ok {
+			data = nil
+			if err := json.Unmarshal(nested, &data); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// awsSecretManager reads secrets from AWS Secrets Manager with the default credentials of the Obot server. The name of
+// a secret is its name or ARN.
+type awsSecretManager struct {
+	client   *http.Client
+	region   string
+	endpoint string
+
+	lock        sync.Mutex
+	credentials aws.CredentialsProvider
+}
+
+func (a *awsSecretManager) getSecret(ctx context.Context, name string) (string, error) {
+	a.lock.Lock()
+	if a.credentials == nil {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(a.region))
+		if err != nil {
+			a.lock.Unlock()
+			return "", fmt.Errorf("failed to load AWS configuration: %w", err)
+		}
+		a.credentials = cfg.Credentials
+	}
+	credentials := a.credentials
+	a.lock.Unlock()
+
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": name})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	payloadHash := sha256.Sum256(body)
+	if err := v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), "secretsmanager", a.region, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to sign AWS request: %w", err)
+	}
+
+	var resp struct {
+		SecretString string `json:"SecretString"`
+		SecretBinary []byte `json:"SecretBinary"`
+	}
+	if err := doSecretRequest(a.client, req, &resp); err != nil {
+		return "", err
+	}
+
+	if resp.SecretString != "" {
+		return resp.SecretString, nil
+	}
+	return string(resp.SecretBinary), nil
+}
+
+// gcpSecretManager reads secrets from Google Cloud Secret Manager with the application default credentials of the
+// Obot server. The name of a secret is its resource name, such as projects/my-project/secrets/my-secret, which reads
+// its latest version unless a version is part of the name.
+type gcpSecretManager struct {
+	client   *http.Client
+	endpoint string
+
+	lock        sync.Mutex
+	tokenSource oauth2.TokenSource
+}
+
+func (g *gcpSecretManager) getSecret(ctx context.Context, name string) (string, error) {
+	g.lock.Lock()
+	if g.tokenSource == nil {
+		// The token source outlives the request, so it can't use the request's context.
+		ts, err := google.DefaultTokenSource(context.Background(), "https://www.googleapis.com/auth/cloud-platform")
+		if err != nil {
+			g.lock.Unlock()
+			return "", fmt.Errorf("failed to load Google Cloud credentials: %w", err)
+		}
+		g.tokenSource = ts
+	}
+	tokenSource := g.tokenSource
+	g.lock.Unlock()
+
+	token, err := tokenSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to get Google Cloud token: %w", err)
+	}
+
+	name = strings.Trim(name, "/")
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+
+	u, err := url.JoinPath(g.endpoint, "v1", name+":access")
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	token.SetAuthHeader(req)
+
+	var resp struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := doSecretRequest(g.client, req, &resp); err != nil {
+		return "", err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(resp.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret: %w", err)
+	}
+	return string(data), nil
+}
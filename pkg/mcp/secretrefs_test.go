@@ -0,0 +1,106 @@
+package mcp
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestParseSecretRef(t *testing.T) {
+	manager, name, key, err := ParseSecretRef("vault:secret/data/app#token")
+	require.NoError(t, err)
+	assert.Equal(t, "vault", manager)
+	assert.Equal(t, "secret/data/app", name)
+	assert.Equal(t, "token", key)
+
+	manager, name, key, err = ParseSecretRef("aws:arn:aws:secretsmanager:us-east-1:123456789012:secret:app")
+	require.NoError(t, err)
+	assert.Equal(t, "aws", manager)
+	assert.Equal(t, "arn:aws:secretsmanager:us-east-1:123456789012:secret:app", name)
+	assert.Empty(t, key)
+
+	for _, ref := range []string{"", "vault", "vault:", "vault:#token", "azure:app"} {
+		_, _, _, err = ParseSecretRef(ref)
+		assert.Error(t, err, ref)
+	}
+}
+
+func TestSecretRefResolverVault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/app":
+			// A KV version 2 secret.
+			_, _ = w.Write([]byte(`{"data":{"data":{"token":"s3cret","port":5432},"metadata":{"version":3}}}`))
+		case "/v1/kv/app":
+			// A KV version 1 secret.
+			_, _ = w.Write([]byte(`{"data":{"token":"v1-secret"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := newSecretRefResolver(Options{
+		MCPSecretManagerVaultAddress: server.URL + "/",
+		MCPSecretManagerVaultToken:   "vault-token",
+	})
+
+	resolved, err := r.resolve(t.Context(), ServerConfig{
+		Env: []string{"EXISTING=value"},
+		SecretRefs: []SecretRef{
+			{EnvKey: "TOKEN", Ref: "vault:secret/data/app#token", Prefix: "Bearer ", Required: true},
+			{EnvKey: "PORT", Ref: "vault:secret/data/app#port"},
+			{EnvKey: "V1_TOKEN", Ref: "vault:kv/app#token", File: true, Dynamic: true},
+			{EnvKey: "OPTIONAL", Ref: "vault:secret/data/missing#token"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EXISTING=value", "TOKEN=Bearer s3cret", "PORT=5432"}, resolved.Env)
+	assert.Equal(t, []File{{Data: "v1-secret", EnvKey: "V1_TOKEN", Dynamic: true}}, resolved.Files)
+	assert.Empty(t, resolved.SecretRefs)
+
+	_, err = r.resolve(t.Context(), ServerConfig{
+		SecretRefs: []SecretRef{{EnvKey: "TOKEN", Ref: "vault:secret/data/app#missing", Required: true}},
+	})
+	require.ErrorIs(t, err, ErrSecretResolutionFailed)
+
+	_, err = r.resolve(t.Context(), ServerConfig{
+		SecretRefs: []SecretRef{{EnvKey: "TOKEN", Ref: "aws:app", Required: true}},
+	})
+	require.ErrorIs(t, err, ErrSecretResolutionFailed)
+	assert.ErrorContains(t, err, "secret manager aws is not configured")
+}
+
+func TestSecretRefResolverGCP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer gcp-token" || r.URL.Path != "/v1/projects/example/secrets/app/versions/latest:access" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"payload":{"data":"` + base64.StdEncoding.EncodeToString([]byte(`{"token":"gcp-secret"}`)) + `"}}`))
+	}))
+	defer server.Close()
+
+	r := &secretRefResolver{managers: map[string]secretManager{
+		secretManagerGCP: &gcpSecretManager{
+			client:      server.Client(),
+			endpoint:    server.URL,
+			tokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "gcp-token"}),
+		},
+	}}
+
+	resolved, err := r.resolve(t.Context(), ServerConfig{
+		SecretRefs: []SecretRef{{EnvKey: "TOKEN", Ref: "gcp:projects/example/secrets/app#token", Required: true}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TOKEN=gcp-secret"}, resolved.Env)
+}
@@ -0,0 +1,72 @@
+package mcp
+
+import (
+	"context"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// sessionStateTimeout bounds the storage operations for session state that are not tied to a request.
+const sessionStateTimeout = 10 * time.Second
+
+// resumableSession returns whether the session for the server and client options can be persisted and resumed.
+// Sessions with stdio servers end with the process that runs them, and OAuth sessions are owned by their token storage.
+func (sm *SessionManager) resumableSession(server ServerConfig, clientOpts nmcp.ClientOption) bool {
+	return sm.sessionStore != nil && server.Runtime != types.RuntimeStdio && clientOpts.TokenStorage == nil && clientOpts.SessionState == nil
+}
+
+func (sm *SessionManager) storedSessionState(ctx context.Context, clientID string) *nmcp.SessionState {
+	state, err := sm.sessionStore.GetSessionState(ctx, clientID)
+	if err != nil {
+		log.Warnf("failed to load stored MCP session state for client %s: %v", clientID, err)
+		return nil
+	}
+	if state == nil || state.ID == "" {
+		return nil
+	}
+	return state
+}
+
+func (sm *SessionManager) storeSessionState(ctx context.Context, serverName string, client *Client) {
+	state, err := client.Session.State()
+	if err != nil {
+		log.Warnf("failed to get MCP session state for client %s: %v", client.ID, err)
+		return
+	}
+	if state == nil || state.ID == "" {
+		// Servers that don't issue session IDs have nothing to resume.
+		return
+	}
+
+	if err = sm.sessionStore.StoreSessionState(ctx, serverName, client.ID, state); err != nil {
+		log.Warnf("failed to store MCP session state for client %s: %v", client.ID, err)
+	}
+}
+
+func (sm *SessionManager) deleteSessionState(clientID string) {
+	if sm.sessionStore == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sessionStateTimeout)
+	defer cancel()
+
+	if err := sm.sessionStore.DeleteSessionState(ctx, clientID); err != nil {
+		log.Warnf("failed to delete stored MCP session state for client %s: %v", clientID, err)
+	}
+}
+
+func (sm *SessionManager) deleteSessionStates(serverName string) {
+	if sm.sessionStore == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sessionStateTimeout)
+	defer cancel()
+
+	if err := sm.sessionStore.DeleteSessionStates(ctx, serverName); err != nil {
+		log.Warnf("failed to delete stored MCP session states for server %s: %v", serverName, err)
+	}
+}
@@ -0,0 +1,68 @@
+package mcp
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+)
+
+const stdioBackendName = "stdio"
+
+// ErrStdioRuntimeDisabled is returned when a stdio MCP server is used but the stdio runtime has not been enabled by an admin.
+var ErrStdioRuntimeDisabled = errors.New("the stdio runtime is disabled, an administrator must enable it to run stdio MCP servers")
+
+// stdioServer builds the nanobot server configuration used to spawn a stdio MCP server as a subprocess.
+// The session manager speaks stdio MCP to the subprocess directly, so there is no container or nanobot shim involved.
+// The subprocess gets a minimal environment: none of Obot's own environment variables are passed to it besides PATH and
+// USER, and its home and default working directory are a directory of its own.
+func stdioServer(server ServerConfig) (nmcp.Server, error) {
+	dir := stdioFilesDir(server.MCPServerName)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nmcp.Server{}, fmt.Errorf("failed to create directory for stdio server: %w", err)
+	}
+
+	env := make(map[string]string, len(server.Env)+len(server.Files)+1)
+	env["HOME"] = dir
+	for _, e := range server.Env {
+		if k, v, ok := strings.Cut(e, "="); ok {
+			env[k] = v
+		}
+	}
+
+	for _, f := range server.Files {
+		path := filepath.Join(dir, f.EnvKey)
+		if err := os.WriteFile(path, []byte(f.Data), 0o600); err != nil {
+			return nmcp.Server{}, fmt.Errorf("failed to write file for %s: %w", f.EnvKey, err)
+		}
+		env[f.EnvKey] = path
+	}
+
+	cwd := server.Cwd
+	if cwd == "" {
+		cwd = dir
+	}
+
+	return nmcp.Server{
+		Command: server.Command,
+		Args:    server.Args,
+		Env:     env,
+		Cwd:     cwd,
+	}, nil
+}
+
+// stdioFilesDir returns the directory of a stdio server, where its file-based environment variables are written.
+func stdioFilesDir(serverName string) string {
+	return filepath.Join(os.TempDir(), "obot-stdio-mcp", serverName)
+}
+
+// removeStdioFiles removes any files written for a stdio server.
+func removeStdioFiles(serverName string) error {
+	if err := os.RemoveAll(stdioFilesDir(serverName)); err != nil {
+		return fmt.Errorf("failed to remove files for stdio server %s: %w", serverName, err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package mcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdioServer(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	server, err := stdioServer(ServerConfig{
+		MCPServerName: "ms1-stdio",
+		Command:       "my-mcp-server",
+		Args:          []string{"--verbose"},
+		Env:           []string{"API_KEY=secret"},
+		Files:         []File{{EnvKey: "CONFIG", Data: "config"}},
+	})
+	require.NoError(t, err)
+
+	dir := stdioFilesDir("ms1-stdio")
+	assert.Equal(t, map[string]string{
+		"HOME":    dir,
+		"API_KEY": "secret",
+		"CONFIG":  filepath.Join(dir, "CONFIG"),
+	}, server.Env)
+	assert.Equal(t, dir, server.Cwd)
+
+	data, err := os.ReadFile(filepath.Join(dir, "CONFIG"))
+	require.NoError(t, err)
+	assert.Equal(t, "config", string(data))
+
+	server, err = stdioServer(ServerConfig{MCPServerName: "ms1-stdio", Command: "my-mcp-server", Cwd: "/srv/mcp"})
+	require.NoError(t, err)
+	assert.Equal(t, "/srv/mcp", server.Cwd)
+}
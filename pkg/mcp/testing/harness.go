@@ -0,0 +1,104 @@
+// Package mcptesting provides a harness for integration tests of MCP flows. It runs a session manager on in-memory
+// storage and a fake backend, and serves mock MCP servers over HTTP, so handlers and controllers can be tested without
+// a live cluster.
+package mcptesting
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/storage"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"k8s.io/client-go/tools/cache"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// Harness holds the pieces of Obot that MCP flows depend on.
+type Harness struct {
+	// Storage is an in-memory storage client, seeded with the objects passed to New.
+	Storage storage.Client
+	// SessionManager runs on the fake backend, which connects to servers at their URLs without deploying anything.
+	SessionManager *mcp.SessionManager
+	// TokenService signs the tokens the session manager sends to MCP servers.
+	TokenService *TokenService
+}
+
+// New returns a harness whose storage is seeded with objs. The session manager is closed when the test finishes.
+func New(t testing.TB, objs ...kclient.Object) *Harness {
+	t.Helper()
+
+	storageClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...).Build()
+	tokenService := NewTokenService()
+
+	sessionManager, err := mcp.NewSessionManager(
+		context.Background(),
+		tokenService,
+		"http://localhost:8080",
+		8080,
+		mcp.Options{MCPRuntimeBackend: "fake"},
+		// The harness doesn't run webhooks or tool policies, so their indexers are always empty.
+		mcp.NewWebhookHelper(newWebhookIndexer(), "http://localhost:8080"),
+		mcp.NewToolPolicyHelper(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		nil,
+		storageClient,
+		nil,
+		"",
+	)
+	if err != nil {
+		t.Fatalf("failed to create MCP session manager: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = sessionManager.Close()
+	})
+
+	return &Harness{
+		Storage:        storageClient,
+		SessionManager: sessionManager,
+		TokenService:   tokenService,
+	}
+}
+
+// newWebhookIndexer returns an indexer with the indexes the webhook helper looks up.
+func newWebhookIndexer() cache.Indexer {
+	none := func(any) ([]string, error) {
+		return nil, nil
+	}
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{
+		"server-names":        none,
+		"catalog-entry-names": none,
+		"catalog-names":       none,
+		"selectors":           none,
+	})
+}
+
+// TokenService signs tokens with a random key that is generated when it is created.
+type TokenService struct {
+	key []byte
+}
+
+func NewTokenService() *TokenService {
+	return &TokenService{key: []byte(rand.Text())}
+}
+
+func (t *TokenService) NewTokenWithClaims(_ context.Context, claims jwt.MapClaims) (*jwt.Token, string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(t.key)
+	if err != nil {
+		return nil, "", err
+	}
+	return token, signed, nil
+}
+
+// ParseToken validates a token signed by the service and returns its claims.
+func (t *TokenService) ParseToken(token string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return t.key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithLeeway(time.Minute))
+	return claims, err
+}
@@ -0,0 +1,67 @@
+package mcptesting
+
+import (
+	"context"
+	"testing"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoParams struct {
+	Message string `json:"message"`
+}
+
+func TestHarnessListsAndCallsTools(t *testing.T) {
+	h := New(t)
+	server := NewMockServer(t, nmcp.NewServerTool("echo", "Echo a message", func(_ context.Context, in echoParams) (string, error) {
+		return in.Message, nil
+	}))
+	config := server.ServerConfig("echo-server")
+
+	tools, err := h.SessionManager.ListTools(t.Context(), config)
+	require.NoError(t, err)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "echo", tools[0].Name)
+
+	_, err = h.SessionManager.PingServer(t.Context(), config)
+	require.NoError(t, err)
+
+	assert.Contains(t, server.Methods(), "initialize")
+	assert.Contains(t, server.Methods(), "tools/list")
+	assert.Contains(t, server.Methods(), "ping")
+
+	claims, err := h.TokenService.ParseToken(server.Authorization())
+	require.NoError(t, err)
+	assert.Equal(t, "echo-server", claims["MCPID"])
+}
+
+func TestServerHealth(t *testing.T) {
+	h := New(t)
+	server := NewMockServer(t)
+
+	assert.Equal(t, types.MCPServerHealthReady, h.SessionManager.ServerHealth(t.Context(), server.ServerConfig("healthy-server")))
+
+	unreachable := server.ServerConfig("unreachable-server")
+	unreachable.URL = "http://127.0.0.1:1/mcp"
+	assert.Equal(t, types.MCPServerHealthUnhealthy, h.SessionManager.ServerHealth(t.Context(), unreachable))
+
+	stdio := server.ServerConfig("stdio-server")
+	stdio.Runtime = types.RuntimeStdio
+	assert.Equal(t, types.MCPServerHealthNotDeployed, h.SessionManager.ServerHealth(t.Context(), stdio), "stdio servers without clients are not running")
+}
+
+func TestProbeServer(t *testing.T) {
+	h := New(t)
+	server := NewMockServer(t)
+
+	_, err := h.SessionManager.ProbeServer(t.Context(), "probed-server")
+	assert.Error(t, err, "servers that were never deployed are not ready")
+
+	_, err = h.SessionManager.PingServer(t.Context(), server.ServerConfig("probed-server"))
+	require.NoError(t, err)
+	_, err = h.SessionManager.ProbeServer(t.Context(), "probed-server")
+	assert.NoError(t, err)
+}
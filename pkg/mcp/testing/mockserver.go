@@ -0,0 +1,128 @@
+package mcptesting
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+)
+
+// MockServer is an MCP server that speaks the streamable HTTP transport and serves the tools it was created with.
+type MockServer struct {
+	// URL is the URL of the server's MCP endpoint.
+	URL string
+
+	tools nmcp.ServerTools
+
+	lock          sync.Mutex
+	methods       []string
+	authorization string
+}
+
+// NewMockServer starts a mock MCP server that is stopped when the test finishes. Use [nmcp.NewServerTool] to create its
+// tools.
+func NewMockServer(t testing.TB, tools ...nmcp.ServerTool) *MockServer {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &MockServer{
+		tools: nmcp.NewServerTools(tools...),
+	}
+
+	handler, err := nmcp.NewHTTPServer(ctx, nil, m, nmcp.HTTPServerOptions{BaseContext: ctx})
+	if err != nil {
+		cancel()
+		t.Fatalf("failed to create mock MCP server: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		m.lock.Lock()
+		m.authorization = strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		m.lock.Unlock()
+
+		handler.ServeHTTP(rw, req)
+	}))
+	t.Cleanup(func() {
+		// Clients may still hold event streams open, which would keep Close from returning.
+		cancel()
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	m.URL = server.URL + "/mcp"
+	return m
+}
+
+// ServerConfig returns the configuration of a remote MCP server with the given name that points at the mock server.
+func (m *MockServer) ServerConfig(name string) mcp.ServerConfig {
+	return mcp.ServerConfig{
+		Runtime:              types.RuntimeRemote,
+		URL:                  m.URL,
+		MCPServerName:        name,
+		MCPServerDisplayName: name,
+		UserID:               "user",
+		StartupTimeout:       10 * time.Second,
+	}
+}
+
+// Methods returns the methods of the JSON-RPC messages the mock server has received so far, in order.
+func (m *MockServer) Methods() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return append([]string(nil), m.methods...)
+}
+
+// Authorization returns the bearer token of the last HTTP request the mock server received.
+func (m *MockServer) Authorization() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.authorization
+}
+
+func (m *MockServer) OnMessage(ctx context.Context, msg nmcp.Message) {
+	m.lock.Lock()
+	m.methods = append(m.methods, msg.Method)
+	m.lock.Unlock()
+
+	switch msg.Method {
+	case "initialize":
+		nmcp.Invoke(ctx, msg, m.initialize)
+	case "notifications/initialized":
+	case "notifications/cancelled":
+		nmcp.HandleCancelled(ctx, msg)
+	case "ping":
+		nmcp.Invoke(ctx, msg, m.ping)
+	case "tools/list":
+		nmcp.Invoke(ctx, msg, m.tools.List)
+	case "tools/call":
+		nmcp.Invoke(ctx, msg, m.tools.Call)
+	default:
+		msg.SendError(ctx, nmcp.ErrRPCMethodNotFound.WithMessage("%v", msg.Method))
+	}
+}
+
+func (m *MockServer) initialize(_ context.Context, _ nmcp.Message, params nmcp.InitializeRequest) (*nmcp.InitializeResult, error) {
+	return &nmcp.InitializeResult{
+		ProtocolVersion: params.ProtocolVersion,
+		Capabilities: nmcp.ServerCapabilities{
+			Tools: &nmcp.ToolsServerCapability{},
+		},
+		ServerInfo: nmcp.ServerInfo{
+			Name:    "mock",
+			Version: "v0.0.0",
+		},
+	}, nil
+}
+
+func (m *MockServer) ping(context.Context, nmcp.Message, struct{}) (*nmcp.PingResult, error) {
+	return &nmcp.PingResult{}, nil
+}
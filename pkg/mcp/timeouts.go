@@ -0,0 +1,56 @@
+package mcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// requestTimeouts are the server-wide timeouts of the requests Obot makes to MCP servers. A zero timeout means the
+// requests are only bound by their context.
+type requestTimeouts struct {
+	toolsList     time.Duration
+	toolsCall     time.Duration
+	resourcesRead time.Duration
+}
+
+func newRequestTimeouts(opts Options) requestTimeouts {
+	return requestTimeouts{
+		toolsList:     time.Duration(opts.MCPToolsListTimeoutSeconds) * time.Second,
+		toolsCall:     time.Duration(opts.MCPToolsCallTimeoutSeconds) * time.Second,
+		resourcesRead: time.Duration(opts.MCPResourcesReadTimeoutSeconds) * time.Second,
+	}
+}
+
+// forServer returns the timeouts with the server's overrides applied.
+func (t requestTimeouts) forServer(overrides *types.MCPRequestTimeouts) requestTimeouts {
+	if overrides == nil {
+		return t
+	}
+
+	return requestTimeouts{
+		toolsList:     requestTimeout(t.toolsList, overrides.ToolsListSeconds),
+		toolsCall:     requestTimeout(t.toolsCall, overrides.ToolsCallSeconds),
+		resourcesRead: requestTimeout(t.resourcesRead, overrides.ResourcesReadSeconds),
+	}
+}
+
+func requestTimeout(def time.Duration, seconds int) time.Duration {
+	switch {
+	case seconds > 0:
+		return time.Duration(seconds) * time.Second
+	case seconds < 0:
+		return 0
+	default:
+		return def
+	}
+}
+
+// withTimeout returns a context that is canceled after the timeout, or the context itself if there is no timeout.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
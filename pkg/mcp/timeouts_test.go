@@ -0,0 +1,42 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestTimeoutsForServer(t *testing.T) {
+	defaults := newRequestTimeouts(Options{
+		MCPToolsListTimeoutSeconds:     60,
+		MCPToolsCallTimeoutSeconds:     0,
+		MCPResourcesReadTimeoutSeconds: 30,
+	})
+
+	assert.Equal(t, defaults, defaults.forServer(nil), "servers without overrides should use the defaults")
+
+	timeouts := defaults.forServer(&types.MCPRequestTimeouts{
+		ToolsListSeconds:     -1,
+		ToolsCallSeconds:     120,
+		ResourcesReadSeconds: 0,
+	})
+	assert.Zero(t, timeouts.toolsList, "-1 should disable the timeout")
+	assert.Equal(t, 2*time.Minute, timeouts.toolsCall)
+	assert.Equal(t, 30*time.Second, timeouts.resourcesRead, "0 should use the default")
+}
+
+func TestWithTimeout(t *testing.T) {
+	ctx, cancel := withTimeout(context.Background(), 0)
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok, "a zero timeout should not set a deadline")
+
+	ctx, cancel = withTimeout(context.Background(), time.Minute)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
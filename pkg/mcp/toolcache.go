@@ -0,0 +1,192 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+)
+
+type toolCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// toolCache is a read-through cache of tools/list results and of the results of tool calls that the server annotates
+// as read-only or idempotent. Entries are stored per MCP server so that they can be dropped together when the server
+// reports that its tools changed or when its clients are closed. A nil cache or a zero TTL disables caching.
+type toolCache struct {
+	ttl     time.Duration
+	lock    sync.Mutex
+	entries map[string]map[string]toolCacheEntry
+}
+
+func newToolCache(ttl time.Duration) *toolCache {
+	return &toolCache{
+		ttl:     ttl,
+		entries: make(map[string]map[string]toolCacheEntry),
+	}
+}
+
+func (c *toolCache) enabled() bool {
+	return c != nil && c.ttl > 0
+}
+
+// toolCacheKey returns the cache key for a request on the client. The client ID identifies the server config and the
+// caller's passthrough headers, and the user ID is included so that results are never shared between users.
+func toolCacheKey(client *Client, method string, params any) string {
+	return hash.Digest(map[string]any{
+		"client": client.ID,
+		"user":   client.Config.UserID,
+		"method": method,
+		"params": params,
+	})
+}
+
+func (c *toolCache) get(serverName, key string) ([]byte, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[serverName][key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries[serverName], key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *toolCache) set(serverName, key string, value []byte) {
+	if !c.enabled() {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	entries := c.entries[serverName]
+	if entries == nil {
+		entries = make(map[string]toolCacheEntry)
+		c.entries[serverName] = entries
+	}
+	for k, entry := range entries {
+		if now.After(entry.expires) {
+			delete(entries, k)
+		}
+	}
+	entries[key] = toolCacheEntry{
+		value:   value,
+		expires: now.Add(c.ttl),
+	}
+}
+
+// invalidate drops all cached results for the server.
+func (c *toolCache) invalidate(serverName string) {
+	if !c.enabled() {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, serverName)
+}
+
+// listTools returns the tools of the client's server, from the cache if possible.
+// The tools are not filtered by the tool policy, so that policy changes apply to cached lists right away.
+func (sm *SessionManager) listTools(ctx context.Context, client *Client) ([]nmcp.Tool, error) {
+	key := toolCacheKey(client, "tools/list", nil)
+	if cached, ok := sm.toolCache.get(client.Config.MCPServerName, key); ok {
+		var tools []nmcp.Tool
+		if err := json.Unmarshal(cached, &tools); err == nil {
+			return tools, nil
+		}
+	}
+
+	ctx, cancel := withTimeout(ctx, sm.requestTimeouts.forServer(client.Config.RequestTimeouts).toolsList)
+	defer cancel()
+
+	tools, ok := client.prefetched.getTools(ctx)
+	if !ok {
+		resp, err := client.ListTools(ctx)
+		sm.circuitBreaker.record(client.Config.MCPServerName, err)
+		if err != nil {
+			return nil, err
+		}
+		tools = resp.Tools
+	}
+
+	if sm.toolCache.enabled() {
+		if data, err := json.Marshal(tools); err == nil {
+			sm.toolCache.set(client.Config.MCPServerName, key, data)
+		}
+	}
+
+	return tools, nil
+}
+
+// toolCallCacheable reports whether the server annotates the tool as read-only or idempotent, in which case repeated
+// calls with the same arguments can be answered from the cache.
+func (sm *SessionManager) toolCallCacheable(ctx context.Context, client *Client, toolName string) bool {
+	if !sm.toolCache.enabled() {
+		return false
+	}
+
+	tools, err := sm.listTools(ctx, client)
+	if err != nil {
+		log.Debugf("failed to list tools to check whether tool %s can be cached: %v", toolName, err)
+		return false
+	}
+
+	for _, tool := range tools {
+		if tool.Name == toolName {
+			return tool.Annotations != nil && (tool.Annotations.ReadOnlyHint || tool.Annotations.IdempotentHint)
+		}
+	}
+	return false
+}
+
+// callTool calls the tool and returns the JSON encoded result, answering from the cache if the tool's result can be cached.
+// Results that are errors are never cached. The meta is sent as the _meta of the call, and doesn't affect caching.
+func (sm *SessionManager) callTool(ctx context.Context, client *Client, toolName string, arguments, meta map[string]any) ([]byte, *nmcp.CallToolResult, error) {
+	cacheable := sm.toolCallCacheable(ctx, client, toolName)
+
+	var key string
+	if cacheable {
+		key = toolCacheKey(client, "tools/call", map[string]any{"name": toolName, "arguments": arguments})
+		if cached, ok := sm.toolCache.get(client.Config.MCPServerName, key); ok {
+			return cached, nil, nil
+		}
+	}
+
+	callCtx, cancel := withTimeout(ctx, sm.requestTimeouts.forServer(client.Config.RequestTimeouts).toolsCall)
+	defer cancel()
+
+	result, err := client.Call(callCtx, toolName, arguments, nmcp.CallOption{Meta: meta})
+	sm.circuitBreaker.record(client.Config.MCPServerName, err)
+	if err != nil {
+		return nil, result, err
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, result, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	if cacheable && !result.IsError {
+		sm.toolCache.set(client.Config.MCPServerName, key, data)
+	}
+
+	return data, result, nil
+}
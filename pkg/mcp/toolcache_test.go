@@ -0,0 +1,59 @@
+package mcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolCache(t *testing.T) {
+	c := newToolCache(time.Minute)
+
+	c.set("ms1a", "key", []byte(`{"a":1}`))
+	c.set("ms1b", "key", []byte(`{"b":1}`))
+
+	value, ok := c.get("ms1a", "key")
+	assert.True(t, ok)
+	assert.Equal(t, `{"a":1}`, string(value))
+
+	_, ok = c.get("ms1a", "other")
+	assert.False(t, ok, "unknown keys should miss")
+
+	c.invalidate("ms1a")
+	_, ok = c.get("ms1a", "key")
+	assert.False(t, ok, "invalidated entries should miss")
+
+	_, ok = c.get("ms1b", "key")
+	assert.True(t, ok, "other servers should not be invalidated")
+}
+
+func TestToolCacheExpiry(t *testing.T) {
+	c := newToolCache(time.Minute)
+	c.entries["ms1a"] = map[string]toolCacheEntry{
+		"key": {value: []byte(`{}`), expires: time.Now().Add(-time.Second)},
+	}
+
+	_, ok := c.get("ms1a", "key")
+	assert.False(t, ok, "expired entries should miss")
+	assert.Empty(t, c.entries["ms1a"], "expired entries should be removed")
+}
+
+func TestToolCacheDisabled(t *testing.T) {
+	for _, c := range []*toolCache{nil, newToolCache(0)} {
+		c.set("ms1a", "key", []byte(`{}`))
+		_, ok := c.get("ms1a", "key")
+		assert.False(t, ok)
+		c.invalidate("ms1a")
+	}
+}
+
+func TestToolCacheKey(t *testing.T) {
+	client := &Client{ID: "client", Config: ServerConfig{UserID: "user1"}}
+	other := &Client{ID: "client", Config: ServerConfig{UserID: "user2"}}
+
+	args := map[string]any{"name": "search", "arguments": map[string]any{"q": "obot"}}
+	assert.Equal(t, toolCacheKey(client, "tools/call", args), toolCacheKey(client, "tools/call", args))
+	assert.NotEqual(t, toolCacheKey(client, "tools/call", args), toolCacheKey(other, "tools/call", args), "keys should differ between users")
+	assert.NotEqual(t, toolCacheKey(client, "tools/call", args), toolCacheKey(client, "tools/list", nil))
+}
@@ -0,0 +1,135 @@
+package mcp
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/obot-platform/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	// ErrToolDenied is returned when a tool call is blocked by the tool policy of the server's catalog entry.
+	ErrToolDenied = errors.New("tool is not allowed by the catalog tool policy")
+	// ErrToolNotAllowlisted is returned when a tool call is blocked by the tool allowlist of the user's server instance.
+	ErrToolNotAllowlisted = errors.New("tool is not in the tool allowlist of the MCP server instance")
+	// ErrToolNotAllowedForAPIKey is returned when a tool call is blocked because the API key is restricted to other tools.
+	ErrToolNotAllowedForAPIKey = errors.New("tool is not allowed for the API key")
+)
+
+// ToolPolicyHelper looks up the tool policy for an MCP server from its catalog entry.
+// Policies are read from the informer cache so that changes take effect without redeploying servers.
+type ToolPolicyHelper struct {
+	indexer cache.Indexer
+}
+
+func NewToolPolicyHelper(indexer cache.Indexer) *ToolPolicyHelper {
+	return &ToolPolicyHelper{
+		indexer: indexer,
+	}
+}
+
+// PolicyForServer returns the tool policy for the given server, or nil if there isn't one.
+func (th *ToolPolicyHelper) PolicyForServer(serverConfig ServerConfig) (*types.MCPToolPolicy, error) {
+	if th == nil || serverConfig.MCPCatalogEntryName == "" {
+		return nil, nil
+	}
+
+	obj, exists, err := th.indexer.GetByKey(system.DefaultNamespace + "/" + serverConfig.MCPCatalogEntryName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog entry %s: %w", serverConfig.MCPCatalogEntryName, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	entry, ok := obj.(*v1.MCPServerCatalogEntry)
+	if !ok {
+		return nil, nil
+	}
+
+	return entry.Spec.Manifest.ToolPolicy, nil
+}
+
+// ToolAllowed returns true if the tool name is allowed by the policy. A nil policy allows all tools.
+func ToolAllowed(policy *types.MCPToolPolicy, toolName string) bool {
+	if policy == nil {
+		return true
+	}
+
+	if slices.ContainsFunc(policy.Deny, func(pattern string) bool {
+		return matchToolPattern(pattern, toolName)
+	}) {
+		return false
+	}
+
+	return len(policy.Allow) == 0 || slices.ContainsFunc(policy.Allow, func(pattern string) bool {
+		return matchToolPattern(pattern, toolName)
+	})
+}
+
+// ToolAllowlisted returns true if the tool name matches one of the patterns of an instance's tool allowlist.
+// An empty allowlist allows all tools.
+func ToolAllowlisted(allowlist []string, toolName string) bool {
+	return len(allowlist) == 0 || slices.ContainsFunc(allowlist, func(pattern string) bool {
+		return matchToolPattern(pattern, toolName)
+	})
+}
+
+// ToolAllowedForServer returns true if the tool name is allowed by both the policy and the tool allowlist of the server.
+// The allowlist can only narrow the tools that the policy allows.
+func ToolAllowedForServer(policy *types.MCPToolPolicy, serverConfig ServerConfig, toolName string) bool {
+	return ToolAllowed(policy, toolName) && ToolAllowlisted(serverConfig.ToolAllowlist, toolName)
+}
+
+// FilterTools removes the tools that are not allowed by the policy or the allowlist.
+func FilterTools(policy *types.MCPToolPolicy, allowlist []string, tools []mcp.Tool) []mcp.Tool {
+	if policy == nil && len(allowlist) == 0 {
+		return tools
+	}
+
+	return slices.DeleteFunc(tools, func(t mcp.Tool) bool {
+		return !ToolAllowed(policy, t.Name) || !ToolAllowlisted(allowlist, t.Name)
+	})
+}
+
+// ValidateToolPattern returns an error if the pattern is not a valid tool name pattern.
+func ValidateToolPattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("tool pattern cannot be empty")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid tool pattern %q: %w", pattern, err)
+	}
+	return nil
+}
+
+func matchToolPattern(pattern, toolName string) bool {
+	matched, err := path.Match(pattern, toolName)
+	return err == nil && matched
+}
+
+// OAuthScopesForServer returns the OAuth scopes of the scope groups that have a tool allowed by both the policy and the
+// tool allowlist of the server, and of the groups without tools. It returns nil if there are no scope groups, in which
+// case the scopes that the server requests are used.
+func OAuthScopesForServer(groups []types.MCPOAuthScopeGroup, policy *types.MCPToolPolicy, serverConfig ServerConfig) []string {
+	var scopes []string
+	for _, group := range groups {
+		if len(group.Tools) > 0 && !slices.ContainsFunc(group.Tools, func(tool string) bool {
+			return ToolAllowedForServer(policy, serverConfig, tool)
+		}) {
+			continue
+		}
+		for _, scope := range group.Scopes {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,150 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *types.MCPToolPolicy
+		toolName string
+		expected bool
+	}{
+		{
+			name:     "nil policy allows everything",
+			toolName: "delete_repo",
+			expected: true,
+		},
+		{
+			name:     "deny wildcard",
+			policy:   &types.MCPToolPolicy{Deny: []string{"delete_*"}},
+			toolName: "delete_repo",
+			expected: false,
+		},
+		{
+			name:     "deny wildcard does not match other tools",
+			policy:   &types.MCPToolPolicy{Deny: []string{"delete_*"}},
+			toolName: "list_repos",
+			expected: true,
+		},
+		{
+			name:     "allow list excludes unmatched tools",
+			policy:   &types.MCPToolPolicy{Allow: []string{"list_*", "get_issue"}},
+			toolName: "create_issue",
+			expected: false,
+		},
+		{
+			name:     "allow list includes matched tools",
+			policy:   &types.MCPToolPolicy{Allow: []string{"list_*", "get_issue"}},
+			toolName: "get_issue",
+			expected: true,
+		},
+		{
+			name:     "deny takes precedence over allow",
+			policy:   &types.MCPToolPolicy{Allow: []string{"*"}, Deny: []string{"delete_repo"}},
+			toolName: "delete_repo",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToolAllowed(tt.policy, tt.toolName))
+		})
+	}
+}
+
+func TestToolAllowedForServer(t *testing.T) {
+	policy := &types.MCPToolPolicy{Deny: []string{"delete_*"}}
+
+	tests := []struct {
+		name      string
+		allowlist []string
+		toolName  string
+		expected  bool
+	}{
+		{
+			name:     "empty allowlist allows what the policy allows",
+			toolName: "list_repos",
+			expected: true,
+		},
+		{
+			name:      "allowlist excludes unmatched tools",
+			allowlist: []string{"get_*"},
+			toolName:  "list_repos",
+			expected:  false,
+		},
+		{
+			name:      "allowlist includes matched tools",
+			allowlist: []string{"get_*", "list_repos"},
+			toolName:  "list_repos",
+			expected:  true,
+		},
+		{
+			name:      "allowlist cannot allow tools denied by the policy",
+			allowlist: []string{"*"},
+			toolName:  "delete_repo",
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToolAllowedForServer(policy, ServerConfig{ToolAllowlist: tt.allowlist}, tt.toolName))
+		})
+	}
+}
+
+func TestOAuthScopesForServer(t *testing.T) {
+	groups := []types.MCPOAuthScopeGroup{
+		{Name: "profile", Scopes: []string{"read:user"}},
+		{Name: "read", Tools: []string{"list_repos", "get_issue"}, Scopes: []string{"repo:read"}},
+		{Name: "write", Tools: []string{"create_issue", "delete_repo"}, Scopes: []string{"repo:read", "repo:write"}},
+	}
+
+	tests := []struct {
+		name      string
+		groups    []types.MCPOAuthScopeGroup
+		policy    *types.MCPToolPolicy
+		allowlist []string
+		expected  []string
+	}{
+		{
+			name:     "no groups",
+			expected: nil,
+		},
+		{
+			name:     "all tools allowed",
+			groups:   groups,
+			expected: []string{"read:user", "repo:read", "repo:write"},
+		},
+		{
+			name:     "policy denies the tools of a group",
+			groups:   groups,
+			policy:   &types.MCPToolPolicy{Deny: []string{"create_*", "delete_*"}},
+			expected: []string{"read:user", "repo:read"},
+		},
+		{
+			name:      "allowlist keeps one tool of a group",
+			groups:    groups,
+			allowlist: []string{"create_issue"},
+			expected:  []string{"read:user", "repo:read", "repo:write"},
+		},
+		{
+			name:      "groups without tools are always included",
+			groups:    groups,
+			allowlist: []string{"unknown"},
+			expected:  []string{"read:user"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, OAuthScopesForServer(tt.groups, tt.policy, ServerConfig{ToolAllowlist: tt.allowlist}))
+		})
+	}
+}
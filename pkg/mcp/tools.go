@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
-	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/obot-platform/nanobot/pkg/mcp"
@@ -19,15 +18,17 @@ func (sm *SessionManager) ListTools(ctx context.Context, serverConfig ServerConf
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
-	defer cancel()
-
-	resp, err := client.ListTools(ctx)
+	tools, err := sm.listTools(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list MCP tools: %w", err)
 	}
 
-	return resp.Tools, nil
+	policy, err := sm.toolPolicyHelper.PolicyForServer(serverConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterTools(policy, serverConfig.ToolAllowlist, tools), nil
 }
 
 func ConvertTools(tools []mcp.Tool, allowedTools, unsupportedTools []string) ([]otypes.MCPServerTool, error) {
@@ -104,3 +105,49 @@ func ApplyToolOverrides(tools []otypes.MCPServerTool, toolOverrides []otypes.Too
 
 	return transformedTools
 }
+
+// CompositeToolConflicts returns the names under which more than one enabled component of a composite server exposes
+// a tool, once each component's tool overrides and effective tool prefix are applied. tools maps the ID of each
+// component to its tools. Enabled components that aren't in tools are returned as unchecked.
+func CompositeToolConflicts(config otypes.CompositeRuntimeConfig, tools map[string][]otypes.MCPServerTool) otypes.CompositeToolConflicts {
+	var (
+		result  = otypes.CompositeToolConflicts{Conflicts: []otypes.CompositeToolConflict{}}
+		exposed = make(map[string][]otypes.CompositeToolConflictTool)
+	)
+	for _, component := range config.ComponentServers {
+		if component.Disabled {
+			continue
+		}
+
+		componentID := component.ComponentID()
+		componentTools, ok := tools[componentID]
+		if !ok {
+			result.Unchecked = append(result.Unchecked, componentID)
+			continue
+		}
+
+		prefix := component.EffectiveToolPrefix(config.ToolNamespace)
+		for _, tool := range componentTools {
+			for _, t := range ApplyToolOverrides([]otypes.MCPServerTool{tool}, component.ToolOverrides, prefix) {
+				exposed[t.Name] = append(exposed[t.Name], otypes.CompositeToolConflictTool{
+					ComponentID: componentID,
+					Tool:        tool.Name,
+				})
+			}
+		}
+	}
+
+	for name, conflicting := range exposed {
+		if len(conflicting) > 1 {
+			result.Conflicts = append(result.Conflicts, otypes.CompositeToolConflict{
+				Name:  name,
+				Tools: conflicting,
+			})
+		}
+	}
+	slices.SortFunc(result.Conflicts, func(a, b otypes.CompositeToolConflict) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
@@ -224,3 +224,58 @@ func TestApplyToolOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestCompositeToolConflicts(t *testing.T) {
+	config := types.CompositeRuntimeConfig{
+		ComponentServers: []types.ComponentServer{
+			{CatalogEntryID: "github", Manifest: types.MCPServerManifest{Name: "GitHub"}},
+			{CatalogEntryID: "gitlab", Manifest: types.MCPServerManifest{Name: "GitLab"}},
+			{
+				MCPServerID: "ms1jira",
+				Manifest:    types.MCPServerManifest{Name: "Jira"},
+				ToolOverrides: []types.ToolOverride{
+					{Name: "search", OverrideName: "create-issue", Enabled: true},
+				},
+			},
+			{CatalogEntryID: "linear", Manifest: types.MCPServerManifest{Name: "Linear"}},
+			{CatalogEntryID: "disabled", Disabled: true},
+		},
+	}
+	tools := map[string][]types.MCPServerTool{
+		"github":  {{Name: "create-issue"}, {Name: "search"}},
+		"gitlab":  {{Name: "create-issue"}, {Name: "list-projects"}},
+		"ms1jira": {{Name: "search"}, {Name: "delete-issue"}},
+	}
+
+	result := CompositeToolConflicts(config, tools)
+	assert.Equal(t, []string{"linear"}, result.Unchecked)
+	assert.Equal(t, []types.CompositeToolConflict{
+		{
+			Name: "create-issue",
+			Tools: []types.CompositeToolConflictTool{
+				{ComponentID: "github", Tool: "create-issue"},
+				{ComponentID: "gitlab", Tool: "create-issue"},
+				{ComponentID: "ms1jira", Tool: "search"},
+			},
+		},
+	}, result.Conflicts)
+
+	config.ToolNamespace = types.ToolNamespaceComponent
+	result = CompositeToolConflicts(config, tools)
+	assert.Empty(t, result.Conflicts)
+}
+
+func TestEffectiveToolPrefix(t *testing.T) {
+	component := types.ComponentServer{
+		CatalogEntryID: "default-github-abc12",
+		Manifest:       types.MCPServerManifest{Name: "GitHub (Enterprise)"},
+	}
+	assert.Equal(t, "", component.EffectiveToolPrefix(types.ToolNamespaceNone))
+	assert.Equal(t, "github__enterprise_", component.EffectiveToolPrefix(types.ToolNamespaceComponent))
+
+	component.Manifest.Name = ""
+	assert.Equal(t, "default-github-abc12_", component.EffectiveToolPrefix(types.ToolNamespaceComponent))
+
+	component.ToolPrefix = "gh."
+	assert.Equal(t, "gh.", component.EffectiveToolPrefix(types.ToolNamespaceComponent))
+}
@@ -0,0 +1,230 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/gptscript-ai/gptscript/pkg/types"
+	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
+	otypes "github.com/obot-platform/obot/apiclient/types"
+)
+
+const (
+	// describeToolsName and callToolName are the tools that servers in the lazy schema mode expose instead of their own.
+	describeToolsName = "obot_describe_tools"
+	callToolName      = "obot_call_tool"
+
+	// allowedToolsMetaKey is the metadata of the lazy tools that holds the names of the tools that they may describe
+	// and call.
+	allowedToolsMetaKey = "obot-allowed-tools"
+
+	// maxSummaryLength is the maximum length of a summarized description.
+	maxSummaryLength = 200
+)
+
+// summarizeDescription returns the first sentence or line of a description, truncated to maxSummaryLength.
+func summarizeDescription(description string) string {
+	description = strings.TrimSpace(description)
+	if i := strings.IndexByte(description, '\n'); i >= 0 {
+		description = strings.TrimSpace(description[:i])
+	}
+	if i := strings.Index(description, ". "); i >= 0 {
+		description = description[:i+1]
+	}
+	if runes := []rune(description); len(runes) > maxSummaryLength {
+		description = strings.TrimSpace(string(runes[:maxSummaryLength-3])) + "..."
+	}
+	return description
+}
+
+// summarizeSchema reduces the documentation of an input schema in place. The descriptions of the arguments are
+// summarized, and the documentation of nested arguments is removed. The structure of the schema is kept, so that the
+// model can still call the tool.
+func summarizeSchema(schema *jsonschema.Schema) {
+	if schema == nil {
+		return
+	}
+	schema.Examples = nil
+	for _, property := range schema.Properties {
+		if property == nil {
+			continue
+		}
+		property.Description = summarizeDescription(property.Description)
+		property.Examples = nil
+		for _, child := range childSchemas(property) {
+			stripSchemaDocs(child)
+		}
+	}
+}
+
+// stripSchemaDocs removes the titles, descriptions, and examples of a schema and its children.
+func stripSchemaDocs(schema *jsonschema.Schema) {
+	if schema == nil {
+		return
+	}
+	schema.Title = ""
+	schema.Description = ""
+	schema.Examples = nil
+	for _, child := range childSchemas(schema) {
+		stripSchemaDocs(child)
+	}
+}
+
+func childSchemas(schema *jsonschema.Schema) []*jsonschema.Schema {
+	children := slices.Concat(schema.AllOf, schema.AnyOf, schema.OneOf, schema.PrefixItems, schema.ItemsArray)
+	children = append(children, schema.Items, schema.AdditionalProperties, schema.Not)
+	for _, property := range schema.Properties {
+		children = append(children, property)
+	}
+	for _, def := range schema.Defs {
+		children = append(children, def)
+	}
+	for _, def := range schema.Definitions {
+		children = append(children, def)
+	}
+	return children
+}
+
+// lazyToolDefs returns the tools that a server in the lazy schema mode exposes: one that describes its allowed tools,
+// whose description lists their names and summaries, and one that calls them.
+func lazyToolDefs(mcpServerDisplayName, clientID string, tools []lazyTool, metadata map[string]string) ([]gptscript.ToolDef, error) {
+	names := make([]string, 0, len(tools))
+	var index strings.Builder
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+		index.WriteString("\n- " + tool.Name)
+		if summary := summarizeDescription(tool.Description); summary != "" {
+			index.WriteString(": " + summary)
+		}
+	}
+
+	allowedTools, err := json.Marshal(names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal allowed tools: %w", err)
+	}
+
+	var describeSchema, callSchema jsonschema.Schema
+	if err = json.Unmarshal([]byte(`{"type":"object","properties":{"tools":{"type":"array","items":{"type":"string"},"description":"The names of the tools to describe"}},"required":["tools"]}`), &describeSchema); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal([]byte(`{"type":"object","properties":{"name":{"type":"string","description":"The name of the tool to call"},"arguments":{"type":"object","description":"The arguments of the tool, matching its input schema"}},"required":["name"]}`), &callSchema); err != nil {
+		return nil, err
+	}
+
+	toolDefs := make([]gptscript.ToolDef, 0, 2)
+	for _, def := range []struct {
+		name, description string
+		schema            *jsonschema.Schema
+	}{
+		{
+			name:        describeToolsName,
+			description: fmt.Sprintf("Returns the descriptions and input schemas of tools of %s. Describe a tool before calling it with %s. The tools are:%s", mcpServerDisplayName, callToolName, index.String()),
+			schema:      &describeSchema,
+		},
+		{
+			name:        callToolName,
+			description: fmt.Sprintf("Calls a tool of %s by name. Use %s first to get the input schema of the tool.", mcpServerDisplayName, describeToolsName),
+			schema:      &callSchema,
+		},
+	} {
+		toolMetadata := map[string]string{allowedToolsMetaKey: string(allowedTools)}
+		for k, v := range metadata {
+			toolMetadata[k] = v
+		}
+		toolDefs = append(toolDefs, gptscript.ToolDef{
+			Name:         mcpServerDisplayName + " -> " + def.name,
+			Description:  def.description,
+			Arguments:    def.schema,
+			Instructions: fmt.Sprintf("%s%s %s default", types.MCPInvokePrefix, def.name, clientID),
+			MetaData:     toolMetadata,
+		})
+	}
+
+	return toolDefs, nil
+}
+
+// lazyTool is a tool of a server in the lazy schema mode.
+type lazyTool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema,omitempty"`
+}
+
+// describeTools returns the descriptions and input schemas of the requested tools of a server in the lazy schema mode.
+// Tools that aren't allowed are reported as unknown.
+func (sm *SessionManager) describeTools(ctx context.Context, client *Client, allowedTools string, arguments map[string]any) (string, error) {
+	var allowed []string
+	if err := json.Unmarshal([]byte(allowedTools), &allowed); err != nil {
+		return "", fmt.Errorf("invalid allowed tools: %w", err)
+	}
+
+	requested, _ := arguments["tools"].([]any)
+	if len(requested) == 0 {
+		return "", fmt.Errorf("tools is required")
+	}
+
+	tools, err := sm.listTools(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		described []lazyTool
+		unknown   []string
+	)
+	for _, r := range requested {
+		name, _ := r.(string)
+		i := slices.IndexFunc(tools, func(tool nmcp.Tool) bool { return tool.Name == name })
+		if i < 0 || !slices.Contains(allowed, name) {
+			unknown = append(unknown, name)
+			continue
+		}
+		described = append(described, lazyTool{
+			Name:        tools[i].Name,
+			Description: tools[i].Description,
+			InputSchema: tools[i].InputSchema,
+		})
+	}
+
+	output, err := json.Marshal(map[string]any{
+		"tools":        described,
+		"unknownTools": unknown,
+	})
+	return string(output), err
+}
+
+// lazyToolCall returns the name and arguments of the tool that the call tool of a server in the lazy schema mode was
+// asked to call.
+func lazyToolCall(allowedTools string, arguments map[string]any) (string, map[string]any, error) {
+	var allowed []string
+	if err := json.Unmarshal([]byte(allowedTools), &allowed); err != nil {
+		return "", nil, fmt.Errorf("invalid allowed tools: %w", err)
+	}
+
+	name, _ := arguments["name"].(string)
+	if name == "" {
+		return "", nil, fmt.Errorf("name is required")
+	}
+	if !slices.Contains(allowed, name) {
+		return "", nil, fmt.Errorf("unknown tool %s", name)
+	}
+
+	toolArguments, _ := arguments["arguments"].(map[string]any)
+	if toolArguments == nil {
+		toolArguments = map[string]any{}
+	}
+	return name, toolArguments, nil
+}
+
+// validToolSchemaMode returns the mode if it is known, and the full mode otherwise.
+func validToolSchemaMode(mode otypes.MCPToolSchemaMode) otypes.MCPToolSchemaMode {
+	if mode == "" || !mode.Valid() {
+		return otypes.MCPToolSchemaModeFull
+	}
+	return mode
+}
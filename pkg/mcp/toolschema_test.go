@@ -0,0 +1,84 @@
+package mcp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeDescription(t *testing.T) {
+	assert.Equal(t, "Searches issues.", summarizeDescription("Searches issues. Supports the full query syntax."))
+	assert.Equal(t, "Searches issues", summarizeDescription("  Searches issues\n\nExamples:\n- is:open"))
+	assert.Equal(t, "Version 1.2 of the API.", summarizeDescription("Version 1.2 of the API."))
+
+	long := summarizeDescription(strings.Repeat("a", 300))
+	assert.Len(t, long, maxSummaryLength)
+	assert.True(t, strings.HasSuffix(long, "..."))
+}
+
+func TestSummarizeSchema(t *testing.T) {
+	var schema jsonschema.Schema
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"query": {"type": "string", "description": "The query. See the docs for the syntax.", "examples": ["is:open"]},
+			"filter": {
+				"type": "object",
+				"description": "Filters the results.",
+				"properties": {"label": {"type": "string", "title": "Label", "description": "Only issues with this label"}}
+			}
+		},
+		"required": ["query"]
+	}`), &schema))
+
+	summarizeSchema(&schema)
+
+	assert.Equal(t, "The query.", schema.Properties["query"].Description)
+	assert.Nil(t, schema.Properties["query"].Examples)
+	assert.Equal(t, "Filters the results.", schema.Properties["filter"].Description)
+	label := schema.Properties["filter"].Properties["label"]
+	assert.Equal(t, "string", label.Type)
+	assert.Empty(t, label.Title)
+	assert.Empty(t, label.Description)
+	assert.Equal(t, []string{"query"}, schema.Required)
+}
+
+func TestLazyToolDefs(t *testing.T) {
+	toolDefs, err := lazyToolDefs("GitHub", "client", []lazyTool{
+		{Name: "search_issues", Description: "Searches issues. Supports the full query syntax."},
+		{Name: "create_issue"},
+	}, map[string]string{"obot-user-id": "1"})
+	require.NoError(t, err)
+	require.Len(t, toolDefs, 2)
+
+	assert.Equal(t, "GitHub -> "+describeToolsName, toolDefs[0].Name)
+	assert.Contains(t, toolDefs[0].Description, "\n- search_issues: Searches issues.\n- create_issue")
+	assert.Equal(t, "GitHub -> "+callToolName, toolDefs[1].Name)
+	for _, toolDef := range toolDefs {
+		assert.JSONEq(t, `["search_issues","create_issue"]`, toolDef.MetaData[allowedToolsMetaKey])
+		assert.Equal(t, "1", toolDef.MetaData["obot-user-id"])
+	}
+}
+
+func TestLazyToolCall(t *testing.T) {
+	allowed := `["search_issues"]`
+
+	name, arguments, err := lazyToolCall(allowed, map[string]any{"name": "search_issues", "arguments": map[string]any{"query": "is:open"}})
+	require.NoError(t, err)
+	assert.Equal(t, "search_issues", name)
+	assert.Equal(t, map[string]any{"query": "is:open"}, arguments)
+
+	_, arguments, err = lazyToolCall(allowed, map[string]any{"name": "search_issues"})
+	require.NoError(t, err)
+	assert.Empty(t, arguments)
+
+	_, _, err = lazyToolCall(allowed, map[string]any{"name": "delete_repo"})
+	assert.ErrorContains(t, err, "unknown tool delete_repo")
+
+	_, _, err = lazyToolCall(allowed, map[string]any{})
+	assert.ErrorContains(t, err, "name is required")
+}
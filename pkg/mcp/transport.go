@@ -0,0 +1,84 @@
+package mcp
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// newRemoteTransport returns the transport shared by all HTTP connections Obot makes to MCP servers. Sharing one pool of
+// keep-alive connections, instead of a client per request, keeps large catalogs of servers from exhausting ephemeral ports.
+func newRemoteTransport(opts Options) http.RoundTripper {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: time.Duration(opts.MCPRemoteKeepAliveSeconds) * time.Second,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.MaxIdleConnsPerHost = opts.MCPRemoteMaxIdleConnsPerHost
+	transport.MaxConnsPerHost = opts.MCPRemoteMaxConnsPerHost
+	transport.IdleConnTimeout = time.Duration(opts.MCPRemoteIdleConnTimeoutSeconds) * time.Second
+	// The pool is shared by every server, so it needs to be able to hold the idle connections of many hosts.
+	transport.MaxIdleConns = 0
+
+	if opts.MCPRemoteConnectRetries <= 0 {
+		return transport
+	}
+
+	return &retryTransport{
+		base:    transport,
+		retries: opts.MCPRemoteConnectRetries,
+		backoff: time.Duration(opts.MCPRemoteConnectRetryBackoffMillis) * time.Millisecond,
+	}
+}
+
+// RemoteTransport returns the pooled transport to use for HTTP requests to MCP servers.
+func (sm *SessionManager) RemoteTransport() http.RoundTripper {
+	return sm.remoteTransport
+}
+
+// retryTransport retries requests that failed because a connection to the server couldn't be established. Nothing was
+// sent to the server in that case, so it is safe to retry any request whose body can be replayed.
+type retryTransport struct {
+	base    http.RoundTripper
+	retries int
+	backoff time.Duration
+}
+
+func (r *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := r.backoff
+	for attempt := 0; ; attempt++ {
+		resp, err := r.base.RoundTrip(req)
+		if err == nil || attempt >= r.retries || !isConnectError(err) || !replayable(req) {
+			return resp, err
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// isConnectError returns whether the error happened while dialing the server, before any of the request was written.
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,92 @@
+package mcp
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func failingDials(failures int, bodies *[]string) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		if req.Body != nil {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+		}
+		*bodies = append(*bodies, body)
+		if len(*bodies) <= failures {
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+}
+
+func TestRetryTransportRetriesConnectErrors(t *testing.T) {
+	var bodies []string
+	transport := &retryTransport{base: failingDials(2, &bodies), retries: 2, backoff: time.Millisecond}
+
+	req, err := http.NewRequest(http.MethodPost, "http://mcp.example.com/mcp", strings.NewReader(`{"jsonrpc":"2.0"}`))
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	// Every attempt sends the full body.
+	assert.Equal(t, []string{`{"jsonrpc":"2.0"}`, `{"jsonrpc":"2.0"}`, `{"jsonrpc":"2.0"}`}, bodies)
+}
+
+func TestRetryTransportGivesUpAfterRetries(t *testing.T) {
+	var bodies []string
+	transport := &retryTransport{base: failingDials(3, &bodies), retries: 2, backoff: time.Millisecond}
+
+	req, err := http.NewRequest(http.MethodGet, "http://mcp.example.com/mcp", nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.True(t, isConnectError(err))
+	assert.Len(t, bodies, 3)
+}
+
+func TestRetryTransportDoesNotRetryUnreplayableBodies(t *testing.T) {
+	var bodies []string
+	transport := &retryTransport{base: failingDials(1, &bodies), retries: 2, backoff: time.Millisecond}
+
+	req, err := http.NewRequest(http.MethodPost, "http://mcp.example.com/mcp", io.NopCloser(strings.NewReader("body")))
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.Error(t, err)
+	assert.Len(t, bodies, 1)
+}
+
+func TestRetryTransportDoesNotRetryOtherErrors(t *testing.T) {
+	var attempts int
+	transport := &retryTransport{
+		base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			attempts++
+			return nil, io.ErrUnexpectedEOF
+		}),
+		retries: 2,
+		backoff: time.Millisecond,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://mcp.example.com/mcp", nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, 1, attempts)
+}
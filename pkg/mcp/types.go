@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/obot-platform/nah/pkg/name"
 	nmcp "github.com/obot-platform/nanobot/pkg/mcp"
 	"github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
@@ -26,6 +27,15 @@ type GlobalTokenStore interface {
 	ForUserAndMCP(userID, mcpID string) nmcp.TokenStorage
 }
 
+// SessionStore persists the state of MCP sessions so they can be resumed after Obot restarts.
+type SessionStore interface {
+	// GetSessionState returns the stored state of the client's session, or nil if there is none.
+	GetSessionState(ctx context.Context, clientID string) (*nmcp.SessionState, error)
+	StoreSessionState(ctx context.Context, mcpServerName, clientID string, state *nmcp.SessionState) error
+	DeleteSessionState(ctx context.Context, clientID string) error
+	DeleteSessionStates(ctx context.Context, mcpServerName string) error
+}
+
 type TokenService interface {
 	NewTokenWithClaims(context.Context, jwt.MapClaims) (*jwt.Token, string, error)
 }
@@ -42,6 +52,12 @@ type ServerConfig struct {
 	Args    []string `json:"args"`
 	Env     []string `json:"env"`
 	Files   []File   `json:"files"`
+	// SecretRefs are environment variables whose values are read from external secret managers when the server is
+	// deployed, and are added to Env and Files then.
+	SecretRefs []SecretRef `json:"secretRefs,omitempty"`
+
+	// Stdio configuration.
+	Cwd string `json:"cwd,omitempty"`
 
 	// Remote configuration.
 	URL                     string   `json:"url"`
@@ -54,6 +70,9 @@ type ServerConfig struct {
 	ContainerPort  int    `json:"containerPort"`
 	ContainerPath  string `json:"containerPath"`
 
+	// Source configuration. The server runs like a containerized server, with an image that is built from its source.
+	Source *types.SourceRuntimeConfig `json:"source,omitempty"`
+
 	// Composite configuration.
 	Components []ComponentServer `json:"components"`
 
@@ -69,6 +88,8 @@ type ServerConfig struct {
 	ProjectMCPServer     bool   `json:"projectMCPServer"`
 	ComponentMCPServer   bool   `json:"componentMCPServer"`
 	SystemMCPServer      bool   `json:"systemMCPServer"`
+	// WorkspaceID is the ID of the workspace of the project that a workspace MCP server is bound to.
+	WorkspaceID string `json:"workspaceID,omitempty"`
 
 	Issuer    string   `json:"issuer"`
 	Audiences []string `json:"audiences"`
@@ -84,6 +105,23 @@ type ServerConfig struct {
 	AuditLogMetadata string `json:"auditLogMetadata"`
 
 	StartupTimeout time.Duration `json:"startupTimeout,omitempty"`
+
+	// MultiUser is true for servers that are shared by the users of a catalog or workspace, which are the only servers
+	// that are scaled to multiple replicas.
+	MultiUser bool `json:"multiUser,omitempty"`
+
+	// ExternalHostname is the custom hostname that a multi-user server is exposed on directly, with the TLS certificate
+	// in ExternalTLSSecretName. Only the Kubernetes backend exposes servers.
+	ExternalHostname      string `json:"externalHostname,omitempty"`
+	ExternalTLSSecretName string `json:"externalTLSSecretName,omitempty"`
+
+	// Sampling is only used by Obot's MCP clients and is not part of the server's deployment.
+	Sampling *types.MCPSamplingConfig `json:"sampling,omitempty"`
+	// RequestTimeouts is only used by Obot's MCP clients and is not part of the server's deployment.
+	RequestTimeouts *types.MCPRequestTimeouts `json:"requestTimeouts,omitempty"`
+	// ToolAllowlist is the tool allowlist of the user's MCP server instance. It is enforced on top of the catalog tool
+	// policy and is not part of the server's deployment.
+	ToolAllowlist []string `json:"toolAllowlist,omitempty"`
 }
 
 type File struct {
@@ -180,6 +218,40 @@ func configureContainerizedRuntime(serverConfig *ServerConfig, containerizedConf
 	return nil
 }
 
+func configureSourceRuntime(serverConfig *ServerConfig, sourceConfig *types.SourceRuntimeConfig, credEnv map[string]string, fileEnvVars map[string]struct{}) error {
+	if sourceConfig == nil {
+		return fmt.Errorf("source runtime requires source config")
+	}
+
+	// Servers with the source runtime are deployed as containerized servers once their image is built.
+	serverConfig.Runtime = types.RuntimeContainerized
+	serverConfig.Source = sourceConfig
+	serverConfig.ContainerPort = sourceConfig.Port
+	serverConfig.ContainerPath = sourceConfig.Path
+	serverConfig.Command = expandEnvVars(sourceConfig.Command, credEnv, fileEnvVars)
+	serverConfig.Args = make([]string, 0, len(sourceConfig.Args))
+	for _, arg := range sourceConfig.Args {
+		serverConfig.Args = append(serverConfig.Args, expandEnvVars(arg, credEnv, fileEnvVars))
+	}
+
+	return nil
+}
+
+func configureStdioRuntime(serverConfig *ServerConfig, stdioConfig *types.StdioRuntimeConfig, credEnv map[string]string, fileEnvVars map[string]struct{}) error {
+	if stdioConfig == nil {
+		return fmt.Errorf("stdio runtime requires stdio config")
+	}
+
+	serverConfig.Command = expandEnvVars(stdioConfig.Command, credEnv, fileEnvVars)
+	serverConfig.Cwd = stdioConfig.Cwd
+	serverConfig.Args = make([]string, 0, len(stdioConfig.Args))
+	for _, arg := range stdioConfig.Args {
+		serverConfig.Args = append(serverConfig.Args, expandEnvVars(arg, credEnv, fileEnvVars))
+	}
+
+	return nil
+}
+
 func configureRemoteRuntime(serverConfig *ServerConfig, remoteConfig *types.RemoteRuntimeConfig, credEnv map[string]string) ([]string, error) {
 	if remoteConfig == nil {
 		return nil, fmt.Errorf("remote runtime requires remote config")
@@ -189,6 +261,11 @@ func configureRemoteRuntime(serverConfig *ServerConfig, remoteConfig *types.Remo
 	serverConfig.URL = remoteConfig.URL
 	serverConfig.Headers = make([]string, 0, len(remoteConfig.Headers))
 	for _, header := range remoteConfig.Headers {
+		if header.OAuthTokenSource != nil {
+			// Headers sourced from the user's OAuth token are passed through on each request so that they are always current.
+			continue
+		}
+
 		val := header.Value
 		if val == "" {
 			val = credEnv[header.Key]
@@ -222,6 +299,7 @@ func CompositeServerToServerConfig(mcpServer v1.MCPServer, components []v1.MCPSe
 		return config, missing, err
 	}
 
+	toolNamespace := mcpServer.Spec.Manifest.CompositeConfig.ToolNamespace
 	overrides := make(map[string]types.ComponentServer, len(mcpServer.Spec.Manifest.CompositeConfig.ComponentServers))
 	for _, component := range mcpServer.Spec.Manifest.CompositeConfig.ComponentServers {
 		if component.CatalogEntryID != "" {
@@ -259,7 +337,7 @@ func CompositeServerToServerConfig(mcpServer v1.MCPServer, components []v1.MCPSe
 			Name:       name,
 			URL:        system.MCPConnectURL(issuer, component.Name),
 			Tools:      tools,
-			ToolPrefix: override.ToolPrefix,
+			ToolPrefix: override.EffectiveToolPrefix(toolNamespace),
 		})
 	}
 
@@ -285,7 +363,7 @@ func CompositeServerToServerConfig(mcpServer v1.MCPServer, components []v1.MCPSe
 			Name:       instance.Name,
 			URL:        system.MCPConnectURL(issuer, instance.Name),
 			Tools:      tools,
-			ToolPrefix: override.ToolPrefix,
+			ToolPrefix: override.EffectiveToolPrefix(toolNamespace),
 		})
 	}
 
@@ -348,6 +426,20 @@ func ServerToServerConfig(mcpServer v1.MCPServer, audiences []string, issuer, us
 		ComponentMCPServer:        mcpServer.Spec.CompositeName != "",
 		NanobotAgentName:          mcpServer.Spec.NanobotAgentID,
 		StartupTimeout:            startupTimeout,
+		MultiUser:                 mcpServer.Spec.MCPCatalogID != "" || mcpServer.Spec.PowerUserWorkspaceID != "",
+		Sampling:                  mcpServer.Spec.Manifest.Sampling,
+		RequestTimeouts:           mcpServer.Spec.Manifest.RequestTimeouts,
+		WorkspaceID:               mcpServer.Status.WorkspaceID,
+	}
+
+	if exposure := mcpServer.Spec.ExternalExposure; exposure != nil && exposure.Hostname != "" {
+		serverConfig.ExternalHostname = exposure.Hostname
+		serverConfig.ExternalTLSSecretName = exposure.TLSSecretName
+		if serverConfig.ExternalTLSSecretName == "" {
+			serverConfig.ExternalTLSSecretName = name.SafeConcatName(mcpServer.Name, "tls")
+		}
+		// Clients that connect to the server directly get tokens for its external URL, which the shim must trust.
+		serverConfig.Audiences = append(slices.Clone(audiences), mcpServer.ExternalURL())
 	}
 
 	if mcpServer.Spec.CompositeName == "" {
@@ -380,6 +472,14 @@ func ServerToServerConfig(mcpServer v1.MCPServer, audiences []string, issuer, us
 		if err != nil {
 			return serverConfig, missingRequiredNames, err
 		}
+	case types.RuntimeStdio:
+		if err := configureStdioRuntime(&serverConfig, mcpServer.Spec.Manifest.StdioConfig, credEnv, fileEnvVars); err != nil {
+			return serverConfig, missingRequiredNames, err
+		}
+	case types.RuntimeSource:
+		if err := configureSourceRuntime(&serverConfig, mcpServer.Spec.Manifest.SourceConfig, credEnv, fileEnvVars); err != nil {
+			return serverConfig, missingRequiredNames, err
+		}
 	case types.RuntimeComposite:
 		return configureCompositeRuntime(serverConfig)
 	default:
@@ -387,6 +487,22 @@ func ServerToServerConfig(mcpServer v1.MCPServer, audiences []string, issuer, us
 	}
 
 	for _, env := range mcpServer.Spec.Manifest.Env {
+		if env.OAuthTokenSource != nil {
+			// These values are added with AddOAuthTokenValues.
+			continue
+		}
+		if env.ValueFrom != "" {
+			serverConfig.SecretRefs = append(serverConfig.SecretRefs, SecretRef{
+				EnvKey:   env.Key,
+				Ref:      env.ValueFrom,
+				Prefix:   env.Prefix,
+				File:     env.File,
+				Dynamic:  env.DynamicFile,
+				Required: env.Required,
+			})
+			continue
+		}
+
 		val, ok := credEnv[env.Key]
 		if !ok || val == "" {
 			if env.Required {
@@ -413,6 +529,109 @@ func ServerToServerConfig(mcpServer v1.MCPServer, audiences []string, issuer, us
 	return serverConfig, missingRequiredNames, nil
 }
 
+// OAuthTokenSourceApps returns the aliases of the OAuth apps that the manifest's headers and environment variables are sourced from.
+func OAuthTokenSourceApps(manifest types.MCPServerManifest) []string {
+	var apps []string
+	for _, header := range oauthTokenSourcedValues(manifest) {
+		if !slices.Contains(apps, header.OAuthTokenSource.OAuthApp) {
+			apps = append(apps, header.OAuthTokenSource.OAuthApp)
+		}
+	}
+	return apps
+}
+
+// OAuthTokenSourceScopes returns the scopes that the manifest requests for the given OAuth app.
+func OAuthTokenSourceScopes(manifest types.MCPServerManifest, oauthApp string) []string {
+	var scopes []string
+	for _, header := range oauthTokenSourcedValues(manifest) {
+		if header.OAuthTokenSource.OAuthApp != oauthApp {
+			continue
+		}
+		for _, scope := range header.OAuthTokenSource.Scopes {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
+
+// AddedOAuthTokenSourceScopes returns the scopes that the after manifest requests for each OAuth app and the before
+// manifest doesn't. Users who authorized an OAuth app before the change have to consent to the added scopes.
+func AddedOAuthTokenSourceScopes(before, after types.MCPServerManifest) map[string][]string {
+	added := make(map[string][]string)
+	for _, app := range OAuthTokenSourceApps(after) {
+		beforeScopes := OAuthTokenSourceScopes(before, app)
+		for _, scope := range OAuthTokenSourceScopes(after, app) {
+			if !slices.Contains(beforeScopes, scope) {
+				added[app] = append(added[app], scope)
+			}
+		}
+	}
+	return added
+}
+
+// AddOAuthTokenValues adds the values sourced from the user's OAuth tokens, keyed by OAuth app alias, to the server config.
+// Headers are passed through on each request and environment variables are provided as dynamic files,
+// so that refreshed tokens are picked up without redeploying the server.
+// The keys of any values whose OAuth app has no token are returned.
+func AddOAuthTokenValues(serverConfig *ServerConfig, manifest types.MCPServerManifest, tokens map[string]string) []string {
+	var missing []string
+	for _, env := range manifest.Env {
+		if env.OAuthTokenSource == nil {
+			continue
+		}
+
+		token := tokens[env.OAuthTokenSource.OAuthApp]
+		if token == "" {
+			missing = append(missing, env.Key)
+			continue
+		}
+
+		serverConfig.Files = append(serverConfig.Files, File{
+			Data:    applyPrefix(token, env.Prefix),
+			EnvKey:  env.Key,
+			Dynamic: true,
+		})
+	}
+
+	if manifest.RemoteConfig != nil {
+		for _, header := range manifest.RemoteConfig.Headers {
+			if header.OAuthTokenSource == nil {
+				continue
+			}
+
+			token := tokens[header.OAuthTokenSource.OAuthApp]
+			if token == "" {
+				missing = append(missing, header.Key)
+				continue
+			}
+
+			serverConfig.PassthroughHeaderNames = append(serverConfig.PassthroughHeaderNames, header.Key)
+			serverConfig.PassthroughHeaderValues = append(serverConfig.PassthroughHeaderValues, applyPrefix(token, header.Prefix))
+		}
+	}
+
+	return missing
+}
+
+func oauthTokenSourcedValues(manifest types.MCPServerManifest) []types.MCPHeader {
+	var values []types.MCPHeader
+	for _, env := range manifest.Env {
+		if env.OAuthTokenSource != nil {
+			values = append(values, env.MCPHeader)
+		}
+	}
+	if manifest.RemoteConfig != nil {
+		for _, header := range manifest.RemoteConfig.Headers {
+			if header.OAuthTokenSource != nil {
+				values = append(values, header)
+			}
+		}
+	}
+	return values
+}
+
 func ProjectServerToConfig(projectMCPServer v1.ProjectMCPServer, publicBaseURL, internalBaseURL, userID string) (ServerConfig, error) {
 	return ServerConfig{
 		URL:                projectMCPServer.ConnectURL(internalBaseURL),
@@ -420,3 +420,77 @@ func TestServerToServerConfig_StaticHeaders_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestServerToServerConfig_OAuthTokenSources(t *testing.T) {
+	baseURL := "http://localhost:8080"
+	mcpServer := v1.MCPServer{
+		Spec: v1.MCPServerSpec{
+			Manifest: types.MCPServerManifest{
+				Runtime: types.RuntimeRemote,
+				RemoteConfig: &types.RemoteRuntimeConfig{
+					URL: "https://example.com/mcp",
+					Headers: []types.MCPHeader{
+						{Key: "X-Static", Value: "static"},
+						{Key: "Authorization", Required: true, Prefix: "Bearer ", OAuthTokenSource: &types.MCPOAuthTokenSource{OAuthApp: "github", Scopes: []string{"repo"}}},
+						{Key: "X-Other-Token", OAuthTokenSource: &types.MCPOAuthTokenSource{OAuthApp: "other"}},
+					},
+				},
+			},
+		},
+	}
+	mcpServer.Name = "test-server"
+
+	// Values sourced from OAuth tokens are never taken from the credential, nor reported as missing config.
+	config, missing, err := ServerToServerConfig(mcpServer, mcpServer.ValidConnectURLs(baseURL), baseURL, "test-user-id", "test-scope", "test-catalog", map[string]string{"Authorization": "stale"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("expected no missing config, got %v", missing)
+	}
+	if len(config.Headers) != 1 || config.Headers[0] != "X-Static=static" {
+		t.Errorf("expected only the static header, got %v", config.Headers)
+	}
+
+	if apps := OAuthTokenSourceApps(mcpServer.Spec.Manifest); len(apps) != 2 || apps[0] != "github" || apps[1] != "other" {
+		t.Errorf("unexpected OAuth apps: %v", apps)
+	}
+	if scopes := OAuthTokenSourceScopes(mcpServer.Spec.Manifest, "github"); len(scopes) != 1 || scopes[0] != "repo" {
+		t.Errorf("unexpected scopes: %v", scopes)
+	}
+
+	missing = AddOAuthTokenValues(&config, mcpServer.Spec.Manifest, map[string]string{"github": "gho_123"})
+	if len(missing) != 1 || missing[0] != "X-Other-Token" {
+		t.Errorf("expected X-Other-Token to be missing, got %v", missing)
+	}
+	if len(config.PassthroughHeaderNames) != 1 || config.PassthroughHeaderNames[0] != "Authorization" || config.PassthroughHeaderValues[0] != "Bearer gho_123" {
+		t.Errorf("unexpected passthrough headers: %v=%v", config.PassthroughHeaderNames, config.PassthroughHeaderValues)
+	}
+
+	// The token is passed through on each request, so refreshing it doesn't change the server ID.
+	refreshed, _, _ := ServerToServerConfig(mcpServer, mcpServer.ValidConnectURLs(baseURL), baseURL, "test-user-id", "test-scope", "test-catalog", nil, nil)
+	AddOAuthTokenValues(&refreshed, mcpServer.Spec.Manifest, map[string]string{"github": "gho_456"})
+	if serverID(config) != serverID(refreshed) {
+		t.Errorf("expected refreshing the token not to change the server ID")
+	}
+}
+
+func TestAddedOAuthTokenSourceScopes(t *testing.T) {
+	manifest := func(scopes ...string) types.MCPServerManifest {
+		return types.MCPServerManifest{
+			Env: []types.MCPEnv{
+				{MCPHeader: types.MCPHeader{Key: "GITHUB_TOKEN", OAuthTokenSource: &types.MCPOAuthTokenSource{OAuthApp: "github", Scopes: scopes}}},
+				{MCPHeader: types.MCPHeader{Key: "OTHER_TOKEN", OAuthTokenSource: &types.MCPOAuthTokenSource{OAuthApp: "other", Scopes: []string{"read"}}}},
+			},
+		}
+	}
+
+	added := AddedOAuthTokenSourceScopes(manifest("repo"), manifest("repo", "gist", "read:org"))
+	if len(added) != 1 || len(added["github"]) != 2 || added["github"][0] != "gist" || added["github"][1] != "read:org" {
+		t.Errorf("unexpected added scopes: %v", added)
+	}
+
+	if added = AddedOAuthTokenSourceScopes(manifest("repo", "gist"), manifest("repo")); len(added) != 0 {
+		t.Errorf("expected removing scopes not to add any, got %v", added)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"k8s.io/client-go/tools/cache"
@@ -87,13 +88,19 @@ func (wh *WebhookHelper) appendWebhooks(namespace string, objs []any, seen map[s
 				toolName = defaultWebhookToolName
 			}
 
+			selectors := res.Spec.Manifest.Selectors
+			if res.Spec.Manifest.Filter && len(selectors) == 0 {
+				selectors = types.DefaultFilterSelectors
+			}
+
 			result = append(result, Webhook{
-				Name:          res.Name,
-				DisplayName:   displayName,
-				URL:           url,
-				ToolName:      toolName,
-				Definitions:   res.Spec.Manifest.Selectors.Strings(),
-				MutateAllowed: res.Spec.Manifest.AllowedToMutate,
+				Name:        res.Name,
+				DisplayName: displayName,
+				URL:         url,
+				ToolName:    toolName,
+				Definitions: selectors.Strings(),
+				// Filters rewrite or reject messages, so they are always allowed to mutate.
+				MutateAllowed: res.Spec.Manifest.AllowedToMutate || res.Spec.Manifest.Filter,
 			})
 		}
 	}
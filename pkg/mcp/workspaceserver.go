@@ -0,0 +1,52 @@
+package mcp
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/obot-platform/obot/pkg/system"
+)
+
+// workspaceServers configures the deployments of the Obot-managed workspace MCP servers, which read and write the
+// files of the project they are bound to through Obot's workspace server file API. The servers never get the
+// credentials of the workspace provider: the API confines them to the project's files and enforces the quota. The
+// controller binds each server to a project and measures how much of its quota the project's files use.
+type workspaceServers struct {
+	transformObotHostname func(string) string
+	quotaBytes            int64
+}
+
+func newWorkspaceServers(transformObotHostname func(string) string, opts Options) *workspaceServers {
+	return &workspaceServers{
+		transformObotHostname: transformObotHostname,
+		quotaBytes:            WorkspaceServerQuotaBytes(opts),
+	}
+}
+
+// WorkspaceServerQuotaBytes returns the maximum size of the files of each project that workspace MCP servers can
+// write, or 0 if there is no limit.
+func WorkspaceServerQuotaBytes(opts Options) int64 {
+	return int64(max(opts.MCPWorkspaceServerQuotaMB, 0)) * 1024 * 1024
+}
+
+// IsWorkspaceServer returns whether the server was created from the catalog entry of the Obot-managed workspace MCP
+// server.
+func IsWorkspaceServer(catalogEntryName string) bool {
+	return catalogEntryName == system.WorkspaceCatalogEntryName
+}
+
+// configure adds the URL of the file API of the server's project, the token that the server authenticates to it with,
+// and the quota to the environment of workspace servers. Servers that aren't bound to a project yet, and other servers,
+// are returned unchanged.
+func (w *workspaceServers) configure(server ServerConfig) ServerConfig {
+	if server.WorkspaceID == "" || server.AuditLogToken == "" || !IsWorkspaceServer(server.MCPCatalogEntryName) {
+		return server
+	}
+
+	server.Env = append(slices.Clone(server.Env),
+		"WORKSPACE_URL="+w.transformObotHostname(server.Issuer+"/api/workspace-server-files"),
+		"WORKSPACE_TOKEN="+server.AuditLogToken,
+		fmt.Sprintf("WORKSPACE_MAX_BYTES=%d", w.quotaBytes),
+	)
+	return server
+}
@@ -0,0 +1,40 @@
+package mcp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaceServersConfigure(t *testing.T) {
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	w := newWorkspaceServers(func(url string) string {
+		return strings.Replace(url, "localhost", "host.docker.internal", 1)
+	}, Options{MCPWorkspaceServerQuotaMB: 10})
+
+	server := w.configure(ServerConfig{
+		MCPServerName:       "ms1-abc",
+		MCPCatalogEntryName: system.WorkspaceCatalogEntryName,
+		WorkspaceID:         "s3://obot-workspaces/abc",
+		Issuer:              "http://localhost:8080",
+		AuditLogToken:       "token",
+		Env:                 []string{"FOO=bar"},
+	})
+	// The server gets the file API of its project, and never the credentials of the workspace provider.
+	assert.Equal(t, []string{
+		"FOO=bar",
+		"WORKSPACE_URL=http://host.docker.internal:8080/api/workspace-server-files",
+		"WORKSPACE_TOKEN=token",
+		"WORKSPACE_MAX_BYTES=10485760",
+	}, server.Env)
+
+	// Servers that aren't bound to a project yet aren't changed.
+	unbound := ServerConfig{MCPServerName: "ms1-abc", MCPCatalogEntryName: system.WorkspaceCatalogEntryName, AuditLogToken: "token", Env: []string{"FOO=bar"}}
+	assert.Equal(t, unbound, w.configure(unbound))
+
+	// Servers from other catalog entries aren't changed.
+	other := ServerConfig{MCPServerName: "ms1-def", MCPCatalogEntryName: "default-other", WorkspaceID: "s3://obot-workspaces/def", AuditLogToken: "token", Env: []string{"FOO=bar"}}
+	assert.Equal(t, other, w.configure(other))
+}
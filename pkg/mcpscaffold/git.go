@@ -0,0 +1,96 @@
+package mcpscaffold
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+const defaultBranch = "main"
+
+// ErrBranchExists is returned when the branch that a project would be pushed to already exists, so that scaffolding
+// never overwrites existing work.
+var ErrBranchExists = errors.New("branch already exists in the repository")
+
+// Branch returns the branch that a project is pushed to in the repository.
+func Branch(repo types.MCPServerScaffoldRepository) string {
+	if repo.Branch == "" {
+		return defaultBranch
+	}
+	return repo.Branch
+}
+
+// Push commits the files to a new branch of the repository, and returns the hash of the commit.
+func Push(ctx context.Context, repo types.MCPServerScaffoldRepository, files []File, author object.Signature) (string, error) {
+	var (
+		branch = plumbing.NewBranchReferenceName(Branch(repo))
+		auth   transport.AuthMethod
+	)
+	if repo.Token != "" {
+		// Git hosts accept tokens as the password of any user name.
+		auth = &githttp.BasicAuth{Username: "obot", Password: repo.Token}
+	}
+
+	r, err := git.InitWithOptions(memory.NewStorage(), memfs.New(), git.InitOptions{DefaultBranch: branch})
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize repository: %w", err)
+	}
+
+	remote, err := r.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{repo.URL}})
+	if err != nil {
+		return "", fmt.Errorf("failed to add remote: %w", err)
+	}
+
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth})
+	if err != nil && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return "", fmt.Errorf("failed to list the branches of %s: %w", repo.URL, err)
+	}
+	for _, ref := range refs {
+		if ref.Name() == branch {
+			return "", fmt.Errorf("%w: %s", ErrBranchExists, branch.Short())
+		}
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if err := util.WriteFile(wt.Filesystem, f.Path, f.Data, 0o644); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", f.Path, err)
+		}
+		if _, err := wt.Add(f.Path); err != nil {
+			return "", fmt.Errorf("failed to add %s: %w", f.Path, err)
+		}
+	}
+
+	if author.When.IsZero() {
+		author.When = time.Now()
+	}
+	hash, err := wt.Commit("Scaffold MCP server", &git.CommitOptions{Author: &author})
+	if err != nil {
+		return "", fmt.Errorf("failed to commit project: %w", err)
+	}
+
+	if err := r.PushContext(ctx, &git.PushOptions{
+		RemoteName: git.DefaultRemoteName,
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("%s:%s", branch, branch))},
+		Auth:       auth,
+	}); err != nil {
+		return "", fmt.Errorf("failed to push to %s: %w", repo.URL, err)
+	}
+
+	return hash.String(), nil
+}
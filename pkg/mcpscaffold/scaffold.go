@@ -0,0 +1,220 @@
+// Package mcpscaffold generates new MCP server projects from language templates that follow the conventions of Obot:
+// the servers serve the streamable HTTP transport and a health check, read their configuration from environment
+// variables, and build into images that Obot can run with the source runtime.
+package mcpscaffold
+
+import (
+	"archive/zip"
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+const (
+	// Port and Path are where scaffolded servers serve MCP.
+	Port = 8080
+	Path = "/mcp"
+
+	// CatalogEntryFile is the file of a scaffolded project with the manifest of its catalog entry.
+	CatalogEntryFile = "obot-catalog-entry.json"
+)
+
+var (
+	//go:embed all:templates
+	templatesFS embed.FS
+
+	projectNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{0,62}$`)
+	envKeyRegex      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	templateFuncs = template.FuncMap{
+		// quote returns the value as a JSON string, which is also a valid string in Python, TypeScript, Go, and TOML.
+		"quote": func(s string) (string, error) {
+			b, err := json.Marshal(s)
+			return string(b), err
+		},
+		// comment returns the value on one line, so that it can't end a comment.
+		"comment": func(s string) string {
+			return strings.Join(strings.Fields(s), " ")
+		},
+		// requiredKeys returns the quoted keys of the required environment variables, separated by commas.
+		"requiredKeys": func(env []types.MCPEnv) (string, error) {
+			var keys []string
+			for _, e := range env {
+				if !e.Required {
+					continue
+				}
+				b, err := json.Marshal(e.Key)
+				if err != nil {
+					return "", err
+				}
+				keys = append(keys, string(b))
+			}
+			return strings.Join(keys, ", "), nil
+		},
+	}
+)
+
+// File is a file of a scaffolded project.
+type File struct {
+	Path string
+	Data []byte
+}
+
+type templateData struct {
+	Name        string
+	Description string
+	Language    types.MCPServerScaffoldLanguage
+	Env         []types.MCPEnv
+	Port        int
+	Path        string
+}
+
+// Validate checks that a project can be scaffolded from the request.
+func Validate(req types.MCPServerScaffoldRequest) error {
+	if !projectNameRegex.MatchString(req.Name) {
+		return fmt.Errorf("name %q must start with a letter and only have lowercase letters, numbers, and dashes", req.Name)
+	}
+
+	if !slices.Contains(Languages(), req.Language) {
+		return fmt.Errorf("unknown language %q", req.Language)
+	}
+
+	seen := make(map[string]struct{}, len(req.Env))
+	for _, env := range req.Env {
+		if !envKeyRegex.MatchString(env.Key) {
+			return fmt.Errorf("environment variable %q must only have letters, numbers, and underscores", env.Key)
+		}
+		if _, ok := seen[env.Key]; ok {
+			return fmt.Errorf("environment variable %s is defined more than once", env.Key)
+		}
+		seen[env.Key] = struct{}{}
+	}
+
+	if req.Repository != nil {
+		u, err := url.Parse(req.Repository.URL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("repository URL %q must be an HTTPS URL", req.Repository.URL)
+		}
+	}
+
+	return nil
+}
+
+// Languages returns the languages that projects can be scaffolded in.
+func Languages() []types.MCPServerScaffoldLanguage {
+	return []types.MCPServerScaffoldLanguage{
+		types.MCPServerScaffoldLanguagePython,
+		types.MCPServerScaffoldLanguageTypeScript,
+		types.MCPServerScaffoldLanguageGo,
+	}
+}
+
+// Generate returns the files of the project, rendered from the templates of its language and the common templates.
+// The repository and ref are set in the source configuration of the generated catalog entry, and can be empty.
+func Generate(req types.MCPServerScaffoldRequest, repository, ref string) ([]File, error) {
+	if err := Validate(req); err != nil {
+		return nil, err
+	}
+
+	data := templateData{
+		Name:        req.Name,
+		Description: req.Description,
+		Language:    req.Language,
+		Env:         req.Env,
+		Port:        Port,
+		Path:        Path,
+	}
+
+	var files []File
+	for _, dir := range []string{"common", string(req.Language)} {
+		rendered, err := renderTemplates(path.Join("templates", dir), data)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, rendered...)
+	}
+
+	manifest, err := json.MarshalIndent(CatalogEntryManifest(req, repository, ref), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, File{Path: CatalogEntryFile, Data: append(manifest, '\n')})
+
+	slices.SortFunc(files, func(a, b File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return files, nil
+}
+
+func renderTemplates(root string, data templateData) ([]File, error) {
+	var files []File
+	err := fs.WalkDir(templatesFS, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		content, err := templatesFS.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		// Templates are named with a .tmpl suffix, so that files like go.mod aren't treated as part of this module.
+		name := strings.TrimSuffix(strings.TrimPrefix(p, root+"/"), ".tmpl")
+		tmpl, err := template.New(name).Funcs(templateFuncs).Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("failed to parse template %s: %w", p, err)
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return fmt.Errorf("failed to render template %s: %w", p, err)
+		}
+
+		files = append(files, File{Path: name, Data: buf.Bytes()})
+		return nil
+	})
+	return files, err
+}
+
+// CatalogEntryManifest returns the manifest of a catalog entry that builds and runs the project with the source
+// runtime.
+func CatalogEntryManifest(req types.MCPServerScaffoldRequest, repository, ref string) types.MCPServerCatalogEntryManifest {
+	return types.MCPServerCatalogEntryManifest{
+		Name:             req.Name,
+		ShortDescription: req.Description,
+		Runtime:          types.RuntimeSource,
+		SourceConfig: &types.SourceRuntimeConfig{
+			Repository: repository,
+			Ref:        ref,
+			Port:       Port,
+			Path:       Path,
+		},
+		Env: req.Env,
+	}
+}
+
+// WriteZip writes the files as a zip archive, with the files in a directory named after the project.
+func WriteZip(w io.Writer, dir string, files []File) error {
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.Create(path.Join(dir, f.Path))
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(f.Data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
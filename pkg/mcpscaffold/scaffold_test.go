@@ -0,0 +1,147 @@
+package mcpscaffold
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scaffoldRequest(language types.MCPServerScaffoldLanguage) types.MCPServerScaffoldRequest {
+	return types.MCPServerScaffoldRequest{
+		Name:        "weather-server",
+		Description: "Looks up the \"weather\".\nFor any city.",
+		Language:    language,
+		Env: []types.MCPEnv{
+			{MCPHeader: types.MCPHeader{Key: "API_KEY", Description: "The API key", Required: true, Sensitive: true}},
+			{MCPHeader: types.MCPHeader{Key: "UNITS", Description: "metric or imperial"}},
+		},
+	}
+}
+
+func filesByPath(files []File) map[string]string {
+	result := make(map[string]string, len(files))
+	for _, f := range files {
+		result[f.Path] = string(f.Data)
+	}
+	return result
+}
+
+func TestGenerate(t *testing.T) {
+	for language, expected := range map[types.MCPServerScaffoldLanguage][]string{
+		types.MCPServerScaffoldLanguagePython:     {"server.py", "requirements.txt"},
+		types.MCPServerScaffoldLanguageTypeScript: {"package.json", "tsconfig.json", "src/index.ts"},
+		types.MCPServerScaffoldLanguageGo:         {"go.mod", "main.go"},
+	} {
+		t.Run(string(language), func(t *testing.T) {
+			files, err := Generate(scaffoldRequest(language), "https://github.com/example/weather-server.git", "main")
+			require.NoError(t, err)
+
+			byPath := filesByPath(files)
+			for _, p := range append(expected, "Dockerfile", "Procfile", "README.md", ".env.example", ".dockerignore", ".gitignore", CatalogEntryFile) {
+				assert.Contains(t, byPath, p)
+			}
+			for p, content := range byPath {
+				assert.NotContains(t, content, "<no value>", p)
+			}
+			assert.Equal(t, "# The API key (required)\nAPI_KEY=\n# metric or imperial\nUNITS=\n", byPath[".env.example"])
+
+			var manifest types.MCPServerCatalogEntryManifest
+			require.NoError(t, json.Unmarshal([]byte(byPath[CatalogEntryFile]), &manifest))
+			assert.Equal(t, types.RuntimeSource, manifest.Runtime)
+			assert.Equal(t, &types.SourceRuntimeConfig{
+				Repository: "https://github.com/example/weather-server.git",
+				Ref:        "main",
+				Port:       Port,
+				Path:       Path,
+			}, manifest.SourceConfig)
+			assert.Equal(t, scaffoldRequest(language).Env, manifest.Env)
+		})
+	}
+}
+
+func TestGenerateGoIsValid(t *testing.T) {
+	files, err := Generate(scaffoldRequest(types.MCPServerScaffoldLanguageGo), "", "")
+	require.NoError(t, err)
+
+	main := filesByPath(files)["main.go"]
+	_, err = parser.ParseFile(token.NewFileSet(), "main.go", main, parser.AllErrors)
+	require.NoError(t, err, main)
+	assert.Contains(t, main, `var requiredEnv = []string{"API_KEY"}`)
+	assert.Contains(t, main, `// weather-server: Looks up the "weather". For any city.`)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(scaffoldRequest(types.MCPServerScaffoldLanguagePython)))
+
+	for name, mutate := range map[string]func(*types.MCPServerScaffoldRequest){
+		"invalid name":      func(r *types.MCPServerScaffoldRequest) { r.Name = "Weather Server" },
+		"unknown language":  func(r *types.MCPServerScaffoldRequest) { r.Language = "rust" },
+		"invalid env key":   func(r *types.MCPServerScaffoldRequest) { r.Env[0].Key = "API-KEY" },
+		"duplicate env key": func(r *types.MCPServerScaffoldRequest) { r.Env[1].Key = "API_KEY" },
+		"non-HTTPS repo URL": func(r *types.MCPServerScaffoldRequest) {
+			r.Repository = &types.MCPServerScaffoldRepository{URL: "git@github.com:example/repo.git"}
+		},
+		"file repository URL": func(r *types.MCPServerScaffoldRequest) {
+			r.Repository = &types.MCPServerScaffoldRepository{URL: "file:///tmp/repo"}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := scaffoldRequest(types.MCPServerScaffoldLanguagePython)
+			mutate(&req)
+			assert.Error(t, Validate(req))
+		})
+	}
+}
+
+func TestWriteZip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteZip(&buf, "weather-server", []File{{Path: "src/index.ts", Data: []byte("export {};\n")}}))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+	assert.Equal(t, "weather-server/src/index.ts", zr.File[0].Name)
+}
+
+func TestPush(t *testing.T) {
+	dir := t.TempDir()
+	_, err := git.PlainInit(dir, true)
+	require.NoError(t, err)
+
+	files, err := Generate(scaffoldRequest(types.MCPServerScaffoldLanguageGo), "", "")
+	require.NoError(t, err)
+
+	repo := types.MCPServerScaffoldRepository{URL: dir}
+	commit, err := Push(t.Context(), repo, files, object.Signature{Name: "Jane Doe", Email: "jane@example.com"})
+	require.NoError(t, err)
+
+	pushed, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+	ref, err := pushed.Reference(plumbing.NewBranchReferenceName("main"), true)
+	require.NoError(t, err)
+	assert.Equal(t, commit, ref.Hash().String())
+
+	c, err := pushed.CommitObject(ref.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", c.Author.Email)
+	_, err = c.File("main.go")
+	require.NoError(t, err)
+
+	// Pushing to the same branch again doesn't overwrite it.
+	_, err = Push(t.Context(), repo, files, object.Signature{Name: "Jane Doe", Email: "jane@example.com"})
+	require.ErrorIs(t, err, ErrBranchExists)
+
+	repo.Branch = "scaffold"
+	_, err = Push(t.Context(), repo, files, object.Signature{Name: "Jane Doe", Email: "jane@example.com"})
+	require.NoError(t, err)
+}
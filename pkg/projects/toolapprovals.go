@@ -0,0 +1,92 @@
+package projects
+
+import (
+	"slices"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ToolApprovalStatus returns the status of the approval given how long approvals last and how long before they expire
+// projects are warned. Approvals never expire if expiration is not positive.
+func ToolApprovalStatus(approval v1.ToolApproval, expiration, warning time.Duration, now time.Time) types.ToolApprovalStatus {
+	if approval.Expired {
+		return types.ToolApprovalExpired
+	}
+	if expiration <= 0 {
+		return types.ToolApprovalApproved
+	}
+
+	expiresAt := approval.ApprovedAt.Add(expiration)
+	switch {
+	case !now.Before(expiresAt):
+		return types.ToolApprovalExpired
+	case !now.Before(expiresAt.Add(-warning)):
+		return types.ToolApprovalExpiring
+	default:
+		return types.ToolApprovalApproved
+	}
+}
+
+// RecordToolApproval records that the user approved the tools currently selected for the given MCP server.
+// It does not call Update() on the thread, so the caller is responsible for calling Update().
+func RecordToolApproval(thread *v1.Thread, key, userID string, now time.Time) {
+	if thread.Spec.ToolApprovals == nil {
+		thread.Spec.ToolApprovals = make(map[string]v1.ToolApproval)
+	}
+
+	thread.Spec.ToolApprovals[key] = v1.ToolApproval{
+		ApprovedBy: userID,
+		ApprovedAt: metav1.NewTime(now),
+	}
+}
+
+// RecertifyToolApproval re-approves the tools for the given MCP server, restoring the tools that were revoked
+// if the approval had expired.
+// It does not call Update() on the thread, so the caller is responsible for calling Update().
+func RecertifyToolApproval(thread *v1.Thread, key, userID string, now time.Time) {
+	if approval := thread.Spec.ToolApprovals[key]; approval.Expired {
+		if thread.Spec.Manifest.AllowedMCPTools == nil {
+			thread.Spec.Manifest.AllowedMCPTools = make(map[string][]string)
+		}
+
+		tools := approval.ExpiredTools
+		if tools == nil {
+			// No tools were selected when the approval expired.
+			tools = []string{}
+		}
+		thread.Spec.Manifest.AllowedMCPTools[key] = tools
+	}
+
+	RecordToolApproval(thread, key, userID, now)
+}
+
+// ExpireToolApproval revokes the tools selected for the given MCP server, saving them so that they can be restored
+// when the approval is re-certified.
+// It does not call Update() on the thread, so the caller is responsible for calling Update().
+func ExpireToolApproval(thread *v1.Thread, key string) {
+	approval := thread.Spec.ToolApprovals[key]
+	if approval.Expired {
+		return
+	}
+
+	tools, ok := thread.Spec.Manifest.AllowedMCPTools[key]
+	if !ok || tools == nil {
+		// A missing selection allows all tools.
+		tools = []string{"*"}
+	}
+
+	if thread.Spec.Manifest.AllowedMCPTools == nil {
+		thread.Spec.Manifest.AllowedMCPTools = make(map[string][]string)
+	}
+	if thread.Spec.ToolApprovals == nil {
+		thread.Spec.ToolApprovals = make(map[string]v1.ToolApproval)
+	}
+
+	approval.Expired = true
+	approval.ExpiredTools = slices.Clone(tools)
+	thread.Spec.ToolApprovals[key] = approval
+	thread.Spec.Manifest.AllowedMCPTools[key] = []string{}
+}
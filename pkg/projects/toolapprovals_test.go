@@ -0,0 +1,102 @@
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToolApprovalStatus(t *testing.T) {
+	var (
+		now        = time.Now()
+		expiration = 90 * 24 * time.Hour
+		warning    = 14 * 24 * time.Hour
+	)
+
+	tests := []struct {
+		name       string
+		approval   v1.ToolApproval
+		expiration time.Duration
+		want       types.ToolApprovalStatus
+	}{
+		{
+			name:       "recent approval",
+			approval:   v1.ToolApproval{ApprovedAt: metav1.NewTime(now.Add(-time.Hour))},
+			expiration: expiration,
+			want:       types.ToolApprovalApproved,
+		},
+		{
+			name:       "within warning period",
+			approval:   v1.ToolApproval{ApprovedAt: metav1.NewTime(now.Add(-80 * 24 * time.Hour))},
+			expiration: expiration,
+			want:       types.ToolApprovalExpiring,
+		},
+		{
+			name:       "past expiration",
+			approval:   v1.ToolApproval{ApprovedAt: metav1.NewTime(now.Add(-91 * 24 * time.Hour))},
+			expiration: expiration,
+			want:       types.ToolApprovalExpired,
+		},
+		{
+			name:     "expiration disabled",
+			approval: v1.ToolApproval{ApprovedAt: metav1.NewTime(now.Add(-365 * 24 * time.Hour))},
+			want:     types.ToolApprovalApproved,
+		},
+		{
+			name:     "revoked while expiration disabled",
+			approval: v1.ToolApproval{ApprovedAt: metav1.NewTime(now.Add(-365 * 24 * time.Hour)), Expired: true},
+			want:     types.ToolApprovalExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToolApprovalStatus(tt.approval, tt.expiration, warning, now); got != tt.want {
+				t.Errorf("ToolApprovalStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpireAndRecertifyToolApproval(t *testing.T) {
+	tests := []struct {
+		name     string
+		selected []string
+		hasKey   bool
+		want     []string
+	}{
+		{name: "selected tools", selected: []string{"read", "write"}, hasKey: true, want: []string{"read", "write"}},
+		{name: "all tools", selected: []string{"*"}, hasKey: true, want: []string{"*"}},
+		{name: "no selection", want: []string{"*"}},
+		{name: "no tools", selected: []string{}, hasKey: true, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thread := &v1.Thread{}
+			if tt.hasKey {
+				thread.Spec.Manifest.AllowedMCPTools = map[string][]string{"server": tt.selected}
+			}
+			RecordToolApproval(thread, "server", "owner", time.Now().Add(-time.Hour))
+
+			ExpireToolApproval(thread, "server")
+			if tools := thread.Spec.Manifest.AllowedMCPTools["server"]; tools == nil || len(tools) != 0 {
+				t.Fatalf("expected tools to be revoked, got %v", tools)
+			}
+			if !thread.Spec.ToolApprovals["server"].Expired {
+				t.Fatal("expected approval to be expired")
+			}
+
+			RecertifyToolApproval(thread, "server", "admin", time.Now())
+			if got := thread.Spec.Manifest.AllowedMCPTools["server"]; len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("expected tools %v to be restored, got %v", tt.want, got)
+			}
+			if approval := thread.Spec.ToolApprovals["server"]; approval.Expired || approval.ApprovedBy != "admin" {
+				t.Errorf("unexpected approval after re-certification: %+v", approval)
+			}
+		})
+	}
+}
@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/adrg/xdg"
@@ -30,10 +33,16 @@ import (
 	"github.com/obot-platform/obot/pkg/api/authz"
 	"github.com/obot-platform/obot/pkg/api/handlers"
 	"github.com/obot-platform/obot/pkg/api/handlers/mcpgateway"
+	"github.com/obot-platform/obot/pkg/api/handlers/mcpgateway/oauth"
 	"github.com/obot-platform/obot/pkg/api/server"
 	"github.com/obot-platform/obot/pkg/api/server/audit"
 	"github.com/obot-platform/obot/pkg/api/server/ratelimiter"
+	"github.com/obot-platform/obot/pkg/api/server/requestinfo"
+	"github.com/obot-platform/obot/pkg/auditlogexport"
 	"github.com/obot-platform/obot/pkg/bootstrap"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpserver"
+	"github.com/obot-platform/obot/pkg/controller/reconcilestats"
+	"github.com/obot-platform/obot/pkg/controller/statusupdate"
 	"github.com/obot-platform/obot/pkg/credstores"
 	"github.com/obot-platform/obot/pkg/encryption"
 	"github.com/obot-platform/obot/pkg/events"
@@ -98,6 +107,7 @@ type Config struct {
 	DevUIPort                   int      `usage:"The port on localhost running the dev instance of the UI" default:"5174"`
 	UserUIPort                  int      `usage:"The port on localhost running the user production instance of the UI" env:"OBOT_SERVER_USER_UI_PORT"`
 	AllowedOrigin               string   `usage:"Allowed origin for CORS"`
+	TrustedProxies              []string `usage:"IP addresses or CIDRs of reverse proxies trusted to set the X-Forwarded-Host and X-Forwarded-Proto headers used when building MCP connect URLs, and the X-Forwarded-For header used to rate limit catalog submissions"`
 	ToolRegistries              []string `usage:"The remote tool references to the set of gptscript tool registries to use" default:"github.com/obot-platform/tools"`
 	WorkspaceProviderType       string   `usage:"The type of workspace provider to use for non-knowledge workspaces" default:"directory" env:"OBOT_WORKSPACE_PROVIDER_TYPE"`
 	HelperModel                 string   `usage:"The model used to generate names and descriptions" default:"gpt-5-mini"`
@@ -107,7 +117,10 @@ type Config struct {
 	KnowledgeSetIngestionLimit  int      `usage:"The maximum number of files to ingest into a knowledge set" default:"3000" name:"knowledge-set-ingestion-limit"`
 	KnowledgeFileWorkers        int      `usage:"The number of workers to process knowledge files" default:"5"`
 	RunWorkers                  int      `usage:"The number of workers to process runs" default:"1000"`
+	RunMCPToolCostWarningUSD    float64  `usage:"The estimated cost in US dollars of the MCP tool calls of a single run above which a warning is shown in the run's details, <= 0 disables the warning" default:"0"`
 	ElectionFile                string   `usage:"Use this file for leader election instead of database leases"`
+	DBMigrationDryRun           bool     `usage:"Don't apply pending changes to an existing database at startup, so that they can be reviewed with GET /api/database-migrations" default:"false" env:"OBOT_SERVER_DB_MIGRATION_DRY_RUN"`
+	DBMigrationAutoContract     bool     `usage:"Remove the old schema of expand/contract database migrations as soon as their data is copied to the new schema. Disable for blue/green upgrades, and contract them once the previous release is stopped" default:"true" env:"OBOT_SERVER_DB_MIGRATION_AUTO_CONTRACT"`
 	EnableAuthentication        bool     `usage:"Enable authentication" default:"false"`
 	ForceEnableBootstrap        bool     `usage:"Enables the bootstrap user even if other admin users have been created" default:"false"`
 	AuthAdminEmails             []string `usage:"Emails of admin users"`
@@ -115,6 +128,8 @@ type Config struct {
 	AgentsDir                   string   `usage:"The directory to auto load agents on start (default $XDG_CONFIG_HOME/.obot/agents)"`
 	StaticDir                   string   `usage:"The directory to serve static files from"`
 	RetentionPolicyHours        int      `usage:"The retention policy for the system. Set to 0 to disable retention." default:"2160"` // default 90 days
+	ToolApprovalExpirationDays  int      `usage:"The number of days after which the tools selected for a project's MCP servers must be re-certified by the project owner or an admin. Set to 0 to disable expiration." default:"0"`
+	ToolApprovalWarningDays     int      `usage:"The number of days before tool approvals expire that projects are flagged for re-certification" default:"14"`
 	DefaultMCPCatalogPath       string   `usage:"The path to the default MCP catalog (accessible to all users)" default:""`
 	DefaultSystemMCPCatalogPath string   `usage:"The path to the default System MCP catalog" default:""`
 	DefaultSkillRepoURL         string   `usage:"The default skill repository URL (must be HTTPS GitHub URL)" default:"https://github.com/obot-platform/skills" env:"OBOT_DEFAULT_SKILL_REPO_URL"`
@@ -130,12 +145,29 @@ type Config struct {
 	EnableMessagePolicies                bool   `usage:"Enable message policies for LLM proxy content enforcement" default:"false"`
 	MCPServerSearchImage                 string `usage:"Container image for the obot MCP server" default:"ghcr.io/obot-platform/obot-mcp-server:v0.2.0"`
 	NanobotAgentImage                    string `usage:"Container image for the Nanobot agent MCP server" default:"ghcr.io/obot-platform/nanobot-agent:v0.0.80"`
+	MCPMemoryServerImage                 string `usage:"Container image for the Obot-managed memory MCP server, which is added to the default catalog when Obot uses PostgreSQL, empty to disable" default:"ghcr.io/obot-platform/mcp-images/memory:v0.1.0"`
+	MCPWorkspaceServerImage              string `usage:"Container image for the Obot-managed workspace MCP server, which is added to the default catalog, empty to disable" default:"ghcr.io/obot-platform/mcp-images/workspace:v0.1.0"`
 	MCPNetworkPolicyProviderChartRepo    string `usage:"Helm repository URL for the network policy provider chart"`
 	MCPNetworkPolicyProviderChartName    string `usage:"Helm chart name for the network policy provider chart"`
 	MCPNetworkPolicyProviderChartVersion string `usage:"Helm chart version for the network policy provider chart"`
 	MCPNetworkPolicyProviderChartPath    string `usage:"Local filesystem path to the network policy provider chart"`
 	MCPNetworkPolicyProviderValues       string `usage:"YAML or JSON values blob merged into the network policy provider chart values"`
 	MCPDefaultDenyAllEgress              bool   `usage:"Default new MCP servers to deny all egress when network policy enforcement is enabled" default:"false"`
+	MCPConnectPingIntervalSeconds        int    `usage:"The interval in seconds between keep-alive pings on event streams opened through mcp-connect, set to 0 to disable" default:"30"`
+	MCPConnectIdleTimeoutSeconds         int    `usage:"The number of seconds an mcp-connect session without requests or open streams is kept before it is closed, set to 0 to disable" default:"300"`
+	MCPConnectMaxSessionDurationSeconds  int    `usage:"The maximum number of seconds an mcp-connect event stream or SSE session is kept open before it is closed, set to 0 to disable" default:"0"`
+	MCPDefaultToolSelection              string `usage:"The tools enabled when an MCP server is added to a project until tools are selected (allow-all, deny-all, catalog-default), can be overridden per catalog" default:"allow-all"`
+	MCPLivenessProbeIntervalSeconds      int    `usage:"The interval in seconds between liveness probes of deployed MCP servers, set to 0 to disable" default:"300"`
+	MCPCredentialExpiryWarningDays       int    `usage:"The number of days before a stored OAuth token of an MCP server that can't be refreshed expires that its user is prompted to authenticate again" default:"7"`
+	MCPStatusCounterIntervalSeconds      int    `usage:"The minimum interval in seconds between status updates of MCP servers for counters such as user counts, set to 0 to disable" default:"30"`
+	MCPToolPreviewAutoGeneration         bool   `usage:"Deploy editable catalog entries temporarily when they are created or changed to generate their tool previews" default:"true"`
+	AlertRuleEvaluationIntervalSeconds   int    `usage:"The interval in seconds between evaluations of alert rules, set to 0 to disable" default:"60"`
+	OAuthChallengeProvider               string `usage:"The bot protection of the OAuth authorization and client registration endpoints (turnstile, hcaptcha, webhook), empty to disable"`
+	OAuthChallengeSiteKey                string `usage:"The site key of the Turnstile or hCaptcha widget"`
+	OAuthChallengeSecretKey              string `usage:"The secret key used to verify Turnstile or hCaptcha responses, or to sign requests to the OAuth challenge webhook"`
+	OAuthChallengeWebhookURL             string `usage:"The URL of the webhook that allows or denies OAuth authorization and client registration requests"`
+	EnableOIDCProvider                   bool   `usage:"Allow the OAuth server to act as an OpenID Connect provider that issues ID tokens to clients that request the openid scope" default:"false" env:"OBOT_SERVER_ENABLE_OIDC_PROVIDER"`
+	OAuthSigningKeyRotationDays          int    `usage:"The number of days after which the key that tokens are signed with is rotated, set to 0 to disable" default:"90"`
 
 	// Published artifact storage
 	ArtifactStorageProvider       string `usage:"Storage provider for published artifacts (s3, gcs, azure, custom)" name:"artifact-storage-provider" env:"OBOT_ARTIFACT_STORAGE_PROVIDER"`
@@ -166,6 +198,7 @@ type Services struct {
 	WorkspaceProviderType       string
 	ServerURL                   string
 	InternalServerURL           string
+	TrustedProxies              []*net.IPNet
 	EmailServerName             string
 	DevUIPort                   int
 	UserUIPort                  int
@@ -195,6 +228,8 @@ type Services struct {
 	AuditLogger                 audit.Logger
 	PostgresDSN                 string
 	RetentionPolicy             time.Duration
+	ToolApprovalExpiration      time.Duration
+	ToolApprovalWarning         time.Duration
 	// Use basic auth for sendgrid webhook, if being set
 	SendgridWebhookUsername string
 	SendgridWebhookPassword string
@@ -213,6 +248,15 @@ type Services struct {
 
 	WebhookHelper *mcp.WebhookHelper
 
+	// Used for looking up catalog-level tool policies for MCP servers.
+	ToolPolicyHelper *mcp.ToolPolicyHelper
+
+	// Tracks reconcile statistics for the MCP-related controller handlers.
+	ReconcileStats *reconcilestats.Tracker
+
+	// Coalesces the status updates of the MCP-related controller handlers.
+	StatusUpdates *statusupdate.Coalescer
+
 	// Used for loading and running MCP servers with GPTScript.
 	MCPLoader *mcp.SessionManager
 
@@ -252,6 +296,8 @@ type Services struct {
 	MCPDefaultDenyAllEgress              bool
 	MCPServerSearchImage                 string
 	NanobotAgentImage                    string
+	MCPMemoryServerImage                 string
+	MCPWorkspaceServerImage              string
 	MCPNetworkPolicyProviderChartRepo    string
 	MCPNetworkPolicyProviderChartName    string
 	MCPNetworkPolicyProviderChartVersion string
@@ -260,6 +306,34 @@ type Services struct {
 	SingleUserIdleServerShutdownInterval time.Duration
 	MultiUserIdleServerShutdownInterval  time.Duration
 	AgentIdleServerShutdownInterval      time.Duration
+	MCPConnectPingInterval               time.Duration
+	MCPConnectIdleTimeout                time.Duration
+	MCPConnectMaxSessionDuration         time.Duration
+	MCPDefaultToolSelection              apiclienttypes.ToolSelectionPolicy
+	MCPLivenessProbeInterval             time.Duration
+	MCPStatusCounterInterval             time.Duration
+	MCPToolPreviewAutoGeneration         bool
+	AlertRuleEvaluationInterval          time.Duration
+	OAuthChallenge                       oauth.ChallengeConfig
+	MCPAuditLogRetentionDays             int
+	MCPAuditLogArchiver                  client.AuditLogArchiver
+	DBMigrationAutoContract              bool
+	OAuthSigningKeyRotationPeriod        time.Duration
+	MCPStaleServerAfter                  time.Duration
+	MCPStaleServerGracePeriod            time.Duration
+	MCPStaleServerAction                 apiclienttypes.MCPServerStaleAction
+	MCPStaleServerWebhookURL             string
+	MCPStaleServerWebhookSecret          string
+	MCPFailureTicketURL                  string
+	MCPFailureTicketTemplate             *template.Template
+	MCPFailureTicketAuthorization        string
+	MCPPrewarmLookback                   time.Duration
+	MCPPrewarmLead                       time.Duration
+	MCPPrewarmMinUsers                   int
+	MCPMemoryServerQuota                 int64
+	MCPWorkspaceServerQuota              int64
+	MonthlyUserMCPToolCallLimit          int
+	RunMCPToolCostWarningUSD             float64
 
 	// Published artifact blob storage
 	ArtifactBlobStore  blob.BlobStore
@@ -508,6 +582,29 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		config.StorageListenPort = 8443
 	}
 
+	if !apiclienttypes.ToolSelectionPolicy(config.MCPDefaultToolSelection).Valid() {
+		return nil, fmt.Errorf("invalid default tool selection policy %q: must be one of allow-all, deny-all, or catalog-default", config.MCPDefaultToolSelection)
+	}
+
+	oauthChallenge := oauth.ChallengeConfig{
+		Provider:   oauth.ChallengeProvider(config.OAuthChallengeProvider),
+		SiteKey:    config.OAuthChallengeSiteKey,
+		SecretKey:  config.OAuthChallengeSecretKey,
+		WebhookURL: config.OAuthChallengeWebhookURL,
+	}
+	if err := oauthChallenge.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !apiclienttypes.MCPServerStaleAction(config.MCPStaleServerAction).Valid() {
+		return nil, fmt.Errorf("invalid stale MCP server action %q: must be one of none, shutdown, or delete", config.MCPStaleServerAction)
+	}
+
+	mcpFailureTicketTemplate, err := mcpserver.ParseFailureTicketTemplate(config.MCPFailureTicketTemplate)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate network policy provider configuration
 	mcpNetworkPolicyEnabled := config.MCPNetworkPolicyProviderChartPath != "" || config.MCPNetworkPolicyProviderChartName != ""
 	if mcpNetworkPolicyEnabled && !runtimeIsK8s {
@@ -548,9 +645,8 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		electionConfig = leader.NewDefaultElectionConfig("", "obot-controller", restConfig)
 	}
 
-	// For now, always auto-migrate.
 	pkgLog.Infof("Initializing gateway database connection")
-	gatewayDB, err := db.New(dbAccess.DB, dbAccess.SQLDB, true)
+	gatewayDB, err := db.New(dbAccess.DB, dbAccess.SQLDB, !config.DBMigrationDryRun)
 	if err != nil {
 		pkgLog.Errorf("Failed to initialize gateway database: error=%v", err)
 		return nil, err
@@ -594,6 +690,11 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		config.UIHostname = "https://" + config.UIHostname
 	}
 
+	trustedProxies, err := requestinfo.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
 	gatewayClient := client.New(
 		ctx,
 		gatewayDB,
@@ -603,7 +704,7 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		config.AuthAdminEmails,
 		time.Duration(config.MCPAuditLogPersistIntervalSeconds)*time.Second,
 		config.MCPAuditLogsPersistBatchSize,
-		config.MCPAuditLogRetentionDays,
+		time.Duration(config.MCPCredentialExpiryWarningDays)*24*time.Hour,
 	)
 	storageServices.Authn.SetServiceAccountValidator(func(ctx context.Context, token string) (string, error) {
 		apiKey, err := gatewayClient.ValidateStorageServiceAccountToken(ctx, token)
@@ -645,9 +746,11 @@ func New(ctx context.Context, config Config) (*Services, error) {
 
 	events := events.NewEmitter(storageClient, gatewayClient)
 
-	var postgresDSN string
+	var postgresDSN, memoryServerImage string
 	if strings.HasPrefix(config.DSN, "postgres://") {
 		postgresDSN = config.DSN
+		// Memory MCP servers store their memories in Obot's database, so they are only available with PostgreSQL.
+		memoryServerImage = config.MCPMemoryServerImage
 	}
 
 	credOnlyGPTscriptClient, err := newGPTScript(ctx, config.EnvKeys, credStore, credStoreEnv, nil)
@@ -746,7 +849,20 @@ func New(ctx context.Context, config Config) (*Services, error) {
 
 	webhookHelper := mcp.NewWebhookHelper(mcpWebhookValidationInformer.GetIndexer(), config.Hostname)
 
-	mcpSessionManager, err := mcp.NewSessionManager(ctx, persistentTokenServer, config.Hostname, config.HTTPListenPort, mcp.Options(config.MCPConfig), webhookHelper, localK8sConfig, storageClient)
+	// Set up MCPServerCatalogEntry informer for tool policy lookups
+	mcpServerCatalogEntryGVK, err := r.Backend().GroupVersionKindFor(&v1.MCPServerCatalogEntry{})
+	if err != nil {
+		return nil, err
+	}
+
+	mcpServerCatalogEntryInformer, err := r.Backend().GetInformerForKind(ctx, mcpServerCatalogEntryGVK)
+	if err != nil {
+		return nil, err
+	}
+
+	toolPolicyHelper := mcp.NewToolPolicyHelper(mcpServerCatalogEntryInformer.GetIndexer())
+
+	mcpSessionManager, err := mcp.NewSessionManager(ctx, persistentTokenServer, config.Hostname, config.HTTPListenPort, mcp.Options(config.MCPConfig), webhookHelper, toolPolicyHelper, localK8sConfig, storageClient, mcpgateway.NewSessionStore(gatewayClient), postgresDSN)
 	if err != nil {
 		return nil, err
 	}
@@ -1030,6 +1146,29 @@ func New(ctx context.Context, config Config) (*Services, error) {
 
 	retentionPolicy := time.Duration(config.RetentionPolicyHours) * time.Hour
 
+	// MCP audit logs follow the system retention policy unless they have their own retention configured.
+	mcpAuditLogRetentionDays := config.MCPAuditLogRetentionDays
+	if mcpAuditLogRetentionDays < 0 {
+		mcpAuditLogRetentionDays = int(math.Ceil(retentionPolicy.Hours() / 24))
+	}
+
+	var mcpAuditLogArchiver client.AuditLogArchiver
+	switch {
+	case config.MCPAuditLogArchiveDir != "" && config.MCPAuditLogArchiveBucket != "":
+		return nil, fmt.Errorf("only one of the MCP audit log archive directory and bucket can be set")
+	case config.MCPAuditLogArchiveDir != "":
+		mcpAuditLogArchiver, err = auditlogexport.NewDirectoryArchiver(config.MCPAuditLogArchiveDir)
+		if err != nil {
+			return nil, err
+		}
+	case config.MCPAuditLogArchiveBucket != "":
+		mcpAuditLogArchiver = auditlogexport.NewStorageArchiver(
+			auditlogexport.NewGPTScriptCredentialProvider(gptscriptClient),
+			config.MCPAuditLogArchiveBucket,
+			config.MCPAuditLogArchiveKeyPrefix,
+		)
+	}
+
 	// Derive registryNoAuth flag from config
 	// When EnableRegistryAuth is false (default), registry is in no-auth mode
 	registryNoAuth := !config.EnableRegistryAuth
@@ -1040,6 +1179,7 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		WorkspaceProviderType: config.WorkspaceProviderType,
 		ServerURL:             config.Hostname,
 		InternalServerURL:     fmt.Sprintf("http://localhost:%d", config.HTTPListenPort),
+		TrustedProxies:        trustedProxies,
 		DevUIPort:             devPort,
 		UserUIPort:            config.UserUIPort,
 		ToolRegistryURLs:      config.ToolRegistries,
@@ -1058,6 +1198,7 @@ func New(ctx context.Context, config Config) (*Services, error) {
 			rateLimiter,
 			config.Hostname,
 			registryNoAuth,
+			trustedProxies,
 		),
 		PersistentTokenServer:       persistentTokenServer,
 		Invoker:                     invoker,
@@ -1078,6 +1219,8 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		AuditLogger:                 auditLogger,
 		PostgresDSN:                 postgresDSN,
 		RetentionPolicy:             retentionPolicy,
+		ToolApprovalExpiration:      time.Duration(config.ToolApprovalExpirationDays) * 24 * time.Hour,
+		ToolApprovalWarning:         time.Duration(config.ToolApprovalWarningDays) * 24 * time.Hour,
 		DefaultMCPCatalogPath:       config.DefaultMCPCatalogPath,
 		DefaultSystemMCPCatalogPath: config.DefaultSystemMCPCatalogPath,
 		DefaultSkillRepoURL:         config.DefaultSkillRepoURL,
@@ -1085,23 +1228,31 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		MCPLoader:                   mcpSessionManager,
 		MCPOAuthTokenStorage:        mcpOAuthTokenStorage,
 		OAuthServerConfig: handlers.OAuthAuthorizationServerConfig{
-			Issuer:                            config.Hostname,
-			AuthorizationEndpoint:             fmt.Sprintf("%s/oauth/authorize", config.Hostname),
-			TokenEndpoint:                     fmt.Sprintf("%s/oauth/token", config.Hostname),
-			RegistrationEndpoint:              fmt.Sprintf("%s/oauth/register", config.Hostname),
-			JWKSURI:                           config.Hostname + "/oauth/jwks.json",
-			ScopesSupported:                   []string{"profile"},
-			ResponseTypesSupported:            []string{"code"},
-			GrantTypesSupported:               []string{"authorization_code", "refresh_token", "urn:ietf:params:oauth:grant-type:token-exchange"},
-			CodeChallengeMethodsSupported:     []string{"S256", "plain"},
-			TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
-			UserInfoEndpoint:                  fmt.Sprintf("%s/oauth/userinfo", config.Hostname),
+			Issuer:                                    config.Hostname,
+			AuthorizationEndpoint:                     fmt.Sprintf("%s/oauth/authorize", config.Hostname),
+			TokenEndpoint:                             fmt.Sprintf("%s/oauth/token", config.Hostname),
+			RegistrationEndpoint:                      fmt.Sprintf("%s/oauth/register", config.Hostname),
+			DeviceAuthorizationEndpoint:               fmt.Sprintf("%s/oauth/device_authorization", config.Hostname),
+			RevocationEndpoint:                        fmt.Sprintf("%s/oauth/revoke", config.Hostname),
+			IntrospectionEndpoint:                     fmt.Sprintf("%s/oauth/introspect", config.Hostname),
+			JWKSURI:                                   config.Hostname + "/oauth/jwks.json",
+			ScopesSupported:                           []string{"profile"},
+			ResponseTypesSupported:                    []string{"code"},
+			GrantTypesSupported:                       []string{"authorization_code", "refresh_token", "urn:ietf:params:oauth:grant-type:token-exchange", handlers.DeviceCodeGrantType, handlers.ClientCredentialsGrantType},
+			CodeChallengeMethodsSupported:             []string{"S256", "plain"},
+			TokenEndpointAuthMethodsSupported:         []string{"client_secret_basic", "client_secret_post", "none"},
+			RevocationEndpointAuthMethodsSupported:    []string{"client_secret_basic", "client_secret_post", "none"},
+			IntrospectionEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+			UserInfoEndpoint:                          fmt.Sprintf("%s/oauth/userinfo", config.Hostname),
 		},
 		AccessControlRuleHelper:              acrHelper,
 		ModelAccessPolicyHelper:              mapHelper,
 		MessagePolicyHelper:                  msgPolicyHelper,
 		SkillAccessRuleHelper:                skillAccessRuleHelper,
 		WebhookHelper:                        webhookHelper,
+		ToolPolicyHelper:                     toolPolicyHelper,
+		ReconcileStats:                       reconcilestats.New(),
+		StatusUpdates:                        statusupdate.New(),
 		LocalK8sConfig:                       localK8sConfig,
 		MCPServerNamespace:                   config.MCPNamespace,
 		MCPClusterDomain:                     config.MCPClusterDomain,
@@ -1120,6 +1271,34 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		SingleUserIdleServerShutdownInterval: time.Duration(config.SingleUserIdleServerShutdownHours) * time.Hour,
 		MultiUserIdleServerShutdownInterval:  time.Duration(config.MultiUserIdleServerShutdownHours) * time.Hour,
 		AgentIdleServerShutdownInterval:      time.Duration(config.IdleAgentShutdownHours) * time.Hour,
+		MCPConnectPingInterval:               time.Duration(config.MCPConnectPingIntervalSeconds) * time.Second,
+		MCPConnectIdleTimeout:                time.Duration(config.MCPConnectIdleTimeoutSeconds) * time.Second,
+		MCPConnectMaxSessionDuration:         time.Duration(config.MCPConnectMaxSessionDurationSeconds) * time.Second,
+		MCPDefaultToolSelection:              apiclienttypes.ToolSelectionPolicy(config.MCPDefaultToolSelection),
+		MCPLivenessProbeInterval:             time.Duration(config.MCPLivenessProbeIntervalSeconds) * time.Second,
+		MCPStatusCounterInterval:             time.Duration(config.MCPStatusCounterIntervalSeconds) * time.Second,
+		MCPToolPreviewAutoGeneration:         config.MCPToolPreviewAutoGeneration,
+		AlertRuleEvaluationInterval:          time.Duration(config.AlertRuleEvaluationIntervalSeconds) * time.Second,
+		OAuthChallenge:                       oauthChallenge,
+		MCPAuditLogRetentionDays:             mcpAuditLogRetentionDays,
+		MCPAuditLogArchiver:                  mcpAuditLogArchiver,
+		DBMigrationAutoContract:              config.DBMigrationAutoContract,
+		OAuthSigningKeyRotationPeriod:        time.Duration(config.OAuthSigningKeyRotationDays) * 24 * time.Hour,
+		MCPStaleServerAfter:                  time.Duration(config.MCPStaleServerDays) * 24 * time.Hour,
+		MCPStaleServerGracePeriod:            time.Duration(config.MCPStaleServerGracePeriodDays) * 24 * time.Hour,
+		MCPStaleServerAction:                 apiclienttypes.MCPServerStaleAction(config.MCPStaleServerAction),
+		MCPStaleServerWebhookURL:             config.MCPStaleServerWebhookURL,
+		MCPStaleServerWebhookSecret:          config.MCPStaleServerWebhookSecret,
+		MCPFailureTicketURL:                  config.MCPFailureTicketURL,
+		MCPFailureTicketTemplate:             mcpFailureTicketTemplate,
+		MCPFailureTicketAuthorization:        config.MCPFailureTicketAuthorization,
+		MCPPrewarmLookback:                   time.Duration(config.MCPPrewarmLookbackDays) * 24 * time.Hour,
+		MCPPrewarmLead:                       time.Duration(config.MCPPrewarmLeadMinutes) * time.Minute,
+		MCPPrewarmMinUsers:                   config.MCPPrewarmMinUsers,
+		MCPMemoryServerQuota:                 mcp.MemoryServerQuotaBytes(mcp.Options(config.MCPConfig)),
+		MCPWorkspaceServerQuota:              mcp.WorkspaceServerQuotaBytes(mcp.Options(config.MCPConfig)),
+		MonthlyUserMCPToolCallLimit:          config.MonthlyUserMCPToolCallLimit,
+		RunMCPToolCostWarningUSD:             config.RunMCPToolCostWarningUSD,
 		RegistryNoAuth:                       registryNoAuth,
 		NanobotIntegration:                   config.NanobotIntegration,
 		MessagePoliciesEnabled:               config.EnableMessagePolicies,
@@ -1127,6 +1306,8 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		MCPDefaultDenyAllEgress:              config.MCPDefaultDenyAllEgress,
 		MCPServerSearchImage:                 config.MCPServerSearchImage,
 		NanobotAgentImage:                    config.NanobotAgentImage,
+		MCPMemoryServerImage:                 memoryServerImage,
+		MCPWorkspaceServerImage:              config.MCPWorkspaceServerImage,
 		MCPNetworkPolicyProviderChartRepo:    config.MCPNetworkPolicyProviderChartRepo,
 		MCPNetworkPolicyProviderChartName:    config.MCPNetworkPolicyProviderChartName,
 		MCPNetworkPolicyProviderChartVersion: config.MCPNetworkPolicyProviderChartVersion,
@@ -1135,6 +1316,13 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		ArtifactBlobBucket:                   config.ArtifactStorageBucket,
 	}
 
+	if config.EnableOIDCProvider {
+		svcs.OAuthServerConfig.ScopesSupported = append(svcs.OAuthServerConfig.ScopesSupported, handlers.OpenIDScope, "email")
+		svcs.OAuthServerConfig.SubjectTypesSupported = []string{"public"}
+		svcs.OAuthServerConfig.IDTokenSigningAlgValuesSupported = []string{"EdDSA"}
+		svcs.OAuthServerConfig.ClaimsSupported = []string{"iss", "sub", "aud", "exp", "iat", "nonce", "name", "preferred_username", "picture", "zoneinfo", "email", "email_verified"}
+	}
+
 	if (config.ArtifactStorageProvider == "") != (config.ArtifactStorageBucket == "") {
 		return nil, fmt.Errorf("both OBOT_ARTIFACT_STORAGE_PROVIDER and OBOT_ARTIFACT_STORAGE_BUCKET must be set together")
 	}
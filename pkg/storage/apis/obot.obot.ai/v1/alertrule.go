@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AlertRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertRuleSpec   `json:"spec,omitempty"`
+	Status AlertRuleStatus `json:"status,omitempty"`
+}
+
+type AlertRuleSpec struct {
+	Manifest types.AlertRuleManifest `json:"manifest"`
+}
+
+type AlertRuleStatus struct {
+	// LastEvaluatedAt is the last time the controller evaluated the rule.
+	LastEvaluatedAt metav1.Time `json:"lastEvaluatedAt,omitzero"`
+	// Firing contains the MCP servers that the rule is firing for.
+	Firing []types.AlertRuleFiring `json:"firing,omitempty"`
+	// Error is the error of the last evaluation or notification, if any.
+	Error string `json:"error,omitempty"`
+}
+
+func (in *AlertRule) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Display Name", "Spec.Manifest.DisplayName"},
+		{"Condition", "Spec.Manifest.Condition"},
+		{"Threshold", "Spec.Manifest.Threshold"},
+		{"Firing", "{{len .Status.Firing}}"},
+		{"Disabled", "{{.Spec.Manifest.Disabled}}"},
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AlertRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []AlertRule `json:"items"`
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"github.com/obot-platform/obot/apiclient/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -17,6 +18,11 @@ type MCPCatalog struct {
 type MCPCatalogSpec struct {
 	DisplayName string   `json:"displayName,omitempty"`
 	SourceURLs  []string `json:"sourceURLs,omitempty"`
+	// ExternalURL, if set, is the base URL used in place of Obot's server URL when building the connect URLs of servers in this catalog.
+	ExternalURL string `json:"externalURL,omitempty"`
+	// DefaultToolSelection, if set, overrides the server-wide policy for which tools of a server from this catalog are
+	// enabled when the server is added to a project.
+	DefaultToolSelection types.ToolSelectionPolicy `json:"defaultToolSelection,omitempty"`
 }
 
 type MCPCatalogStatus struct {
@@ -24,6 +30,9 @@ type MCPCatalogStatus struct {
 	// SyncErrors is a map of source URLs to the error encountered while syncing it, if any.
 	SyncErrors map[string]string `json:"syncErrors,omitempty"`
 	IsSyncing  bool              `json:"isSyncing,omitempty"`
+	// SyncConflicts contains the names of the entries imported from an MCP registry that were edited in Obot and changed
+	// in the registry since, so they weren't updated by the last sync.
+	SyncConflicts []string `json:"syncConflicts,omitempty"`
 }
 
 func (in *MCPCatalog) GetColumns() [][]string {
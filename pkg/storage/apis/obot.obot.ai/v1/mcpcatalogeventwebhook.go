@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ DeleteRefs    = (*MCPCatalogEventWebhook)(nil)
+	_ fields.Fields = (*MCPCatalogEventWebhook)(nil)
+	_ DeleteRefs    = (*MCPCatalogEvent)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPCatalogEventWebhook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPCatalogEventWebhookSpec   `json:"spec,omitempty"`
+	Status MCPCatalogEventWebhookStatus `json:"status,omitempty"`
+}
+
+type MCPCatalogEventWebhookSpec struct {
+	MCPCatalogID string                               `json:"mcpCatalogID,omitempty"`
+	Manifest     types.MCPCatalogEventWebhookManifest `json:"manifest"`
+}
+
+type MCPCatalogEventWebhookStatus struct {
+	// LastDeliveredAt is the last time an event was delivered to the webhook.
+	LastDeliveredAt metav1.Time `json:"lastDeliveredAt,omitzero"`
+	// Error is the error of the last delivery to the webhook, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+func (in *MCPCatalogEventWebhook) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Display Name", "Spec.Manifest.DisplayName"},
+		{"MCP Catalog", "Spec.MCPCatalogID"},
+		{"Disabled", "{{.Spec.Manifest.Disabled}}"},
+	}
+}
+
+func (in *MCPCatalogEventWebhook) Has(field string) bool {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *MCPCatalogEventWebhook) Get(field string) string {
+	switch field {
+	case "spec.mcpCatalogID":
+		return in.Spec.MCPCatalogID
+	}
+	return ""
+}
+
+func (in *MCPCatalogEventWebhook) FieldNames() []string {
+	return []string{
+		"spec.mcpCatalogID",
+	}
+}
+
+func (in *MCPCatalogEventWebhook) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &MCPCatalog{}, Name: in.Spec.MCPCatalogID},
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPCatalogEventWebhookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPCatalogEventWebhook `json:"items"`
+}
+
+// MCPCatalogEvent is a lifecycle event of an MCP catalog that is waiting to be delivered to the catalog's webhooks.
+// It is deleted once it is delivered to all of them, or delivery is given up on.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MCPCatalogEvent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPCatalogEventSpec   `json:"spec,omitempty"`
+	Status MCPCatalogEventStatus `json:"status,omitempty"`
+}
+
+type MCPCatalogEventSpec struct {
+	Event types.MCPCatalogEvent `json:"event"`
+}
+
+type MCPCatalogEventStatus struct {
+	// Delivered contains the names of the webhooks that the event was delivered to.
+	Delivered []string `json:"delivered,omitempty"`
+	// Attempts is the number of times that delivering the event to the remaining webhooks failed.
+	Attempts int `json:"attempts,omitempty"`
+}
+
+func (in *MCPCatalogEvent) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Type", "Spec.Event.Type"},
+		{"MCP Catalog", "Spec.Event.MCPCatalogID"},
+		{"Attempts", "Status.Attempts"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *MCPCatalogEvent) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &MCPCatalog{}, Name: in.Spec.Event.MCPCatalogID},
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPCatalogEventList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPCatalogEvent `json:"items"`
+}
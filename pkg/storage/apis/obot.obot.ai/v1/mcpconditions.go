@@ -0,0 +1,15 @@
+package v1
+
+// Condition types reported in the status of MCPServer, MCPServerCatalogEntry, and MCPServerInstance objects.
+const (
+	// MCPConditionReady indicates whether the MCP server is deployed and available.
+	MCPConditionReady = "Ready"
+	// MCPConditionSynced indicates whether the object's status reflects its latest configuration.
+	MCPConditionSynced = "Synced"
+	// MCPConditionCredentialConfigured indicates whether the static OAuth credentials required by the server have been configured.
+	MCPConditionCredentialConfigured = "CredentialConfigured"
+	// MCPConditionDriftDetected indicates whether the configuration has drifted from its source and needs to be updated.
+	MCPConditionDriftDetected = "DriftDetected"
+	// MCPConditionK8sSettingsApplied indicates whether the server is deployed with the current Kubernetes settings.
+	MCPConditionK8sSettingsApplied = "K8sSettingsApplied"
+)
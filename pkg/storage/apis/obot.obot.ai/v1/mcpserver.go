@@ -3,6 +3,7 @@ package v1
 import (
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/obot-platform/nah/pkg/fields"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -51,6 +52,16 @@ func (in *MCPServer) Get(field string) (value string) {
 		return string(in.Spec.Manifest.Runtime)
 	case "auditLogTokenHash":
 		return in.Status.AuditLogTokenHash
+	case "status.needsUpdate":
+		return strconv.FormatBool(in.Status.NeedsUpdate)
+	case "status.deploymentStatus":
+		return in.Status.DeploymentStatus
+	case "spec.connectAliasHost":
+		return in.ConnectAliasHost()
+	case "spec.externalExposureHost":
+		if in.Spec.ExternalExposure != nil {
+			return in.Spec.ExternalExposure.Hostname
+		}
 	}
 	return ""
 }
@@ -66,6 +77,10 @@ func (in *MCPServer) FieldNames() []string {
 		"spec.compositeName",
 		"spec.manifest.runtime",
 		"auditLogTokenHash",
+		"status.needsUpdate",
+		"status.deploymentStatus",
+		"spec.connectAliasHost",
+		"spec.externalExposureHost",
 	}
 }
 
@@ -93,6 +108,48 @@ func (in *MCPServer) ValidConnectURLs(base string) []string {
 	return append(urls, system.MCPConnectURL(base, in.Name))
 }
 
+// ConnectAliasHost returns the hostname of the server's connect alias, if it has one.
+func (in *MCPServer) ConnectAliasHost() string {
+	host, _ := SplitConnectAlias(in.Spec.ConnectAlias)
+	return host
+}
+
+// ExternalURL returns the URL that clients connect to the server at directly when it is exposed on a custom hostname,
+// or an empty string if it isn't.
+func (in *MCPServer) ExternalURL() string {
+	if in.Spec.ExternalExposure == nil || in.Spec.ExternalExposure.Hostname == "" {
+		return ""
+	}
+
+	// The shim serves the server at the same path as the server itself.
+	var path string
+	switch {
+	case in.Spec.Manifest.ContainerizedConfig != nil:
+		path = in.Spec.Manifest.ContainerizedConfig.Path
+	case in.Spec.Manifest.SourceConfig != nil:
+		path = in.Spec.Manifest.SourceConfig.Path
+	}
+	return "https://" + in.Spec.ExternalExposure.Hostname + "/" + strings.TrimPrefix(path, "/")
+}
+
+// EffectiveMaintenanceNotice returns the server's own maintenance notice, or else the one of its catalog entry that the
+// controller copied to the status. The server's own notice is read from the spec so that changes apply right away.
+func (in *MCPServer) EffectiveMaintenanceNotice() *types.MCPMaintenanceNotice {
+	if in.Spec.MaintenanceNotice != nil {
+		return in.Spec.MaintenanceNotice
+	}
+	return in.Status.MaintenanceNotice
+}
+
+// SplitConnectAlias splits a connect alias into its hostname and path. The path is empty or starts with a slash.
+func SplitConnectAlias(alias string) (string, string) {
+	host, path, ok := strings.Cut(alias, "/")
+	if !ok {
+		return host, ""
+	}
+	return host, "/" + path
+}
+
 type MCPServerSpec struct {
 	Manifest types.MCPServerManifest `json:"manifest"`
 	// List of tool names that are known to not work well in Obot.
@@ -111,6 +168,8 @@ type MCPServerSpec struct {
 	MCPCatalogID string `json:"mcpCatalogID,omitempty"`
 	// MCPServerCatalogEntryName contains the name of the MCPServerCatalogEntry from which this MCP server was created, if there is one.
 	MCPServerCatalogEntryName string `json:"mcpServerCatalogEntryName,omitempty"`
+	// ConfigurationPreset is the name of the catalog entry preset this MCP server was created with, if there is one.
+	ConfigurationPreset string `json:"configurationPreset,omitempty"`
 	// NeedsURL indicates whether the server's URL needs to be updated to match the catalog entry.
 	NeedsURL bool `json:"needsURL,omitempty"`
 	// PreviousURL contains the URL of the server before it was updated to match the catalog entry.
@@ -124,6 +183,17 @@ type MCPServerSpec struct {
 	CompositeName string `json:"compositeName,omitempty"`
 	// NanobotAgentID is the name of the NanobotAgent that created this MCP server, if there is one.
 	NanobotAgentID string `json:"nanobotAgentID,omitempty"`
+	// ConnectAlias is a vanity hostname, optionally followed by a path, that the gateway routes to this server's connect URL.
+	// This may only be set for multi-user MCP servers.
+	ConnectAlias string `json:"connectAlias,omitempty"`
+	// ExternalExposure exposes this server directly on a custom hostname through objects generated by the Kubernetes
+	// backend. This may only be set for multi-user MCP servers.
+	ExternalExposure *types.MCPServerExternalExposure `json:"externalExposure,omitempty"`
+	// MaintenanceNotice is a planned outage of this server, set by an admin.
+	MaintenanceNotice *types.MCPMaintenanceNotice `json:"maintenanceNotice,omitempty"`
+	// PinnedCatalogEntryRevision is the revision of the catalog entry that this server is pinned to, if it is pinned.
+	// Unpinned servers follow the latest revision of their catalog entry.
+	PinnedCatalogEntryRevision int `json:"pinnedCatalogEntryRevision,omitempty"`
 }
 
 type MCPServerStatus struct {
@@ -159,6 +229,70 @@ type MCPServerStatus struct {
 	OAuthCredentialConfigured bool `json:"oauthCredentialConfigured,omitempty"`
 	// LastRequestTime is the time of the last request to the server, in 15 minute granularity.
 	LastRequestTime metav1.Time `json:"lastRequestTime,omitzero"`
+	// LastProbeTime is the time the liveness prober last checked the server's deployment.
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitzero"`
+	// LastHealthyTime is the last time the liveness prober found the server's deployment ready.
+	LastHealthyTime metav1.Time `json:"lastHealthyTime,omitzero"`
+	// ConsecutiveProbeFailures is the number of liveness probes that failed in a row since the server was last healthy.
+	ConsecutiveProbeFailures int `json:"consecutiveProbeFailures,omitempty"`
+	// RestartCount is the number of times the containers of the server's deployment restarted, as of the last liveness probe.
+	RestartCount int32 `json:"restartCount,omitempty"`
+	// DeploymentFailureReason is the reason that the server's deployment is failing permanently, such as CrashLoopBackOff,
+	// as of the last liveness probe.
+	DeploymentFailureReason string `json:"deploymentFailureReason,omitempty"`
+	// FailureTicketTime is when a ticket was created for the current permanent failure of the server's deployment.
+	FailureTicketTime metav1.Time `json:"failureTicketTime,omitzero"`
+	// CredentialStatus is whether the owner's stored OAuth token for this single-user server is expected to keep
+	// working, as of the last check. It is unset if the owner has no token for the server.
+	CredentialStatus types.MCPOAuthTokenStatus `json:"credentialStatus,omitempty"`
+	// CredentialWarning explains why the CredentialStatus isn't healthy.
+	CredentialWarning string `json:"credentialWarning,omitempty"`
+	// CredentialExpiry is when the owner's stored OAuth token for this server expires, if it can't be refreshed.
+	CredentialExpiry metav1.Time `json:"credentialExpiry,omitzero"`
+	// StaleSince is when this single-user server was flagged for not being used, and its owner notified.
+	StaleSince metav1.Time `json:"staleSince,omitzero"`
+	// StaleAction is what happens to this server at StaleActionTime if it is still not used by then.
+	StaleAction     types.MCPServerStaleAction `json:"staleAction,omitempty"`
+	StaleActionTime metav1.Time                `json:"staleActionTime,omitzero"`
+	// StaleShutdown indicates whether this server was shut down for being stale.
+	StaleShutdown bool `json:"staleShutdown,omitempty"`
+	// LastPrewarmTime is when this multi-user server was last deployed ahead of one of its usage peaks.
+	LastPrewarmTime metav1.Time `json:"lastPrewarmTime,omitzero"`
+	// MemoryUsageBytes is the size of the memories stored by this server, if it is an Obot-managed memory server, as of
+	// the last time it was measured.
+	MemoryUsageBytes int64 `json:"memoryUsageBytes,omitempty"`
+	// MemoryQuotaBytes is the maximum size of the memories of this memory server, or 0 if there is no limit.
+	MemoryQuotaBytes int64 `json:"memoryQuotaBytes,omitempty"`
+	// WorkspaceProjectName is the project that this server is bound to, if it is an Obot-managed workspace server. The
+	// server is bound to the first project that it is added to.
+	WorkspaceProjectName string `json:"workspaceProjectName,omitempty"`
+	// WorkspaceID is the ID of the workspace of the project that this workspace server is bound to.
+	WorkspaceID string `json:"workspaceID,omitempty"`
+	// WorkspaceUsageBytes is the size of the files of the project that this workspace server is bound to, as of the last
+	// time it was measured.
+	WorkspaceUsageBytes int64 `json:"workspaceUsageBytes,omitempty"`
+	// WorkspaceQuotaBytes is the maximum size of the files that this workspace server can write, or 0 if there is no
+	// limit.
+	WorkspaceQuotaBytes int64 `json:"workspaceQuotaBytes,omitempty"`
+	// MaintenanceNotice is the notice that applies to this server: its own, or else the one of its catalog entry.
+	// Expired notices are not included.
+	MaintenanceNotice *types.MCPMaintenanceNotice `json:"maintenanceNotice,omitempty"`
+	// Conditions contains the Ready, CredentialConfigured, DriftDetected, and K8sSettingsApplied conditions for this server.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ConnectBlockers are the reasons that clients can't connect to this server that the controller checks: its URL,
+	// OAuth credentials, deployment, and maintenance window. Its configuration is checked when it is read, because it
+	// depends on its credentials.
+	ConnectBlockers []types.MCPServerConnectBlocker `json:"connectBlockers,omitempty"`
+	// CatalogEvents is the state of this server that the last lifecycle events of its catalog were created for. It is
+	// nil until the server-created event is.
+	CatalogEvents *MCPServerCatalogEventState `json:"catalogEvents,omitempty"`
+}
+
+type MCPServerCatalogEventState struct {
+	// ManifestHash is the hash of the server's manifest, to detect when an update is applied to it.
+	ManifestHash string `json:"manifestHash,omitempty"`
+	// DeploymentStatus is the deployment status of the server, to detect when its health changes.
+	DeploymentStatus string `json:"deploymentStatus,omitempty"`
 }
 
 type DeploymentCondition struct {
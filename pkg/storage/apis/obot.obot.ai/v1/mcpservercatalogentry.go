@@ -3,6 +3,7 @@ package v1
 import (
 	"slices"
 
+	"github.com/gptscript-ai/gptscript/pkg/hash"
 	"github.com/obot-platform/nah/pkg/fields"
 	"github.com/obot-platform/obot/apiclient/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,14 +63,30 @@ func (in *MCPServerCatalogEntry) DeleteRefs() []Ref {
 	}
 }
 
+// ToolPreviewsHash returns a hash of the manifest without its tool previews, so that generating the tool previews
+// doesn't look like a change to the manifest.
+func (in *MCPServerCatalogEntry) ToolPreviewsHash() string {
+	manifest := in.Spec.Manifest
+	manifest.ToolPreview = nil
+	return hash.Digest(manifest)
+}
+
 type MCPServerCatalogEntrySpec struct {
 	Manifest         types.MCPServerCatalogEntryManifest `json:"manifest,omitempty"`
 	UnsupportedTools []string                            `json:"unsupportedTools,omitempty"`
 	MCPCatalogName   string                              `json:"mcpCatalogName,omitempty"`
 	Editable         bool                                `json:"editable,omitempty"`
 	SourceURL        string                              `json:"sourceURL,omitempty"`
+	// ImportedManifestHash is the hash of the manifest as it was last imported from an MCP registry, if the entry was
+	// imported from one. An entry whose manifest doesn't match this hash was edited in Obot.
+	ImportedManifestHash string `json:"importedManifestHash,omitempty"`
 	// PowerUserWorkspaceID contains the name of the PowerUserWorkspace that owns this catalog entry, if there is one.
 	PowerUserWorkspaceID string `json:"powerUserWorkspaceID,omitempty"`
+	// MaintenanceNotice is a planned outage of the servers created from this catalog entry, set by an admin.
+	MaintenanceNotice *types.MCPMaintenanceNotice `json:"maintenanceNotice,omitempty"`
+	// TeamCredentialGroupIDs are the auth provider groups that have a team credential for this catalog entry. The
+	// single-user servers of the members of these groups use the team credential when they aren't configured.
+	TeamCredentialGroupIDs []string `json:"teamCredentialGroupIDs,omitempty"`
 }
 
 type MCPServerCatalogEntryStatus struct {
@@ -79,13 +96,24 @@ type MCPServerCatalogEntryStatus struct {
 	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
 	// ToolPreviewsLastGenerated is the timestamp when the tool previews were last generated for this catalog entry.
 	ToolPreviewsLastGenerated *metav1.Time `json:"toolPreviewsLastGenerated,omitempty"`
+	// ToolPreviewsManifestHash is the ToolPreviewsHash of the manifest that the tool previews were last generated for.
+	ToolPreviewsManifestHash string `json:"toolPreviewsManifestHash,omitempty"`
+	// ToolPreviewsError is the error from the last automatic generation of the tool previews, if it failed.
+	ToolPreviewsError string `json:"toolPreviewsError,omitempty"`
 	// ManifestHash is a SHA256 hash of the catalog entry configuration used to detect changes.
 	ManifestHash string `json:"manifestHash,omitempty"`
+	// Revision is the number of the latest MCPServerCatalogEntryRevision of this catalog entry.
+	Revision int `json:"revision,omitempty"`
+	// PublishedEventRevision is the revision of this catalog entry that the last entry-published event of its catalog
+	// was created for.
+	PublishedEventRevision int `json:"publishedEventRevision,omitempty"`
 	// NeedsUpdate indicates whether this composite catalog entry's component snapshots have drifted from their sources.
 	NeedsUpdate bool `json:"needsUpdate,omitempty"`
 	// OAuthCredentialConfigured indicates whether OAuth credentials have been configured for this remote catalog entry.
 	// Only relevant when Runtime is "remote" and RemoteConfig.StaticOAuthRequired is true.
 	OAuthCredentialConfigured bool `json:"oauthCredentialConfigured,omitempty"`
+	// Conditions contains the Synced, CredentialConfigured, and DriftDetected conditions for this catalog entry.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
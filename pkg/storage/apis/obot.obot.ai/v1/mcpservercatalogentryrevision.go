@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ DeleteRefs    = (*MCPServerCatalogEntryRevision)(nil)
+	_ fields.Fields = (*MCPServerCatalogEntryRevision)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MCPServerCatalogEntryRevision is an immutable snapshot of the manifest of an MCPServerCatalogEntry.
+// A new revision is created each time the manifest of the catalog entry changes.
+type MCPServerCatalogEntryRevision struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPServerCatalogEntryRevisionSpec `json:"spec,omitempty"`
+	Status EmptyStatus                       `json:"status,omitempty"`
+}
+
+func (in *MCPServerCatalogEntryRevision) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Catalog Entry", "Spec.MCPServerCatalogEntryName"},
+		{"Revision", "Spec.Revision"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *MCPServerCatalogEntryRevision) Has(field string) bool {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *MCPServerCatalogEntryRevision) Get(field string) string {
+	switch field {
+	case "spec.mcpServerCatalogEntryName":
+		return in.Spec.MCPServerCatalogEntryName
+	}
+	return ""
+}
+
+func (in *MCPServerCatalogEntryRevision) FieldNames() []string {
+	return []string{"spec.mcpServerCatalogEntryName"}
+}
+
+func (in *MCPServerCatalogEntryRevision) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &MCPServerCatalogEntry{}, Name: in.Spec.MCPServerCatalogEntryName},
+	}
+}
+
+type MCPServerCatalogEntryRevisionSpec struct {
+	// MCPServerCatalogEntryName is the name of the catalog entry that this is a revision of.
+	MCPServerCatalogEntryName string `json:"mcpServerCatalogEntryName,omitempty"`
+	// Revision is the number of this revision. Revisions of a catalog entry are numbered from 1.
+	Revision int                                 `json:"revision,omitempty"`
+	Manifest types.MCPServerCatalogEntryManifest `json:"manifest,omitempty"`
+	// ManifestHash is the hash of the manifest, used to detect when the catalog entry changes.
+	ManifestHash string `json:"manifestHash,omitempty"`
+}
+
+// MCPServerCatalogEntryRevisionName returns the name of a revision of a catalog entry.
+func MCPServerCatalogEntryRevisionName(entryName string, revision int) string {
+	return name.SafeConcatName(entryName, fmt.Sprintf("r%d", revision))
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPServerCatalogEntryRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPServerCatalogEntryRevision `json:"items"`
+}
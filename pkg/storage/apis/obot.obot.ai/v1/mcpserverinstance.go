@@ -21,7 +21,8 @@ type MCPServerInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec MCPServerInstanceSpec `json:"spec,omitempty"`
+	Spec   MCPServerInstanceSpec   `json:"spec,omitempty"`
+	Status MCPServerInstanceStatus `json:"status,omitempty"`
 }
 
 func (in *MCPServerInstance) Has(field string) (exists bool) {
@@ -93,6 +94,16 @@ type MCPServerInstanceSpec struct {
 	CompositeName string `json:"compositeName,omitempty"`
 	// MultiUserConfig indicates the configuration required from the MCP server that this instance points to.
 	MultiUserConfig *types.MultiUserConfig `json:"multiUserConfig,omitempty"`
+	// ToolAllowlist contains the tool name patterns that the owner of this instance allowed. When it is set, only
+	// matching tools can be listed and called through this instance. The tool policy of the catalog entry still applies.
+	ToolAllowlist []string `json:"toolAllowlist,omitempty"`
+}
+
+type MCPServerInstanceStatus struct {
+	// Conditions contains the Ready and Synced conditions for this MCP server instance.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ConnectBlockers are the connect blockers of the MCP server that this instance points to.
+	ConnectBlockers []types.MCPServerConnectBlocker `json:"connectBlockers,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -28,6 +28,10 @@ func (in *OAuthAuthRequest) Get(field string) string {
 		switch field {
 		case "spec.hashedAuthCode":
 			return in.Spec.HashedAuthCode
+		case "spec.hashedDeviceCode":
+			return in.Spec.HashedDeviceCode
+		case "spec.hashedUserCode":
+			return in.Spec.HashedUserCode
 		}
 	}
 
@@ -35,7 +39,7 @@ func (in *OAuthAuthRequest) Get(field string) string {
 }
 
 func (in *OAuthAuthRequest) FieldNames() []string {
-	return []string{"spec.hashedAuthCode"}
+	return []string{"spec.hashedAuthCode", "spec.hashedDeviceCode", "spec.hashedUserCode"}
 }
 
 func (in *OAuthAuthRequest) DeleteRefs() []Ref {
@@ -59,6 +63,18 @@ type OAuthAuthRequestSpec struct {
 	AuthProviderUserID    string `json:"authProviderUserID"`
 	AuthProviderNamespace string `json:"authProviderNamespace"`
 	AuthProviderName      string `json:"authProviderName"`
+	// Nonce is the OpenID Connect nonce of the request, which is returned in the ID token.
+	Nonce string `json:"nonce,omitempty"`
+
+	// HashedDeviceCode and HashedUserCode identify the requests of the device authorization grant. The client polls
+	// the token endpoint with the device code while the user enters the user code in a browser.
+	HashedDeviceCode string `json:"hashedDeviceCode,omitempty"`
+	HashedUserCode   string `json:"hashedUserCode,omitempty"`
+	// DeviceApproved and DeviceDenied record the user's decision on a device authorization request.
+	DeviceApproved bool `json:"deviceApproved,omitempty"`
+	DeviceDenied   bool `json:"deviceDenied,omitempty"`
+	// LastPollTime is when the client last polled the token endpoint for a device authorization request.
+	LastPollTime metav1.Time `json:"lastPollTime,omitzero"`
 }
 
 type OAuthAuthRequestStatus struct {
@@ -1,8 +1,14 @@
 package v1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"github.com/obot-platform/nah/pkg/fields"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
-var _ DeleteRefs = (*OAuthToken)(nil)
+var (
+	_ DeleteRefs    = (*OAuthToken)(nil)
+	_ fields.Fields = (*OAuthToken)(nil)
+)
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -13,6 +19,25 @@ type OAuthToken struct {
 	Status            OAuthTokenStatus `json:"status"`
 }
 
+func (in *OAuthToken) Has(field string) bool {
+	return in.Get(field) != ""
+}
+
+func (in *OAuthToken) Get(field string) string {
+	if in != nil {
+		switch field {
+		case "spec.grantID":
+			return in.Spec.GrantID
+		}
+	}
+
+	return ""
+}
+
+func (in *OAuthToken) FieldNames() []string {
+	return []string{"spec.grantID"}
+}
+
 func (in *OAuthToken) DeleteRefs() []Ref {
 	return []Ref{
 		{ObjType: new(OAuthClient), Name: in.Spec.ClientID},
@@ -28,6 +53,9 @@ type OAuthTokenSpec struct {
 	AuthProviderUserID    string `json:"authProviderUserID"`
 	AuthProviderName      string `json:"authProviderName"`
 	AuthProviderNamespace string `json:"authProviderNamespace"`
+	// GrantID identifies the authorization that the token was issued for. It is kept when the token is refreshed, so
+	// that revoking a token revokes every token issued for the same authorization.
+	GrantID string `json:"grantID,omitempty"`
 }
 
 type OAuthTokenStatus struct{}
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ DeleteRefs    = (*PendingCatalogEntry)(nil)
+	_ fields.Fields = (*PendingCatalogEntry)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PendingCatalogEntry is a catalog entry, or an edit to one, that a user proposed for a catalog. Admins review it, and
+// approving it creates or updates the MCPServerCatalogEntry.
+type PendingCatalogEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PendingCatalogEntrySpec   `json:"spec,omitempty"`
+	Status PendingCatalogEntryStatus `json:"status,omitempty"`
+}
+
+func (in *PendingCatalogEntry) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Display Name", "Spec.Manifest.Manifest.Name"},
+		{"Catalog", "Spec.Manifest.CatalogID"},
+		{"Entry", "Spec.Manifest.CatalogEntryID"},
+		{"User", "Spec.UserID"},
+		{"State", "Status.State"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *PendingCatalogEntry) Has(field string) bool {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *PendingCatalogEntry) Get(field string) string {
+	switch field {
+	case "spec.userID":
+		return in.Spec.UserID
+	case "spec.manifest.catalogID":
+		return in.Spec.Manifest.CatalogID
+	}
+	return ""
+}
+
+func (in *PendingCatalogEntry) FieldNames() []string {
+	return []string{"spec.userID", "spec.manifest.catalogID"}
+}
+
+func (in *PendingCatalogEntry) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &MCPCatalog{}, Name: in.Spec.Manifest.CatalogID},
+	}
+}
+
+type PendingCatalogEntrySpec struct {
+	Manifest types.PendingCatalogEntryManifest `json:"manifest"`
+	// UserID is the ID of the user who submitted the entry.
+	UserID string `json:"userID,omitempty"`
+	// Vendor is the vendor that submitted the entry through the submission portal of the catalog, instead of a user.
+	Vendor *types.PendingCatalogEntryVendor `json:"vendor,omitempty"`
+	// StatusTokenHash is the SHA-256 hash of the token that the vendor gets the status of the submission with.
+	StatusTokenHash string `json:"statusTokenHash,omitempty"`
+}
+
+type PendingCatalogEntryStatus struct {
+	// State is empty until the entry is approved or rejected.
+	State    types.PendingCatalogEntryState     `json:"state,omitempty"`
+	Comments []types.PendingCatalogEntryComment `json:"comments,omitempty"`
+	// ReviewerID is the ID of the admin who approved or rejected the entry.
+	ReviewerID string      `json:"reviewerID,omitempty"`
+	ReviewedAt metav1.Time `json:"reviewedAt,omitzero"`
+	// ApprovedCatalogEntryName is the catalog entry that was created or updated when the entry was approved.
+	ApprovedCatalogEntryName string `json:"approvedCatalogEntryName,omitempty"`
+	// Checks are the results of the automatic checks of entries submitted through the submission portal.
+	Checks *types.PendingCatalogEntryChecks `json:"checks,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PendingCatalogEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PendingCatalogEntry `json:"items"`
+}
@@ -137,6 +137,16 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&PublishedArtifactList{},
 		&OktaGroupMigration{},
 		&OktaGroupMigrationList{},
+		&AlertRule{},
+		&AlertRuleList{},
+		&MCPCatalogEventWebhook{},
+		&MCPCatalogEventWebhookList{},
+		&MCPCatalogEvent{},
+		&MCPCatalogEventList{},
+		&MCPServerCatalogEntryRevision{},
+		&MCPServerCatalogEntryRevisionList{},
+		&PendingCatalogEntry{},
+		&PendingCatalogEntryList{},
 	); err != nil {
 		return err
 	}
@@ -254,6 +254,22 @@ type ThreadSpec struct {
 	// The wildcard "*" will allow all tools.
 	// A trailing wildcard will allow all tools with the matching prefix; e.g. "Everything Server ->*".
 	ApprovedTools []string `json:"approvedTools,omitempty"`
+
+	// ToolApprovals records who approved the tools selected for each of the project's MCP servers and when.
+	// It is keyed the same way as the AllowedMCPTools of the manifest.
+	ToolApprovals map[string]ToolApproval `json:"toolApprovals,omitempty"`
+}
+
+// ToolApproval is the approval of the tools selected for an MCP server in a project.
+type ToolApproval struct {
+	// ApprovedBy is the ID of the user that last approved the tools.
+	ApprovedBy string `json:"approvedBy,omitempty"`
+	// ApprovedAt is when the tools were last approved.
+	ApprovedAt metav1.Time `json:"approvedAt,omitempty"`
+	// Expired indicates that the approval lapsed and the tools were revoked until they are re-certified.
+	Expired bool `json:"expired,omitempty"`
+	// ExpiredTools are the tools that were selected when the approval expired, restored when it is re-certified.
+	ExpiredTools []string `json:"expiredTools,omitempty"`
 }
 
 func (in *Thread) DeleteRefs() []Ref {
@@ -338,6 +354,10 @@ type ThreadStatus struct {
 	// LastUpgraded is a timestamp corresponding to the last time the thread was last successfully
 	// upgraded from the source thread.
 	LastUpgraded metav1.Time `json:"lastUpgraded,omitempty"`
+
+	// ToolRecertificationRequired indicates that the tool approvals of one or more of the project's MCP servers
+	// have expired or are about to expire.
+	ToolRecertificationRequired bool `json:"toolRecertificationRequired,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -204,6 +204,104 @@ func (in *AgentStatus) DeepCopy() *AgentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRule) DeepCopyInto(out *AlertRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRule.
+func (in *AlertRule) DeepCopy() *AlertRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleList) DeepCopyInto(out *AlertRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleList.
+func (in *AlertRuleList) DeepCopy() *AlertRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleSpec) DeepCopyInto(out *AlertRuleSpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleSpec.
+func (in *AlertRuleSpec) DeepCopy() *AlertRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleStatus) DeepCopyInto(out *AlertRuleStatus) {
+	*out = *in
+	in.LastEvaluatedAt.DeepCopyInto(&out.LastEvaluatedAt)
+	if in.Firing != nil {
+		in, out := &in.Firing, &out.Firing
+		*out = make([]types.AlertRuleFiring, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleStatus.
+func (in *AlertRuleStatus) DeepCopy() *AlertRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alias) DeepCopyInto(out *Alias) {
 	*out = *in
@@ -1361,6 +1459,192 @@ func (in *MCPCatalog) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEvent) DeepCopyInto(out *MCPCatalogEvent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEvent.
+func (in *MCPCatalogEvent) DeepCopy() *MCPCatalogEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPCatalogEvent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventList) DeepCopyInto(out *MCPCatalogEventList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPCatalogEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventList.
+func (in *MCPCatalogEventList) DeepCopy() *MCPCatalogEventList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEventList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPCatalogEventList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventSpec) DeepCopyInto(out *MCPCatalogEventSpec) {
+	*out = *in
+	in.Event.DeepCopyInto(&out.Event)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventSpec.
+func (in *MCPCatalogEventSpec) DeepCopy() *MCPCatalogEventSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEventSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventStatus) DeepCopyInto(out *MCPCatalogEventStatus) {
+	*out = *in
+	if in.Delivered != nil {
+		in, out := &in.Delivered, &out.Delivered
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventStatus.
+func (in *MCPCatalogEventStatus) DeepCopy() *MCPCatalogEventStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEventStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventWebhook) DeepCopyInto(out *MCPCatalogEventWebhook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventWebhook.
+func (in *MCPCatalogEventWebhook) DeepCopy() *MCPCatalogEventWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEventWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPCatalogEventWebhook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventWebhookList) DeepCopyInto(out *MCPCatalogEventWebhookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPCatalogEventWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventWebhookList.
+func (in *MCPCatalogEventWebhookList) DeepCopy() *MCPCatalogEventWebhookList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEventWebhookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPCatalogEventWebhookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventWebhookSpec) DeepCopyInto(out *MCPCatalogEventWebhookSpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventWebhookSpec.
+func (in *MCPCatalogEventWebhookSpec) DeepCopy() *MCPCatalogEventWebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEventWebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogEventWebhookStatus) DeepCopyInto(out *MCPCatalogEventWebhookStatus) {
+	*out = *in
+	in.LastDeliveredAt.DeepCopyInto(&out.LastDeliveredAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogEventWebhookStatus.
+func (in *MCPCatalogEventWebhookStatus) DeepCopy() *MCPCatalogEventWebhookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogEventWebhookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPCatalogList) DeepCopyInto(out *MCPCatalogList) {
 	*out = *in
@@ -1424,6 +1708,11 @@ func (in *MCPCatalogStatus) DeepCopyInto(out *MCPCatalogStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.SyncConflicts != nil {
+		in, out := &in.SyncConflicts, &out.SyncConflicts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogStatus.
@@ -1623,6 +1912,81 @@ func (in *MCPServerCatalogEntryList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryRevision) DeepCopyInto(out *MCPServerCatalogEntryRevision) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryRevision.
+func (in *MCPServerCatalogEntryRevision) DeepCopy() *MCPServerCatalogEntryRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerCatalogEntryRevision) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryRevisionList) DeepCopyInto(out *MCPServerCatalogEntryRevisionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerCatalogEntryRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryRevisionList.
+func (in *MCPServerCatalogEntryRevisionList) DeepCopy() *MCPServerCatalogEntryRevisionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryRevisionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerCatalogEntryRevisionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryRevisionSpec) DeepCopyInto(out *MCPServerCatalogEntryRevisionSpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryRevisionSpec.
+func (in *MCPServerCatalogEntryRevisionSpec) DeepCopy() *MCPServerCatalogEntryRevisionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryRevisionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPServerCatalogEntrySpec) DeepCopyInto(out *MCPServerCatalogEntrySpec) {
 	*out = *in
@@ -1632,6 +1996,16 @@ func (in *MCPServerCatalogEntrySpec) DeepCopyInto(out *MCPServerCatalogEntrySpec
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaintenanceNotice != nil {
+		in, out := &in.MaintenanceNotice, &out.MaintenanceNotice
+		*out = new(types.MCPMaintenanceNotice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamCredentialGroupIDs != nil {
+		in, out := &in.TeamCredentialGroupIDs, &out.TeamCredentialGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntrySpec.
@@ -1655,6 +2029,13 @@ func (in *MCPServerCatalogEntryStatus) DeepCopyInto(out *MCPServerCatalogEntrySt
 		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryStatus.
@@ -1667,12 +2048,28 @@ func (in *MCPServerCatalogEntryStatus) DeepCopy() *MCPServerCatalogEntryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEventState) DeepCopyInto(out *MCPServerCatalogEventState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEventState.
+func (in *MCPServerCatalogEventState) DeepCopy() *MCPServerCatalogEventState {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEventState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstance.
@@ -1733,6 +2130,11 @@ func (in *MCPServerInstanceSpec) DeepCopyInto(out *MCPServerInstanceSpec) {
 		*out = new(types.MultiUserConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ToolAllowlist != nil {
+		in, out := &in.ToolAllowlist, &out.ToolAllowlist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceSpec.
@@ -1745,6 +2147,33 @@ func (in *MCPServerInstanceSpec) DeepCopy() *MCPServerInstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstanceStatus) DeepCopyInto(out *MCPServerInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectBlockers != nil {
+		in, out := &in.ConnectBlockers, &out.ConnectBlockers
+		*out = make([]types.MCPServerConnectBlocker, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceStatus.
+func (in *MCPServerInstanceStatus) DeepCopy() *MCPServerInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
 	*out = *in
@@ -1786,6 +2215,16 @@ func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExternalExposure != nil {
+		in, out := &in.ExternalExposure, &out.ExternalExposure
+		*out = new(types.MCPServerExternalExposure)
+		**out = **in
+	}
+	if in.MaintenanceNotice != nil {
+		in, out := &in.MaintenanceNotice, &out.MaintenanceNotice
+		*out = new(types.MCPMaintenanceNotice)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
@@ -1829,6 +2268,35 @@ func (in *MCPServerStatus) DeepCopyInto(out *MCPServerStatus) {
 		}
 	}
 	in.LastRequestTime.DeepCopyInto(&out.LastRequestTime)
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastHealthyTime.DeepCopyInto(&out.LastHealthyTime)
+	in.FailureTicketTime.DeepCopyInto(&out.FailureTicketTime)
+	in.CredentialExpiry.DeepCopyInto(&out.CredentialExpiry)
+	in.StaleSince.DeepCopyInto(&out.StaleSince)
+	in.StaleActionTime.DeepCopyInto(&out.StaleActionTime)
+	in.LastPrewarmTime.DeepCopyInto(&out.LastPrewarmTime)
+	if in.MaintenanceNotice != nil {
+		in, out := &in.MaintenanceNotice, &out.MaintenanceNotice
+		*out = new(types.MCPMaintenanceNotice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectBlockers != nil {
+		in, out := &in.ConnectBlockers, &out.ConnectBlockers
+		*out = make([]types.MCPServerConnectBlocker, len(*in))
+		copy(*out, *in)
+	}
+	if in.CatalogEvents != nil {
+		in, out := &in.CatalogEvents, &out.CatalogEvents
+		*out = new(MCPServerCatalogEventState)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerStatus.
@@ -2642,7 +3110,7 @@ func (in *OAuthAuthRequest) DeepCopyInto(out *OAuthAuthRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -2699,6 +3167,7 @@ func (in *OAuthAuthRequestList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuthAuthRequestSpec) DeepCopyInto(out *OAuthAuthRequestSpec) {
 	*out = *in
+	in.LastPollTime.DeepCopyInto(&out.LastPollTime)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuthAuthRequestSpec.
@@ -3007,6 +3476,114 @@ func (in *OktaGroupMigrationSpec) DeepCopy() *OktaGroupMigrationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntry) DeepCopyInto(out *PendingCatalogEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntry.
+func (in *PendingCatalogEntry) DeepCopy() *PendingCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PendingCatalogEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryList) DeepCopyInto(out *PendingCatalogEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PendingCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryList.
+func (in *PendingCatalogEntryList) DeepCopy() *PendingCatalogEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PendingCatalogEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntrySpec) DeepCopyInto(out *PendingCatalogEntrySpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.Vendor != nil {
+		in, out := &in.Vendor, &out.Vendor
+		*out = new(types.PendingCatalogEntryVendor)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntrySpec.
+func (in *PendingCatalogEntrySpec) DeepCopy() *PendingCatalogEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingCatalogEntryStatus) DeepCopyInto(out *PendingCatalogEntryStatus) {
+	*out = *in
+	if in.Comments != nil {
+		in, out := &in.Comments, &out.Comments
+		*out = make([]types.PendingCatalogEntryComment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ReviewedAt.DeepCopyInto(&out.ReviewedAt)
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = new(types.PendingCatalogEntryChecks)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingCatalogEntryStatus.
+func (in *PendingCatalogEntryStatus) DeepCopy() *PendingCatalogEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingCatalogEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSecurityAdmissionSettings) DeepCopyInto(out *PodSecurityAdmissionSettings) {
 	*out = *in
@@ -4703,6 +5280,13 @@ func (in *ThreadSpec) DeepCopyInto(out *ThreadSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ToolApprovals != nil {
+		in, out := &in.ToolApprovals, &out.ToolApprovals
+		*out = make(map[string]ToolApproval, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadSpec.
@@ -4770,6 +5354,27 @@ func (in *Tool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolApproval) DeepCopyInto(out *ToolApproval) {
+	*out = *in
+	in.ApprovedAt.DeepCopyInto(&out.ApprovedAt)
+	if in.ExpiredTools != nil {
+		in, out := &in.ExpiredTools, &out.ExpiredTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolApproval.
+func (in *ToolApproval) DeepCopy() *ToolApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ToolList) DeepCopyInto(out *ToolList) {
 	*out = *in
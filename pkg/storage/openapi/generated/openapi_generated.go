@@ -25,6 +25,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.AgentIcons":                                         schema_obot_platform_obot_apiclient_types_AgentIcons(ref),
 		"github.com/obot-platform/obot/apiclient/types.AgentList":                                          schema_obot_platform_obot_apiclient_types_AgentList(ref),
 		"github.com/obot-platform/obot/apiclient/types.AgentManifest":                                      schema_obot_platform_obot_apiclient_types_AgentManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertEvent":                                         schema_obot_platform_obot_apiclient_types_AlertEvent(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertNotification":                                  schema_obot_platform_obot_apiclient_types_AlertNotification(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertRule":                                          schema_obot_platform_obot_apiclient_types_AlertRule(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertRuleActiveHours":                               schema_obot_platform_obot_apiclient_types_AlertRuleActiveHours(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertRuleFiring":                                    schema_obot_platform_obot_apiclient_types_AlertRuleFiring(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertRuleList":                                      schema_obot_platform_obot_apiclient_types_AlertRuleList(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertRuleManifest":                                  schema_obot_platform_obot_apiclient_types_AlertRuleManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.AlertRuleNotification":                              schema_obot_platform_obot_apiclient_types_AlertRuleNotification(ref),
 		"github.com/obot-platform/obot/apiclient/types.AppPreferences":                                     schema_obot_platform_obot_apiclient_types_AppPreferences(ref),
 		"github.com/obot-platform/obot/apiclient/types.Assistant":                                          schema_obot_platform_obot_apiclient_types_Assistant(ref),
 		"github.com/obot-platform/obot/apiclient/types.AssistantList":                                      schema_obot_platform_obot_apiclient_types_AssistantList(ref),
@@ -39,20 +47,40 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.AuthProviderManifest":                               schema_obot_platform_obot_apiclient_types_AuthProviderManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.AuthProviderStatus":                                 schema_obot_platform_obot_apiclient_types_AuthProviderStatus(ref),
 		"github.com/obot-platform/obot/apiclient/types.AzureConfig":                                        schema_obot_platform_obot_apiclient_types_AzureConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.BackstageEntity":                                    schema_obot_platform_obot_apiclient_types_BackstageEntity(ref),
+		"github.com/obot-platform/obot/apiclient/types.BackstageEntityList":                                schema_obot_platform_obot_apiclient_types_BackstageEntityList(ref),
+		"github.com/obot-platform/obot/apiclient/types.BackstageEntityMetadata":                            schema_obot_platform_obot_apiclient_types_BackstageEntityMetadata(ref),
+		"github.com/obot-platform/obot/apiclient/types.BackstageEntitySpec":                                schema_obot_platform_obot_apiclient_types_BackstageEntitySpec(ref),
+		"github.com/obot-platform/obot/apiclient/types.BackstageLink":                                      schema_obot_platform_obot_apiclient_types_BackstageLink(ref),
 		"github.com/obot-platform/obot/apiclient/types.CatalogComponentServer":                             schema_obot_platform_obot_apiclient_types_CatalogComponentServer(ref),
 		"github.com/obot-platform/obot/apiclient/types.ClientInfo":                                         schema_obot_platform_obot_apiclient_types_ClientInfo(ref),
 		"github.com/obot-platform/obot/apiclient/types.CommonProviderMetadata":                             schema_obot_platform_obot_apiclient_types_CommonProviderMetadata(ref),
 		"github.com/obot-platform/obot/apiclient/types.CommonProviderStatus":                               schema_obot_platform_obot_apiclient_types_CommonProviderStatus(ref),
 		"github.com/obot-platform/obot/apiclient/types.ComponentServer":                                    schema_obot_platform_obot_apiclient_types_ComponentServer(ref),
 		"github.com/obot-platform/obot/apiclient/types.CompositeCatalogConfig":                             schema_obot_platform_obot_apiclient_types_CompositeCatalogConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.CompositeComponentsPatch":                           schema_obot_platform_obot_apiclient_types_CompositeComponentsPatch(ref),
 		"github.com/obot-platform/obot/apiclient/types.CompositeRuntimeConfig":                             schema_obot_platform_obot_apiclient_types_CompositeRuntimeConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.CompositeToolConflict":                              schema_obot_platform_obot_apiclient_types_CompositeToolConflict(ref),
+		"github.com/obot-platform/obot/apiclient/types.CompositeToolConflictTool":                          schema_obot_platform_obot_apiclient_types_CompositeToolConflictTool(ref),
+		"github.com/obot-platform/obot/apiclient/types.CompositeToolConflicts":                             schema_obot_platform_obot_apiclient_types_CompositeToolConflicts(ref),
+		"github.com/obot-platform/obot/apiclient/types.Condition":                                          schema_obot_platform_obot_apiclient_types_Condition(ref),
 		"github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig":                         schema_obot_platform_obot_apiclient_types_ContainerizedRuntimeConfig(ref),
 		"github.com/obot-platform/obot/apiclient/types.Credential":                                         schema_obot_platform_obot_apiclient_types_Credential(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialBackup":                                   schema_obot_platform_obot_apiclient_types_CredentialBackup(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialBackupEntry":                              schema_obot_platform_obot_apiclient_types_CredentialBackupEntry(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialBackupRequest":                            schema_obot_platform_obot_apiclient_types_CredentialBackupRequest(ref),
 		"github.com/obot-platform/obot/apiclient/types.CredentialList":                                     schema_obot_platform_obot_apiclient_types_CredentialList(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialRestoreEntry":                             schema_obot_platform_obot_apiclient_types_CredentialRestoreEntry(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialRestoreRequest":                           schema_obot_platform_obot_apiclient_types_CredentialRestoreRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialRestoreResult":                            schema_obot_platform_obot_apiclient_types_CredentialRestoreResult(ref),
+		"github.com/obot-platform/obot/apiclient/types.CredentialRestoreResultList":                        schema_obot_platform_obot_apiclient_types_CredentialRestoreResultList(ref),
 		"github.com/obot-platform/obot/apiclient/types.CronJob":                                            schema_obot_platform_obot_apiclient_types_CronJob(ref),
 		"github.com/obot-platform/obot/apiclient/types.CronJobList":                                        schema_obot_platform_obot_apiclient_types_CronJobList(ref),
 		"github.com/obot-platform/obot/apiclient/types.CronJobManifest":                                    schema_obot_platform_obot_apiclient_types_CronJobManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.CustomS3Config":                                     schema_obot_platform_obot_apiclient_types_CustomS3Config(ref),
+		"github.com/obot-platform/obot/apiclient/types.DatabaseChange":                                     schema_obot_platform_obot_apiclient_types_DatabaseChange(ref),
+		"github.com/obot-platform/obot/apiclient/types.DatabaseMigration":                                  schema_obot_platform_obot_apiclient_types_DatabaseMigration(ref),
+		"github.com/obot-platform/obot/apiclient/types.DatabaseMigrationStatus":                            schema_obot_platform_obot_apiclient_types_DatabaseMigrationStatus(ref),
 		"github.com/obot-platform/obot/apiclient/types.DefaultModelAlias":                                  schema_obot_platform_obot_apiclient_types_DefaultModelAlias(ref),
 		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasList":                              schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref),
 		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest":                          schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref),
@@ -100,6 +128,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.Item":                                               schema_obot_platform_obot_apiclient_types_Item(ref),
 		"github.com/obot-platform/obot/apiclient/types.K8sSettings":                                        schema_obot_platform_obot_apiclient_types_K8sSettings(ref),
 		"github.com/obot-platform/obot/apiclient/types.K8sSettingsStatus":                                  schema_obot_platform_obot_apiclient_types_K8sSettingsStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.KeyRotation":                                        schema_obot_platform_obot_apiclient_types_KeyRotation(ref),
+		"github.com/obot-platform/obot/apiclient/types.KeyRotationResource":                                schema_obot_platform_obot_apiclient_types_KeyRotationResource(ref),
 		"github.com/obot-platform/obot/apiclient/types.KnowledgeFile":                                      schema_obot_platform_obot_apiclient_types_KnowledgeFile(ref),
 		"github.com/obot-platform/obot/apiclient/types.KnowledgeFileList":                                  schema_obot_platform_obot_apiclient_types_KnowledgeFileList(ref),
 		"github.com/obot-platform/obot/apiclient/types.KnowledgeSource":                                    schema_obot_platform_obot_apiclient_types_KnowledgeSource(ref),
@@ -112,34 +142,122 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.MCPAuditLogResponse":                                schema_obot_platform_obot_apiclient_types_MCPAuditLogResponse(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPCapacityInfo":                                    schema_obot_platform_obot_apiclient_types_MCPCapacityInfo(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPCatalog":                                         schema_obot_platform_obot_apiclient_types_MCPCatalog(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundle":                                   schema_obot_platform_obot_apiclient_types_MCPCatalogBundle(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleAccessControlRule":                  schema_obot_platform_obot_apiclient_types_MCPCatalogBundleAccessControlRule(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleCatalog":                            schema_obot_platform_obot_apiclient_types_MCPCatalogBundleCatalog(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleChange":                             schema_obot_platform_obot_apiclient_types_MCPCatalogBundleChange(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleEntry":                              schema_obot_platform_obot_apiclient_types_MCPCatalogBundleEntry(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleImportResult":                       schema_obot_platform_obot_apiclient_types_MCPCatalogBundleImportResult(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleResource":                           schema_obot_platform_obot_apiclient_types_MCPCatalogBundleResource(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleServer":                             schema_obot_platform_obot_apiclient_types_MCPCatalogBundleServer(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogEvent":                                    schema_obot_platform_obot_apiclient_types_MCPCatalogEvent(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogEventWebhook":                             schema_obot_platform_obot_apiclient_types_MCPCatalogEventWebhook(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogEventWebhookList":                         schema_obot_platform_obot_apiclient_types_MCPCatalogEventWebhookList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogEventWebhookManifest":                     schema_obot_platform_obot_apiclient_types_MCPCatalogEventWebhookManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPCatalogList":                                     schema_obot_platform_obot_apiclient_types_MCPCatalogList(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPCatalogManifest":                                 schema_obot_platform_obot_apiclient_types_MCPCatalogManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogSubmission":                               schema_obot_platform_obot_apiclient_types_MCPCatalogSubmission(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogSubmissionPortal":                         schema_obot_platform_obot_apiclient_types_MCPCatalogSubmissionPortal(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogSubmissionPortalManifest":                 schema_obot_platform_obot_apiclient_types_MCPCatalogSubmissionPortalManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogSubmissionRequest":                        schema_obot_platform_obot_apiclient_types_MCPCatalogSubmissionRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCatalogWebhook":                                  schema_obot_platform_obot_apiclient_types_MCPCatalogWebhook(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCompletionArgument":                              schema_obot_platform_obot_apiclient_types_MCPCompletionArgument(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCompletionReference":                             schema_obot_platform_obot_apiclient_types_MCPCompletionReference(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCompletionRequest":                               schema_obot_platform_obot_apiclient_types_MCPCompletionRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCompletionResult":                                schema_obot_platform_obot_apiclient_types_MCPCompletionResult(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPComponentServerConfig":                           schema_obot_platform_obot_apiclient_types_MCPComponentServerConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPComponentServerDetails":                          schema_obot_platform_obot_apiclient_types_MCPComponentServerDetails(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCompositeComponentFailure":                       schema_obot_platform_obot_apiclient_types_MCPCompositeComponentFailure(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPCompositeLaunchResult":                           schema_obot_platform_obot_apiclient_types_MCPCompositeLaunchResult(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPConfigurationPreset":                             schema_obot_platform_obot_apiclient_types_MCPConfigurationPreset(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPConnectSession":                                  schema_obot_platform_obot_apiclient_types_MCPConnectSession(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPConnectSessionList":                              schema_obot_platform_obot_apiclient_types_MCPConnectSessionList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPConnectSettings":                                 schema_obot_platform_obot_apiclient_types_MCPConnectSettings(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPElicitation":                                     schema_obot_platform_obot_apiclient_types_MCPElicitation(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPElicitationResponse":                             schema_obot_platform_obot_apiclient_types_MCPElicitationResponse(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPEnv":                                             schema_obot_platform_obot_apiclient_types_MCPEnv(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPErrorRateStat":                                   schema_obot_platform_obot_apiclient_types_MCPErrorRateStat(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPErrorRateStats":                                  schema_obot_platform_obot_apiclient_types_MCPErrorRateStats(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPHeader":                                          schema_obot_platform_obot_apiclient_types_MCPHeader(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPLogSettings":                                     schema_obot_platform_obot_apiclient_types_MCPLogSettings(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice":                               schema_obot_platform_obot_apiclient_types_MCPMaintenanceNotice(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPManifestChange":                                  schema_obot_platform_obot_apiclient_types_MCPManifestChange(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthFailure":                                    schema_obot_platform_obot_apiclient_types_MCPOAuthFailure(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthOverview":                                   schema_obot_platform_obot_apiclient_types_MCPOAuthOverview(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthScopeChange":                                schema_obot_platform_obot_apiclient_types_MCPOAuthScopeChange(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthScopeGroup":                                 schema_obot_platform_obot_apiclient_types_MCPOAuthScopeGroup(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthTelemetry":                                  schema_obot_platform_obot_apiclient_types_MCPOAuthTelemetry(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthTelemetryReport":                            schema_obot_platform_obot_apiclient_types_MCPOAuthTelemetryReport(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenHealth":                                schema_obot_platform_obot_apiclient_types_MCPOAuthTokenHealth(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSource":                                schema_obot_platform_obot_apiclient_types_MCPOAuthTokenSource(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSourceStatus":                          schema_obot_platform_obot_apiclient_types_MCPOAuthTokenSourceStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSourceStatusList":                      schema_obot_platform_obot_apiclient_types_MCPOAuthTokenSourceStatusList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPProjectUsage":                                    schema_obot_platform_obot_apiclient_types_MCPProjectUsage(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPPromptReadStats":                                 schema_obot_platform_obot_apiclient_types_MCPPromptReadStats(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPRequestTimeouts":                                 schema_obot_platform_obot_apiclient_types_MCPRequestTimeouts(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPResourceReadStats":                               schema_obot_platform_obot_apiclient_types_MCPResourceReadStats(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPResourceRequests":                                schema_obot_platform_obot_apiclient_types_MCPResourceRequests(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPResourceUpdate":                                  schema_obot_platform_obot_apiclient_types_MCPResourceUpdate(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPRoot":                                            schema_obot_platform_obot_apiclient_types_MCPRoot(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPRootList":                                        schema_obot_platform_obot_apiclient_types_MCPRootList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPSamplingConfig":                                  schema_obot_platform_obot_apiclient_types_MCPSamplingConfig(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPSelector":                                        schema_obot_platform_obot_apiclient_types_MCPSelector(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServer":                                          schema_obot_platform_obot_apiclient_types_MCPServer(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerBulkRequest":                               schema_obot_platform_obot_apiclient_types_MCPServerBulkRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerBulkResponse":                              schema_obot_platform_obot_apiclient_types_MCPServerBulkResponse(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerBulkResult":                                schema_obot_platform_obot_apiclient_types_MCPServerBulkResult(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntry":                              schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntry(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryDryRunResult":                  schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryDryRunResult(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryFacets":                        schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryFacets(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryList":                          schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryList(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest":                      schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryRevision":                      schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryRevision(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryRevisionList":                  schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryRevisionList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationIssue":               schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryValidationIssue(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationRequest":             schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryValidationRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationResult":              schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryValidationResult(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerCloneRequest":                              schema_obot_platform_obot_apiclient_types_MCPServerCloneRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerConnectAliasRequest":                       schema_obot_platform_obot_apiclient_types_MCPServerConnectAliasRequest(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerDetails":                                   schema_obot_platform_obot_apiclient_types_MCPServerDetails(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerEvent":                                     schema_obot_platform_obot_apiclient_types_MCPServerEvent(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposure":                          schema_obot_platform_obot_apiclient_types_MCPServerExternalExposure(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposureRequest":                   schema_obot_platform_obot_apiclient_types_MCPServerExternalExposureRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerFailureReport":                             schema_obot_platform_obot_apiclient_types_MCPServerFailureReport(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerInstance":                                  schema_obot_platform_obot_apiclient_types_MCPServerInstance(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerInstanceList":                              schema_obot_platform_obot_apiclient_types_MCPServerInstanceList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerInstanceOAuthState":                        schema_obot_platform_obot_apiclient_types_MCPServerInstanceOAuthState(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerInstanceToolAllowlist":                     schema_obot_platform_obot_apiclient_types_MCPServerInstanceToolAllowlist(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerLaunchStatus":                              schema_obot_platform_obot_apiclient_types_MCPServerLaunchStatus(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerList":                                      schema_obot_platform_obot_apiclient_types_MCPServerList(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerManifest":                                  schema_obot_platform_obot_apiclient_types_MCPServerManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerNeedingK8sUpdate":                          schema_obot_platform_obot_apiclient_types_MCPServerNeedingK8sUpdate(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerOAuthCredentialRequest":                    schema_obot_platform_obot_apiclient_types_MCPServerOAuthCredentialRequest(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerOAuthCredentialStatus":                     schema_obot_platform_obot_apiclient_types_MCPServerOAuthCredentialStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerPinRequest":                                schema_obot_platform_obot_apiclient_types_MCPServerPinRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerReauthentication":                          schema_obot_platform_obot_apiclient_types_MCPServerReauthentication(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerReauthenticationList":                      schema_obot_platform_obot_apiclient_types_MCPServerReauthenticationList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerScaffoldRepository":                        schema_obot_platform_obot_apiclient_types_MCPServerScaffoldRepository(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerScaffoldRequest":                           schema_obot_platform_obot_apiclient_types_MCPServerScaffoldRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerScaffoldResult":                            schema_obot_platform_obot_apiclient_types_MCPServerScaffoldResult(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerStaleNotification":                         schema_obot_platform_obot_apiclient_types_MCPServerStaleNotification(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServerTool":                                      schema_obot_platform_obot_apiclient_types_MCPServerTool(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerUpdatePreview":                             schema_obot_platform_obot_apiclient_types_MCPServerUpdatePreview(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPServerWatchEvent":                                schema_obot_platform_obot_apiclient_types_MCPServerWatchEvent(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPServersNeedingK8sUpdateList":                     schema_obot_platform_obot_apiclient_types_MCPServersNeedingK8sUpdateList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPTeamCredential":                                  schema_obot_platform_obot_apiclient_types_MCPTeamCredential(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPTeamCredentialList":                              schema_obot_platform_obot_apiclient_types_MCPTeamCredentialList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPToolCallDailyStat":                               schema_obot_platform_obot_apiclient_types_MCPToolCallDailyStat(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPToolCallDailyStats":                              schema_obot_platform_obot_apiclient_types_MCPToolCallDailyStats(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPToolCallStats":                                   schema_obot_platform_obot_apiclient_types_MCPToolCallStats(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPToolCallStatsItem":                               schema_obot_platform_obot_apiclient_types_MCPToolCallStatsItem(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPToolCost":                                        schema_obot_platform_obot_apiclient_types_MCPToolCost(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPToolPolicy":                                      schema_obot_platform_obot_apiclient_types_MCPToolPolicy(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPUsage":                                           schema_obot_platform_obot_apiclient_types_MCPUsage(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPUsageReport":                                     schema_obot_platform_obot_apiclient_types_MCPUsageReport(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem":                                   schema_obot_platform_obot_apiclient_types_MCPUsageStatItem(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPUsageStats":                                      schema_obot_platform_obot_apiclient_types_MCPUsageStats(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPUsageStatsList":                                  schema_obot_platform_obot_apiclient_types_MCPUsageStatsList(ref),
+		"github.com/obot-platform/obot/apiclient/types.MCPWebhookSignatureScheme":                          schema_obot_platform_obot_apiclient_types_MCPWebhookSignatureScheme(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPWebhookValidation":                               schema_obot_platform_obot_apiclient_types_MCPWebhookValidation(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPWebhookValidationList":                           schema_obot_platform_obot_apiclient_types_MCPWebhookValidationList(ref),
 		"github.com/obot-platform/obot/apiclient/types.MCPWebhookValidationManifest":                       schema_obot_platform_obot_apiclient_types_MCPWebhookValidationManifest(ref),
@@ -179,6 +297,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.OAuthAppList":                                       schema_obot_platform_obot_apiclient_types_OAuthAppList(ref),
 		"github.com/obot-platform/obot/apiclient/types.OAuthAppLoginAuthStatus":                            schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref),
 		"github.com/obot-platform/obot/apiclient/types.OAuthAppManifest":                                   schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.OAuthAppProvider":                                   schema_obot_platform_obot_apiclient_types_OAuthAppProvider(ref),
 		"github.com/obot-platform/obot/apiclient/types.OAuthClient":                                        schema_obot_platform_obot_apiclient_types_OAuthClient(ref),
 		"github.com/obot-platform/obot/apiclient/types.OAuthClientList":                                    schema_obot_platform_obot_apiclient_types_OAuthClientList(ref),
 		"github.com/obot-platform/obot/apiclient/types.OAuthClientManifest":                                schema_obot_platform_obot_apiclient_types_OAuthClientManifest(ref),
@@ -186,6 +305,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.OnEmail":                                            schema_obot_platform_obot_apiclient_types_OnEmail(ref),
 		"github.com/obot-platform/obot/apiclient/types.OnWebhook":                                          schema_obot_platform_obot_apiclient_types_OnWebhook(ref),
 		"github.com/obot-platform/obot/apiclient/types.OneDriveConfig":                                     schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntry":                                schema_obot_platform_obot_apiclient_types_PendingCatalogEntry(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryCheck":                           schema_obot_platform_obot_apiclient_types_PendingCatalogEntryCheck(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryChecks":                          schema_obot_platform_obot_apiclient_types_PendingCatalogEntryChecks(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryComment":                         schema_obot_platform_obot_apiclient_types_PendingCatalogEntryComment(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryList":                            schema_obot_platform_obot_apiclient_types_PendingCatalogEntryList(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryManifest":                        schema_obot_platform_obot_apiclient_types_PendingCatalogEntryManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryReview":                          schema_obot_platform_obot_apiclient_types_PendingCatalogEntryReview(ref),
+		"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryVendor":                          schema_obot_platform_obot_apiclient_types_PendingCatalogEntryVendor(ref),
 		"github.com/obot-platform/obot/apiclient/types.PodSecurityAdmissionSettings":                       schema_obot_platform_obot_apiclient_types_PodSecurityAdmissionSettings(ref),
 		"github.com/obot-platform/obot/apiclient/types.PowerUserWorkspace":                                 schema_obot_platform_obot_apiclient_types_PowerUserWorkspace(ref),
 		"github.com/obot-platform/obot/apiclient/types.PowerUserWorkspaceList":                             schema_obot_platform_obot_apiclient_types_PowerUserWorkspaceList(ref),
@@ -217,6 +344,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.PublishedArtifactManifest":                          schema_obot_platform_obot_apiclient_types_PublishedArtifactManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.PublishedArtifactVersionEntry":                      schema_obot_platform_obot_apiclient_types_PublishedArtifactVersionEntry(ref),
 		"github.com/obot-platform/obot/apiclient/types.PublishedArtifactVersionSummary":                    schema_obot_platform_obot_apiclient_types_PublishedArtifactVersionSummary(ref),
+		"github.com/obot-platform/obot/apiclient/types.ReconcileHandlerStats":                              schema_obot_platform_obot_apiclient_types_ReconcileHandlerStats(ref),
+		"github.com/obot-platform/obot/apiclient/types.ReconcileObjectError":                               schema_obot_platform_obot_apiclient_types_ReconcileObjectError(ref),
+		"github.com/obot-platform/obot/apiclient/types.ReconcileStats":                                     schema_obot_platform_obot_apiclient_types_ReconcileStats(ref),
 		"github.com/obot-platform/obot/apiclient/types.RegistryGitHubMeta":                                 schema_obot_platform_obot_apiclient_types_RegistryGitHubMeta(ref),
 		"github.com/obot-platform/obot/apiclient/types.RegistryMeta":                                       schema_obot_platform_obot_apiclient_types_RegistryMeta(ref),
 		"github.com/obot-platform/obot/apiclient/types.RegistryObotMeta":                                   schema_obot_platform_obot_apiclient_types_RegistryObotMeta(ref),
@@ -230,6 +360,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.RegistryServerRemote":                               schema_obot_platform_obot_apiclient_types_RegistryServerRemote(ref),
 		"github.com/obot-platform/obot/apiclient/types.RegistryServerRepository":                           schema_obot_platform_obot_apiclient_types_RegistryServerRepository(ref),
 		"github.com/obot-platform/obot/apiclient/types.RegistryServerResponse":                             schema_obot_platform_obot_apiclient_types_RegistryServerResponse(ref),
+		"github.com/obot-platform/obot/apiclient/types.RemainingMCPToolCalls":                              schema_obot_platform_obot_apiclient_types_RemainingMCPToolCalls(ref),
 		"github.com/obot-platform/obot/apiclient/types.RemainingTokenUsage":                                schema_obot_platform_obot_apiclient_types_RemainingTokenUsage(ref),
 		"github.com/obot-platform/obot/apiclient/types.RemainingTokenUsageList":                            schema_obot_platform_obot_apiclient_types_RemainingTokenUsageList(ref),
 		"github.com/obot-platform/obot/apiclient/types.RemoteCatalogConfig":                                schema_obot_platform_obot_apiclient_types_RemoteCatalogConfig(ref),
@@ -237,6 +368,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.Resource":                                           schema_obot_platform_obot_apiclient_types_Resource(ref),
 		"github.com/obot-platform/obot/apiclient/types.Run":                                                schema_obot_platform_obot_apiclient_types_Run(ref),
 		"github.com/obot-platform/obot/apiclient/types.RunList":                                            schema_obot_platform_obot_apiclient_types_RunList(ref),
+		"github.com/obot-platform/obot/apiclient/types.RunMCPToolCall":                                     schema_obot_platform_obot_apiclient_types_RunMCPToolCall(ref),
 		"github.com/obot-platform/obot/apiclient/types.RuntimeValidationError":                             schema_obot_platform_obot_apiclient_types_RuntimeValidationError(ref),
 		"github.com/obot-platform/obot/apiclient/types.S3Config":                                           schema_obot_platform_obot_apiclient_types_S3Config(ref),
 		"github.com/obot-platform/obot/apiclient/types.Schedule":                                           schema_obot_platform_obot_apiclient_types_Schedule(ref),
@@ -244,6 +376,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.ScheduledAuditLogExportListResponse":                schema_obot_platform_obot_apiclient_types_ScheduledAuditLogExportListResponse(ref),
 		"github.com/obot-platform/obot/apiclient/types.ScheduledAuditLogExportResponse":                    schema_obot_platform_obot_apiclient_types_ScheduledAuditLogExportResponse(ref),
 		"github.com/obot-platform/obot/apiclient/types.ScheduledAuditLogExportUpdateRequest":               schema_obot_platform_obot_apiclient_types_ScheduledAuditLogExportUpdateRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.SchemaTransition":                                   schema_obot_platform_obot_apiclient_types_SchemaTransition(ref),
 		"github.com/obot-platform/obot/apiclient/types.Skill":                                              schema_obot_platform_obot_apiclient_types_Skill(ref),
 		"github.com/obot-platform/obot/apiclient/types.SkillAccessRule":                                    schema_obot_platform_obot_apiclient_types_SkillAccessRule(ref),
 		"github.com/obot-platform/obot/apiclient/types.SkillAccessRuleList":                                schema_obot_platform_obot_apiclient_types_SkillAccessRuleList(ref),
@@ -254,6 +387,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.SkillRepositoryList":                                schema_obot_platform_obot_apiclient_types_SkillRepositoryList(ref),
 		"github.com/obot-platform/obot/apiclient/types.SkillRepositoryManifest":                            schema_obot_platform_obot_apiclient_types_SkillRepositoryManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.SkillResource":                                      schema_obot_platform_obot_apiclient_types_SkillResource(ref),
+		"github.com/obot-platform/obot/apiclient/types.SourceRuntimeConfig":                                schema_obot_platform_obot_apiclient_types_SourceRuntimeConfig(ref),
+		"github.com/obot-platform/obot/apiclient/types.StdioRuntimeConfig":                                 schema_obot_platform_obot_apiclient_types_StdioRuntimeConfig(ref),
 		"github.com/obot-platform/obot/apiclient/types.Step":                                               schema_obot_platform_obot_apiclient_types_Step(ref),
 		"github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke":                                 schema_obot_platform_obot_apiclient_types_StepTemplateInvoke(ref),
 		"github.com/obot-platform/obot/apiclient/types.StorageConfig":                                      schema_obot_platform_obot_apiclient_types_StorageConfig(ref),
@@ -293,6 +428,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.TokenUsage":                                         schema_obot_platform_obot_apiclient_types_TokenUsage(ref),
 		"github.com/obot-platform/obot/apiclient/types.TokenUsageByDate":                                   schema_obot_platform_obot_apiclient_types_TokenUsageByDate(ref),
 		"github.com/obot-platform/obot/apiclient/types.TokenUsageList":                                     schema_obot_platform_obot_apiclient_types_TokenUsageList(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolApproval":                                       schema_obot_platform_obot_apiclient_types_ToolApproval(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolApprovalList":                                   schema_obot_platform_obot_apiclient_types_ToolApprovalList(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolApprovalReference":                              schema_obot_platform_obot_apiclient_types_ToolApprovalReference(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolCall":                                           schema_obot_platform_obot_apiclient_types_ToolCall(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolConfirm":                                        schema_obot_platform_obot_apiclient_types_ToolConfirm(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolConfirmResponse":                                schema_obot_platform_obot_apiclient_types_ToolConfirmResponse(ref),
@@ -300,13 +438,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.ToolInput":                                          schema_obot_platform_obot_apiclient_types_ToolInput(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolManifest":                                       schema_obot_platform_obot_apiclient_types_ToolManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolOverride":                                       schema_obot_platform_obot_apiclient_types_ToolOverride(ref),
+		"github.com/obot-platform/obot/apiclient/types.ToolRecertificationRequest":                         schema_obot_platform_obot_apiclient_types_ToolRecertificationRequest(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolReference":                                      schema_obot_platform_obot_apiclient_types_ToolReference(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolReferenceList":                                  schema_obot_platform_obot_apiclient_types_ToolReferenceList(ref),
 		"github.com/obot-platform/obot/apiclient/types.ToolReferenceManifest":                              schema_obot_platform_obot_apiclient_types_ToolReferenceManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig":                                   schema_obot_platform_obot_apiclient_types_UVXRuntimeConfig(ref),
 		"github.com/obot-platform/obot/apiclient/types.User":                                               schema_obot_platform_obot_apiclient_types_User(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserActivityExportManifest":                         schema_obot_platform_obot_apiclient_types_UserActivityExportManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserActivityExportOAuth":                            schema_obot_platform_obot_apiclient_types_UserActivityExportOAuth(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserActivityExportOAuthGrant":                       schema_obot_platform_obot_apiclient_types_UserActivityExportOAuthGrant(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserActivityExportServer":                           schema_obot_platform_obot_apiclient_types_UserActivityExportServer(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserActivityExportServerInstance":                   schema_obot_platform_obot_apiclient_types_UserActivityExportServerInstance(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserActivityExportSession":                          schema_obot_platform_obot_apiclient_types_UserActivityExportSession(ref),
 		"github.com/obot-platform/obot/apiclient/types.UserDefaultRoleSetting":                             schema_obot_platform_obot_apiclient_types_UserDefaultRoleSetting(ref),
 		"github.com/obot-platform/obot/apiclient/types.UserList":                                           schema_obot_platform_obot_apiclient_types_UserList(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserPurgeReport":                                    schema_obot_platform_obot_apiclient_types_UserPurgeReport(ref),
+		"github.com/obot-platform/obot/apiclient/types.UserPurgeRequest":                                   schema_obot_platform_obot_apiclient_types_UserPurgeRequest(ref),
 		"github.com/obot-platform/obot/apiclient/types.Webhook":                                            schema_obot_platform_obot_apiclient_types_Webhook(ref),
 		"github.com/obot-platform/obot/apiclient/types.WebhookList":                                        schema_obot_platform_obot_apiclient_types_WebhookList(ref),
 		"github.com/obot-platform/obot/apiclient/types.WebhookManifest":                                    schema_obot_platform_obot_apiclient_types_WebhookManifest(ref),
@@ -327,6 +474,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AgentList":                         schema_storage_apis_obotobotai_v1_AgentList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AgentSpec":                         schema_storage_apis_obotobotai_v1_AgentSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AgentStatus":                       schema_storage_apis_obotobotai_v1_AgentStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRule":                         schema_storage_apis_obotobotai_v1_AlertRule(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRuleList":                     schema_storage_apis_obotobotai_v1_AlertRuleList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRuleSpec":                     schema_storage_apis_obotobotai_v1_AlertRuleSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRuleStatus":                   schema_storage_apis_obotobotai_v1_AlertRuleStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Alias":                             schema_storage_apis_obotobotai_v1_Alias(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AliasList":                         schema_storage_apis_obotobotai_v1_AliasList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AliasSpec":                         schema_storage_apis_obotobotai_v1_AliasSpec(ref),
@@ -376,6 +527,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummarySpec":              schema_storage_apis_obotobotai_v1_KnowledgeSummarySpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummaryStatus":            schema_storage_apis_obotobotai_v1_KnowledgeSummaryStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalog":                        schema_storage_apis_obotobotai_v1_MCPCatalog(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEvent":                   schema_storage_apis_obotobotai_v1_MCPCatalogEvent(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventList":               schema_storage_apis_obotobotai_v1_MCPCatalogEventList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventSpec":               schema_storage_apis_obotobotai_v1_MCPCatalogEventSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventStatus":             schema_storage_apis_obotobotai_v1_MCPCatalogEventStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhook":            schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhook(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhookList":        schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhookList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhookSpec":        schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhookSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhookStatus":      schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhookStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogList":                    schema_storage_apis_obotobotai_v1_MCPCatalogList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogSpec":                    schema_storage_apis_obotobotai_v1_MCPCatalogSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogStatus":                  schema_storage_apis_obotobotai_v1_MCPCatalogStatus(ref),
@@ -386,11 +545,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServer":                         schema_storage_apis_obotobotai_v1_MCPServer(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntry":             schema_storage_apis_obotobotai_v1_MCPServerCatalogEntry(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryList":         schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryRevision":     schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryRevision(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryRevisionList": schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryRevisionList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryRevisionSpec": schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryRevisionSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntrySpec":         schema_storage_apis_obotobotai_v1_MCPServerCatalogEntrySpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryStatus":       schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEventState":        schema_storage_apis_obotobotai_v1_MCPServerCatalogEventState(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstance":                 schema_storage_apis_obotobotai_v1_MCPServerInstance(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceList":             schema_storage_apis_obotobotai_v1_MCPServerInstanceList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceSpec":             schema_storage_apis_obotobotai_v1_MCPServerInstanceSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceStatus":           schema_storage_apis_obotobotai_v1_MCPServerInstanceStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerList":                     schema_storage_apis_obotobotai_v1_MCPServerList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerSpec":                     schema_storage_apis_obotobotai_v1_MCPServerSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerStatus":                   schema_storage_apis_obotobotai_v1_MCPServerStatus(ref),
@@ -443,6 +607,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.OktaGroupMigration":                schema_storage_apis_obotobotai_v1_OktaGroupMigration(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.OktaGroupMigrationList":            schema_storage_apis_obotobotai_v1_OktaGroupMigrationList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.OktaGroupMigrationSpec":            schema_storage_apis_obotobotai_v1_OktaGroupMigrationSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntry":               schema_storage_apis_obotobotai_v1_PendingCatalogEntry(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntryList":           schema_storage_apis_obotobotai_v1_PendingCatalogEntryList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntrySpec":           schema_storage_apis_obotobotai_v1_PendingCatalogEntrySpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntryStatus":         schema_storage_apis_obotobotai_v1_PendingCatalogEntryStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PodSecurityAdmissionSettings":      schema_storage_apis_obotobotai_v1_PodSecurityAdmissionSettings(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PowerUserWorkspace":                schema_storage_apis_obotobotai_v1_PowerUserWorkspace(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PowerUserWorkspaceList":            schema_storage_apis_obotobotai_v1_PowerUserWorkspaceList(ref),
@@ -514,6 +682,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ThreadSpec":                        schema_storage_apis_obotobotai_v1_ThreadSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ThreadStatus":                      schema_storage_apis_obotobotai_v1_ThreadStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Tool":                              schema_storage_apis_obotobotai_v1_Tool(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ToolApproval":                      schema_storage_apis_obotobotai_v1_ToolApproval(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ToolList":                          schema_storage_apis_obotobotai_v1_ToolList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ToolReference":                     schema_storage_apis_obotobotai_v1_ToolReference(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ToolReferenceList":                 schema_storage_apis_obotobotai_v1_ToolReferenceList(ref),
@@ -1242,127 +1411,148 @@ func schema_obot_platform_obot_apiclient_types_AgentManifest(ref common.Referenc
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_AppPreferences(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_AlertEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AppPreferences represents global application appearance preferences",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"logos": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.LogoPreferences"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"theme": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThemePreferences"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"mcpServerDisplayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userDisplayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
 				},
+				Required: []string{"type", "value", "time"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.LogoPreferences", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ThemePreferences"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Assistant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_AlertNotification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AlertNotification is the body of the webhook requests sent for alert rules.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"name": {
+					"alertRuleID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"default": {
+					"alertRuleName": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
+							Default: "",
+							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"description": {
+					"condition": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"icons": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
-						},
-					},
-					"alias": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"introductionMessage": {
+					"threshold": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
 						},
 					},
-					"starterMessages": {
+					"events": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.AlertEvent"),
 									},
 								},
 							},
 						},
 					},
-					"entityID": {
+				},
+				Required: []string{"alertRuleID", "alertRuleName", "condition", "threshold", "events"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AlertEvent"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_AlertRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"maxTools": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"websiteKnowledge": {
+					"deleted": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"allowedModelProviders": {
+					"links": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1373,10 +1563,11 @@ func schema_obot_platform_obot_apiclient_types_Assistant(ref common.ReferenceCal
 							},
 						},
 					},
-					"availableThreadTools": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1387,9 +1578,71 @@ func schema_obot_platform_obot_apiclient_types_Assistant(ref common.ReferenceCal
 							},
 						},
 					},
-					"defaultThreadTools": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold is the value that the metric of a server must exceed for the rule to fire for that server.",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"windowMinutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowMinutes is how far back the conditions that look at tool calls look. It defaults to 15 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minCalls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinCalls is the number of tool calls that a server must have received, or a user must have made, in the window for the conditions that look at tool calls to apply to it. It defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"baselineDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaselineDays is how many days before the window the user-call-spike condition compares the window to. It defaults to 7 days.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveHours are the hours when the user-off-hours-calls condition expects users to call tools.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.AlertRuleActiveHours"),
+						},
+					},
+					"mcpServerIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerIDs limits the rule to these MCP servers. The rule applies to all MCP servers if it is empty.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -1401,97 +1654,157 @@ func schema_obot_platform_obot_apiclient_types_Assistant(ref common.ReferenceCal
 							},
 						},
 					},
-					"tools": {
+					"notification": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.AlertRuleNotification"),
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"hasSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasSecret is true if notifications for this rule are signed.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"firing": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.AlertRuleFiring"),
 									},
 								},
 							},
 						},
 					},
+					"lastEvaluatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is the error of the last evaluation or notification, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"Metadata", "name", "default", "description", "icons", "introductionMessage", "starterMessages", "entityID"},
+				Required: []string{"created", "displayName", "condition", "threshold", "notification", "hasSecret"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
+			"github.com/obot-platform/obot/apiclient/types.AlertRuleActiveHours", "github.com/obot-platform/obot/apiclient/types.AlertRuleFiring", "github.com/obot-platform/obot/apiclient/types.AlertRuleNotification", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_AssistantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_AlertRuleActiveHours(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AlertRuleActiveHours is the range of hours of the day, in a time zone, when users are expected to call tools.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"start": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Assistant"),
-									},
-								},
-							},
+							Description: "Start is the first active hour, from 0 to 23.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the hour, from 0 to 23, when the active hours end. It is before Start if the active hours span midnight.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA time zone of the hours, such as America/New_York. It defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"start", "end"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Assistant"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_AssistantTool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_AlertRuleFiring(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AlertRuleFiring is an MCP server or a user that an alert rule is firing for.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"ToolManifest": {
+					"mcpServerDisplayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolManifest"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"enabled": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "UserID is set instead of MCPID for the conditions that fire for users.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"builtin": {
+					"userDisplayName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"since": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
 				},
-				Required: []string{"Metadata", "ToolManifest"},
+				Required: []string{"value", "since"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ToolManifest"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_AssistantToolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_AlertRuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1504,7 +1817,7 @@ func schema_obot_platform_obot_apiclient_types_AssistantToolList(ref common.Refe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.AssistantTool"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.AlertRule"),
 									},
 								},
 							},
@@ -1515,83 +1828,221 @@ func schema_obot_platform_obot_apiclient_types_AssistantToolList(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AssistantTool"},
+			"github.com/obot-platform/obot/apiclient/types.AlertRule"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_AuditLogExportCreateRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_AlertRuleManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AuditLogExportCreateRequest represents a request to create an audit log export",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"startTime": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"endTime": {
+					"condition": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"filters": {
+					"threshold": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.AuditLogExportFilters"),
+							Description: "Threshold is the value that the metric of a server must exceed for the rule to fire for that server.",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"bucket": {
+					"windowMinutes": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "WindowMinutes is how far back the conditions that look at tool calls look. It defaults to 15 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"keyPrefix": {
+					"minCalls": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Description: "MinCalls is the number of tool calls that a server must have received, or a user must have made, in the window for the conditions that look at tool calls to apply to it. It defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"baselineDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaselineDays is how many days before the window the user-call-spike condition compares the window to. It defaults to 7 days.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveHours are the hours when the user-off-hours-calls condition expects users to call tools.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.AlertRuleActiveHours"),
+						},
+					},
+					"mcpServerIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerIDs limits the rule to these MCP servers. The rule applies to all MCP servers if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notification": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.AlertRuleNotification"),
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"name", "startTime", "endTime"},
+				Required: []string{"displayName", "condition", "threshold", "notification"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AuditLogExportFilters", "github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.AlertRuleActiveHours", "github.com/obot-platform/obot/apiclient/types.AlertRuleNotification"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_AuditLogExportFilters(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_AlertRuleNotification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AuditLogExportFilters represents filters for audit log export",
+				Description: "AlertRuleNotification is where notifications are sent when a rule starts or stops firing for a server.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userIDs": {
+					"webhookURL": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"mcpIDs": {
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret signs the notifications, in the same way as requests to webhook filters. It is never returned by the API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"webhookURL"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_AppPreferences(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AppPreferences represents global application appearance preferences",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"logos": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.LogoPreferences"),
+						},
+					},
+					"theme": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThemePreferences"),
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.LogoPreferences", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ThemePreferences"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Assistant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"icons": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"introductionMessage": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"starterMessages": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -1605,7 +2056,25 @@ func schema_obot_platform_obot_apiclient_types_AuditLogExportFilters(ref common.
 							},
 						},
 					},
-					"mcpServerDisplayNames": {
+					"entityID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"maxTools": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"websiteKnowledge": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"),
+						},
+					},
+					"allowedModelProviders": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -1619,7 +2088,7 @@ func schema_obot_platform_obot_apiclient_types_AuditLogExportFilters(ref common.
 							},
 						},
 					},
-					"mcpServerCatalogEntryNames": {
+					"availableThreadTools": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -1633,7 +2102,7 @@ func schema_obot_platform_obot_apiclient_types_AuditLogExportFilters(ref common.
 							},
 						},
 					},
-					"callTypes": {
+					"defaultThreadTools": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -1647,7 +2116,7 @@ func schema_obot_platform_obot_apiclient_types_AuditLogExportFilters(ref common.
 							},
 						},
 					},
-					"callIdentifiers": {
+					"tools": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -1661,22 +2130,268 @@ func schema_obot_platform_obot_apiclient_types_AuditLogExportFilters(ref common.
 							},
 						},
 					},
-					"sessionIDs": {
+				},
+				Required: []string{"Metadata", "name", "default", "description", "icons", "introductionMessage", "starterMessages", "entityID"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_AssistantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Assistant"),
 									},
 								},
 							},
 						},
 					},
-					"clientNames": {
-						SchemaProps: spec.SchemaProps{
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Assistant"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_AssistantTool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"ToolManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolManifest"),
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"builtin": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"Metadata", "ToolManifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ToolManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_AssistantToolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.AssistantTool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AssistantTool"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_AuditLogExportCreateRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogExportCreateRequest represents a request to create an audit log export",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.AuditLogExportFilters"),
+						},
+					},
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"keyPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name", "startTime", "endTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AuditLogExportFilters", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_AuditLogExportFilters(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogExportFilters represents filters for audit log export",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userIDs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mcpIDs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mcpServerDisplayNames": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mcpServerCatalogEntryNames": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"callTypes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"callIdentifiers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sessionIDs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"clientNames": {
+						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2036,29 +2751,261 @@ func schema_obot_platform_obot_apiclient_types_AzureConfig(ref common.ReferenceC
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_CatalogComponentServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_BackstageEntity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "BackstageEntity is an entity of the Backstage software catalog, in the format of Backstage catalog-info files. The servers of an MCP catalog are described by a System for the catalog, an API for each catalog entry, and a Component for each deployed multi-user server.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"catalogEntryID": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CatalogEntryID if set, reference the catalog entry the component server is sourced from",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"mcpServerID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MCPServerID if set, reference the multi-user MCP server the component server proxies to",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"manifest": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Manifest is the catalog entry manifest of the component server",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.BackstageEntityMetadata"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.BackstageEntitySpec"),
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind", "metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.BackstageEntityMetadata", "github.com/obot-platform/obot/apiclient/types.BackstageEntitySpec"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_BackstageEntityList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.BackstageEntity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.BackstageEntity"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_BackstageEntityMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"links": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.BackstageLink"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.BackstageLink"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_BackstageEntitySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackstageEntitySpec contains the spec fields of the System, API, and Component kinds. Only the fields of the entity's kind are set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lifecycle": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"system": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"definition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Definition is the definition of an API. For MCP servers, it lists the tools of the server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providesApis": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"owner"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_BackstageLink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_CatalogComponentServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"catalogEntryID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogEntryID if set, reference the catalog entry the component server is sourced from",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mcpServerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerID if set, reference the multi-user MCP server the component server proxies to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manifest is the catalog entry manifest of the component server",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
 						},
@@ -2330,13 +3277,14 @@ func schema_obot_platform_obot_apiclient_types_CompositeCatalogConfig(ref common
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_CompositeRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CompositeComponentsPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "CompositeComponentsPatch adds and removes components of a composite server. Components that are added replace the components with the same component IDs, and the others are appended. Remove contains the component IDs of the components to remove.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentServers": {
+					"add": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -2349,8 +3297,21 @@ func schema_obot_platform_obot_apiclient_types_CompositeRuntimeConfig(ref common
 							},
 						},
 					},
+					"remove": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"componentServers"},
 			},
 		},
 		Dependencies: []string{
@@ -2358,33 +3319,236 @@ func schema_obot_platform_obot_apiclient_types_CompositeRuntimeConfig(ref common
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ContainerizedRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CompositeRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerizedRuntimeConfig represents configuration for containerized runtime (Docker containers)",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"componentServers": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ComponentServer"),
+									},
+								},
+							},
 						},
 					},
-					"command": {
+					"toolNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: Docker image name",
+							Description: "ToolNamespace controls how the tools of components without a ToolPrefix are namespaced, so that tools with the same name in different components don't conflict.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"args": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Override container command",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
+				},
+				Required: []string{"componentServers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ComponentServer"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_CompositeToolConflict(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CompositeToolConflict is a tool name that more than one component of a composite server exposes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"tools": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.CompositeToolConflictTool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "tools"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.CompositeToolConflictTool"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_CompositeToolConflictTool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CompositeToolConflictTool is a component's tool that is exposed under a conflicting name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"tool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tool is the name of the tool in the component, before its overrides and prefix are applied.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentID", "tool"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_CompositeToolConflicts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CompositeToolConflicts is the result of checking the tools of a composite server's components for names that more than one component exposes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conflicts": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.CompositeToolConflict"),
+									},
+								},
+							},
+						},
+					},
+					"unchecked": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unchecked are the enabled components whose tools are unknown, because they don't have tool previews.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"conflicts"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.CompositeToolConflict"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Condition describes one aspect of an object's current state, following the Kubernetes condition conventions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of condition, e.g. Ready, Synced, CredentialConfigured, DriftDetected, or K8sSettingsApplied.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the object that the condition was set based on.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a CamelCase reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the condition transitioned from one status to another.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status", "lastTransitionTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ContainerizedRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerizedRuntimeConfig represents configuration for containerized runtime (Docker containers)",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: Docker image name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Override container command",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
 										Type:    []string{"string"},
@@ -2484,208 +3648,292 @@ func schema_obot_platform_obot_apiclient_types_Credential(ref common.ReferenceCa
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_CredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CredentialBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "CredentialBackup is an export of the credentials of multi-user MCP servers. Only the credential values are encrypted, the entries describing them are not, so that the credentials to restore can be selected before decrypting.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"createdAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"entries": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Credential"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.CredentialBackupEntry"),
 									},
 								},
 							},
 						},
 					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data is the encrypted credential values of the entries, base64 encoded.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"version", "createdAt", "encryption", "entries", "data"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Credential"},
+			"github.com/obot-platform/obot/apiclient/types.CredentialBackupEntry", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_CronJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CredentialBackupEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Description: "ID identifies the entry in the backup. It is the ID of the MCP server the credential was backed up from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"CronJobManifest": {
+					"mcpServerName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.CronJobManifest"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"lastRunStartedAt": {
+					"catalogEntryID": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"lastSuccessfulRunCompleted": {
+					"mcpCatalogID": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"nextRunAt": {
+					"powerUserWorkspaceID": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-				},
-				Required: []string{"Metadata", "CronJobManifest"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.CronJobManifest", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_CronJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"envVars": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.CronJob"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"id"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.CronJob"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_CronJobManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CredentialBackupRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"description": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"schedule": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"workflowName": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Key encrypts the backup. When empty, the backup is encrypted with the platform's encryption provider.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"input": {
+					"mcpServerIDs": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MCPServerIDs limits the backup to the credentials of these multi-user MCP servers. All are included when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"taskSchedule": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_CredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Credential"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Schedule"},
+			"github.com/obot-platform/obot/apiclient/types.Credential"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_CustomS3Config(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CredentialRestoreEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"endpoint": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"region": {
+					"targetMCPServerID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "TargetMCPServerID is the MCP server to restore the credential to. When empty, the credential is restored to the server with the same ID, or else to the only server in the same catalog or workspace with the same catalog entry and name.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"accessKeyID": {
+				},
+				Required: []string{"id"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_CredentialRestoreRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backup": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.CredentialBackup"),
 						},
 					},
-					"secretAccessKey": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Key decrypts a backup that was encrypted with a key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"entries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entries selects the entries to restore. All entries are restored when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.CredentialRestoreEntry"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"endpoint", "region"},
+				Required: []string{"backup"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.CredentialBackup", "github.com/obot-platform/obot/apiclient/types.CredentialRestoreEntry"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DefaultModelAlias(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CredentialRestoreResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"DefaultModelAliasManifest": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"targetMCPServerID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"restored": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"DefaultModelAliasManifest"},
+				Required: []string{"id", "restored"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CredentialRestoreResultList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2698,7 +3946,7 @@ func schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref common.
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DefaultModelAlias"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.CredentialRestoreResult"),
 									},
 								},
 							},
@@ -2709,255 +3957,333 @@ func schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.DefaultModelAlias"},
+			"github.com/obot-platform/obot/apiclient/types.CredentialRestoreResult"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CronJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"alias": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"model": {
+					"CronJobManifest": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.CronJobManifest"),
+						},
+					},
+					"lastRunStartedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"lastSuccessfulRunCompleted": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"nextRunAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
 				},
-				Required: []string{"alias", "model"},
+				Required: []string{"Metadata", "CronJobManifest"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.CronJobManifest", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeploymentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CronJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of deployment condition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.CronJob"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.CronJob"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_CronJobManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"reason": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"message": {
+					"workflowName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"lastTransitionTime": {
+					"input": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"lastUpdateTime": {
+					"taskSchedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
 						},
 					},
 				},
-				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.Schedule"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeviceClientStat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_CustomS3Config(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceClientStat is one row of the per-client rollup.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the canonical client name.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"deviceCount": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeviceCount is the number of distinct devices with this client.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"userCount": {
+					"accessKeyID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UserCount is the number of distinct submitters with this client.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"observationCount": {
+					"secretAccessKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservationCount is the total number of client rows for this name.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"name", "deviceCount", "userCount", "observationCount"},
+				Required: []string{"endpoint", "region"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeviceMCPServerDetail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_DatabaseChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceMCPServerDetail is the GET /api/devices/mcp-servers/{config_hash} response. EnvKeys and HeaderKeys are not in the hash and may vary per observation; they are unioned across all observations.",
+				Description: "DatabaseChange is a change to the schema or data of Obot's database.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"DeviceMCPServerStat": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceMCPServerStat"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"envKeys": {
+					"table": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EnvKeys is the set union of env var names referenced across observations of this hash.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Table is the table that the change is made to. It is empty for data migrations.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"headerKeys": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HeaderKeys is the set union of HTTP header names referenced across observations of this hash.",
-							Type:        []string{"array"},
+							Description: "Name is the name of the column, index, or data migration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DatabaseMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatabaseMigration is a startup of Obot that changed its database.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"appliedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DatabaseChange"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"DeviceMCPServerStat", "envKeys", "headerKeys"},
+				Required: []string{"id", "appliedAt", "version", "changes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.DeviceMCPServerStat"},
+			"github.com/obot-platform/obot/apiclient/types.DatabaseChange", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_DatabaseMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceMCPServerOccurrence is one device's latest-scan row for a specific ConfigHash.",
+				Description: "DatabaseMigrationStatus describes the changes that are waiting to be made to Obot's database, and the changes that were made.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"deviceScanID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeviceScanID is the parent scan's primary key.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"deviceID": {
+					"dryRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeviceID is the device that submitted the parent scan.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "DryRun is true when Obot was started without applying pending changes, so that they can be reviewed.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"client": {
+					"pending": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Client is the canonical client name on this row.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Pending are the changes that the next startup without dry run makes. Changes of the types of existing columns aren't included.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DatabaseChange"),
+									},
+								},
+							},
 						},
 					},
-					"scope": {
+					"history": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scope is \"global\" or \"project\".",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "History are the startups that changed the database, the most recent first.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DatabaseMigration"),
+									},
+								},
+							},
 						},
 					},
-					"scannedAt": {
+					"transitions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScannedAt is when the parent scan was collected on the device.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Description: "Transitions are the expand/contract migrations of the database.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.SchemaTransition"),
+									},
+								},
+							},
 						},
 					},
-					"index": {
+				},
+				Required: []string{"dryRun", "pending", "history", "transitions"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.DatabaseChange", "github.com/obot-platform/obot/apiclient/types.DatabaseMigration", "github.com/obot-platform/obot/apiclient/types.SchemaTransition"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DefaultModelAlias(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"DefaultModelAliasManifest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Index is the position of this row inside the parent scan's MCPServers slice.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest"),
 						},
 					},
 				},
-				Required: []string{"deviceScanID", "deviceID", "client", "scope", "scannedAt", "index"},
+				Required: []string{"DefaultModelAliasManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrenceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2970,7 +4296,7 @@ func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrenceList(ref
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DefaultModelAlias"),
 									},
 								},
 							},
@@ -2981,134 +4307,120 @@ func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrenceList(ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"},
+			"github.com/obot-platform/obot/apiclient/types.DefaultModelAlias"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrenceResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceMCPServerOccurrenceResponse is returned by GET /api/devices/mcp-servers/{config_hash}/occurrences.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"),
-									},
-								},
-							},
-						},
-					},
-					"total": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"limit": {
+					"alias": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"offset": {
+					"model": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"items", "total", "limit", "offset"},
+				Required: []string{"alias", "model"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeviceMCPServerStat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_DeploymentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceMCPServerStat is one row of the fleet-wide MCP aggregation, keyed by ConfigHash. Identity fields (Name, Transport, Command, Args, URL) are stable within a hash group by construction — they are inputs to the hash itself.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"configHash": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigHash is the aggregation key.",
+							Description: "Type of deployment condition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"transport": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"command": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"args": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"url": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Last time the condition was updated.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"deviceCount": {
+				},
+				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DeviceClientStat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceClientStat is one row of the per-client rollup.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeviceCount is the number of distinct devices observing this hash.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Name is the canonical client name.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"userCount": {
+					"deviceCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UserCount is the number of distinct submitters observing this hash.",
+							Description: "DeviceCount is the number of distinct devices with this client.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"clientCount": {
+					"userCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClientCount is the number of distinct client names observing this hash.",
+							Description: "UserCount is the number of distinct submitters with this client.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
@@ -3116,166 +4428,452 @@ func schema_obot_platform_obot_apiclient_types_DeviceMCPServerStat(ref common.Re
 					},
 					"observationCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservationCount is the total number of rows with this hash.",
+							Description: "ObservationCount is the total number of client rows for this name.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"configHash", "name", "transport", "deviceCount", "userCount", "clientCount", "observationCount"},
+				Required: []string{"name", "deviceCount", "userCount", "observationCount"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_DeviceScan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_DeviceMCPServerDetail(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceScan is a persisted scan: the submitted manifest plus server-assigned fields.",
+				Description: "DeviceMCPServerDetail is the GET /api/devices/mcp-servers/{config_hash} response. EnvKeys and HeaderKeys are not in the hash and may vary per observation; they are unioned across all observations.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"scannerVersion": {
+					"DeviceMCPServerStat": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScannerVersion is the obot version that produced the scan.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceMCPServerStat"),
 						},
 					},
-					"scannedAt": {
+					"envKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScannedAt is when the scanner finished collecting on the device.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Description: "EnvKeys is the set union of env var names referenced across observations of this hash.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"deviceID": {
+					"headerKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeviceID is the persisted per-device identifier so re-scans collate.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "HeaderKeys is the set union of HTTP header names referenced across observations of this hash.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"hostname": {
+				},
+				Required: []string{"DeviceMCPServerStat", "envKeys", "headerKeys"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.DeviceMCPServerStat"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceMCPServerOccurrence is one device's latest-scan row for a specific ConfigHash.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deviceScanID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Hostname is the device hostname at scan time.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DeviceScanID is the parent scan's primary key.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"os": {
+					"deviceID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OS is the operating system (darwin, linux, windows).",
+							Description: "DeviceID is the device that submitted the parent scan.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"arch": {
+					"client": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arch is the CPU architecture (amd64, arm64).",
+							Description: "Client is the canonical client name on this row.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"username": {
+					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username is the OS user that ran the scan.",
+							Description: "Scope is \"global\" or \"project\".",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"files": {
+					"scannedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Files are the config / manifest files captured during the scan, deduped by absolute path.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanFile"),
-									},
-								},
-							},
+							Description: "ScannedAt is when the parent scan was collected on the device.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"mcpServers": {
+					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MCPServers are the MCP server observations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanMCPServer"),
-									},
-								},
-							},
+							Description: "Index is the position of this row inside the parent scan's MCPServers slice.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"skills": {
+				},
+				Required: []string{"deviceScanID", "deviceID", "client", "scope", "scannedAt", "index"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrenceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Skills are the skill observations (SKILL.md hits).",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanSkill"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"),
 									},
 								},
 							},
 						},
 					},
-					"plugins": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DeviceMCPServerOccurrenceResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceMCPServerOccurrenceResponse is returned by GET /api/devices/mcp-servers/{config_hash}/occurrences.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Plugins are the plugin observations.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanPlugin"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"),
 									},
 								},
 							},
 						},
 					},
-					"clients": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Clients are the per-client presence + roll-up rows.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanClient"),
-									},
-								},
-							},
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"id": {
+					"limit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the server-assigned primary key.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"receivedAt": {
+					"offset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReceivedAt is the server's receipt timestamp.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"submittedBy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SubmittedBy is the user ID of the caller that posted the scan.",
+				},
+				Required: []string{"items", "total", "limit", "offset"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.DeviceMCPServerOccurrence"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DeviceMCPServerStat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceMCPServerStat is one row of the fleet-wide MCP aggregation, keyed by ConfigHash. Identity fields (Name, Transport, Command, Args, URL) are stable within a hash group by construction — they are inputs to the hash itself.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigHash is the aggregation key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"transport": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"deviceCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceCount is the number of distinct devices observing this hash.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"userCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserCount is the number of distinct submitters observing this hash.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"clientCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientCount is the number of distinct client names observing this hash.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"observationCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservationCount is the total number of rows with this hash.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"configHash", "name", "transport", "deviceCount", "userCount", "clientCount", "observationCount"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_DeviceScan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceScan is a persisted scan: the submitted manifest plus server-assigned fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scannerVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScannerVersion is the obot version that produced the scan.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scannedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScannedAt is when the scanner finished collecting on the device.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"deviceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceID is the persisted per-device identifier so re-scans collate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hostname is the device hostname at scan time.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"os": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OS is the operating system (darwin, linux, windows).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"arch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arch is the CPU architecture (amd64, arm64).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the OS user that ran the scan.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Files are the config / manifest files captured during the scan, deduped by absolute path.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanFile"),
+									},
+								},
+							},
+						},
+					},
+					"mcpServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServers are the MCP server observations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanMCPServer"),
+									},
+								},
+							},
+						},
+					},
+					"skills": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Skills are the skill observations (SKILL.md hits).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanSkill"),
+									},
+								},
+							},
+						},
+					},
+					"plugins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugins are the plugin observations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanPlugin"),
+									},
+								},
+							},
+						},
+					},
+					"clients": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clients are the per-client presence + roll-up rows.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeviceScanClient"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the server-assigned primary key.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"receivedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReceivedAt is the server's receipt timestamp.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"submittedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubmittedBy is the user ID of the caller that posted the scan.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -5042,15 +6640,124 @@ func schema_obot_platform_obot_apiclient_types_K8sSettingsStatus(ref common.Refe
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_KnowledgeFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_KeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "KeyRotation is the status of the job that re-encrypts stored secrets with the current write key of the encryption provider. An empty state means that no job has run yet.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"startedBy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"completedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.KeyRotationResource"),
+									},
+								},
+							},
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.KeyRotationResource", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_KeyRotationResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KeyRotationResource is the progress of re-encrypting one kind of stored secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of records to re-encrypt. It is zero for resources that are re-encrypted as a whole.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reencrypted": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"done": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"resource", "done"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_KnowledgeFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
 							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
@@ -5555,155 +7262,5169 @@ func schema_obot_platform_obot_apiclient_types_MCPAuditLog(ref common.ReferenceC
 							Format:  "",
 						},
 					},
-					"mcpServerCatalogEntryName": {
+					"mcpServerCatalogEntryName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"client": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ClientInfo"),
+						},
+					},
+					"clientIP": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"callType": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"callIdentifier": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"requestMutated": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"requestBody": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"mutatedRequestBody": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"responseMutated": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"responseBody": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"originalResponseBody": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"responseStatus": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"webhookStatuses": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.WebhookStatus"),
+									},
+								},
+							},
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"processingTimeMs": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"sessionID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"runID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"estimatedCostUSD": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"estimatedCredits": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"requestID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userAgent": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"requestHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"responseHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+				},
+				Required: []string{"id", "createdAt", "userID", "mcpID", "mcpServerDisplayName", "mcpServerCatalogEntryName", "client", "clientIP", "callType", "requestMutated", "responseMutated", "responseStatus", "processingTimeMs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ClientInfo", "github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.WebhookStatus"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPAuditLogList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPAuditLogList represents a list of MCP audit logs",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPAuditLog"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPAuditLog"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPAuditLogResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPAuditLog"),
+									},
+								},
+							},
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"offset": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"items", "total", "limit", "offset"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPAuditLog"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCapacityInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCapacityInfo represents MCP namespace capacity information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source indicates where the capacity data comes from (graceful degradation)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuRequested": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPURequested is the total CPU requested by MCP deployments",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPULimit is the CPU limit from ResourceQuota",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memoryRequested": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryRequested is the total memory requested by MCP deployments",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryLimit is the memory limit from ResourceQuota",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"activeDeployments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeployments is the number of active MCP server deployments",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error message if capacity info couldn't be fully retrieved",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"source", "activeDeployments"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"MCPCatalogManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogManifest"),
+						},
+					},
+					"lastSynced": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"syncErrors": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"isSyncing": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"syncConflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncConflicts contains the IDs of the entries imported from an MCP registry that were edited in Obot and changed in the registry since, so they weren't updated by the last sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"Metadata", "MCPCatalogManifest", "lastSynced"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogManifest", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundle(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogBundle is the configuration of an MCP catalog, exported from one Obot instance so that it can be imported into another, such as when promoting configuration from staging to production. Credentials are not included.\n\nEntries, servers, and access control rules are identified by their names, which must be unique in a bundle. When a bundle is imported, they update the entries, servers, and rules of the same names in the catalog, and the rest are created. Nothing is deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"catalog": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleCatalog"),
+						},
+					},
+					"entries": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleEntry"),
+									},
+								},
+							},
+						},
+					},
+					"servers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleServer"),
+									},
+								},
+							},
+						},
+					},
+					"accessControlRules": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleAccessControlRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"version", "catalog"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleAccessControlRule", "github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleCatalog", "github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleEntry", "github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleServer"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundleAccessControlRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogBundleAccessControlRule is an access control rule of the catalog. Its name is its display name. Its subjects are copied as they are, so the users and groups must have the same IDs in the instance that it is imported into.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"subjects": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleResource"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"displayName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleResource", "github.com/obot-platform/obot/apiclient/types.Subject"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundleCatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogBundleCatalog contains the settings of the catalog. The external URL is not included, because it is specific to each instance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceURLs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultToolSelection": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundleChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the object in the catalog, if it exists or was created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff is a unified diff from the object's configuration in the catalog to its configuration in the bundle, for updates.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is why the change can't be made, or why making it failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name", "action"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundleEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogBundleEntry is a catalog entry that was created in Obot. Entries from the catalog's source URLs are not included, because they are synced from the source URLs. Its name is the name in its manifest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
+						},
+					},
+				},
+				Required: []string{"manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundleImportResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogBundleImportResult is the response to importing a bundle. For a dry run, it contains the changes that importing the bundle would make, without making them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleChange"),
+									},
+								},
+							},
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of changes that failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"changes"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogBundleChange"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundleResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogBundleResource is a resource of an access control rule. Entries and servers of the bundle are referred to by Name, and other entries by ID. A resource of the mcpCatalog type refers to the catalog that the bundle is imported into, so it has neither.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogBundleServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogBundleServer is a multi-user server of the catalog. Its name is the name in its manifest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"catalogEntry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogEntry is the name of the entry of the bundle that the server was created from, if it was.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"catalogEntryID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogEntryID is the ID of the entry that the server was created from, if it was created from an entry that is not in the bundle, such as one from the catalog's source URLs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerManifest"),
+						},
+					},
+				},
+				Required: []string{"manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogEvent is the body of the webhook requests sent for the lifecycle events of an MCP catalog.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID identifies the event, so that receivers can ignore an event that is delivered again.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpCatalogID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"catalogEntryID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"catalogEntryName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"catalogEntryRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogEntryRevision is the revision of the catalog entry that was published.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"mcpServerID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mcpServerName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the owner of a single-user server. It is empty for multi-user servers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deploymentStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentStatus is the deployment status of the server, and PreviousDeploymentStatus is the one before it changed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"previousDeploymentStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"id", "type", "mcpCatalogID", "time"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogEventWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogEventWebhook is a subscription of an external system to the lifecycle events of an MCP catalog.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"deleted": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"links": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret signs the events, in the same way as requests to webhook filters. It is never returned by the API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events are the types of events that are sent to this webhook. All events are sent if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"mcpCatalogID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"hasSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasSecret is true if the events sent to this webhook are signed.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastDeliveredAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastDeliveredAt is the last time an event was delivered to this webhook.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is the error of the last delivery to this webhook, if it failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"created", "displayName", "url", "mcpCatalogID", "hasSecret"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogEventWebhookList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogEventWebhook"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogEventWebhook"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogEventWebhookManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret signs the events, in the same way as requests to webhook filters. It is never returned by the API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events are the types of events that are sent to this webhook. All events are sent if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"displayName", "url"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalog"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalog"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"sourceURLs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sourceURLCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"externalURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalURL, if set, is the base URL used in place of Obot's server URL when building the connect URLs of servers in this catalog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultToolSelection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultToolSelection, if set, overrides the server-wide policy for which tools of a server from this catalog are enabled when the server is added to a project.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"displayName", "sourceURLs"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogSubmission(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogSubmission is the status of an entry submitted through the submission portal of a catalog, as seen by its vendor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"catalogID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"checks": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryChecks"),
+						},
+					},
+					"comments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comments are the messages of the reviewers of the entry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryComment"),
+									},
+								},
+							},
+						},
+					},
+					"statusToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatusToken is only returned when the entry is submitted. The vendor sends it in the X-Obot-Submission-Status-Token header to get the status of the submission.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "catalogID", "created", "state", "checks"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryChecks", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryComment", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogSubmissionPortal(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogSubmissionPortal is the submission portal of a catalog.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"MCPCatalogSubmissionPortalManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogSubmissionPortalManifest"),
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is where vendors submit entries.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"token": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Token is the token that vendors submit entries with, if the portal requires one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"MCPCatalogSubmissionPortalManifest", "url", "enabled"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogSubmissionPortalManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogSubmissionPortalManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogSubmissionPortalManifest configures the submission portal of a catalog, through which vendors without an Obot account submit catalog entries for admins to review.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requireToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireToken requires vendors to send the token of the portal in the X-Obot-Submission-Token header. Otherwise, anyone can submit entries.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sandboxTest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SandboxTest deploys a temporary server from each submitted entry to list its tools, once an admin triages it.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogSubmissionRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogSubmissionRequest is a catalog entry that a vendor submits through the submission portal of a catalog.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains the submission to the reviewers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vendor": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryVendor"),
+						},
+					},
+					"sandboxConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SandboxConfig and SandboxURL configure the temporary server of the sandbox test. They are stored as a credential until the sandbox test runs, and aren't shown to the reviewers.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sandboxURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"manifest", "vendor"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryVendor"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCatalogWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCatalogWebhook is the webhook that Git hosting platforms call to sync a catalog when its Git sources are pushed to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"configured": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret is only returned when it is generated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "configured"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCompletionArgument(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCompletionReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the prompt, for prompt references.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URI is the URI template of the resource, for resource references.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCompletionRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCompletionRequest asks an MCP server for completions of a prompt argument or resource template variable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCompletionReference"),
+						},
+					},
+					"argument": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCompletionArgument"),
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments are the values of previously completed arguments, which the server may use to narrow its completions.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ref", "argument"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCompletionArgument", "github.com/obot-platform/obot/apiclient/types.MCPCompletionReference"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCompletionResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"hasMore": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"values"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPComponentServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPComponentServerConfig is the configuration of a temporary server for a component of a composite catalog entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPComponentServerDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPComponentServerDetails is the deployment status and health of a component of a composite server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpServerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerID is the ID of the server that the component runs as, or the multi-user server that it refers to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled is whether the component is disabled in the composite server's configuration, in which case it is not deployed and its tools are not available.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deploymentStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"isAvailable": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lastHealthyTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"componentID", "isAvailable"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCompositeComponentFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCompositeComponentFailure is a component of a composite server that failed to launch.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpServerID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"componentID", "error"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPCompositeLaunchResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPCompositeLaunchResult is the response to launching a composite server with degraded components allowed. The components that failed to launch are disabled, and the tools of the rest are available.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disabledComponents": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCompositeComponentFailure"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"disabledComponents"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCompositeComponentFailure"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPConfigurationPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPConfigurationPreset is a named, pre-filled configuration for servers created from a catalog entry. Presets never contain sensitive values; users still provide those when configuring the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env contains values for the non-sensitive environment variables and headers of the catalog entry, keyed by their keys.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the default URL for remote catalog entries that require users to provide a URL.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tools are the tools that are enabled when a server created with this preset is added to a project. When empty, all tools are enabled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPConnectSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPConnectSession is a session that a client has open with an MCP server through its connect URL.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"transport": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"lastActive": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"streaming": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Streaming is whether the client currently has an event stream open in the session.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "mcpID", "userID", "transport", "created", "lastActive"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPConnectSessionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPConnectSession"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPConnectSession"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPConnectSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPConnectSettings tunes the event streams that clients open through an MCP server's connect URL, for example when clients connect through proxies that drop idle or long-lived connections. A value of 0 uses the server-wide default and a value of -1 disables the setting.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pingIntervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PingIntervalSeconds is the interval between keep-alive pings sent on open event streams.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"idleTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleTimeoutSeconds is how long a session without requests or open streams is kept before it is closed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSessionDurationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSessionDurationSeconds is how long an event stream, or a session using the SSE transport, is kept open before it is closed and the client has to reconnect.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPElicitation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPElicitation is a request from an MCP server for input from the user while one of its tool calls is in progress. The tool call is paused until the user responds or the elicitation expires.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpServerID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpServerDisplayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is either \"form\", in which case the user should respond with content matching RequestedSchema, or \"url\", in which case the user should visit URL to provide the input out-of-band.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"requestedSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestedSchema is the JSON schema of the content requested from the user.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"id", "mcpServerID", "mcpServerDisplayName", "message", "created", "expiresAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPElicitationResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPElicitationResponse is the user's response to an MCPElicitation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the user's input, as a JSON object. It is only used when Action is \"accept\".",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"action"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPEnv(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For static headers",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sensitive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For user-supplied headers",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"oauthTokenSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For values sourced from the user's OAuth token. The current token is injected in place of a user-supplied value and is refreshed automatically.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSource"),
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"dynamicFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DynamicFile indicates that this file will be dynamically read by the process and that the server does not need to be restarted for changes to this file to be picked up. Ignored if File is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom references a secret in an external secret manager, such as vault:secret/data/app#token, that is resolved when the server is deployed instead of being supplied by the user. Only catalog entries can set it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "description", "key", "value", "sensitive", "required", "file"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSource"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPErrorRateStat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPErrorRateStat is the number of calls and errors of an MCP server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpServerDisplayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"callCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"errorCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"errorRate": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+				},
+				Required: []string{"mcpID", "mcpServerDisplayName", "callCount", "errorCount", "errorRate"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPErrorRateStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeStart": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"timeEnd": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"callCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"errorCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"errorRate": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPErrorRateStat"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"timeStart", "timeEnd", "callCount", "errorCount", "errorRate", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPErrorRateStat", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For static headers",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sensitive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For user-supplied headers",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"oauthTokenSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For values sourced from the user's OAuth token. The current token is injected in place of a user-supplied value and is refreshed automatically.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSource"),
+						},
+					},
+				},
+				Required: []string{"name", "description", "key", "value", "sensitive", "required"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSource"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPLogSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPLogSettings configures the logs of MCP servers that are streamed to users.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maskSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaskSecrets redacts the configured credentials of a server, and strings that look like common kinds of credentials, from its logs. It is on by default.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"maskSecrets"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPMaintenanceNotice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPMaintenanceNotice is a planned outage of an MCP server or catalog entry. During the window, users are warned in the results of their tool calls.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"message", "startTime", "endTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPManifestChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPManifestChange is a change to a top-level field of a manifest. Before is empty for added fields, and After is empty for removed fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"field": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"before": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"after": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+				},
+				Required: []string{"field"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthFailure is the number of OAuth failures of a category and code, with the last error message.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"category": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"code": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lastErrorAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"category", "count"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthOverview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthOverview is the health of the OAuth tokens that the current user has stored for MCP servers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"needsAttention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NeedsAttention is the number of tokens that aren't healthy.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenHealth"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"needsAttention", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenHealth"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthScopeChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthScopeChange is an OAuth app that an update to a catalog entry requests new scopes for.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"oauthApp": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"addedScopes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"affectedUsers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AffectedUsers is the number of users who authorized the OAuth app without the added scopes, and who are asked to consent to them the next time they use the server.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"oauthApp", "addedScopes", "affectedUsers"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthScopeGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthScopeGroup is a group of tools of a remote MCP server and the OAuth scopes that they need.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is shown to users on the consent page before they continue to the authorization server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tools are the names of the tools of the group. The scopes of a group without tools are always requested.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"scopes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "scopes"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthTelemetry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthTelemetry is the number of each outcome of the OAuth flows with an MCP server. Flows that were initiated but neither completed nor failed were abandoned by the user or are still in progress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is only set when the report is filtered by user.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"initiated": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"completed": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"refreshed": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"refreshFailed": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"rejected": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"averageExchangeMs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AverageExchangeMs is the average time that the authorization server took to exchange authorization codes.",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"failures": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthFailure"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"mcpID", "initiated", "completed", "failed", "refreshed", "refreshFailed", "rejected", "averageExchangeMs", "failures"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthFailure"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthTelemetryReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthTelemetryReport is the OAuth telemetry of each MCP server in the days overlapping a time range, ordered by the number of failures.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTelemetry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"start", "end", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthTelemetry", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthTokenHealth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthTokenHealth is the health of a user's stored OAuth token for an MCP server. It doesn't include the token.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"warning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Warning explains why the status isn't healthy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiry": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"refreshable": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"checkedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckedAt is when the authorization server last confirmed whether the token is valid. It is unset if the authorization server doesn't support checking tokens.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"mcpID", "status", "refreshable"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthTokenSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthTokenSource declares that a header or environment variable value is the user's access token for an OAuth app registered in Obot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"oauthApp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OAuthApp is the alias of the OAuth app.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scopes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scopes are the scopes to request when the user authorizes the OAuth app.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"oauthApp"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthTokenSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPOAuthTokenSourceStatus reports whether the user has authorized an OAuth app that an MCP server sources values from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"oauthApp": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"keys": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"authenticated": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"missingScopes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MissingScopes are the scopes that the server requests and the user's token wasn't granted, such as after the server started requesting new scopes. The user has to authorize the OAuth app again to consent to them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"oauthApp", "keys", "authenticated"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPOAuthTokenSourceStatusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSourceStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenSourceStatus"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPProjectUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPProjectUsage is the estimated cost of the MCP tool calls that the agent runs of a project made.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"toolCalls": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"estimatedCostUSD": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"estimatedCredits": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+				},
+				Required: []string{"projectID", "toolCalls", "estimatedCostUSD", "estimatedCredits"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPPromptReadStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPPromptReadStats represents statistics for individual prompt reads",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"promptName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"readCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+				},
+				Required: []string{"promptName", "readCount"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPRequestTimeouts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPRequestTimeouts sets how long Obot waits for an MCP server to respond to a request. A value of 0 uses the server-wide default and a value of -1 disables the timeout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"toolsListSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolsListSeconds is the timeout of tools/list requests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"toolsCallSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolsCallSeconds is the timeout of tools/call requests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resourcesReadSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourcesReadSeconds is the timeout of resources/read requests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPResourceReadStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPResourceReadStats represents statistics for individual resource reads",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceURI": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"readCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+				},
+				Required: []string{"resourceURI", "readCount"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPResourceRequests(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPResourceRequests represents the resource requests for an MCP server deployment",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPResourceUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPResourceUpdate is sent to API clients subscribed to a resource when the MCP server reports that the resource changed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpServerID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"uri": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"mcpServerID", "uri", "time"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPRoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPRoot is a filesystem location that an MCP server may operate on, exposed to the server through the roots capability.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"uri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URI must be a file:// URI.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"uri"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPRootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPRoot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPRoot"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPSamplingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPSamplingConfig controls how sampling/createMessage requests from an MCP server are handled. When sampling is enabled, requests are routed through Obot's model providers and the token usage is attributed to the user of the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled allows the server to request LLM completions. Sampling requests are rejected when this is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowedModels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedModels restricts the models the server may use, by model ID or default model alias name. Model hints from the server are honored only if they match an allowed model. The first allowed model is used otherwise. When empty, the default \"llm\" model alias is used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"identifiers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"direction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Direction limits the selector to requests or responses. An empty direction matches both.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerManifest"),
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alias is a user-defined alias for the MCP server. This may only be set for single user and remote MCP servers (i.e. where `MCPCatalogID` is \"\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"configured": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"missingRequiredEnvVars": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"missingRequiredHeader": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"missingOAuthCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"catalogEntryID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"powerUserWorkspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpCatalogID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"connectURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"nanobotAgentID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"connectAlias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectAlias is a vanity hostname, optionally followed by a path, that routes to this multi-user server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalExposure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalExposure exposes this multi-user server directly on a custom hostname, without going through Obot.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposure"),
+						},
+					},
+					"externalURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalURL is the URL that clients connect to this server at directly when it is exposed on a custom hostname.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceNotice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceNotice is the planned outage of this server, or of its catalog entry, if one is scheduled.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice"),
+						},
+					},
+					"inMaintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InMaintenance indicates whether the window of the maintenance notice is in progress.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"readyToConnect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyToConnect indicates whether a client can connect to the server: it is configured, has the OAuth credentials it requires, and is not failing or in maintenance. ConnectBlockers are the reasons if it isn't.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"connectBlockers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"configurationPreset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigurationPreset is the name of the catalog entry preset this server was created with, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pinnedCatalogEntryRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PinnedCatalogEntryRevision is the revision of the catalog entry that this server is pinned to, if it is pinned. Pinned servers are only flagged for updates when their manifest differs from the pinned revision.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"needsUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NeedsUpdate indicates whether the configuration in this server's catalog entry has drift from this server's configuration. Deprecated: use the DriftDetected condition instead.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"needsK8sUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NeedsK8sUpdate indicates whether this server needs redeployment with new K8s settings Deprecated: use the K8sSettingsApplied condition instead.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"needsURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NeedsURL indicates whether the server's URL needs to be updated to match the catalog entry.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"previousURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreviousURL contains the URL of the server before it was updated to match the catalog entry.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mcpServerInstanceUserCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerInstanceUserCount contains the number of unique users with server instances pointing to this MCP server. This is only set for multi-user servers.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deploymentStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentStatus indicates the overall status of the MCP server deployment (Ready, Progressing, Failed).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deploymentAvailableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentAvailableReplicas is the number of available replicas in the deployment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deploymentReadyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentReadyReplicas is the number of ready replicas in the deployment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deploymentReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentReplicas is the desired number of replicas in the deployment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deploymentConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentConditions contains key deployment conditions that indicate deployment health.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeploymentCondition"),
+									},
+								},
+							},
+						},
+					},
+					"k8sSettingsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "K8sSettingsHash contains the hash of K8s settings this server was deployed with",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastHealthyTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHealthyTime is the last time the liveness prober found the server's deployment ready.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"consecutiveProbeFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsecutiveProbeFailures is the number of liveness probes that failed in a row since the server was last healthy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartCount is the number of times the containers of the server's deployment restarted, as of the last liveness probe.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deploymentFailureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentFailureReason is the reason that the server's deployment is failing permanently, such as CrashLoopBackOff, as of the last liveness probe.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialStatus is whether the owner's stored OAuth token for this single-user server is expected to keep working. It is unset if the owner has no token for the server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialWarning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialWarning explains why the CredentialStatus isn't healthy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialExpiry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialExpiry is when the owner's stored OAuth token for this server expires, if it can't be refreshed.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"staleSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleSince is when this single-user server was flagged for not being used, if it is stale.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"staleAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleAction is what happens to this server at StaleActionTime if it is still not used by then.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"staleActionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"memoryUsageBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryUsageBytes is the size of the memories stored by this server, if it is an Obot-managed memory server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"memoryQuotaBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryQuotaBytes is the maximum size of the memories of this memory server, or 0 if there is no limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"workspaceProjectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkspaceProjectID is the project that this server is bound to, if it is an Obot-managed workspace server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workspaceUsageBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkspaceUsageBytes is the size of the files of the project that this workspace server is bound to.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"workspaceQuotaBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkspaceQuotaBytes is the maximum size of the files that this workspace server can write, or 0 if there is no limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template indicates whether this MCP server is a template server. Template servers are hidden from user views and are used for creating project instances.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"compositeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompositeName is the name of the composite server that this MCP server is a component of, if there is one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the Ready, CredentialConfigured, DriftDetected, and K8sSettingsApplied conditions for this server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"Metadata", "manifest", "userID", "configured", "catalogEntryID", "powerUserWorkspaceID", "readyToConnect"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Condition", "github.com/obot-platform/obot/apiclient/types.DeploymentCondition", "github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice", "github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposure", "github.com/obot-platform/obot/apiclient/types.MCPServerManifest", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerBulkRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerBulkRequest performs the same action on each of a list of MCP servers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"serverIDs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"configuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration is what the configure action sets on every server, in the same form as the body of the configure endpoint of a single server.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"action", "serverIDs"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerBulkResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerBulkResponse is the result of a bulk action on MCP servers, with a result for each server in the order of the request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerBulkResult"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"succeeded", "failed", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerBulkResult"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerBulkResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerBulkResult is the result of a bulk action on one MCP server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"statusCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatusCode is the HTTP status code that the action would have had if it were performed on this server alone.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"serverID", "statusCode"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
+						},
+					},
+					"editable": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"catalogName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sourceURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userCount": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"lastUpdated": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"toolPreviewsLastGenerated": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"toolPreviewsError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolPreviewsError is why the tool previews couldn't be generated automatically the last time the entry changed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"powerUserWorkspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"powerUserID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"needsUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"oauthCredentialConfigured": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"maintenanceNotice": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice"),
+						},
+					},
+					"inMaintenance": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"oauthScopeChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OAuthScopeChanges are the OAuth apps that an update to the entry requests new scopes for. It is only set in the response to the update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthScopeChange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"Metadata", "manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Condition", "github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice", "github.com/obot-platform/obot/apiclient/types.MCPOAuthScopeChange", "github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryDryRunResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCatalogEntryDryRunResult is the result of deploying a temporary server from a catalog entry manifest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"tools": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerTool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"succeeded"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerTool"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryFacets(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCatalogEntryFacets counts catalog entries by the values that they can be filtered by. The counts include every entry that the user can see and that matches the search query, regardless of the other filters of the request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runtimes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"categories": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"requiresConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"multiUser": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"runtimes", "categories", "tags", "requiresConfig", "multiUser"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntry"),
+									},
+								},
+							},
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of entries that match the filters, before the limit and offset are applied.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"continue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Continue is the token to pass as the continue query parameter to get the next page. It is empty on the last page.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"facets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Facets counts the entries by each value that they can be filtered by. It is only set when requested.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryFacets"),
+						},
+					},
+				},
+				Required: []string{"items", "total"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntry", "github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryFacets"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"shortDescription": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"icon": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"toolPreview": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerTool"),
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags are free-form labels for finding the server. Categories are set in the categories metadata instead.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"runtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runtime configuration",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uvxConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runtime-specific configurations (only one should be populated based on runtime)",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"),
+						},
+					},
+					"npxConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig"),
+						},
+					},
+					"containerizedConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig"),
+						},
+					},
+					"remoteConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.RemoteCatalogConfig"),
+						},
+					},
+					"compositeConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.CompositeCatalogConfig"),
+						},
+					},
+					"stdioConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.StdioRuntimeConfig"),
+						},
+					},
+					"sourceConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.SourceRuntimeConfig"),
+						},
+					},
+					"multiUserConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MultiUserConfig is the multi-user specific configuration for this component server, if applicable.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MultiUserConfig"),
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPEnv"),
+									},
+								},
+							},
+						},
+					},
+					"startupTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupTimeout configures the timeout to start and connect to an MCP Server. When unset, it defaults to 60s. The maximum allowed value is 600s (10 minutes). Attempting to set a higher value will cause an error.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"toolPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolPolicy restricts which tools can be listed and called on every server created from this catalog entry. It is enforced by the MCP gateway, regardless of any per-project tool selections.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPToolPolicy"),
+						},
+					},
+					"sampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sampling controls whether servers created from this catalog entry may request LLM completions from Obot.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPSamplingConfig"),
+						},
+					},
+					"connectSettings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectSettings overrides the server-wide stream settings of the connect URL for servers created from this catalog entry.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPConnectSettings"),
+						},
+					},
+					"requestTimeouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestTimeouts overrides the server-wide timeouts of the requests Obot makes to servers created from this catalog entry.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPRequestTimeouts"),
+						},
+					},
+					"presets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Presets are named configurations that users can pick from when creating a server from this catalog entry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPConfigurationPreset"),
+									},
+								},
+							},
+						},
+					},
+					"defaultTools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultTools are the tools that are enabled when a server created from this catalog entry is added to a project and the catalog-default tool selection policy applies. When empty, all tools are enabled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"oauthScopeGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OAuthScopeGroups are the OAuth scopes that the tools of remote servers created from this catalog entry need. When set, users are only asked to grant the scopes of the groups that have a tool allowed for their server, instead of the scopes that the server requests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthScopeGroup"),
+									},
+								},
+							},
+						},
+					},
+					"toolCosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolCosts are the estimated costs of calling the tools of servers created from this catalog entry. They are added up in the MCP usage report and the details of the runs that call the tools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPToolCost"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "shortDescription", "description", "icon", "runtime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.CompositeCatalogConfig", "github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.MCPConfigurationPreset", "github.com/obot-platform/obot/apiclient/types.MCPConnectSettings", "github.com/obot-platform/obot/apiclient/types.MCPEnv", "github.com/obot-platform/obot/apiclient/types.MCPOAuthScopeGroup", "github.com/obot-platform/obot/apiclient/types.MCPRequestTimeouts", "github.com/obot-platform/obot/apiclient/types.MCPSamplingConfig", "github.com/obot-platform/obot/apiclient/types.MCPServerTool", "github.com/obot-platform/obot/apiclient/types.MCPToolCost", "github.com/obot-platform/obot/apiclient/types.MCPToolPolicy", "github.com/obot-platform/obot/apiclient/types.MultiUserConfig", "github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.RemoteCatalogConfig", "github.com/obot-platform/obot/apiclient/types.SourceRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.StdioRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCatalogEntryRevision is a snapshot of the manifest of a catalog entry, taken each time the manifest changes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"catalogEntryID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes contains the changes to the manifest since the previous revision.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPManifestChange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"Metadata", "catalogEntryID", "revision", "manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPManifestChange", "github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest", "github.com/obot-platform/obot/apiclient/types.Metadata"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryRevisionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryRevision"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryRevision"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryValidationIssue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCatalogEntryValidationIssue is a problem with a field of a catalog entry manifest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"field": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"message"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryValidationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCatalogEntryValidationRequest is a catalog entry manifest to validate before it is published.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"catalogID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogID or WorkspaceID is where the entry would be published. The components of a composite entry must be in the same catalog or workspace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun deploys a temporary server from the manifest to list its tools, then tears it down. It is only done if the manifest is valid.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config and URL configure the temporary server of a dry run.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"componentConfigs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentConfigs configure the temporary servers of the components of a composite entry, by component ID. Components without a configuration are skipped.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPComponentServerConfig"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPComponentServerConfig", "github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryValidationResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCatalogEntryValidationResult is the result of validating a catalog entry manifest. The manifest is valid if there are no errors and the dry run, if one was requested, succeeded.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"valid": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"errors": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationIssue"),
+									},
+								},
+							},
+						},
+					},
+					"warnings": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationIssue"),
+									},
+								},
+							},
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryDryRunResult"),
+						},
+					},
+				},
+				Required: []string{"valid"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryDryRunResult", "github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationIssue"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerCloneRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCloneRequest represents a request to duplicate a single-user MCP server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alias is the alias for the new server. If not set, the alias of the source server is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the user that will own the new server. Only admins may clone a server for another user. If not set, the new server is owned by the requesting user.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectID, if set, is the project that the new server is added to. The project must belong to the new server's owner.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"copyConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CopyConfiguration copies the non-sensitive environment variables and headers of the source server to the new server.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"copySensitiveConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CopySensitiveConfiguration also copies the sensitive environment variables and headers. It is only allowed when the new server has the same owner as the source server, so that secrets are never copied to another user.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"configuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration sets environment variables and headers of the new server, replacing any copied values. An empty value removes a copied value.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerConnectAliasRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerConnectAliasRequest sets the vanity hostname that routes to a multi-user MCP server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"connectAlias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectAlias is a hostname, optionally followed by a path, such as jira.mcp.example.com or mcp.example.com/jira. An empty value removes the alias.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"connectAlias"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deploymentName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastRestart": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"isAvailable": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason is the reason that a container of the deployment is failing permanently, such as CrashLoopBackOff or ImagePullBackOff, if it is.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerEvent"),
+									},
+								},
+							},
+						},
+					},
+					"components": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Components are the statuses of the components of a composite server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPComponentServerDetails"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"deploymentName", "namespace", "lastRestart", "readyReplicas", "replicas", "isAvailable", "restartCount", "events"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPComponentServerDetails", "github.com/obot-platform/obot/apiclient/types.MCPServerEvent", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"eventType": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"resourceKind": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"time", "reason", "message", "eventType", "action", "count", "resourceName", "resourceKind"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerExternalExposure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerExternalExposure exposes a multi-user MCP server directly on a custom hostname through an Ingress or a Gateway API HTTPRoute that Obot generates, for clients that can't go through Obot's hostname. Requests are still authenticated by the server's shim with tokens issued by Obot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hostname is the custom hostname of the server, such as jira.mcp.partner.example.com.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSSecretName is the secret with the TLS certificate of the hostname in the MCP namespace. When Obot is configured with a cert-manager issuer, the certificate is issued into this secret. Defaults to the name of the server with a -tls suffix. It is not used when servers are exposed through a Gateway, which terminates TLS itself.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"hostname"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerExternalExposureRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerExternalExposureRequest sets or removes the custom hostname that a multi-user MCP server is exposed on.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"externalExposure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalExposure is the exposure of the server. A nil value stops exposing the server.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposure"),
+						},
+					},
+				},
+				Required: []string{"externalExposure"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposure"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerFailureReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerFailureReport is the diagnostics report of an MCP server whose deployment is failing permanently. It is the body of the requests that create tickets for such failures, or the data of the configured ticket template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpServerDisplayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mcpServerCatalogEntryName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mcpCatalogID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"powerUserWorkspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the owner of the server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason is the reason that the deployment is failing, such as CrashLoopBackOff or ImagePullBackOff.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"consecutiveProbeFailures": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"lastHealthyTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary is a one-line description of the failure, and Description is the whole report as plain text, for ticket templates.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"details": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerDetails"),
+						},
+					},
+				},
+				Required: []string{"mcpID", "failureReason", "summary", "description", "details"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerDetails", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"configured": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configured indicates whether all required user-supplied configuration has been provided.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"missingRequiredHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MissingRequiredHeaders contains required headers from the MCP server's multi-user config that have not been provided.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the user that owns this MCP server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mcpServerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerID is the ID of the MCP server this instance is associated with.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mcpCatalogID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPCatalogID is the ID of the MCP catalog that the server that this instance points to is shared within.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mcpServerCatalogEntryID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerCatalogEntryID is the ID of the MCP server catalog entry that the server that this instance points to is based on, if there is one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"powerUserWorkspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PowerUserWorkspaceID is the ID of the PowerUserWorkspace that the server that this instance points to is owned by, if there is one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectURL is the URL to connect to the MCP server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"multiUserConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MultiUserConfig is the multi-user configuration for this instance, which is copied from the MCP server's manifest. This will be nil if the MCP server does not have multi-user config.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MultiUserConfig"),
+						},
+					},
+					"toolAllowlist": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolAllowlist contains the tool name patterns that can be listed and called through this instance, if it is restricted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the Ready and Synced conditions for this instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"readyToConnect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyToConnect indicates whether the user can connect to the instance: its server is ready to connect, and the user has provided the headers that the server requires. ConnectBlockers are the reasons if they can't.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"connectBlockers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"Metadata", "configured", "readyToConnect"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Condition", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.MultiUserConfig"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerInstance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerInstance"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerInstanceOAuthState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerInstanceOAuthState is the OAuth state of the user of an MCP server instance, for troubleshooting. It doesn't include the tokens themselves.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpServerInstanceID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"client": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ClientInfo"),
-						},
-					},
-					"clientIP": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"callType": {
+					"hasToken": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "HasToken indicates whether an OAuth token is stored for the user.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"callIdentifier": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"requestMutated": {
-						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
-						},
-					},
-					"requestBody": {
+					"scopes": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"mutatedRequestBody": {
+					"expiry": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"responseMutated": {
+					"expired": {
 						SchemaProps: spec.SchemaProps{
 							Default: false,
 							Type:    []string{"boolean"},
 							Format:  "",
 						},
 					},
-					"responseBody": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"originalResponseBody": {
+					"hasRefreshToken": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
 						},
 					},
-					"responseStatus": {
+					"pendingAuthorizations": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "PendingAuthorizations is the number of OAuth authorizations that the user started and hasn't finished.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"webhookStatuses": {
+				},
+				Required: []string{"mcpServerInstanceID", "userID", "hasToken", "expired", "hasRefreshToken", "pendingAuthorizations"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerInstanceToolAllowlist(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerInstanceToolAllowlist restricts the tools of an MCP server instance to those matching one of the patterns. Patterns support the `*` and `?` wildcards. An empty allowlist removes the restriction.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allow": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.WebhookStatus"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"error": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"processingTimeMs": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"sessionID": {
+				},
+				Required: []string{"allow"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerLaunchStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerLaunchStatus is the status of the last asynchronous launch of an MCP server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"requestID": {
+					"queuePosition": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "QueuePosition is the position of the server's deployment in the deployment queue, starting at 1, while it is queued.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"userAgent": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"requestHeaders": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"responseHeaders": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
 				},
-				Required: []string{"id", "createdAt", "userID", "mcpID", "mcpServerDisplayName", "mcpServerCatalogEntryName", "client", "clientIP", "callType", "requestMutated", "responseMutated", "responseStatus", "processingTimeMs"},
+				Required: []string{"state"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ClientInfo", "github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.WebhookStatus"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPAuditLogList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPAuditLogList represents a list of MCP audit logs",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"items": {
 						SchemaProps: spec.SchemaProps{
@@ -5712,230 +12433,168 @@ func schema_obot_platform_obot_apiclient_types_MCPAuditLogList(ref common.Refere
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPAuditLog"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServer"),
 									},
 								},
 							},
 						},
 					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of servers that match the search, before the limit and offset are applied.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"continue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Continue is the token to pass as the continue query parameter to get the next page. It is empty on the last page.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPAuditLog"},
+			"github.com/obot-platform/obot/apiclient/types.MCPServer"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPAuditLogResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPAuditLog"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"total": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"limit": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"offset": {
+					"shortDescription": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-				},
-				Required: []string{"items", "total", "limit", "offset"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPAuditLog"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPCapacityInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MCPCapacityInfo represents MCP namespace capacity information",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"source": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source indicates where the capacity data comes from (graceful degradation)",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"cpuRequested": {
+					"icon": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPURequested is the total CPU requested by MCP deployments",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"cpuLimit": {
+					"toolPreview": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPULimit is the CPU limit from ResourceQuota",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerTool"),
+									},
+								},
+							},
 						},
 					},
-					"memoryRequested": {
+					"runtime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryRequested is the total memory requested by MCP deployments",
+							Description: "Runtime configuration",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"memoryLimit": {
+					"uvxConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryLimit is the memory limit from ResourceQuota",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Runtime-specific configurations (only one should be populated based on runtime)",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"),
 						},
 					},
-					"activeDeployments": {
+					"npxConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ActiveDeployments is the number of active MCP server deployments",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig"),
 						},
 					},
-					"error": {
+					"containerizedConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Error message if capacity info couldn't be fully retrieved",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig"),
 						},
 					},
-				},
-				Required: []string{"source", "activeDeployments"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPCatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"remoteConfig": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig"),
 						},
 					},
-					"MCPCatalogManifest": {
+					"compositeConfig": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogManifest"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.CompositeRuntimeConfig"),
 						},
 					},
-					"lastSynced": {
+					"stdioConfig": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.StdioRuntimeConfig"),
 						},
 					},
-					"syncErrors": {
+					"sourceConfig": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.SourceRuntimeConfig"),
 						},
 					},
-					"isSyncing": {
+					"multiUserConfig": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Multi-user specific configuration",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MultiUserConfig"),
 						},
 					},
-				},
-				Required: []string{"Metadata", "MCPCatalogManifest", "lastSynced"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPCatalogManifest", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPCatalogList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"env": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalog"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPEnv"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPCatalog"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPCatalogManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"displayName": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Legacy fields that are deprecated, used only for cleaning up old servers",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sourceURLs": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -5949,904 +12608,943 @@ func schema_obot_platform_obot_apiclient_types_MCPCatalogManifest(ref common.Ref
 							},
 						},
 					},
-					"sourceURLCredentials": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPHeader"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"displayName", "sourceURLs"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPEnv(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"description": {
+					"idleShutdownIntervalHours": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"key": {
+					"startupTimeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"value": {
+					"sampling": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For static headers",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Sampling controls whether this server may request LLM completions from Obot.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPSamplingConfig"),
 						},
 					},
-					"sensitive": {
+					"connectSettings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For user-supplied headers",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ConnectSettings overrides the server-wide stream settings of this server's connect URL.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPConnectSettings"),
 						},
 					},
-					"required": {
+					"requestTimeouts": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "RequestTimeouts overrides the server-wide timeouts of the requests Obot makes to this server.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPRequestTimeouts"),
 						},
 					},
-					"prefix": {
+				},
+				Required: []string{"name", "shortDescription", "description", "icon", "runtime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.CompositeRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.MCPConnectSettings", "github.com/obot-platform/obot/apiclient/types.MCPEnv", "github.com/obot-platform/obot/apiclient/types.MCPHeader", "github.com/obot-platform/obot/apiclient/types.MCPRequestTimeouts", "github.com/obot-platform/obot/apiclient/types.MCPSamplingConfig", "github.com/obot-platform/obot/apiclient/types.MCPServerTool", "github.com/obot-platform/obot/apiclient/types.MultiUserConfig", "github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.SourceRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.StdioRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerNeedingK8sUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerNeedingK8sUpdate represents a server that needs redeployment with new K8s settings",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpServerId": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MCPServerID is the ID of the MCP server that needs updating",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"file": {
+					"mcpServerCatalogEntryId": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "MCPServerCatalogEntryID is the ID of the catalog entry this server was created from, if applicable This field is empty for multi-user servers that were created directly (not from a catalog entry)",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"dynamicFile": {
+					"powerUserWorkspaceId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DynamicFile indicates that this file will be dynamically read by the process and that the server does not need to be restarted for changes to this file to be picked up. Ignored if File is false.",
-							Type:        []string{"boolean"},
+							Description: "PowerUserWorkspaceID is the ID of the workspace this server belongs to, if applicable This field is empty for servers that belong to catalogs (not workspaces)",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "description", "key", "value", "sensitive", "required", "file"},
+				Required: []string{"mcpServerId"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerOAuthCredentialRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPServerOAuthCredentialRequest represents a request to set OAuth credentials for an MCP server",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"description": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"key": {
+					"clientSecret": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"value": {
-						SchemaProps: spec.SchemaProps{
-							Description: "For static headers",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"sensitive": {
+				},
+				Required: []string{"clientID", "clientSecret"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerOAuthCredentialStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerOAuthCredentialStatus represents the status of OAuth credentials for an MCP server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configured": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For user-supplied headers",
+							Description: "Configured is true if OAuth credentials have been set",
 							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"required": {
-						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
-						},
-					},
-					"prefix": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ClientID is the configured client ID (never includes secret)",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "description", "key", "value", "sensitive", "required"},
+				Required: []string{"configured"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPPromptReadStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerPinRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPPromptReadStats represents statistics for individual prompt reads",
+				Description: "MCPServerPinRequest pins an MCP server to a revision of its catalog entry. A revision of 0 unpins the server so that it follows the latest revision.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"promptName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"readCount": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
-							Format:  "int64",
+							Format:  "int32",
 						},
 					},
 				},
-				Required: []string{"promptName", "readCount"},
+				Required: []string{"revision"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPResourceReadStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerReauthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPResourceReadStats represents statistics for individual resource reads",
+				Description: "MCPServerReauthentication is an MCP server that the current user has to authenticate to again, or will have to soon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceURI": {
+					"mcpServerID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"readCount": {
+					"mcpServerInstanceID": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Description: "MCPServerInstanceID is set if the user connects to the multi-user server through an instance.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"resourceURI", "readCount"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPResourceRequests(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MCPResourceRequests represents the resource requests for an MCP server deployment",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"cpu": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"memory": {
+					"connectURL": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"token": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenHealth"),
+						},
+					},
 				},
+				Required: []string{"mcpServerID", "token"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenHealth"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerReauthenticationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"method": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"identifiers": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerReauthentication"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerReauthentication"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerScaffoldRepository(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPServerScaffoldRepository is the Git repository that a scaffolded project is pushed to.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Description: "URL is the HTTPS URL of the repository.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"manifest": {
+					"branch": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerManifest"),
+							Description: "Branch is the branch that the project is pushed to, which must not exist yet. Defaults to main.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"alias": {
+					"token": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Alias is a user-defined alias for the MCP server. This may only be set for single user and remote MCP servers (i.e. where `MCPCatalogID` is \"\").",
+							Description: "Token is used as the password to push to the repository. It isn't stored.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"userID": {
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerScaffoldRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerScaffoldRequest describes a new MCP server project to scaffold.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name is the name of the project, which has to be lowercase letters, numbers, and dashes.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configured": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"missingRequiredEnvVars": {
+					"language": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"missingRequiredHeader": {
+					"env": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Env is the configuration of the server. The project reads it from environment variables, and it is part of the catalog entry manifest that is generated with the project.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPEnv"),
 									},
 								},
 							},
 						},
 					},
-					"missingOAuthCredentials": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"catalogEntryID": {
+					"repository": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Repository is the Git repository that the project is pushed to. If it isn't set, the project is returned as a zip archive.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPServerScaffoldRepository"),
 						},
 					},
-					"powerUserWorkspaceID": {
+				},
+				Required: []string{"name", "language"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPEnv", "github.com/obot-platform/obot/apiclient/types.MCPServerScaffoldRepository"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerScaffoldResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerScaffoldResult is the result of scaffolding a project into a Git repository.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"mcpCatalogID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"connectURL": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"nanobotAgentID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"needsUpdate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NeedsUpdate indicates whether the configuration in this server's catalog entry has drift from this server's configuration.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"needsK8sUpdate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NeedsK8sUpdate indicates whether this server needs redeployment with new K8s settings",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"needsURL": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NeedsURL indicates whether the server's URL needs to be updated to match the catalog entry.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"previousURL": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PreviousURL contains the URL of the server before it was updated to match the catalog entry.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"mcpServerInstanceUserCount": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MCPServerInstanceUserCount contains the number of unique users with server instances pointing to this MCP server. This is only set for multi-user servers.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"deploymentStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeploymentStatus indicates the overall status of the MCP server deployment (Ready, Progressing, Failed).",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"deploymentAvailableReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeploymentAvailableReplicas is the number of available replicas in the deployment.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"deploymentReadyReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeploymentReadyReplicas is the number of ready replicas in the deployment.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"deploymentReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeploymentReplicas is the desired number of replicas in the deployment.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"deploymentConditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeploymentConditions contains key deployment conditions that indicate deployment health.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.DeploymentCondition"),
-									},
-								},
-							},
-						},
-					},
-					"k8sSettingsHash": {
+					"branch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "K8sSettingsHash contains the hash of K8s settings this server was deployed with",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"template": {
+					"commit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template indicates whether this MCP server is a template server. Template servers are hidden from user views and are used for creating project instances.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"compositeName": {
+					"catalogEntryManifest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CompositeName is the name of the composite server that this MCP server is a component of, if there is one.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CatalogEntryManifest is the manifest of a catalog entry that builds and runs the project with the source runtime.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
 						},
 					},
 				},
-				Required: []string{"Metadata", "manifest", "userID", "configured", "catalogEntryID", "powerUserWorkspaceID"},
+				Required: []string{"repository", "branch", "commit", "catalogEntryManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.DeploymentCondition", "github.com/obot-platform/obot/apiclient/types.MCPServerManifest", "github.com/obot-platform/obot/apiclient/types.Metadata"},
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerStaleNotification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPServerStaleNotification is the body of the webhook requests sent about stale single-user MCP servers, so that their owners can be notified.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"manifest": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"editable": {
+					"mcpServerDisplayName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"catalogName": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"sourceURL": {
+					"userEmail": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"userCount": {
+					"lastUsed": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"lastUpdated": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Description: "Action and ActionTime are what will happen to a flagged server, and when, if it is still not used by then.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"toolPreviewsLastGenerated": {
+					"actionTime": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"powerUserWorkspaceID": {
+				},
+				Required: []string{"type", "mcpID", "userID", "lastUsed"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServerTool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"powerUserID": {
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"needsUpdate": {
+					"params": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"oauthCredentialConfigured": {
+					"unsupported": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"Metadata", "manifest"},
+				Required: []string{"id", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerUpdatePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPServerUpdatePreview contains the changes that updating an MCP server from its catalog entry would make.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision of the catalog entry that the server would be updated to.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"changes": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntry"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPManifestChange"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"changes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntry"},
+			"github.com/obot-platform/obot/apiclient/types.MCPManifestChange"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerCatalogEntryManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPServerWatchEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPServerWatchEvent is a change to one of a user's MCP servers, streamed by GET /api/mcp-servers/watch. The Server of a deleted event only has its metadata.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"shortDescription": {
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServer"),
 						},
 					},
-					"description": {
+				},
+				Required: []string{"type", "server"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServer"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPServersNeedingK8sUpdateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServersNeedingK8sUpdateList is a list of servers needing K8s updates",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerNeedingK8sUpdate"),
+									},
+								},
+							},
 						},
 					},
-					"icon": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerNeedingK8sUpdate"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPTeamCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPTeamCredential is a configuration of the servers of a catalog entry that the members of an auth provider group share, so that they don't each have to configure the same API keys. It is used by the single-user servers of the members that aren't configured, as long as an access control rule gives the group access to the catalog entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"groupID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "GroupID is the ID of the auth provider group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"repoURL": {
+					"configuredKeys": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ConfiguredKeys are the names of the configured environment variables and headers. The values are never returned.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"toolPreview": {
+				},
+				Required: []string{"groupID"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPTeamCredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerTool"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPTeamCredential"),
 									},
 								},
 							},
 						},
 					},
-					"runtime": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPTeamCredential"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPToolCallDailyStat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPToolCallDailyStat is the number of calls of a tool of an MCP server on a day",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"date": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Runtime configuration",
+							Description: "Date is the UTC day of the calls, formatted as YYYY-MM-DD",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"uvxConfig": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Runtime-specific configurations (only one should be populated based on runtime)",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"npxConfig": {
+					"mcpServerDisplayName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"containerizedConfig": {
+					"toolName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"remoteConfig": {
+					"callCount": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.RemoteCatalogConfig"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"compositeConfig": {
+					"errorCount": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.CompositeCatalogConfig"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"multiUserConfig": {
+				},
+				Required: []string{"date", "mcpID", "mcpServerDisplayName", "toolName", "callCount", "errorCount"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPToolCallDailyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeStart": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MultiUserConfig is the multi-user specific configuration for this component server, if applicable.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MultiUserConfig"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"env": {
+					"timeEnd": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPEnv"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPToolCallDailyStat"),
 									},
 								},
 							},
 						},
 					},
-					"startupTimeoutSeconds": {
-						SchemaProps: spec.SchemaProps{
-							Description: "StartupTimeout configures the timeout to start and connect to an MCP Server. When unset, it defaults to 60s. The maximum allowed value is 600s (10 minutes). Attempting to set a higher value will cause an error.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
 				},
-				Required: []string{"name", "shortDescription", "description", "icon", "runtime"},
+				Required: []string{"timeStart", "timeEnd", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.CompositeCatalogConfig", "github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.MCPEnv", "github.com/obot-platform/obot/apiclient/types.MCPServerTool", "github.com/obot-platform/obot/apiclient/types.MultiUserConfig", "github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.RemoteCatalogConfig", "github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"},
+			"github.com/obot-platform/obot/apiclient/types.MCPToolCallDailyStat", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPToolCallStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPToolCallStats represents statistics for individual tool calls",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"deploymentName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"namespace": {
+					"toolName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"lastRestart": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"readyReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"replicas": {
+					"callCount": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"isAvailable": {
-						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Format:  "int64",
 						},
 					},
-					"events": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerEvent"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPToolCallStatsItem"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"deploymentName", "namespace", "lastRestart", "readyReplicas", "replicas", "isAvailable", "events"},
+				Required: []string{"toolName", "callCount", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServerEvent", "github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.MCPToolCallStatsItem"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPToolCallStatsItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPToolCallStats represents statistics for individual tool calls",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"time": {
+					"createdAt": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"reason": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"message": {
+					"processingTimeMs": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"eventType": {
+					"responseStatus": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"error": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"action": {
+				},
+				Required: []string{"createdAt", "userID", "processingTimeMs", "responseStatus", "error"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPToolCost(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPToolCost is the estimated cost of one call of a tool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tool": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"count": {
+					"credits": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Credits are the API credits of the upstream service that a call uses.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"resourceName": {
+					"usdPerCall": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "USDPerCall is the price of a call in US dollars.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"resourceKind": {
+					"rateLimitWeight": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "RateLimitWeight is how many requests a call counts as against the upstream service's rate limits.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"time", "reason", "message", "eventType", "action", "count", "resourceName", "resourceKind"},
+				Required: []string{"tool"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPToolPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPToolPolicy is an allowlist/denylist of tool name patterns. Patterns support the `*` and `?` wildcards, e.g. `delete_*`. A tool is allowed if it does not match any deny pattern and either the allow list is empty or it matches an allow pattern.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"configured": {
+					"allow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configured indicates whether all required user-supplied configuration has been provided.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"missingRequiredHeaders": {
+					"deny": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MissingRequiredHeaders contains required headers from the MCP server's multi-user config that have not been provided.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -6858,69 +13556,113 @@ func schema_obot_platform_obot_apiclient_types_MCPServerInstance(ref common.Refe
 							},
 						},
 					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPUsage is the number and duration of the tool calls that a user made to an MCP server, and the tokens used by the server's sampling requests on the user's behalf.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
 					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UserID is the user that owns this MCP server instance.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"mcpServerID": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MCPServerID is the ID of the MCP server this instance is associated with.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"mcpCatalogID": {
+					"mcpServerDisplayName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MCPCatalogID is the ID of the MCP catalog that the server that this instance points to is shared within.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"mcpServerCatalogEntryID": {
+					"toolCalls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MCPServerCatalogEntryID is the ID of the MCP server catalog entry that the server that this instance points to is based on, if there is one.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"powerUserWorkspaceID": {
+					"errorCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PowerUserWorkspaceID is the ID of the PowerUserWorkspace that the server that this instance points to is owned by, if there is one.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"totalDurationMs": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"averageDurationMs": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"samplingPromptTokens": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"samplingCompletionTokens": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"connectURL": {
+					"estimatedCostUSD": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConnectURL is the URL to connect to the MCP server.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"number"},
+							Format: "double",
 						},
 					},
-					"multiUserConfig": {
+					"estimatedCredits": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MultiUserConfig is the multi-user configuration for this instance, which is copied from the MCP server's manifest. This will be nil if the MCP server does not have multi-user config.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MultiUserConfig"),
+							Type:   []string{"number"},
+							Format: "double",
 						},
 					},
 				},
-				Required: []string{"Metadata", "configured"},
+				Required: []string{"userID", "mcpID", "toolCalls", "errorCount", "totalDurationMs", "averageDurationMs"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.MultiUserConfig"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPUsageReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPUsageReport is the usage of MCP servers in a month, per user and server.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"month": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Month is the month of the report, in YYYY-MM format. Months start and end at midnight UTC.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -6928,319 +13670,260 @@ func schema_obot_platform_obot_apiclient_types_MCPServerInstanceList(ref common.
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerInstance"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPUsage"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServerInstance"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPServerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"projects": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Projects are the costs of the tool calls made by agent runs, per project. Other tool calls have no project.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServer"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPProjectUsage"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"month", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServer"},
+			"github.com/obot-platform/obot/apiclient/types.MCPProjectUsage", "github.com/obot-platform/obot/apiclient/types.MCPUsage"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPUsageStatItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPUsageStatItem represents usage statistics for MCP servers",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"shortDescription": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"description": {
+					"mcpServerDisplayName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"icon": {
+					"mcpServerCatalogEntryName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"toolPreview": {
+					"toolCalls": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerTool"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPToolCallStats"),
 									},
 								},
 							},
 						},
 					},
-					"runtime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Runtime configuration",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"uvxConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Runtime-specific configurations (only one should be populated based on runtime)",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"),
-						},
-					},
-					"npxConfig": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig"),
-						},
-					},
-					"containerizedConfig": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig"),
-						},
-					},
-					"remoteConfig": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig"),
-						},
-					},
-					"compositeConfig": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.CompositeRuntimeConfig"),
-						},
-					},
-					"multiUserConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Multi-user specific configuration",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MultiUserConfig"),
-						},
-					},
-					"env": {
+					"resourceReads": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPEnv"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPResourceReadStats"),
 									},
 								},
 							},
 						},
 					},
-					"command": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Legacy fields that are deprecated, used only for cleaning up old servers",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"args": {
+					"promptReads": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPPromptReadStats"),
 									},
 								},
 							},
 						},
 					},
-					"url": {
+				},
+				Required: []string{"mcpID", "mcpServerDisplayName", "mcpServerCatalogEntryName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPPromptReadStats", "github.com/obot-platform/obot/apiclient/types.MCPResourceReadStats", "github.com/obot-platform/obot/apiclient/types.MCPToolCallStats"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MCPUsageStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalCalls": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"headers": {
+					"uniqueUsers": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"timeStart": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"timeEnd": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPHeader"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem"),
 									},
 								},
 							},
 						},
 					},
-					"idleShutdownIntervalHours": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"startupTimeoutSeconds": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
 				},
-				Required: []string{"name", "shortDescription", "description", "icon", "runtime"},
+				Required: []string{"totalCalls", "uniqueUsers", "timeStart", "timeEnd", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.CompositeRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.MCPEnv", "github.com/obot-platform/obot/apiclient/types.MCPHeader", "github.com/obot-platform/obot/apiclient/types.MCPServerTool", "github.com/obot-platform/obot/apiclient/types.MultiUserConfig", "github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"},
+			"github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerNeedingK8sUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPUsageStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPServerNeedingK8sUpdate represents a server that needs redeployment with new K8s settings",
+				Description: "MCPUsageStatsList represents a list of MCP usage statistics",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mcpServerId": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MCPServerID is the ID of the MCP server that needs updating",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"mcpServerCatalogEntryId": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MCPServerCatalogEntryID is the ID of the catalog entry this server was created from, if applicable This field is empty for multi-user servers that were created directly (not from a catalog entry)",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"powerUserWorkspaceId": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PowerUserWorkspaceID is the ID of the workspace this server belongs to, if applicable This field is empty for servers that belong to catalogs (not workspaces)",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"mcpServerId"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerOAuthCredentialRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPWebhookSignatureScheme(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPServerOAuthCredentialRequest represents a request to set OAuth credentials for an MCP server",
+				Description: "MCPWebhookSignatureScheme describes how requests to HTTP webhooks are signed with the webhook secret, so that webhook authors can verify them.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clientID": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clientSecret": {
+					"algorithm": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
-					},
-				},
-				Required: []string{"clientID", "clientSecret"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPServerOAuthCredentialStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MCPServerOAuthCredentialStatus represents the status of OAuth credentials for an MCP server",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"configured": {
+					},
+					"signedPayload": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configured is true if OAuth credentials have been set",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "SignedPayload is the format of the data that is signed.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"clientID": {
+					"timestampHeader": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClientID is the configured client ID (never includes secret)",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"signatureHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureHeader holds one or more comma-separated signatures, each prefixed with the version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"legacySignatureHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LegacySignatureHeader holds a signature of the body alone. It does not protect against replayed requests.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"toleranceSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToleranceSeconds is the recommended maximum age of a request's timestamp.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"configured"},
+				Required: []string{"version", "algorithm", "signedPayload", "timestampHeader", "signatureHeader", "legacySignatureHeader", "toleranceSeconds"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPServerTool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPWebhookValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -7248,25 +13931,21 @@ func schema_obot_platform_obot_apiclient_types_MCPServerTool(ref common.Referenc
 				Properties: map[string]spec.Schema{
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"name": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"description": {
+					"deleted": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"params": {
+					"links": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
@@ -7281,10 +13960,11 @@ func schema_obot_platform_obot_apiclient_types_MCPServerTool(ref common.Referenc
 							},
 						},
 					},
-					"credentials": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -7295,75 +13975,134 @@ func schema_obot_platform_obot_apiclient_types_MCPServerTool(ref common.Referenc
 							},
 						},
 					},
-					"enabled": {
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Resource"),
+									},
+								},
+							},
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mcpServerManifest": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest"),
+						},
+					},
+					"systemMCPServerCatalogEntryID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"toolName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"selectors": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPSelector"),
+									},
+								},
+							},
+						},
+					},
+					"allowedToMutate": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"unsupported": {
+					"disabled": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-				},
-				Required: []string{"id", "name"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPServersNeedingK8sUpdateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MCPServersNeedingK8sUpdateList is a list of servers needing K8s updates",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter indicates that the webhook is a filter that may rewrite or reject tool call arguments and results, rather than only observe them. Filters are always allowed to mutate messages and are invoked synchronously by the MCP server shim. If no selectors are provided, a filter applies to all tool calls, in both directions.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasSecret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"configured": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"missingRequiredEnvVars": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerNeedingK8sUpdate"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"created", "configured"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServerNeedingK8sUpdate"},
+			"github.com/obot-platform/obot/apiclient/types.MCPSelector", "github.com/obot-platform/obot/apiclient/types.Resource", "github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPToolCallStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPWebhookValidationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPToolCallStats represents statistics for individual tool calls",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"toolName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"callCount": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -7371,205 +14110,160 @@ func schema_obot_platform_obot_apiclient_types_MCPToolCallStats(ref common.Refer
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPToolCallStatsItem"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPWebhookValidation"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"toolName", "callCount", "items"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPToolCallStatsItem"},
+			"github.com/obot-platform/obot/apiclient/types.MCPWebhookValidation"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPToolCallStatsItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MCPWebhookValidationManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPToolCallStats represents statistics for individual tool calls",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"createdAt": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"userID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"processingTimeMs": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Resource"),
+									},
+								},
+							},
 						},
 					},
-					"responseStatus": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"error": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-				},
-				Required: []string{"createdAt", "userID", "processingTimeMs", "responseStatus", "error"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MCPUsageStatItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MCPUsageStatItem represents usage statistics for MCP servers",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"mcpID": {
+					"mcpServerManifest": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest"),
 						},
 					},
-					"mcpServerDisplayName": {
+					"systemMCPServerCatalogEntryID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"mcpServerCatalogEntryName": {
+					"toolName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"toolCalls": {
+					"selectors": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPToolCallStats"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPSelector"),
 									},
 								},
 							},
 						},
 					},
-					"resourceReads": {
+					"allowedToMutate": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPResourceReadStats"),
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"promptReads": {
+					"disabled": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPPromptReadStats"),
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter indicates that the webhook is a filter that may rewrite or reject tool call arguments and results, rather than only observe them. Filters are always allowed to mutate messages and are invoked synchronously by the MCP server shim. If no selectors are provided, a filter applies to all tool calls, in both directions.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"mcpID", "mcpServerDisplayName", "mcpServerCatalogEntryName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPPromptReadStats", "github.com/obot-platform/obot/apiclient/types.MCPResourceReadStats", "github.com/obot-platform/obot/apiclient/types.MCPToolCallStats"},
+			"github.com/obot-platform/obot/apiclient/types.MCPSelector", "github.com/obot-platform/obot/apiclient/types.Resource", "github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPUsageStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_Memory(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Memory represents a single memory item",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"totalCalls": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"uniqueUsers": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"timeStart": {
+					"content": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"timeEnd": {
+					"createdAt": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem"),
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"totalCalls", "uniqueUsers", "timeStart", "timeEnd", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem", "github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPUsageStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MemoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MCPUsageStatsList represents a list of MCP usage statistics",
+				Description: "MemoryList represents a list of memories",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -7577,22 +14271,22 @@ func schema_obot_platform_obot_apiclient_types_MCPUsageStatsList(ref common.Refe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Memory"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"Metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPUsageStatItem"},
+			"github.com/obot-platform/obot/apiclient/types.Memory", "github.com/obot-platform/obot/apiclient/types.Metadata"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPWebhookValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -7650,275 +14344,248 @@ func schema_obot_platform_obot_apiclient_types_MCPWebhookValidation(ref common.R
 							Format: "",
 						},
 					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Resource"),
-									},
-								},
-							},
-						},
-					},
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"secret": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"mcpServerManifest": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"systemMCPServerCatalogEntryID": {
+					"definition": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"toolName": {
+					"direction": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"selectors": {
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPSelector"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
 									},
 								},
 							},
 						},
 					},
-					"allowedToMutate": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"disabled": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"hasSecret": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"configured": {
-						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
-						},
-					},
-					"missingRequiredEnvVars": {
+				},
+				Required: []string{"created", "displayName", "definition", "direction"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Subject", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MessagePolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicy"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"created", "configured"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPSelector", "github.com/obot-platform/obot/apiclient/types.Resource", "github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest", "github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.MessagePolicy"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPWebhookValidationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"definition": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"direction": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPWebhookValidation"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"displayName", "definition", "direction"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPWebhookValidation"},
+			"github.com/obot-platform/obot/apiclient/types.Subject"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MCPWebhookValidationManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"resources": {
+					"createdAt": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Resource"),
-									},
-								},
-							},
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"url": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"secret": {
+					"policyID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"mcpServerManifest": {
+					"policyName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"systemMCPServerCatalogEntryID": {
+					"policyDefinition": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"toolName": {
+					"direction": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"selectors": {
+					"violationExplanation": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPSelector"),
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"allowedToMutate": {
+					"blockedContent": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
-					"disabled": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"threadID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
+				Required: []string{"id", "createdAt", "userID", "policyID", "policyName", "policyDefinition", "direction", "violationExplanation", "projectID", "threadID"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPSelector", "github.com/obot-platform/obot/apiclient/types.Resource", "github.com/obot-platform/obot/apiclient/types.SystemMCPServerManifest"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Memory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationDirectionCounts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Memory represents a single memory item",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"content": {
+					"userMessage": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"createdAt": {
+					"toolCalls": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
 				},
+				Required: []string{"userMessage", "toolCalls"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MemoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MemoryList represents a list of memories",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -7926,217 +14593,204 @@ func schema_obot_platform_obot_apiclient_types_MemoryList(ref common.ReferenceCa
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Memory"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"Metadata", "items"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Memory", "github.com/obot-platform/obot/apiclient/types.Metadata"},
+			"github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationPolicyCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"created": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"deleted": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"links": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"displayName": {
+					"policyID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"definition": {
+					"policyName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"direction": {
+					"count": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"subjects": {
+				},
+				Required: []string{"policyID", "policyName", "count"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"),
 									},
 								},
 							},
 						},
 					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"offset": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
 				},
-				Required: []string{"created", "displayName", "definition", "direction"},
+				Required: []string{"items", "total", "limit", "offset"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Subject", "github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"byTime": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationTimeBucket"),
+									},
+								},
+							},
+						},
+					},
+					"byPolicy": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicy"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationPolicyCount"),
+									},
+								},
+							},
+						},
+					},
+					"byUser": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationUserCount"),
 									},
 								},
 							},
 						},
 					},
+					"byDirection": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationDirectionCounts"),
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"byTime", "byPolicy", "byUser", "byDirection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MessagePolicy"},
+			"github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationDirectionCounts", "github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationPolicyCount", "github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationTimeBucket", "github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationUserCount"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationTimeBucket(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"displayName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"definition": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"direction": {
+					"category": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"subjects": {
+					"count": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
-									},
-								},
-							},
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
 				},
-				Required: []string{"displayName", "definition", "direction"},
+				Required: []string{"time", "category", "count"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Subject"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationUserCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"createdAt": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
 					"userID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -8144,63 +14798,80 @@ func schema_obot_platform_obot_apiclient_types_MessagePolicyViolation(ref common
 							Format:  "",
 						},
 					},
-					"policyID": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"policyName": {
+					"count": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"policyDefinition": {
+				},
+				Required: []string{"userID", "count"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"direction": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"violationExplanation": {
+					"deleted": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"blockedContent": {
+					"links": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"projectID": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"threadID": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"id", "createdAt", "userID", "policyID", "policyName", "policyDefinition", "direction", "violationExplanation", "projectID", "threadID"},
+				Required: []string{"created"},
 			},
 		},
 		Dependencies: []string{
@@ -8208,96 +14879,139 @@ func schema_obot_platform_obot_apiclient_types_MessagePolicyViolation(ref common
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationDirectionCounts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_Model(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userMessage": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"toolCalls": {
+					"ModelManifest": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelManifest"),
+						},
+					},
+					"ModelStatus": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelStatus"),
 						},
 					},
 				},
-				Required: []string{"userMessage", "toolCalls"},
+				Required: []string{"Metadata", "ModelManifest", "ModelStatus"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ModelManifest", "github.com/obot-platform/obot/apiclient/types.ModelStatus"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelAccessPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"deleted": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"links": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationPolicyCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"policyID": {
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"policyName": {
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
+									},
+								},
+							},
 						},
 					},
-					"count": {
+					"models": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelResource"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"policyID", "policyName", "count"},
+				Required: []string{"created"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ModelResource", "github.com/obot-platform/obot/apiclient/types.Subject", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelAccessPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8310,264 +15024,274 @@ func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationResponse(re
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelAccessPolicy"),
 									},
 								},
 							},
 						},
 					},
-					"total": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"limit": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"offset": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
 				},
-				Required: []string{"items", "total", "limit", "offset"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MessagePolicyViolation"},
+			"github.com/obot-platform/obot/apiclient/types.ModelAccessPolicy"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelAccessPolicyManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"byTime": {
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationTimeBucket"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
 									},
 								},
 							},
 						},
 					},
-					"byPolicy": {
+					"models": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationPolicyCount"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelResource"),
 									},
 								},
 							},
 						},
 					},
-					"byUser": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ModelResource", "github.com/obot-platform/obot/apiclient/types.Subject"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ModelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationUserCount"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Model"),
 									},
 								},
 							},
 						},
 					},
-					"byDirection": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationDirectionCounts"),
-						},
-					},
 				},
-				Required: []string{"byTime", "byPolicy", "byUser", "byDirection"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationDirectionCounts", "github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationPolicyCount", "github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationTimeBucket", "github.com/obot-platform/obot/apiclient/types.MessagePolicyViolationUserCount"},
+			"github.com/obot-platform/obot/apiclient/types.Model"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationTimeBucket(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"time": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"category": {
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targetModel": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"modelProvider": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"usage": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"count": {
+					"dialect": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"time", "category", "count"},
+				Required: []string{"active", "usage"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_MessagePolicyViolationUserCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userID": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"count": {
+					"ModelProviderManifest": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderManifest"),
+						},
+					},
+					"ModelProviderStatus": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"),
 						},
 					},
 				},
-				Required: []string{"userID", "count"},
+				Required: []string{"Metadata", "ModelProviderManifest", "ModelProviderStatus"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ModelProviderManifest", "github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelProviderList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"created": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"deleted": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"links": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProvider"),
 									},
 								},
 							},
 						},
 					},
-					"metadata": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ModelProvider"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ModelProviderManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"type": {
+					"toolReference": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"created"},
+				Required: []string{"name", "toolReference"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Model(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"ModelManifest": {
+					"CommonProviderStatus": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelManifest"),
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.CommonProviderStatus"),
 						},
 					},
-					"ModelStatus": {
+					"modelsBackPopulated": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelStatus"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"Metadata", "ModelManifest", "ModelStatus"},
+				Required: []string{"CommonProviderStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ModelManifest", "github.com/obot-platform/obot/apiclient/types.ModelStatus"},
+			"github.com/obot-platform/obot/apiclient/types.CommonProviderStatus"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelAccessPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8575,176 +15299,220 @@ func schema_obot_platform_obot_apiclient_types_ModelAccessPolicy(ref common.Refe
 				Properties: map[string]spec.Schema{
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"created": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"deleted": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Description: "ID is the unique identifier of the model resource. It either be: - the wildcard '*', which selects all available models - the model ID of a specific model - an Obot default model alias in the form \"obot://<alias>\"\n\nWhen a model ID is provided, it must match the ID field of an existing referenced model.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"links": {
+				},
+				Required: []string{"id"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ModelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"aliasAssigned": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"modelProviderName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"type": {
+					"icon": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"displayName": {
+					"iconDark": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"subjects": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
-									},
-								},
-							},
-						},
-					},
-					"models": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelResource"),
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"created"},
+				Required: []string{"modelProviderName"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ModelResource", "github.com/obot-platform/obot/apiclient/types.Subject", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelAccessPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_MultiUserConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MultiUserConfig represents configuration for multi-user MCP servers in catalog entries",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"userDefinedHeaders": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Headers that users should provide when configuring their server instance.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelAccessPolicy"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPHeader"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ModelAccessPolicy"},
+			"github.com/obot-platform/obot/apiclient/types.MCPHeader"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelAccessPolicyManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NPXRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NPXRuntimeConfig represents configuration for NPX runtime (Node.js packages via npx)",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"displayName": {
+					"package": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"subjects": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Required: NPM package name",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Subject"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"models": {
+					"egressDomains": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Optional: Additional arguments",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelResource"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"denyAllEgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Empty means allow all, otherwise allow only the listed domains when network policy enforcement is enabled",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"package"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_NanobotAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NanobotAgent represents a nanobot workflow in the API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"NanobotAgentManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.NanobotAgentManifest"),
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"projectV2ID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"connectURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"needsUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"needsK8sUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"needsURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"deploymentStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
+				Required: []string{"Metadata", "NanobotAgentManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ModelResource", "github.com/obot-platform/obot/apiclient/types.Subject"},
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.NanobotAgentManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NanobotAgentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NanobotAgentList is a list of nanobot workflows",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"items": {
 						SchemaProps: spec.SchemaProps{
@@ -8753,7 +15521,7 @@ func schema_obot_platform_obot_apiclient_types_ModelList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Model"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NanobotAgent"),
 									},
 								},
 							},
@@ -8764,74 +15532,52 @@ func schema_obot_platform_obot_apiclient_types_ModelList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Model"},
+			"github.com/obot-platform/obot/apiclient/types.NanobotAgent"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NanobotAgentManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NanobotAgentManifest contains the user-editable fields for a nanobot workflow",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
 					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"targetModel": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"modelProvider": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"alias": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"active": {
-						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
-						},
-					},
-					"usage": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"dialect": {
+					"defaultAgent": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"active", "usage"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OAuthApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8843,28 +15589,22 @@ func schema_obot_platform_obot_apiclient_types_ModelProvider(ref common.Referenc
 							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"ModelProviderManifest": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderManifest"),
-						},
-					},
-					"ModelProviderStatus": {
+					"OAuthAppManifest": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"),
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"),
 						},
 					},
 				},
-				Required: []string{"Metadata", "ModelProviderManifest", "ModelProviderStatus"},
+				Required: []string{"Metadata", "OAuthAppManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ModelProviderManifest", "github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"},
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelProviderList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8877,7 +15617,7 @@ func schema_obot_platform_obot_apiclient_types_ModelProviderList(ref common.Refe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProvider"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthApp"),
 									},
 								},
 							},
@@ -8888,169 +15628,188 @@ func schema_obot_platform_obot_apiclient_types_ModelProviderList(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ModelProvider"},
+			"github.com/obot-platform/obot/apiclient/types.OAuthApp"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelProviderManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"toolReference": {
+					"authenticated": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-				},
-				Required: []string{"name", "toolReference"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ModelProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"CommonProviderStatus": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.CommonProviderStatus"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"modelsBackPopulated": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"CommonProviderStatus"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.CommonProviderStatus"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the unique identifier of the model resource. It either be: - the wildcard '*', which selects all available models - the model ID of a specific model - an Obot default model alias in the form \"obot://<alias>\"\n\nWhen a model ID is provided, it must match the ID field of an existing referenced model.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-				},
-				Required: []string{"id"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ModelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"aliasAssigned": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"modelProviderName": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"icon": {
+					"clientSecret": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"iconDark": {
+					"authURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "These fields are only needed for custom OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenURL": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-				},
-				Required: []string{"modelProviderName"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_MultiUserConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MultiUserConfig represents configuration for multi-user MCP servers in catalog entries",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"userDefinedHeaders": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Headers that users should provide when configuring their server instance.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPHeader"),
-									},
-								},
-							},
+							Description: "This field is only needed for Microsoft 365 OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is only needed for HubSpot OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"optionalScope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is optional for HubSpot OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is required, it correlates to the integration name in the gptscript oauth cred tool",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"global": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Global indicates if the OAuth app is globally applied to all agents. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"instanceURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is only used by Salesforce",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gitlabBaseURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is used for GitLab enterprise instances",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authorizationServerURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthorizationServerURL is the URL used in the MCP oauth flow",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider overrides how Obot deals with the quirks of the app's provider. Apps of the built-in types that don't set it use the quirks of their provider.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.OAuthAppProvider"),
 						},
 					},
 				},
+				Required: []string{"type", "clientID"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPHeader"},
+			"github.com/obot-platform/obot/apiclient/types.OAuthAppProvider"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_NPXRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthAppProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NPXRuntimeConfig represents configuration for NPX runtime (Node.js packages via npx)",
+				Description: "OAuthAppProvider describes how a provider deviates from standard OAuth 2.0, so that providers with quirks can be used without code changes. Apps of the built-in types that don't set it use the quirks of their provider.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"package": {
+					"authorizeParams": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "AuthorizeParams are added to the query of the authorization URL.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"args": {
+					"passthroughAuthorizeParams": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: NPM package name",
+							Description: "PassthroughAuthorizeParams are the query parameters that are copied from the authorize request, if they are set, to the authorization URL.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9063,9 +15822,32 @@ func schema_obot_platform_obot_apiclient_types_NPXRuntimeConfig(ref common.Refer
 							},
 						},
 					},
-					"egressDomains": {
+					"omitEmptyScope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Additional arguments",
+							Description: "OmitEmptyScope leaves the scope out of the authorization URL when no scope is requested.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tokenParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenParams are added to the body of the requests that exchange authorization codes for tokens.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"refreshOmitParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshOmitParams are the standard parameters, such as scope or redirect_uri, that are left out of refresh requests.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9078,26 +15860,47 @@ func schema_obot_platform_obot_apiclient_types_NPXRuntimeConfig(ref common.Refer
 							},
 						},
 					},
-					"denyAllEgress": {
+					"clientAuthMethod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Empty means allow all, otherwise allow only the listed domains when network policy enforcement is enabled",
-							Type:        []string{"boolean"},
+							Description: "ClientAuthMethod is how the client credentials are sent to the token endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenResponseFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenResponseFormat is the format of the responses of the token endpoint. Defaults to json.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"extras": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extras are added to the credentials of the tokens, for tools that need them.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"package"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_NanobotAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthClient(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NanobotAgent represents a nanobot workflow in the API",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"Metadata": {
 						SchemaProps: spec.SchemaProps{
@@ -9105,161 +15908,90 @@ func schema_obot_platform_obot_apiclient_types_NanobotAgent(ref common.Reference
 							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"NanobotAgentManifest": {
+					"OAuthClientManifest": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.NanobotAgentManifest"),
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthClientManifest"),
 						},
 					},
-					"userID": {
+					"registration_access_token": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"projectV2ID": {
+					"registration_token_issued_at": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"connectURL": {
+					"registration_token_expires_at": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"needsUpdate": {
+					"registration_client_uri": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"needsK8sUpdate": {
+					"client_id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"needsURL": {
+					"client_secret": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"deploymentStatus": {
+					"client_secret_issued_at": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-				},
-				Required: []string{"Metadata", "NanobotAgentManifest"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.NanobotAgentManifest"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_NanobotAgentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NanobotAgentList is a list of nanobot workflows",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"client_secret_expires_at": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NanobotAgent"),
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.NanobotAgent"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_NanobotAgentManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NanobotAgentManifest contains the user-editable fields for a nanobot workflow",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"displayName": {
+					"static": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"description": {
+					"authorize_url": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"defaultAgent": {
+					"token_url": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_NotionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_OAuthApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"OAuthAppManifest": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"),
-						},
-					},
-				},
-				Required: []string{"Metadata", "OAuthAppManifest"},
+				Required: []string{"Metadata", "OAuthClientManifest", "registration_client_uri", "client_id"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"},
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.OAuthClientManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthClientList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -9272,7 +16004,7 @@ func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.Reference
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthApp"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthClient"),
 									},
 								},
 							},
@@ -9283,316 +16015,320 @@ func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.OAuthApp"},
+			"github.com/obot-platform/obot/apiclient/types.OAuthClient"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthClientManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"authenticated": {
+					"redirect_uri": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "RedirectURI is a single redirection URI string Maintained for backward compatibility\n\nDeprecated: use RedirectURIs instead",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"required": {
+					"redirect_uris": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "RedirectURIs is an array of redirection URI strings for use in redirect-based flows such as the authorization code and implicit flows. As required by Section 2 of OAuth 2.0 [RFC6749], clients using flows with redirection MUST register their redirection URI values. Required for redirect-based flows.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"error": {
+					"token_endpoint_auth_method": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "TokenEndpointAuthMethod is a string indicator of the requested authentication method for the token endpoint. Values defined include: \"none\", \"client_secret_post\", \"client_secret_basic\". If unspecified or omitted, the default is \"client_secret_basic\". Optional.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"grant_types": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "GrantTypes is an array of OAuth 2.0 grant type strings that the client can use at the token endpoint. If omitted, the default behavior is that the client will use only the \"authorization_code\" Grant Type. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"name": {
+					"allowed_audiences": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "AllowedAudiences are the mcp-connect URLs that the client can request tokens for with the client_credentials grant. Only clients created by admins can use the client_credentials grant. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"clientID": {
+					"response_types": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "ResponseTypes is an array of the OAuth 2.0 response type strings that the client can use at the authorization endpoint. If omitted, the default is that the client will use only the \"code\" response type. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"clientSecret": {
+					"client_name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ClientName is a human-readable string name of the client to be presented to the end-user during authorization. If omitted, the authorization server MAY display the raw \"client_id\" value to the end-user instead. It is RECOMMENDED that clients always send this field. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"authURL": {
+					"client_uri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "These fields are only needed for custom OAuth apps.",
+							Description: "ClientURI is a URL string of a web page providing information about the client. If present, the server SHOULD display this URL to the end-user in a clickable fashion. It is RECOMMENDED that clients always send this field. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tokenURL": {
+					"logo_uri": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LogoURI is a URL string that references a logo for the client. If present, the server SHOULD display this image to the end-user during approval. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tenantID": {
+					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is only needed for Microsoft 365 OAuth apps.",
+							Description: "Scope is a string containing a space-separated list of scope values that the client can use when requesting access tokens. If omitted, an authorization server MAY register a client with a default set of scopes. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"appID": {
+					"contacts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is only needed for HubSpot OAuth apps.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Contacts is an array of strings representing ways to contact people responsible for this client, typically email addresses. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"optionalScope": {
+					"tos_uri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is optional for HubSpot OAuth apps.",
+							Description: "TOSURI is a URL string that points to a human-readable terms of service document for the client. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"alias": {
+					"policy_uri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is required, it correlates to the integration name in the gptscript oauth cred tool",
+							Description: "PolicyURI is a URL string that points to a human-readable privacy policy document. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"global": {
+					"jwks_uri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Global indicates if the OAuth app is globally applied to all agents. Defaults to false.",
-							Type:        []string{"boolean"},
+							Description: "JWKSURI is a URL string referencing the client's JSON Web Key (JWK) Set document, which contains the client's public keys. The \"jwks_uri\" and \"jwks\" parameters MUST NOT both be present in the same request or response. Optional.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"instanceURL": {
+					"jwks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is only used by Salesforce",
+							Description: "JWKS is the client's JSON Web Key Set document value, which contains the client's public keys. This parameter is intended to be used by clients that cannot use the \"jwks_uri\" parameter. The \"jwks_uri\" and \"jwks\" parameters MUST NOT both be present in the same request or response. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"gitlabBaseURL": {
+					"software_id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is used for GitLab enterprise instances",
+							Description: "SoftwareID is a unique identifier string assigned by the client developer or software publisher used by registration endpoints to identify the client software to be dynamically registered. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"authorizationServerURL": {
+					"software_version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthorizationServerURL is the URL used in the MCP oauth flow",
+							Description: "SoftwareVersion is a version identifier string for the client software identified by \"software_id\". Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "clientID"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthClient(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"access_token": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"OAuthClientManifest": {
+					"refresh_token": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthClientManifest"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"registration_access_token": {
+					"expires_in": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"registration_token_issued_at": {
+					"token_type": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"registration_token_expires_at": {
+					"id_token": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "IDToken is the OpenID Connect ID token, issued when the openid scope is granted.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"registration_client_uri": {
+				},
+				Required: []string{"access_token", "refresh_token", "expires_in", "token_type"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OnEmail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"client_id": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"client_secret": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"client_secret_issued_at": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"client_secret_expires_at": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"static": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"authorize_url": {
+					"alias": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"token_url": {
+					"workflowName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-				},
-				Required: []string{"Metadata", "OAuthClientManifest", "registration_client_uri", "client_id"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.OAuthClientManifest"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_OAuthClientList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"allowedSenders": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthClient"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "description", "workflowName"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.OAuthClient"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthClientManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OnWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"redirect_uri": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RedirectURI is a single redirection URI string Maintained for backward compatibility\n\nDeprecated: use RedirectURIs instead",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"redirect_uris": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RedirectURIs is an array of redirection URI strings for use in redirect-based flows such as the authorization code and implicit flows. As required by Section 2 of OAuth 2.0 [RFC6749], clients using flows with redirection MUST register their redirection URI values. Required for redirect-based flows.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"token_endpoint_auth_method": {
+					"alias": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TokenEndpointAuthMethod is a string indicator of the requested authentication method for the token endpoint. Values defined include: \"none\", \"client_secret_post\", \"client_secret_basic\". If unspecified or omitted, the default is \"client_secret_basic\". Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"grant_types": {
+					"workflowName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GrantTypes is an array of OAuth 2.0 grant type strings that the client can use at the token endpoint. If omitted, the default behavior is that the client will use only the \"authorization_code\" Grant Type. Optional.",
-							Type:        []string{"array"},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -9604,10 +16340,36 @@ func schema_obot_platform_obot_apiclient_types_OAuthClientManifest(ref common.Re
 							},
 						},
 					},
-					"response_types": {
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"validationHeader": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResponseTypes is an array of the OAuth 2.0 response type strings that the client can use at the authorization endpoint. If omitted, the default is that the client will use only the \"code\" response type. Optional.",
-							Type:        []string{"array"},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"name", "description", "alias", "workflowName", "headers", "secret", "validationHeader"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sharedLinks": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -9619,282 +16381,350 @@ func schema_obot_platform_obot_apiclient_types_OAuthClientManifest(ref common.Re
 							},
 						},
 					},
-					"client_name": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingCatalogEntry is a catalog entry, or an edit to one, that is proposed by a user and reviewed by an admin. Approving it creates or updates the catalog entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClientName is a human-readable string name of the client to be presented to the end-user during authorization. If omitted, the authorization server MAY display the raw \"client_id\" value to the end-user instead. It is RECOMMENDED that clients always send this field. Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"client_uri": {
+					"PendingCatalogEntryManifest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClientURI is a URL string of a web page providing information about the client. If present, the server SHOULD display this URL to the end-user in a clickable fashion. It is RECOMMENDED that clients always send this field. Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryManifest"),
 						},
 					},
-					"logo_uri": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LogoURI is a URL string that references a logo for the client. If present, the server SHOULD display this image to the end-user during approval. Optional.",
+							Description: "UserID is the user who submitted the entry.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"scope": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scope is a string containing a space-separated list of scope values that the client can use when requesting access tokens. If omitted, an authorization server MAY register a client with a default set of scopes. Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"contacts": {
+					"comments": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contacts is an array of strings representing ways to contact people responsible for this client, typically email addresses. Optional.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryComment"),
 									},
 								},
 							},
 						},
 					},
-					"tos_uri": {
+					"reviewerID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TOSURI is a URL string that points to a human-readable terms of service document for the client. Optional.",
+							Description: "ReviewerID is the admin who approved or rejected the entry.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"policy_uri": {
+					"reviewedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PolicyURI is a URL string that points to a human-readable privacy policy document. Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"jwks_uri": {
+					"approvedCatalogEntryID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JWKSURI is a URL string referencing the client's JSON Web Key (JWK) Set document, which contains the client's public keys. The \"jwks_uri\" and \"jwks\" parameters MUST NOT both be present in the same request or response. Optional.",
+							Description: "ApprovedCatalogEntryID is the catalog entry that was created or updated when the entry was approved.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jwks": {
+					"vendor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JWKS is the client's JSON Web Key Set document value, which contains the client's public keys. This parameter is intended to be used by clients that cannot use the \"jwks_uri\" parameter. The \"jwks_uri\" and \"jwks\" parameters MUST NOT both be present in the same request or response. Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Vendor is the vendor that submitted the entry through the submission portal of the catalog. UserID is empty for these entries.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryVendor"),
 						},
 					},
-					"software_id": {
+					"checks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SoftwareID is a unique identifier string assigned by the client developer or software publisher used by registration endpoints to identify the client software to be dynamically registered. Optional.",
+							Description: "Checks are the results of the automatic checks of entries submitted through the submission portal.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryChecks"),
+						},
+					},
+				},
+				Required: []string{"Metadata", "PendingCatalogEntryManifest", "userID", "state"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryChecks", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryComment", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryManifest", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryVendor", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntryCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingCatalogEntryCheck is the result of an automatic check of a submitted catalog entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why the check failed or was skipped.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"software_version": {
+					"output": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SoftwareVersion is a version identifier string for the client software identified by \"software_id\". Optional.",
+							Description: "Output is the output of the image scanner.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"state"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntryChecks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PendingCatalogEntryChecks are the results of the automatic checks of a catalog entry submitted through the submission portal of a catalog. Submissions that fail linting are rejected right away, so only the warnings of the linter are recorded. The image scan and sandbox test only run once an admin triages the submission.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"access_token": {
+					"lintWarnings": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationIssue"),
+									},
+								},
+							},
 						},
 					},
-					"refresh_token": {
+					"imageScan": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "ImageScan scans the image of containerized entries for vulnerabilities.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryCheck"),
 						},
 					},
-					"expires_in": {
+					"sandboxTest": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "SandboxTest deploys a temporary server from the entry and lists its tools.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryCheck"),
 						},
 					},
-					"token_type": {
+					"tools": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerTool"),
+									},
+								},
+							},
+						},
+					},
+					"triagedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriagedAt is when an admin last started the image scan and sandbox test.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
 				},
-				Required: []string{"access_token", "refresh_token", "expires_in", "token_type"},
+				Required: []string{"imageScan", "sandboxTest"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryValidationIssue", "github.com/obot-platform/obot/apiclient/types.MCPServerTool", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryCheck", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OnEmail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntryComment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PendingCatalogEntryComment is a comment from the submitter or a reviewer of a pending catalog entry.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"description": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"alias": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"workflowName": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"allowedSenders": {
+				},
+				Required: []string{"userID", "message", "created"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntry"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name", "description", "workflowName"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntry"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OnWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntryManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PendingCatalogEntryManifest is a catalog entry, or an edit to one, that a user proposes for a catalog.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"catalogID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "CatalogID is the catalog that the entry is proposed for. It defaults to the default catalog.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"description": {
+					"catalogEntryID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "CatalogEntryID is the entry that the manifest replaces when the submission is an edit. It is empty for new entries.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"alias": {
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
 						},
 					},
-					"workflowName": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Message explains the submission to the reviewers.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"headers": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+				},
+				Required: []string{"manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntryReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingCatalogEntryReview is the body of requests to comment on, approve, or reject a pending catalog entry. The message is added as a comment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"secret": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_PendingCatalogEntryVendor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingCatalogEntryVendor is a vendor that submitted a catalog entry through the submission portal of a catalog.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"validationHeader": {
+					"email": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-				},
-				Required: []string{"name", "description", "alias", "workflowName", "headers", "secret", "validationHeader"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"sharedLinks": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"name", "email"},
 			},
 		},
 	}
@@ -10248,6 +17078,12 @@ func schema_obot_platform_obot_apiclient_types_Project(ref common.ReferenceCallb
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
+					"toolRecertificationRequired": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
 				},
 				Required: []string{"Metadata", "ProjectManifest", "editor"},
 			},
@@ -10518,8 +17354,29 @@ func schema_obot_platform_obot_apiclient_types_ProjectMCPServer(ref common.Refer
 							Format: "",
 						},
 					},
+					"readyToConnect": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"connectBlockers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"Metadata", "ProjectMCPServerManifest", "name", "description", "icon", "userID", "configured", "needsURL", "needsUpdate", "needsK8sUpdate"},
+				Required: []string{"Metadata", "ProjectMCPServerManifest", "name", "description", "icon", "userID", "configured", "needsURL", "needsUpdate", "needsK8sUpdate", "readyToConnect"},
 			},
 		},
 		Dependencies: []string{
@@ -11402,6 +18259,167 @@ func schema_obot_platform_obot_apiclient_types_PublishedArtifactVersionSummary(r
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_ReconcileHandlerStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReconcileHandlerStats contains reconcile statistics for a single controller handler and object kind.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"handler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Handler is the name of the handler function.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of object the handler reconciles.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reconciles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reconciles is the total number of times the handler has run since the server started.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"errors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Errors is the total number of times the handler has returned an error since the server started.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"backlog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backlog is the number of objects whose most recent reconcile failed and are waiting to be retried.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReconcileTime is the last time the handler ran.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"failingObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailingObjects contains the last error for each object currently in the backlog.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ReconcileObjectError"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"handler", "kind", "reconciles", "errors", "backlog"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ReconcileObjectError", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ReconcileObjectError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReconcileObjectError describes the most recent reconcile error for a single object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is the number of consecutive failed reconciles for this object.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"firstFailureTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FirstFailureTime is the time of the first failure in the current run of consecutive failures.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"lastFailureTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastFailureTime is the time of the most recent failure.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "error", "retryCount", "firstFailureTime", "lastFailureTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ReconcileStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReconcileStats contains reconcile statistics for the MCP-related controller handlers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"handlers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ReconcileHandlerStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"handlers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ReconcileHandlerStats"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_RegistryGitHubMeta(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11467,6 +18485,21 @@ func schema_obot_platform_obot_apiclient_types_RegistryObotMeta(ref common.Refer
 							Format: "",
 						},
 					},
+					"connectBlockers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectBlockers are the reasons that the server isn't ready to connect to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -11823,18 +18856,65 @@ func schema_obot_platform_obot_apiclient_types_RegistryServerResponse(ref common
 							Ref:     ref("github.com/obot-platform/obot/apiclient/types.RegistryServerDetail"),
 						},
 					},
-					"_meta": {
+					"_meta": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.RegistryMeta"),
+						},
+					},
+				},
+				Required: []string{"server", "_meta"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.RegistryMeta", "github.com/obot-platform/obot/apiclient/types.RegistryServerDetail"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_RemainingMCPToolCalls(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemainingMCPToolCalls is the number of MCP tool calls that a user can still make this month.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"toolCalls": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"remaining": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"unlimited": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.RegistryMeta"),
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"server", "_meta"},
+				Required: []string{"userID", "toolCalls", "unlimited"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.RegistryMeta", "github.com/obot-platform/obot/apiclient/types.RegistryServerDetail"},
 	}
 }
 
@@ -12134,12 +19214,40 @@ func schema_obot_platform_obot_apiclient_types_Run(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"mcpToolCalls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPToolCalls are the MCP tools that the run called, from the audit logs. They are only included in the run's detail.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.RunMCPToolCall"),
+									},
+								},
+							},
+						},
+					},
+					"estimatedMCPToolCostUSD": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EstimatedMCPToolCostUSD is the sum of the estimated costs of the MCP tool calls of the run.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"mcpToolCostWarning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPToolCostWarning is set when the estimated cost of the MCP tool calls of the run exceeds the configured threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"input"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.RunMCPToolCall", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
@@ -12171,6 +19279,92 @@ func schema_obot_platform_obot_apiclient_types_RunList(ref common.ReferenceCallb
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_RunMCPToolCall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RunMCPToolCall is an MCP tool call that a run made.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"auditLogID": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"mcpID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mcpServerDisplayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"toolName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments are only included if the user is allowed to see the request bodies of the server's audit logs.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"responseStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"processingTimeMs": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"estimatedCostUSD": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"estimatedCredits": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+				},
+				Required: []string{"auditLogID", "created", "mcpID", "toolName", "processingTimeMs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_RuntimeValidationError(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12512,6 +19706,58 @@ func schema_obot_platform_obot_apiclient_types_ScheduledAuditLogExportUpdateRequ
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_SchemaTransition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchemaTransition is an expand/contract migration, which changes the schema of Obot's database without downtime while instances of the previous and new release run side by side.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"backfilledRows": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"backfilledAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"contractedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "phase", "backfilledRows", "startedAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_Skill(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12938,15 +20184,144 @@ func schema_obot_platform_obot_apiclient_types_SkillResource(ref common.Referenc
 							Format:  "",
 						},
 					},
-					"id": {
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"type", "id"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_SourceRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SourceRuntimeConfig represents configuration for source runtime (MCP servers that Obot builds into an image from their source code, and then runs like containerized servers)",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Git repository to build, required unless bundleURL is set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bundleURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Branch, tag, or commit of the repository to build, defaults to the default branch",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"contextDir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of a .tar.gz bundle of the source code to build, required unless repository is set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dockerfile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Directory of the repository or bundle to build, defaults to its root",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Dockerfile in the context directory, defaults to Dockerfile. Only used by Dockerfile builders.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Override container command",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Container arguments",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: Container port",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"port", "path"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_StdioRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StdioRuntimeConfig represents configuration for stdio runtime (local subprocesses spawned by Obot)",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: Command to run",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"cwd": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Optional: Additional arguments",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "id"},
+				Required: []string{"command"},
 			},
 		},
 	}
@@ -13713,13 +21088,252 @@ func schema_obot_platform_obot_apiclient_types_SystemMCPServerList(ref common.Re
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_SystemMCPServerManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_SystemMCPServerManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"shortDescription": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"icon": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether this server should be deployed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runtime configuration",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uvxConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runtime-specific configurations (only one should be populated based on runtime)",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"),
+						},
+					},
+					"npxConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig"),
+						},
+					},
+					"containerizedConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig"),
+						},
+					},
+					"remoteConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig"),
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPEnv"),
+									},
+								},
+							},
+						},
+					},
+					"startupTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+				Required: []string{"name", "shortDescription", "description", "icon", "runtime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.MCPEnv", "github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Task(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"TaskManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"managed": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"Metadata", "TaskManifest", "managed"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TaskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Task"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Task"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TaskManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskStep"),
+									},
+								},
+							},
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
+						},
+					},
+					"onDemand": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnDemand"),
+						},
+					},
+				},
+				Required: []string{"name", "description", "steps", "schedule", "onDemand"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Schedule", "github.com/obot-platform/obot/apiclient/types.TaskOnDemand", "github.com/obot-platform/obot/apiclient/types.TaskStep"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
+					"params": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
@@ -13734,145 +21348,173 @@ func schema_obot_platform_obot_apiclient_types_SystemMCPServerManifest(ref commo
 							},
 						},
 					},
-					"name": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TaskRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"shortDescription": {
+					"taskID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"description": {
+					"threadID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"icon": {
+					"input": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"enabled": {
+					"output": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled controls whether this server should be deployed",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"runtime": {
+					"task": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Runtime configuration",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
 						},
 					},
-					"uvxConfig": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Runtime-specific configurations (only one should be populated based on runtime)",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"npxConfig": {
+					"endTime": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"containerizedConfig": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"remoteConfig": {
+					"warning": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"env": {
+				},
+				Required: []string{"Metadata"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TaskRunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPEnv"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskRun"),
 									},
 								},
 							},
 						},
 					},
-					"startupTimeoutSeconds": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
 				},
-				Required: []string{"name", "shortDescription", "description", "icon", "runtime"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ContainerizedRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.MCPEnv", "github.com/obot-platform/obot/apiclient/types.NPXRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.RemoteRuntimeConfig", "github.com/obot-platform/obot/apiclient/types.UVXRuntimeConfig"},
+			"github.com/obot-platform/obot/apiclient/types.TaskRun"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Task(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"TaskManifest": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"projectID": {
+					"step": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"alias": {
+					"loop": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"managed": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TemplateAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"TemplateAuthorizationManifest": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TemplateAuthorizationManifest"),
 						},
 					},
 				},
-				Required: []string{"Metadata", "TaskManifest", "managed"},
+				Required: []string{"TemplateAuthorizationManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest"},
+			"github.com/obot-platform/obot/apiclient/types.TemplateAuthorizationManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13885,7 +21527,7 @@ func schema_obot_platform_obot_apiclient_types_TaskList(ref common.ReferenceCall
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Task"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TemplateAuthorization"),
 									},
 								},
 							},
@@ -13896,81 +21538,122 @@ func schema_obot_platform_obot_apiclient_types_TaskList(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Task"},
+			"github.com/obot-platform/obot/apiclient/types.TemplateAuthorization"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"description": {
+					"templateID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"steps": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ThemePreferences(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backgroundColor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"onBackgroundColor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"onSurfaceColor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"surface1Color": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"surface2Color": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"surface3Color": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"primaryColor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"darkBackgroundColor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"darkOnBackgroundColor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"darkOnSurfaceColor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"darkSurface1Color": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskStep"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"schedule": {
+					"darkSurface2Color": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"onDemand": {
+					"darkSurface3Color": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnDemand"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-				},
-				Required: []string{"name", "description", "steps", "schedule", "onDemand"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Schedule", "github.com/obot-platform/obot/apiclient/types.TaskOnDemand", "github.com/obot-platform/obot/apiclient/types.TaskStep"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"params": {
+					"darkPrimaryColor": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
@@ -13979,7 +21662,7 @@ func schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref common.Reference
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_Thread(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13991,114 +21674,85 @@ func schema_obot_platform_obot_apiclient_types_TaskRun(ref common.ReferenceCallb
 							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"taskID": {
+					"ThreadManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadManifest"),
+						},
+					},
+					"assistantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"threadID": {
+					"taskID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"input": {
+					"taskRunID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"output": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"task": {
+					"lastRunID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"startTime": {
+					"currentRunID": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"endTime": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"error": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"warning": {
+					"abort": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-				},
-				Required: []string{"Metadata"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest", "github.com/obot-platform/obot/apiclient/types.Time"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_TaskRunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"systemTask": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskRun"),
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.TaskRun"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_TaskStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"id": {
+					"ephemeral": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"step": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"loop": {
+					"env": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -14112,34 +21766,43 @@ func schema_obot_platform_obot_apiclient_types_TaskStep(ref common.ReferenceCall
 							},
 						},
 					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
 				},
+				Required: []string{"Metadata", "ThreadManifest"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ThreadManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TemplateAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ThreadAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"TemplateAuthorizationManifest": {
+					"ThreadAuthorizationManifest": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TemplateAuthorizationManifest"),
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadAuthorizationManifest"),
 						},
 					},
 				},
-				Required: []string{"TemplateAuthorizationManifest"},
+				Required: []string{"ThreadAuthorizationManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.TemplateAuthorizationManifest"},
+			"github.com/obot-platform/obot/apiclient/types.ThreadAuthorizationManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ThreadAuthorizationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14152,7 +21815,7 @@ func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationList(ref com
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TemplateAuthorization"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadAuthorization"),
 									},
 								},
 							},
@@ -14163,11 +21826,11 @@ func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationList(ref com
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.TemplateAuthorization"},
+			"github.com/obot-platform/obot/apiclient/types.ThreadAuthorization"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ThreadAuthorizationManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14179,7 +21842,7 @@ func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationManifest(ref
 							Format: "",
 						},
 					},
-					"templateID": {
+					"threadID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
@@ -14191,243 +21854,350 @@ func schema_obot_platform_obot_apiclient_types_TemplateAuthorizationManifest(ref
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ThemePreferences(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ThreadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"backgroundColor": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Thread"),
+									},
+								},
+							},
 						},
 					},
-					"onBackgroundColor": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Thread"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ThreadManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"onSurfaceColor": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"surface1Color": {
+					"icons": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
 						},
 					},
-					"surface2Color": {
+					"introductionMessage": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"surface3Color": {
+					"starterMessages": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"primaryColor": {
+					"websiteKnowledge": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"),
 						},
 					},
-					"darkBackgroundColor": {
+					"tools": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"darkOnBackgroundColor": {
+					"modelProvider": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"darkOnSurfaceColor": {
+					"model": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"darkSurface1Color": {
+					"prompt": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"darkSurface2Color": {
+					"sharedTasks": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"darkSurface3Color": {
+					"allowedMCPTools": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 					},
-					"darkPrimaryColor": {
+					"mcpToolSchemaModes": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MCPToolSchemaModes are how the tools of the project's MCP servers are exposed to models, keyed the same way as AllowedMCPTools. Servers without a mode expose the full schemas of their tools.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name", "icons", "introductionMessage", "starterMessages", "prompt"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Thread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ThreadManifestManagedFields(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"ThreadManifest": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadManifest"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"assistantID": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"taskID": {
+					"icons": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
 						},
 					},
-					"taskRunID": {
+					"introductionMessage": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"state": {
+					"starterMessages": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"lastRunID": {
+					"websiteKnowledge": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"),
 						},
 					},
-					"currentRunID": {
+				},
+				Required: []string{"name", "icons", "introductionMessage", "starterMessages"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Time(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type:   types.Time{}.OpenAPISchemaType(),
+				Format: types.Time{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TokenUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"projectID": {
+					"runName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"userID": {
+					"model": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"abort": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"systemTask": {
+					"promptTokens": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"ephemeral": {
+					"completionTokens": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"project": {
+					"totalTokens": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"env": {
+					"date": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"ready": {
+					"personalToken": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"Metadata", "ThreadManifest"},
+				Required: []string{"promptTokens", "completionTokens", "totalTokens", "date", "personalToken"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ThreadManifest"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ThreadAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TokenUsageByDate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ThreadAuthorizationManifest": {
+					"date": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadAuthorizationManifest"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"ThreadAuthorizationManifest"},
+				Required: []string{"date", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ThreadAuthorizationManifest"},
+			"github.com/obot-platform/obot/apiclient/types.TokenUsage"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ThreadAuthorizationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TokenUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14440,7 +22210,7 @@ func schema_obot_platform_obot_apiclient_types_ThreadAuthorizationList(ref commo
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadAuthorization"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
 									},
 								},
 							},
@@ -14451,35 +22221,63 @@ func schema_obot_platform_obot_apiclient_types_ThreadAuthorizationList(ref commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ThreadAuthorization"},
+			"github.com/obot-platform/obot/apiclient/types.TokenUsage"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ThreadAuthorizationManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userID": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"threadID": {
+					"projectMCPServerID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"approvedBy": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"approvedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
 				},
+				Required: []string{"projectID", "projectMCPServerID", "status"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ThreadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolApprovalList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14492,7 +22290,7 @@ func schema_obot_platform_obot_apiclient_types_ThreadList(ref common.ReferenceCa
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Thread"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolApproval"),
 									},
 								},
 							},
@@ -14503,64 +22301,84 @@ func schema_obot_platform_obot_apiclient_types_ThreadList(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Thread"},
+			"github.com/obot-platform/obot/apiclient/types.ToolApproval"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ThreadManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolApprovalReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"projectMCPServerID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
+				},
+				Required: []string{"projectID", "projectMCPServerID"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ToolCall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"icons": {
+					"taskID": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"introductionMessage": {
+					"taskRunID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"starterMessages": {
+					"input": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"websiteKnowledge": {
+					"output": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"tools": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -14571,26 +22389,100 @@ func schema_obot_platform_obot_apiclient_types_ThreadManifest(ref common.Referen
 							},
 						},
 					},
-					"modelProvider": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ToolConfirm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ToolConfirm is sent when a tool call needs user approval",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ID is the ID of the tool call to confirm.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"model": {
+					"toolName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ToolName is the name of the tool to be called.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"prompt": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Description is the description of the tool.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sharedTasks": {
+					"input": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Input is the input the tool will be called with.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "toolName"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ToolConfirmResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ToolConfirmResponse is sent by the client to approve or deny a tool call.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the tool call to approve.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"decision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Decision indicates if the tool call should be denied, approved, or approved for the duration of the thread.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"toolName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolName is the name of a tool to pre-approve for the duration of the thread. The wildcard \"*\" will allow all tools. A trailing wildcard will allow all tools with the matching prefix; e.g. \"Everything Server ->*\". This field is ignored when Decision is not ToolConfirmDecisionApproveThread.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "decision"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ToolInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"credentialNames": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -14604,38 +22496,21 @@ func schema_obot_platform_obot_apiclient_types_ThreadManifest(ref common.Referen
 							},
 						},
 					},
-					"allowedMCPTools": {
+					"authorized": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type: []string{"array"},
-										Items: &spec.SchemaOrArray{
-											Schema: &spec.Schema{
-												SchemaProps: spec.SchemaProps{
-													Default: "",
-													Type:    []string{"string"},
-													Format:  "",
-												},
-											},
-										},
-									},
-								},
-							},
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"name", "icons", "introductionMessage", "starterMessages", "prompt"},
+				Required: []string{"authorized"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ThreadManifestManagedFields(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolInput(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -14643,9 +22518,8 @@ func schema_obot_platform_obot_apiclient_types_ThreadManifestManagedFields(ref c
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 					"description": {
@@ -14654,22 +22528,17 @@ func schema_obot_platform_obot_apiclient_types_ThreadManifestManagedFields(ref c
 							Format: "",
 						},
 					},
-					"icons": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
-						},
-					},
-					"introductionMessage": {
+					"input": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"starterMessages": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -14680,203 +22549,217 @@ func schema_obot_platform_obot_apiclient_types_ThreadManifestManagedFields(ref c
 							},
 						},
 					},
-					"websiteKnowledge": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"),
-						},
-					},
 				},
-				Required: []string{"name", "icons", "introductionMessage", "starterMessages"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_Time(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type:   types.Time{}.OpenAPISchemaType(),
-				Format: types.Time{}.OpenAPISchemaFormat(),
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TokenUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"runName": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"model": {
+					"icon": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"promptTokens": {
+					"toolType": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"completionTokens": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"totalTokens": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"date": {
+					"instructions": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"personalToken": {
+					"params": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"promptTokens", "completionTokens", "totalTokens", "date", "personalToken"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TokenUsageByDate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ToolOverride defines how a single component tool is exposed by the composite server",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"date": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name is the original tool name as returned by the component server",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"overrideName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
-									},
-								},
-							},
+							Description: "OverrideName is the tool name exposed by the composite server. An empty string denotes that the tool name should not be overridden.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the unaltered tool description at the time the override was created. This field should be used for display purposes only.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overrideDescription": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverrideDescription is optional and will override the tool description returned by the component server An empty string denotes that the live description from the MCP server should be used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates if the tool should be included in the tool allowlist.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"date", "items"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.TokenUsage"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TokenUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolRecertificationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ToolRecertificationRequest re-approves the tools selected for the given project MCP servers.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"approvals": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolApprovalReference"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"approvals"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.TokenUsage"},
+			"github.com/obot-platform/obot/apiclient/types.ToolApprovalReference"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ToolCall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"description": {
+					"ToolReferenceManifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolReferenceManifest"),
 						},
 					},
-					"taskID": {
+					"resolved": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"taskRunID": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"input": {
+					"builtin": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"output": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"metadata": {
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"params": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
@@ -14891,102 +22774,141 @@ func schema_obot_platform_obot_apiclient_types_ToolCall(ref common.ReferenceCall
 							},
 						},
 					},
+					"bundle": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"bundleToolName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
+				Required: []string{"Metadata", "ToolReferenceManifest"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ToolReferenceManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ToolConfirm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ToolReferenceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ToolConfirm is sent when a tool call needs user approval",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the ID of the tool call to confirm.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolReference"),
+									},
+								},
+							},
 						},
 					},
-					"toolName": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ToolReference"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ToolReferenceManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ToolName is the name of the tool to be called.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"description": {
+					"toolType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is the description of the tool.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"input": {
+					"commit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Input is the input the tool will be called with.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"reference": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"id", "toolName"},
+				Required: []string{"name", "toolType"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ToolConfirmResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_UVXRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ToolConfirmResponse is sent by the client to approve or deny a tool call.",
+				Description: "UVXRuntimeConfig represents configuration for UVX runtime (Python packages via uvx)",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"package": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the ID of the tool call to approve.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"decision": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Decision indicates if the tool call should be denied, approved, or approved for the duration of the thread.",
+							Description: "Required: Python package name",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"toolName": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ToolName is the name of a tool to pre-approve for the duration of the thread. The wildcard \"*\" will allow all tools. A trailing wildcard will allow all tools with the matching prefix; e.g. \"Everything Server ->*\". This field is ignored when Decision is not ToolConfirmDecisionApproveThread.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Optional: Specific command to run inside of the package. If empty, the package name will be treated as the command.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"id", "decision"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ToolInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"credentialNames": {
+					"egressDomains": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Optional: Additional arguments",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -14998,49 +22920,54 @@ func schema_obot_platform_obot_apiclient_types_ToolInfo(ref common.ReferenceCall
 							},
 						},
 					},
-					"authorized": {
+					"denyAllEgress": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "Optional: Empty means allow all, otherwise allow only the listed domains when network policy enforcement is enabled",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"authorized"},
+				Required: []string{"package", "command"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ToolInput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_User(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"description": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"input": {
+					"role": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"metadata": {
+					"effectiveRole": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -15051,338 +22978,316 @@ func schema_obot_platform_obot_apiclient_types_ToolInput(ref common.ReferenceCal
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ToolManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"explicitRole": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"description": {
+					"email": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"icon": {
+					"iconURL": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"toolType": {
+					"timezone": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"image": {
+					"currentAuthProvider": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"context": {
+					"lastActiveDay": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"instructions": {
+					"internal": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"params": {
+					"dailyPromptTokensLimit": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ToolOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ToolOverride defines how a single component tool is exposed by the composite server",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"dailyCompletionTokensLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the original tool name as returned by the component server",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"overrideName": {
+					"monthlyMCPToolCallLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OverrideName is the tool name exposed by the composite server. An empty string denotes that the tool name should not be overridden.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"description": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is the unaltered tool description at the time the override was created. This field should be used for display purposes only.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"overrideDescription": {
+					"deletedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OverrideDescription is optional and will override the tool description returned by the component server An empty string denotes that the live description from the MCP server should be used.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"enabled": {
+					"originalEmail": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates if the tool should be included in the tool allowlist.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"originalUsername": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"autonomousToolUseEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"Metadata", "lastActiveDay"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ToolReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_UserActivityExportManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "UserActivityExportManifest describes the contents of an export of a user's MCP activity. It is the manifest.json file of the archive.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"ToolReferenceManifest": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolReferenceManifest"),
+							Description: "StartTime and EndTime are the range of the activity in the export. A zero StartTime includes all activity up to EndTime. Server configurations and OAuth grants are exported as they are when the export is generated.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"resolved": {
+					"endTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"error": {
+					"generatedAt": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"builtin": {
+					"generatedBy": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"description": {
+					"withRequestAndResponse": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "WithRequestAndResponse is whether the audit logs include the bodies and headers of requests and responses. They are only included for auditors.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"credentials": {
+					"files": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Files counts the records in each file of the archive.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
 									},
 								},
 							},
 						},
 					},
-					"params": {
+				},
+				Required: []string{"userID", "startTime", "endTime", "generatedAt", "generatedBy", "withRequestAndResponse", "files"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_UserActivityExportOAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserActivityExportOAuth is the OAuth consent history of a user in an export of their MCP activity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"grants": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Grants are the MCP clients that the user authorized to connect to MCP servers through Obot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.UserActivityExportOAuthGrant"),
 									},
 								},
 							},
 						},
 					},
-					"bundle": {
+					"authorizations": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Authorizations are the authorizations of MCP clients that the user started.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.UserActivityExportOAuthGrant"),
+									},
+								},
+							},
 						},
 					},
-					"bundleToolName": {
+					"tokens": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Tokens are the OAuth tokens that the user has stored for MCP servers. The tokens themselves are never exported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenHealth"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"Metadata", "ToolReferenceManifest"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ToolReferenceManifest"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ToolReferenceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"activity": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Activity counts the outcomes of the OAuth flows between the user and each MCP server in the range.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolReference"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPOAuthTelemetry"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"grants", "authorizations", "tokens", "activity"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ToolReference"},
+			"github.com/obot-platform/obot/apiclient/types.MCPOAuthTelemetry", "github.com/obot-platform/obot/apiclient/types.MCPOAuthTokenHealth", "github.com/obot-platform/obot/apiclient/types.UserActivityExportOAuthGrant"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ToolReferenceManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_UserActivityExportOAuthGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "UserActivityExportOAuthGrant is an authorization of an MCP client by a user.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"toolType": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"commit": {
+					"scope": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"reference": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"active": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"completed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Completed is whether the authorization was completed. It is only set for authorizations.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "toolType"},
+				Required: []string{"clientID", "created"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_UVXRuntimeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_UserActivityExportServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "UVXRuntimeConfig represents configuration for UVX runtime (Python packages via uvx)",
+				Description: "UserActivityExportServer is the configuration of an MCP server in an export of a user's MCP activity. Values of sensitive configuration are masked.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"package": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"command": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Required: Python package name",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"args": {
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Specific command to run inside of the package. If empty, the package name will be treated as the command.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServer"),
 						},
 					},
-					"egressDomains": {
+					"configuration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Additional arguments",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -15393,54 +23298,33 @@ func schema_obot_platform_obot_apiclient_types_UVXRuntimeConfig(ref common.Refer
 							},
 						},
 					},
-					"denyAllEgress": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Empty means allow all, otherwise allow only the listed domains when network policy enforcement is enabled",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"package", "command"},
+				Required: []string{"server"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServer"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_User(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_UserActivityExportServerInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "UserActivityExportServerInstance is a connection of a user to a multi-user MCP server in an export of their MCP activity. The values of its configuration are always masked.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"instance": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"username": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"role": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"effectiveRole": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerInstance"),
 						},
 					},
-					"groups": {
+					"configuration": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -15451,142 +23335,213 @@ func schema_obot_platform_obot_apiclient_types_User(ref common.ReferenceCallback
 							},
 						},
 					},
-					"explicitRole": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"email": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"iconURL": {
+				},
+				Required: []string{"instance"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerInstance"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_UserActivityExportSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserActivityExportSession is a session that a user had with an MCP server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"timezone": {
+					"mcpID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"currentAuthProvider": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"lastActiveDay": {
+					"lastUsedTime": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"internal": {
+				},
+				Required: []string{"id", "mcpID", "created"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_UserDefaultRoleSetting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"role": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"dailyPromptTokensLimit": {
+				},
+				Required: []string{"role"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_UserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.User"),
+									},
+								},
+							},
 						},
 					},
-					"dailyCompletionTokensLimit": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.User"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_UserPurgeReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserPurgeReport is the result of a purge of a user's personal data.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"displayName": {
+					"purgedAt": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"deletedAt": {
+					"purgedBy": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"originalEmail": {
+					"deleted": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Deleted counts the records of the user that were deleted, by the kind of record.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-					"originalUsername": {
+					"anonymized": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Anonymized counts the records of the user that are kept for auditing without their personal data, by the kind of record.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-					"autonomousToolUseEnabled": {
+					"pending": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Pending counts the objects of the user that are still being deleted, by the kind of object. The user's credentials are only deleted once nothing else is pending, because deleting some objects needs them.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"Metadata", "lastActiveDay"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_UserDefaultRoleSetting(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"role": {
+					"complete": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Complete is whether all of the user's personal data was removed. If it wasn't, the purge should be repeated once the pending objects are deleted.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"role"},
+				Required: []string{"userID", "purgedAt", "purgedBy", "deleted", "anonymized", "complete"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_UserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_UserPurgeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "UserPurgeRequest confirms the purge of a user's personal data. A purge can't be undone.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"confirm": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.User"),
-									},
-								},
-							},
+							Description: "Confirm must be the email address of the user that is purged.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"confirm"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.User"},
 	}
 }
 
@@ -16394,81 +24349,238 @@ func schema_storage_apis_obotobotai_v1_AgentSpec(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AgentManifest"},
+			"github.com/obot-platform/obot/apiclient/types.AgentManifest"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_AgentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"knowledgeSetNames": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"workspaceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"aliasAssigned": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"authStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppLoginAuthStatus"),
+									},
+								},
+							},
+						},
+					},
+					"toolInfo": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolInfo"),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.OAuthAppLoginAuthStatus", "github.com/obot-platform/obot/apiclient/types.ToolInfo"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_AlertRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRuleSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRuleStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRuleSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRuleStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_AlertRuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.AlertRule", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_AlertRuleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.AlertRuleManifest"),
+						},
+					},
+				},
+				Required: []string{"manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AlertRuleManifest"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_AgentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_AlertRuleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"knowledgeSetNames": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"workspaceName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"aliasAssigned": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"authStatus": {
+					"lastEvaluatedAt": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppLoginAuthStatus"),
-									},
-								},
-							},
+							Description: "LastEvaluatedAt is the last time the controller evaluated the rule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"toolInfo": {
+					"firing": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Firing contains the MCP servers that the rule is firing for.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolInfo"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.AlertRuleFiring"),
 									},
 								},
 							},
 						},
 					},
-					"observedGeneration": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Error is the error of the last evaluation or notification, if any.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"lastEvaluatedAt"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.OAuthAppLoginAuthStatus", "github.com/obot-platform/obot/apiclient/types.ToolInfo"},
+			"github.com/obot-platform/obot/apiclient/types.AlertRuleFiring", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -17309,99 +25421,356 @@ func schema_storage_apis_obotobotai_v1_EmptyStatus(ref common.ReferenceCallback)
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_ExternalCall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_ExternalCall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_ExternalCallResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"id", "data"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_ExternalCallResume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type should equal \"obotExternalCallResume\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"call": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall"),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult"),
+						},
+					},
+				},
+				Required: []string{"type", "call", "result"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_GroupRoleChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChangeSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChangeSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_GroupRoleChangeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChange", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_GroupRoleChangeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"groupName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_K8sSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"type": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"data": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_ExternalCallResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_K8sSettingsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"data": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettings"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"id", "data"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettings", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_ExternalCallResume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_K8sSettingsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"storageClassName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type should equal \"obotExternalCallResume\"",
-							Default:     "",
+							Description: "StorageClassName specifies the StorageClass for nanobot workspace volumes",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"call": {
+					"nanobotWorkspaceSize": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall"),
+							Description: "NanobotWorkspaceSize specifies the size for nanobot workspace volumes",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"result": {
+					"podSecurityAdmission": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult"),
+							Description: "PodSecurityAdmission contains Pod Security Admission settings for the MCP namespace",
+							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PodSecurityAdmissionSettings"),
+						},
+					},
+					"setViaHelm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SetViaHelm indicates if these settings came from Helm (cannot be updated via API)",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "call", "result"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PodSecurityAdmissionSettings"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_GroupRoleChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_K8sSettingsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_KnowledgeFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17430,24 +25799,24 @@ func schema_storage_apis_obotobotai_v1_GroupRoleChange(ref common.ReferenceCallb
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChangeSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChangeSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_GroupRoleChangeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeFileList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17480,7 +25849,7 @@ func schema_storage_apis_obotobotai_v1_GroupRoleChangeList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChange"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFile"),
 									},
 								},
 							},
@@ -17491,29 +25860,157 @@ func schema_storage_apis_obotobotai_v1_GroupRoleChangeList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.GroupRoleChange", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFile", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_GroupRoleChangeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeFileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"groupName": {
+					"knowledgeSetName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"knowledgeSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"approved": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"fileName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"updatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"checksum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sizeInBytes": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"ingestGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_KnowledgeFileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"updatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"checksum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"runNames": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastIngestionStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastIngestionEndTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"ingestGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_K8sSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17542,24 +26039,24 @@ func schema_storage_apis_obotobotai_v1_K8sSettings(ref common.ReferenceCallback)
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettingsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_K8sSettingsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17592,7 +26089,7 @@ func schema_storage_apis_obotobotai_v1_K8sSettingsList(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettings"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSet"),
 									},
 								},
 							},
@@ -17603,62 +26100,139 @@ func schema_storage_apis_obotobotai_v1_K8sSettingsList(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.K8sSettings", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_K8sSettingsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSetManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KnowledgeSetManifest should be moved to types once we expose this API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataDescription": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_KnowledgeSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"storageClassName": {
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StorageClassName specifies the StorageClass for nanobot workspace volumes",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetManifest"),
+						},
+					},
+					"agentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AgentName is the name of the agent that created and owns this knowledge set",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"nanobotWorkspaceSize": {
+					"threadName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NanobotWorkspaceSize specifies the size for nanobot workspace volumes",
+							Description: "ThreadName is the name of the thread that created and owns this knowledge set",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"podSecurityAdmission": {
+					"fromKnowledgeSetName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodSecurityAdmission contains Pod Security Admission settings for the MCP namespace",
-							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PodSecurityAdmissionSettings"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"setViaHelm": {
+					"relatedKnowledgeSetNames": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SetViaHelm indicates if these settings came from Helm (cannot be updated via API)",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "RelatedKnowledgeSetNames is a list of knowledge set that must match the same text embedding model The best effort will be made to keep the same text embedding model used for the related knowledge sets",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PodSecurityAdmissionSettings"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetManifest"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_K8sSettingsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasContent": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"datasetCreated": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"suggestedDataDescription": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workspaceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"threadName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"existingFile": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"textEmbeddingModel": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17687,24 +26261,24 @@ func schema_storage_apis_obotobotai_v1_KnowledgeFile(ref common.ReferenceCallbac
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeFileList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSourceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17737,7 +26311,7 @@ func schema_storage_apis_obotobotai_v1_KnowledgeFileList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFile"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSource"),
 									},
 								},
 							},
@@ -17748,65 +26322,29 @@ func schema_storage_apis_obotobotai_v1_KnowledgeFileList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeFile", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSource", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeFileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"knowledgeSetName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"knowledgeSourceName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"approved": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"fileName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"updatedAt": {
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"),
 						},
 					},
-					"checksum": {
+					"knowledgeSetName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"sizeInBytes": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"ingestGeneration": {
+					"syncGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
 							Format: "int64",
@@ -17815,79 +26353,78 @@ func schema_storage_apis_obotobotai_v1_KnowledgeFileSpec(ref common.ReferenceCal
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeFileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"state": {
+					"workspaceName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"error": {
+					"threadName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"url": {
+					"runName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"updatedAt": {
+					"syncState": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"checksum": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"runNames": {
+					"syncDetails": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
-					"lastIngestionStartTime": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"lastIngestionEndTime": {
+					"syncGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"lastSyncStartTime": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"ingestGeneration": {
+					"lastSyncEndTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"retryCount": {
+					"nextSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
@@ -17898,7 +26435,7 @@ func schema_storage_apis_obotobotai_v1_KnowledgeFileStatus(ref common.ReferenceC
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17927,24 +26464,24 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSet(ref common.ReferenceCallback
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummarySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummaryStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummarySpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummaryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSummaryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17977,7 +26514,7 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSetList(ref common.ReferenceCall
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSet"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummary"),
 									},
 								},
 							},
@@ -17988,143 +26525,102 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSetList(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummary", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSetManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSummarySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KnowledgeSetManifest should be moved to types once we expose this API",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dataDescription": {
+					"threadName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"contentHash": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_KnowledgeSummaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"manifest": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetManifest"),
-						},
-					},
-					"agentName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AgentName is the name of the agent that created and owns this knowledge set",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"threadName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ThreadName is the name of the thread that created and owns this knowledge set",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"fromKnowledgeSetName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"relatedKnowledgeSetNames": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RelatedKnowledgeSetNames is a list of knowledge set that must match the same text embedding model The best effort will be made to keep the same text embedding model used for the related knowledge sets",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSetManifest"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hasContent": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"datasetCreated": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"suggestedDataDescription": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workspaceName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"threadName": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"existingFile": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogSpec"),
 						},
 					},
-					"textEmbeddingModel": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MCPCatalogEvent is a lifecycle event of an MCP catalog that is waiting to be delivered to the catalog's webhooks. It is deleted once it is delivered to all of them, or delivery is given up on.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -18149,24 +26645,24 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSource(ref common.ReferenceCallb
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSourceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEventList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18199,7 +26695,7 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSourceList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSource"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEvent"),
 									},
 								},
 							},
@@ -18210,120 +26706,66 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSourceList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSource", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEvent", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEventSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"manifest": {
+					"event": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"),
-						},
-					},
-					"knowledgeSetName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"syncGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogEvent"),
 						},
 					},
 				},
+				Required: []string{"event"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.KnowledgeSourceManifest"},
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogEvent"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEventStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"workspaceName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"threadName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"runName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"syncState": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"syncDetails": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"error": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"syncGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"lastSyncStartTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastSyncEndTime": {
+					"delivered": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Delivered contains the names of the webhooks that the event was delivered to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"nextSyncTime": {
+					"attempts": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Attempts is the number of times that delivering the event to the remaining webhooks failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18352,24 +26794,24 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSummary(ref common.ReferenceCall
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummarySpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhookSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummaryStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhookStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummarySpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummaryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhookSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhookStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSummaryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhookList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18402,7 +26844,7 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSummaryList(ref common.Reference
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummary"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhook"),
 									},
 								},
 							},
@@ -18413,93 +26855,62 @@ func schema_storage_apis_obotobotai_v1_KnowledgeSummaryList(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.KnowledgeSummary", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogEventWebhook", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSummarySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhookSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"threadName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"contentHash": {
+					"mcpCatalogID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"summary": {
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPCatalogEventWebhookManifest"),
 						},
 					},
 				},
+				Required: []string{"manifest"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPCatalogEventWebhookManifest"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_KnowledgeSummaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
-	}
-}
-
-func schema_storage_apis_obotobotai_v1_MCPCatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_MCPCatalogEventWebhookStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"lastDeliveredAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastDeliveredAt is the last time an event was delivered to the webhook.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Error is the error of the last delivery to the webhook, if it failed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogStatus"),
-						},
-					},
 				},
+				Required: []string{"lastDeliveredAt"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPCatalogStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -18577,6 +26988,20 @@ func schema_storage_apis_obotobotai_v1_MCPCatalogSpec(ref common.ReferenceCallba
 							},
 						},
 					},
+					"externalURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalURL, if set, is the base URL used in place of Obot's server URL when building the connect URLs of servers in this catalog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultToolSelection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultToolSelection, if set, overrides the server-wide policy for which tools of a server from this catalog are enabled when the server is added to a project.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -18616,6 +27041,21 @@ func schema_storage_apis_obotobotai_v1_MCPCatalogStatus(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"syncConflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncConflicts contains the names of the entries imported from an MCP registry that were edited in Obot and changed in the registry since, so they weren't updated by the last sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"lastSyncTime"},
 			},
@@ -18922,6 +27362,142 @@ func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryList(ref common.Refe
 	}
 }
 
+func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MCPServerCatalogEntryRevision is an immutable snapshot of the manifest of an MCPServerCatalogEntry. A new revision is created each time the manifest of the catalog entry changes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryRevisionSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryRevisionSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryRevisionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryRevision"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEntryRevision", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryRevisionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mcpServerCatalogEntryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MCPServerCatalogEntryName is the name of the catalog entry that this is a revision of.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of this revision. Revisions of a catalog entry are numbered from 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"),
+						},
+					},
+					"manifestHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestHash is the hash of the manifest, used to detect when the catalog entry changes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"},
+	}
+}
+
 func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18966,6 +27542,13 @@ func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntrySpec(ref common.Refe
 							Format: "",
 						},
 					},
+					"importedManifestHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportedManifestHash is the hash of the manifest as it was last imported from an MCP registry, if the entry was imported from one. An entry whose manifest doesn't match this hash was edited in Obot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"powerUserWorkspaceID": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PowerUserWorkspaceID contains the name of the PowerUserWorkspace that owns this catalog entry, if there is one.",
@@ -18973,11 +27556,32 @@ func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntrySpec(ref common.Refe
 							Format:      "",
 						},
 					},
+					"maintenanceNotice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceNotice is a planned outage of the servers created from this catalog entry, set by an admin.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice"),
+						},
+					},
+					"teamCredentialGroupIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TeamCredentialGroupIDs are the auth provider groups that have a team credential for this catalog entry. The single-user servers of the members of these groups use the team credential when they aren't configured.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"},
+			"github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice", "github.com/obot-platform/obot/apiclient/types.MCPServerCatalogEntryManifest"},
 	}
 }
 
@@ -19006,32 +27610,100 @@ func schema_storage_apis_obotobotai_v1_MCPServerCatalogEntryStatus(ref common.Re
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"toolPreviewsManifestHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolPreviewsManifestHash is the ToolPreviewsHash of the manifest that the tool previews were last generated for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"toolPreviewsError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolPreviewsError is the error from the last automatic generation of the tool previews, if it failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manifestHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestHash is a SHA256 hash of the catalog entry configuration used to detect changes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the latest MCPServerCatalogEntryRevision of this catalog entry.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"publishedEventRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublishedEventRevision is the revision of this catalog entry that the last entry-published event of its catalog was created for.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"needsUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NeedsUpdate indicates whether this composite catalog entry's component snapshots have drifted from their sources.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"oauthCredentialConfigured": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OAuthCredentialConfigured indicates whether OAuth credentials have been configured for this remote catalog entry. Only relevant when Runtime is \"remote\" and RemoteConfig.StaticOAuthRequired is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the Synced, CredentialConfigured, and DriftDetected conditions for this catalog entry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_MCPServerCatalogEventState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
 					"manifestHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManifestHash is a SHA256 hash of the catalog entry configuration used to detect changes.",
+							Description: "ManifestHash is the hash of the server's manifest, to detect when an update is applied to it.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"needsUpdate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NeedsUpdate indicates whether this composite catalog entry's component snapshots have drifted from their sources.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"oauthCredentialConfigured": {
+					"deploymentStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OAuthCredentialConfigured indicates whether OAuth credentials have been configured for this remote catalog entry. Only relevant when Runtime is \"remote\" and RemoteConfig.StaticOAuthRequired is true.",
-							Type:        []string{"boolean"},
+							Description: "DeploymentStatus is the deployment status of the server, to detect when its health changes.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -19067,11 +27739,17 @@ func schema_storage_apis_obotobotai_v1_MCPServerInstance(ref common.ReferenceCal
 							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -19184,6 +27862,21 @@ func schema_storage_apis_obotobotai_v1_MCPServerInstanceSpec(ref common.Referenc
 							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MultiUserConfig"),
 						},
 					},
+					"toolAllowlist": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolAllowlist contains the tool name patterns that the owner of this instance allowed. When it is set, only matching tools can be listed and called through this instance. The tool policy of the catalog entry still applies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -19192,6 +27885,49 @@ func schema_storage_apis_obotobotai_v1_MCPServerInstanceSpec(ref common.Referenc
 	}
 }
 
+func schema_storage_apis_obotobotai_v1_MCPServerInstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the Ready and Synced conditions for this MCP server instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"connectBlockers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectBlockers are the connect blockers of the MCP server that this instance points to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
 func schema_storage_apis_obotobotai_v1_MCPServerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19309,6 +28045,13 @@ func schema_storage_apis_obotobotai_v1_MCPServerSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"configurationPreset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigurationPreset is the name of the catalog entry preset this MCP server was created with, if there is one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"needsURL": {
 						SchemaProps: spec.SchemaProps{
 							Description: "NeedsURL indicates whether the server's URL needs to be updated to match the catalog entry.",
@@ -19351,12 +28094,38 @@ func schema_storage_apis_obotobotai_v1_MCPServerSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"connectAlias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectAlias is a vanity hostname, optionally followed by a path, that the gateway routes to this server's connect URL. This may only be set for multi-user MCP servers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalExposure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalExposure exposes this server directly on a custom hostname through objects generated by the Kubernetes backend. This may only be set for multi-user MCP servers.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposure"),
+						},
+					},
+					"maintenanceNotice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceNotice is a planned outage of this server, set by an admin.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice"),
+						},
+					},
+					"pinnedCatalogEntryRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PinnedCatalogEntryRevision is the revision of the catalog entry that this server is pinned to, if it is pinned. Unpinned servers follow the latest revision of their catalog entry.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"manifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.MCPServerManifest"},
+			"github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice", "github.com/obot-platform/obot/apiclient/types.MCPServerExternalExposure", "github.com/obot-platform/obot/apiclient/types.MCPServerManifest"},
 	}
 }
 
@@ -19470,12 +28239,185 @@ func schema_storage_apis_obotobotai_v1_MCPServerStatus(ref common.ReferenceCallb
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastProbeTime is the time the liveness prober last checked the server's deployment.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastHealthyTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHealthyTime is the last time the liveness prober found the server's deployment ready.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"consecutiveProbeFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsecutiveProbeFailures is the number of liveness probes that failed in a row since the server was last healthy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartCount is the number of times the containers of the server's deployment restarted, as of the last liveness probe.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deploymentFailureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentFailureReason is the reason that the server's deployment is failing permanently, such as CrashLoopBackOff, as of the last liveness probe.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureTicketTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureTicketTime is when a ticket was created for the current permanent failure of the server's deployment.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"credentialStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialStatus is whether the owner's stored OAuth token for this single-user server is expected to keep working, as of the last check. It is unset if the owner has no token for the server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialWarning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialWarning explains why the CredentialStatus isn't healthy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialExpiry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialExpiry is when the owner's stored OAuth token for this server expires, if it can't be refreshed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"staleSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleSince is when this single-user server was flagged for not being used, and its owner notified.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"staleAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleAction is what happens to this server at StaleActionTime if it is still not used by then.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"staleActionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"staleShutdown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleShutdown indicates whether this server was shut down for being stale.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastPrewarmTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastPrewarmTime is when this multi-user server was last deployed ahead of one of its usage peaks.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"memoryUsageBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryUsageBytes is the size of the memories stored by this server, if it is an Obot-managed memory server, as of the last time it was measured.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"memoryQuotaBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryQuotaBytes is the maximum size of the memories of this memory server, or 0 if there is no limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"workspaceProjectName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkspaceProjectName is the project that this server is bound to, if it is an Obot-managed workspace server. The server is bound to the first project that it is added to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkspaceID is the ID of the workspace of the project that this workspace server is bound to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workspaceUsageBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkspaceUsageBytes is the size of the files of the project that this workspace server is bound to, as of the last time it was measured.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"workspaceQuotaBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkspaceQuotaBytes is the maximum size of the files that this workspace server can write, or 0 if there is no limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maintenanceNotice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceNotice is the notice that applies to this server: its own, or else the one of its catalog entry. Expired notices are not included.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice"),
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the Ready, CredentialConfigured, DriftDetected, and K8sSettingsApplied conditions for this server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"connectBlockers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectBlockers are the reasons that clients can't connect to this server that the controller checks: its URL, OAuth credentials, deployment, and maintenance window. Its configuration is checked when it is read, because it depends on its credentials.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"catalogEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatalogEvents is the state of this server that the last lifecycle events of its catalog were created for. It is nil until the server-created event is.",
+							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEventState"),
+						},
+					},
 				},
-				Required: []string{"lastRequestTime"},
+				Required: []string{"lastRequestTime", "lastProbeTime", "lastHealthyTime", "failureTicketTime", "credentialExpiry", "staleSince", "staleActionTime", "lastPrewarmTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.DeploymentCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/obot-platform/obot/apiclient/types.MCPMaintenanceNotice", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.DeploymentCondition", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.MCPServerCatalogEventState", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -20894,38 +29836,79 @@ func schema_storage_apis_obotobotai_v1_OAuthAuthRequestSpec(ref common.Reference
 							Format:  "int32",
 						},
 					},
-					"mcpID": {
+					"mcpID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"authProviderUserID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"authProviderNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"authProviderName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"nonce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nonce is the OpenID Connect nonce of the request, which is returned in the ID token.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hashedDeviceCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HashedDeviceCode and HashedUserCode identify the requests of the device authorization grant. The client polls the token endpoint with the device code while the user enters the user code in a browser.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hashedUserCode": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"authProviderUserID": {
+					"deviceApproved": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "DeviceApproved and DeviceDenied record the user's decision on a device authorization request.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"authProviderNamespace": {
+					"deviceDenied": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"authProviderName": {
+					"lastPollTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "LastPollTime is when the client last polled the token endpoint for a device authorization request.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"redirectURI", "state", "clientID", "codeChallenge", "scope", "codeChallengeMethod", "grantType", "resource", "hashedAuthCode", "userID", "mcpID", "authProviderUserID", "authProviderNamespace", "authProviderName"},
+				Required: []string{"redirectURI", "state", "clientID", "codeChallenge", "scope", "codeChallengeMethod", "grantType", "resource", "hashedAuthCode", "userID", "mcpID", "authProviderUserID", "authProviderNamespace", "authProviderName", "lastPollTime"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -21308,6 +30291,13 @@ func schema_storage_apis_obotobotai_v1_OAuthTokenSpec(ref common.ReferenceCallba
 							Format:  "",
 						},
 					},
+					"grantID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GrantID identifies the authorization that the token was issued for. It is kept when the token is refreshed, so that revoking a token revokes every token issued for the same authorization.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"scope", "resource", "clientID", "userID", "mcpID", "authProviderUserID", "authProviderName", "authProviderNamespace"},
 			},
@@ -21447,6 +30437,202 @@ func schema_storage_apis_obotobotai_v1_OktaGroupMigrationSpec(ref common.Referen
 	}
 }
 
+func schema_storage_apis_obotobotai_v1_PendingCatalogEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingCatalogEntry is a catalog entry, or an edit to one, that a user proposed for a catalog. Admins review it, and approving it creates or updates the MCPServerCatalogEntry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntrySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntryStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntrySpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_PendingCatalogEntryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.PendingCatalogEntry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_PendingCatalogEntrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryManifest"),
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the ID of the user who submitted the entry.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vendor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vendor is the vendor that submitted the entry through the submission portal of the catalog, instead of a user.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryVendor"),
+						},
+					},
+					"statusTokenHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatusTokenHash is the SHA-256 hash of the token that the vendor gets the status of the submission with.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"manifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryManifest", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryVendor"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_PendingCatalogEntryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is empty until the entry is approved or rejected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comments": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryComment"),
+									},
+								},
+							},
+						},
+					},
+					"reviewerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReviewerID is the ID of the admin who approved or rejected the entry.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reviewedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"approvedCatalogEntryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedCatalogEntryName is the catalog entry that was created or updated when the entry was approved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checks are the results of the automatic checks of entries submitted through the submission portal.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryChecks"),
+						},
+					},
+				},
+				Required: []string{"reviewedAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryChecks", "github.com/obot-platform/obot/apiclient/types.PendingCatalogEntryComment", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_storage_apis_obotobotai_v1_PodSecurityAdmissionSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24745,11 +33931,26 @@ func schema_storage_apis_obotobotai_v1_ThreadSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"toolApprovals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolApprovals records who approved the tools selected for each of the project's MCP servers and when. It is keyed the same way as the AllowedMCPTools of the manifest.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ToolApproval"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.EnvVar", "github.com/obot-platform/obot/apiclient/types.ThreadManifest", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ThreadCapabilities"},
+			"github.com/obot-platform/obot/apiclient/types.EnvVar", "github.com/obot-platform/obot/apiclient/types.ThreadManifest", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ThreadCapabilities", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ToolApproval"},
 	}
 }
 
@@ -24894,6 +34095,13 @@ func schema_storage_apis_obotobotai_v1_ThreadStatus(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"toolRecertificationRequired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolRecertificationRequired indicates that the tool approvals of one or more of the project's MCP servers have expired or are about to expire.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -24948,6 +34156,56 @@ func schema_storage_apis_obotobotai_v1_Tool(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_storage_apis_obotobotai_v1_ToolApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ToolApproval is the approval of the tools selected for an MCP server in a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the ID of the user that last approved the tools.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedAt is when the tools were last approved.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"expired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expired indicates that the approval lapsed and the tools were revoked until they are re-certified.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"expiredTools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiredTools are the tools that were selected when the approval expired, restored when it is re-certified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_storage_apis_obotobotai_v1_ToolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
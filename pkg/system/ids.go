@@ -59,6 +59,10 @@ const (
 	ProjectV2Prefix               = "pv21"
 	PublishedArtifactPrefix       = "pa1"
 	OktaGroupMigrationPrefix      = "ogm1"
+	AlertRulePrefix               = "ar1"
+	MCPCatalogEventWebhookPrefix  = "mcew1"
+	MCPCatalogEventPrefix         = "mce1"
+	PendingCatalogEntryPrefix     = "pce1"
 
 	ObotMCPServerName = SystemMCPServerPrefix + "obot-mcp-server"
 )
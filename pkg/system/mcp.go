@@ -1,12 +1,27 @@
 package system
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 const (
 	// MCPOAuthCredentialContextPrefix is the credential context prefix for MCP OAuth credentials
 	MCPOAuthCredentialContextPrefix = "mcp-oauth"
+
+	// MemoryCatalogEntryName is the name of the catalog entry of the Obot-managed memory MCP server in the default
+	// catalog.
+	MemoryCatalogEntryName = "default-obot-memory"
+
+	// WorkspaceCatalogEntryName is the name of the catalog entry of the Obot-managed workspace MCP server in the
+	// default catalog.
+	WorkspaceCatalogEntryName = "default-obot-workspace"
+
+	// WorkspaceServerFilesPrefix is the prefix of the files of a project in its workspace, which workspace MCP servers
+	// are confined to.
+	WorkspaceServerFilesPrefix = "files/"
 )
 
 // MCPOAuthCredentialName returns the credential name for an MCP server's OAuth credentials
@@ -14,6 +29,23 @@ func MCPOAuthCredentialName(mcpServerName string) string {
 	return fmt.Sprintf("%s-%s", MCPOAuthCredentialContextPrefix, mcpServerName)
 }
 
+// MCPRotatingCredentialContext returns the credential context that holds the new credential of an MCP server while its
+// credentials are rotated, until the server is running with it.
+func MCPRotatingCredentialContext(credCtx string) string {
+	return credCtx + "-rotating"
+}
+
+// MCPTeamCredentialContext returns the credential context of the team credential of a group for a catalog entry. The
+// group ID is hashed, since the IDs of some auth providers contain characters that aren't allowed in credential contexts.
+func MCPTeamCredentialContext(catalogName, entryName, groupID string) string {
+	return fmt.Sprintf("%s-%s-team-%x", catalogName, entryName, sha256.Sum256([]byte(groupID)))
+}
+
+// MemoryStoreSchema returns the database schema that the memories of a memory MCP server are stored in.
+func MemoryStoreSchema(mcpServerName string) string {
+	return "memory_" + strings.ReplaceAll(mcpServerName, "-", "_")
+}
+
 func MCPConnectURL(serverURL, id string) string {
 	return fmt.Sprintf("%s/mcp-connect/%s", serverURL, id)
 }
@@ -21,3 +53,9 @@ func MCPConnectURL(serverURL, id string) string {
 func NanobotAgentConnectURL(serverURL, id string) string {
 	return MCPConnectURL(serverURL, MCPServerPrefix+id)
 }
+
+// MCPOAuthConsentURL returns the URL of the page that shows users the scopes that an MCP server requests before they
+// continue to its authorization URL.
+func MCPOAuthConsentURL(serverURL, authURL string) string {
+	return fmt.Sprintf("%s/oauth/mcp/consent?auth_url=%s", serverURL, url.QueryEscape(authURL))
+}
@@ -37,7 +37,9 @@ const (
 	GenericAuthProviderCredentialContext        = "auth-provider"
 	GenericFileScannerProviderCredentialContext = "file-scanner-provider"
 
-	MCPWebhookValidationCredentialContext = "mcp-webhook-context"
+	MCPWebhookValidationCredentialContext   = "mcp-webhook-context"
+	AlertRuleCredentialContext              = "alert-rule-context"
+	MCPCatalogEventWebhookCredentialContext = "mcp-catalog-event-webhook-context"
 
 	JWKCredentialContext = "jwk"
 )
@@ -0,0 +1,164 @@
+package validation
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+var (
+	envVarNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// headerNameRegex matches the token characters that HTTP header names are made of.
+	headerNameRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+)
+
+// ValidateCatalogEntryTemplates returns every problem with the environment variables of a catalog entry manifest and
+// the ${VAR} templates that reference them. Unlike ValidateCatalogEntryManifest, it doesn't stop at the first problem,
+// so that authors can fix them all at once.
+func ValidateCatalogEntryTemplates(manifest types.MCPServerCatalogEntryManifest) []types.RuntimeValidationError {
+	var errs []types.RuntimeValidationError
+
+	keys := make(map[string]struct{}, len(manifest.Env))
+	for i, env := range manifest.Env {
+		if !envVarNameRegex.MatchString(env.Key) {
+			errs = append(errs, types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   fmt.Sprintf("env[%d].key", i),
+				Message: fmt.Sprintf("%q is not a valid environment variable name", env.Key),
+			})
+		}
+		if _, ok := keys[env.Key]; ok {
+			errs = append(errs, types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   fmt.Sprintf("env[%d].key", i),
+				Message: fmt.Sprintf("duplicate environment variable %q", env.Key),
+			})
+		}
+		keys[env.Key] = struct{}{}
+	}
+
+	templates := catalogEntryTemplates(manifest)
+	for _, field := range slices.Sorted(maps.Keys(templates)) {
+		if msg := templateError(templates[field]); msg != "" {
+			errs = append(errs, types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   field,
+				Message: msg,
+			})
+		}
+	}
+
+	return errs
+}
+
+// ValidateCatalogEntryHeaders returns every problem with the header definitions of a remote catalog entry manifest.
+func ValidateCatalogEntryHeaders(manifest types.MCPServerCatalogEntryManifest) []types.RuntimeValidationError {
+	if manifest.RemoteConfig == nil {
+		return nil
+	}
+
+	var (
+		errs []types.RuntimeValidationError
+		keys = make(map[string]struct{}, len(manifest.RemoteConfig.Headers))
+	)
+	for i, header := range manifest.RemoteConfig.Headers {
+		if header.Key == "" {
+			// ValidateCatalogEntryManifest reports empty header keys.
+			continue
+		}
+		if !headerNameRegex.MatchString(header.Key) {
+			errs = append(errs, types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   fmt.Sprintf("remoteConfig.headers[%d].key", i),
+				Message: fmt.Sprintf("%q is not a valid header name", header.Key),
+			})
+		}
+		// Header names are case-insensitive.
+		key := strings.ToLower(header.Key)
+		if _, ok := keys[key]; ok {
+			errs = append(errs, types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   fmt.Sprintf("remoteConfig.headers[%d].key", i),
+				Message: fmt.Sprintf("duplicate header %q", header.Key),
+			})
+		}
+		keys[key] = struct{}{}
+
+		if strings.ContainsAny(header.Value, "\r\n") || strings.ContainsAny(header.Prefix, "\r\n") {
+			errs = append(errs, types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   fmt.Sprintf("remoteConfig.headers[%d]", i),
+				Message: "header values cannot contain line breaks",
+			})
+		}
+	}
+
+	return errs
+}
+
+// catalogEntryTemplates returns the fields of a catalog entry manifest that can reference variables with ${VAR}.
+func catalogEntryTemplates(manifest types.MCPServerCatalogEntryManifest) map[string]string {
+	templates := make(map[string]string)
+	addArgs := func(prefix string, args []string) {
+		for i, arg := range args {
+			templates[fmt.Sprintf("%s.args[%d]", prefix, i)] = arg
+		}
+	}
+
+	switch manifest.Runtime {
+	case types.RuntimeUVX:
+		if manifest.UVXConfig != nil {
+			templates["uvxConfig.command"] = manifest.UVXConfig.Command
+			addArgs("uvxConfig", manifest.UVXConfig.Args)
+		}
+	case types.RuntimeNPX:
+		if manifest.NPXConfig != nil {
+			addArgs("npxConfig", manifest.NPXConfig.Args)
+		}
+	case types.RuntimeContainerized:
+		if manifest.ContainerizedConfig != nil {
+			templates["containerizedConfig.command"] = manifest.ContainerizedConfig.Command
+			addArgs("containerizedConfig", manifest.ContainerizedConfig.Args)
+		}
+	case types.RuntimeStdio:
+		if manifest.StdioConfig != nil {
+			templates["stdioConfig.command"] = manifest.StdioConfig.Command
+			addArgs("stdioConfig", manifest.StdioConfig.Args)
+		}
+	case types.RuntimeSource:
+		if manifest.SourceConfig != nil {
+			templates["sourceConfig.command"] = manifest.SourceConfig.Command
+			addArgs("sourceConfig", manifest.SourceConfig.Args)
+		}
+	case types.RuntimeRemote:
+		if manifest.RemoteConfig != nil {
+			templates["remoteConfig.urlTemplate"] = manifest.RemoteConfig.URLTemplate
+		}
+	}
+
+	return templates
+}
+
+// templateError returns why the ${VAR} references in text are malformed, or an empty string if they aren't.
+func templateError(text string) string {
+	for rest := text; ; {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			return ""
+		}
+		rest = rest[start+2:]
+
+		end := strings.Index(rest, "}")
+		if end < 0 {
+			return "unterminated variable reference: missing }"
+		}
+		if name := rest[:end]; !envVarNameRegex.MatchString(name) {
+			return fmt.Sprintf("%q is not a valid variable name", name)
+		}
+		rest = rest[end+1:]
+	}
+}
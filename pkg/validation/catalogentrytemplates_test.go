@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCatalogEntryTemplates(t *testing.T) {
+	manifest := types.MCPServerCatalogEntryManifest{
+		Runtime: types.RuntimeNPX,
+		NPXConfig: &types.NPXRuntimeConfig{
+			Package: "test-server",
+			Args:    []string{"--token=${TOKEN}", "--dir=${DIR", "--name=${not-valid}", "--plain"},
+		},
+		Env: []types.MCPEnv{
+			{MCPHeader: types.MCPHeader{Key: "TOKEN"}},
+			{MCPHeader: types.MCPHeader{Key: "TOKEN"}},
+			{MCPHeader: types.MCPHeader{Key: "1BAD"}},
+		},
+	}
+
+	require.Equal(t, []types.RuntimeValidationError{
+		{Runtime: types.RuntimeNPX, Field: "env[1].key", Message: `duplicate environment variable "TOKEN"`},
+		{Runtime: types.RuntimeNPX, Field: "env[2].key", Message: `"1BAD" is not a valid environment variable name`},
+		{Runtime: types.RuntimeNPX, Field: "npxConfig.args[1]", Message: "unterminated variable reference: missing }"},
+		{Runtime: types.RuntimeNPX, Field: "npxConfig.args[2]", Message: `"not-valid" is not a valid variable name`},
+	}, ValidateCatalogEntryTemplates(manifest))
+
+	manifest.NPXConfig.Args = []string{"--token=${TOKEN}"}
+	manifest.Env = manifest.Env[:1]
+	require.Empty(t, ValidateCatalogEntryTemplates(manifest))
+}
+
+func TestValidateCatalogEntryHeaders(t *testing.T) {
+	manifest := types.MCPServerCatalogEntryManifest{
+		Runtime: types.RuntimeRemote,
+		RemoteConfig: &types.RemoteCatalogConfig{
+			FixedURL: "https://example.com/mcp",
+			Headers: []types.MCPHeader{
+				{Key: "Authorization", Prefix: "Bearer "},
+				{Key: "authorization"},
+				{Key: "X Api Key"},
+				{Key: "X-Static", Value: "a\r\nX-Injected: b"},
+				{Key: ""},
+			},
+		},
+	}
+
+	require.Equal(t, []types.RuntimeValidationError{
+		{Runtime: types.RuntimeRemote, Field: "remoteConfig.headers[1].key", Message: `duplicate header "authorization"`},
+		{Runtime: types.RuntimeRemote, Field: "remoteConfig.headers[2].key", Message: `"X Api Key" is not a valid header name`},
+		{Runtime: types.RuntimeRemote, Field: "remoteConfig.headers[3]", Message: "header values cannot contain line breaks"},
+	}, ValidateCatalogEntryHeaders(manifest))
+}
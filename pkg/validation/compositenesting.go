@@ -0,0 +1,82 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// MaxCompositeNestingDepth is the number of levels of composite servers that can be nested in a composite server. With
+// a depth of 1, the components of a composite server can be composite servers, but their components can't.
+const MaxCompositeNestingDepth = 1
+
+// nestedComponent is a component of a composite server or catalog entry, with its own components if it is a composite.
+type nestedComponent struct {
+	id         string
+	composite  bool
+	components []nestedComponent
+}
+
+func serverComponentTree(component types.ComponentServer) nestedComponent {
+	node := nestedComponent{
+		id:        component.ComponentID(),
+		composite: component.Manifest.Runtime == types.RuntimeComposite,
+	}
+	if component.Manifest.CompositeConfig != nil {
+		for _, child := range component.Manifest.CompositeConfig.ComponentServers {
+			node.components = append(node.components, serverComponentTree(child))
+		}
+	}
+	return node
+}
+
+func catalogComponentTree(component types.CatalogComponentServer) nestedComponent {
+	node := nestedComponent{
+		id:        component.ComponentID(),
+		composite: component.Manifest.Runtime == types.RuntimeComposite,
+	}
+	if component.Manifest.CompositeConfig != nil {
+		for _, child := range component.Manifest.CompositeConfig.ComponentServers {
+			node.components = append(node.components, catalogComponentTree(child))
+		}
+	}
+	return node
+}
+
+// ValidateComponentNesting validates that a component of a composite server doesn't nest composite servers deeper than
+// MaxCompositeNestingDepth, and that it doesn't contain itself or the composite server. compositeIDs are the IDs of the
+// composite server, such as its name and the name of its catalog entry.
+func ValidateComponentNesting(component types.ComponentServer, compositeIDs ...string) error {
+	return validateNesting(serverComponentTree(component), slices.DeleteFunc(slices.Clone(compositeIDs), isEmpty), 0)
+}
+
+// ValidateCatalogComponentNesting validates a component of a composite catalog entry like ValidateComponentNesting.
+func ValidateCatalogComponentNesting(component types.CatalogComponentServer, compositeIDs ...string) error {
+	return validateNesting(catalogComponentTree(component), slices.DeleteFunc(slices.Clone(compositeIDs), isEmpty), 0)
+}
+
+func validateNesting(component nestedComponent, ancestors []string, depth int) error {
+	if slices.Contains(ancestors, component.id) {
+		return fmt.Errorf("component %s creates a cycle: %s", component.id, strings.Join(append(ancestors, component.id), " -> "))
+	}
+	if !component.composite {
+		return nil
+	}
+	if depth >= MaxCompositeNestingDepth {
+		return fmt.Errorf("component %s is a composite server nested more than %d level(s) deep", component.id, MaxCompositeNestingDepth)
+	}
+
+	ancestors = append(slices.Clip(ancestors), component.id)
+	for _, child := range component.components {
+		if err := validateNesting(child, ancestors, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isEmpty(s string) bool {
+	return s == ""
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -652,6 +654,15 @@ func (v CompositeValidator) ValidateConfig(manifest types.MCPServerManifest) err
 		}
 	}
 
+	toolNamespace := manifest.CompositeConfig.ToolNamespace
+	if toolNamespace != types.ToolNamespaceNone && toolNamespace != types.ToolNamespaceComponent {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeComposite,
+			Field:   "compositeConfig.toolNamespace",
+			Message: fmt.Sprintf("toolNamespace must be empty or %q", types.ToolNamespaceComponent),
+		}
+	}
+
 	var (
 		componentServerIDs = make(map[string]struct{}, numComponents)
 		toolPrefixes       = make(map[string]struct{}, numComponents)
@@ -668,12 +679,12 @@ func (v CompositeValidator) ValidateConfig(manifest types.MCPServerManifest) err
 			}
 		}
 
-		// Prevent composite MCP servers from being nested
-		if component.Manifest.Runtime == types.RuntimeComposite {
+		// Limit the nesting of composite MCP servers and prevent cycles
+		if err := ValidateComponentNesting(component); err != nil {
 			return types.RuntimeValidationError{
 				Runtime: types.RuntimeComposite,
-				Field:   fmt.Sprintf("compositeConfig.componentServers[%d].manifest.runtime", i),
-				Message: "runtime cannot be composite",
+				Field:   fmt.Sprintf("compositeConfig.componentServers[%d]", i),
+				Message: err.Error(),
 			}
 		}
 
@@ -688,8 +699,8 @@ func (v CompositeValidator) ValidateConfig(manifest types.MCPServerManifest) err
 			}
 		}
 
-		// Validate the tool prefix
-		prefix := component.ToolPrefix
+		// Validate the tool prefix, including one derived from the component's name
+		prefix := component.EffectiveToolPrefix(toolNamespace)
 		if prefix != "" {
 			// Prevent duplicates
 			if _, ok := toolPrefixes[prefix]; ok {
@@ -822,12 +833,12 @@ func (v CompositeValidator) ValidateCatalogConfig(manifest types.MCPServerCatalo
 			}
 		}
 
-		// Prevent composite MCP servers from being nested
-		if component.Manifest.Runtime == types.RuntimeComposite {
+		// Limit the nesting of composite MCP servers and prevent cycles
+		if err := ValidateCatalogComponentNesting(component); err != nil {
 			return types.RuntimeValidationError{
 				Runtime: types.RuntimeComposite,
-				Field:   fmt.Sprintf("compositeConfig.componentServers[%d].manifest.runtime", i),
-				Message: "runtime cannot be composite",
+				Field:   fmt.Sprintf("compositeConfig.componentServers[%d]", i),
+				Message: err.Error(),
 			}
 		}
 
@@ -950,6 +961,250 @@ func (v CompositeValidator) ValidateSystemConfig(manifest types.SystemMCPServerM
 	}
 }
 
+// StdioValidator implements RuntimeValidator for stdio runtime
+type StdioValidator struct{}
+
+func (v StdioValidator) ValidateConfig(manifest types.MCPServerManifest) error {
+	if manifest.Runtime != types.RuntimeStdio {
+		return types.RuntimeValidationError{
+			Runtime: manifest.Runtime,
+			Field:   "runtime",
+			Message: "expected stdio runtime",
+		}
+	}
+
+	if manifest.StdioConfig == nil {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeStdio,
+			Field:   "stdioConfig",
+			Message: "stdio configuration is required",
+		}
+	}
+
+	return v.validateStdioConfig(*manifest.StdioConfig)
+}
+
+func (v StdioValidator) ValidateCatalogConfig(manifest types.MCPServerCatalogEntryManifest) error {
+	if manifest.Runtime != types.RuntimeStdio {
+		return types.RuntimeValidationError{
+			Runtime: manifest.Runtime,
+			Field:   "runtime",
+			Message: "expected stdio runtime",
+		}
+	}
+
+	if manifest.StdioConfig == nil {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeStdio,
+			Field:   "stdioConfig",
+			Message: "stdio configuration is required",
+		}
+	}
+
+	return v.validateStdioConfig(*manifest.StdioConfig)
+}
+
+func (v StdioValidator) ValidateSystemConfig(manifest types.SystemMCPServerManifest) error {
+	if manifest.Runtime != types.RuntimeStdio {
+		return types.RuntimeValidationError{
+			Runtime: manifest.Runtime,
+			Field:   "runtime",
+			Message: "expected stdio runtime",
+		}
+	}
+
+	return types.RuntimeValidationError{
+		Runtime: types.RuntimeStdio,
+		Field:   "runtime",
+		Message: "stdio runtime is not supported for system servers",
+	}
+}
+
+func (v StdioValidator) validateStdioConfig(config types.StdioRuntimeConfig) error {
+	if strings.TrimSpace(config.Command) == "" {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeStdio,
+			Field:   "command",
+			Message: "command field cannot be empty",
+		}
+	}
+
+	// Validate args format if provided
+	for i, arg := range config.Args {
+		if strings.TrimSpace(arg) == "" {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeStdio,
+				Field:   "args[" + strconv.Itoa(i) + "]",
+				Message: "argument cannot be empty",
+			}
+		}
+	}
+
+	if config.Cwd != "" && (!path.IsAbs(config.Cwd) || path.Clean(config.Cwd) != config.Cwd) {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeStdio,
+			Field:   "cwd",
+			Message: "working directory must be a clean absolute path",
+		}
+	}
+
+	return nil
+}
+
+// UsesStdioRuntime returns whether the catalog entry manifest, or any of its composite components at any depth, runs a
+// command as a subprocess of Obot.
+func UsesStdioRuntime(manifest types.MCPServerCatalogEntryManifest) bool {
+	if manifest.Runtime == types.RuntimeStdio {
+		return true
+	}
+	if manifest.CompositeConfig == nil {
+		return false
+	}
+	return slices.ContainsFunc(manifest.CompositeConfig.ComponentServers, func(component types.CatalogComponentServer) bool {
+		return UsesStdioRuntime(component.Manifest)
+	})
+}
+
+// SourceValidator implements RuntimeValidator for source runtime
+type SourceValidator struct{}
+
+func (v SourceValidator) ValidateConfig(manifest types.MCPServerManifest) error {
+	if manifest.Runtime != types.RuntimeSource {
+		return types.RuntimeValidationError{
+			Runtime: manifest.Runtime,
+			Field:   "runtime",
+			Message: "expected source runtime",
+		}
+	}
+
+	if manifest.SourceConfig == nil {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeSource,
+			Field:   "sourceConfig",
+			Message: "source configuration is required",
+		}
+	}
+
+	return v.validateSourceConfig(*manifest.SourceConfig)
+}
+
+func (v SourceValidator) ValidateCatalogConfig(manifest types.MCPServerCatalogEntryManifest) error {
+	if manifest.Runtime != types.RuntimeSource {
+		return types.RuntimeValidationError{
+			Runtime: manifest.Runtime,
+			Field:   "runtime",
+			Message: "expected source runtime",
+		}
+	}
+
+	if manifest.SourceConfig == nil {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeSource,
+			Field:   "sourceConfig",
+			Message: "source configuration is required",
+		}
+	}
+
+	return v.validateSourceConfig(*manifest.SourceConfig)
+}
+
+func (v SourceValidator) ValidateSystemConfig(manifest types.SystemMCPServerManifest) error {
+	if manifest.Runtime != types.RuntimeSource {
+		return types.RuntimeValidationError{
+			Runtime: manifest.Runtime,
+			Field:   "runtime",
+			Message: "expected source runtime",
+		}
+	}
+
+	return types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "runtime",
+		Message: "source runtime is not supported for system servers",
+	}
+}
+
+func (v SourceValidator) validateSourceConfig(config types.SourceRuntimeConfig) error {
+	repository, bundleURL := strings.TrimSpace(config.Repository), strings.TrimSpace(config.BundleURL)
+	switch {
+	case repository == "" && bundleURL == "":
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeSource,
+			Field:   "repository",
+			Message: "either repository or bundleURL is required",
+		}
+	case repository != "" && bundleURL != "":
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeSource,
+			Field:   "bundleURL",
+			Message: "only one of repository and bundleURL can be set",
+		}
+	case repository != "":
+		if u, err := url.Parse(repository); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeSource,
+				Field:   "repository",
+				Message: "repository must be an http or https URL",
+			}
+		}
+	default:
+		if u, err := url.Parse(bundleURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeSource,
+				Field:   "bundleURL",
+				Message: "bundleURL must be an http or https URL",
+			}
+		}
+	}
+
+	if config.Ref != "" && (strings.ContainsAny(config.Ref, " \t\n") || strings.HasPrefix(config.Ref, "-")) {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeSource,
+			Field:   "ref",
+			Message: "ref must be a branch, tag, or commit",
+		}
+	}
+
+	for _, field := range []struct{ name, path string }{{"contextDir", config.ContextDir}, {"dockerfile", config.Dockerfile}} {
+		if field.path != "" && (path.IsAbs(field.path) || slices.Contains(strings.Split(field.path, "/"), "..")) {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeSource,
+				Field:   field.name,
+				Message: "must be a relative path inside the source",
+			}
+		}
+	}
+
+	if config.Port <= 0 || config.Port > 65535 {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeSource,
+			Field:   "port",
+			Message: "port must be between 1 and 65535",
+		}
+	}
+
+	if strings.TrimSpace(config.Path) == "" {
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeSource,
+			Field:   "path",
+			Message: "path field cannot be empty",
+		}
+	}
+
+	// Validate args format if provided
+	for i, arg := range config.Args {
+		if strings.TrimSpace(arg) == "" {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeSource,
+				Field:   "args[" + strconv.Itoa(i) + "]",
+				Message: "argument cannot be empty",
+			}
+		}
+	}
+
+	return nil
+}
+
 // getRuntimeValidators returns a map of all available runtime validators
 func getRuntimeValidators() RuntimeValidators {
 	return RuntimeValidators{
@@ -958,6 +1213,8 @@ func getRuntimeValidators() RuntimeValidators {
 		types.RuntimeContainerized: ContainerizedValidator{},
 		types.RuntimeRemote:        RemoteValidator{},
 		types.RuntimeComposite:     CompositeValidator{},
+		types.RuntimeStdio:         StdioValidator{},
+		types.RuntimeSource:        SourceValidator{},
 	}
 }
 
@@ -974,6 +1231,30 @@ func ValidateServerManifest(manifest types.MCPServerManifest, isMultiUser bool)
 		return err
 	}
 
+	if err := validateSamplingConfig(manifest.Runtime, manifest.Sampling); err != nil {
+		return err
+	}
+
+	if err := validateConnectSettings(manifest.Runtime, manifest.ConnectSettings); err != nil {
+		return err
+	}
+
+	if err := validateRequestTimeouts(manifest.Runtime, manifest.RequestTimeouts); err != nil {
+		return err
+	}
+
+	var headers []types.MCPHeader
+	if manifest.RemoteConfig != nil {
+		headers = manifest.RemoteConfig.Headers
+	}
+	if err := validateOAuthTokenSources(manifest.Runtime, manifest.Env, headers, isMultiUser); err != nil {
+		return err
+	}
+
+	if err := validateSecretRefs(manifest.Runtime, manifest.Env); err != nil {
+		return err
+	}
+
 	if validator, ok := getRuntimeValidators()[manifest.Runtime]; ok {
 		return validator.ValidateConfig(manifest)
 	}
@@ -990,6 +1271,46 @@ func ValidateCatalogEntryManifest(manifest types.MCPServerCatalogEntryManifest)
 		return err
 	}
 
+	if err := validateToolPolicy(manifest.Runtime, manifest.ToolPolicy); err != nil {
+		return err
+	}
+
+	if err := validateOAuthScopeGroups(manifest.Runtime, manifest.OAuthScopeGroups); err != nil {
+		return err
+	}
+
+	if err := validateToolCosts(manifest.Runtime, manifest.ToolCosts); err != nil {
+		return err
+	}
+
+	if err := validateSamplingConfig(manifest.Runtime, manifest.Sampling); err != nil {
+		return err
+	}
+
+	if err := validateConnectSettings(manifest.Runtime, manifest.ConnectSettings); err != nil {
+		return err
+	}
+
+	if err := validateRequestTimeouts(manifest.Runtime, manifest.RequestTimeouts); err != nil {
+		return err
+	}
+
+	if err := validateConfigurationPresets(manifest); err != nil {
+		return err
+	}
+
+	var headers []types.MCPHeader
+	if manifest.RemoteConfig != nil {
+		headers = manifest.RemoteConfig.Headers
+	}
+	if err := validateOAuthTokenSources(manifest.Runtime, manifest.Env, headers, false); err != nil {
+		return err
+	}
+
+	if err := validateSecretRefs(manifest.Runtime, manifest.Env); err != nil {
+		return err
+	}
+
 	if validator, ok := getRuntimeValidators()[manifest.Runtime]; ok {
 		return validator.ValidateCatalogConfig(manifest)
 	}
@@ -1070,3 +1391,360 @@ func validateStartupTimeout(runtime types.Runtime, startupTimeoutSeconds int) er
 
 	return nil
 }
+
+func validateToolPolicy(runtime types.Runtime, policy *types.MCPToolPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	for _, pattern := range policy.Allow {
+		if err := mcp.ValidateToolPattern(pattern); err != nil {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "toolPolicy.allow",
+				Message: err.Error(),
+			}
+		}
+	}
+	for _, pattern := range policy.Deny {
+		if err := mcp.ValidateToolPattern(pattern); err != nil {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "toolPolicy.deny",
+				Message: err.Error(),
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateOAuthScopeGroups(runtime types.Runtime, groups []types.MCPOAuthScopeGroup) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	if runtime != types.RuntimeRemote {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   "oauthScopeGroups",
+			Message: "OAuth scope groups are only supported for remote servers",
+		}
+	}
+
+	names := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		if group.Name == "" {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "oauthScopeGroups.name",
+				Message: "OAuth scope group name cannot be empty",
+			}
+		}
+		if _, ok := names[group.Name]; ok {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "oauthScopeGroups.name",
+				Message: fmt.Sprintf("duplicate OAuth scope group %q", group.Name),
+			}
+		}
+		names[group.Name] = struct{}{}
+
+		if len(group.Scopes) == 0 {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "oauthScopeGroups.scopes",
+				Message: fmt.Sprintf("OAuth scope group %q must have at least one scope", group.Name),
+			}
+		}
+		for _, scope := range group.Scopes {
+			if scope == "" || strings.ContainsAny(scope, " \t\n\"\\") {
+				return types.RuntimeValidationError{
+					Runtime: runtime,
+					Field:   "oauthScopeGroups.scopes",
+					Message: fmt.Sprintf("invalid OAuth scope %q in group %q", scope, group.Name),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateToolCosts(runtime types.Runtime, costs []types.MCPToolCost) error {
+	tools := make(map[string]struct{}, len(costs))
+	for _, cost := range costs {
+		if cost.Tool == "" {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "toolCosts.tool",
+				Message: "tool name cannot be empty",
+			}
+		}
+		if _, ok := tools[cost.Tool]; ok {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "toolCosts.tool",
+				Message: fmt.Sprintf("duplicate cost for tool %q", cost.Tool),
+			}
+		}
+		tools[cost.Tool] = struct{}{}
+
+		if cost.Credits < 0 || cost.USDPerCall < 0 || cost.RateLimitWeight < 0 {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "toolCosts",
+				Message: fmt.Sprintf("cost of tool %q cannot be negative", cost.Tool),
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateConnectSettings(runtime types.Runtime, settings *types.MCPConnectSettings) error {
+	if settings == nil {
+		return nil
+	}
+
+	for _, setting := range []struct {
+		field string
+		value int
+	}{
+		{"connectSettings.pingIntervalSeconds", settings.PingIntervalSeconds},
+		{"connectSettings.idleTimeoutSeconds", settings.IdleTimeoutSeconds},
+		{"connectSettings.maxSessionDurationSeconds", settings.MaxSessionDurationSeconds},
+	} {
+		if setting.value < -1 {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   setting.field,
+				Message: "must be -1 to disable, 0 to use the default, or a positive number of seconds",
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateRequestTimeouts(runtime types.Runtime, timeouts *types.MCPRequestTimeouts) error {
+	if timeouts == nil {
+		return nil
+	}
+
+	for _, timeout := range []struct {
+		field string
+		value int
+	}{
+		{"requestTimeouts.toolsListSeconds", timeouts.ToolsListSeconds},
+		{"requestTimeouts.toolsCallSeconds", timeouts.ToolsCallSeconds},
+		{"requestTimeouts.resourcesReadSeconds", timeouts.ResourcesReadSeconds},
+	} {
+		if timeout.value < -1 {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   timeout.field,
+				Message: "must be -1 to disable, 0 to use the default, or a positive number of seconds",
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSamplingConfig(runtime types.Runtime, config *types.MCPSamplingConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	if runtime == types.RuntimeComposite && config.Enabled {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   "sampling",
+			Message: "sampling is configured on the component servers of a composite server",
+		}
+	}
+
+	for _, model := range config.AllowedModels {
+		if strings.TrimSpace(model) == "" {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   "sampling.allowedModels",
+				Message: "model names cannot be empty",
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateConfigurationPresets(manifest types.MCPServerCatalogEntryManifest) error {
+	if len(manifest.Presets) == 0 {
+		return nil
+	}
+
+	if manifest.Runtime == types.RuntimeComposite {
+		return types.RuntimeValidationError{
+			Runtime: manifest.Runtime,
+			Field:   "presets",
+			Message: "presets are configured on the component servers of a composite server",
+		}
+	}
+
+	sensitive := make(map[string]struct{})
+	for _, env := range manifest.Env {
+		if env.Sensitive {
+			sensitive[env.Key] = struct{}{}
+		}
+	}
+	if manifest.RemoteConfig != nil {
+		for _, header := range manifest.RemoteConfig.Headers {
+			if header.Sensitive {
+				sensitive[header.Key] = struct{}{}
+			}
+		}
+	}
+
+	names := make(map[string]struct{}, len(manifest.Presets))
+	for _, preset := range manifest.Presets {
+		if strings.TrimSpace(preset.Name) == "" {
+			return types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   "presets.name",
+				Message: "preset names cannot be empty",
+			}
+		}
+		if _, ok := names[preset.Name]; ok {
+			return types.RuntimeValidationError{
+				Runtime: manifest.Runtime,
+				Field:   "presets.name",
+				Message: fmt.Sprintf("duplicate preset name %q", preset.Name),
+			}
+		}
+		names[preset.Name] = struct{}{}
+
+		for key := range preset.Env {
+			if _, ok := sensitive[key]; ok {
+				return types.RuntimeValidationError{
+					Runtime: manifest.Runtime,
+					Field:   "presets.env",
+					Message: fmt.Sprintf("preset %q cannot set a value for sensitive key %q", preset.Name, key),
+				}
+			}
+		}
+
+		if preset.URL != "" {
+			if manifest.Runtime != types.RuntimeRemote || manifest.RemoteConfig == nil || manifest.RemoteConfig.Hostname == "" {
+				return types.RuntimeValidationError{
+					Runtime: manifest.Runtime,
+					Field:   "presets.url",
+					Message: fmt.Sprintf("preset %q can only set a URL for remote catalog entries that require a user URL", preset.Name),
+				}
+			}
+			if err := types.ValidateURLHostname(preset.URL, manifest.RemoteConfig.Hostname); err != nil {
+				return err
+			}
+		}
+
+		for _, tool := range preset.Tools {
+			if strings.TrimSpace(tool) == "" {
+				return types.RuntimeValidationError{
+					Runtime: manifest.Runtime,
+					Field:   "presets.tools",
+					Message: "tool names cannot be empty",
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateOAuthTokenSources(runtime types.Runtime, env []types.MCPEnv, headers []types.MCPHeader, isMultiUser bool) error {
+	for i, e := range env {
+		if e.OAuthTokenSource == nil {
+			continue
+		}
+		if isMultiUser {
+			// A multi-user server's environment is shared by all of its users, so it can't hold any one user's token.
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   fmt.Sprintf("env[%d]", i),
+				Message: "environment variables of multi-user servers cannot be sourced from an OAuth token",
+			}
+		}
+		if err := validateOAuthTokenSource(runtime, fmt.Sprintf("env[%d]", i), e.MCPHeader); err != nil {
+			return err
+		}
+		if !e.File || !e.DynamicFile {
+			// The token is refreshed in place, so the server must read it from a dynamic file rather than its environment.
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   fmt.Sprintf("env[%d]", i),
+				Message: "values sourced from an OAuth token must be provided as a dynamic file",
+			}
+		}
+	}
+
+	for i, header := range headers {
+		if header.OAuthTokenSource == nil {
+			continue
+		}
+		if err := validateOAuthTokenSource(runtime, fmt.Sprintf("header[%d]", i), header); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSecretRefs(runtime types.Runtime, env []types.MCPEnv) error {
+	for i, e := range env {
+		if e.ValueFrom == "" {
+			continue
+		}
+		if _, _, _, err := mcp.ParseSecretRef(e.ValueFrom); err != nil {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   fmt.Sprintf("env[%d].valueFrom", i),
+				Message: err.Error(),
+			}
+		}
+		if e.Value != "" || e.OAuthTokenSource != nil {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   fmt.Sprintf("env[%d]", i),
+				Message: "a value from an external secret cannot also have a static value or be sourced from an OAuth token",
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateOAuthTokenSource(runtime types.Runtime, field string, header types.MCPHeader) error {
+	if strings.TrimSpace(header.OAuthTokenSource.OAuthApp) == "" {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   field + ".oauthTokenSource.oauthApp",
+			Message: "OAuth app cannot be empty",
+		}
+	}
+	if header.Value != "" {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   field,
+			Message: "a value sourced from an OAuth token cannot also have a static value",
+		}
+	}
+	for _, scope := range header.OAuthTokenSource.Scopes {
+		if strings.TrimSpace(scope) == "" {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   field + ".oauthTokenSource.scopes",
+				Message: "scopes cannot be empty",
+			}
+		}
+	}
+
+	return nil
+}
@@ -1056,7 +1056,7 @@ func TestCompositeValidator_ValidateCatalogConfig(t *testing.T) {
 			},
 		},
 		{
-			name: "nested composite runtime not allowed in catalog",
+			name: "one level of nested composite runtime allowed in catalog",
 			manifest: types.MCPServerCatalogEntryManifest{
 				Runtime: types.RuntimeComposite,
 				CompositeConfig: &types.CompositeCatalogConfig{
@@ -1065,6 +1065,42 @@ func TestCompositeValidator_ValidateCatalogConfig(t *testing.T) {
 							CatalogEntryID: "entry-1",
 							Manifest: types.MCPServerCatalogEntryManifest{
 								Runtime: types.RuntimeComposite,
+								CompositeConfig: &types.CompositeCatalogConfig{
+									ComponentServers: []types.CatalogComponentServer{
+										{
+											CatalogEntryID: "entry-2",
+											Manifest: types.MCPServerCatalogEntryManifest{
+												Runtime: types.RuntimeRemote,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "nested composite runtime too deep in catalog",
+			manifest: types.MCPServerCatalogEntryManifest{
+				Runtime: types.RuntimeComposite,
+				CompositeConfig: &types.CompositeCatalogConfig{
+					ComponentServers: []types.CatalogComponentServer{
+						{
+							CatalogEntryID: "entry-1",
+							Manifest: types.MCPServerCatalogEntryManifest{
+								Runtime: types.RuntimeComposite,
+								CompositeConfig: &types.CompositeCatalogConfig{
+									ComponentServers: []types.CatalogComponentServer{
+										{
+											CatalogEntryID: "entry-2",
+											Manifest: types.MCPServerCatalogEntryManifest{
+												Runtime: types.RuntimeComposite,
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -1072,8 +1108,39 @@ func TestCompositeValidator_ValidateCatalogConfig(t *testing.T) {
 			},
 			expectedError: types.RuntimeValidationError{
 				Runtime: types.RuntimeComposite,
-				Field:   "compositeConfig.componentServers[0].manifest.runtime",
-				Message: "runtime cannot be composite",
+				Field:   "compositeConfig.componentServers[0]",
+				Message: "component entry-2 is a composite server nested more than 1 level(s) deep",
+			},
+		},
+		{
+			name: "nested composite cycle detected in catalog",
+			manifest: types.MCPServerCatalogEntryManifest{
+				Runtime: types.RuntimeComposite,
+				CompositeConfig: &types.CompositeCatalogConfig{
+					ComponentServers: []types.CatalogComponentServer{
+						{
+							CatalogEntryID: "entry-1",
+							Manifest: types.MCPServerCatalogEntryManifest{
+								Runtime: types.RuntimeComposite,
+								CompositeConfig: &types.CompositeCatalogConfig{
+									ComponentServers: []types.CatalogComponentServer{
+										{
+											CatalogEntryID: "entry-1",
+											Manifest: types.MCPServerCatalogEntryManifest{
+												Runtime: types.RuntimeRemote,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   "compositeConfig.componentServers[0]",
+				Message: "component entry-1 creates a cycle: entry-1 -> entry-1",
 			},
 		},
 		{
@@ -1636,6 +1703,95 @@ func TestCompositeValidator_ValidateConfig_ToolPrefixLength(t *testing.T) {
 	}
 }
 
+func TestCompositeValidator_ValidateConfig_ToolNamespace(t *testing.T) {
+	validator := CompositeValidator{}
+
+	components := func(names ...string) []types.ComponentServer {
+		var result []types.ComponentServer
+		for i, name := range names {
+			result = append(result, types.ComponentServer{
+				CatalogEntryID: fmt.Sprintf("entry-%d", i+1),
+				Manifest: types.MCPServerManifest{
+					Name:    name,
+					Runtime: types.RuntimeRemote,
+				},
+				ToolOverrides: []types.ToolOverride{
+					{Name: "search", Enabled: true},
+				},
+			})
+		}
+		return result
+	}
+
+	tests := []struct {
+		name          string
+		manifest      types.MCPServerManifest
+		expectedError error
+	}{
+		{
+			name: "component namespace resolves conflicting tool names",
+			manifest: types.MCPServerManifest{
+				Runtime: types.RuntimeComposite,
+				CompositeConfig: &types.CompositeRuntimeConfig{
+					ComponentServers: components("GitHub", "GitLab"),
+					ToolNamespace:    types.ToolNamespaceComponent,
+				},
+			},
+		},
+		{
+			name: "conflicting tool names without a namespace",
+			manifest: types.MCPServerManifest{
+				Runtime: types.RuntimeComposite,
+				CompositeConfig: &types.CompositeRuntimeConfig{
+					ComponentServers: components("GitHub", "GitLab"),
+				},
+			},
+			expectedError: types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   "compositeConfig.componentServers[1].toolOverrides[0]",
+				Message: "duplicate tool name: search",
+			},
+		},
+		{
+			name: "components with the same name",
+			manifest: types.MCPServerManifest{
+				Runtime: types.RuntimeComposite,
+				CompositeConfig: &types.CompositeRuntimeConfig{
+					ComponentServers: components("GitHub", "GitHub"),
+					ToolNamespace:    types.ToolNamespaceComponent,
+				},
+			},
+			expectedError: types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   "compositeConfig.componentServers[1].toolPrefix",
+				Message: "duplicate toolPrefix: github_",
+			},
+		},
+		{
+			name: "unknown namespace",
+			manifest: types.MCPServerManifest{
+				Runtime: types.RuntimeComposite,
+				CompositeConfig: &types.CompositeRuntimeConfig{
+					ComponentServers: components("GitHub"),
+					ToolNamespace:    "server",
+				},
+			},
+			expectedError: types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   "compositeConfig.toolNamespace",
+				Message: `toolNamespace must be empty or "component"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateConfig(tt.manifest)
+			require.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
 func TestValidateManifestStartupTimeoutNonNegative(t *testing.T) {
 	t.Run("server manifest rejects negative startup timeout", func(t *testing.T) {
 		err := ValidateServerManifest(types.MCPServerManifest{
@@ -1703,3 +1859,382 @@ func TestValidateManifestStartupTimeoutNonNegative(t *testing.T) {
 		}, err)
 	})
 }
+
+func TestValidateManifestConnectSettings(t *testing.T) {
+	t.Run("server manifest accepts disabled and default settings", func(t *testing.T) {
+		err := ValidateServerManifest(types.MCPServerManifest{
+			Runtime: types.RuntimeRemote,
+			RemoteConfig: &types.RemoteRuntimeConfig{
+				URL: "https://example.com/mcp",
+			},
+			ConnectSettings: &types.MCPConnectSettings{
+				PingIntervalSeconds:       -1,
+				MaxSessionDurationSeconds: 3600,
+			},
+		}, false)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("catalog manifest rejects values below -1", func(t *testing.T) {
+		err := ValidateCatalogEntryManifest(types.MCPServerCatalogEntryManifest{
+			Runtime: types.RuntimeRemote,
+			RemoteConfig: &types.RemoteCatalogConfig{
+				FixedURL: "https://example.com/mcp",
+			},
+			ConnectSettings: &types.MCPConnectSettings{
+				IdleTimeoutSeconds: -2,
+			},
+		})
+
+		require.Equal(t, types.RuntimeValidationError{
+			Runtime: types.RuntimeRemote,
+			Field:   "connectSettings.idleTimeoutSeconds",
+			Message: "must be -1 to disable, 0 to use the default, or a positive number of seconds",
+		}, err)
+	})
+}
+
+func TestValidateOAuthScopeGroups(t *testing.T) {
+	manifest := func(runtime types.Runtime, groups ...types.MCPOAuthScopeGroup) types.MCPServerCatalogEntryManifest {
+		m := types.MCPServerCatalogEntryManifest{
+			Runtime:          runtime,
+			OAuthScopeGroups: groups,
+		}
+		if runtime == types.RuntimeRemote {
+			m.RemoteConfig = &types.RemoteCatalogConfig{FixedURL: "https://example.com/mcp"}
+		}
+		return m
+	}
+
+	t.Run("accepts groups with and without tools", func(t *testing.T) {
+		err := ValidateCatalogEntryManifest(manifest(types.RuntimeRemote,
+			types.MCPOAuthScopeGroup{Name: "profile", Scopes: []string{"read:user"}},
+			types.MCPOAuthScopeGroup{Name: "issues", Tools: []string{"create_issue"}, Scopes: []string{"repo"}},
+		))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects groups for servers that aren't remote", func(t *testing.T) {
+		err := validateOAuthScopeGroups(types.RuntimeContainerized, []types.MCPOAuthScopeGroup{{Name: "issues", Scopes: []string{"repo"}}})
+
+		require.Equal(t, types.RuntimeValidationError{
+			Runtime: types.RuntimeContainerized,
+			Field:   "oauthScopeGroups",
+			Message: "OAuth scope groups are only supported for remote servers",
+		}, err)
+	})
+
+	t.Run("rejects duplicate names", func(t *testing.T) {
+		err := ValidateCatalogEntryManifest(manifest(types.RuntimeRemote,
+			types.MCPOAuthScopeGroup{Name: "issues", Scopes: []string{"repo"}},
+			types.MCPOAuthScopeGroup{Name: "issues", Scopes: []string{"read:org"}},
+		))
+
+		require.ErrorContains(t, err, `duplicate OAuth scope group "issues"`)
+	})
+
+	t.Run("rejects groups without scopes and scopes with spaces", func(t *testing.T) {
+		require.ErrorContains(t, ValidateCatalogEntryManifest(manifest(types.RuntimeRemote,
+			types.MCPOAuthScopeGroup{Name: "issues"},
+		)), "must have at least one scope")
+		require.ErrorContains(t, ValidateCatalogEntryManifest(manifest(types.RuntimeRemote,
+			types.MCPOAuthScopeGroup{Name: "issues", Scopes: []string{"repo read:org"}},
+		)), `invalid OAuth scope "repo read:org"`)
+	})
+}
+
+func TestValidateToolCosts(t *testing.T) {
+	require.NoError(t, validateToolCosts(types.RuntimeRemote, []types.MCPToolCost{
+		{Tool: "search", USDPerCall: 0.01},
+		{Tool: "create_issue", Credits: 5, RateLimitWeight: 2},
+	}))
+
+	require.ErrorContains(t, validateToolCosts(types.RuntimeRemote, []types.MCPToolCost{{USDPerCall: 0.01}}), "tool name cannot be empty")
+	require.ErrorContains(t, validateToolCosts(types.RuntimeRemote, []types.MCPToolCost{
+		{Tool: "search", USDPerCall: 0.01},
+		{Tool: "search", Credits: 1},
+	}), `duplicate cost for tool "search"`)
+	require.ErrorContains(t, validateToolCosts(types.RuntimeRemote, []types.MCPToolCost{{Tool: "search", USDPerCall: -1}}), "cannot be negative")
+}
+
+func TestStdioValidator(t *testing.T) {
+	validator := StdioValidator{}
+
+	require.NoError(t, validator.ValidateConfig(types.MCPServerManifest{
+		Runtime: types.RuntimeStdio,
+		StdioConfig: &types.StdioRuntimeConfig{
+			Command: "my-mcp-server",
+			Args:    []string{"--verbose"},
+		},
+	}))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeStdio,
+		Field:   "stdioConfig",
+		Message: "stdio configuration is required",
+	}, validator.ValidateCatalogConfig(types.MCPServerCatalogEntryManifest{
+		Runtime: types.RuntimeStdio,
+	}))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeStdio,
+		Field:   "command",
+		Message: "command field cannot be empty",
+	}, validator.ValidateConfig(types.MCPServerManifest{
+		Runtime:     types.RuntimeStdio,
+		StdioConfig: &types.StdioRuntimeConfig{Command: " "},
+	}))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeStdio,
+		Field:   "args[1]",
+		Message: "argument cannot be empty",
+	}, validator.ValidateConfig(types.MCPServerManifest{
+		Runtime: types.RuntimeStdio,
+		StdioConfig: &types.StdioRuntimeConfig{
+			Command: "my-mcp-server",
+			Args:    []string{"--verbose", ""},
+		},
+	}))
+
+	for _, cwd := range []string{"relative/dir", "/srv/../etc", "/srv/mcp/"} {
+		require.Equal(t, types.RuntimeValidationError{
+			Runtime: types.RuntimeStdio,
+			Field:   "cwd",
+			Message: "working directory must be a clean absolute path",
+		}, validator.ValidateConfig(types.MCPServerManifest{
+			Runtime:     types.RuntimeStdio,
+			StdioConfig: &types.StdioRuntimeConfig{Command: "my-mcp-server", Cwd: cwd},
+		}), cwd)
+	}
+
+	require.True(t, UsesStdioRuntime(types.MCPServerCatalogEntryManifest{
+		Runtime: types.RuntimeComposite,
+		CompositeConfig: &types.CompositeCatalogConfig{
+			ComponentServers: []types.CatalogComponentServer{
+				{Manifest: types.MCPServerCatalogEntryManifest{Runtime: types.RuntimeRemote}},
+				{Manifest: types.MCPServerCatalogEntryManifest{Runtime: types.RuntimeStdio}},
+			},
+		},
+	}))
+	require.True(t, UsesStdioRuntime(types.MCPServerCatalogEntryManifest{
+		Runtime: types.RuntimeComposite,
+		CompositeConfig: &types.CompositeCatalogConfig{
+			ComponentServers: []types.CatalogComponentServer{
+				{Manifest: types.MCPServerCatalogEntryManifest{
+					Runtime: types.RuntimeComposite,
+					CompositeConfig: &types.CompositeCatalogConfig{
+						ComponentServers: []types.CatalogComponentServer{
+							{Manifest: types.MCPServerCatalogEntryManifest{Runtime: types.RuntimeStdio}},
+						},
+					},
+				}},
+			},
+		},
+	}), "a stdio component of a nested composite must be found")
+	require.False(t, UsesStdioRuntime(types.MCPServerCatalogEntryManifest{Runtime: types.RuntimeRemote}))
+
+	require.Error(t, validator.ValidateSystemConfig(types.SystemMCPServerManifest{Runtime: types.RuntimeStdio}))
+}
+
+func TestSourceValidator(t *testing.T) {
+	validator := SourceValidator{}
+	manifest := func(config types.SourceRuntimeConfig) types.MCPServerManifest {
+		return types.MCPServerManifest{Runtime: types.RuntimeSource, SourceConfig: &config}
+	}
+
+	require.NoError(t, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{
+		Repository: "https://github.com/example/mcp-server.git",
+		Ref:        "v1.2.0",
+		ContextDir: "server",
+		Port:       8080,
+		Path:       "/mcp",
+	})))
+	require.NoError(t, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{
+		BundleURL: "https://example.com/mcp-server.tar.gz",
+		Port:      8080,
+		Path:      "/mcp",
+	})))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "sourceConfig",
+		Message: "source configuration is required",
+	}, validator.ValidateCatalogConfig(types.MCPServerCatalogEntryManifest{
+		Runtime: types.RuntimeSource,
+	}))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "repository",
+		Message: "either repository or bundleURL is required",
+	}, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{Port: 8080, Path: "/mcp"})))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "bundleURL",
+		Message: "only one of repository and bundleURL can be set",
+	}, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{
+		Repository: "https://github.com/example/mcp-server.git",
+		BundleURL:  "https://example.com/mcp-server.tar.gz",
+		Port:       8080,
+		Path:       "/mcp",
+	})))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "repository",
+		Message: "repository must be an http or https URL",
+	}, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{
+		Repository: "file:///etc",
+		Port:       8080,
+		Path:       "/mcp",
+	})))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "ref",
+		Message: "ref must be a branch, tag, or commit",
+	}, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{
+		Repository: "https://github.com/example/mcp-server.git",
+		Ref:        "--upload-pack=evil",
+		Port:       8080,
+		Path:       "/mcp",
+	})))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "contextDir",
+		Message: "must be a relative path inside the source",
+	}, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{
+		Repository: "https://github.com/example/mcp-server.git",
+		ContextDir: "../other",
+		Port:       8080,
+		Path:       "/mcp",
+	})))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeSource,
+		Field:   "port",
+		Message: "port must be between 1 and 65535",
+	}, validator.ValidateConfig(manifest(types.SourceRuntimeConfig{
+		Repository: "https://github.com/example/mcp-server.git",
+		Path:       "/mcp",
+	})))
+
+	require.Error(t, validator.ValidateSystemConfig(types.SystemMCPServerManifest{Runtime: types.RuntimeSource}))
+}
+
+func TestValidateConfigurationPresets(t *testing.T) {
+	remoteManifest := func(presets ...types.MCPConfigurationPreset) types.MCPServerCatalogEntryManifest {
+		return types.MCPServerCatalogEntryManifest{
+			Runtime: types.RuntimeRemote,
+			RemoteConfig: &types.RemoteCatalogConfig{
+				Hostname: "*.example.com",
+				Headers: []types.MCPHeader{
+					{Key: "X-Region"},
+					{Key: "Authorization", Sensitive: true},
+				},
+			},
+			Env: []types.MCPEnv{
+				{MCPHeader: types.MCPHeader{Key: "API_TOKEN", Sensitive: true}},
+			},
+			Presets: presets,
+		}
+	}
+
+	t.Run("valid presets", func(t *testing.T) {
+		require.NoError(t, ValidateCatalogEntryManifest(remoteManifest(
+			types.MCPConfigurationPreset{Name: "us", URL: "https://us.example.com/mcp", Env: map[string]string{"X-Region": "us"}},
+			types.MCPConfigurationPreset{Name: "read-only", Tools: []string{"list_items", "get_item"}},
+		)))
+	})
+
+	t.Run("rejects duplicate names", func(t *testing.T) {
+		err := ValidateCatalogEntryManifest(remoteManifest(
+			types.MCPConfigurationPreset{Name: "us"},
+			types.MCPConfigurationPreset{Name: "us"},
+		))
+		require.Equal(t, types.RuntimeValidationError{
+			Runtime: types.RuntimeRemote,
+			Field:   "presets.name",
+			Message: `duplicate preset name "us"`,
+		}, err)
+	})
+
+	t.Run("rejects sensitive values", func(t *testing.T) {
+		for _, key := range []string{"Authorization", "API_TOKEN"} {
+			err := ValidateCatalogEntryManifest(remoteManifest(
+				types.MCPConfigurationPreset{Name: "us", Env: map[string]string{key: "secret"}},
+			))
+			require.Equal(t, types.RuntimeValidationError{
+				Runtime: types.RuntimeRemote,
+				Field:   "presets.env",
+				Message: fmt.Sprintf("preset %q cannot set a value for sensitive key %q", "us", key),
+			}, err)
+		}
+	})
+
+	t.Run("rejects URL that does not match the hostname", func(t *testing.T) {
+		require.Error(t, ValidateCatalogEntryManifest(remoteManifest(
+			types.MCPConfigurationPreset{Name: "us", URL: "https://evil.com/mcp"},
+		)))
+	})
+
+	t.Run("rejects URL for catalog entries with a fixed URL", func(t *testing.T) {
+		err := ValidateCatalogEntryManifest(types.MCPServerCatalogEntryManifest{
+			Runtime: types.RuntimeRemote,
+			RemoteConfig: &types.RemoteCatalogConfig{
+				FixedURL: "https://example.com/mcp",
+			},
+			Presets: []types.MCPConfigurationPreset{{Name: "us", URL: "https://example.com/other"}},
+		})
+		require.Equal(t, types.RuntimeValidationError{
+			Runtime: types.RuntimeRemote,
+			Field:   "presets.url",
+			Message: `preset "us" can only set a URL for remote catalog entries that require a user URL`,
+		}, err)
+	})
+}
+
+func TestValidateSecretRefs(t *testing.T) {
+	manifest := func(env ...types.MCPEnv) types.MCPServerCatalogEntryManifest {
+		return types.MCPServerCatalogEntryManifest{
+			Runtime:   types.RuntimeNPX,
+			NPXConfig: &types.NPXRuntimeConfig{Package: "@example/mcp-server"},
+			Env:       env,
+		}
+	}
+
+	require.NoError(t, ValidateCatalogEntryManifest(manifest(
+		types.MCPEnv{MCPHeader: types.MCPHeader{Key: "API_TOKEN"}, ValueFrom: "vault:secret/data/app#token"},
+		types.MCPEnv{MCPHeader: types.MCPHeader{Key: "DB_PASSWORD"}, ValueFrom: "aws:prod/db"},
+		types.MCPEnv{MCPHeader: types.MCPHeader{Key: "CREDENTIALS"}, File: true, ValueFrom: "gcp:projects/example/secrets/creds"},
+	)))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeNPX,
+		Field:   "env[0].valueFrom",
+		Message: `secret reference "azure:app" has unknown secret manager "azure", must be vault, aws, or gcp`,
+	}, ValidateCatalogEntryManifest(manifest(
+		types.MCPEnv{MCPHeader: types.MCPHeader{Key: "API_TOKEN"}, ValueFrom: "azure:app"},
+	)))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeNPX,
+		Field:   "env[0].valueFrom",
+		Message: `secret reference "vault:#token" is missing the name of the secret`,
+	}, ValidateCatalogEntryManifest(manifest(
+		types.MCPEnv{MCPHeader: types.MCPHeader{Key: "API_TOKEN"}, ValueFrom: "vault:#token"},
+	)))
+
+	require.Equal(t, types.RuntimeValidationError{
+		Runtime: types.RuntimeNPX,
+		Field:   "env[0]",
+		Message: "a value from an external secret cannot also have a static value or be sourced from an OAuth token",
+	}, ValidateCatalogEntryManifest(manifest(
+		types.MCPEnv{MCPHeader: types.MCPHeader{Key: "API_TOKEN", Value: "static"}, ValueFrom: "vault:secret/data/app#token"},
+	)))
+}
@@ -27,6 +27,10 @@ export async function deleteApiKey(id: string): Promise<void> {
 	await doDelete(`/api-keys/${id}`);
 }
 
+export async function revokeApiKey(id: string): Promise<APIKey> {
+	return (await doPost(`/api-keys/${id}/revoke`, {})) as APIKey;
+}
+
 // Admin endpoints
 
 export async function listAllApiKeys(opts?: { fetch?: Fetcher }): Promise<APIKey[]> {
@@ -42,3 +46,7 @@ export async function getAnyApiKey(id: string, opts?: { fetch?: Fetcher }): Prom
 export async function deleteAnyApiKey(id: string): Promise<void> {
 	await doDelete(`/admin-api-keys/${id}`);
 }
+
+export async function revokeAnyApiKey(id: string): Promise<APIKey> {
+	return (await doPost(`/admin-api-keys/${id}/revoke`, {})) as APIKey;
+}
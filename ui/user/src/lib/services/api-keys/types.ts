@@ -7,7 +7,9 @@ export interface APIKey {
 	createdAt: string;
 	lastUsedAt?: string;
 	expiresAt?: string;
+	revokedAt?: string;
 	mcpServerIds?: string[];
+	mcpServerTools?: Record<string, string[]>;
 }
 
 export interface APIKeyCreateRequest {
@@ -15,6 +17,7 @@ export interface APIKeyCreateRequest {
 	description?: string;
 	expiresAt?: string;
 	mcpServerIds: string[];
+	mcpServerTools?: Record<string, string[]>;
 	canAccessSkills?: boolean;
 }
 